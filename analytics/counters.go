@@ -0,0 +1,225 @@
+package analytics
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lexfrei/go-unifi/api/network"
+)
+
+// Counters of switch ports and client sessions, used in the names of PortSeries
+// and ClientSeries.
+const (
+	CounterRxBytes   = "rx_bytes"
+	CounterTxBytes   = "tx_bytes"
+	CounterRxErrors  = "rx_errors"
+	CounterTxErrors  = "tx_errors"
+	CounterRxDropped = "rx_dropped"
+	CounterTxDropped = "tx_dropped"
+)
+
+// CounterConfig configures a CounterTracker.
+type CounterConfig struct {
+	// Bits is the width of the counters, 32 or 64 (defaults to 64). Counters
+	// narrower than 64 bits wrap around to zero when they overflow.
+	Bits int
+
+	// OnReset is called with every delta of a counter that was reset,
+	// synchronously from Observe (optional)
+	OnReset func(Delta)
+}
+
+// Delta is the increase of a counter between two observations.
+type Delta struct {
+	// Series is the name of the series.
+	Series string
+
+	// Time is the observation time passed to Observe.
+	Time time.Time
+
+	// Value is the increase since the previous observation.
+	Value uint64
+
+	// Interval is the time since the previous observation.
+	Interval time.Duration
+
+	// Reset tells that the counter went back, e.g. because the device rebooted,
+	// and Value is the count since the reset.
+	Reset bool
+
+	// Rollover tells that the counter wrapped around its maximum.
+	Rollover bool
+}
+
+// Rate returns the increase per second.
+func (d Delta) Rate() float64 {
+	if d.Interval <= 0 {
+		return 0
+	}
+	return float64(d.Value) / d.Interval.Seconds()
+}
+
+// CounterTracker turns monotonically increasing counters, such as the byte
+// counters of switch ports, into per-interval deltas. It is safe for concurrent use.
+type CounterTracker struct {
+	config CounterConfig
+
+	mu     sync.Mutex
+	series map[string]counterSample
+}
+
+type counterSample struct {
+	value uint64
+	at    time.Time
+}
+
+// NewCounterTracker creates a CounterTracker. cfg may be nil to use the defaults.
+func NewCounterTracker(cfg *CounterConfig) *CounterTracker {
+	var config CounterConfig
+	if cfg != nil {
+		config = *cfg
+	}
+	if config.Bits <= 0 || config.Bits > 64 {
+		config.Bits = 64
+	}
+
+	return &CounterTracker{
+		config: config,
+		series: make(map[string]counterSample),
+	}
+}
+
+// Observe records the value of a counter and returns its increase since the
+// previous observation. The first observation of a series, and observations not
+// newer than the previous one, only record the value and return false.
+//
+// A value below the previous one is taken as a rollover if the counter is narrower
+// than 64 bits and the previous value was in the upper half of its range; otherwise
+// as a reset, and the delta is the new value, counted from zero.
+func (t *CounterTracker) Observe(series string, value uint64, at time.Time) (Delta, bool) {
+	t.mu.Lock()
+	previous, ok := t.series[series]
+	if ok && !at.After(previous.at) {
+		t.mu.Unlock()
+		return Delta{}, false
+	}
+	t.series[series] = counterSample{value: value, at: at}
+	t.mu.Unlock()
+	if !ok {
+		return Delta{}, false
+	}
+
+	delta := Delta{Series: series, Time: at, Value: value - previous.value, Interval: at.Sub(previous.at)}
+	if value < previous.value {
+		if t.config.Bits < 64 && previous.value >= 1<<(t.config.Bits-1) && previous.value <= t.maxValue() {
+			delta.Value = t.maxValue() - previous.value + value + 1
+			delta.Rollover = true
+		} else {
+			delta.Value = value
+			delta.Reset = true
+		}
+	}
+
+	if delta.Reset && t.config.OnReset != nil {
+		t.config.OnReset(delta)
+	}
+	return delta, true
+}
+
+// maxValue returns the largest value of the counters.
+func (t *CounterTracker) maxValue() uint64 {
+	if t.config.Bits >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<t.config.Bits - 1
+}
+
+// Forget drops the last value of a series, e.g. when a device is removed, so
+// that its next observation starts over.
+func (t *CounterTracker) Forget(series string) {
+	t.mu.Lock()
+	delete(t.series, series)
+	t.mu.Unlock()
+}
+
+// PortSeries names the series of a counter of a switch port, e.g.
+// PortSeries(deviceID, 1, CounterRxBytes).
+func PortSeries(deviceID string, port int, counter string) string {
+	return "port/" + deviceID + "/" + strconv.Itoa(port) + "/" + counter
+}
+
+// ClientSeries names the series of a byte counter of a client, by MAC address.
+func ClientSeries(mac, counter string) string {
+	return "client/" + mac + "/" + counter
+}
+
+// ObservePorts observes the byte, error and drop counters of the ports of a
+// device, as returned by network.APIClient.GetPortStates, and returns their deltas.
+func ObservePorts(t *CounterTracker, deviceID string, ports []network.SwitchPortState, at time.Time) []Delta {
+	var deltas []Delta
+	for i := range ports {
+		port := &ports[i]
+		for _, c := range []struct {
+			name  string
+			value *network.FlexibleInt
+		}{
+			{CounterRxBytes, port.RxBytes},
+			{CounterTxBytes, port.TxBytes},
+			{CounterRxErrors, port.RxErrors},
+			{CounterTxErrors, port.TxErrors},
+			{CounterRxDropped, port.RxDropped},
+			{CounterTxDropped, port.TxDropped},
+		} {
+			if c.value != nil && *c.value >= 0 {
+				deltas = appendDelta(deltas, t, PortSeries(deviceID, port.PortIdx, c.name), uint64(*c.value), at)
+			}
+		}
+	}
+	return deltas
+}
+
+// ObserveClientSessions observes the byte counters of clients from their
+// sessions, as returned by network.APIClient.ListClientSessions, and returns
+// their deltas. Only the latest session of each client counts. A new session
+// starts its counters from zero, which is reported as a reset once they are
+// below those of the previous session.
+func ObserveClientSessions(t *CounterTracker, sessions []network.ClientSession, at time.Time) []Delta {
+	latest := make(map[string]*network.ClientSession)
+	var macs []string
+	for i := range sessions {
+		session := &sessions[i]
+		current, ok := latest[session.Mac]
+		if !ok {
+			macs = append(macs, session.Mac)
+		}
+		if !ok || session.AssocTime > current.AssocTime {
+			latest[session.Mac] = session
+		}
+	}
+
+	var deltas []Delta
+	for _, mac := range macs {
+		session := latest[mac]
+		for _, c := range []struct {
+			name  string
+			value *int64
+		}{
+			{CounterRxBytes, session.RxBytes},
+			{CounterTxBytes, session.TxBytes},
+		} {
+			if c.value != nil && *c.value >= 0 {
+				deltas = appendDelta(deltas, t, ClientSeries(mac, c.name), uint64(*c.value), at)
+			}
+		}
+	}
+	return deltas
+}
+
+func appendDelta(deltas []Delta, t *CounterTracker, series string, value uint64, at time.Time) []Delta {
+	if delta, ok := t.Observe(series, value, at); ok {
+		deltas = append(deltas, delta)
+	}
+	return deltas
+}
@@ -0,0 +1,131 @@
+package analytics_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/analytics"
+	"github.com/lexfrei/go-unifi/api/network"
+)
+
+func TestCounterTracker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		bits         int
+		previous     uint64
+		current      uint64
+		wantValue    uint64
+		wantReset    bool
+		wantRollover bool
+	}{
+		{name: "increase", previous: 1000, current: 4000, wantValue: 3000},
+		{name: "unchanged", previous: 1000, current: 1000},
+		{name: "reboot", previous: 1 << 40, current: 500, wantValue: 500, wantReset: true},
+		{name: "32-bit rollover", bits: 32, previous: math.MaxUint32 - 99, current: 400, wantValue: 500, wantRollover: true},
+		{name: "32-bit reset", bits: 32, previous: 1000, current: 400, wantValue: 400, wantReset: true},
+		{name: "64-bit counters do not roll over", previous: math.MaxUint32 - 99, current: 400, wantValue: 400, wantReset: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var resets []analytics.Delta
+			tracker := analytics.NewCounterTracker(&analytics.CounterConfig{
+				Bits:    tt.bits,
+				OnReset: func(d analytics.Delta) { resets = append(resets, d) },
+			})
+
+			_, ok := tracker.Observe("port/sw/1/rx_bytes", tt.previous, testStart)
+			assert.False(t, ok, "the first observation has no delta")
+
+			delta, ok := tracker.Observe("port/sw/1/rx_bytes", tt.current, testStart.Add(10*time.Second))
+			require.True(t, ok)
+			assert.Equal(t, tt.wantValue, delta.Value)
+			assert.Equal(t, tt.wantReset, delta.Reset)
+			assert.Equal(t, tt.wantRollover, delta.Rollover)
+			assert.Equal(t, 10*time.Second, delta.Interval)
+			assert.InDelta(t, float64(tt.wantValue)/10, delta.Rate(), 1e-9)
+			if tt.wantReset {
+				assert.Equal(t, []analytics.Delta{delta}, resets)
+			} else {
+				assert.Empty(t, resets)
+			}
+		})
+	}
+}
+
+func TestCounterTrackerOutOfOrder(t *testing.T) {
+	t.Parallel()
+
+	tracker := analytics.NewCounterTracker(nil)
+	tracker.Observe("series", 100, testStart)
+
+	_, ok := tracker.Observe("series", 50, testStart)
+	assert.False(t, ok, "observations not newer than the previous one are ignored")
+	_, ok = tracker.Observe("series", 50, testStart.Add(-time.Minute))
+	assert.False(t, ok)
+
+	delta, ok := tracker.Observe("series", 300, testStart.Add(time.Minute))
+	require.True(t, ok)
+	assert.Equal(t, uint64(200), delta.Value)
+
+	tracker.Forget("series")
+	_, ok = tracker.Observe("series", 400, testStart.Add(2*time.Minute))
+	assert.False(t, ok, "forgotten series start over")
+}
+
+func TestObservePorts(t *testing.T) {
+	t.Parallel()
+
+	bytes := func(v network.FlexibleInt) *network.FlexibleInt { return &v }
+	tracker := analytics.NewCounterTracker(nil)
+
+	ports := []network.SwitchPortState{
+		{PortIdx: 1, RxBytes: bytes(1000), TxBytes: bytes(2000)},
+		{PortIdx: 2, RxErrors: bytes(3)},
+	}
+	assert.Empty(t, analytics.ObservePorts(tracker, "switch", ports, testStart))
+
+	ports = []network.SwitchPortState{
+		{PortIdx: 1, RxBytes: bytes(61000), TxBytes: bytes(100)},
+		{PortIdx: 2, RxErrors: bytes(5)},
+	}
+	deltas := analytics.ObservePorts(tracker, "switch", ports, testStart.Add(time.Minute))
+	require.Len(t, deltas, 3)
+
+	assert.Equal(t, analytics.PortSeries("switch", 1, analytics.CounterRxBytes), deltas[0].Series)
+	assert.InDelta(t, 1000, deltas[0].Rate(), 1e-9)
+	assert.Equal(t, "port/switch/1/tx_bytes", deltas[1].Series)
+	assert.True(t, deltas[1].Reset)
+	assert.Equal(t, "port/switch/2/rx_errors", deltas[2].Series)
+	assert.Equal(t, uint64(2), deltas[2].Value)
+}
+
+func TestObserveClientSessions(t *testing.T) {
+	t.Parallel()
+
+	bytes := func(v int64) *int64 { return &v }
+	tracker := analytics.NewCounterTracker(nil)
+	const mac = "aa:bb:cc:dd:ee:ff"
+
+	analytics.ObserveClientSessions(tracker, []network.ClientSession{
+		{Mac: mac, AssocTime: 100, RxBytes: bytes(5000)},
+	}, testStart)
+
+	// The client reconnected: the older session is ignored and the new one restarts from zero
+	deltas := analytics.ObserveClientSessions(tracker, []network.ClientSession{
+		{Mac: mac, AssocTime: 200, RxBytes: bytes(700)},
+		{Mac: mac, AssocTime: 100, RxBytes: bytes(9000)},
+	}, testStart.Add(time.Minute))
+	require.Len(t, deltas, 1)
+	assert.Equal(t, analytics.ClientSeries(mac, analytics.CounterRxBytes), deltas[0].Series)
+	assert.Equal(t, uint64(700), deltas[0].Value)
+	assert.True(t, deltas[0].Reset)
+}
@@ -0,0 +1,214 @@
+package analytics
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAlpha is the default smoothing factor of baselines.
+	DefaultAlpha = 0.3
+
+	// DefaultThreshold is the default deviation, in standard deviations, reported as anomaly.
+	DefaultThreshold = 3.0
+
+	// DefaultWarmup is the default number of samples a series needs before anomalies are reported.
+	DefaultWarmup = 10
+)
+
+// Direction tells whether an anomalous value is above or below its baseline.
+type Direction string
+
+// Directions of anomalies.
+const (
+	Spike Direction = "spike"
+	Drop  Direction = "drop"
+)
+
+// Config configures a Detector.
+type Config struct {
+	// Alpha is the EWMA smoothing factor in (0, 1] (defaults to DefaultAlpha)
+	Alpha float64
+
+	// Threshold is the deviation from the baseline, in standard deviations, above
+	// which a value is anomalous (defaults to DefaultThreshold)
+	Threshold float64
+
+	// MinDeviation is the absolute deviation a value must also exceed, so that
+	// series with an almost constant baseline do not report every small change (optional)
+	MinDeviation float64
+
+	// Warmup is the number of samples a series needs before anomalies are reported
+	// (defaults to DefaultWarmup)
+	Warmup int
+
+	// OnAnomaly is called with every anomaly, synchronously from Observe (optional)
+	OnAnomaly func(Anomaly)
+}
+
+// Baseline is the rolling expectation of a series.
+type Baseline struct {
+	// Mean is the exponentially weighted moving average.
+	Mean float64
+
+	// StdDev is the exponentially weighted standard deviation.
+	StdDev float64
+
+	// Samples is the number of observed values.
+	Samples int
+}
+
+// Anomaly is a value that deviated from its baseline beyond the threshold.
+type Anomaly struct {
+	// Series is the name of the series.
+	Series string
+
+	// Time is the observation time passed to Observe.
+	Time time.Time
+
+	// Value is the observed value.
+	Value float64
+
+	// Expected is the baseline mean before the observation.
+	Expected float64
+
+	// StdDev is the baseline standard deviation before the observation.
+	StdDev float64
+
+	// Score is the deviation in standard deviations; +Inf if the baseline had no variance.
+	Score float64
+
+	// Direction tells whether Value is above or below Expected.
+	Direction Direction
+}
+
+// Detector tracks baselines of named series and reports anomalies.
+// It is safe for concurrent use.
+type Detector struct {
+	config Config
+
+	mu     sync.Mutex
+	series map[string]*ewma
+}
+
+// ewma is an exponentially weighted moving average and variance.
+type ewma struct {
+	mean     float64
+	variance float64
+	samples  int
+}
+
+func (e *ewma) update(x, alpha float64) {
+	if e.samples == 0 {
+		e.mean = x
+	} else {
+		diff := x - e.mean
+		incr := alpha * diff
+		e.mean += incr
+		e.variance = (1 - alpha) * (e.variance + diff*incr)
+	}
+	e.samples++
+}
+
+// NewDetector creates a Detector. cfg may be nil to use the defaults.
+func NewDetector(cfg *Config) *Detector {
+	var config Config
+	if cfg != nil {
+		config = *cfg
+	}
+	if config.Alpha <= 0 || config.Alpha > 1 {
+		config.Alpha = DefaultAlpha
+	}
+	if config.Threshold <= 0 {
+		config.Threshold = DefaultThreshold
+	}
+	if config.Warmup <= 0 {
+		config.Warmup = DefaultWarmup
+	}
+
+	return &Detector{
+		config: config,
+		series: make(map[string]*ewma),
+	}
+}
+
+// Observe adds a value to a series and reports whether it is anomalous
+// compared to the baseline built from the previous values. NaN and infinite
+// values are ignored.
+func (d *Detector) Observe(series string, value float64, at time.Time) (Anomaly, bool) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Anomaly{}, false
+	}
+
+	d.mu.Lock()
+	state, ok := d.series[series]
+	if !ok {
+		state = &ewma{}
+		d.series[series] = state
+	}
+	anomaly, found := d.check(series, state, value, at)
+	state.update(value, d.config.Alpha)
+	d.mu.Unlock()
+
+	if found && d.config.OnAnomaly != nil {
+		d.config.OnAnomaly(anomaly)
+	}
+	return anomaly, found
+}
+
+func (d *Detector) check(series string, state *ewma, value float64, at time.Time) (Anomaly, bool) {
+	if state.samples < d.config.Warmup {
+		return Anomaly{}, false
+	}
+
+	deviation := value - state.mean
+	if math.Abs(deviation) <= d.config.MinDeviation {
+		return Anomaly{}, false
+	}
+
+	stdDev := math.Sqrt(state.variance)
+	score := math.Inf(1)
+	if stdDev > 0 {
+		score = math.Abs(deviation) / stdDev
+	} else if deviation == 0 {
+		score = 0
+	}
+	if score <= d.config.Threshold {
+		return Anomaly{}, false
+	}
+
+	direction := Spike
+	if deviation < 0 {
+		direction = Drop
+	}
+	return Anomaly{
+		Series:    series,
+		Time:      at,
+		Value:     value,
+		Expected:  state.mean,
+		StdDev:    stdDev,
+		Score:     score,
+		Direction: direction,
+	}, true
+}
+
+// Baseline returns the current baseline of a series, or false if the series
+// has not been observed.
+func (d *Detector) Baseline(series string) (Baseline, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	state, ok := d.series[series]
+	if !ok {
+		return Baseline{}, false
+	}
+	return Baseline{Mean: state.mean, StdDev: math.Sqrt(state.variance), Samples: state.samples}, true
+}
+
+// Reset forgets the baseline of a series, e.g. after a planned change.
+func (d *Detector) Reset(series string) {
+	d.mu.Lock()
+	delete(d.series, series)
+	d.mu.Unlock()
+}
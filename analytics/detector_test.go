@@ -0,0 +1,172 @@
+package analytics_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/analytics"
+)
+
+var testStart = time.Date(2025, 10, 19, 12, 0, 0, 0, time.UTC)
+
+// observeAll feeds values one minute apart and returns the anomalies.
+func observeAll(d *analytics.Detector, series string, values ...float64) []analytics.Anomaly {
+	var anomalies []analytics.Anomaly
+	for i, v := range values {
+		if a, ok := d.Observe(series, v, testStart.Add(time.Duration(i)*time.Minute)); ok {
+			anomalies = append(anomalies, a)
+		}
+	}
+	return anomalies
+}
+
+// noisy returns n values alternating around base.
+func noisy(n int, base, amplitude float64) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = base + amplitude*float64(1-2*(i%2))
+	}
+	return values
+}
+
+func TestDetector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		config        *analytics.Config
+		values        []float64
+		wantDirection []analytics.Direction
+	}{
+		{
+			name:   "steady series",
+			values: noisy(30, 50, 2),
+		},
+		{
+			name:          "spike",
+			values:        append(noisy(20, 50, 2), 120),
+			wantDirection: []analytics.Direction{analytics.Spike},
+		},
+		{
+			name:          "drop",
+			values:        append(noisy(20, 50, 2), 0),
+			wantDirection: []analytics.Direction{analytics.Drop},
+		},
+		{
+			name:   "during warmup",
+			values: append(noisy(5, 50, 2), 500),
+		},
+		{
+			name:   "below threshold",
+			config: &analytics.Config{Threshold: 100},
+			values: append(noisy(20, 50, 2), 120),
+		},
+		{
+			name:   "below minimum deviation",
+			config: &analytics.Config{MinDeviation: 100},
+			values: append(noisy(20, 50, 2), 120),
+		},
+		{
+			name:          "constant baseline",
+			values:        append(noisy(20, 7, 0), 8),
+			wantDirection: []analytics.Direction{analytics.Spike},
+		},
+		{
+			name:   "non-finite values ignored",
+			values: append(noisy(20, 50, 2), math.NaN(), math.Inf(1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var notified int
+			config := tt.config
+			if config == nil {
+				config = &analytics.Config{}
+			}
+			config.OnAnomaly = func(analytics.Anomaly) { notified++ }
+
+			anomalies := observeAll(analytics.NewDetector(config), "clients/default", tt.values...)
+			require.Len(t, anomalies, len(tt.wantDirection))
+			assert.Equal(t, len(tt.wantDirection), notified)
+			for i, a := range anomalies {
+				assert.Equal(t, tt.wantDirection[i], a.Direction)
+				assert.Equal(t, "clients/default", a.Series)
+				assert.Greater(t, a.Score, analytics.DefaultThreshold)
+			}
+		})
+	}
+}
+
+func TestDetector_AnomalyDetails(t *testing.T) {
+	t.Parallel()
+
+	d := analytics.NewDetector(nil)
+	observeAll(d, "traffic/default", noisy(20, 100, 5)...)
+
+	at := testStart.Add(time.Hour)
+	anomaly, ok := d.Observe("traffic/default", 200, at)
+	require.True(t, ok)
+	assert.Equal(t, at, anomaly.Time)
+	assert.InDelta(t, 200, anomaly.Value, 0)
+	assert.InDelta(t, 100, anomaly.Expected, 5)
+	assert.Positive(t, anomaly.StdDev)
+	assert.InDelta(t, (anomaly.Value-anomaly.Expected)/anomaly.StdDev, anomaly.Score, 1e-9)
+}
+
+func TestDetector_BaselineAdapts(t *testing.T) {
+	t.Parallel()
+
+	d := analytics.NewDetector(&analytics.Config{Alpha: 0.5})
+	observeAll(d, "s", noisy(20, 10, 1)...)
+
+	// A lasting level change is reported at first, then absorbed
+	anomalies := observeAll(d, "s", noisy(20, 100, 1)...)
+	require.NotEmpty(t, anomalies)
+	assert.Less(t, len(anomalies), 5)
+
+	baseline, ok := d.Baseline("s")
+	require.True(t, ok)
+	assert.InDelta(t, 100, baseline.Mean, 2)
+	assert.Equal(t, 40, baseline.Samples)
+}
+
+func TestDetector_Reset(t *testing.T) {
+	t.Parallel()
+
+	d := analytics.NewDetector(nil)
+	observeAll(d, "s", noisy(20, 10, 1)...)
+	d.Reset("s")
+
+	_, ok := d.Baseline("s")
+	assert.False(t, ok)
+
+	// A reset series warms up again
+	assert.Empty(t, observeAll(d, "s", 10, 1000))
+}
+
+func TestDetector_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	d := analytics.NewDetector(nil)
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for i := range 100 {
+				d.Observe("s", float64(i%3), testStart)
+			}
+		})
+	}
+	wg.Wait()
+
+	baseline, ok := d.Baseline("s")
+	require.True(t, ok)
+	assert.Equal(t, 800, baseline.Samples)
+}
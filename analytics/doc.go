@@ -0,0 +1,58 @@
+// Package analytics detects unusual site traffic from values polled with the UniFi clients,
+// and turns polled counters into rates.
+//
+// A Detector keeps a rolling baseline, an exponentially weighted moving average (EWMA)
+// and variance, for every named series it observes. Once a series has seen enough
+// samples, a value further than Config.Threshold standard deviations from the
+// baseline is reported as an Anomaly:
+//
+//	detector := analytics.NewDetector(&analytics.Config{
+//	    Threshold: 3,
+//	    OnAnomaly: func(a analytics.Anomaly) {
+//	        log.Printf("%s: %.0f, expected %.0f (%s)", a.Series, a.Value, a.Expected, a.Direction)
+//	    },
+//	})
+//
+//	for range time.Tick(time.Minute) {
+//	    clients, err := seq.Collect(client.AllSiteClients(ctx, siteID))
+//	    if err == nil {
+//	        analytics.ObserveClients(detector, "default", clients, time.Now())
+//	    }
+//	}
+//
+// # Series
+//
+// Series are plain names chosen by the caller. ObserveClients and ObserveDashboard
+// feed the series named by ClientsSeries and TrafficSeries from Network API
+// responses; any other value, such as WAN throughput from the ISP metrics of the
+// Site Manager API, can be fed with Detector.Observe.
+//
+// # Baselines
+//
+// Every observation updates the baseline, anomalous ones included, so a lasting
+// change in traffic becomes the new normal after a few samples. Config.Alpha sets
+// how fast the baseline follows: higher values adapt faster and forget sooner.
+//
+// # Counters
+//
+// Byte, error and drop counters of ports and clients only ever increase, until the
+// device reboots or a client reconnects. A CounterTracker remembers the last value
+// of every series and returns the increase since, so exporters pushing rates or
+// deltas, rather than raw counters, report correct values across resets:
+//
+//	tracker := analytics.NewCounterTracker(nil)
+//
+//	for range time.Tick(time.Minute) {
+//	    ports, err := client.GetPortStates(ctx, siteID, deviceID)
+//	    if err != nil {
+//	        continue
+//	    }
+//	    for _, delta := range analytics.ObservePorts(tracker, deviceID.String(), ports, time.Now()) {
+//	        log.Printf("%s: %.0f/s", delta.Series, delta.Rate())
+//	    }
+//	}
+//
+// A counter lower than its previous value is a reset, and the delta is counted from
+// zero. Counters narrower than 64 bits, set with CounterConfig.Bits, wrap around
+// instead when their previous value was in the upper half of their range.
+package analytics
@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/api/sitemanager"
+)
+
+// ClientsSeries names the series of connected clients of a site. An empty
+// clientType names the total; otherwise the count of one connection type,
+// e.g. ClientsSeries("default", network.WIRELESS).
+func ClientsSeries(site string, clientType network.ClientListItemType) string {
+	if clientType == "" {
+		return "clients/" + site
+	}
+	return "clients/" + site + "/" + strings.ToLower(string(clientType))
+}
+
+// TrafficSeries names the series of client traffic of a site, in bytes over
+// the dashboard history window.
+func TrafficSeries(site string) string {
+	return "traffic/" + site
+}
+
+// WANSeries names a series of WAN measurements of a site: "download_kbps",
+// "upload_kbps" or "latency_ms".
+func WANSeries(siteID string, wan sitemanager.WANID, measurement string) string {
+	return "wan/" + siteID + "/" + string(wan) + "/" + measurement
+}
+
+// ObserveClients observes the number of connected clients of a site, in total
+// and per connection type, and returns the anomalies found.
+func ObserveClients(d *Detector, site string, clients []network.ClientListItem, at time.Time) []Anomaly {
+	counts := map[network.ClientListItemType]int{
+		network.WIRED:    0,
+		network.WIRELESS: 0,
+	}
+	for i := range clients {
+		counts[clients[i].Type]++
+	}
+
+	var anomalies []Anomaly
+	anomalies = appendAnomaly(anomalies, d, ClientsSeries(site, ""), float64(len(clients)), at)
+	for _, clientType := range slices.Sorted(maps.Keys(counts)) {
+		anomalies = appendAnomaly(anomalies, d, ClientsSeries(site, clientType), float64(counts[clientType]), at)
+	}
+	return anomalies
+}
+
+// ObserveDashboard observes the client traffic reported by the aggregated
+// dashboard of a site and returns the anomalies found. Dashboards without
+// traffic data are ignored.
+func ObserveDashboard(d *Detector, site string, dashboard *network.AggregatedDashboard, at time.Time) []Anomaly {
+	if dashboard == nil || dashboard.MostActiveClients == nil || dashboard.MostActiveClients.TotalBytes == nil {
+		return nil
+	}
+	return appendAnomaly(nil, d, TrafficSeries(site), float64(*dashboard.MostActiveClients.TotalBytes), at)
+}
+
+// ObserveWANMetrics observes download and upload throughput and latency of
+// per-WAN ISP metrics, as returned by sitemanager.GroupByWAN, in time order,
+// and returns the anomalies found.
+func ObserveWANMetrics(d *Detector, metrics []sitemanager.WANMetric) []Anomaly {
+	var anomalies []Anomaly
+	for i := range metrics {
+		metric := &metrics[i]
+		for _, m := range []struct {
+			name  string
+			value *int
+		}{
+			{"download_kbps", metric.Data.DownloadKbps},
+			{"upload_kbps", metric.Data.UploadKbps},
+			{"latency_ms", metric.Data.AvgLatency},
+		} {
+			if m.value != nil {
+				anomalies = appendAnomaly(anomalies, d, WANSeries(metric.SiteID, metric.WAN, m.name), float64(*m.value), metric.Time)
+			}
+		}
+	}
+	return anomalies
+}
+
+func appendAnomaly(anomalies []Anomaly, d *Detector, series string, value float64, at time.Time) []Anomaly {
+	if anomaly, ok := d.Observe(series, value, at); ok {
+		anomalies = append(anomalies, anomaly)
+	}
+	return anomalies
+}
@@ -0,0 +1,72 @@
+package analytics_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/analytics"
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/api/sitemanager"
+	smtestdata "github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+)
+
+func TestObserveClients(t *testing.T) {
+	t.Parallel()
+
+	wired := network.ClientListItem{Type: network.WIRED}
+	wireless := network.ClientListItem{Type: network.WIRELESS}
+	usual := []network.ClientListItem{wired, wired, wireless, wireless, wireless}
+
+	d := analytics.NewDetector(&analytics.Config{Warmup: 3, MinDeviation: 1})
+	for i := range 5 {
+		assert.Empty(t, analytics.ObserveClients(d, "default", usual, testStart.Add(time.Duration(i)*time.Minute)))
+	}
+
+	baseline, ok := d.Baseline(analytics.ClientsSeries("default", network.WIRELESS))
+	require.True(t, ok)
+	assert.InDelta(t, 3, baseline.Mean, 0)
+
+	// All wireless clients gone
+	anomalies := analytics.ObserveClients(d, "default", []network.ClientListItem{wired, wired}, testStart.Add(time.Hour))
+	require.Len(t, anomalies, 2)
+	assert.Equal(t, "clients/default", anomalies[0].Series)
+	assert.Equal(t, "clients/default/wireless", anomalies[1].Series)
+	assert.Equal(t, analytics.Drop, anomalies[1].Direction)
+}
+
+func TestObserveDashboard(t *testing.T) {
+	t.Parallel()
+
+	d := analytics.NewDetector(nil)
+	assert.Empty(t, analytics.ObserveDashboard(d, "default", &network.AggregatedDashboard{}, testStart))
+	_, ok := d.Baseline(analytics.TrafficSeries("default"))
+	assert.False(t, ok, "dashboards without traffic are ignored")
+
+	var dashboard network.AggregatedDashboard
+	require.NoError(t, json.Unmarshal([]byte(`{"most_active_clients": {"total_bytes": 1048576}}`), &dashboard))
+	analytics.ObserveDashboard(d, "default", &dashboard, testStart)
+	baseline, ok := d.Baseline(analytics.TrafficSeries("default"))
+	require.True(t, ok)
+	assert.InDelta(t, 1048576, baseline.Mean, 0)
+}
+
+func TestObserveWANMetrics(t *testing.T) {
+	t.Parallel()
+
+	var resp sitemanager.ISPMetricsResponse
+	smtestdata.LoadFixtureJSON(t, "metrics/get_isp_metrics_dual_wan.json", &resp)
+	groups := sitemanager.GroupByWAN(resp.Data)
+	require.NotEmpty(t, groups[sitemanager.WANSecondary])
+
+	d := analytics.NewDetector(nil)
+	analytics.ObserveWANMetrics(d, groups[sitemanager.WANSecondary])
+
+	metric := groups[sitemanager.WANSecondary][0]
+	baseline, ok := d.Baseline(analytics.WANSeries(metric.SiteID, sitemanager.WANSecondary, "download_kbps"))
+	require.True(t, ok)
+	assert.Equal(t, len(groups[sitemanager.WANSecondary]), baseline.Samples)
+}
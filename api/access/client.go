@@ -0,0 +1,552 @@
+package access
+
+//go:generate oapi-codegen -config .oapi-codegen.yaml openapi.yaml
+//go:generate go run ../../internal/enumgen
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	unifi "github.com/lexfrei/go-unifi"
+	"github.com/lexfrei/go-unifi/internal/httpclient"
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/ratelimit"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const (
+	// DefaultPort is the port the Access application serves its API on.
+	DefaultPort = "12445"
+	// DeveloperBasePath is the base path of the Access API, relative to the controller URL.
+	DeveloperBasePath = "/api/v1/developer"
+)
+
+const (
+	// DefaultRateLimit is the default rate limit for the Access API (requests per minute).
+	DefaultRateLimit = 1000
+	// RateLimitDisabled turns off client-side rate limiting when used as RateLimitPerMinute.
+	RateLimitDisabled = -1
+
+	// DefaultMaxRetries is the default number of retries for failed requests.
+	DefaultMaxRetries = 3
+	// DefaultRetryWaitTime is the default wait time between retries.
+	DefaultRetryWaitTime = 1 * time.Second
+	// DefaultTimeout is the default HTTP client timeout.
+	DefaultTimeout = 30 * time.Second
+)
+
+// APIClient wraps the generated API client with composable middleware.
+type APIClient struct {
+	client  *ClientWithResponses
+	decoder *response.Decoder
+	paths   *middleware.PathCache
+}
+
+// Compile-time check to ensure APIClient implements AccessAPIClient interface.
+var _ AccessAPIClient = (*APIClient)(nil)
+
+// ClientConfig holds configuration for the Access API client. It mirrors the
+// configuration of the Network API client, so both can be built from the same
+// console settings.
+type ClientConfig struct {
+	// ControllerURL is the base URL of the UniFi console (e.g., "https://unifi.local" or "https://192.168.1.1");
+	// the Access API port 12445 is used unless the URL has a port
+	ControllerURL string
+
+	// APIToken is the API token for authentication, created in the Access application
+	// under Settings > General > API Token with the scopes of the operations to call
+	APIToken string
+
+	// InsecureSkipVerify disables TLS certificate verification (useful for self-signed certs)
+	InsecureSkipVerify bool
+
+	// RootCAs sets the certificate authorities that verify the server certificate, e.g. a
+	// private CA of the controller (optional, defaults to the system pool)
+	RootCAs *x509.CertPool
+
+	// RateLimitPerMinute sets the rate limit (defaults to 1000); RateLimitDisabled, or any
+	// negative value, removes client-side rate limiting
+	RateLimitPerMinute int
+
+	// MaxRetries sets maximum number of retries for failed requests
+	MaxRetries int
+
+	// RetryWaitTime sets the wait time between retries
+	RetryWaitTime time.Duration
+
+	// RetryMaxWaitTime caps the exponentially growing wait between retries; Retry-After
+	// headers are honored even above it (defaults to 0, uncapped)
+	RetryMaxWaitTime time.Duration
+
+	// RetryJitter is the fraction of each wait between retries that is randomized, from 0
+	// (fixed waits) to 1, spreading out clients that failed at the same time (defaults to 0)
+	RetryJitter float64
+
+	// RetryBudgetPerMinute caps the retries of all requests made through the client,
+	// refilled continuously; a failure that finds the budget empty is returned as
+	// *unifierr.RetryBudgetError instead of being retried (defaults to 0, unlimited)
+	RetryBudgetPerMinute int
+
+	// OnRetryDecision is called before every retry with the failed response (nil on network
+	// errors), the retry number, and the wait time; returning false stops retrying (optional)
+	OnRetryDecision RetryDecisionFunc
+
+	// WaitPastDeadline makes requests wait for the rate limiter even when the wait
+	// ends after the context deadline. By default such requests fail immediately
+	// with an error matching unifierr.ErrWouldExceedDeadline (defaults to false)
+	WaitPastDeadline bool
+
+	// UserAgent identifies the application in the User-Agent header of requests, e.g.
+	// "my-exporter/1.2", for controller-side log forensics and support requests.
+	// go-unifi/<version> is always appended (optional)
+	UserAgent string
+
+	// Timeout bounds each request as a whole, from dialing to reading the end of the
+	// response body (defaults to 30 seconds). Any negative value removes it, leaving
+	// only the context deadline and the phase timeouts below
+	Timeout time.Duration
+
+	// DialTimeout bounds establishing a connection, including DNS resolution
+	// (defaults to 30 seconds, as http.DefaultTransport)
+	DialTimeout time.Duration
+
+	// TLSHandshakeTimeout bounds the TLS handshake (defaults to 10 seconds, as
+	// http.DefaultTransport)
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout bounds waiting for the response headers once the request is
+	// sent, without limiting how long reading the body may take (defaults to 0, unbounded)
+	ResponseHeaderTimeout time.Duration
+
+	// Logger for observability (optional, uses noop logger if nil)
+	Logger observability.Logger
+
+	// Metrics recorder for observability (optional, uses noop recorder if nil).
+	// Recorders implementing observability.PathCacheMetricsRecorder also receive
+	// statistics of the normalized-path cache
+	Metrics observability.MetricsRecorder
+
+	// PathCacheSize bounds the number of request paths whose normalized form is cached
+	// for metrics (defaults to 4096)
+	PathCacheSize int
+
+	// KnownPaths pre-seeds the normalized-path cache with request paths that are never
+	// evicted, e.g. the CachedPaths of a previous run (optional)
+	KnownPaths []string
+
+	// StrictDecoding controls how response fields unknown to the client are handled
+	// (defaults to StrictDecodingOff; use StrictDecodingLog or StrictDecodingFail to detect schema drift)
+	StrictDecoding StrictDecodingMode
+}
+
+// RetryDecisionFunc decides whether a failed request is retried. It receives the failed
+// response (nil on network errors), the number of the upcoming retry starting at 1, and
+// the wait time before it, e.g. to record telemetry from response headers or to give up
+// early on specific responses.
+type RetryDecisionFunc = middleware.RetryDecisionFunc
+
+// StrictDecodingMode controls how response fields that are not part of the client's models are handled.
+type StrictDecodingMode = response.StrictMode
+
+// Strict decoding modes.
+const (
+	// StrictDecodingOff ignores unknown response fields.
+	StrictDecodingOff = response.StrictOff
+	// StrictDecodingLog logs unknown response fields as warnings via the configured Logger.
+	StrictDecodingLog = response.StrictLog
+	// StrictDecodingFail fails calls whose responses contain unknown fields with unifierr.ErrUnknownField.
+	StrictDecodingFail = response.StrictFail
+)
+
+// New creates a new UniFi Access API client with default settings.
+//
+// Default settings:
+//   - Port: 12445, unless controllerURL has one
+//   - Rate limit: 1000 requests/minute
+//   - Max retries: 3
+//   - Retry wait time: 1 second
+//   - Timeout: 30 seconds
+//   - TLS verification: disabled (for self-signed certificates)
+//
+// For custom configuration, use NewWithConfig.
+//
+// Example:
+//
+//	client, err := access.New("https://unifi.local", "your-api-token")
+func New(controllerURL, apiToken string) (*APIClient, error) {
+	return NewWithConfig(&ClientConfig{
+		ControllerURL:      controllerURL,
+		APIToken:           apiToken,
+		InsecureSkipVerify: true, // Default to true for self-signed certs
+	})
+}
+
+// NewWithConfig creates a new UniFi Access API client with custom configuration.
+//
+// Example:
+//
+//	client, err := access.NewWithConfig(&access.ClientConfig{
+//	    ControllerURL:      "https://unifi.local",
+//	    APIToken:           "your-api-token",
+//	    InsecureSkipVerify: true,
+//	    Logger:             myLogger,
+//	    Metrics:            myMetrics,
+//	})
+func NewWithConfig(cfg *ClientConfig) (*APIClient, error) {
+	if cfg == nil {
+		return nil, errors.New("config is required")
+	}
+	if cfg.ControllerURL == "" {
+		return nil, errors.New("controller URL is required")
+	}
+	if cfg.APIToken == "" {
+		return nil, errors.New("API token is required")
+	}
+
+	serverURL, err := serverURL(cfg.ControllerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set defaults
+	if cfg.RateLimitPerMinute == 0 {
+		cfg.RateLimitPerMinute = DefaultRateLimit
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.RetryWaitTime == 0 {
+		cfg.RetryWaitTime = DefaultRetryWaitTime
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	// Create rate limiter (nil when disabled, which removes the middleware from the chain)
+	rateLimiter := ratelimit.NewOptionalRateLimiter(cfg.RateLimitPerMinute)
+
+	paths := middleware.NewPathCache(cfg.PathCacheSize, cfg.KnownPaths)
+
+	// Build middleware chain (applied in reverse order: last = innermost, applied first)
+	// Order from outside to inside: Recover -> UserAgent -> RequestID -> Observability -> RateLimit -> Retry -> TLS -> Timeouts.
+	// TLS and Timeouts must stay innermost: they configure the base *http.Transport and would
+	// replace, rather than wrap, any middleware below it.
+	httpClient := httpclient.New(
+		httpclient.WithTimeout(max(cfg.Timeout, 0)),
+		httpclient.WithMiddleware(
+			middleware.Recover(cfg.Logger, cfg.Metrics),
+			middleware.UserAgent(cfg.UserAgent, "go-unifi/"+unifi.Version()),
+			middleware.RequestID(),
+			middleware.Observability(cfg.Logger, cfg.Metrics, paths),
+			middleware.RateLimit(middleware.RateLimitConfig{
+				Limiter:          rateLimiter,
+				Logger:           cfg.Logger,
+				Metrics:          cfg.Metrics,
+				WaitPastDeadline: cfg.WaitPastDeadline,
+			}),
+			middleware.Retry(middleware.RetryConfig{
+				MaxRetries:      cfg.MaxRetries,
+				InitialWait:     cfg.RetryWaitTime,
+				MaxWait:         cfg.RetryMaxWaitTime,
+				Jitter:          cfg.RetryJitter,
+				Logger:          cfg.Logger,
+				Metrics:         cfg.Metrics,
+				OnRetryDecision: cfg.OnRetryDecision,
+				Budget:          ratelimit.NewRetryBudget(cfg.RetryBudgetPerMinute),
+			}),
+			middleware.TLSConfig(&tls.Config{
+				InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // User-configurable
+				RootCAs:            cfg.RootCAs,
+			}),
+			middleware.Timeouts(middleware.TransportTimeouts{
+				Dial:           cfg.DialTimeout,
+				TLSHandshake:   cfg.TLSHandshakeTimeout,
+				ResponseHeader: cfg.ResponseHeaderTimeout,
+			}),
+		),
+	)
+
+	// Create request editor to add Authorization and Accept headers
+	requestEditor := func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+cfg.APIToken)
+		req.Header.Set("Accept", "application/json")
+		return nil
+	}
+
+	// Create generated client
+	generatedClient, err := NewClientWithResponses(
+		serverURL,
+		WithHTTPClient(httpClient.HTTPClient()),
+		WithRequestEditorFn(requestEditor),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create API client")
+	}
+
+	return &APIClient{
+		client:  generatedClient,
+		decoder: &response.Decoder{Mode: cfg.StrictDecoding, Logger: cfg.Logger},
+		paths:   paths,
+	}, nil
+}
+
+// serverURL returns the base URL of the Access API on the controller, on DefaultPort
+// unless controllerURL has a port.
+func serverURL(controllerURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSuffix(controllerURL, "/"))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid controller URL")
+	}
+	if u.Host == "" {
+		return "", errors.Newf("invalid controller URL %q: host is required", controllerURL)
+	}
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), DefaultPort)
+	}
+	return u.String() + DeveloperBasePath, nil
+}
+
+// CachedPaths returns the request paths in the normalized-path cache: the KnownPaths,
+// then the others from most to least recently used. Passing them as
+// ClientConfig.KnownPaths on the next start keeps the paths of regular requests
+// cached however many one-off paths are requested.
+func (c *APIClient) CachedPaths() []string {
+	return c.paths.Paths()
+}
+
+// ListDoors retrieves all doors managed by the Access application.
+func (c *APIClient) ListDoors(ctx context.Context) ([]Door, error) {
+	ctx = middleware.WithOperation(ctx, "ListDoors")
+	resp, err := c.client.ListDoorsWithResponse(ctx)
+	var data *DoorsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	doors, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list doors")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	err = checkResult(doors.Code, doors.Msg, "failed to list doors")
+	if err != nil {
+		return nil, err
+	}
+	return doors.Data, nil
+}
+
+// GetDoor retrieves a door by ID.
+func (c *APIClient) GetDoor(ctx context.Context, doorID string) (*Door, error) {
+	ctx = middleware.WithOperation(ctx, "GetDoor")
+	err := validateID("door", doorID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetDoorWithResponse(ctx, doorID)
+	var data *DoorResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	door, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get door "+doorID)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	err = checkResult(door.Code, door.Msg, "failed to get door "+doorID)
+	if err != nil {
+		return nil, err
+	}
+	return &door.Data, nil
+}
+
+// UnlockDoor unlocks a door remotely for the unlock duration configured for the door.
+// The unlock is recorded in the access logs under actor (optional), or under the API
+// token without one.
+func (c *APIClient) UnlockDoor(ctx context.Context, doorID string, actor *UnlockDoorRequest) error {
+	ctx = middleware.WithOperation(ctx, "UnlockDoor")
+	err := validateID("door", doorID)
+	if err != nil {
+		return err
+	}
+	if actor == nil {
+		actor = &UnlockDoorRequest{}
+	}
+	resp, err := c.client.UnlockDoorWithResponse(ctx, doorID, *actor)
+	var data *StatusResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	result, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to unlock door "+doorID)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return err
+	}
+	return checkResult(result.Code, result.Msg, "failed to unlock door "+doorID)
+}
+
+// GetDoorLockRule retrieves the temporary lock rule of a door.
+func (c *APIClient) GetDoorLockRule(ctx context.Context, doorID string) (*DoorLockRule, error) {
+	ctx = middleware.WithOperation(ctx, "GetDoorLockRule")
+	err := validateID("door", doorID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetDoorLockRuleWithResponse(ctx, doorID)
+	var data *DoorLockRuleResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	rule, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get lock rule of door "+doorID)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	err = checkResult(rule.Code, rule.Msg, "failed to get lock rule of door "+doorID)
+	if err != nil {
+		return nil, err
+	}
+	return &rule.Data, nil
+}
+
+// SetDoorLockRule sets a temporary lock rule on a door, such as keeping it unlocked
+// until the rule is reset.
+func (c *APIClient) SetDoorLockRule(ctx context.Context, doorID string, rule DoorLockRuleInput) error {
+	ctx = middleware.WithOperation(ctx, "SetDoorLockRule")
+	err := validateID("door", doorID)
+	if err != nil {
+		return err
+	}
+	if rule.Type == LockRuleCustom && (rule.Interval == nil || *rule.Interval <= 0) {
+		return errors.Wrap(unifierr.ErrValidation, "custom lock rules require a positive interval")
+	}
+	resp, err := c.client.SetDoorLockRuleWithResponse(ctx, doorID, rule)
+	var data *StatusResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	result, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to set lock rule of door "+doorID)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return err
+	}
+	return checkResult(result.Code, result.Msg, "failed to set lock rule of door "+doorID)
+}
+
+// ListDevices retrieves all Access devices, such as hubs and readers.
+func (c *APIClient) ListDevices(ctx context.Context) ([]Device, error) {
+	ctx = middleware.WithOperation(ctx, "ListDevices")
+	resp, err := c.client.ListDevicesWithResponse(ctx)
+	var data *DevicesResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	devices, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list devices")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	err = checkResult(devices.Code, devices.Msg, "failed to list devices")
+	if err != nil {
+		return nil, err
+	}
+
+	// The API groups devices by the door or location they are installed at
+	var all []Device
+	for _, group := range devices.Data {
+		all = append(all, group...)
+	}
+	return all, nil
+}
+
+// ListSchedules retrieves all access schedules.
+func (c *APIClient) ListSchedules(ctx context.Context) ([]Schedule, error) {
+	ctx = middleware.WithOperation(ctx, "ListSchedules")
+	resp, err := c.client.ListSchedulesWithResponse(ctx)
+	var data *SchedulesResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	schedules, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list schedules")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	err = checkResult(schedules.Code, schedules.Msg, "failed to list schedules")
+	if err != nil {
+		return nil, err
+	}
+	return schedules.Data, nil
+}
+
+// GetSchedule retrieves an access schedule by ID, with its weekly time ranges.
+func (c *APIClient) GetSchedule(ctx context.Context, scheduleID string) (*Schedule, error) {
+	ctx = middleware.WithOperation(ctx, "GetSchedule")
+	err := validateID("schedule", scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetScheduleWithResponse(ctx, scheduleID)
+	var data *ScheduleResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	schedule, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get schedule "+scheduleID)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	err = checkResult(schedule.Code, schedule.Msg, "failed to get schedule "+scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	return &schedule.Data, nil
+}
+
+// ListSystemLogs retrieves a page of system logs, such as door openings, matching
+// query. Pages are selected by params (optional) and numbered from 1; the Pagination
+// of the response reports the total number of logs.
+func (c *APIClient) ListSystemLogs(ctx context.Context, query SystemLogsQuery, params *ListSystemLogsParams) (*SystemLogsResponse, error) {
+	ctx = middleware.WithOperation(ctx, "ListSystemLogs")
+	resp, err := c.client.ListSystemLogsWithResponse(ctx, params, query)
+	var data *SystemLogsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	logs, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list system logs")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	err = checkResult(logs.Code, logs.Msg, "failed to list system logs")
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
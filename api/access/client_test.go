@@ -0,0 +1,422 @@
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/access/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Test constants.
+const (
+	testAPIToken   = "test-api-token"
+	testDoorID     = "0ed545f8-2fcd-4839-9021-b39e707f6aa9"
+	testScheduleID = "1b8e6c7d-5d4e-4a4f-9b57-4e1a2c3d8f90"
+)
+
+// newTestClient creates a client for the mock server at serverURL.
+func newTestClient(t *testing.T, serverURL string) *APIClient {
+	t.Helper()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: serverURL,
+		APIToken:      testAPIToken,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://unifi.local", testAPIToken)
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	assert.NotNil(t, client.client)
+}
+
+func TestNewWithConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  *ClientConfig
+		wantErr bool
+	}{
+		{
+			name:   "minimal config",
+			config: &ClientConfig{ControllerURL: "https://unifi.local", APIToken: "test-token"},
+		},
+		{
+			name: "custom settings",
+			config: &ClientConfig{
+				ControllerURL:      "https://unifi.local/",
+				APIToken:           "test-token",
+				RateLimitPerMinute: RateLimitDisabled,
+				MaxRetries:         5,
+				RetryWaitTime:      2 * time.Second,
+			},
+		},
+		{name: "nil config", config: nil, wantErr: true},
+		{name: "empty controller URL", config: &ClientConfig{APIToken: "test-token"}, wantErr: true},
+		{name: "controller URL without host", config: &ClientConfig{ControllerURL: "unifi.local", APIToken: "test-token"}, wantErr: true},
+		{name: "empty API token", config: &ClientConfig{ControllerURL: "https://unifi.local"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewWithConfig(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, client)
+		})
+	}
+}
+
+func TestServerURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		controllerURL string
+		want          string
+	}{
+		{controllerURL: "https://unifi.local", want: "https://unifi.local:12445/api/v1/developer"},
+		{controllerURL: "https://192.168.1.1/", want: "https://192.168.1.1:12445/api/v1/developer"},
+		{controllerURL: "https://unifi.local:8443", want: "https://unifi.local:8443/api/v1/developer"},
+		{controllerURL: "https://[fd00::1]", want: "https://[fd00::1]:12445/api/v1/developer"},
+	}
+
+	for _, tt := range tests {
+		got, err := serverURL(tt.controllerURL)
+		require.NoError(t, err, tt.controllerURL)
+		assert.Equal(t, tt.want, got, tt.controllerURL)
+	}
+}
+
+func TestListDoors(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, DeveloperBasePath+"/doors", r.URL.Path)
+		assert.Equal(t, "Bearer "+testAPIToken, r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "doors/list_success.json")))
+	})
+	defer server.Close()
+
+	doors, err := newTestClient(t, server.URL).ListDoors(context.Background())
+	require.NoError(t, err)
+	require.Len(t, doors, 2)
+
+	assert.Equal(t, testDoorID, doors[0].Id)
+	assert.Equal(t, "HQ - 1F - Main Entrance", doors[0].FullName)
+	assert.Equal(t, DoorLocked, doors[0].DoorLockRelayStatus)
+	require.NotNil(t, doors[0].DoorPositionStatus)
+	assert.Equal(t, DoorClosed, *doors[0].DoorPositionStatus)
+	assert.Equal(t, DoorUnlocked, doors[1].DoorLockRelayStatus)
+	assert.Nil(t, doors[1].DoorPositionStatus, "doors without a position sensor report null")
+}
+
+func TestGetDoor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, DeveloperBasePath+"/doors/"+testDoorID, "",
+			testdata.LoadFixture(t, "doors/get_success.json"), http.StatusOK)
+		defer server.Close()
+
+		door, err := newTestClient(t, server.URL).GetDoor(context.Background(), testDoorID)
+		require.NoError(t, err)
+		assert.Equal(t, "Main Entrance", door.Name)
+		assert.True(t, door.IsBindHub)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, DeveloperBasePath+"/doors/"+testDoorID, "",
+			testdata.LoadFixture(t, "errors/not_found.json"), http.StatusNotFound)
+		defer server.Close()
+
+		_, err := newTestClient(t, server.URL).GetDoor(context.Background(), testDoorID)
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, DeveloperBasePath+"/doors/"+testDoorID, "",
+			testdata.LoadFixture(t, "errors/unauthorized.json"), http.StatusUnauthorized)
+		defer server.Close()
+
+		_, err := newTestClient(t, server.URL).GetDoor(context.Background(), testDoorID)
+		require.ErrorIs(t, err, unifierr.ErrUnauthorized)
+	})
+
+	t.Run("failure reported with status 200", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, DeveloperBasePath+"/doors/"+testDoorID, "",
+			testdata.LoadFixture(t, "errors/not_found.json"), http.StatusOK)
+		defer server.Close()
+
+		_, err := newTestClient(t, server.URL).GetDoor(context.Background(), testDoorID)
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+		var resultErr *ResultError
+		require.ErrorAs(t, err, &resultErr)
+		assert.Equal(t, "CODE_RESOURCE_NOT_FOUND", resultErr.Code)
+		assert.Equal(t, "Resource not found.", resultErr.Message)
+	})
+
+	t.Run("empty ID is rejected before sending", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newTestClient(t, "https://unifi.invalid").GetDoor(context.Background(), "")
+		require.ErrorIs(t, err, unifierr.ErrValidation)
+	})
+}
+
+func TestUnlockDoor(t *testing.T) {
+	t.Parallel()
+
+	actorName := "Front Desk"
+	tests := []struct {
+		name     string
+		actor    *UnlockDoorRequest
+		wantBody string
+	}{
+		{name: "without actor", actor: nil, wantBody: `{}`},
+		{name: "with actor", actor: &UnlockDoorRequest{ActorName: &actorName}, wantBody: `{"actor_name":"Front Desk"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, DeveloperBasePath+"/doors/"+testDoorID+"/unlock", r.URL.Path)
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, tt.wantBody, string(body))
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(testdata.LoadFixture(t, "status/success.json")))
+			})
+			defer server.Close()
+
+			err := newTestClient(t, server.URL).UnlockDoor(context.Background(), testDoorID, tt.actor)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("failure reported with status 200", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, DeveloperBasePath+"/doors/"+testDoorID+"/unlock", "",
+			testdata.LoadFixture(t, "errors/params_invalid.json"), http.StatusOK)
+		defer server.Close()
+
+		err := newTestClient(t, server.URL).UnlockDoor(context.Background(), testDoorID, nil)
+		require.ErrorIs(t, err, unifierr.ErrValidation)
+	})
+}
+
+func TestGetDoorLockRule(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, DeveloperBasePath+"/doors/"+testDoorID+"/lock_rule", "",
+		testdata.LoadFixture(t, "doors/lock_rule_success.json"), http.StatusOK)
+	defer server.Close()
+
+	rule, err := newTestClient(t, server.URL).GetDoorLockRule(context.Background(), testDoorID)
+	require.NoError(t, err)
+	assert.Equal(t, LockRuleCustom, rule.Type)
+	require.NotNil(t, rule.EndedTime)
+	assert.Equal(t, int64(1729159800), *rule.EndedTime)
+}
+
+func TestSetDoorLockRule(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, DeveloperBasePath+"/doors/"+testDoorID+"/lock_rule", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"type":"custom","interval":30}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "status/success.json")))
+	})
+	defer server.Close()
+
+	interval := 30
+	err := newTestClient(t, server.URL).SetDoorLockRule(context.Background(), testDoorID, DoorLockRuleInput{
+		Type:     LockRuleCustom,
+		Interval: &interval,
+	})
+	require.NoError(t, err)
+
+	t.Run("custom rules require an interval", func(t *testing.T) {
+		t.Parallel()
+
+		err := newTestClient(t, "https://unifi.invalid").SetDoorLockRule(context.Background(), testDoorID,
+			DoorLockRuleInput{Type: LockRuleCustom})
+		require.ErrorIs(t, err, unifierr.ErrValidation)
+	})
+}
+
+func TestListDevices(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, DeveloperBasePath+"/devices", "",
+		testdata.LoadFixture(t, "devices/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	devices, err := newTestClient(t, server.URL).ListDevices(context.Background())
+	require.NoError(t, err)
+	require.Len(t, devices, 3, "device groups are flattened")
+
+	assert.Equal(t, "UAH", devices[0].Type)
+	require.NotNil(t, devices[1].Alias)
+	assert.Equal(t, "Main Entrance Reader", *devices[1].Alias)
+	assert.Equal(t, "UA-Intercom", devices[2].Type)
+	assert.Nil(t, devices[2].Alias)
+}
+
+func TestListSchedules(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, DeveloperBasePath+"/access_policies/schedules", "",
+		testdata.LoadFixture(t, "schedules/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	schedules, err := newTestClient(t, server.URL).ListSchedules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	assert.True(t, schedules[0].IsDefault)
+	assert.Equal(t, testScheduleID, schedules[1].Id)
+}
+
+func TestGetSchedule(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, DeveloperBasePath+"/access_policies/schedules/"+testScheduleID, "",
+		testdata.LoadFixture(t, "schedules/get_success.json"), http.StatusOK)
+	defer server.Close()
+
+	schedule, err := newTestClient(t, server.URL).GetSchedule(context.Background(), testScheduleID)
+	require.NoError(t, err)
+	assert.Equal(t, "Office Hours", schedule.Name)
+	require.NotNil(t, schedule.Weekly)
+	require.NotNil(t, schedule.Weekly.Friday)
+	assert.Equal(t, []TimeRange{
+		{StartTime: "08:00:00", EndTime: "12:00:59"},
+		{StartTime: "13:00:00", EndTime: "16:00:59"},
+	}, *schedule.Weekly.Friday)
+	assert.Empty(t, *schedule.Weekly.Sunday)
+}
+
+func TestListSystemLogs(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, DeveloperBasePath+"/system/logs", r.URL.Path)
+		assert.Equal(t, "page_num=2&page_size=25", r.URL.RawQuery)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"topic":"door_openings","since":1729065600}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "system/logs_success.json")))
+	})
+	defer server.Close()
+
+	since := int64(1729065600)
+	pageNum, pageSize := 2, 25
+	page, err := newTestClient(t, server.URL).ListSystemLogs(context.Background(),
+		SystemLogsQuery{Topic: LogTopicDoorOpenings, Since: &since},
+		&ListSystemLogsParams{PageNum: &pageNum, PageSize: &pageSize})
+	require.NoError(t, err)
+	require.Len(t, page.Data.Hits, 1)
+	assert.Equal(t, 1, page.Pagination.Total)
+
+	log := page.Data.Hits[0]
+	assert.Equal(t, time.Date(2024, 10, 17, 8, 12, 31, 0, time.UTC), log.Timestamp)
+	assert.Equal(t, "Jane Doe", log.Source.Actor.DisplayName)
+	assert.Equal(t, "access.door.unlock", log.Source.Event.Type)
+	require.NotNil(t, log.Source.Authentication)
+	assert.Equal(t, "NFC", log.Source.Authentication.CredentialProvider)
+	require.NotNil(t, log.Source.Target)
+	assert.Equal(t, testDoorID, (*log.Source.Target)[0].Id)
+}
+
+func TestResultError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code string
+		want error
+	}{
+		{code: "CODE_PARAMS_INVALID", want: unifierr.ErrValidation},
+		{code: "CODE_NOT_EXISTS", want: unifierr.ErrNotFound},
+		{code: "CODE_OPERATION_FORBIDDEN", want: unifierr.ErrUnauthorized},
+		{code: "CODE_SYSTEM_ERROR", want: unifierr.ErrUnavailable},
+	}
+
+	for _, tt := range tests {
+		err := checkResult(tt.code, "message", "failed")
+		require.ErrorIs(t, err, tt.want, tt.code)
+	}
+
+	require.NoError(t, checkResult("SUCCESS", "success", "failed"))
+
+	err := checkResult("CODE_DEVICE_OFFLINE", "Device offline.", "failed")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, unifierr.ErrNotFound)
+	assert.Contains(t, err.Error(), "code=CODE_DEVICE_OFFLINE")
+}
+
+func TestDoorJSON(t *testing.T) {
+	t.Parallel()
+
+	var doors DoorsResponse
+	testdata.LoadFixtureJSON(t, "doors/list_success.json", &doors)
+
+	data, err := json.Marshal(doors.Data[1])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"door_position_status":null`, "doors without a sensor keep a null position")
+}
+
+func TestGetSwagger(t *testing.T) {
+	t.Parallel()
+
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	assert.Equal(t, "UniFi Access API", swagger.Info.Title)
+	assert.NotNil(t, swagger.Paths.Find("/doors/{id}/unlock"))
+}
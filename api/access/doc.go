@@ -0,0 +1,81 @@
+// Package access provides a Go client for the UniFi Access API.
+//
+// The UniFi Access API allows programmatic access to the Access application of UniFi
+// OS consoles. It provides endpoints for listing doors, unlocking them remotely and
+// setting temporary lock rules, listing Access devices and schedules, and reading
+// system logs such as door openings.
+//
+// The client is built on the same middleware stack as the Network and Protect API
+// clients, with the same logging, metrics, rate limiting and retry settings.
+//
+// # API Access
+//
+// This API is served by the Access application on its own port of the console:
+//
+//	https://<controller-ip>:12445/api/v1/developer/
+//
+// The client uses port 12445 unless the controller URL has a port.
+//
+// # Authentication
+//
+// All requests require an API token created in the Access application under
+// Settings > General > API Token, sent as a Bearer token. API keys of the UniFi OS
+// settings are not accepted. Tokens are granted scopes: calling an operation outside
+// them fails with an error matching unifierr.ErrUnauthorized.
+//
+// # Basic Usage
+//
+//	client, err := access.New("https://unifi.local", "your-api-token")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	doors, err := client.ListDoors(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	for _, door := range doors {
+//	    fmt.Printf("Door: %s (%s)\n", door.FullName, door.DoorLockRelayStatus)
+//	}
+//
+//	err = client.UnlockDoor(ctx, doors[0].Id, nil)
+//
+// # Lock Rules
+//
+// SetDoorLockRule keeps a door locked or unlocked, or unlocks it for a number of
+// minutes, until the rule is reset:
+//
+//	interval := 30
+//	err := client.SetDoorLockRule(ctx, doorID, access.DoorLockRuleInput{
+//	    Type:     access.LockRuleCustom,
+//	    Interval: &interval,
+//	})
+//
+// # System Logs
+//
+// ListSystemLogs returns a page of the logs of a topic:
+//
+//	since := time.Now().Add(-24 * time.Hour).Unix()
+//	page, err := client.ListSystemLogs(ctx, access.SystemLogsQuery{
+//	    Topic: access.LogTopicDoorOpenings,
+//	    Since: &since,
+//	}, nil)
+//
+// # Error Handling
+//
+// Failed API calls match the sentinel errors in github.com/lexfrei/go-unifi/unifierr
+// (ErrNotFound, ErrUnauthorized, ErrRateLimited, ErrUnavailable, ErrValidation). The
+// Access API reports some failures in the result code of a successful response; these
+// are returned as *ResultError, which matches the same sentinel errors:
+//
+//	if errors.Is(err, unifierr.ErrNotFound) {
+//	    // Door no longer exists
+//	}
+//
+// # Rate Limiting and Retries
+//
+// The client throttles requests locally to 1000 requests/minute by default and retries
+// network errors, 5xx server errors and 429 responses up to 3 times with exponential
+// backoff, as the Network API client does.
+package access
@@ -0,0 +1,63 @@
+// Code generated by enumgen from generated.go. DO NOT EDIT.
+
+package access
+
+// IsKnown reports whether e is one of the values of DoorLockRuleType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DoorLockRuleType) IsKnown() bool {
+	switch e {
+	case LockRuleCustom, LockRuleKeepLock, LockRuleKeepUnlock, LockRuleLockEarly, LockRuleReset, LockRuleSchedule:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DoorLockRuleType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DoorLockStatus defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DoorLockStatus) IsKnown() bool {
+	switch e {
+	case DoorLocked, DoorUnlocked:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DoorLockStatus) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DoorPositionStatus defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DoorPositionStatus) IsKnown() bool {
+	switch e {
+	case DoorClosed, DoorOpen:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DoorPositionStatus) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of SystemLogTopic defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e SystemLogTopic) IsKnown() bool {
+	switch e {
+	case LogTopicAdminActivity, LogTopicAll, LogTopicCritical, LogTopicDeviceEvents, LogTopicDoorOpenings, LogTopicUpdates, LogTopicVisitor:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e SystemLogTopic) Raw() string {
+	return string(e)
+}
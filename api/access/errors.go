@@ -0,0 +1,61 @@
+package access
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// resultSuccess is the result code of successful responses.
+const resultSuccess = "SUCCESS"
+
+// ResultError is returned when the Access API reports a failure in the code of a
+// response envelope, which it does with HTTP status 200 for some failures. It matches
+// the unifierr sentinel error for its code via errors.Is.
+type ResultError struct {
+	// Code is the result code, such as "CODE_PARAMS_INVALID".
+	Code string
+
+	// Message is the human-readable result message.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ResultError) Error() string {
+	return fmt.Sprintf("API error: code=%s msg=%s", e.Code, e.Message)
+}
+
+// Unwrap returns the sentinel error matching the result code, if any.
+func (e *ResultError) Unwrap() error {
+	switch e.Code {
+	case "CODE_PARAMS_INVALID":
+		return unifierr.ErrValidation
+	case "CODE_RESOURCE_NOT_FOUND", "CODE_NOT_EXISTS":
+		return unifierr.ErrNotFound
+	case "CODE_AUTH_FAILED", "CODE_ACCESS_TOKEN_INVALID", "CODE_UNAUTHORIZED", "CODE_OPERATION_FORBIDDEN":
+		return unifierr.ErrUnauthorized
+	case "CODE_SYSTEM_ERROR":
+		return unifierr.ErrUnavailable
+	default:
+		return nil
+	}
+}
+
+// checkResult returns a *ResultError wrapped with errorMsg unless code reports success.
+func checkResult(code, msg, errorMsg string) error {
+	if code == resultSuccess {
+		return nil
+	}
+	return errors.Wrap(&ResultError{Code: code, Message: msg}, errorMsg)
+}
+
+// validateID rejects empty IDs of kind, such as "door", with an error matching
+// unifierr.ErrValidation instead of sending a request to the collection path.
+func validateID(kind, id string) error {
+	if id == "" {
+		return errors.Wrapf(unifierr.ErrValidation, "%s ID is required", kind)
+	}
+	return nil
+}
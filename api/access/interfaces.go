@@ -0,0 +1,69 @@
+package access
+
+import "context"
+
+// AccessAPIClient defines the interface for UniFi Access API operations.
+// This interface enables consumers to create mock implementations for testing.
+//
+// The Access API provides local access to the Access application of a UniFi OS
+// console for managing:
+//   - Doors, remote unlocks and temporary lock rules
+//   - Access devices, such as hubs and readers
+//   - Access schedules
+//   - System logs, such as door openings
+//
+// All methods mirror the corresponding methods in APIClient to ensure
+// compatibility and ease of use.
+//
+// Example usage with mocking frameworks:
+//
+//	// Using gomock:
+//	//go:generate mockgen -destination=mocks/access_client.go -package=mocks github.com/lexfrei/go-unifi/api/access AccessAPIClient
+//
+//	// Using testify/mock:
+//	type MockClient struct {
+//	    mock.Mock
+//	}
+//
+//	func (m *MockClient) ListDoors(ctx context.Context) ([]Door, error) {
+//	    args := m.Called(ctx)
+//	    return args.Get(0).([]Door), args.Error(1)
+//	}
+//
+//nolint:revive // AccessAPIClient is intentionally explicit to avoid confusion with APIClient struct
+type AccessAPIClient interface {
+	// Doors operations
+
+	// ListDoors retrieves all doors managed by the Access application.
+	ListDoors(ctx context.Context) ([]Door, error)
+
+	// GetDoor retrieves a door by ID.
+	GetDoor(ctx context.Context, doorID string) (*Door, error)
+
+	// UnlockDoor unlocks a door remotely, recording the unlock under actor (optional).
+	UnlockDoor(ctx context.Context, doorID string, actor *UnlockDoorRequest) error
+
+	// GetDoorLockRule retrieves the temporary lock rule of a door.
+	GetDoorLockRule(ctx context.Context, doorID string) (*DoorLockRule, error)
+
+	// SetDoorLockRule sets a temporary lock rule on a door.
+	SetDoorLockRule(ctx context.Context, doorID string, rule DoorLockRuleInput) error
+
+	// Devices operations
+
+	// ListDevices retrieves all Access devices, such as hubs and readers.
+	ListDevices(ctx context.Context) ([]Device, error)
+
+	// Schedules operations
+
+	// ListSchedules retrieves all access schedules.
+	ListSchedules(ctx context.Context) ([]Schedule, error)
+
+	// GetSchedule retrieves an access schedule by ID.
+	GetSchedule(ctx context.Context, scheduleID string) (*Schedule, error)
+
+	// System logs operations
+
+	// ListSystemLogs retrieves a page of system logs matching query.
+	ListSystemLogs(ctx context.Context, query SystemLogsQuery, params *ListSystemLogsParams) (*SystemLogsResponse, error)
+}
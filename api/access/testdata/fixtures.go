@@ -0,0 +1,37 @@
+// Package testdata provides test fixtures for Access API tests.
+// All JSON files contain API responses shaped as returned by the Access application of UniFi OS consoles.
+package testdata
+
+import (
+	"embed"
+	"encoding/json"
+	"testing"
+)
+
+// FS embeds all JSON fixture files.
+//
+//go:embed **/*.json
+var FS embed.FS
+
+// LoadFixture reads and returns fixture content as string.
+// The path should be relative to testdata directory (e.g., "doors/list_success.json").
+func LoadFixture(tb testing.TB, path string) string {
+	tb.Helper()
+
+	data, err := FS.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("failed to load fixture %s: %v", path, err)
+	}
+
+	return string(data)
+}
+
+// LoadFixtureJSON reads fixture and unmarshals into provided value.
+func LoadFixtureJSON(tb testing.TB, path string, v any) {
+	tb.Helper()
+
+	data := LoadFixture(tb, path)
+	if err := json.Unmarshal([]byte(data), v); err != nil {
+		tb.Fatalf("failed to unmarshal fixture %s: %v", path, err)
+	}
+}
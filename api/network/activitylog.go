@@ -0,0 +1,51 @@
+package network
+
+import (
+	"context"
+	"time"
+
+	"github.com/lexfrei/go-unifi/seq"
+)
+
+// DefaultAdminActivityPageSize is the page size used by ListAllAdminActivityLog.
+const DefaultAdminActivityPageSize = 100
+
+// NewAdminActivityLogRequest builds a request for admin activity between since and until
+// (both inclusive), starting at the first page.
+//
+// Example:
+//
+//	page, err := client.ListAdminActivityLog(ctx, "default",
+//	    network.NewAdminActivityLogRequest(time.Now().Add(-24*time.Hour), time.Now()))
+func NewAdminActivityLogRequest(since, until time.Time) *AdminActivityLogRequest {
+	return &AdminActivityLogRequest{
+		TimestampFrom: since.UnixMilli(),
+		TimestampTo:   until.UnixMilli(),
+	}
+}
+
+// Time returns the time of the activity.
+func (e *AdminActivityEntry) Time() time.Time {
+	return time.UnixMilli(e.Timestamp)
+}
+
+// Admin returns the name of the admin who performed the activity,
+// or an empty string if the entry does not reference one.
+func (e *AdminActivityEntry) Admin() string {
+	if e.Parameters == nil {
+		return ""
+	}
+	admin, ok := (*e.Parameters)["ADMIN"]
+	if !ok || admin.Name == nil {
+		return ""
+	}
+	return *admin.Name
+}
+
+// ListAllAdminActivityLog retrieves all admin activity between since and until,
+// following pagination until the last page. Entries are returned newest first.
+// To process entries as pages arrive, use AllAdminActivity.
+func (c *APIClient) ListAllAdminActivityLog(ctx context.Context, site Site, since, until time.Time) ([]AdminActivityEntry, error) {
+	//nolint:wrapcheck // Errors come from ListAdminActivityLog and are already wrapped
+	return seq.Collect(c.AllAdminActivity(ctx, site, since, until))
+}
@@ -0,0 +1,114 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+const testAdminActivityPath = "/proxy/network/v2/api/site/" + testSiteInternal + "/system-log/admin-activity"
+
+func TestListAdminActivityLog(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, time.October, 16, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testAdminActivityPath, r.URL.Path)
+
+		var body AdminActivityLogRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, since.UnixMilli(), body.TimestampFrom)
+		assert.Equal(t, until.UnixMilli(), body.TimestampTo)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "systemlog/admin_activity.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	resp, err := client.ListAdminActivityLog(context.Background(), testSiteInternal, NewAdminActivityLogRequest(since, until))
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 2)
+
+	entry := resp.Data[0]
+	assert.Equal(t, AdminActionClientBlocked, entry.Key)
+	assert.Equal(t, "John Doe", entry.Admin())
+	assert.True(t, entry.Time().Equal(time.UnixMilli(1760620800000)))
+	assert.Equal(t, AdminActionLogin, resp.Data[1].Key)
+}
+
+func TestAdminActivityEntryUnknownAction(t *testing.T) {
+	t.Parallel()
+
+	var entry AdminActivityEntry
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"1","key":"ADMIN_SOMETHING_NEW","timestamp":0}`), &entry))
+	assert.Equal(t, AdminActivityAction("ADMIN_SOMETHING_NEW"), entry.Key)
+	assert.Empty(t, entry.Admin())
+}
+
+func TestListAllAdminActivityLog(t *testing.T) {
+	t.Parallel()
+
+	const totalEntries = 250
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		var body AdminActivityLogRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if !assert.NotNil(t, body.PageNumber) || !assert.NotNil(t, body.PageSize) {
+			return
+		}
+
+		start := *body.PageNumber * *body.PageSize
+		items := make([]string, 0, *body.PageSize)
+		for i := start; i < totalEntries && len(items) < *body.PageSize; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"%d","key":"ADMIN_LOGIN","timestamp":%d}`, i, 1760620000000-int64(i)))
+		}
+
+		pages := (totalEntries + *body.PageSize - 1) / *body.PageSize
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"page_number":%d,"total_element_count":%d,"total_page_count":%d}`,
+			strings.Join(items, ","), *body.PageNumber, totalEntries, pages)
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	entries, err := client.ListAllAdminActivityLog(context.Background(), testSiteInternal,
+		time.Now().Add(-time.Hour), time.Now())
+	require.NoError(t, err)
+	require.Len(t, entries, totalEntries)
+	assert.Equal(t, "249", entries[totalEntries-1].Id)
+}
+
+func TestListAdminActivityLogRawJSON(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testAdminActivityPath, testAPIKey,
+		testdata.LoadFixture(t, "systemlog/admin_activity.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, RetainRawJSON: true})
+	require.NoError(t, err)
+
+	resp, err := client.ListAdminActivityLog(context.Background(), testSiteInternal,
+		NewAdminActivityLogRequest(time.Now().Add(-time.Hour), time.Now()))
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 2)
+	assert.Contains(t, string(resp.Data[0].RawJSON), `"subcategory": "ADMIN_CLIENTS"`)
+}
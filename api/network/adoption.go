@@ -0,0 +1,358 @@
+package network
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/backoff"
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// LegacyDeviceState is the state of a device in its legacy record.
+type LegacyDeviceState int
+
+// Legacy device states.
+const (
+	LegacyDeviceDisconnected    LegacyDeviceState = 0
+	LegacyDeviceConnected       LegacyDeviceState = 1
+	LegacyDevicePendingAdoption LegacyDeviceState = 2
+	LegacyDeviceUpgrading       LegacyDeviceState = 4
+	LegacyDeviceProvisioning    LegacyDeviceState = 5
+	LegacyDeviceHeartbeatMissed LegacyDeviceState = 6
+	LegacyDeviceAdopting        LegacyDeviceState = 7
+	LegacyDeviceAdoptionError   LegacyDeviceState = 9
+	LegacyDeviceAdoptionFailed  LegacyDeviceState = 10
+	LegacyDeviceIsolated        LegacyDeviceState = 11
+)
+
+var legacyDeviceStateNames = map[LegacyDeviceState]string{
+	LegacyDeviceDisconnected:    "disconnected",
+	LegacyDeviceConnected:       "connected",
+	LegacyDevicePendingAdoption: "pending adoption",
+	LegacyDeviceUpgrading:       "upgrading",
+	LegacyDeviceProvisioning:    "provisioning",
+	LegacyDeviceHeartbeatMissed: "heartbeat missed",
+	LegacyDeviceAdopting:        "adopting",
+	LegacyDeviceAdoptionError:   "adoption error",
+	LegacyDeviceAdoptionFailed:  "adoption failed",
+	LegacyDeviceIsolated:        "isolated",
+}
+
+// String returns the name of the state, or its number if unknown.
+func (s LegacyDeviceState) String() string {
+	if name, ok := legacyDeviceStateNames[s]; ok {
+		return name
+	}
+	return "state " + strconv.Itoa(int(s))
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting numbers sent as strings.
+func (s *LegacyDeviceState) UnmarshalJSON(data []byte) error {
+	var value FlexibleInt
+	err := value.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+	*s = LegacyDeviceState(value)
+	return nil
+}
+
+// ErrAdoptionFailed is returned by AdoptWithSettings when the device reports a failed
+// adoption, or is still not adopted when AdoptionSettings.Timeout elapses.
+var ErrAdoptionFailed = errors.New("device adoption failed")
+
+// DefaultAdoptionTimeout is the default time AdoptWithSettings waits for a device to
+// finish adoption. Adoption includes a reboot and often a firmware upgrade.
+const DefaultAdoptionTimeout = 10 * time.Minute
+
+// adoptionPoll paces AdoptWithSettings while the device adopts.
+var adoptionPoll = backoff.Policy{Initial: 2 * time.Second, Max: 10 * time.Second, Multiplier: 1.5}
+
+// AdoptionSettings is the initial configuration AdoptWithSettings applies to a device.
+// Every field is optional; a zero value adopts the device with the site defaults.
+type AdoptionSettings struct {
+	// Name is the device name shown in the console
+	Name string
+
+	// Address sets a static management IP address with its prefix length, e.g.
+	// 192.168.1.20/24 (optional, the device keeps using DHCP if zero)
+	Address netip.Prefix
+
+	// Gateway is the default gateway of a static Address (required with Address)
+	Gateway netip.Addr
+
+	// DNS lists up to two DNS servers of a static Address (optional)
+	DNS []netip.Addr
+
+	// PortProfileID applies a port profile to every port of a switch except its uplink,
+	// which keeps the device reachable (optional)
+	PortProfileID string
+
+	// Timeout bounds the wait for the adoption to finish (defaults to DefaultAdoptionTimeout)
+	Timeout time.Duration
+}
+
+// Validate checks the settings, returning an error matching unifierr.ErrValidation
+// that describes the first problem.
+func (s *AdoptionSettings) Validate() error {
+	if s.PortProfileID != "" {
+		err := validateObjectID("port profile", s.PortProfileID)
+		if err != nil {
+			return err
+		}
+	}
+	if !s.Address.IsValid() {
+		if s.Gateway.IsValid() || len(s.DNS) > 0 {
+			return errors.Wrap(unifierr.ErrValidation, "gateway and DNS servers require a static address")
+		}
+		return nil
+	}
+
+	switch {
+	case !s.Address.Addr().Is4():
+		return errors.Wrapf(unifierr.ErrValidation, "device address %s must be IPv4", s.Address)
+	case s.Address.Bits() == 0 || s.Address.Bits() > 30:
+		return errors.Wrapf(unifierr.ErrValidation, "device address %s needs a prefix length between 1 and 30", s.Address)
+	case !s.Gateway.IsValid():
+		return errors.Wrapf(unifierr.ErrValidation, "device address %s needs a gateway", s.Address)
+	case !s.Address.Masked().Contains(s.Gateway) || s.Gateway == s.Address.Addr():
+		return errors.Wrapf(unifierr.ErrValidation, "gateway %s is not another address of %s", s.Gateway, s.Address.Masked())
+	case len(s.DNS) > 2:
+		return errors.Wrapf(unifierr.ErrValidation, "at most 2 DNS servers, got %d", len(s.DNS))
+	}
+	for _, dns := range s.DNS {
+		if !dns.Is4() {
+			return errors.Wrapf(unifierr.ErrValidation, "DNS server %s must be IPv4", dns)
+		}
+	}
+	return nil
+}
+
+// input returns the configuration to apply to the adopted device, or nil if the
+// settings leave it unchanged.
+func (s *AdoptionSettings) input(device *LegacyDevice) *LegacyDeviceInput {
+	var input LegacyDeviceInput
+	changed := false
+	if s.Name != "" {
+		input.Name = &s.Name
+		changed = true
+	}
+	if s.Address.IsValid() {
+		ip, gateway := s.Address.Addr().String(), s.Gateway.String()
+		netmask := net.IP(net.CIDRMask(s.Address.Bits(), 32)).String()
+		config := DeviceNetworkConfig{Type: DeviceIPStatic, IP: &ip, Netmask: &netmask, Gateway: &gateway}
+		dns := make([]string, len(s.DNS))
+		for i := range s.DNS {
+			dns[i] = s.DNS[i].String()
+		}
+		if len(dns) > 0 {
+			config.DNS1 = &dns[0]
+		}
+		if len(dns) > 1 {
+			config.DNS2 = &dns[1]
+		}
+		input.ConfigNetwork = &config
+		changed = true
+	}
+	if s.PortProfileID != "" && device.PortTable != nil {
+		overrides := make([]PortOverride, 0, len(*device.PortTable))
+		for _, port := range *device.PortTable {
+			if port.IsUplink != nil && bool(*port.IsUplink) {
+				continue
+			}
+			overrides = append(overrides, PortOverride{PortIdx: port.PortIdx, PortProfileID: &s.PortProfileID})
+		}
+		input.PortOverrides = &overrides
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return &input
+}
+
+// ListPendingAdoptionDevices lists the devices of a site that wait for adoption, such
+// as new hardware that was just connected to the network, so provisioning pipelines
+// can pick them up and adopt them with AdoptWithSettings.
+func (c *APIClient) ListPendingAdoptionDevices(ctx context.Context, site Site) ([]LegacyDevice, error) {
+	ctx = middleware.WithOperation(ctx, "ListPendingAdoptionDevices")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListLegacyDevicesWithResponse(ctx, site)
+	var data *LegacyDevicesResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	devices, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list devices pending adoption in site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+
+	pending := []LegacyDevice{}
+	for _, device := range devices.Data {
+		if isPendingAdoption(&device) {
+			pending = append(pending, device)
+		}
+	}
+	return pending, nil
+}
+
+func isPendingAdoption(device *LegacyDevice) bool {
+	return !valueOrZero(device.Adopted) && valueOrZero(device.State) == LegacyDevicePendingAdoption
+}
+
+// AdoptWithSettings adopts a device waiting for adoption, identified by its MAC
+// address, waits for the adoption to finish, then applies the initial settings in a
+// single update, which the device provisions like any configuration change. It
+// returns the device record after the update. A nil settings adopts the device with
+// the site defaults.
+//
+// A device that is not pending adoption is an error matching unifierr.ErrNotFound;
+// invalid settings are an error matching unifierr.ErrValidation, returned before the
+// device is adopted. A device that reports a failed adoption, or is still adopting
+// when the timeout elapses, is an error matching ErrAdoptionFailed; the adoption
+// itself is not undone.
+//
+// Example:
+//
+//	pending, err := client.ListPendingAdoptionDevices(ctx, "default")
+//	...
+//	device, err := client.AdoptWithSettings(ctx, "default", pending[0].Mac, &network.AdoptionSettings{
+//	    Name:          "Lobby Switch",
+//	    Address:       netip.MustParsePrefix("192.168.1.20/24"),
+//	    Gateway:       netip.MustParseAddr("192.168.1.1"),
+//	    PortProfileID: camerasProfileID,
+//	})
+func (c *APIClient) AdoptWithSettings(ctx context.Context, site Site, mac string, settings *AdoptionSettings) (*LegacyDevice, error) {
+	ctx = middleware.WithOperation(ctx, "AdoptWithSettings")
+	if settings == nil {
+		settings = &AdoptionSettings{}
+	}
+	err := settings.Validate()
+	if err != nil {
+		return nil, err
+	}
+	mac, err = NormalizeMAC(mac)
+	if err != nil {
+		return nil, err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := fmt.Sprintf("failed to adopt device %s in site %s", mac, site)
+	device, err := c.legacyDeviceByMAC(ctx, site, mac, errorMsg)
+	if err != nil {
+		return nil, err
+	}
+	if !isPendingAdoption(device) {
+		return nil, errors.Wrapf(unifierr.ErrNotFound, "%s: device is not pending adoption (%s)", errorMsg, valueOrZero(device.State))
+	}
+
+	resp, err := c.client.ExecuteDeviceCommandWithResponse(ctx, site, DeviceCommandRequest{Cmd: DeviceCommandAdopt, Mac: mac})
+	err = response.HandleNoContent(resp, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // response.HandleNoContent wraps errors internally
+		return nil, err
+	}
+
+	device, err = c.waitForAdoption(ctx, site, mac, cmp.Or(settings.Timeout, DefaultAdoptionTimeout), errorMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	input := settings.input(device)
+	if input == nil {
+		return device, nil
+	}
+	updateResp, err := c.client.UpdateLegacyDeviceWithResponse(ctx, site, valueOrZero(device.Id), *input)
+	var data *LegacyDevicesResponse
+	var body []byte
+	if updateResp != nil {
+		data = updateResp.JSON200
+		body = updateResp.Body
+	}
+	updated, err := response.HandleDecoded(c.decoder, updateResp, body, data, err,
+		fmt.Sprintf("device %s adopted, but failed to apply its settings", mac))
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	if len(updated.Data) == 0 {
+		return device, nil
+	}
+	return &updated.Data[0], nil
+}
+
+// waitForAdoption polls a device in a resolved site until it is adopted and connected.
+func (c *APIClient) waitForAdoption(ctx context.Context, site Site, mac string, timeout time.Duration, errorMsg string) (*LegacyDevice, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	state := LegacyDevicePendingAdoption
+	for attempt := 0; ; attempt++ {
+		if backoff.Sleep(waitCtx, adoptionPoll.Delay(attempt)) != nil {
+			if ctx.Err() != nil {
+				return nil, errors.Wrap(ctx.Err(), errorMsg)
+			}
+			return nil, errors.Wrapf(ErrAdoptionFailed, "%s: still %s after %s", errorMsg, state, timeout)
+		}
+
+		device, err := c.legacyDeviceByMAC(waitCtx, site, mac, errorMsg)
+		switch {
+		case err == nil:
+		case ctx.Err() == nil && waitCtx.Err() != nil:
+			return nil, errors.Wrapf(ErrAdoptionFailed, "%s: still %s after %s", errorMsg, state, timeout)
+		case errors.Is(err, unifierr.ErrNotFound):
+			// The device briefly disappears while it reboots into the site
+			continue
+		default:
+			return nil, err
+		}
+
+		state = valueOrZero(device.State)
+		switch {
+		case valueOrZero(device.Adopted) && state == LegacyDeviceConnected:
+			return device, nil
+		case state == LegacyDeviceAdoptionFailed || state == LegacyDeviceAdoptionError:
+			return nil, errors.Wrapf(ErrAdoptionFailed, "%s: %s", errorMsg, state)
+		}
+	}
+}
+
+// legacyDeviceByMAC retrieves the legacy record of a device by its normalized MAC
+// address in a resolved site.
+func (c *APIClient) legacyDeviceByMAC(ctx context.Context, site Site, mac, errorMsg string) (*LegacyDevice, error) {
+	resp, err := c.client.GetLegacyDeviceWithResponse(ctx, site, mac)
+	var data *LegacyDevicesResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	devices, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	if len(devices.Data) == 0 {
+		return nil, errors.Wrapf(unifierr.ErrNotFound, "%s: no device with MAC address %s", errorMsg, mac)
+	}
+	return &devices.Data[0], nil
+}
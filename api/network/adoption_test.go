@@ -0,0 +1,278 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/backoff"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const testPendingMAC = "f4:e2:c6:0a:1b:2c"
+
+// adoptionController is a stateful mock of the legacy device endpoints. Adopting the
+// pending device moves it through adoptingPolls polls in the adopting state, then
+// makes it connected, or leaves it in finalState if set.
+type adoptionController struct {
+	t *testing.T
+
+	mu            sync.Mutex
+	devices       []map[string]any
+	adoptingPolls int
+	finalState    int
+	adopted       bool
+	updates       []LegacyDeviceInput
+}
+
+func newAdoptionController(t *testing.T, adoptingPolls int) *adoptionController {
+	t.Helper()
+
+	var fixture struct {
+		Data []map[string]any `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(testdata.LoadFixture(t, "devices/pending_adoption.json")), &fixture))
+	return &adoptionController{t: t, devices: fixture.Data, adoptingPolls: adoptingPolls}
+}
+
+func (c *adoptionController) device(mac string) map[string]any {
+	for _, device := range c.devices {
+		if device["mac"] == mac {
+			return device
+		}
+	}
+	return nil
+}
+
+func (c *adoptionController) respond(w http.ResponseWriter, devices ...map[string]any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"meta": map[string]string{"rc": "ok"}, "data": devices})
+}
+
+func (c *adoptionController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	legacy := "/proxy/network/api/s/" + testSiteInternal
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == legacy+"/stat/device":
+		c.respond(w, c.devices...)
+	case r.Method == http.MethodPost && r.URL.Path == legacy+"/cmd/devmgr":
+		var cmd DeviceCommandRequest
+		assert.NoError(c.t, json.NewDecoder(r.Body).Decode(&cmd))
+		assert.Equal(c.t, DeviceCommandAdopt, cmd.Cmd)
+		c.device(cmd.Mac)["state"] = int(LegacyDeviceAdopting)
+		c.adopted = true
+		c.respond(w)
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, legacy+"/stat/device/"):
+		device := c.device(strings.TrimPrefix(r.URL.Path, legacy+"/stat/device/"))
+		if device == nil {
+			c.respond(w)
+			return
+		}
+		if c.adopted && device["state"] == int(LegacyDeviceAdopting) {
+			if c.adoptingPolls > 0 {
+				c.adoptingPolls--
+			} else if c.finalState != 0 {
+				device["state"] = c.finalState
+			} else {
+				device["state"], device["adopted"] = int(LegacyDeviceConnected), true
+			}
+		}
+		c.respond(w, device)
+	case r.Method == http.MethodPut && r.URL.Path == legacy+"/rest/device/60a1b2c3d4e5f6a7b8c9d0f1":
+		var input LegacyDeviceInput
+		assert.NoError(c.t, json.NewDecoder(r.Body).Decode(&input))
+		c.updates = append(c.updates, input)
+		device := c.device(testPendingMAC)
+		if input.Name != nil {
+			device["name"] = *input.Name
+		}
+		c.respond(w, device)
+	default:
+		c.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestListPendingAdoptionDevices(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/proxy/network/api/s/"+testSiteInternal+"/stat/device", testAPIKey,
+		testdata.LoadFixture(t, "devices/pending_adoption.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	pending, err := client.ListPendingAdoptionDevices(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.Len(t, pending, 1, "adopted and isolated devices are not pending")
+	assert.Equal(t, testPendingMAC, pending[0].Mac)
+	assert.Equal(t, "US8P60", *pending[0].Model)
+	assert.Equal(t, LegacyDevicePendingAdoption, *pending[0].State)
+}
+
+// Not parallel: it shortens the shared polling policy.
+func TestAdoptWithSettings(t *testing.T) {
+	saved := adoptionPoll
+	adoptionPoll = backoff.Policy{Initial: time.Millisecond}
+	t.Cleanup(func() { adoptionPoll = saved })
+
+	controller := newAdoptionController(t, 2)
+	server := httptest.NewServer(controller)
+	defer server.Close()
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	device, err := client.AdoptWithSettings(context.Background(), testSiteInternal, "F4-E2-C6-0A-1B-2C", &AdoptionSettings{
+		Name:          "Lobby Switch",
+		Address:       netip.MustParsePrefix("192.168.1.20/24"),
+		Gateway:       netip.MustParseAddr("192.168.1.1"),
+		DNS:           []netip.Addr{netip.MustParseAddr("192.168.1.1"), netip.MustParseAddr("1.1.1.1")},
+		PortProfileID: "5f8a1b2c3d4e5f6a7b8c9d31",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Lobby Switch", *device.Name)
+	assert.True(t, *device.Adopted)
+
+	require.Len(t, controller.updates, 1, "settings are applied in one update")
+	input := controller.updates[0]
+	require.NotNil(t, input.ConfigNetwork)
+	config := input.ConfigNetwork
+	assert.Equal(t, DeviceIPStatic, config.Type)
+	assert.Equal(t, "192.168.1.20", *config.IP)
+	assert.Equal(t, "255.255.255.0", *config.Netmask)
+	assert.Equal(t, "192.168.1.1", *config.Gateway)
+	assert.Equal(t, "192.168.1.1", *config.DNS1)
+	assert.Equal(t, "1.1.1.1", *config.DNS2)
+	require.NotNil(t, input.PortOverrides)
+	profile := "5f8a1b2c3d4e5f6a7b8c9d31"
+	assert.Equal(t, []PortOverride{
+		{PortIdx: 2, PortProfileID: &profile},
+		{PortIdx: 3, PortProfileID: &profile},
+	}, *input.PortOverrides, "the uplink port keeps its profile")
+
+	// Without settings, the device is only adopted
+	controller = newAdoptionController(t, 0)
+	server2 := httptest.NewServer(controller)
+	defer server2.Close()
+	client, err = New(server2.URL, testAPIKey)
+	require.NoError(t, err)
+
+	device, err = client.AdoptWithSettings(context.Background(), testSiteInternal, testPendingMAC, nil)
+	require.NoError(t, err)
+	assert.Equal(t, LegacyDeviceConnected, *device.State)
+	assert.Empty(t, controller.updates)
+
+	// Failed adoption
+	controller = newAdoptionController(t, 1)
+	controller.finalState = int(LegacyDeviceAdoptionFailed)
+	server3 := httptest.NewServer(controller)
+	defer server3.Close()
+	client, err = New(server3.URL, testAPIKey)
+	require.NoError(t, err)
+
+	_, err = client.AdoptWithSettings(context.Background(), testSiteInternal, testPendingMAC, &AdoptionSettings{Name: "Lobby Switch"})
+	require.ErrorIs(t, err, ErrAdoptionFailed)
+	assert.Contains(t, err.Error(), "adoption failed")
+	assert.Empty(t, controller.updates)
+
+	// Timeout
+	controller = newAdoptionController(t, 1_000_000)
+	server4 := httptest.NewServer(controller)
+	defer server4.Close()
+	client, err = New(server4.URL, testAPIKey)
+	require.NoError(t, err)
+
+	_, err = client.AdoptWithSettings(context.Background(), testSiteInternal, testPendingMAC, &AdoptionSettings{Timeout: 50 * time.Millisecond})
+	require.ErrorIs(t, err, ErrAdoptionFailed)
+	assert.Contains(t, err.Error(), "still adopting")
+}
+
+func TestAdoptWithSettingsErrors(t *testing.T) {
+	t.Parallel()
+
+	controller := newAdoptionController(t, 0)
+	server := httptest.NewServer(controller)
+	defer server.Close()
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.AdoptWithSettings(ctx, testSiteInternal, "aa:bb:cc:99:ea:6b", nil)
+	require.ErrorIs(t, err, unifierr.ErrNotFound, "adopted devices are not pending")
+	assert.Contains(t, err.Error(), "connected")
+
+	_, err = client.AdoptWithSettings(ctx, testSiteInternal, "aa:bb:cc:00:00:99", nil)
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+	_, err = client.AdoptWithSettings(ctx, testSiteInternal, "bogus", nil)
+	require.ErrorIs(t, err, ErrInvalidMAC)
+
+	_, err = client.AdoptWithSettings(ctx, testSiteInternal, testPendingMAC,
+		&AdoptionSettings{Address: netip.MustParsePrefix("192.168.1.20/24")})
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+	assert.False(t, controller.adopted, "invalid settings are rejected before adopting")
+}
+
+func TestAdoptionSettingsValidate(t *testing.T) {
+	t.Parallel()
+
+	address := netip.MustParsePrefix("192.168.1.20/24")
+	gateway := netip.MustParseAddr("192.168.1.1")
+	tests := []struct {
+		name     string
+		settings AdoptionSettings
+		wantMsg  string
+	}{
+		{name: "gateway without address", settings: AdoptionSettings{Gateway: gateway}, wantMsg: "require a static address"},
+		{name: "IPv6 address", settings: AdoptionSettings{Address: netip.MustParsePrefix("2001:db8::2/64")}, wantMsg: "must be IPv4"},
+		{name: "host prefix", settings: AdoptionSettings{Address: netip.MustParsePrefix("192.168.1.20/32"), Gateway: gateway}, wantMsg: "prefix length"},
+		{name: "no gateway", settings: AdoptionSettings{Address: address}, wantMsg: "needs a gateway"},
+		{
+			name:     "gateway outside subnet",
+			settings: AdoptionSettings{Address: address, Gateway: netip.MustParseAddr("10.0.0.1")},
+			wantMsg:  "is not another address of 192.168.1.0/24",
+		},
+		{name: "gateway is the device", settings: AdoptionSettings{Address: address, Gateway: address.Addr()}, wantMsg: "is not another address"},
+		{
+			name: "too many DNS servers",
+			settings: AdoptionSettings{Address: address, Gateway: gateway, DNS: []netip.Addr{
+				gateway, netip.MustParseAddr("1.1.1.1"), netip.MustParseAddr("8.8.8.8"),
+			}},
+			wantMsg: "at most 2 DNS servers",
+		},
+		{name: "bad port profile", settings: AdoptionSettings{PortProfileID: testPendingMAC}, wantMsg: "port profile ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.settings.Validate()
+			require.ErrorIs(t, err, unifierr.ErrValidation)
+			assert.Contains(t, err.Error(), tt.wantMsg)
+		})
+	}
+
+	assert.NoError(t, (&AdoptionSettings{Name: "Lobby Switch"}).Validate())
+	assert.NoError(t, (&AdoptionSettings{Address: address, Gateway: gateway}).Validate())
+}
+
+func TestLegacyDeviceStateString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "pending adoption", LegacyDevicePendingAdoption.String())
+	assert.Equal(t, "state 42", LegacyDeviceState(42).String())
+}
@@ -0,0 +1,211 @@
+package network
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// APGroupAction is the kind of change in an AP group reconciliation plan.
+type APGroupAction string
+
+// AP group reconciliation actions.
+const (
+	// APGroupCreate creates a missing group with the desired members.
+	APGroupCreate APGroupAction = "create"
+
+	// APGroupUpdate replaces the members of an existing group.
+	APGroupUpdate APGroupAction = "update"
+)
+
+// APGroupChange is one change needed to make the AP groups of a site match a desired membership.
+type APGroupChange struct {
+	// Action is APGroupCreate or APGroupUpdate.
+	Action APGroupAction
+
+	// GroupID identifies the group to update; empty for APGroupCreate.
+	GroupID APGroupId
+
+	// Name is the name of the group.
+	Name string
+
+	// DeviceMACs is the desired membership, normalized and sorted.
+	DeviceMACs []string
+
+	// Added and Removed list the normalized MAC addresses joining and leaving the group, sorted.
+	Added   []string
+	Removed []string
+}
+
+// HasDevice reports whether the access point with the given MAC address is a member of the group.
+// MAC addresses are compared in normalized form.
+func (g *APGroup) HasDevice(mac string) bool {
+	mac, err := NormalizeMAC(mac)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(normalizedMACs(g.DeviceMACs), mac)
+}
+
+// AssignDeviceToGroup adds the access point with the given MAC address to an AP group,
+// keeping the existing members. Assigning a device that is already a member is a no-op.
+// An access point may belong to several groups.
+func (c *APIClient) AssignDeviceToGroup(ctx context.Context, site Site, groupID APGroupId, mac string) error {
+	ctx = middleware.WithOperation(ctx, "AssignDeviceToGroup")
+	err := validateObjectID("AP group", groupID)
+	if err != nil {
+		return err
+	}
+	mac, err = NormalizeMAC(mac)
+	if err != nil {
+		return err
+	}
+
+	errorMsg := fmt.Sprintf("failed to assign device %s to AP group %s", mac, groupID)
+	groups, err := c.ListAPGroups(ctx, site)
+	if err != nil {
+		return errors.Wrap(err, errorMsg)
+	}
+	index := slices.IndexFunc(groups, func(g APGroup) bool { return g.Id == groupID })
+	if index < 0 {
+		return errors.Wrap(unifierr.ErrNotFound, errorMsg)
+	}
+
+	group := &groups[index]
+	if group.HasDevice(mac) {
+		return nil
+	}
+	input := &APGroupInput{Name: group.Name, DeviceMACs: append(slices.Clone(group.DeviceMACs), mac)}
+	_, err = c.UpdateAPGroup(ctx, site, groupID, input)
+	if err != nil {
+		return errors.Wrap(err, errorMsg)
+	}
+	return nil
+}
+
+// PlanAPGroups computes the changes that make current match desired, a mapping of
+// group names to the MAC addresses of their access points. Groups are matched by
+// name; if several groups share a name, the first one is used. Groups missing from
+// desired are left untouched, so one plan can manage a subset of the groups of a site.
+//
+// Changes are sorted by group name. An invalid MAC address in desired is an error
+// matching ErrInvalidMAC.
+func PlanAPGroups(current []APGroup, desired map[string][]string) ([]APGroupChange, error) {
+	names := make([]string, 0, len(desired))
+	for name := range desired {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var changes []APGroupChange
+	for _, name := range names {
+		want, err := normalizeDesiredMACs(desired[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "AP group %s", name)
+		}
+
+		index := slices.IndexFunc(current, func(g APGroup) bool { return g.Name == name })
+		if index < 0 {
+			changes = append(changes, APGroupChange{Action: APGroupCreate, Name: name, DeviceMACs: want, Added: want})
+			continue
+		}
+
+		have := normalizedMACs(current[index].DeviceMACs)
+		added := missingFrom(want, have)
+		removed := missingFrom(have, want)
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+		changes = append(changes, APGroupChange{
+			Action:     APGroupUpdate,
+			GroupID:    current[index].Id,
+			Name:       name,
+			DeviceMACs: want,
+			Added:      added,
+			Removed:    removed,
+		})
+	}
+	return changes, nil
+}
+
+// ReconcileAPGroups makes the AP groups of a site match desired, a mapping of group
+// names to the MAC addresses of their access points, creating missing groups and
+// replacing the members of differing ones (see PlanAPGroups). It returns the changes
+// applied; on error, the changes applied before the failure.
+//
+// Example:
+//
+//	changes, err := client.ReconcileAPGroups(ctx, "default", map[string][]string{
+//	    "Ground floor": {"f4:e2:c6:00:00:01", "f4:e2:c6:00:00:02"},
+//	    "Warehouse":    {"f4:e2:c6:00:00:03"},
+//	})
+func (c *APIClient) ReconcileAPGroups(ctx context.Context, site Site, desired map[string][]string) ([]APGroupChange, error) {
+	current, err := c.ListAPGroups(ctx, site)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reconcile AP groups")
+	}
+	changes, err := PlanAPGroups(current, desired)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reconcile AP groups")
+	}
+
+	for i, change := range changes {
+		input := &APGroupInput{Name: change.Name, DeviceMACs: change.DeviceMACs}
+		if change.Action == APGroupCreate {
+			group, err := c.CreateAPGroup(ctx, site, input)
+			if err != nil {
+				return changes[:i], errors.Wrap(err, "failed to reconcile AP groups")
+			}
+			changes[i].GroupID = group.Id
+			continue
+		}
+		_, err := c.UpdateAPGroup(ctx, site, change.GroupID, input)
+		if err != nil {
+			return changes[:i], errors.Wrap(err, "failed to reconcile AP groups")
+		}
+	}
+	return changes, nil
+}
+
+// normalizeDesiredMACs normalizes, sorts and deduplicates MAC addresses, rejecting invalid ones.
+func normalizeDesiredMACs(macs []string) ([]string, error) {
+	normalized := make([]string, 0, len(macs))
+	for _, mac := range macs {
+		n, err := NormalizeMAC(mac)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, n)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// normalizedMACs normalizes, sorts and deduplicates MAC addresses reported by the
+// controller, keeping values that are not valid MAC addresses as they are.
+func normalizedMACs(macs []string) []string {
+	normalized := make([]string, 0, len(macs))
+	for _, mac := range macs {
+		n, _ := NormalizeMAC(mac) //nolint:errcheck // Invalid values are compared verbatim
+		normalized = append(normalized, cmp.Or(n, mac))
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
+// missingFrom returns the elements of a not present in b; both must be sorted.
+func missingFrom(a, b []string) []string {
+	var missing []string
+	for _, s := range a {
+		if _, found := slices.BinarySearch(b, s); !found {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
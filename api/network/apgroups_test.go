@@ -0,0 +1,175 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const testAPGroupsPath = "/proxy/network/v2/api/site/" + testSiteInternal + "/apgroups"
+
+type apGroupWrite struct {
+	method string
+	path   string
+	input  APGroupInput
+}
+
+// apGroupsServer serves the AP group fixture and records group writes.
+func apGroupsServer(t *testing.T) (*APIClient, func() []apGroupWrite) {
+	t.Helper()
+
+	var (
+		mu     sync.Mutex
+		writes []apGroupWrite
+	)
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			assert.Equal(t, testAPGroupsPath, r.URL.Path)
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "apgroups/list_success.json")))
+			return
+		}
+
+		var input APGroupInput
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+		mu.Lock()
+		writes = append(writes, apGroupWrite{method: r.Method, path: r.URL.Path, input: input})
+		mu.Unlock()
+		_ = json.NewEncoder(w).Encode(APGroup{Id: "5f8a1b2c3d4e5f6a7b8c9d21", Name: input.Name, DeviceMACs: input.DeviceMACs})
+	})
+	t.Cleanup(server.Close)
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+	return client, func() []apGroupWrite {
+		mu.Lock()
+		defer mu.Unlock()
+		return writes
+	}
+}
+
+func TestListAPGroups(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testAPGroupsPath, testAPIKey,
+		testdata.LoadFixture(t, "apgroups/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	groups, err := client.ListAPGroups(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "All APs", groups[0].Name)
+	assert.True(t, *groups[0].AttrNoDelete)
+	assert.True(t, groups[1].HasDevice("f4-e2-c6-00-00-01"), "membership should ignore MAC formatting")
+	assert.False(t, groups[1].HasDevice("f4:e2:c6:00:00:02"))
+	assert.False(t, groups[1].HasDevice("not-a-mac"))
+}
+
+func TestAssignDeviceToGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adds a new member", func(t *testing.T) {
+		t.Parallel()
+
+		client, writes := apGroupsServer(t)
+		err := client.AssignDeviceToGroup(context.Background(), testSiteInternal, "5f8a1b2c3d4e5f6a7b8c9d20", "F4:E2:C6:00:00:02")
+		require.NoError(t, err)
+
+		require.Len(t, writes(), 1)
+		write := writes()[0]
+		assert.Equal(t, http.MethodPut, write.method)
+		assert.Equal(t, testAPGroupsPath+"/5f8a1b2c3d4e5f6a7b8c9d20", write.path)
+		assert.Equal(t, "Ground floor", write.input.Name)
+		assert.Equal(t, []string{"F4:E2:C6:00:00:01", "f4:e2:c6:00:00:02"}, write.input.DeviceMACs)
+	})
+
+	t.Run("existing member is a no-op", func(t *testing.T) {
+		t.Parallel()
+
+		client, writes := apGroupsServer(t)
+		err := client.AssignDeviceToGroup(context.Background(), testSiteInternal, "5f8a1b2c3d4e5f6a7b8c9d20", "f4:e2:c6:00:00:01")
+		require.NoError(t, err)
+		assert.Empty(t, writes())
+	})
+
+	t.Run("unknown group", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := apGroupsServer(t)
+		err := client.AssignDeviceToGroup(context.Background(), testSiteInternal, "missing", "f4:e2:c6:00:00:01")
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+	})
+
+	t.Run("invalid MAC", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := apGroupsServer(t)
+		err := client.AssignDeviceToGroup(context.Background(), testSiteInternal, "5f8a1b2c3d4e5f6a7b8c9d20", "nope")
+		require.ErrorIs(t, err, ErrInvalidMAC)
+	})
+}
+
+func TestPlanAPGroups(t *testing.T) {
+	t.Parallel()
+
+	var current []APGroup
+	testdata.LoadFixtureJSON(t, "apgroups/list_success.json", &current)
+
+	changes, err := PlanAPGroups(current, map[string][]string{
+		"All APs":      {"f4:e2:c6:00:00:03", "F4-E2-C6-00-00-01", "f4:e2:c6:00:00:02"},
+		"Ground floor": {"f4:e2:c6:00:00:02", "f4:e2:c6:00:00:02"},
+		"Warehouse":    {"f4:e2:c6:00:00:03"},
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 2, "groups already matching should not change")
+
+	assert.Equal(t, APGroupChange{
+		Action:     APGroupUpdate,
+		GroupID:    "5f8a1b2c3d4e5f6a7b8c9d20",
+		Name:       "Ground floor",
+		DeviceMACs: []string{"f4:e2:c6:00:00:02"},
+		Added:      []string{"f4:e2:c6:00:00:02"},
+		Removed:    []string{"f4:e2:c6:00:00:01"},
+	}, changes[0])
+	assert.Equal(t, APGroupChange{
+		Action:     APGroupCreate,
+		Name:       "Warehouse",
+		DeviceMACs: []string{"f4:e2:c6:00:00:03"},
+		Added:      []string{"f4:e2:c6:00:00:03"},
+	}, changes[1])
+
+	_, err = PlanAPGroups(current, map[string][]string{"Broken": {"nope"}})
+	require.ErrorIs(t, err, ErrInvalidMAC)
+}
+
+func TestReconcileAPGroups(t *testing.T) {
+	t.Parallel()
+
+	client, writes := apGroupsServer(t)
+	changes, err := client.ReconcileAPGroups(context.Background(), testSiteInternal, map[string][]string{
+		"Ground floor": {"f4:e2:c6:00:00:01", "f4:e2:c6:00:00:02"},
+		"Warehouse":    {"f4:e2:c6:00:00:03"},
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, APGroupId("5f8a1b2c3d4e5f6a7b8c9d21"), changes[1].GroupID, "created groups should carry their new ID")
+
+	require.Len(t, writes(), 2)
+	assert.Equal(t, http.MethodPut, writes()[0].method)
+	assert.Equal(t, []string{"f4:e2:c6:00:00:01", "f4:e2:c6:00:00:02"}, writes()[0].input.DeviceMACs)
+	assert.Equal(t, http.MethodPost, writes()[1].method)
+	assert.Equal(t, testAPGroupsPath, writes()[1].path)
+	assert.Equal(t, "Warehouse", writes()[1].input.Name)
+}
@@ -0,0 +1,274 @@
+package network
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/seq"
+)
+
+// AuditFindingKind classifies an addressing problem reported by AuditAddresses.
+type AuditFindingKind string
+
+// Kinds of addressing problems, in the order they are reported.
+const (
+	// AuditDuplicateIP means several connected clients use the same IP address.
+	AuditDuplicateIP AuditFindingKind = "duplicate_ip"
+
+	// AuditDuplicateReservation means a fixed IP is reserved for several clients.
+	AuditDuplicateReservation AuditFindingKind = "duplicate_reservation"
+
+	// AuditReservationConflict means a connected client uses an IP reserved for another client.
+	AuditReservationConflict AuditFindingKind = "reservation_conflict"
+
+	// AuditDuplicateMAC means a MAC address appears in several client records.
+	AuditDuplicateMAC AuditFindingKind = "duplicate_mac"
+
+	// AuditOutOfSubnet means a fixed IP is invalid or outside the subnet of its network.
+	AuditOutOfSubnet AuditFindingKind = "out_of_subnet"
+)
+
+var auditKindOrder = []AuditFindingKind{
+	AuditDuplicateIP,
+	AuditDuplicateReservation,
+	AuditReservationConflict,
+	AuditDuplicateMAC,
+	AuditOutOfSubnet,
+}
+
+// AuditFinding is one addressing problem of a site.
+type AuditFinding struct {
+	// Kind classifies the problem.
+	Kind AuditFindingKind
+
+	// IP is the affected address; empty for AuditDuplicateMAC.
+	IP string
+
+	// MACs lists the normalized MAC addresses of the clients involved, sorted.
+	MACs []string
+
+	// NetworkID is the network of the reservation for AuditOutOfSubnet.
+	NetworkID string
+
+	// Detail describes the problem for humans.
+	Detail string
+}
+
+// AuditReport is the result of an addressing audit.
+type AuditReport struct {
+	// Findings lists all problems, grouped by kind and sorted by IP and MAC within a kind.
+	Findings []AuditFinding
+
+	// ClientsScanned is the number of connected clients checked.
+	ClientsScanned int
+
+	// ReservationsScanned is the number of fixed IP reservations checked.
+	ReservationsScanned int
+}
+
+// HasFindings reports whether the audit found any problem.
+func (r *AuditReport) HasFindings() bool {
+	return len(r.Findings) > 0
+}
+
+// Of returns the findings of the given kind.
+func (r *AuditReport) Of(kind AuditFindingKind) []AuditFinding {
+	var findings []AuditFinding
+	for _, finding := range r.Findings {
+		if finding.Kind == kind {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// AuditAddressing audits the IP addressing of a site: it loads the connected clients,
+// the known client records and the networks of the site and passes them to AuditAddresses.
+// site may be a site UUID or internal reference.
+//
+// Example:
+//
+//	report, err := client.AuditAddressing(ctx, "default")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, finding := range report.Findings {
+//	    log.Printf("%s: %s", finding.Kind, finding.Detail)
+//	}
+func (c *APIClient) AuditAddressing(ctx context.Context, site Site) (*AuditReport, error) {
+	resolved, err := c.sites.Resolve(ctx, site)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve site %s", site)
+	}
+
+	clients, err := seq.Collect(c.AllSiteClients(ctx, resolved.Id))
+	if err != nil {
+		return nil, err
+	}
+	known, err := c.ListKnownClients(ctx, resolved.InternalReference)
+	if err != nil {
+		return nil, err
+	}
+	networks, err := c.ListNetworks(ctx, resolved.InternalReference)
+	if err != nil {
+		return nil, err
+	}
+	return AuditAddresses(clients, known, networks), nil
+}
+
+// AuditAddresses checks connected clients and fixed IP reservations for duplicate IPs,
+// duplicate MAC entries and reservations outside the subnet of their network.
+// It makes no requests; see AuditAddressing to audit a site directly.
+//
+// Reservations are the known clients with use_fixedip set. A reservation without a
+// network is checked against all networks with a subnet. Networks without a parsable
+// subnet are ignored, and so are connected clients without an IP address.
+func AuditAddresses(clients []ClientListItem, known []KnownClient, networks []NetworkConf) *AuditReport {
+	report := &AuditReport{ClientsScanned: len(clients)}
+
+	connected := make(map[string][]string)
+	connectedMACs := make(map[string]int)
+	for i := range clients {
+		mac := normalizeAuditMAC(clients[i].MacAddress)
+		connectedMACs[mac]++
+		if ip := clients[i].IpAddress; ip != "" {
+			connected[ip] = append(connected[ip], mac)
+		}
+	}
+
+	reserved := make(map[string][]string)
+	knownMACs := make(map[string]int)
+	var reservations []*KnownClient
+	for i := range known {
+		mac := normalizeAuditMAC(known[i].Mac)
+		knownMACs[mac]++
+		if known[i].UseFixedIP != nil && *known[i].UseFixedIP && valueOrZero(known[i].FixedIP) != "" {
+			reservations = append(reservations, &known[i])
+			reserved[*known[i].FixedIP] = append(reserved[*known[i].FixedIP], mac)
+		}
+	}
+	report.ReservationsScanned = len(reservations)
+
+	for ip, macs := range connected {
+		if len(macs) > 1 {
+			report.add(AuditFinding{Kind: AuditDuplicateIP, IP: ip, MACs: macs,
+				Detail: fmt.Sprintf("%s is used by %d connected clients", ip, len(macs))})
+		}
+	}
+	for ip, macs := range reserved {
+		if len(macs) > 1 {
+			report.add(AuditFinding{Kind: AuditDuplicateReservation, IP: ip, MACs: macs,
+				Detail: fmt.Sprintf("%s is reserved for %d clients", ip, len(macs))})
+		}
+	}
+	for ip, owners := range reserved {
+		for _, mac := range connected[ip] {
+			if !slices.Contains(owners, mac) {
+				report.add(AuditFinding{Kind: AuditReservationConflict, IP: ip, MACs: append([]string{mac}, owners...),
+					Detail: fmt.Sprintf("%s is reserved for %s but used by %s", ip, strings.Join(owners, ", "), mac)})
+			}
+		}
+	}
+	auditDuplicateMACs(report, connectedMACs, "connected clients")
+	auditDuplicateMACs(report, knownMACs, "known client records")
+	auditSubnets(report, reservations, networks)
+
+	slices.SortStableFunc(report.Findings, func(a, b AuditFinding) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(auditKindOrder, a.Kind), slices.Index(auditKindOrder, b.Kind)),
+			compareIPs(a.IP, b.IP),
+			slices.Compare(a.MACs, b.MACs),
+		)
+	})
+	return report
+}
+
+func auditDuplicateMACs(report *AuditReport, counts map[string]int, source string) {
+	for mac, count := range counts {
+		if count > 1 {
+			report.add(AuditFinding{Kind: AuditDuplicateMAC, MACs: []string{mac},
+				Detail: fmt.Sprintf("%s appears in %d %s", mac, count, source)})
+		}
+	}
+}
+
+func auditSubnets(report *AuditReport, reservations []*KnownClient, networks []NetworkConf) {
+	subnets := make(map[string]netip.Prefix)
+	for i := range networks {
+		prefix, err := netip.ParsePrefix(valueOrZero(networks[i].IPSubnet))
+		if err == nil {
+			subnets[networks[i].Id] = prefix.Masked()
+		}
+	}
+
+	for _, reservation := range reservations {
+		mac := normalizeAuditMAC(reservation.Mac)
+		networkID := valueOrZero(reservation.NetworkID)
+		finding := AuditFinding{Kind: AuditOutOfSubnet, IP: *reservation.FixedIP, MACs: []string{mac}, NetworkID: networkID}
+
+		addr, err := netip.ParseAddr(*reservation.FixedIP)
+		if err != nil {
+			finding.Detail = fmt.Sprintf("%s reserved for %s is not a valid IP address", finding.IP, mac)
+			report.add(finding)
+			continue
+		}
+
+		if subnet, ok := subnets[networkID]; ok {
+			if !subnet.Contains(addr) {
+				finding.Detail = fmt.Sprintf("%s reserved for %s is outside %s of network %s", addr, mac, subnet, networkID)
+				report.add(finding)
+			}
+			continue
+		}
+		if networkID == "" && len(subnets) > 0 && !anySubnetContains(subnets, addr) {
+			finding.Detail = fmt.Sprintf("%s reserved for %s is outside every network subnet", addr, mac)
+			report.add(finding)
+		}
+	}
+}
+
+func anySubnetContains(subnets map[string]netip.Prefix, addr netip.Addr) bool {
+	for _, subnet := range subnets {
+		if subnet.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// add appends a finding with its MAC addresses sorted.
+func (r *AuditReport) add(finding AuditFinding) {
+	finding.MACs = slices.Clone(finding.MACs)
+	slices.Sort(finding.MACs)
+	r.Findings = append(r.Findings, finding)
+}
+
+// normalizeAuditMAC normalizes mac, keeping values that cannot be parsed as lowercase text.
+func normalizeAuditMAC(mac string) string {
+	if normalized, err := NormalizeMAC(mac); err == nil {
+		return normalized
+	}
+	return strings.ToLower(mac)
+}
+
+// compareIPs orders valid addresses numerically before invalid ones, which sort as text.
+func compareIPs(a, b string) int {
+	addrA, errA := netip.ParseAddr(a)
+	addrB, errB := netip.ParseAddr(b)
+	switch {
+	case errA == nil && errB == nil:
+		return addrA.Compare(addrB)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	default:
+		return cmp.Compare(a, b)
+	}
+}
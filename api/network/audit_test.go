@@ -0,0 +1,278 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const (
+	testKnownClientsPath = "/proxy/network/api/s/" + testSiteInternal + "/rest/user"
+	testNetworksPath     = "/proxy/network/api/s/" + testSiteInternal + "/rest/networkconf"
+)
+
+func auditHandlers(t *testing.T) map[string]http.HandlerFunc {
+	t.Helper()
+	fixture := func(name string) http.HandlerFunc {
+		body := testdata.LoadFixture(t, name)
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		}
+	}
+	return map[string]http.HandlerFunc{
+		testSitesPath: fixture("sites/list_success.json"),
+		testSitesPath + "/" + testSiteID.String() + "/clients": fixture("clients/list_success.json"),
+		testKnownClientsPath: fixture("clients/known_clients.json"),
+		testNetworksPath:     fixture("networks/list_success.json"),
+	}
+}
+
+func TestListKnownClients(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testKnownClientsPath, testAPIKey,
+		testdata.LoadFixture(t, "clients/known_clients.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	known, err := client.ListKnownClients(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.Len(t, known, 8)
+	assert.Equal(t, "aa:bb:cc:14:01:56", known[0].Mac)
+	require.NotNil(t, known[0].UseFixedIP)
+	assert.True(t, *known[0].UseFixedIP)
+	assert.Equal(t, "10.222.189.242", *known[0].FixedIP)
+	assert.Nil(t, known[1].UseFixedIP)
+}
+
+func TestListNetworks(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testNetworksPath, testAPIKey,
+		testdata.LoadFixture(t, "networks/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	networks, err := client.ListNetworks(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.Len(t, networks, 3)
+	assert.Equal(t, "10.222.189.1/24", *networks[0].IPSubnet)
+	require.NotNil(t, networks[1].VLAN)
+	assert.Equal(t, int64(20), networks[1].VLAN.Int64())
+	assert.Nil(t, networks[2].IPSubnet)
+}
+
+func TestListNetworksError(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testNetworksPath, testAPIKey,
+		testdata.LoadFixture(t, "errors/unauthorized.json"), http.StatusUnauthorized)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	_, err = client.ListNetworks(context.Background(), testSiteInternal)
+	require.ErrorIs(t, err, unifierr.ErrUnauthorized)
+}
+
+func TestAuditAddressing(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, auditHandlers(t))
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	for _, site := range []Site{testSiteInternal, testSiteID.String()} {
+		report, err := client.AuditAddressing(context.Background(), site)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, report.ClientsScanned)
+		assert.Equal(t, 5, report.ReservationsScanned)
+		assert.Equal(t, []AuditFinding{
+			{
+				Kind: AuditDuplicateReservation, IP: "10.222.189.50",
+				MACs:   []string{"aa:bb:cc:20:00:02", "aa:bb:cc:20:00:03"},
+				Detail: "10.222.189.50 is reserved for 2 clients",
+			},
+			{
+				Kind: AuditReservationConflict, IP: "10.103.206.70",
+				MACs:   []string{"aa:bb:cc:20:00:01", "aa:bb:cc:9c:58:6f"},
+				Detail: "10.103.206.70 is reserved for aa:bb:cc:20:00:01 but used by aa:bb:cc:9c:58:6f",
+			},
+			{
+				Kind: AuditDuplicateMAC, MACs: []string{"aa:bb:cc:9c:58:6f"},
+				Detail: "aa:bb:cc:9c:58:6f appears in 2 known client records",
+			},
+			{
+				Kind: AuditOutOfSubnet, IP: "192.168.50.10", MACs: []string{"aa:bb:cc:20:00:04"},
+				NetworkID: "5f8a1b2c3d4e5f6a7b8c9d11",
+				Detail:    "192.168.50.10 reserved for aa:bb:cc:20:00:04 is outside 10.222.189.0/24 of network 5f8a1b2c3d4e5f6a7b8c9d11",
+			},
+		}, report.Findings)
+	}
+}
+
+func TestAuditAddressingUnknownSite(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, auditHandlers(t))
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	_, err = client.AuditAddressing(context.Background(), "branch")
+	require.ErrorIs(t, err, ErrSiteNotFound)
+}
+
+func TestAuditAddresses(t *testing.T) {
+	t.Parallel()
+
+	fixed := func(mac, ip, networkID string) KnownClient {
+		use := true
+		return KnownClient{Mac: mac, UseFixedIP: &use, FixedIP: &ip, NetworkID: &networkID}
+	}
+	connected := func(mac, ip string) ClientListItem {
+		return ClientListItem{MacAddress: mac, IpAddress: ip}
+	}
+	lan := "192.168.1.1/24"
+	iot := "10.0.20.1/24"
+	networks := []NetworkConf{{Id: "lan", IPSubnet: &lan}, {Id: "iot", IPSubnet: &iot}, {Id: "wan"}}
+
+	tests := []struct {
+		name     string
+		clients  []ClientListItem
+		known    []KnownClient
+		networks []NetworkConf
+		want     map[AuditFindingKind]int
+	}{
+		{
+			name:     "clean",
+			clients:  []ClientListItem{connected("aa:bb:cc:00:00:01", "192.168.1.10"), connected("aa:bb:cc:00:00:02", "")},
+			known:    []KnownClient{fixed("aa:bb:cc:00:00:01", "192.168.1.10", "lan")},
+			networks: networks,
+			want:     map[AuditFindingKind]int{},
+		},
+		{
+			name: "duplicate connected IP",
+			clients: []ClientListItem{
+				connected("aa:bb:cc:00:00:01", "192.168.1.10"),
+				connected("aa:bb:cc:00:00:02", "192.168.1.10"),
+				connected("aa:bb:cc:00:00:03", "192.168.1.10"),
+			},
+			want: map[AuditFindingKind]int{AuditDuplicateIP: 1},
+		},
+		{
+			name:    "duplicate connected MAC in different formats",
+			clients: []ClientListItem{connected("aa:bb:cc:00:00:01", "192.168.1.10"), connected("AA-BB-CC-00-00-01", "192.168.1.11")},
+			want:    map[AuditFindingKind]int{AuditDuplicateMAC: 1},
+		},
+		{
+			name:     "reservation used by its owner with another MAC format",
+			clients:  []ClientListItem{connected("AA:BB:CC:00:00:01", "192.168.1.10")},
+			known:    []KnownClient{fixed("aa:bb:cc:00:00:01", "192.168.1.10", "lan")},
+			networks: networks,
+			want:     map[AuditFindingKind]int{},
+		},
+		{
+			name:     "reservation in the wrong network",
+			known:    []KnownClient{fixed("aa:bb:cc:00:00:01", "10.0.20.5", "lan")},
+			networks: networks,
+			want:     map[AuditFindingKind]int{AuditOutOfSubnet: 1},
+		},
+		{
+			name:     "reservation without network outside all subnets",
+			known:    []KnownClient{fixed("aa:bb:cc:00:00:01", "172.16.0.5", ""), fixed("aa:bb:cc:00:00:02", "10.0.20.5", "")},
+			networks: networks,
+			want:     map[AuditFindingKind]int{AuditOutOfSubnet: 1},
+		},
+		{
+			name:     "reservation in a network without subnet",
+			known:    []KnownClient{fixed("aa:bb:cc:00:00:01", "172.16.0.5", "wan")},
+			networks: networks,
+			want:     map[AuditFindingKind]int{},
+		},
+		{
+			name:     "invalid reserved IP",
+			known:    []KnownClient{fixed("aa:bb:cc:00:00:01", "192.168.1.300", "lan")},
+			networks: networks,
+			want:     map[AuditFindingKind]int{AuditOutOfSubnet: 1},
+		},
+		{
+			name:  "no networks skips subnet checks",
+			known: []KnownClient{fixed("aa:bb:cc:00:00:01", "172.16.0.5", "")},
+			want:  map[AuditFindingKind]int{},
+		},
+		{
+			name: "everything at once",
+			clients: []ClientListItem{
+				connected("aa:bb:cc:00:00:09", "192.168.1.20"),
+				connected("aa:bb:cc:00:00:08", "192.168.1.20"),
+			},
+			known: []KnownClient{
+				fixed("aa:bb:cc:00:00:01", "192.168.1.20", "lan"),
+				fixed("aa:bb:cc:00:00:02", "192.168.1.20", "lan"),
+				fixed("aa:bb:cc:00:00:02", "10.0.20.9", "lan"),
+			},
+			networks: networks,
+			want: map[AuditFindingKind]int{
+				AuditDuplicateIP:          1,
+				AuditDuplicateReservation: 1,
+				AuditReservationConflict:  2,
+				AuditDuplicateMAC:         1,
+				AuditOutOfSubnet:          1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			report := AuditAddresses(tt.clients, tt.known, tt.networks)
+
+			got := make(map[AuditFindingKind]int)
+			for _, finding := range report.Findings {
+				got[finding.Kind]++
+				assert.NotEmpty(t, finding.Detail)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, len(tt.want) > 0, report.HasFindings())
+			for kind, count := range tt.want {
+				assert.Len(t, report.Of(kind), count)
+			}
+		})
+	}
+}
+
+func TestAuditAddressesOrder(t *testing.T) {
+	t.Parallel()
+
+	clients := []ClientListItem{
+		{MacAddress: "aa:bb:cc:00:00:04", IpAddress: "192.168.1.100"},
+		{MacAddress: "aa:bb:cc:00:00:03", IpAddress: "192.168.1.100"},
+		{MacAddress: "aa:bb:cc:00:00:02", IpAddress: "192.168.1.9"},
+		{MacAddress: "aa:bb:cc:00:00:01", IpAddress: "192.168.1.9"},
+	}
+
+	report := AuditAddresses(clients, nil, nil)
+	require.Len(t, report.Findings, 2)
+	assert.Equal(t, "192.168.1.9", report.Findings[0].IP)
+	assert.Equal(t, []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02"}, report.Findings[0].MACs)
+	assert.Equal(t, "192.168.1.100", report.Findings[1].IP)
+}
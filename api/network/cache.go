@@ -0,0 +1,68 @@
+package network
+
+import (
+	"net/http"
+	"strings"
+)
+
+// cacheInvalidations maps the resource a mutating request targets to the resources
+// whose cached GET responses it makes stale. Resources are matched as path segments,
+// so a rule applies to the collection and its items across integration, v2 and legacy
+// paths. Mutations of resources missing here invalidate the whole cache.
+var cacheInvalidations = map[string][]string{
+	"static-dns":        {"static-dns"},
+	"firewall-policies": {"firewall-policies"},
+	"trafficrules":      {"trafficrules"},
+	"hotspot/vouchers":  {"hotspot/vouchers"},
+	"rest/usergroup":    {"rest/usergroup", "rest/user", "stat/user"},
+	"rest/user":         {"rest/user", "stat/user", "clients"},
+	"cmd/stamgr":        {"rest/user", "stat/user", "clients"},
+	"rest/wlanconf":     {"rest/wlanconf"},
+	"rest/networkconf":  {"rest/networkconf"},
+	"bgp/config":        {"bgp/config"},
+	"apgroups":          {"apgroups"},
+}
+
+// staleCachedPaths is the middleware.CacheInvalidator of the Network API client.
+func staleCachedPaths(mutation *http.Request) func(path string) bool {
+	for resource, stale := range cacheInvalidations {
+		if !hasPathSegment(mutation.URL.Path, resource) {
+			continue
+		}
+		return func(path string) bool {
+			for _, s := range stale {
+				if hasPathSegment(path, s) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return nil
+}
+
+// hasPathSegment reports whether path contains segment as whole path segments.
+func hasPathSegment(path, segment string) bool {
+	needle := "/" + segment
+	for offset := 0; ; {
+		i := strings.Index(path[offset:], needle)
+		if i < 0 {
+			return false
+		}
+		end := offset + i + len(needle)
+		if end == len(path) || path[end] == '/' {
+			return true
+		}
+		offset = end
+	}
+}
+
+// InvalidateCache drops all cached responses. It has no effect unless CacheTTL is set.
+//
+// Mutations made through this client invalidate related cached responses automatically;
+// call InvalidateCache after changes made elsewhere, e.g. in the UniFi UI.
+func (c *APIClient) InvalidateCache() {
+	if c.cache != nil {
+		c.cache.Purge()
+	}
+}
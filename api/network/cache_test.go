@@ -0,0 +1,102 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestHasPathSegment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path    string
+		segment string
+		want    bool
+	}{
+		{path: "/proxy/network/v2/api/site/default/static-dns", segment: "static-dns", want: true},
+		{path: "/proxy/network/v2/api/site/default/static-dns/abc", segment: "static-dns", want: true},
+		{path: "/proxy/network/api/s/default/rest/usergroup/1", segment: "rest/usergroup", want: true},
+		{path: "/proxy/network/api/s/default/rest/usergroup/1", segment: "rest/user", want: false},
+		{path: "/proxy/network/api/s/default/rest/user/1", segment: "rest/user", want: true},
+		{path: "/proxy/network/integration/v1/sites/x/clients", segment: "clients", want: true},
+		{path: "/proxy/network/integration/v1/sites/x/hotspot/vouchers/1", segment: "hotspot/vouchers", want: true},
+		{path: "/proxy/network/v2/api/site/default/trafficrules", segment: "firewall-policies", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path+"/"+tt.segment, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, hasPathSegment(tt.path, tt.segment))
+		})
+	}
+}
+
+func TestCacheInvalidationOnMutation(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	gets := map[string]int{}
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/static-dns"):
+			mu.Lock()
+			gets["dns"]++
+			mu.Unlock()
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/list_success.json")))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/trafficrules"):
+			mu.Lock()
+			gets["traffic"]++
+			mu.Unlock()
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "traffic/empty_list.json")))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, CacheTTL: time.Minute})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	count := func(key string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return gets[key]
+	}
+
+	for range 3 {
+		_, err = client.ListDNSRecords(ctx, testSiteInternal)
+		require.NoError(t, err)
+		_, err = client.ListTrafficRules(ctx, testSiteInternal)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, count("dns"))
+	assert.Equal(t, 1, count("traffic"))
+
+	require.NoError(t, client.DeleteDNSRecord(ctx, testSiteInternal, "record-1"))
+
+	_, err = client.ListDNSRecords(ctx, testSiteInternal)
+	require.NoError(t, err)
+	_, err = client.ListTrafficRules(ctx, testSiteInternal)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count("dns"), "DNS list should be refetched after a DNS mutation")
+	assert.Equal(t, 1, count("traffic"), "traffic rules should stay cached")
+
+	client.InvalidateCache()
+	_, err = client.ListTrafficRules(ctx, testSiteInternal)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count("traffic"))
+}
@@ -0,0 +1,114 @@
+package network
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+// ErrInvalidCertificate is returned when a certificate and key cannot be installed
+// on the controller.
+var ErrInvalidCertificate = errors.New("invalid certificate")
+
+// GetControllerCertificate retrieves the TLS certificate served by the controller.
+//
+// Example:
+//
+//	// Renew 30 days ahead, as ACME clients do
+//	cert, err := client.GetControllerCertificate(ctx)
+//	if err == nil && (cert.ExpiresWithin(30*24*time.Hour) || !cert.CoversHost("unifi.example.com")) {
+//	    // obtain a new certificate and install it with UpdateControllerCertificate
+//	}
+func (c *APIClient) GetControllerCertificate(ctx context.Context) (*ControllerCertificate, error) {
+	ctx = middleware.WithOperation(ctx, "GetControllerCertificate")
+	resp, err := c.client.GetControllerCertificateWithResponse(ctx)
+	var data *ControllerCertificate
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get controller certificate")
+}
+
+// UpdateControllerCertificate installs a PEM certificate chain, leaf first, and its
+// PEM private key as the TLS certificate of the controller, and returns the installed
+// certificate. The pair is checked first: the key must match the leaf, which must be
+// currently valid. Errors from the check match ErrInvalidCertificate.
+//
+// The controller restarts its web server to apply the certificate, so requests made
+// right after may briefly fail; retries cover this when enabled.
+func (c *APIClient) UpdateControllerCertificate(ctx context.Context, certPEM, keyPEM []byte) (*ControllerCertificate, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateControllerCertificate")
+	err := checkCertificate(certPEM, keyPEM, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.UpdateControllerCertificateWithResponse(ctx, ControllerCertificateInput{
+		Certificate: string(certPEM),
+		PrivateKey:  string(keyPEM),
+	})
+	var data *ControllerCertificate
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to update controller certificate")
+}
+
+// checkCertificate checks that keyPEM matches the leaf of certPEM and that the leaf
+// is valid at now.
+func checkCertificate(certPEM, keyPEM []byte, now time.Time) error {
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidCertificate, "%v", err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return errors.Wrapf(ErrInvalidCertificate, "%v", err)
+	}
+	if now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
+		return errors.Wrapf(ErrInvalidCertificate, "certificate for %s is only valid from %s to %s",
+			leaf.Subject.CommonName, leaf.NotBefore.Format(time.RFC3339), leaf.NotAfter.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// ExpiresWithin reports whether the certificate expires within d from now, or has
+// already expired.
+func (c *ControllerCertificate) ExpiresWithin(d time.Duration) bool {
+	return time.Until(c.NotAfter) < d
+}
+
+// CoversHost reports whether the certificate is valid for host, matching its DNS
+// names, or its subject if it has none, with single-label wildcards.
+func (c *ControllerCertificate) CoversHost(host string) bool {
+	names := valueOrZero(c.DnsNames)
+	if len(names) == 0 {
+		names = []string{c.Subject}
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if name == host {
+			return true
+		}
+		if suffix, ok := strings.CutPrefix(name, "*."); ok {
+			if label, rest, found := strings.Cut(host, "."); found && label != "" && rest == suffix {
+				return true
+			}
+		}
+	}
+	return false
+}
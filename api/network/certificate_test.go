@@ -0,0 +1,142 @@
+package network
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+// newTestCertificate returns a self-signed PEM certificate for host, valid from
+// notBefore to notAfter, and its PEM private key.
+func newTestCertificate(t *testing.T, host string, notBefore, notAfter time.Time) (certPEM, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestGetControllerCertificate(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/proxy/network/v2/api/certificate", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "controller/certificate.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	cert, err := client.GetControllerCertificate(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "unifi.example.com", cert.Subject)
+	assert.Equal(t, time.Date(2025, 11, 30, 0, 0, 0, 0, time.UTC), cert.NotAfter)
+	assert.False(t, *cert.SelfSigned)
+}
+
+func TestUpdateControllerCertificate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	certPEM, keyPEM := newTestCertificate(t, "unifi.example.com", now.Add(-time.Hour), now.Add(90*24*time.Hour))
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "/proxy/network/v2/api/certificate", r.URL.Path)
+		assert.Equal(t, http.MethodPut, r.Method)
+
+		var body ControllerCertificateInput
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, string(certPEM), body.Certificate)
+		assert.Equal(t, string(keyPEM), body.PrivateKey)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "controller/certificate.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	cert, err := client.UpdateControllerCertificate(context.Background(), certPEM, keyPEM)
+	require.NoError(t, err)
+	assert.Equal(t, "unifi.example.com", cert.Subject)
+
+	_, otherKeyPEM := newTestCertificate(t, "unifi.example.com", now.Add(-time.Hour), now.Add(time.Hour))
+	expiredPEM, expiredKeyPEM := newTestCertificate(t, "unifi.example.com", now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+
+	for name, pair := range map[string][2][]byte{
+		"mismatched key": {certPEM, otherKeyPEM},
+		"expired":        {expiredPEM, expiredKeyPEM},
+		"not PEM":        {[]byte("certificate"), []byte("key")},
+	} {
+		_, err = client.UpdateControllerCertificate(context.Background(), pair[0], pair[1])
+		require.ErrorIs(t, err, ErrInvalidCertificate, name)
+	}
+	assert.Equal(t, int32(1), requests.Load(), "invalid certificates must not reach the controller")
+}
+
+func TestControllerCertificateExpiresWithin(t *testing.T) {
+	t.Parallel()
+
+	cert := &ControllerCertificate{NotAfter: time.Now().Add(10 * 24 * time.Hour)}
+	assert.True(t, cert.ExpiresWithin(30*24*time.Hour))
+	assert.False(t, cert.ExpiresWithin(24*time.Hour))
+
+	cert.NotAfter = time.Now().Add(-time.Hour)
+	assert.True(t, cert.ExpiresWithin(0))
+}
+
+func TestControllerCertificateCoversHost(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"unifi.example.com", "*.sites.example.com"}
+	cert := &ControllerCertificate{Subject: "unifi.example.com", DnsNames: &names}
+
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{host: "unifi.example.com", want: true},
+		{host: "UNIFI.example.com.", want: true},
+		{host: "office.sites.example.com", want: true},
+		{host: "sites.example.com"},
+		{host: "a.b.sites.example.com"},
+		{host: "example.com"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, cert.CoversHost(tt.host), tt.host)
+	}
+
+	bare := &ControllerCertificate{Subject: "unifi.local"}
+	assert.True(t, bare.CoversHost("unifi.local"))
+}
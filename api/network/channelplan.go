@@ -0,0 +1,275 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// RadioChannelAuto lets the controller choose the channel of a radio.
+const RadioChannelAuto RadioChannel = 0
+
+// RadioChannel is the channel number of an access point radio, or RadioChannelAuto.
+// The controller stores channels as strings ("36" or "auto"), so it encodes as one
+// and decodes from both strings and numbers.
+type RadioChannel int
+
+// MarshalJSON implements json.Marshaler.
+func (c RadioChannel) MarshalJSON() ([]byte, error) {
+	//nolint:wrapcheck // marshaling a string cannot fail
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *RadioChannel) UnmarshalJSON(data []byte) error {
+	s, ok, err := flexibleScalar(data)
+	if err != nil || !ok {
+		return err
+	}
+	if s == "" || strings.EqualFold(s, "auto") {
+		*c = RadioChannelAuto
+		return nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return errors.Newf("cannot decode %s as radio channel", data)
+	}
+	*c = RadioChannel(n)
+	return nil
+}
+
+// String returns the channel number, or "auto".
+func (c RadioChannel) String() string {
+	if c == RadioChannelAuto {
+		return "auto"
+	}
+	return strconv.Itoa(int(c))
+}
+
+// Allowed returns the channels the regulatory domain allows on a band at a channel
+// width in MHz. It returns nil for widths the band does not support.
+func (r *RegulatoryChannels) Allowed(band RadioBand, width int) []int {
+	var byWidth map[int]*[]int
+	switch band {
+	case RadioBand2G:
+		byWidth = map[int]*[]int{20: r.Channels2G20, 40: r.Channels2G40}
+	case RadioBand5G:
+		byWidth = map[int]*[]int{20: r.Channels5G20, 40: r.Channels5G40, 80: r.Channels5G80, 160: r.Channels5G160}
+	case RadioBand6G:
+		byWidth = map[int]*[]int{20: r.Channels6G20, 40: r.Channels6G40, 80: r.Channels6G80, 160: r.Channels6G160}
+	}
+	if channels := byWidth[width]; channels != nil {
+		return *channels
+	}
+	return nil
+}
+
+// RadioSettings is the target configuration of one access point radio.
+type RadioSettings struct {
+	// Channel to use; RadioChannelAuto lets the controller choose.
+	Channel RadioChannel
+	// Width is the channel width in MHz. Zero keeps the current width.
+	Width int
+	// TxPowerMode is the transmit power level. Empty keeps the current mode.
+	TxPowerMode TxPowerMode
+	// TxPower is the transmit power in dBm, required with TxPowerCustom.
+	TxPower int
+}
+
+// ChannelPlan maps access points to the target settings of their radios, by band.
+// Radios not listed keep their configuration.
+type ChannelPlan map[DeviceId]map[RadioBand]RadioSettings
+
+// ApplyChannelPlan sets the channel, width and transmit power of access point
+// radios across a site.
+//
+// The whole plan is validated before anything is changed: every listed band must
+// exist on the access point, the channel must be allowed at the requested width by
+// the regulatory domain of the site country, and a custom transmit power must be
+// within the range the radio supports. Any violation is reported in a single error
+// matching unifierr.ErrValidation.
+//
+// Access points are then updated one by one, in device ID order. If an update
+// fails, the access points before it keep their new configuration and the error
+// names the device that failed.
+//
+// Example:
+//
+//	err := client.ApplyChannelPlan(ctx, siteID, network.ChannelPlan{
+//	    lobbyAP: {
+//	        network.RadioBand2G: {Channel: 1, Width: 20},
+//	        network.RadioBand5G: {Channel: 36, Width: 80, TxPowerMode: network.TxPowerCustom, TxPower: 17},
+//	    },
+//	    officeAP: {
+//	        network.RadioBand2G: {Channel: 6, Width: 20},
+//	        network.RadioBand5G: {Channel: 149, Width: 80},
+//	    },
+//	})
+func (c *APIClient) ApplyChannelPlan(ctx context.Context, siteID SiteId, plan ChannelPlan) error {
+	ctx = middleware.WithOperation(ctx, "ApplyChannelPlan")
+	if len(plan) == 0 {
+		return errors.Wrap(unifierr.ErrValidation, "channel plan is empty")
+	}
+
+	regulatory, err := c.ListRegulatoryChannels(ctx, siteID.String())
+	if err != nil {
+		return err
+	}
+	if len(regulatory) == 0 {
+		return errors.Wrapf(unifierr.ErrNotFound, "no regulatory domain reported for site %s", siteID)
+	}
+	domain := &regulatory[0]
+
+	deviceIDs := slices.SortedFunc(maps.Keys(plan), func(a, b DeviceId) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	tables := make([][]RadioConfig, len(deviceIDs))
+	devices := make([]*LegacyDevice, len(deviceIDs))
+	var problems []string
+	for i, deviceID := range deviceIDs {
+		device, err := c.getLegacyDevice(ctx, siteID, deviceID, "failed to get radios of device")
+		if err != nil {
+			return err
+		}
+		if device.Id == nil || device.RadioTable == nil {
+			problems = append(problems, fmt.Sprintf("device %s has no radios", deviceID))
+			continue
+		}
+		devices[i] = device
+		tables[i], problems = planRadios(deviceID, *device.RadioTable, plan[deviceID], domain, problems)
+	}
+	if len(problems) > 0 {
+		return errors.Wrapf(unifierr.ErrValidation, "invalid channel plan for site %s (%s): %s",
+			siteID, valueOrZero(domain.Key), strings.Join(problems, "; "))
+	}
+
+	site, err := c.sites.InternalReference(ctx, siteID.String())
+	if err != nil {
+		return err
+	}
+	for i, deviceID := range deviceIDs {
+		err := c.updateRadios(ctx, site, *devices[i].Id, tables[i])
+		if err != nil {
+			return errors.Wrapf(err, "failed to apply channel plan to device %s", deviceID)
+		}
+	}
+	return nil
+}
+
+// ListRegulatoryChannels retrieves the channels the regulatory domain of the site
+// country allows for each radio band and channel width.
+func (c *APIClient) ListRegulatoryChannels(ctx context.Context, site Site) ([]RegulatoryChannels, error) {
+	ctx = middleware.WithOperation(ctx, "ListRegulatoryChannels")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListRegulatoryChannelsWithResponse(ctx, site)
+	var data *RegulatoryChannelsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	channels, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list regulatory channels in site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return channels.Data, nil
+}
+
+// updateRadios replaces the radio table of a device.
+func (c *APIClient) updateRadios(ctx context.Context, site Site, legacyID string, table []RadioConfig) error {
+	resp, err := c.client.UpdateLegacyDeviceWithResponse(ctx, site, legacyID, LegacyDeviceInput{RadioTable: &table})
+	var data *LegacyDevicesResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	_, err = response.HandleDecoded(c.decoder, resp, body, data, err, "failed to update radios of device "+legacyID)
+	//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+	return err
+}
+
+// planRadios returns the radio table of a device with the planned settings applied,
+// appending every constraint violation to problems.
+func planRadios(
+	deviceID DeviceId, current []RadioConfig, settings map[RadioBand]RadioSettings,
+	domain *RegulatoryChannels, problems []string,
+) ([]RadioConfig, []string) {
+	table := slices.Clone(current)
+	for _, band := range slices.Sorted(maps.Keys(settings)) {
+		want := settings[band]
+		idx := slices.IndexFunc(table, func(r RadioConfig) bool { return r.Radio == band })
+		if idx < 0 {
+			problems = append(problems, fmt.Sprintf("device %s has no %s radio", deviceID, band))
+			continue
+		}
+		radio := &table[idx]
+
+		width := want.Width
+		if width == 0 && radio.Width != nil {
+			width = int(*radio.Width)
+		}
+		allowed := domain.Allowed(band, width)
+		switch {
+		case allowed == nil:
+			problems = append(problems, fmt.Sprintf("device %s: %d MHz is not a valid %s channel width", deviceID, width, band))
+		case want.Channel != RadioChannelAuto && !slices.Contains(allowed, int(want.Channel)):
+			problems = append(problems, fmt.Sprintf("device %s: channel %d is not allowed on %s at %d MHz",
+				deviceID, want.Channel, band, width))
+		}
+
+		problems = checkTxPower(deviceID, band, radio, want, problems)
+
+		channel := want.Channel
+		radio.Channel = &channel
+		w := FlexibleInt(width)
+		radio.Width = &w
+		if want.TxPowerMode != "" {
+			mode := want.TxPowerMode
+			radio.TxPowerMode = &mode
+		}
+		if want.TxPowerMode == TxPowerCustom {
+			power := FlexibleInt(want.TxPower)
+			radio.TxPower = &power
+		}
+	}
+	return table, problems
+}
+
+// checkTxPower appends the transmit power violations of a radio to problems.
+func checkTxPower(deviceID DeviceId, band RadioBand, radio *RadioConfig, want RadioSettings, problems []string) []string {
+	switch want.TxPowerMode {
+	case "", TxPowerAuto, TxPowerLow, TxPowerMedium, TxPowerHigh:
+		if want.TxPower != 0 {
+			return append(problems, fmt.Sprintf("device %s: %s transmit power requires the custom power mode", deviceID, band))
+		}
+		return problems
+	case TxPowerCustom:
+	default:
+		return append(problems, fmt.Sprintf("device %s: unknown %s transmit power mode %q", deviceID, band, want.TxPowerMode))
+	}
+
+	if radio.MinTxPower != nil && want.TxPower < int(*radio.MinTxPower) ||
+		radio.MaxTxPower != nil && want.TxPower > int(*radio.MaxTxPower) {
+		return append(problems, fmt.Sprintf("device %s: %s transmit power %d dBm is outside %d-%d dBm",
+			deviceID, band, want.TxPower, valueOrZero(radio.MinTxPower), valueOrZero(radio.MaxTxPower)))
+	}
+	return problems
+}
@@ -0,0 +1,169 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const testAPUpdatePath = "/proxy/network/api/s/" + testSiteInternal + "/rest/device/60a1b2c3d4e5f6a7b8c9d0f1"
+
+// channelPlanHandlers serves the access point fixtures and records the radio
+// tables sent to the controller.
+func channelPlanHandlers(t *testing.T, updates *[][]RadioConfig) map[string]http.HandlerFunc {
+	t.Helper()
+	handlers := neighborHandlers(t, testdata.LoadFixture(t, "devices/legacy_ap.json"))
+	regulatory := testdata.LoadFixture(t, "devices/regulatory_channels.json")
+	handlers["/proxy/network/api/s/"+testSiteInternal+"/stat/current-channel"] = func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(regulatory))
+	}
+	handlers[testAPUpdatePath] = func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		raw, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var input LegacyDeviceInput
+		assert.NoError(t, json.Unmarshal(raw, &input))
+		if input.RadioTable != nil {
+			*updates = append(*updates, *input.RadioTable)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	}
+	return handlers
+}
+
+func TestApplyChannelPlan(t *testing.T) {
+	t.Parallel()
+
+	var updates [][]RadioConfig
+	server := testutil.NewMockServerMulti(t, channelPlanHandlers(t, &updates))
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	err = client.ApplyChannelPlan(context.Background(), testSiteID, ChannelPlan{
+		testNeighborDeviceID: {
+			RadioBand2G: {Channel: 6, Width: 20},
+			RadioBand5G: {Channel: 149, TxPowerMode: TxPowerCustom, TxPower: 17},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Len(t, updates[0], 2, "the whole radio table is sent")
+
+	ng, na := updates[0][0], updates[0][1]
+	assert.Equal(t, RadioBand2G, ng.Radio)
+	assert.Equal(t, RadioChannel(6), *ng.Channel)
+	assert.Equal(t, FlexibleInt(20), *ng.Width)
+	assert.Equal(t, TxPowerAuto, *ng.TxPowerMode, "power mode is kept when not planned")
+
+	assert.Equal(t, RadioBand5G, na.Radio)
+	assert.Equal(t, RadioChannel(149), *na.Channel)
+	assert.Equal(t, FlexibleInt(80), *na.Width, "width is kept when not planned")
+	assert.Equal(t, TxPowerCustom, *na.TxPowerMode)
+	assert.Equal(t, FlexibleInt(17), *na.TxPower)
+}
+
+func TestApplyChannelPlanInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		plan    ChannelPlan
+		wantErr string
+	}{
+		{name: "empty plan", plan: ChannelPlan{}, wantErr: "channel plan is empty"},
+		{
+			name:    "missing band",
+			plan:    ChannelPlan{testNeighborDeviceID: {RadioBand6G: {Channel: 37}}},
+			wantErr: "has no 6e radio",
+		},
+		{
+			name:    "channel not allowed",
+			plan:    ChannelPlan{testNeighborDeviceID: {RadioBand2G: {Channel: 13, Width: 20}}},
+			wantErr: "channel 13 is not allowed on ng at 20 MHz",
+		},
+		{
+			name:    "channel not allowed at width",
+			plan:    ChannelPlan{testNeighborDeviceID: {RadioBand5G: {Channel: 165, Width: 80}}},
+			wantErr: "channel 165 is not allowed on na at 80 MHz",
+		},
+		{
+			name:    "width not supported",
+			plan:    ChannelPlan{testNeighborDeviceID: {RadioBand2G: {Channel: 6, Width: 80}}},
+			wantErr: "80 MHz is not a valid ng channel width",
+		},
+		{
+			name: "power out of range",
+			plan: ChannelPlan{testNeighborDeviceID: {
+				RadioBand5G: {Channel: 36, TxPowerMode: TxPowerCustom, TxPower: 30},
+			}},
+			wantErr: "na transmit power 30 dBm is outside 6-23 dBm",
+		},
+		{
+			name:    "power without custom mode",
+			plan:    ChannelPlan{testNeighborDeviceID: {RadioBand5G: {Channel: 36, TxPower: 10}}},
+			wantErr: "requires the custom power mode",
+		},
+		{
+			name:    "unknown power mode",
+			plan:    ChannelPlan{testNeighborDeviceID: {RadioBand5G: {Channel: 36, TxPowerMode: "max"}}},
+			wantErr: `unknown na transmit power mode "max"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var updates [][]RadioConfig
+			server := testutil.NewMockServerMulti(t, channelPlanHandlers(t, &updates))
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			err = client.ApplyChannelPlan(context.Background(), testSiteID, tt.plan)
+			require.ErrorIs(t, err, unifierr.ErrValidation)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, updates, "nothing is applied when validation fails")
+		})
+	}
+}
+
+func TestRadioChannelJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want RadioChannel
+	}{
+		{in: `"auto"`, want: RadioChannelAuto},
+		{in: `"36"`, want: 36},
+		{in: `11`, want: 11},
+		{in: `""`, want: RadioChannelAuto},
+	}
+	for _, tt := range tests {
+		var got RadioChannel
+		require.NoError(t, json.Unmarshal([]byte(tt.in), &got), tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+
+	var bad RadioChannel
+	require.Error(t, json.Unmarshal([]byte(`"wide"`), &bad))
+
+	out, err := json.Marshal([]RadioChannel{RadioChannelAuto, 149})
+	require.NoError(t, err)
+	assert.JSONEq(t, `["auto","149"]`, string(out))
+}
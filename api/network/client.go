@@ -1,27 +1,38 @@
 package network
 
 //go:generate oapi-codegen -config .oapi-codegen.yaml openapi.yaml
+//go:generate go run ../../internal/enumgen
 
 import (
+	"cmp"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"golang.org/x/time/rate"
 
+	unifi "github.com/lexfrei/go-unifi"
 	"github.com/lexfrei/go-unifi/internal/httpclient"
 	"github.com/lexfrei/go-unifi/internal/middleware"
 	"github.com/lexfrei/go-unifi/internal/ratelimit"
 	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/internal/retry"
 	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
 )
 
 const (
 	// DefaultRateLimit is the default rate limit for the Network API (requests per minute).
 	DefaultRateLimit = 1000
+	// RateLimitDisabled turns off client-side rate limiting when used as RateLimitPerMinute.
+	RateLimitDisabled = -1
 
 	// DefaultMaxRetries is the default number of retries for failed requests.
 	DefaultMaxRetries = 3
@@ -33,7 +44,34 @@ const (
 
 // APIClient wraps the generated API client with composable middleware.
 type APIClient struct {
-	client *ClientWithResponses
+	client  *ClientWithResponses
+	decoder *response.Decoder
+	sites   *SiteResolver
+	cache   *middleware.ResponseCache
+	paths   *middleware.PathCache
+
+	// httpClient is the middleware chain of client, used by DoRaw.
+	httpClient *http.Client
+
+	// downloader shares the middleware chain of client but has no overall timeout,
+	// so that Download is bounded by its context only.
+	downloader *http.Client
+	baseURL    *url.URL
+	apiKey     string
+	logger     observability.Logger
+	logDiffs   bool
+
+	// filterUnsupported is set once the controller rejected a client filter expression,
+	// so that FilterSiteClients filters locally without trying again. Scoped clients
+	// share it with the client they were created from.
+	filterUnsupported *atomic.Bool
+
+	// ruleGroups holds the traffic rules bound to user groups with
+	// BindTrafficRuleToUserGroup.
+	ruleGroups *trafficRuleBindings
+
+	// v2Paths adapts v2 request paths to the controller version.
+	v2Paths *v2Paths
 }
 
 // Compile-time check to ensure APIClient implements NetworkAPIClient interface.
@@ -53,25 +91,217 @@ type ClientConfig struct {
 	// InsecureSkipVerify disables TLS certificate verification (useful for self-signed certs)
 	InsecureSkipVerify bool
 
-	// RateLimitPerMinute sets the rate limit (defaults to 1000)
+	// RootCAs sets the certificate authorities that verify the server certificate, e.g. a
+	// private CA of the controller (optional, defaults to the system pool)
+	RootCAs *x509.CertPool
+
+	// RateLimitPerMinute sets the rate limit (defaults to 1000); RateLimitDisabled, or any
+	// negative value, removes client-side rate limiting, e.g. for dedicated controllers
 	RateLimitPerMinute int
 
+	// RateLimits sets separate rate limits, in requests per minute, for endpoints whose
+	// path starts with a key, mirroring per-endpoint server budgets (optional). Paths are
+	// relative to the Network application, with IDs written as :id and v2 site names as
+	// :site, e.g. "/integration/v1/sites/:id/hotspot/vouchers". The longest matching
+	// prefix wins and has its own budget; other requests use RateLimitPerMinute.
+	// RateLimitDisabled exempts matching endpoints from rate limiting; zero and other
+	// negative values are rejected.
+	RateLimits map[string]int
+
 	// MaxRetries sets maximum number of retries for failed requests
 	MaxRetries int
 
 	// RetryWaitTime sets the wait time between retries
 	RetryWaitTime time.Duration
 
-	// Timeout sets the HTTP client timeout
+	// RetryMaxWaitTime caps the exponentially growing wait between retries; Retry-After
+	// headers are honored even above it (defaults to 0, uncapped)
+	RetryMaxWaitTime time.Duration
+
+	// RetryJitter is the fraction of each wait between retries that is randomized, from 0
+	// (fixed waits) to 1, spreading out clients that failed at the same time (defaults to 0)
+	RetryJitter float64
+
+	// RetryBudgetPerMinute caps the retries of all requests made through the client,
+	// refilled continuously; a failure that finds the budget empty is returned as
+	// *unifierr.RetryBudgetError instead of being retried (defaults to 0, unlimited)
+	RetryBudgetPerMinute int
+
+	// OnRetryDecision is called before every retry with the failed response (nil on network
+	// errors), the retry number, and the wait time; returning false stops retrying (optional)
+	OnRetryDecision RetryDecisionFunc
+
+	// DetectMaintenance fails requests answered with a 503 maintenance page immediately
+	// with an error matching unifierr.ErrControllerMaintenance instead of retrying them
+	// (defaults to false)
+	DetectMaintenance bool
+
+	// WaitPastDeadline makes requests wait for the rate limiter even when the wait
+	// ends after the context deadline. By default such requests fail immediately
+	// with an error matching unifierr.ErrWouldExceedDeadline (defaults to false)
+	WaitPastDeadline bool
+
+	// UserAgent identifies the application in the User-Agent header of requests, e.g.
+	// "my-exporter/1.2", for controller-side log forensics and support requests.
+	// go-unifi/<version> is always appended (optional)
+	UserAgent string
+
+	// Timeout bounds each request as a whole, from dialing to reading the end of the
+	// response body (defaults to 30 seconds). Any negative value removes it, leaving
+	// only the context deadline and the phase timeouts below, e.g. for large downloads
 	Timeout time.Duration
 
+	// DialTimeout bounds establishing a connection, including DNS resolution
+	// (defaults to 30 seconds, as http.DefaultTransport)
+	DialTimeout time.Duration
+
+	// TLSHandshakeTimeout bounds the TLS handshake (defaults to 10 seconds, as
+	// http.DefaultTransport)
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout bounds waiting for the response headers once the request is
+	// sent, without limiting how long reading the body may take (defaults to 0, unbounded)
+	ResponseHeaderTimeout time.Duration
+
 	// Logger for observability (optional, uses noop logger if nil)
 	Logger observability.Logger
 
-	// Metrics recorder for observability (optional, uses noop recorder if nil)
+	// Metrics recorder for observability (optional, uses noop recorder if nil).
+	// Recorders implementing observability.PathCacheMetricsRecorder also receive
+	// statistics of the normalized-path cache
 	Metrics observability.MetricsRecorder
+
+	// PathCacheSize bounds the number of request paths whose normalized form is cached
+	// for metrics (defaults to 4096)
+	PathCacheSize int
+
+	// KnownPaths pre-seeds the normalized-path cache with request paths that are never
+	// evicted, e.g. the CachedPaths of a previous run (optional)
+	KnownPaths []string
+
+	// StrictDecoding controls how response fields unknown to the client are handled
+	// (defaults to StrictDecodingOff; use StrictDecodingLog or StrictDecodingFail to detect schema drift)
+	StrictDecoding StrictDecodingMode
+
+	// RetainRawJSON keeps the raw JSON of decoded models in their RawJSON field,
+	// giving access to fields not yet modeled by the client (defaults to false)
+	RetainRawJSON bool
+
+	// LenientDecoding makes ListSiteClients and ListSiteDevices skip list elements that
+	// fail to decode instead of failing the call. Skipped elements are logged as warnings
+	// via Logger and reported in the Warnings field of the response (defaults to false)
+	LenientDecoding bool
+
+	// JSONUnmarshal replaces encoding/json.Unmarshal for the potentially large responses
+	// of ListSiteClients and ListSiteDevices, e.g. with the Unmarshal function of go-json or sonic (optional)
+	JSONUnmarshal func(data []byte, v any) error
+
+	// SerializeSiteMutations lets only one create, update or delete request per site
+	// through at a time, so parallel jobs sharing the client do not trigger provisioning
+	// conflicts on the controller (defaults to false). Reads are never held back.
+	SerializeSiteMutations bool
+
+	// SiteListTTL is how long the memoized site list used by Sites and site resolution
+	// is kept before Sites reloads it (defaults to 0, kept until refreshed). Resolution
+	// reloads it anyway when it meets an unknown site.
+	SiteListTTL time.Duration
+
+	// ReadOnly makes the client refuse every request that could change the controller
+	// configuration with an error matching unifierr.ErrReadOnlyClient, before sending it,
+	// so that a monitoring service cannot make changes even with a leaked or over-privileged
+	// API key (defaults to false). Reads made through POST, such as ListClientSessions,
+	// are still allowed; raw requests must use GET, HEAD or OPTIONS.
+	ReadOnly bool
+
+	// LogUpdateDiffs makes UpdateDNSRecord, UpdateFirewallPolicy and UpdateTrafficRule
+	// fetch the object before updating it and log the fields the update changed at Info
+	// level via Logger, as an audit trail of what automation changed (defaults to false).
+	// Each update costs one more list request, which scoped clients need read access for;
+	// values of secret fields are redacted.
+	LogUpdateDiffs bool
+
+	// CacheTTL enables caching of successful GET responses for the given duration
+	// (defaults to 0, disabled). Mutations made through the client invalidate related
+	// cached responses, so callers never read lists older than their own writes.
+	CacheTTL time.Duration
+
+	// ControllerVersion is the Network application version, e.g. "8.7.11", that selects
+	// the V2PathRules to apply (optional). If empty, requests are sent unchanged until
+	// one that a rule is about returns 404, which detects the version with
+	// GetControllerStatus and tries the path of that version.
+	ControllerVersion string
+
+	// V2PathRules map the v2 API paths the client requests to the paths of the
+	// controller version, for endpoints that moved between Network application releases
+	// (defaults to DefaultV2PathRules; an empty non-nil slice disables the mapping)
+	V2PathRules []V2PathRule
+
+	// Fault injects delays, error responses and connection resets into requests at the
+	// given rates, below retries, to test how automation copes with a flaky controller
+	// using the client it runs in production (optional, never set it in production)
+	Fault *FaultConfig
+}
+
+// RetryDecisionFunc decides whether a failed request is retried. It receives the failed
+// response (nil on network errors), the number of the upcoming retry starting at 1, and
+// the wait time before it, e.g. to record telemetry from response headers or to give up
+// early on specific responses.
+type RetryDecisionFunc = middleware.RetryDecisionFunc
+
+// WaitStats reports how long the requests made with a context waited on the client's
+// rate limiter and between retries, see WithWaitStats.
+type WaitStats = middleware.WaitStats
+
+// FaultConfig configures the faults injected into requests, see ClientConfig.Fault.
+// Faults are logged at debug level via ClientConfig.Logger unless FaultConfig.Logger is set.
+type FaultConfig = middleware.FaultConfig
+
+// WithWaitStats returns a context that records the waits of every request made with it,
+// so batch schedulers can adapt their own pacing to client-side throttling instead of
+// queueing work blindly.
+//
+// Example:
+//
+//	ctx, waits := network.WithWaitStats(ctx)
+//	_, err := client.ListSites(ctx, nil)
+//	if waits.RateLimitWait() > time.Second {
+//	    // slow down
+//	}
+func WithWaitStats(ctx context.Context) (context.Context, *WaitStats) {
+	return middleware.WithWaitStats(ctx)
+}
+
+// WithUserAgent returns a context whose requests identify themselves with userAgent
+// instead of ClientConfig.UserAgent, e.g. to tell the jobs of a shared client apart in
+// controller logs. go-unifi/<version> is still appended.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return middleware.WithUserAgent(ctx, userAgent)
+}
+
+// WithRequestID returns a context whose requests are sent with id in their X-Request-ID
+// header instead of a generated UUID, e.g. to propagate the ID of an incoming request
+// into distributed logs. Failed calls report the request ID, and the trace ID returned
+// by the API, in their *unifierr.APIError.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return middleware.WithRequestID(ctx, id)
 }
 
+// StrictDecodingMode controls how response fields that are not part of the client's models are handled.
+type StrictDecodingMode = response.StrictMode
+
+// Strict decoding modes.
+const (
+	// StrictDecodingOff ignores unknown response fields.
+	StrictDecodingOff = response.StrictOff
+	// StrictDecodingLog logs unknown response fields as warnings via the configured Logger.
+	StrictDecodingLog = response.StrictLog
+	// StrictDecodingFail fails calls whose responses contain unknown fields with unifierr.ErrUnknownField.
+	StrictDecodingFail = response.StrictFail
+)
+
+// DecodeWarning reports a list element skipped by lenient decoding.
+type DecodeWarning = response.DecodeWarning
+
 // New creates a new UniFi Network API client with default settings.
 // This is the recommended way to create a client for most use cases.
 //
@@ -121,6 +351,12 @@ func NewWithConfig(cfg *ClientConfig) (*APIClient, error) {
 	if cfg.APIKey == "" {
 		return nil, errors.New("API key is required")
 	}
+	for prefix, perMinute := range cfg.RateLimits {
+		// A zero limit would reject every matching request instead of throttling it
+		if perMinute <= 0 && perMinute != RateLimitDisabled {
+			return nil, errors.Newf("rate limit of %s must be positive or RateLimitDisabled, got %d", prefix, perMinute)
+		}
+	}
 
 	// Set defaults
 	if cfg.RateLimitPerMinute == 0 {
@@ -136,38 +372,132 @@ func NewWithConfig(cfg *ClientConfig) (*APIClient, error) {
 		cfg.Timeout = DefaultTimeout
 	}
 
-	// Create rate limiter
-	rateLimiter := ratelimit.NewRateLimiter(cfg.RateLimitPerMinute)
+	// Create rate limiter (nil when disabled, which removes the middleware from the chain)
+	rateLimiter := ratelimit.NewOptionalRateLimiter(cfg.RateLimitPerMinute)
+
+	paths := middleware.NewPathCache(cfg.PathCacheSize, cfg.KnownPaths)
+
+	// Per-endpoint limiters are chosen by path prefix, falling back to rateLimiter
+	var rateLimiterSelector middleware.RateLimiterSelector
+	if len(cfg.RateLimits) > 0 {
+		limiters := make(map[string]*rate.Limiter, len(cfg.RateLimits))
+		for prefix, perMinute := range cfg.RateLimits {
+			limiters[prefix] = ratelimit.NewOptionalRateLimiter(perMinute)
+		}
+		rateLimiterSelector = middleware.PathPrefixSelector(NetworkBasePath, limiters, rateLimiter, paths)
+	}
+
+	// Cached responses bypass rate limiting and retries; a pass-through
+	// middleware keeps the chain unchanged when caching is disabled.
+	var cache *middleware.ResponseCache
+	cacheMiddleware := func(next http.RoundTripper) http.RoundTripper { return next }
+	if cfg.CacheTTL > 0 {
+		cache = middleware.NewResponseCache(middleware.CacheConfig{
+			TTL:        cfg.CacheTTL,
+			Invalidate: staleCachedPaths,
+			Logger:     cfg.Logger,
+		})
+		cacheMiddleware = cache.Middleware()
+	}
+
+	// Mutations are serialized per site, identified through the site resolver so that
+	// Integration (UUID) and v2 (internal reference) requests for a site share a lock.
+	// The resolver gets its client once the API client exists.
+	sites := &SiteResolver{ttl: cfg.SiteListTTL}
+	siteLockMiddleware := func(next http.RoundTripper) http.RoundTripper { return next }
+	if cfg.SerializeSiteMutations {
+		siteLockMiddleware = middleware.SerializeMutations(func(req *http.Request) string {
+			return sites.mutationKey(req.Context(), req.URL.Path)
+		})
+	}
+
+	// Mutations of read-only clients fail before reaching observability, so that
+	// refused requests are not reported as sent.
+	readOnlyMiddleware := func(next http.RoundTripper) http.RoundTripper { return next }
+	if cfg.ReadOnly {
+		readOnlyMiddleware = middleware.ReadOnly(postReadOperations...)
+	}
+
+	// Faults are injected below Retry, so that injected failures are retried like real ones
+	faultMiddleware := func(next http.RoundTripper) http.RoundTripper { return next }
+	if cfg.Fault != nil {
+		fault := *cfg.Fault
+		fault.Logger = cmp.Or(fault.Logger, cfg.Logger)
+		faultMiddleware = middleware.Fault(fault)
+	}
+
+	// v2 paths are adapted below retries, so that caching, rate limits and metrics see
+	// the same paths whatever the controller version. The rewriter gets the client
+	// detecting the version once the API client exists.
+	v2PathRules := cfg.V2PathRules
+	if v2PathRules == nil {
+		v2PathRules = DefaultV2PathRules
+	}
+	v2Paths, err := newV2Paths(v2PathRules, cfg.ControllerVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid v2 path configuration")
+	}
+	v2PathMiddleware := func(next http.RoundTripper) http.RoundTripper { return next }
+	if len(v2PathRules) > 0 {
+		v2PathMiddleware = v2Paths.middleware
+	}
 
 	// Build middleware chain (applied in reverse order: last = innermost, applied first)
-	// Order from outside to inside: Observability -> TLS -> RateLimit -> Retry
+	// Order from outside to inside: Recover -> UserAgent -> RequestID -> ReadOnly -> Observability -> Cache -> SiteLock -> RateLimit -> Retry -> V2Paths -> Fault -> TLS -> Timeouts.
+	// TLS and Timeouts must stay innermost: they configure the base *http.Transport and would
+	// replace, rather than wrap, any middleware below it.
 	httpClient := httpclient.New(
-		httpclient.WithTimeout(cfg.Timeout),
+		httpclient.WithTimeout(max(cfg.Timeout, 0)),
 		httpclient.WithMiddleware(
-			middleware.Observability(cfg.Logger, cfg.Metrics),
-			middleware.TLSConfig(&tls.Config{
-				InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // User-configurable
-			}),
+			middleware.Recover(cfg.Logger, cfg.Metrics),
+			middleware.UserAgent(cfg.UserAgent, "go-unifi/"+unifi.Version()),
+			middleware.RequestID(),
+			readOnlyMiddleware,
+			middleware.Observability(cfg.Logger, cfg.Metrics, paths),
+			cacheMiddleware,
+			siteLockMiddleware,
 			middleware.RateLimit(middleware.RateLimitConfig{
-				Limiter: rateLimiter,
-				Logger:  cfg.Logger,
-				Metrics: cfg.Metrics,
+				Limiter:          rateLimiter,
+				Selector:         rateLimiterSelector,
+				Logger:           cfg.Logger,
+				Metrics:          cfg.Metrics,
+				WaitPastDeadline: cfg.WaitPastDeadline,
 			}),
 			middleware.Retry(middleware.RetryConfig{
-				MaxRetries:  cfg.MaxRetries,
-				InitialWait: cfg.RetryWaitTime,
-				Logger:      cfg.Logger,
-				Metrics:     cfg.Metrics,
+				MaxRetries:        cfg.MaxRetries,
+				InitialWait:       cfg.RetryWaitTime,
+				MaxWait:           cfg.RetryMaxWaitTime,
+				Jitter:            cfg.RetryJitter,
+				Logger:            cfg.Logger,
+				Metrics:           cfg.Metrics,
+				OnRetryDecision:   cfg.OnRetryDecision,
+				DetectMaintenance: cfg.DetectMaintenance,
+				Budget:            ratelimit.NewRetryBudget(cfg.RetryBudgetPerMinute),
+			}),
+			v2PathMiddleware,
+			faultMiddleware,
+			middleware.TLSConfig(&tls.Config{
+				InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // User-configurable
+				RootCAs:            cfg.RootCAs,
+			}),
+			middleware.Timeouts(middleware.TransportTimeouts{
+				Dial:           cfg.DialTimeout,
+				TLSHandshake:   cfg.TLSHandshakeTimeout,
+				ResponseHeader: cfg.ResponseHeaderTimeout,
 			}),
 		),
 	)
 
 	// Build base URL (paths like /integration/v1/sites are added by generated client)
-	baseURL := cfg.ControllerURL + "/proxy/network"
+	baseURL := cfg.ControllerURL + NetworkBasePath
+	parsedBaseURL, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid controller URL")
+	}
 
 	// Create request editor to add API key and Accept headers
 	requestEditor := func(_ context.Context, req *http.Request) error {
-		req.Header.Set("X-API-KEY", cfg.APIKey)
+		req.Header.Set(APIKeyHeader, cfg.APIKey)
 		req.Header.Set("Accept", "application/json")
 		return nil
 	}
@@ -182,101 +512,305 @@ func NewWithConfig(cfg *ClientConfig) (*APIClient, error) {
 		return nil, errors.Wrap(err, "failed to create API client")
 	}
 
-	return &APIClient{
-		client: generatedClient,
-	}, nil
+	apiClient := &APIClient{
+		client:     generatedClient,
+		decoder:    newDecoder(cfg),
+		cache:      cache,
+		paths:      paths,
+		httpClient: httpClient.HTTPClient(),
+		downloader: &http.Client{Transport: httpClient.HTTPClient().Transport},
+		baseURL:    parsedBaseURL,
+		apiKey:     cfg.APIKey,
+		logger:     cfg.Logger,
+		logDiffs:   cfg.LogUpdateDiffs,
+
+		filterUnsupported: &atomic.Bool{},
+		ruleGroups:        &trafficRuleBindings{},
+	}
+	if apiClient.logger == nil {
+		apiClient.logger = observability.NoopLogger()
+	}
+	sites.client = apiClient
+	apiClient.sites = sites
+	v2Paths.client = apiClient
+	apiClient.v2Paths = v2Paths
+
+	return apiClient, nil
+}
+
+// newDecoder builds the response decoder from the decoding options in cfg.
+func newDecoder(cfg *ClientConfig) *response.Decoder {
+	dec := &response.Decoder{
+		Mode:      cfg.StrictDecoding,
+		Logger:    cfg.Logger,
+		Lenient:   cfg.LenientDecoding,
+		Unmarshal: cfg.JSONUnmarshal,
+	}
+	if cfg.RetainRawJSON {
+		dec.OnDecoded = retainRawJSON
+	}
+	return dec
+}
+
+// CachedPaths returns the request paths in the normalized-path cache: the KnownPaths,
+// then the others from most to least recently used. Passing them as
+// ClientConfig.KnownPaths on the next start keeps the paths of regular requests
+// cached however many one-off paths are requested.
+func (c *APIClient) CachedPaths() []string {
+	return c.paths.Paths()
 }
 
 // ListSites retrieves a list of all sites configured on the controller.
 func (c *APIClient) ListSites(ctx context.Context, params *ListSitesParams) (*SitesResponse, error) {
+	ctx = middleware.WithOperation(ctx, "ListSites")
 	resp, err := c.client.ListSitesWithResponse(ctx, params)
 	var data *SitesResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to list sites")
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list sites")
 }
 
 // ListSiteDevices retrieves a list of all devices for a specific site.
+// With ClientConfig.LenientDecoding, devices that fail to decode are skipped and
+// reported in the Warnings field of the response.
 func (c *APIClient) ListSiteDevices(ctx context.Context, siteID SiteId, params *ListSiteDevicesParams) (*DevicesResponse, error) {
-	resp, err := c.client.ListSiteDevicesWithResponse(ctx, siteID, params)
+	ctx = middleware.WithOperation(ctx, "ListSiteDevices")
+	err := validateUUID("site", siteID)
+	if err != nil {
+		return nil, err
+	}
+	errorMsg := fmt.Sprintf("failed to list devices for site %s", siteID)
+	raw, err := c.client.ListSiteDevices(ctx, siteID, params)
+	resp, warnings, err := response.ParseList[DevicesResponse](c.decoder, raw, err, errorMsg)
 	var data *DevicesResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
+	}
+	devices, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to list devices for site %s", siteID))
+	devices.Warnings = warnings
+	return devices, nil
 }
 
 // GetDeviceByID retrieves detailed information about a specific device.
 func (c *APIClient) GetDeviceByID(ctx context.Context, siteID SiteId, deviceID DeviceId) (*Device, error) {
+	ctx = middleware.WithOperation(ctx, "GetDeviceByID")
+	err := errors.Join(validateUUID("site", siteID), validateUUID("device", deviceID))
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetDeviceByIdWithResponse(ctx, siteID, deviceID)
 	var data *Device
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to get device %s in site %s", deviceID, siteID))
+}
+
+// GetDeviceNeighbors retrieves the LLDP/CDP neighbors seen on the ports of a device.
+//
+// Neighbor tables are only exposed by the legacy API, so the device is looked up
+// first to find its MAC address. Devices without neighbors return an empty slice.
+func (c *APIClient) GetDeviceNeighbors(ctx context.Context, siteID SiteId, deviceID DeviceId) ([]LLDPNeighbor, error) {
+	ctx = middleware.WithOperation(ctx, "GetDeviceNeighbors")
+	device, err := c.getLegacyDevice(ctx, siteID, deviceID, "failed to get neighbors of device")
+	if err != nil {
+		return nil, err
+	}
+	if device.LLDPTable == nil {
+		return []LLDPNeighbor{}, nil
+	}
+	return *device.LLDPTable, nil
+}
+
+// GetPortStates retrieves the link, spanning tree and error counter state of every
+// port of a device. Devices without ports return an empty slice.
+func (c *APIClient) GetPortStates(ctx context.Context, siteID SiteId, deviceID DeviceId) ([]SwitchPortState, error) {
+	ctx = middleware.WithOperation(ctx, "GetPortStates")
+	device, err := c.getLegacyDevice(ctx, siteID, deviceID, "failed to get port states of device")
+	if err != nil {
+		return nil, err
+	}
+	if device.PortTable == nil {
+		return []SwitchPortState{}, nil
+	}
+	return *device.PortTable, nil
+}
+
+// getLegacyDevice retrieves the legacy statistics record of a device identified by
+// its Integration API IDs, resolving its MAC address and the site internal reference.
+func (c *APIClient) getLegacyDevice(ctx context.Context, siteID SiteId, deviceID DeviceId, action string) (*LegacyDevice, error) {
+	device, err := c.GetDeviceByID(ctx, siteID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	site, err := c.sites.InternalReference(ctx, siteID.String())
+	if err != nil {
+		return nil, err
+	}
+	mac, err := NormalizeMAC(device.MacAddress)
+	if err != nil {
+		return nil, err
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to get device %s in site %s", deviceID, siteID))
+
+	return c.legacyDeviceByMAC(ctx, site, mac, fmt.Sprintf("%s %s in site %s", action, deviceID, siteID))
 }
 
 // ListSiteClients retrieves a list of all clients for a specific site.
+// With ClientConfig.LenientDecoding, clients that fail to decode are skipped and
+// reported in the Warnings field of the response.
 func (c *APIClient) ListSiteClients(ctx context.Context, siteID SiteId, params *ListSiteClientsParams) (*ClientsResponse, error) {
-	resp, err := c.client.ListSiteClientsWithResponse(ctx, siteID, params)
+	ctx = middleware.WithOperation(ctx, "ListSiteClients")
+	err := validateUUID("site", siteID)
+	if err != nil {
+		return nil, err
+	}
+	errorMsg := fmt.Sprintf("failed to list clients for site %s", siteID)
+	raw, err := c.client.ListSiteClients(ctx, siteID, params)
+	resp, warnings, err := response.ParseList[ClientsResponse](c.decoder, raw, err, errorMsg)
 	var data *ClientsResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
+	}
+	clients, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to list clients for site %s", siteID))
+	clients.Warnings = warnings
+	return clients, nil
 }
 
 // GetClientByID retrieves detailed information about a specific client.
 func (c *APIClient) GetClientByID(ctx context.Context, siteID SiteId, clientID ClientId) (*NetworkClient, error) {
+	ctx = middleware.WithOperation(ctx, "GetClientByID")
+	err := errors.Join(validateUUID("site", siteID), validateUUID("client", clientID))
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetClientByIdWithResponse(ctx, siteID, clientID)
 	var data *NetworkClient
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to get client %s in site %s", clientID, siteID))
+}
+
+// BlockClient blocks the client with the given MAC address from connecting to the site.
+func (c *APIClient) BlockClient(ctx context.Context, site Site, mac string) error {
+	ctx = middleware.WithOperation(ctx, "BlockClient")
+	return c.executeClientCommand(ctx, site, ClientCommandBlock, mac)
+}
+
+// UnblockClient allows a previously blocked client to connect to the site again.
+func (c *APIClient) UnblockClient(ctx context.Context, site Site, mac string) error {
+	ctx = middleware.WithOperation(ctx, "UnblockClient")
+	return c.executeClientCommand(ctx, site, ClientCommandUnblock, mac)
+}
+
+// KickClient disconnects a wireless client from its access point. The client
+// reassociates on its own, so this forces a roaming decision without blocking it.
+func (c *APIClient) KickClient(ctx context.Context, site Site, mac string) error {
+	ctx = middleware.WithOperation(ctx, "KickClient")
+	return c.executeClientCommand(ctx, site, ClientCommandKick, mac)
+}
+
+// UnauthorizeGuest revokes the hotspot authorization of the guest with the given MAC
+// address, e.g. one granted by a voucher, so that the guest is sent back to the portal.
+func (c *APIClient) UnauthorizeGuest(ctx context.Context, site Site, mac string) error {
+	ctx = middleware.WithOperation(ctx, "UnauthorizeGuest")
+	return c.executeClientCommand(ctx, site, ClientCommandUnauthorizeGuest, mac)
+}
+
+func (c *APIClient) executeClientCommand(ctx context.Context, site Site, cmd ClientCommandRequestCmd, mac string) error {
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return err
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to get client %s in site %s", clientID, siteID))
+
+	resp, err := c.client.ExecuteClientCommandWithResponse(ctx, site, ClientCommandRequest{Cmd: cmd, Mac: mac})
+	//nolint:wrapcheck // response.HandleNoContent wraps errors internally
+	return response.HandleNoContent(resp, err, fmt.Sprintf("failed to execute %s for client %s in site %s", cmd, mac, site))
 }
 
 // ListHotspotVouchers retrieves a list of all hotspot vouchers for a specific site.
 func (c *APIClient) ListHotspotVouchers(ctx context.Context, siteID SiteId, params *ListHotspotVouchersParams) (*HotspotVouchersResponse, error) {
+	ctx = middleware.WithOperation(ctx, "ListHotspotVouchers")
+	err := validateUUID("site", siteID)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.client.ListHotspotVouchersWithResponse(ctx, siteID, params)
 	var data *HotspotVouchersResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to list hotspot vouchers for site %s", siteID))
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to list hotspot vouchers for site %s", siteID))
 }
 
 // CreateHotspotVouchers creates one or more hotspot vouchers for temporary guest access.
 func (c *APIClient) CreateHotspotVouchers(ctx context.Context, siteID SiteId, request *CreateVouchersRequest) (*HotspotVouchersResponse, error) {
+	ctx = middleware.WithOperation(ctx, "CreateHotspotVouchers")
+	err := validateUUID("site", siteID)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.client.CreateHotspotVouchersWithResponse(ctx, siteID, *request)
 	var data *HotspotVouchersResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to create hotspot vouchers for site %s", siteID))
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to create hotspot vouchers for site %s", siteID))
 }
 
 // GetHotspotVoucher retrieves detailed information about a specific hotspot voucher.
 func (c *APIClient) GetHotspotVoucher(ctx context.Context, siteID SiteId, voucherID openapi_types.UUID) (*HotspotVoucher, error) {
+	ctx = middleware.WithOperation(ctx, "GetHotspotVoucher")
+	err := errors.Join(validateUUID("site", siteID), validateUUID("voucher", voucherID))
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetHotspotVoucherWithResponse(ctx, siteID, voucherID)
 	var data *HotspotVoucher
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to get hotspot voucher %s in site %s", voucherID, siteID))
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to get hotspot voucher %s in site %s", voucherID, siteID))
 }
 
 // DeleteHotspotVoucher permanently deletes a hotspot voucher.
 func (c *APIClient) DeleteHotspotVoucher(ctx context.Context, siteID SiteId, voucherID openapi_types.UUID) error {
+	ctx = middleware.WithOperation(ctx, "DeleteHotspotVoucher")
+	err := errors.Join(validateUUID("site", siteID), validateUUID("voucher", voucherID))
+	if err != nil {
+		return err
+	}
 	resp, err := c.client.DeleteHotspotVoucherWithResponse(ctx, siteID, voucherID)
 	//nolint:wrapcheck // response.HandleNoContent wraps errors internally
 	return response.HandleNoContent(resp, err, fmt.Sprintf("failed to delete hotspot voucher %s in site %s", voucherID, siteID))
@@ -284,43 +818,105 @@ func (c *APIClient) DeleteHotspotVoucher(ctx context.Context, siteID SiteId, vou
 
 // ListDNSRecords lists all static DNS records for a site.
 func (c *APIClient) ListDNSRecords(ctx context.Context, site Site) ([]DNSRecord, error) {
+	ctx = middleware.WithOperation(ctx, "ListDNSRecords")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.ListDNSRecordsWithResponse(ctx, site)
 	var dataPtr *[]DNSRecord
+	var body []byte
 	if resp != nil {
 		dataPtr = resp.JSON200
+		body = resp.Body
 	}
-	data, err := response.Handle(resp, dataPtr, err, "failed to list DNS records for site "+site)
+	data, err := response.HandleDecoded(c.decoder, resp, body, dataPtr, err, "failed to list DNS records for site "+site)
 	if err != nil {
-		//nolint:wrapcheck // err is already wrapped by response.Handle
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
 		return nil, err
 	}
 	return *data, nil
 }
 
 // CreateDNSRecord creates a new static DNS record.
+// The record is validated first; see DNSRecordInput.Validate.
 func (c *APIClient) CreateDNSRecord(ctx context.Context, site Site, record *DNSRecordInput) (*DNSRecord, error) {
+	ctx = middleware.WithOperation(ctx, "CreateDNSRecord")
+	err := record.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.CreateDNSRecordWithResponse(ctx, site, *record)
 	var data *DNSRecord
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to create DNS record %s in site %s", record.Key, site))
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to create DNS record %s in site %s", record.Key, site))
 }
 
 // UpdateDNSRecord updates an existing DNS record.
+// The record is validated first; see DNSRecordInput.Validate.
+// With ClientConfig.LogUpdateDiffs, the changed fields are logged; with
+// WithExpectedRevision, concurrent changes are detected.
 func (c *APIClient) UpdateDNSRecord(ctx context.Context, site Site, recordID RecordId, record *DNSRecordInput) (*DNSRecord, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateDNSRecord")
+	err := validateObjectID("DNS record", recordID)
+	if err != nil {
+		return nil, err
+	}
+	err = record.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := beginUpdate(ctx, c, "UpdateDNSRecord", "DNS record", site, recordID, c.ListDNSRecords, func(v *DNSRecord) string { return v.UnderscoreId })
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.UpdateDNSRecordWithResponse(ctx, site, recordID, *record)
 	var data *DNSRecord
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
+	}
+	updated, err := response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to update DNS record %s in site %s", recordID, site))
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to update DNS record %s in site %s", recordID, site))
+	diff.log(c.logger, record)
+	return updated, nil
 }
 
 // DeleteDNSRecord deletes a DNS record.
 func (c *APIClient) DeleteDNSRecord(ctx context.Context, site Site, recordID RecordId) error {
+	ctx = middleware.WithOperation(ctx, "DeleteDNSRecord")
+	err := validateObjectID("DNS record", recordID)
+	if err != nil {
+		return err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
 	resp, err := c.client.DeleteDNSRecordWithResponse(ctx, site, recordID)
 	//nolint:wrapcheck // response.HandleNoContent wraps errors internally
 	return response.HandleNoContent(resp, err, fmt.Sprintf("failed to delete DNS record %s in site %s", recordID, site))
@@ -328,99 +924,1001 @@ func (c *APIClient) DeleteDNSRecord(ctx context.Context, site Site, recordID Rec
 
 // ListFirewallPolicies lists all firewall policies for a site.
 func (c *APIClient) ListFirewallPolicies(ctx context.Context, site Site) ([]FirewallPolicy, error) {
+	ctx = middleware.WithOperation(ctx, "ListFirewallPolicies")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.ListFirewallPoliciesWithResponse(ctx, site)
 	var dataPtr *[]FirewallPolicy
+	var body []byte
 	if resp != nil {
 		dataPtr = resp.JSON200
+		body = resp.Body
 	}
-	data, err := response.Handle(resp, dataPtr, err, "failed to list firewall policies for site "+site)
+	data, err := response.HandleDecoded(c.decoder, resp, body, dataPtr, err, "failed to list firewall policies for site "+site)
 	if err != nil {
-		//nolint:wrapcheck // err is already wrapped by response.Handle
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
 		return nil, err
 	}
 	return *data, nil
 }
 
 // UpdateFirewallPolicy updates an existing firewall policy.
+// A schedule, if set, is validated first; see PolicySchedule.Validate.
+// With ClientConfig.LogUpdateDiffs, the changed fields are logged; with
+// WithExpectedRevision, concurrent changes are detected.
 func (c *APIClient) UpdateFirewallPolicy(ctx context.Context, site Site, policyID PolicyId, policy *FirewallPolicyInput) (*FirewallPolicy, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateFirewallPolicy")
+	err := validateObjectID("firewall policy", policyID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.Schedule != nil {
+		err := policy.Schedule.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := beginUpdate(ctx, c, "UpdateFirewallPolicy", "firewall policy", site, policyID, c.ListFirewallPolicies, func(v *FirewallPolicy) string { return v.UnderscoreId })
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.UpdateFirewallPolicyWithResponse(ctx, site, policyID, *policy)
 	var data *FirewallPolicy
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to update firewall policy %s in site %s", policyID, site))
+	updated, err := response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to update firewall policy %s in site %s", policyID, site))
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	diff.log(c.logger, policy)
+	return updated, nil
 }
 
 // CreateFirewallPolicy creates a new firewall policy.
+// A schedule, if set, is validated first; see PolicySchedule.Validate.
 func (c *APIClient) CreateFirewallPolicy(ctx context.Context, site Site, policy *FirewallPolicyInput) (*FirewallPolicy, error) {
+	ctx = middleware.WithOperation(ctx, "CreateFirewallPolicy")
+	if policy.Schedule != nil {
+		err := policy.Schedule.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.CreateFirewallPolicyWithResponse(ctx, site, *policy)
 	var data *FirewallPolicy
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to create firewall policy in site "+site)
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to create firewall policy in site "+site)
 }
 
 // DeleteFirewallPolicy permanently deletes a firewall policy.
 func (c *APIClient) DeleteFirewallPolicy(ctx context.Context, site Site, policyID PolicyId) error {
+	ctx = middleware.WithOperation(ctx, "DeleteFirewallPolicy")
+	err := validateObjectID("firewall policy", policyID)
+	if err != nil {
+		return err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
 	resp, err := c.client.DeleteFirewallPolicyWithResponse(ctx, site, policyID)
 	//nolint:wrapcheck // response.HandleNoContent wraps errors internally
 	return response.HandleNoContent(resp, err, fmt.Sprintf("failed to delete firewall policy %s in site %s", policyID, site))
 }
 
+// ListFirewallZones lists the firewall zones of a site, including the built-in zones.
+func (c *APIClient) ListFirewallZones(ctx context.Context, site Site) ([]FirewallZone, error) {
+	ctx = middleware.WithOperation(ctx, "ListFirewallZones")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListFirewallZonesWithResponse(ctx, site)
+	var dataPtr *[]FirewallZone
+	var body []byte
+	if resp != nil {
+		dataPtr = resp.JSON200
+		body = resp.Body
+	}
+	data, err := response.HandleDecoded(c.decoder, resp, body, dataPtr, err, "failed to list firewall zones for site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return *data, nil
+}
+
 // ListTrafficRules lists all traffic rules for a site.
 func (c *APIClient) ListTrafficRules(ctx context.Context, site Site) ([]TrafficRule, error) {
+	ctx = middleware.WithOperation(ctx, "ListTrafficRules")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.ListTrafficRulesWithResponse(ctx, site)
 	var dataPtr *[]TrafficRule
+	var body []byte
 	if resp != nil {
 		dataPtr = resp.JSON200
+		body = resp.Body
 	}
-	data, err := response.Handle(resp, dataPtr, err, "failed to list traffic rules for site "+site)
+	data, err := response.HandleDecoded(c.decoder, resp, body, dataPtr, err, "failed to list traffic rules for site "+site)
 	if err != nil {
-		//nolint:wrapcheck // err is already wrapped by response.Handle
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
 		return nil, err
 	}
 	return *data, nil
 }
 
 // UpdateTrafficRule updates an existing traffic rule.
+// With ClientConfig.LogUpdateDiffs, the changed fields are logged; with
+// WithExpectedRevision, concurrent changes are detected.
 func (c *APIClient) UpdateTrafficRule(ctx context.Context, site Site, ruleID RuleId, rule *TrafficRuleInput) (*TrafficRule, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateTrafficRule")
+	err := validateObjectID("traffic rule", ruleID)
+	if err != nil {
+		return nil, err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := beginUpdate(ctx, c, "UpdateTrafficRule", "traffic rule", site, ruleID, c.ListTrafficRules, func(v *TrafficRule) string { return v.UnderscoreId })
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.UpdateTrafficRuleWithResponse(ctx, site, ruleID, *rule)
 	var data *TrafficRule
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
+	}
+	updated, err := response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to update traffic rule %s in site %s", ruleID, site))
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to update traffic rule %s in site %s", ruleID, site))
+	diff.log(c.logger, rule)
+	return updated, nil
 }
 
 // CreateTrafficRule creates a new traffic rule.
 func (c *APIClient) CreateTrafficRule(ctx context.Context, site Site, rule *TrafficRuleInput) (*TrafficRule, error) {
+	ctx = middleware.WithOperation(ctx, "CreateTrafficRule")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.CreateTrafficRuleWithResponse(ctx, site, *rule)
 	var data *TrafficRule
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to create traffic rule in site "+site)
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to create traffic rule in site "+site)
 }
 
 // DeleteTrafficRule permanently deletes a traffic rule.
 func (c *APIClient) DeleteTrafficRule(ctx context.Context, site Site, ruleID RuleId) error {
-	resp, err := c.client.DeleteTrafficRuleWithResponse(ctx, site, ruleID)
-	//nolint:wrapcheck // response.HandleNoContent wraps errors internally
-	return response.HandleNoContent(resp, err, fmt.Sprintf("failed to delete traffic rule %s in site %s", ruleID, site))
-}
+	ctx = middleware.WithOperation(ctx, "DeleteTrafficRule")
+	err := validateObjectID("traffic rule", ruleID)
+	if err != nil {
+		return err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.DeleteTrafficRuleWithResponse(ctx, site, ruleID)
+	err = response.HandleNoContent(resp, err, fmt.Sprintf("failed to delete traffic rule %s in site %s", ruleID, site))
+	if err != nil {
+		//nolint:wrapcheck // response.HandleNoContent wraps errors internally
+		return err
+	}
+	c.ruleGroups.unbind(ruleID)
+	return nil
+}
 
 // GetAggregatedDashboard retrieves aggregated dashboard statistics.
 func (c *APIClient) GetAggregatedDashboard(ctx context.Context, site Site, params *GetAggregatedDashboardParams) (*AggregatedDashboard, error) {
+	ctx = middleware.WithOperation(ctx, "GetAggregatedDashboard")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.GetAggregatedDashboardWithResponse(ctx, site, params)
 	var data *AggregatedDashboard
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get aggregated dashboard for site "+site)
+}
+
+// ListAdminActivityLog retrieves one page of the controller's admin activity (audit) log.
+// Use NewAdminActivityLogRequest to filter by time range.
+func (c *APIClient) ListAdminActivityLog(ctx context.Context, site Site, request *AdminActivityLogRequest) (*AdminActivityLogResponse, error) {
+	ctx = middleware.WithOperation(ctx, "ListAdminActivityLog")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListAdminActivityLogWithResponse(ctx, site, *request)
+	var data *AdminActivityLogResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list admin activity log for site "+site)
+}
+
+// ListUserGroups lists all user groups (bandwidth profiles) for a site.
+func (c *APIClient) ListUserGroups(ctx context.Context, site Site) ([]UserGroup, error) {
+	ctx = middleware.WithOperation(ctx, "ListUserGroups")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListUserGroupsWithResponse(ctx, site)
+	var data *UserGroupsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	groups, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list user groups for site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return groups.Data, nil
+}
+
+// CreateUserGroup creates a new user group. Use NewUserGroupInput to build group from typed rate limits.
+func (c *APIClient) CreateUserGroup(ctx context.Context, site Site, group *UserGroupInput) (*UserGroup, error) {
+	ctx = middleware.WithOperation(ctx, "CreateUserGroup")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := fmt.Sprintf("failed to create user group %s in site %s", group.Name, site)
+	resp, err := c.client.CreateUserGroupWithResponse(ctx, site, *group)
+	var data *UserGroupsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	groups, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return firstUserGroup(groups, errorMsg)
+}
+
+// UpdateUserGroup updates the name and rate limits of an existing user group.
+func (c *APIClient) UpdateUserGroup(ctx context.Context, site Site, groupID UserGroupId, group *UserGroupInput) (*UserGroup, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateUserGroup")
+	err := validateObjectID("user group", groupID)
+	if err != nil {
+		return nil, err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := fmt.Sprintf("failed to update user group %s in site %s", groupID, site)
+	resp, err := c.client.UpdateUserGroupWithResponse(ctx, site, groupID, *group)
+	var data *UserGroupsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	groups, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return firstUserGroup(groups, errorMsg)
+}
+
+// DeleteUserGroup deletes a user group. Clients assigned to it fall back to the site default group.
+func (c *APIClient) DeleteUserGroup(ctx context.Context, site Site, groupID UserGroupId) error {
+	ctx = middleware.WithOperation(ctx, "DeleteUserGroup")
+	err := validateObjectID("user group", groupID)
+	if err != nil {
+		return err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.DeleteUserGroupWithResponse(ctx, site, groupID)
+	//nolint:wrapcheck // response.HandleNoContent wraps errors internally
+	return response.HandleNoContent(resp, err, fmt.Sprintf("failed to delete user group %s in site %s", groupID, site))
+}
+
+// AssignClientToUserGroup assigns the client with the given MAC address to a user group,
+// applying the group's rate limits to it. The client must have connected to the site before.
+func (c *APIClient) AssignClientToUserGroup(ctx context.Context, site Site, mac string, groupID UserGroupId) error {
+	ctx = middleware.WithOperation(ctx, "AssignClientToUserGroup")
+	err := validateObjectID("user group", groupID)
+	if err != nil {
+		return err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	mac, err = NormalizeMAC(mac)
+	if err != nil {
+		return err
+	}
+
+	errorMsg := fmt.Sprintf("failed to assign client %s to user group %s in site %s", mac, groupID, site)
+	known, err := c.getKnownClient(ctx, site, mac, errorMsg)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.UpdateKnownClientWithResponse(ctx, site, known.Id, KnownClientInput{UsergroupId: groupID})
+	err = response.HandleNoContent(resp, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // response.HandleNoContent wraps errors internally
+		return err
+	}
+
+	// Rules bound to the previous or the new group of the client follow the change
+	_, err = c.syncTrafficRules(ctx, site, c.ruleGroups.inSite(site))
+	if err != nil {
+		return errors.Wrapf(err, "client %s assigned to user group %s, but its traffic rules are out of sync", mac, groupID)
+	}
+	return nil
+}
+
+// getKnownClient retrieves the stored configuration of a client by its normalized
+// MAC address in a resolved site.
+func (c *APIClient) getKnownClient(ctx context.Context, site Site, mac, errorMsg string) (*KnownClient, error) {
+	resp, err := c.client.GetKnownClientWithResponse(ctx, site, mac)
+	var data *KnownClientsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	known, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	if len(known.Data) == 0 {
+		return nil, errors.Wrap(unifierr.ErrNotFound, errorMsg)
+	}
+	return &known.Data[0], nil
+}
+
+// ListKnownClients lists the stored configuration of every client known to a site,
+// including offline clients and fixed IP reservations.
+func (c *APIClient) ListKnownClients(ctx context.Context, site Site) ([]KnownClient, error) {
+	ctx = middleware.WithOperation(ctx, "ListKnownClients")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListKnownClientsWithResponse(ctx, site)
+	var data *KnownClientsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	known, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list known clients in site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return known.Data, nil
+}
+
+// ListClientSessions retrieves the connection history of a site: one session per client
+// association. Use NewClientSessionsRequest to filter by time range.
+func (c *APIClient) ListClientSessions(ctx context.Context, site Site, request *ClientSessionsRequest) ([]ClientSession, error) {
+	ctx = middleware.WithOperation(ctx, "ListClientSessions")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListClientSessionsWithResponse(ctx, site, *request)
+	var data *ClientSessionsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	sessions, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list client sessions in site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return sessions.Data, nil
+}
+
+// ListNetworks lists all networks (LANs, VLANs and WANs) configured on a site.
+func (c *APIClient) ListNetworks(ctx context.Context, site Site) ([]NetworkConf, error) {
+	ctx = middleware.WithOperation(ctx, "ListNetworks")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListNetworksWithResponse(ctx, site)
+	var data *NetworkConfsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	networks, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list networks in site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return networks.Data, nil
+}
+
+// ListSiteToSiteVPNs lists the manual site-to-site IPsec VPNs of a site: the networks
+// with the site-vpn purpose and the ipsec-vpn type. Pre-shared keys are not returned.
+func (c *APIClient) ListSiteToSiteVPNs(ctx context.Context, site Site) ([]SiteToSiteVPN, error) {
+	ctx = middleware.WithOperation(ctx, "ListSiteToSiteVPNs")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := "failed to list site-to-site VPNs in site " + site
+	raw, err := c.client.ListNetworks(ctx, site)
+	resp, _, err := response.ParseList[SiteToSiteVPNsResponse](c.decoder, raw, err, errorMsg)
+	var data *SiteToSiteVPNsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	networks, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+
+	vpns := make([]SiteToSiteVPN, 0, len(networks.Data))
+	for _, network := range networks.Data {
+		if network.isSiteToSiteVPN() {
+			vpns = append(vpns, network)
+		}
+	}
+	return vpns, nil
+}
+
+// CreateSiteToSiteVPN creates a manual site-to-site IPsec VPN. Use NewSiteToSiteVPNInput
+// to build vpn from typed phase 1 and phase 2 parameters. The pre-shared key is
+// required; invalid fields fail the call with an error matching unifierr.ErrValidation.
+func (c *APIClient) CreateSiteToSiteVPN(ctx context.Context, site Site, vpn *SiteToSiteVPNInput) (*SiteToSiteVPN, error) {
+	ctx = middleware.WithOperation(ctx, "CreateSiteToSiteVPN")
+	request, err := siteToSiteVPNRequest(vpn, true)
+	if err != nil {
+		return nil, err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := fmt.Sprintf("failed to create site-to-site VPN %s in site %s", vpn.Name, site)
+	resp, err := c.client.CreateSiteToSiteVPNWithResponse(ctx, site, request)
+	var data *SiteToSiteVPNsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	vpns, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return firstSiteToSiteVPN(vpns, errorMsg)
+}
+
+// UpdateSiteToSiteVPN updates a manual site-to-site IPsec VPN. Fields left unset keep
+// their value; in particular, the pre-shared key is only changed when vpn sets one.
+func (c *APIClient) UpdateSiteToSiteVPN(ctx context.Context, site Site, networkID NetworkId, vpn *SiteToSiteVPNInput) (*SiteToSiteVPN, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateSiteToSiteVPN")
+	err := validateObjectID("network", networkID)
+	if err != nil {
+		return nil, err
+	}
+	request, err := siteToSiteVPNRequest(vpn, false)
+	if err != nil {
+		return nil, err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := fmt.Sprintf("failed to update site-to-site VPN %s in site %s", networkID, site)
+	resp, err := c.client.UpdateSiteToSiteVPNWithResponse(ctx, site, networkID, request)
+	var data *SiteToSiteVPNsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	vpns, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return firstSiteToSiteVPN(vpns, errorMsg)
+}
+
+// DeleteSiteToSiteVPN deletes a manual site-to-site IPsec VPN and tears down its tunnel.
+// The controller deletes any network by ID, so pass only IDs of ListSiteToSiteVPNs.
+func (c *APIClient) DeleteSiteToSiteVPN(ctx context.Context, site Site, networkID NetworkId) error {
+	ctx = middleware.WithOperation(ctx, "DeleteSiteToSiteVPN")
+	err := validateObjectID("network", networkID)
+	if err != nil {
+		return err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.DeleteSiteToSiteVPNWithResponse(ctx, site, networkID)
+	//nolint:wrapcheck // response.HandleNoContent wraps errors internally
+	return response.HandleNoContent(resp, err, fmt.Sprintf("failed to delete site-to-site VPN %s in site %s", networkID, site))
+}
+
+// ListWLANs lists all wireless networks (SSIDs) configured on a site.
+func (c *APIClient) ListWLANs(ctx context.Context, site Site) ([]WLAN, error) {
+	ctx = middleware.WithOperation(ctx, "ListWLANs")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListWLANsWithResponse(ctx, site)
+	var data *WLANsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	wlans, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list WLANs in site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return wlans.Data, nil
+}
+
+// GetWLANMACFilter retrieves the MAC address filter of a WLAN.
+func (c *APIClient) GetWLANMACFilter(ctx context.Context, site Site, wlanID WLANId) (*WLANMACFilter, error) {
+	ctx = middleware.WithOperation(ctx, "GetWLANMACFilter")
+	err := validateObjectID("WLAN", wlanID)
+	if err != nil {
+		return nil, err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	wlan, err := c.getWLAN(ctx, site, wlanID, "failed to get MAC filter of WLAN")
+	if err != nil {
+		return nil, err
+	}
+
+	filter := wlan.MACFilter()
+	return &filter, nil
+}
+
+// getWLAN retrieves a WLAN of a site given by its internal reference.
+// action describes the operation in error messages, e.g. "failed to get WLAN".
+func (c *APIClient) getWLAN(ctx context.Context, site Site, wlanID WLANId, action string) (*WLAN, error) {
+	errorMsg := fmt.Sprintf("%s %s in site %s", action, wlanID, site)
+	resp, err := c.client.GetWLANWithResponse(ctx, site, wlanID)
+	var data *WLANsResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
+	}
+	wlans, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	if len(wlans.Data) == 0 {
+		return nil, errors.Wrap(unifierr.ErrNotFound, errorMsg)
+	}
+	return &wlans.Data[0], nil
+}
+
+// UpdateWLANMACFilter replaces the MAC address filter of a WLAN and returns the filter
+// the controller stored. MAC addresses are normalized before they are sent; an invalid
+// address fails the call with ErrInvalidMAC without changing the WLAN.
+func (c *APIClient) UpdateWLANMACFilter(ctx context.Context, site Site, wlanID WLANId, filter *WLANMACFilter) (*WLANMACFilter, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateWLANMACFilter")
+	err := validateObjectID("WLAN", wlanID)
+	if err != nil {
+		return nil, err
+	}
+	macs := make([]string, 0, len(filter.MACs))
+	for _, mac := range filter.MACs {
+		normalized, err := NormalizeMAC(mac)
+		if err != nil {
+			return nil, err
+		}
+		macs = append(macs, normalized)
+	}
+
+	wlan, err := c.updateWLAN(ctx, site, wlanID, &WLANInput{
+		MACFilterEnabled: &filter.Enabled,
+		MACFilterPolicy:  &filter.Policy,
+		MACFilterList:    &macs,
+	}, "failed to update MAC filter of WLAN")
+	if err != nil {
+		return nil, err
+	}
+
+	updated := wlan.MACFilter()
+	return &updated, nil
+}
+
+// SetWLANClientIsolation enables or disables client isolation on a WLAN. Isolated
+// wireless clients can reach the gateway but not each other.
+func (c *APIClient) SetWLANClientIsolation(ctx context.Context, site Site, wlanID WLANId, enabled bool) error {
+	ctx = middleware.WithOperation(ctx, "SetWLANClientIsolation")
+	err := validateObjectID("WLAN", wlanID)
+	if err != nil {
+		return err
+	}
+	_, err = c.updateWLAN(ctx, site, wlanID, &WLANInput{ClientIsolation: &enabled}, "failed to set client isolation of WLAN")
+	return err
+}
+
+// updateWLAN applies a partial update to a WLAN and returns the updated WLAN.
+// action describes the operation in error messages, e.g. "failed to update WLAN".
+func (c *APIClient) updateWLAN(ctx context.Context, site Site, wlanID WLANId, input *WLANInput, action string) (*WLAN, error) {
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := fmt.Sprintf("%s %s in site %s", action, wlanID, site)
+	resp, err := c.client.UpdateWLANWithResponse(ctx, site, wlanID, *input)
+	var data *WLANsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	wlans, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	if len(wlans.Data) == 0 {
+		return nil, errors.Wrap(errors.New("empty response from API"), errorMsg)
+	}
+	return &wlans.Data[0], nil
+}
+
+// firstUserGroup returns the single user group returned by create and update calls.
+func firstUserGroup(groups *UserGroupsResponse, errorMsg string) (*UserGroup, error) {
+	if len(groups.Data) == 0 {
+		return nil, errors.Wrap(errors.New("empty response from API"), errorMsg)
+	}
+	return &groups.Data[0], nil
+}
+
+// firstSiteToSiteVPN returns the single site-to-site VPN returned by create and update calls.
+func firstSiteToSiteVPN(vpns *SiteToSiteVPNsResponse, errorMsg string) (*SiteToSiteVPN, error) {
+	if len(vpns.Data) == 0 {
+		return nil, errors.Wrap(errors.New("empty response from API"), errorMsg)
+	}
+	return &vpns.Data[0], nil
+}
+
+// ListAPGroups lists all access point groups of a site.
+func (c *APIClient) ListAPGroups(ctx context.Context, site Site) ([]APGroup, error) {
+	ctx = middleware.WithOperation(ctx, "ListAPGroups")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListAPGroupsWithResponse(ctx, site)
+	var dataPtr *[]APGroup
+	var body []byte
+	if resp != nil {
+		dataPtr = resp.JSON200
+		body = resp.Body
+	}
+	data, err := response.HandleDecoded(c.decoder, resp, body, dataPtr, err, "failed to list AP groups for site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return *data, nil
+}
+
+// CreateAPGroup creates a new access point group.
+func (c *APIClient) CreateAPGroup(ctx context.Context, site Site, group *APGroupInput) (*APGroup, error) {
+	ctx = middleware.WithOperation(ctx, "CreateAPGroup")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.CreateAPGroupWithResponse(ctx, site, *group)
+	var data *APGroup
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to create AP group %s in site %s", group.Name, site))
+}
+
+// UpdateAPGroup replaces the name and members of an existing access point group.
+func (c *APIClient) UpdateAPGroup(ctx context.Context, site Site, groupID APGroupId, group *APGroupInput) (*APGroup, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateAPGroup")
+	err := validateObjectID("AP group", groupID)
+	if err != nil {
+		return nil, err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.UpdateAPGroupWithResponse(ctx, site, groupID, *group)
+	var data *APGroup
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to update AP group %s in site %s", groupID, site))
+}
+
+// DeleteAPGroup deletes an access point group.
+func (c *APIClient) DeleteAPGroup(ctx context.Context, site Site, groupID APGroupId) error {
+	ctx = middleware.WithOperation(ctx, "DeleteAPGroup")
+	err := validateObjectID("AP group", groupID)
+	if err != nil {
+		return err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.DeleteAPGroupWithResponse(ctx, site, groupID)
+	//nolint:wrapcheck // response.HandleNoContent wraps errors internally
+	return response.HandleNoContent(resp, err, fmt.Sprintf("failed to delete AP group %s in site %s", groupID, site))
+}
+
+// GetControllerStatus reports whether the Network application is ready, starting,
+// migrating its database, or updating. Maintenance is reported as a status, not an
+// error, whether or not ClientConfig.DetectMaintenance is set.
+func (c *APIClient) GetControllerStatus(ctx context.Context) (*ControllerStatus, error) {
+	ctx = middleware.WithOperation(ctx, "GetControllerStatus")
+	resp, err := c.client.GetControllerStatusWithResponse(ctx)
+
+	var maintenance *unifierr.MaintenanceError
+	if errors.As(err, &maintenance) {
+		return &ControllerStatus{State: ControllerState(maintenance.State), RetryAfter: maintenance.RetryAfter}, nil
+	}
+	if err == nil && resp.StatusCode() == http.StatusServiceUnavailable {
+		if state := middleware.MaintenanceState(resp.Body); state != "" {
+			return &ControllerStatus{
+				State:      ControllerState(state),
+				RetryAfter: retry.ParseRetryAfter(resp.HTTPResponse.Header.Get("Retry-After")),
+			}, nil
+		}
+	}
+
+	var data *ControllerStatusResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	result, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get controller status")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+
+	status := &ControllerStatus{State: ControllerReady}
+	if result.Meta.Up != nil && !*result.Meta.Up {
+		status.State = ControllerStarting
+	}
+	if result.Meta.ServerVersion != nil {
+		status.Version = *result.Meta.ServerVersion
+	}
+	return status, nil
+}
+
+// GenerateSupportFile makes the controller collect the diagnostics of itself and its
+// devices into a support file, to attach to a ticket with Ubiquiti, and returns the
+// path to fetch it with Download. Generation can take longer than the default
+// ClientConfig.Timeout on large sites.
+func (c *APIClient) GenerateSupportFile(ctx context.Context, site Site) (*SupportFile, error) {
+	ctx = middleware.WithOperation(ctx, "GenerateSupportFile")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := "failed to generate support file for site " + site
+	resp, err := c.client.ExecuteSystemCommandWithResponse(ctx, site, SystemCommandRequest{Cmd: SystemCommandGenerateSupportFile})
+	var data *SupportFileResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	result, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	if len(result.Data) == 0 || result.Data[0].URL == "" {
+		return nil, errors.New(errorMsg + ": no support file in response")
+	}
+	return &result.Data[0], nil
+}
+
+// GetControllerTime reads the controller clock and NTP settings, so monitoring can
+// detect clock drift on consoles. The clock is taken from the Date header of a fresh,
+// uncached response and compared to the local clock, halfway through the request.
+//
+// Example:
+//
+//	clock, err := client.GetControllerTime(ctx, "default")
+//	if err == nil && !clock.InSync(30*time.Second) {
+//	    log.Printf("controller clock is off by %s", clock.Drift)
+//	}
+func (c *APIClient) GetControllerTime(ctx context.Context, site Site) (*ControllerTime, error) {
+	ctx = middleware.WithOperation(ctx, "GetControllerTime")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := "failed to get controller time for site " + site
+	start := time.Now()
+	resp, err := c.client.GetSystemInfoWithResponse(middleware.WithoutCache(ctx), site)
+	roundTrip := time.Since(start)
+	var data *SystemInfoResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	result, err := response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+
+	date, err := http.ParseTime(resp.HTTPResponse.Header.Get("Date"))
+	if err != nil {
+		return nil, errors.Wrap(err, errorMsg+": invalid Date header")
+	}
+
+	// HTTP dates are truncated to the second: compare from the middle of it
+	clock := &ControllerTime{
+		Time:      date,
+		Drift:     date.Add(time.Second / 2).Sub(start.Add(roundTrip / 2)).Round(time.Millisecond),
+		RoundTrip: roundTrip,
+	}
+	if len(result.Data) > 0 && result.Data[0].Timezone != nil {
+		clock.Timezone = *result.Data[0].Timezone
+	}
+
+	ntp, err := c.getNTPStatus(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	clock.NTP = *ntp
+	return clock, nil
+}
+
+// getNTPStatus returns the NTP configuration of the controller.
+func (c *APIClient) getNTPStatus(ctx context.Context, site Site) (*NTPStatus, error) {
+	resp, err := c.client.GetNTPSettingsWithResponse(ctx, site)
+	var data *NTPSettingsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	result, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get NTP settings for site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+
+	status := &NTPStatus{Preference: NTPPreferenceAuto}
+	if len(result.Data) == 0 {
+		return status, nil
+	}
+	settings := result.Data[0]
+	if settings.SettingPreference != nil {
+		status.Preference = *settings.SettingPreference
+	}
+	for _, server := range []*string{settings.NTPServer1, settings.NTPServer2, settings.NTPServer3, settings.NTPServer4} {
+		if server != nil && *server != "" {
+			status.Servers = append(status.Servers, *server)
+		}
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to get aggregated dashboard for site "+site)
+	return status, nil
 }
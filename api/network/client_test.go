@@ -2,14 +2,20 @@ package network
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	unifi "github.com/lexfrei/go-unifi"
 	"github.com/lexfrei/go-unifi/api/network/testdata"
 	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +38,21 @@ const (
 
 var testSiteID = types.UUID{0x88, 0xf7, 0xaf, 0x54, 0x98, 0xf8, 0x30, 0x6a, 0xa1, 0xc7, 0xc9, 0x34, 0x97, 0x22, 0xb1, 0xf6}
 
+// newTestClient creates a client with a short retry wait, keeping tests of
+// retried error responses fast.
+func newTestClient(t *testing.T, serverURL string) *APIClient {
+	t.Helper()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: serverURL,
+		APIKey:        testAPIKey,
+		RetryWaitTime: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	return client
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 
@@ -96,6 +117,28 @@ func TestNewWithConfig(t *testing.T) {
 	}
 }
 
+func TestCachedPaths(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites", testAPIKey,
+		testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	knownPath := "/proxy/network/v2/api/site/default/static-dns"
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		PathCacheSize: 1,
+		KnownPaths:    []string{knownPath},
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListSites(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{knownPath, "/proxy/network/integration/v1/sites"}, client.CachedPaths())
+}
+
 func TestListSites(t *testing.T) {
 	t.Parallel()
 
@@ -151,8 +194,7 @@ func TestListSites(t *testing.T) {
 			server := testutil.NewMockServer(t, expectedPath, testAPIKey, tt.mockResponse, tt.mockStatusCode)
 			defer server.Close()
 
-			client, err := New(server.URL, testAPIKey)
-			require.NoError(t, err)
+			client := newTestClient(t, server.URL)
 
 			resp, err := client.ListSites(context.Background(), nil)
 
@@ -229,8 +271,7 @@ func TestListDNSRecords(t *testing.T) {
 			server := testutil.NewMockServer(t, expectedPath, testAPIKey, tt.mockResponse, tt.mockStatusCode)
 			defer server.Close()
 
-			client, err := New(server.URL, testAPIKey)
-			require.NoError(t, err)
+			client := newTestClient(t, server.URL)
 
 			resp, err := client.ListDNSRecords(context.Background(), testSiteInternal)
 
@@ -496,8 +537,7 @@ func TestListSiteDevices(t *testing.T) {
 			server := testutil.NewMockServer(t, expectedPath, testAPIKey, tt.mockResponse, tt.mockStatusCode)
 			defer server.Close()
 
-			client, err := New(server.URL, testAPIKey)
-			require.NoError(t, err)
+			client := newTestClient(t, server.URL)
 
 			resp, err := client.ListSiteDevices(context.Background(), testSiteID, nil)
 
@@ -626,8 +666,7 @@ func TestListSiteClients(t *testing.T) {
 			server := testutil.NewMockServer(t, expectedPath, testAPIKey, tt.mockResponse, tt.mockStatusCode)
 			defer server.Close()
 
-			client, err := New(server.URL, testAPIKey)
-			require.NoError(t, err)
+			client := newTestClient(t, server.URL)
 
 			resp, err := client.ListSiteClients(context.Background(), testSiteID, nil)
 
@@ -645,6 +684,79 @@ func TestListSiteClients(t *testing.T) {
 	}
 }
 
+func TestListSiteClientsLenient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		lenient bool
+		wantErr bool
+	}{
+		{name: "strict fails on malformed client", wantErr: true},
+		{name: "lenient skips malformed client", lenient: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expectedPath := "/proxy/network/integration/v1/sites/" + testSiteID.String() + "/clients"
+			server := testutil.NewMockServer(t, expectedPath, testAPIKey, testdata.LoadFixture(t, "clients/list_malformed.json"), http.StatusOK)
+			defer server.Close()
+
+			client, err := NewWithConfig(&ClientConfig{
+				ControllerURL:   server.URL,
+				APIKey:          testAPIKey,
+				LenientDecoding: tt.lenient,
+				RetainRawJSON:   true,
+			})
+			require.NoError(t, err)
+
+			resp, err := client.ListSiteClients(context.Background(), testSiteID, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, resp.Data, 2)
+			assert.Equal(t, "client-1", resp.Data[0].Name)
+			assert.Equal(t, "client-3", resp.Data[1].Name)
+			assert.Contains(t, string(resp.Data[1].RawJSON), "client-3")
+			assert.Equal(t, 3, resp.Count)
+
+			require.Len(t, resp.Warnings, 1)
+			assert.Equal(t, 1, resp.Warnings[0].Index)
+			assert.Contains(t, string(resp.Warnings[0].Raw), "client-2")
+			require.Error(t, resp.Warnings[0].Err)
+		})
+	}
+}
+
+func TestListSiteClientsJSONUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	expectedPath := "/proxy/network/integration/v1/sites/" + testSiteID.String() + "/clients"
+	server := testutil.NewMockServer(t, expectedPath, testAPIKey, testdata.LoadFixture(t, "clients/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	var calls atomic.Int32
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		JSONUnmarshal: func(data []byte, v any) error {
+			calls.Add(1)
+			return json.Unmarshal(data, v)
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := client.ListSiteClients(context.Background(), testSiteID, nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Data)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
 func TestGetClientByID(t *testing.T) {
 	t.Parallel()
 
@@ -815,8 +927,7 @@ func TestListFirewallPolicies(t *testing.T) {
 			server := testutil.NewMockServer(t, expectedPath, testAPIKey, tt.mockResponse, tt.mockStatusCode)
 			defer server.Close()
 
-			client, err := New(server.URL, testAPIKey)
-			require.NoError(t, err)
+			client := newTestClient(t, server.URL)
 
 			resp, err := client.ListFirewallPolicies(context.Background(), testSiteInternal)
 
@@ -1070,8 +1181,7 @@ func TestListTrafficRules(t *testing.T) {
 			server := testutil.NewMockServer(t, expectedPath, testAPIKey, tt.mockResponse, tt.mockStatusCode)
 			defer server.Close()
 
-			client, err := New(server.URL, testAPIKey)
-			require.NoError(t, err)
+			client := newTestClient(t, server.URL)
 
 			resp, err := client.ListTrafficRules(context.Background(), testSiteInternal)
 
@@ -1196,6 +1306,13 @@ func TestUpdateTrafficRule(t *testing.T) {
 			t.Parallel()
 
 			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// A 404 makes the client check whether the controller version moved the path
+				if r.URL.Path == "/proxy/network/status" {
+					w.Header().Set("Content-Type", "application/json")
+					w.Write([]byte(testdata.LoadFixture(t, "controller/status_up.json")))
+					return
+				}
+
 				expectedPath := "/proxy/network/v2/api/site/" + testSiteInternal + "/trafficrules/" + ruleID
 				assert.Equal(t, expectedPath, r.URL.Path)
 				assert.Equal(t, http.MethodPut, r.Method)
@@ -1263,6 +1380,13 @@ func TestDeleteTrafficRule(t *testing.T) {
 			t.Parallel()
 
 			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// A 404 makes the client check whether the controller version moved the path
+				if r.URL.Path == "/proxy/network/status" {
+					w.Header().Set("Content-Type", "application/json")
+					w.Write([]byte(testdata.LoadFixture(t, "controller/status_up.json")))
+					return
+				}
+
 				expectedPath := "/proxy/network/v2/api/site/" + testSiteInternal + "/trafficrules/" + ruleID
 				assert.Equal(t, expectedPath, r.URL.Path)
 				assert.Equal(t, http.MethodDelete, r.Method)
@@ -1489,6 +1613,11 @@ func TestDeleteHotspotVoucher(t *testing.T) {
 			mockStatusCode: http.StatusOK,
 			wantErr:        false,
 		},
+		{
+			name:           "no content",
+			mockStatusCode: http.StatusNoContent,
+			wantErr:        false,
+		},
 		{
 			name:           "not found",
 			mockResponse:   testdata.LoadFixture(t, "errors/not_found.json"),
@@ -1529,6 +1658,397 @@ func TestDeleteHotspotVoucher(t *testing.T) {
 
 // Edge case tests.
 
+func TestOnRetryDecisionAbortsRetries(t *testing.T) {
+	t.Parallel()
+
+	var attempts int32
+	var mu sync.Mutex
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		attempts++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/server_error.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		RetryWaitTime: time.Millisecond,
+		OnRetryDecision: func(resp *http.Response, attempt int, _ time.Duration) bool {
+			assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+			assert.Equal(t, 1, attempt)
+			return false
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListSites(context.Background(), nil)
+	require.ErrorIs(t, err, unifierr.ErrUnavailable)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, int32(1), attempts, "retries should stop when the callback returns false")
+}
+
+func TestOnRetryDecisionPanicIsRecovered(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites", testAPIKey,
+		testdata.LoadFixture(t, "errors/server_error.json"), http.StatusServiceUnavailable)
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		RetryWaitTime: time.Millisecond,
+		OnRetryDecision: func(*http.Response, int, time.Duration) bool {
+			panic("faulty hook")
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListSites(context.Background(), nil)
+	require.ErrorIs(t, err, unifierr.ErrPanic)
+
+	var panicErr *unifierr.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "faulty hook", panicErr.Value)
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		rateLimit int
+		wantErr   error
+	}{
+		{name: "limited", rateLimit: 1, wantErr: unifierr.ErrWouldExceedDeadline},
+		{name: "disabled", rateLimit: RateLimitDisabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, testSitesPath, testAPIKey,
+				testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+			defer server.Close()
+
+			client, err := NewWithConfig(&ClientConfig{
+				ControllerURL:      server.URL,
+				APIKey:             testAPIKey,
+				RateLimitPerMinute: tt.rateLimit,
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			// A limit of one request per minute leaves no token for the second request
+			_, err = client.ListSites(ctx, nil)
+			require.NoError(t, err)
+			_, err = client.ListSites(ctx, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPerEndpointRateLimits(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"offset":0,"limit":25,"count":0,"totalCount":0,"data":[]}`))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		RateLimits: map[string]int{
+			"/integration/v1/sites/:id/hotspot/vouchers": 1,
+		},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// The voucher budget is spent after one request, other endpoints are unaffected
+	_, err = client.ListHotspotVouchers(ctx, testSiteID, nil)
+	require.NoError(t, err)
+	_, err = client.ListHotspotVouchers(ctx, testSiteID, nil)
+	require.ErrorIs(t, err, unifierr.ErrWouldExceedDeadline)
+
+	_, err = client.ListSites(ctx, nil)
+	require.NoError(t, err)
+	_, err = client.ListSites(ctx, nil)
+	require.NoError(t, err)
+}
+
+func TestPerEndpointRateLimitsValidation(t *testing.T) {
+	t.Parallel()
+
+	for _, perMinute := range []int{0, -5} {
+		_, err := NewWithConfig(&ClientConfig{
+			ControllerURL: "https://unifi.local",
+			APIKey:        testAPIKey,
+			RateLimits:    map[string]int{"/integration/v1/sites/:id/hotspot/vouchers": perMinute},
+		})
+		require.Error(t, err, perMinute)
+		assert.Contains(t, err.Error(), "/integration/v1/sites/:id/hotspot/vouchers")
+	}
+
+	_, err := NewWithConfig(&ClientConfig{
+		ControllerURL: "https://unifi.local",
+		APIKey:        testAPIKey,
+		RateLimits:    map[string]int{"/integration/v1/sites/:id/hotspot/vouchers": RateLimitDisabled},
+	})
+	require.NoError(t, err)
+}
+
+func TestWithWaitStats(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/server_error.json")))
+			return
+		}
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		RetryWaitTime: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	ctx, waits := WithWaitStats(context.Background())
+	_, err = client.ListSites(ctx, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, waits.Retries())
+	assert.GreaterOrEqual(t, waits.RetryWait(), 10*time.Millisecond)
+	assert.Zero(t, waits.RateLimitWait())
+}
+
+func TestUserAgent(t *testing.T) {
+	t.Parallel()
+
+	var userAgents []string
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, UserAgent: "my-exporter/1.2"})
+	require.NoError(t, err)
+
+	_, err = client.ListSites(context.Background(), nil)
+	require.NoError(t, err)
+	_, err = client.ListSites(WithUserAgent(context.Background(), "backup-job"), nil)
+	require.NoError(t, err)
+
+	sdk := "go-unifi/" + unifi.Version()
+	assert.Equal(t, []string{"my-exporter/1.2 " + sdk, "backup-job " + sdk}, userAgents)
+}
+
+func TestReadOnly(t *testing.T) {
+	t.Parallel()
+
+	var methods []string
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "sessions/list_success.json")))
+			return
+		}
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/list_success.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, ReadOnly: true})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.ListDNSRecords(ctx, testSiteInternal)
+	require.NoError(t, err)
+	_, err = client.ListClientSessions(ctx, testSiteInternal, NewClientSessionsRequest(testSessionWindow()))
+	require.NoError(t, err)
+
+	record, err := NewTXTRecord("example.com", "v=spf1 -all")
+	require.NoError(t, err)
+	_, err = client.CreateDNSRecord(ctx, testSiteInternal, record)
+	require.ErrorIs(t, err, unifierr.ErrReadOnlyClient)
+	err = client.DeleteDNSRecord(ctx, testSiteInternal, testRecordID)
+	require.ErrorIs(t, err, unifierr.ErrReadOnlyClient)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.BuildURL(V2BasePath, "site", "default", "static-dns"),
+		strings.NewReader(`{}`))
+	require.NoError(t, err)
+	_, err = client.DoRaw(req)
+	require.ErrorIs(t, err, unifierr.ErrReadOnlyClient)
+
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost}, methods, "mutations must not reach the controller")
+}
+
+func TestFault(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	})
+	defer server.Close()
+
+	var retries atomic.Int32
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		MaxRetries:    2,
+		RetryWaitTime: time.Millisecond,
+		OnRetryDecision: func(*http.Response, int, time.Duration) bool {
+			retries.Add(1)
+			return true
+		},
+		Fault: &FaultConfig{ErrorRate: 1},
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListSites(context.Background(), nil)
+	require.ErrorIs(t, err, unifierr.ErrUnavailable)
+	assert.Equal(t, int32(2), retries.Load(), "injected failures are retried")
+	assert.Zero(t, requests.Load(), "failed requests do not reach the controller")
+}
+
+func TestRootCAs(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	}))
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		RootCAs:       pool,
+	})
+	require.NoError(t, err)
+
+	resp, err := client.ListSites(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, resp.Data, 1)
+}
+
+func TestStrictDecoding(t *testing.T) {
+	t.Parallel()
+
+	body := `{"offset":0,"limit":25,"count":0,"totalCount":0,"data":[],"futureField":true}`
+
+	tests := []struct {
+		name    string
+		mode    StrictDecodingMode
+		wantErr bool
+	}{
+		{name: "off", mode: StrictDecodingOff},
+		{name: "log", mode: StrictDecodingLog},
+		{name: "fail", mode: StrictDecodingFail, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites", testAPIKey, body, http.StatusOK)
+			defer server.Close()
+
+			client, err := NewWithConfig(&ClientConfig{
+				ControllerURL:  server.URL,
+				APIKey:         testAPIKey,
+				StrictDecoding: tt.mode,
+			})
+			require.NoError(t, err)
+
+			_, err = client.ListSites(context.Background(), nil)
+			if tt.wantErr {
+				require.ErrorIs(t, err, unifierr.ErrUnknownField)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestErrorTaxonomy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		wantErr        error
+	}{
+		{
+			name:           "unauthorized",
+			mockResponse:   testdata.LoadFixture(t, "errors/unauthorized.json"),
+			mockStatusCode: http.StatusUnauthorized,
+			wantErr:        unifierr.ErrUnauthorized,
+		},
+		{
+			name:           "not found",
+			mockResponse:   testdata.LoadFixture(t, "errors/not_found.json"),
+			mockStatusCode: http.StatusNotFound,
+			wantErr:        unifierr.ErrNotFound,
+		},
+		{
+			name:           "bad request",
+			mockResponse:   testdata.LoadFixture(t, "errors/bad_request.json"),
+			mockStatusCode: http.StatusBadRequest,
+			wantErr:        unifierr.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites", testAPIKey, tt.mockResponse, tt.mockStatusCode)
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			_, err = client.ListSites(context.Background(), nil)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestContextTimeout(t *testing.T) {
 	t.Parallel()
 
@@ -1563,6 +2083,43 @@ func TestInvalidJSON(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestPhaseTimeouts(t *testing.T) {
+	t.Parallel()
+
+	// Headers arrive at once, the body only after a while, like a large list download
+	server := testutil.NewMockServerWithHandler(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(100 * time.Millisecond)
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	}))
+	defer server.Close()
+
+	overall, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		MaxRetries:    1,
+		RetryWaitTime: time.Millisecond,
+		Timeout:       30 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	_, err = overall.ListSites(context.Background(), nil)
+	require.Error(t, err, "the overall timeout covers reading the body")
+
+	phases, err := NewWithConfig(&ClientConfig{
+		ControllerURL:         server.URL,
+		APIKey:                testAPIKey,
+		Timeout:               -1,
+		DialTimeout:           time.Second,
+		ResponseHeaderTimeout: 30 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	sites, err := phases.ListSites(context.Background(), nil)
+	require.NoError(t, err, "the response header timeout does not cover reading the body")
+	assert.NotEmpty(t, sites.Data)
+}
+
 func TestNetworkError(t *testing.T) {
 	t.Parallel()
 
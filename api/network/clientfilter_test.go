@@ -0,0 +1,152 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+var testClientsPath = testSitesPath + "/" + testSiteID.String() + "/clients"
+
+func TestClientFilterExpression(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2025, time.October, 20, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	tests := []struct {
+		name   string
+		filter ClientFilter
+		want   string
+	}{
+		{name: "empty", filter: ClientFilter{}, want: ""},
+		{name: "type", filter: ClientFilter{Type: WIRED}, want: "type.eq('WIRED')"},
+		{name: "since", filter: ClientFilter{ConnectedSince: since}, want: "connectedAt.ge(2025-10-20T10:00:00Z)"},
+		{
+			name:   "SSID implies wireless",
+			filter: ClientFilter{SSID: "Guests", ConnectedSince: since},
+			want:   "and(type.eq('WIRELESS'), connectedAt.ge(2025-10-20T10:00:00Z))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.filter.Expression())
+		})
+	}
+}
+
+// clientsServer serves the client list, answering requests with a filter expression
+// with status, and records the expressions received.
+func clientsServer(t *testing.T, status int) (*APIClient, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var filters []string
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		filter := r.URL.Query().Get("filter")
+		mu.Lock()
+		filters = append(filters, filter)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if filter != "" && status != http.StatusOK {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"statusCode": 400, "statusName": "BAD_REQUEST", "message": "Unsupported filter"}`))
+			return
+		}
+		// The fixture is not filtered, as an old controller ignoring the expression would
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "clients/list_success.json")))
+	})
+	t.Cleanup(server.Close)
+
+	return newTestClient(t, server.URL), func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return filters
+	}
+}
+
+func clientNames(clients []ClientListItem) []string {
+	names := make([]string, 0, len(clients))
+	for _, client := range clients {
+		names = append(names, client.Name)
+	}
+	return names
+}
+
+func TestFilterSiteClients(t *testing.T) {
+	t.Parallel()
+
+	client, filters := clientsServer(t, http.StatusOK)
+	filter := &ClientFilter{Type: WIRELESS, ConnectedSince: time.Date(2025, time.October, 20, 0, 0, 0, 0, time.UTC)}
+
+	clients, err := client.FilterSiteClients(context.Background(), testSiteID, filter)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"client-3"}, clientNames(clients), "results are checked locally")
+	assert.Equal(t, []string{filter.Expression()}, filters())
+
+	clients, err = client.FilterSiteClients(context.Background(), testSiteID, nil)
+	require.NoError(t, err)
+	assert.Len(t, clients, 3)
+	assert.Equal(t, []string{filter.Expression(), ""}, filters())
+}
+
+func TestFilterSiteClientsUnsupported(t *testing.T) {
+	t.Parallel()
+
+	client, filters := clientsServer(t, http.StatusBadRequest)
+	filter := &ClientFilter{Type: WIRELESS}
+
+	for range 2 {
+		clients, err := client.FilterSiteClients(context.Background(), testSiteID, filter)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"client-2", "client-3"}, clientNames(clients))
+	}
+	assert.Equal(t, []string{filter.Expression(), "", ""}, filters(), "a rejected filter is not sent again")
+}
+
+func TestFilterSiteClientsBySSID(t *testing.T) {
+	t.Parallel()
+
+	clientList := testdata.LoadFixture(t, "clients/list_success.json")
+	sites := testdata.LoadFixture(t, "sites/list_success.json")
+	var sessionsRequested bool
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		testSitesPath: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(sites))
+		},
+		testClientsPath: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(clientList))
+		},
+		testSessionsPath: func(w http.ResponseWriter, _ *http.Request) {
+			sessionsRequested = true
+			w.Header().Set("Content-Type", "application/json")
+			// client-2 roamed from Guests to Office, client-3 is on Guests
+			_, _ = w.Write([]byte(`{"meta": {"rc": "ok"}, "data": [
+				{"_id": "s1", "mac": "aa:bb:cc:9c:58:6f", "assoc_time": 1760868600, "disassoc_time": 1760868624, "essid": "Guests"},
+				{"_id": "s2", "mac": "aa:bb:cc:9c:58:6f", "assoc_time": 1760868624, "essid": "Office"},
+				{"_id": "s3", "mac": "AA:BB:CC:10:A8:87", "assoc_time": 1761340512, "essid": "Guests"}
+			]}`))
+		},
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	clients, err := client.FilterSiteClients(context.Background(), testSiteID, &ClientFilter{SSID: "Guests"})
+	require.NoError(t, err)
+	assert.True(t, sessionsRequested)
+	assert.Equal(t, []string{"client-3"}, clientNames(clients))
+
+	clients, err = client.FilterSiteClients(context.Background(), testSiteID, &ClientFilter{SSID: "Office"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"client-2"}, clientNames(clients))
+}
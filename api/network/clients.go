@@ -0,0 +1,325 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+// DefaultClientBatchConcurrency is the default number of concurrent requests
+// issued by batch client operations.
+const DefaultClientBatchConcurrency = 4
+
+// ErrInvalidMAC is returned for client MAC addresses that cannot be parsed.
+var ErrInvalidMAC = errors.New("invalid MAC address")
+
+// NormalizeMAC parses mac in any format accepted by net.ParseMAC and returns it
+// in the lowercase, colon-separated form used by the controller.
+func NormalizeMAC(mac string) (string, error) {
+	hw, err := net.ParseMAC(mac)
+	if err != nil {
+		return "", errors.Wrapf(ErrInvalidMAC, "%q", mac)
+	}
+	return hw.String(), nil
+}
+
+// ClientBatchOptions configures batch client operations.
+type ClientBatchOptions struct {
+	// Concurrency limits the number of requests in flight (defaults to DefaultClientBatchConcurrency)
+	Concurrency int
+}
+
+// ClientBatchResult reports the per-client outcome of a batch client operation.
+// MAC addresses are normalized with NormalizeMAC, except invalid ones which are
+// reported in Failed as given.
+type ClientBatchResult struct {
+	// Succeeded lists the MAC addresses the operation was applied to, sorted.
+	Succeeded []string
+
+	// Failed maps MAC addresses to the error of their operation.
+	Failed map[string]error
+}
+
+// Err returns nil if every client succeeded, or an error joining all per-client failures.
+// The joined errors keep their causes, so errors.Is matches e.g. unifierr.ErrNotFound.
+func (r *ClientBatchResult) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+
+	macs := make([]string, 0, len(r.Failed))
+	for mac := range r.Failed {
+		macs = append(macs, mac)
+	}
+	slices.Sort(macs)
+
+	errs := make([]error, 0, len(macs))
+	for _, mac := range macs {
+		errs = append(errs, r.Failed[mac])
+	}
+	return errors.Wrapf(errors.Join(errs...), "%d of %d clients failed", len(r.Failed), len(r.Failed)+len(r.Succeeded))
+}
+
+// BlockClients blocks all clients in macs, issuing up to opts.Concurrency requests at a time.
+// Duplicate MAC addresses are blocked once. A failure for one client does not stop the others:
+// the returned result lists each outcome and the returned error equals result.Err().
+// If site cannot be resolved, no client is processed and only an error is returned.
+//
+// Example:
+//
+//	result, err := client.BlockClients(ctx, "default", tags.MACs("kids"), nil)
+//	if err != nil {
+//	    log.Printf("blocked %d clients: %v", len(result.Succeeded), err)
+//	}
+func (c *APIClient) BlockClients(ctx context.Context, site Site, macs []string, opts *ClientBatchOptions) (*ClientBatchResult, error) {
+	return c.runClientBatch(ctx, site, macs, opts, c.BlockClient)
+}
+
+// UnblockClients unblocks all clients in macs with the same semantics as BlockClients.
+func (c *APIClient) UnblockClients(ctx context.Context, site Site, macs []string, opts *ClientBatchOptions) (*ClientBatchResult, error) {
+	return c.runClientBatch(ctx, site, macs, opts, c.UnblockClient)
+}
+
+func (c *APIClient) runClientBatch(
+	ctx context.Context,
+	site Site,
+	macs []string,
+	opts *ClientBatchOptions,
+	apply func(ctx context.Context, site Site, mac string) error,
+) (*ClientBatchResult, error) {
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := DefaultClientBatchConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	result := &ClientBatchResult{Failed: make(map[string]error)}
+	var mu sync.Mutex
+	record := func(mac string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Failed[mac] = err
+			return
+		}
+		result.Succeeded = append(result.Succeeded, mac)
+	}
+
+	seen := make(map[string]struct{}, len(macs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for _, raw := range macs {
+		mac, err := NormalizeMAC(raw)
+		if err != nil {
+			record(raw, err)
+			continue
+		}
+		if _, dup := seen[mac]; dup {
+			continue
+		}
+		seen[mac] = struct{}{}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			record(mac, errors.Wrapf(ctx.Err(), "client %s not processed", mac))
+			continue
+		}
+
+		wg.Go(func() {
+			defer func() { <-sem }()
+			record(mac, apply(ctx, site, mac))
+		})
+	}
+
+	wg.Wait()
+	slices.Sort(result.Succeeded)
+
+	return result, result.Err()
+}
+
+// SteerClient moves a wireless client to the access point with MAC address apMAC.
+//
+// The controller cannot hand a client over directly, so the client is locked to
+// the access point (the "Lock to AP" client setting) and then kicked; it can only
+// reassociate with that access point afterwards. The lock persists: steering with
+// an empty apMAC removes it and kicks the client so it can roam freely again.
+// Steering a client to a band is not supported per client; use the band steering
+// setting of the WLAN instead.
+//
+// Example:
+//
+//	// balance a sticky client off a busy access point
+//	err := client.SteerClient(ctx, "default", "80:af:ca:ad:05:8d", "aa:bb:cc:99:ea:6b")
+func (c *APIClient) SteerClient(ctx context.Context, site Site, mac, apMAC string) error {
+	ctx = middleware.WithOperation(ctx, "SteerClient")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	mac, err = NormalizeMAC(mac)
+	if err != nil {
+		return err
+	}
+	locked := apMAC != ""
+	if locked {
+		apMAC, err = NormalizeMAC(apMAC)
+		if err != nil {
+			return err
+		}
+	}
+
+	errorMsg := fmt.Sprintf("failed to steer client %s to access point %q in site %s", mac, apMAC, site)
+	known, err := c.getKnownClient(ctx, site, mac, errorMsg)
+	if err != nil {
+		return err
+	}
+
+	input := KnownClientInput{
+		UsergroupId:    valueOrZero(known.UsergroupId),
+		FixedAPEnabled: &locked,
+		FixedAPMAC:     &apMAC,
+	}
+	resp, err := c.client.UpdateKnownClientWithResponse(ctx, site, known.Id, input)
+	err = response.HandleNoContent(resp, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleNoContent
+		return err
+	}
+	return c.KickClient(ctx, site, mac)
+}
+
+// ClientTags groups client MAC addresses under caller-defined tags such as "kids".
+// The controller has no notion of tags: a ClientTags lives on the caller side and is
+// typically filled from configuration or from ListSiteClients results, then passed
+// to BlockClients or UnblockClients via MACs.
+//
+// ClientTags is safe for concurrent use. The zero value is not usable; use NewClientTags.
+type ClientTags struct {
+	mu   sync.RWMutex
+	tags map[string]map[string]struct{}
+}
+
+// NewClientTags creates an empty tag set.
+func NewClientTags() *ClientTags {
+	return &ClientTags{tags: make(map[string]map[string]struct{})}
+}
+
+// Tag adds the given MAC addresses to tag. Either all MAC addresses are added,
+// or none if one of them is invalid.
+func (t *ClientTags) Tag(tag string, macs ...string) error {
+	normalized := make([]string, 0, len(macs))
+	for _, mac := range macs {
+		n, err := NormalizeMAC(mac)
+		if err != nil {
+			return err
+		}
+		normalized = append(normalized, n)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	members, ok := t.tags[tag]
+	if !ok {
+		members = make(map[string]struct{}, len(normalized))
+		t.tags[tag] = members
+	}
+	for _, mac := range normalized {
+		members[mac] = struct{}{}
+	}
+	return nil
+}
+
+// TagMatching adds every client in clients for which match returns true to tag
+// and returns the number of clients tagged.
+//
+// Example:
+//
+//	resp, err := client.ListSiteClients(ctx, siteID, nil)
+//	tags.TagMatching("kids", resp.Data, func(c *network.ClientListItem) bool {
+//	    return strings.HasPrefix(c.Name, "kid-")
+//	})
+func (t *ClientTags) TagMatching(tag string, clients []ClientListItem, match func(*ClientListItem) bool) int {
+	var macs []string
+	for i := range clients {
+		if match(&clients[i]) {
+			macs = append(macs, clients[i].MacAddress)
+		}
+	}
+
+	// Skip clients whose reported MAC address cannot be parsed instead of dropping the whole set.
+	tagged := 0
+	for _, mac := range macs {
+		if t.Tag(tag, mac) == nil {
+			tagged++
+		}
+	}
+	return tagged
+}
+
+// Untag removes the given MAC addresses from tag. Unknown tags and MAC addresses are ignored.
+func (t *ClientTags) Untag(tag string, macs ...string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	members, ok := t.tags[tag]
+	if !ok {
+		return
+	}
+	for _, mac := range macs {
+		n, err := NormalizeMAC(mac)
+		if err == nil {
+			delete(members, n)
+		}
+	}
+	if len(members) == 0 {
+		delete(t.tags, tag)
+	}
+}
+
+// MACs returns the sorted union of MAC addresses tagged with any of tags.
+func (t *ClientTags) MACs(tags ...string) []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	union := make(map[string]struct{})
+	for _, tag := range tags {
+		for mac := range t.tags[tag] {
+			union[mac] = struct{}{}
+		}
+	}
+
+	macs := make([]string, 0, len(union))
+	for mac := range union {
+		macs = append(macs, mac)
+	}
+	slices.Sort(macs)
+	return macs
+}
+
+// Tags returns the sorted names of all tags with at least one MAC address.
+func (t *ClientTags) Tags() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	names := make([]string, 0, len(t.tags))
+	for tag := range t.tags {
+		names = append(names, tag)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,305 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const testStamgrPath = "/proxy/network/api/s/" + testSiteInternal + "/cmd/stamgr"
+
+func TestBlockClient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		run     func(c *APIClient, ctx context.Context, site Site, mac string) error
+		wantCmd ClientCommandRequestCmd
+	}{
+		{name: "block", run: (*APIClient).BlockClient, wantCmd: ClientCommandBlock},
+		{name: "unblock", run: (*APIClient).UnblockClient, wantCmd: ClientCommandUnblock},
+		{name: "kick", run: (*APIClient).KickClient, wantCmd: ClientCommandKick},
+		{name: "unauthorize guest", run: (*APIClient).UnauthorizeGuest, wantCmd: ClientCommandUnauthorizeGuest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, testStamgrPath, r.URL.Path)
+
+				var body ClientCommandRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, tt.wantCmd, body.Cmd)
+				assert.Equal(t, "80:af:ca:ad:05:8d", body.Mac)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(testdata.LoadFixture(t, "clients/command_success.json")))
+			})
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			err = tt.run(client, context.Background(), testSiteInternal, "80:af:ca:ad:05:8d")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBlockClients(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var requested []string
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		var body ClientCommandRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		mu.Lock()
+		requested = append(requested, body.Mac)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if body.Mac == "aa:bb:cc:dd:ee:03" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/bad_request.json")))
+			return
+		}
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "clients/command_success.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	macs := []string{
+		"AA:BB:CC:DD:EE:02",
+		"aa-bb-cc-dd-ee-01",
+		"aa:bb:cc:dd:ee:01", // duplicate after normalization
+		"aa:bb:cc:dd:ee:03",
+		"not-a-mac",
+	}
+
+	result, err := client.BlockClients(context.Background(), testSiteInternal, macs, nil)
+	require.Error(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, []string{"aa:bb:cc:dd:ee:01", "aa:bb:cc:dd:ee:02"}, result.Succeeded)
+	require.Len(t, result.Failed, 2)
+	require.ErrorIs(t, result.Failed["aa:bb:cc:dd:ee:03"], unifierr.ErrValidation)
+	require.ErrorIs(t, result.Failed["not-a-mac"], ErrInvalidMAC)
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+	require.ErrorIs(t, err, ErrInvalidMAC)
+	assert.Len(t, requested, 3, "invalid and duplicate MAC addresses must not be sent")
+}
+
+func TestBlockClientsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			peak := maxInFlight.Load()
+			if current <= peak || maxInFlight.CompareAndSwap(peak, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "clients/command_success.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	macs := []string{
+		"aa:bb:cc:dd:ee:01", "aa:bb:cc:dd:ee:02", "aa:bb:cc:dd:ee:03",
+		"aa:bb:cc:dd:ee:04", "aa:bb:cc:dd:ee:05", "aa:bb:cc:dd:ee:06",
+	}
+
+	result, err := client.UnblockClients(context.Background(), testSiteInternal, macs, &ClientBatchOptions{Concurrency: 2})
+	require.NoError(t, err)
+	assert.Len(t, result.Succeeded, len(macs))
+	assert.Empty(t, result.Failed)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestBlockClientsUnknownSite(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testSitesPath, testAPIKey,
+		testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	result, err := client.BlockClients(context.Background(), "00000000-0000-0000-0000-000000000001",
+		[]string{"aa:bb:cc:dd:ee:01"}, nil)
+	require.ErrorIs(t, err, ErrSiteNotFound)
+	assert.Nil(t, result)
+}
+
+func TestClientBatchResultErr(t *testing.T) {
+	t.Parallel()
+
+	result := &ClientBatchResult{Succeeded: []string{"aa:bb:cc:dd:ee:01"}, Failed: map[string]error{}}
+	require.NoError(t, result.Err())
+
+	result.Failed["aa:bb:cc:dd:ee:02"] = errors.New("boom")
+	err := result.Err()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1 of 2 clients failed")
+}
+
+func TestClientTags(t *testing.T) {
+	t.Parallel()
+
+	tags := NewClientTags()
+
+	require.NoError(t, tags.Tag("kids", "AA:BB:CC:DD:EE:02", "aa:bb:cc:dd:ee:01"))
+	require.NoError(t, tags.Tag("guests", "aa:bb:cc:dd:ee:01", "aa:bb:cc:dd:ee:09"))
+	require.ErrorIs(t, tags.Tag("kids", "aa:bb:cc:dd:ee:03", "bogus"), ErrInvalidMAC)
+
+	assert.Equal(t, []string{"guests", "kids"}, tags.Tags())
+	assert.Equal(t, []string{"aa:bb:cc:dd:ee:01", "aa:bb:cc:dd:ee:02"}, tags.MACs("kids"))
+	assert.Equal(t, []string{"aa:bb:cc:dd:ee:01", "aa:bb:cc:dd:ee:02", "aa:bb:cc:dd:ee:09"},
+		tags.MACs("kids", "guests"))
+	assert.Empty(t, tags.MACs("unknown"))
+
+	tags.Untag("guests", "aa:bb:cc:dd:ee:01", "AA:BB:CC:DD:EE:09")
+	assert.Equal(t, []string{"kids"}, tags.Tags())
+}
+
+func TestClientTagsTagMatching(t *testing.T) {
+	t.Parallel()
+
+	var resp ClientsResponse
+	testdata.LoadFixtureJSON(t, "clients/list_success.json", &resp)
+	require.NotEmpty(t, resp.Data)
+
+	tags := NewClientTags()
+	tagged := tags.TagMatching("wired", resp.Data, func(c *ClientListItem) bool {
+		return c.Type == WIRED
+	})
+
+	wired := 0
+	for _, c := range resp.Data {
+		if c.Type == WIRED {
+			wired++
+		}
+	}
+	assert.Equal(t, wired, tagged)
+	assert.Len(t, tags.MACs("wired"), wired)
+}
+
+func TestSteerClient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		apMAC      string
+		wantLocked bool
+		wantAPMAC  string
+	}{
+		{name: "lock to access point", apMAC: "AA-BB-CC-99-EA-6B", wantLocked: true, wantAPMAC: "aa:bb:cc:99:ea:6b"},
+		{name: "release lock", apMAC: "", wantLocked: false, wantAPMAC: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			var mu sync.Mutex
+			record := func(call string) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls = append(calls, call)
+			}
+
+			server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+				"/proxy/network/api/s/" + testSiteInternal + "/stat/user/" + testClientMAC: func(w http.ResponseWriter, _ *http.Request) {
+					record("lookup")
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(testdata.LoadFixture(t, "usergroups/known_client.json")))
+				},
+				"/proxy/network/api/s/" + testSiteInternal + "/rest/user/60a1b2c3d4e5f6a7b8c9d0e1": func(w http.ResponseWriter, r *http.Request) {
+					record("lock")
+					assert.Equal(t, http.MethodPut, r.Method)
+
+					var body KnownClientInput
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Empty(t, body.UsergroupId, "the current user group is kept")
+					assert.Equal(t, tt.wantLocked, *body.FixedAPEnabled)
+					assert.Equal(t, tt.wantAPMAC, *body.FixedAPMAC)
+
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(testdata.LoadFixture(t, "usergroups/known_client.json")))
+				},
+				testStamgrPath: func(w http.ResponseWriter, r *http.Request) {
+					record("kick")
+
+					var body ClientCommandRequest
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, ClientCommandKick, body.Cmd)
+					assert.Equal(t, testClientMAC, body.Mac)
+
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(testdata.LoadFixture(t, "clients/command_success.json")))
+				},
+			})
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			err = client.SteerClient(context.Background(), testSiteInternal, testClientMAC, tt.apMAC)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"lookup", "lock", "kick"}, calls)
+		})
+	}
+}
+
+func TestSteerClientErrors(t *testing.T) {
+	t.Parallel()
+
+	var kicked atomic.Bool
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == testStamgrPath {
+			kicked.Store(true)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	err = client.SteerClient(context.Background(), testSiteInternal, testClientMAC, "not-a-mac")
+	require.ErrorIs(t, err, ErrInvalidMAC)
+
+	err = client.SteerClient(context.Background(), testSiteInternal, testClientMAC, "aa:bb:cc:99:ea:6b")
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+	assert.False(t, kicked.Load(), "unknown clients are not kicked")
+}
@@ -0,0 +1,211 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const testTargetSite = "branch"
+
+func TestListFirewallZones(t *testing.T) {
+	t.Parallel()
+
+	expectedPath := "/proxy/network/v2/api/site/" + testSiteInternal + "/firewall/zone"
+	server := testutil.NewMockServer(t, expectedPath, testAPIKey, testdata.LoadFixture(t, "firewall/zones.json"), http.StatusOK)
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	zones, err := client.ListFirewallZones(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.Len(t, zones, 3)
+	assert.Equal(t, "Internal", zones[0].Name)
+	require.NotNil(t, zones[0].ZoneKey)
+	assert.Equal(t, "internal", *zones[0].ZoneKey)
+	assert.Nil(t, zones[2].ZoneKey)
+}
+
+type cloneWrite struct {
+	method string
+	path   string
+	body   map[string]any
+}
+
+// cloneServer serves a template site (testSiteInternal) built from fixtures and a
+// partially configured target site, recording writes.
+func cloneServer(t *testing.T) (*APIClient, func() []cloneWrite) {
+	t.Helper()
+
+	legacy := func(data string) string { return `{"meta": {"rc": "ok"}, "data": ` + data + `}` }
+	responses := map[string]string{
+		"/v2/api/site/default/firewall/zone": testdata.LoadFixture(t, "firewall/zones.json"),
+		"/v2/api/site/default/firewall-policies": `[
+			{"_id": "f0", "name": "Allow All Traffic", "action": "ALLOW", "enabled": true, "predefined": true},
+			{"_id": "f1", "name": "IoT to Internet", "action": "ALLOW", "enabled": true, "index": 10000,
+			 "source": {"zone_id": "678ccc2a2e4c3c5e2e4a7b10", "network_ids": ["5f8a1b2c3d4e5f6a7b8c9d12"]},
+			 "destination": {"zone_id": "678ccc2a2e4c3c5e2e4a7b11"}},
+			{"_id": "f2", "name": "Isolate cameras", "action": "DROP", "enabled": true,
+			 "source": {"zone_id": "678ccc2a2e4c3c5e2e4a7b12"}}
+		]`,
+		"/v2/api/site/default/trafficrules": testdata.LoadFixture(t, "traffic/list_success.json"),
+		"/v2/api/site/default/apgroups":     testdata.LoadFixture(t, "apgroups/list_success.json"),
+		"/api/s/default/rest/networkconf":   testdata.LoadFixture(t, "networks/list_success.json"),
+		"/api/s/default/rest/usergroup":     testdata.LoadFixture(t, "usergroups/list_success.json"),
+		"/api/s/default/rest/wlanconf":      testdata.LoadFixture(t, "wlans/list_success.json"),
+
+		"/v2/api/site/branch/firewall/zone": `[
+			{"_id": "b-zone-internal", "name": "Intern", "zone_key": "internal", "default_zone": true},
+			{"_id": "b-zone-external", "name": "Extern", "zone_key": "external", "default_zone": true}
+		]`,
+		"/v2/api/site/branch/firewall-policies": `[
+			{"_id": "b-f0", "name": "Allow All Traffic", "action": "ALLOW", "enabled": true, "predefined": true}
+		]`,
+		"/v2/api/site/branch/trafficrules": `[
+			{"_id": "b-r1", "site_id": "b-site", "action": "BLOCK", "description": "Block region", "enabled": false,
+			 "matching_target": "REGION", "regions": ["KP"], "target_devices": [{"type": "ALL_CLIENTS"}]}
+		]`,
+		"/v2/api/site/branch/apgroups": `[]`,
+		"/api/s/branch/rest/networkconf": legacy(`[
+			{"_id": "b-net-default", "name": "Default"},
+			{"_id": "b-net-iot", "name": "IoT"}
+		]`),
+		"/api/s/branch/rest/usergroup": legacy(`[{"_id": "b-ug-default", "name": "Default"}]`),
+		"/api/s/branch/rest/wlanconf": legacy(`[
+			{"_id": "b-wlan-home", "name": "Home", "enabled": true, "security": "open", "networkconf_id": "b-net-default"}
+		]`),
+	}
+
+	var mu sync.Mutex
+	var writes []cloneWrite
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		path := strings.TrimPrefix(r.URL.Path, "/proxy/network")
+		if r.Method == http.MethodGet {
+			body, found := responses[path]
+			if !found {
+				t.Errorf("unexpected request %s %s", r.Method, path)
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(body))
+			return
+		}
+
+		var body map[string]any
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+		mu.Lock()
+		writes = append(writes, cloneWrite{method: r.Method, path: path, body: body})
+		mu.Unlock()
+
+		created := `{"_id": "new-id"}`
+		if strings.HasPrefix(path, "/api/") {
+			created = legacy(`[` + created + `]`)
+		}
+		_, _ = w.Write([]byte(created))
+	})
+	t.Cleanup(server.Close)
+
+	return newTestClient(t, server.URL), func() []cloneWrite {
+		mu.Lock()
+		defer mu.Unlock()
+		return writes
+	}
+}
+
+func TestPlanSiteClone(t *testing.T) {
+	t.Parallel()
+
+	client, writes := cloneServer(t)
+
+	report, err := client.PlanSiteClone(context.Background(), testSiteInternal, testTargetSite,
+		[]ResourceKind{ResourceWLANs, ResourceTrafficRules, ResourceFirewallPolicies})
+	require.NoError(t, err)
+	assert.Empty(t, writes(), "planning should not change anything")
+
+	type summary struct {
+		kind     ResourceKind
+		action   CloneAction
+		name     string
+		targetID string
+	}
+	got := make([]summary, len(report.Changes))
+	for i, change := range report.Changes {
+		got[i] = summary{change.Kind, change.Action, change.Name, change.TargetID}
+	}
+	assert.Equal(t, []summary{
+		{ResourceFirewallPolicies, CloneCreate, "IoT to Internet", ""},
+		{ResourceFirewallPolicies, CloneSkip, "Isolate cameras", ""},
+		{ResourceTrafficRules, CloneCreate, "Block social media for kids", ""},
+		{ResourceWLANs, CloneUpdate, "Home", "b-wlan-home"},
+		{ResourceWLANs, CloneSkip, "IoT", ""},
+	}, got, "identical resources and predefined policies should not be listed")
+
+	assert.Contains(t, report.Changes[1].Reason, `firewall zone "Cameras"`)
+	assert.Contains(t, report.Changes[4].Reason, `network "Internet 1"`)
+	assert.Equal(t, "b-net-iot", report.IDMap["5f8a1b2c3d4e5f6a7b8c9d12"])
+	assert.Equal(t, "b-zone-internal", report.IDMap["678ccc2a2e4c3c5e2e4a7b10"], "built-in zones should match by key")
+}
+
+func TestCloneSiteConfig(t *testing.T) {
+	t.Parallel()
+
+	client, writes := cloneServer(t)
+
+	report, err := client.CloneSiteConfig(context.Background(), testSiteInternal, testTargetSite,
+		[]ResourceKind{ResourceFirewallPolicies, ResourceWLANs})
+	require.NoError(t, err)
+	require.Len(t, report.Changes, 4)
+	assert.Equal(t, "new-id", report.Changes[0].TargetID)
+
+	got := writes()
+	require.Len(t, got, 2, "skipped resources should not be written")
+
+	policy := got[0]
+	assert.Equal(t, http.MethodPost, policy.method)
+	assert.Equal(t, "/v2/api/site/branch/firewall-policies", policy.path)
+	assert.NotContains(t, policy.body, "_id")
+	assert.NotContains(t, policy.body, "index", "the position of a policy is site-specific")
+	assert.Equal(t, map[string]any{"zone_id": "b-zone-internal", "network_ids": []any{"b-net-iot"}}, policy.body["source"])
+	assert.Equal(t, map[string]any{"zone_id": "b-zone-external"}, policy.body["destination"])
+
+	wlan := got[1]
+	assert.Equal(t, http.MethodPut, wlan.method)
+	assert.Equal(t, "/api/s/branch/rest/wlanconf/b-wlan-home", wlan.path)
+	assert.Equal(t, "wpapsk", wlan.body["security"])
+	assert.Equal(t, "b-net-default", wlan.body["networkconf_id"])
+}
+
+func TestPlanSiteCloneInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		resources []ResourceKind
+	}{
+		{name: "no resources"},
+		{name: "unknown resource", resources: []ResourceKind{ResourceWLANs, "port-profiles"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, _ := cloneServer(t)
+			_, err := client.PlanSiteClone(context.Background(), testSiteInternal, testTargetSite, tt.resources)
+			require.ErrorIs(t, err, unifierr.ErrValidation)
+		})
+	}
+}
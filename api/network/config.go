@@ -0,0 +1,170 @@
+package network
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/config"
+)
+
+// EnvPrefix is the prefix of the environment variables read by NewFromEnv.
+const EnvPrefix = "UNIFI_"
+
+// configKeys lists the settings accepted by NewFromEnv and NewFromConfigFile.
+var configKeys = []string{
+	"controller_url",
+	"api_key",
+	"api_key_file",
+	"api_key_env",
+	"api_key_credential",
+	"insecure_skip_verify",
+	"ca_cert_file",
+	"rate_limit_per_minute",
+	"max_retries",
+	"retry_wait_time",
+	"retry_max_wait_time",
+	"retry_jitter",
+	"retry_budget_per_minute",
+	"timeout",
+	"dial_timeout",
+	"tls_handshake_timeout",
+	"response_header_timeout",
+	"user_agent",
+	"controller_version",
+	"strict_decoding",
+	"retain_raw_json",
+	"lenient_decoding",
+	"path_cache_size",
+	"cache_ttl",
+	"site_list_ttl",
+	"detect_maintenance",
+	"serialize_site_mutations",
+	"read_only",
+	"log_update_diffs",
+	"wait_past_deadline",
+	"log_level",
+}
+
+// NewFromEnv creates a client configured from UNIFI_* environment variables.
+//
+// Each setting accepted by NewFromConfigFile is read from the variable named
+// UNIFI_ followed by the upper-cased key, e.g. UNIFI_CONTROLLER_URL and UNIFI_API_KEY.
+//
+// Example:
+//
+//	client, err := network.NewFromEnv()
+func NewFromEnv() (*APIClient, error) {
+	return newFromValues(config.FromEnv(EnvPrefix, configKeys))
+}
+
+// NewFromConfigFile creates a client configured from a flat YAML, TOML or JSON file,
+// chosen by the file extension. Nested sections are not supported.
+//
+// Supported keys:
+//
+//	controller_url           controller base URL (required)
+//	api_key                  API key; alternatively api_key_file (path to a file
+//	                         containing the key), api_key_env (environment variable name)
+//	                         or api_key_credential (account in the credentials.Default store)
+//	insecure_skip_verify     skip TLS certificate verification (defaults to true, as New)
+//	ca_cert_file             PEM file of the CAs verifying the controller certificate
+//	rate_limit_per_minute    request rate limit (-1 disables client-side rate limiting)
+//	max_retries              maximum number of retries
+//	retry_wait_time          wait between retries, e.g. "2s"
+//	retry_max_wait_time      cap of the growing wait between retries, e.g. "30s"
+//	retry_jitter             randomized fraction of each retry wait, from 0 to 1
+//	retry_budget_per_minute  retries allowed per minute across all requests (unlimited by default)
+//	timeout                  overall request timeout, e.g. "30s" (negative disables it)
+//	dial_timeout             connection timeout, e.g. "5s"
+//	tls_handshake_timeout    TLS handshake timeout, e.g. "5s"
+//	response_header_timeout  timeout waiting for response headers, e.g. "30s"
+//	user_agent               application identifier for the User-Agent header, e.g. "my-exporter/1.2"
+//	controller_version       Network application version adapting v2 paths, e.g. "8.7.11" (detected by default)
+//	strict_decoding          off, log or fail
+//	retain_raw_json          keep raw JSON of decoded models
+//	lenient_decoding         skip list elements that fail to decode instead of failing
+//	path_cache_size          request paths whose normalized form is cached for metrics
+//	cache_ttl                cache GET responses for this long, e.g. "10s"
+//	site_list_ttl            reload the memoized site list once this old, e.g. "5m"
+//	detect_maintenance       fail fast with unifierr.ErrControllerMaintenance on maintenance pages
+//	serialize_site_mutations allow one create, update or delete request per site at a time
+//	read_only                refuse requests that could change the configuration
+//	log_update_diffs         log the fields changed by DNS, firewall and traffic rule updates
+//	wait_past_deadline       wait for the rate limiter even past the context deadline
+//	log_level                debug, info, warn, error or off; logs to stderr via log/slog
+//
+// Example config.yaml:
+//
+//	controller_url: https://unifi.local
+//	api_key_env: UNIFI_API_KEY
+//	insecure_skip_verify: false
+//	log_level: info
+func NewFromConfigFile(path string) (*APIClient, error) {
+	values, err := config.Load(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load client config")
+	}
+	return newFromValues(values)
+}
+
+func newFromValues(values config.Values) (*APIClient, error) {
+	cfg, err := configFromValues(values)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load client config")
+	}
+	return NewWithConfig(cfg)
+}
+
+func configFromValues(values config.Values) (*ClientConfig, error) {
+	err := values.CheckKeys(configKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check config keys")
+	}
+
+	apiKey, err := values.Secret("api_key")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve API key")
+	}
+	logger, err := values.Logger("log_level")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure logger")
+	}
+
+	cfg := &ClientConfig{
+		APIKey:             apiKey,
+		InsecureSkipVerify: true, // Same default as New
+		Logger:             logger,
+	}
+	values.String("controller_url", &cfg.ControllerURL)
+	values.String("user_agent", &cfg.UserAgent)
+	values.String("controller_version", &cfg.ControllerVersion)
+
+	err = errors.Join(
+		values.Bool("insecure_skip_verify", &cfg.InsecureSkipVerify),
+		values.CertPool("ca_cert_file", &cfg.RootCAs),
+		values.Int("rate_limit_per_minute", &cfg.RateLimitPerMinute),
+		values.Int("max_retries", &cfg.MaxRetries),
+		values.Duration("retry_wait_time", &cfg.RetryWaitTime),
+		values.Duration("retry_max_wait_time", &cfg.RetryMaxWaitTime),
+		values.Float("retry_jitter", &cfg.RetryJitter),
+		values.Int("retry_budget_per_minute", &cfg.RetryBudgetPerMinute),
+		values.Duration("timeout", &cfg.Timeout),
+		values.Duration("dial_timeout", &cfg.DialTimeout),
+		values.Duration("tls_handshake_timeout", &cfg.TLSHandshakeTimeout),
+		values.Duration("response_header_timeout", &cfg.ResponseHeaderTimeout),
+		values.StrictMode("strict_decoding", &cfg.StrictDecoding),
+		values.Bool("retain_raw_json", &cfg.RetainRawJSON),
+		values.Bool("lenient_decoding", &cfg.LenientDecoding),
+		values.Int("path_cache_size", &cfg.PathCacheSize),
+		values.Duration("cache_ttl", &cfg.CacheTTL),
+		values.Duration("site_list_ttl", &cfg.SiteListTTL),
+		values.Bool("detect_maintenance", &cfg.DetectMaintenance),
+		values.Bool("serialize_site_mutations", &cfg.SerializeSiteMutations),
+		values.Bool("read_only", &cfg.ReadOnly),
+		values.Bool("log_update_diffs", &cfg.LogUpdateDiffs),
+		values.Bool("wait_past_deadline", &cfg.WaitPastDeadline),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse config values")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,158 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/config"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestNewFromConfigFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name:    "yaml",
+			file:    "unifi.yaml",
+			content: "controller_url: %s\napi_key: %s\nlog_level: off\n",
+		},
+		{
+			name:    "toml",
+			file:    "unifi.toml",
+			content: "controller_url = \"%s\"\napi_key = \"%s\"\nmax_retries = 1\n",
+		},
+		{
+			name:    "json",
+			file:    "unifi.json",
+			content: `{"controller_url": "%s", "api_key": "%s", "timeout": "5s"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites", testAPIKey,
+				testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+			defer server.Close()
+
+			content := fmt.Sprintf(tt.content, server.URL, testAPIKey)
+			client, err := NewFromConfigFile(writeConfigFile(t, tt.file, content))
+			require.NoError(t, err)
+
+			resp, err := client.ListSites(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Len(t, resp.Data, 1)
+		})
+	}
+}
+
+func TestNewFromConfigFileErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "unknown key", content: "controller_url: https://unifi.local\napi_key: x\napi_kye: y\n", wantErr: config.ErrInvalidConfig},
+		{name: "invalid value", content: "controller_url: https://unifi.local\napi_key: x\ntimeout: soon\n", wantErr: config.ErrInvalidConfig},
+		{name: "missing API key", content: "controller_url: https://unifi.local\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewFromConfigFile(writeConfigFile(t, "unifi.yaml", tt.content))
+			require.Error(t, err)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigFromValues(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := configFromValues(config.Values{
+		"controller_url":          "https://unifi.local",
+		"api_key":                 testAPIKey,
+		"insecure_skip_verify":    "false",
+		"rate_limit_per_minute":   "600",
+		"max_retries":             "2",
+		"retry_wait_time":         "500ms",
+		"retry_max_wait_time":     "5s",
+		"retry_jitter":            "0.2",
+		"timeout":                 "-1s",
+		"response_header_timeout": "20s",
+		"user_agent":              "my-exporter/1.2",
+		"controller_version":      "8.7.11",
+		"site_list_ttl":           "5m",
+		"strict_decoding":         "fail",
+		"retain_raw_json":         "true",
+		"log_level":               "debug",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://unifi.local", cfg.ControllerURL)
+	assert.Equal(t, testAPIKey, cfg.APIKey)
+	assert.False(t, cfg.InsecureSkipVerify)
+	assert.Equal(t, 600, cfg.RateLimitPerMinute)
+	assert.Equal(t, 2, cfg.MaxRetries)
+	assert.Equal(t, 500*time.Millisecond, cfg.RetryWaitTime)
+	assert.Equal(t, 5*time.Second, cfg.RetryMaxWaitTime)
+	assert.InDelta(t, 0.2, cfg.RetryJitter, 0)
+	assert.Equal(t, -time.Second, cfg.Timeout)
+	assert.Equal(t, 20*time.Second, cfg.ResponseHeaderTimeout)
+	assert.Equal(t, "my-exporter/1.2", cfg.UserAgent)
+	assert.Equal(t, "8.7.11", cfg.ControllerVersion)
+	assert.Equal(t, 5*time.Minute, cfg.SiteListTTL)
+	assert.Equal(t, StrictDecodingFail, cfg.StrictDecoding)
+	assert.True(t, cfg.RetainRawJSON)
+	assert.NotNil(t, cfg.Logger)
+
+	defaults, err := configFromValues(config.Values{"controller_url": "https://unifi.local", "api_key": testAPIKey})
+	require.NoError(t, err)
+	assert.True(t, defaults.InsecureSkipVerify, "should default to skipping verification like New")
+	assert.Nil(t, defaults.Logger)
+}
+
+func TestNewFromEnv(t *testing.T) {
+	server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites", testAPIKey,
+		testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	t.Setenv("UNIFI_CONTROLLER_URL", server.URL)
+	t.Setenv("UNIFI_API_KEY", testAPIKey)
+	t.Setenv("UNIFI_MAX_RETRIES", "1")
+
+	client, err := NewFromEnv()
+	require.NoError(t, err)
+
+	resp, err := client.ListSites(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Len(t, resp.Data, 1)
+}
@@ -0,0 +1,77 @@
+package network
+
+import "time"
+
+// ControllerState is the lifecycle state of the Network application.
+type ControllerState string
+
+// Controller states reported by GetControllerStatus.
+const (
+	// ControllerReady means the Network application is serving requests.
+	ControllerReady ControllerState = "ready"
+	// ControllerStarting means the Network application is starting up.
+	ControllerStarting ControllerState = "starting"
+	// ControllerMigrating means the Network application is migrating its database.
+	ControllerMigrating ControllerState = "migrating"
+	// ControllerUpdating means the Network application or UniFi OS is being updated.
+	ControllerUpdating ControllerState = "updating"
+	// ControllerMaintenance means the controller is in maintenance for an unspecified reason.
+	ControllerMaintenance ControllerState = "maintenance"
+)
+
+// ControllerStatus reports whether the Network application can serve requests.
+type ControllerStatus struct {
+	// State is the lifecycle state of the Network application.
+	State ControllerState
+
+	// Version is the Network application version, empty while in maintenance.
+	Version string
+
+	// RetryAfter is the wait suggested by the controller while in maintenance, or zero.
+	RetryAfter time.Duration
+}
+
+// IsReady reports whether the Network application is serving requests.
+func (s *ControllerStatus) IsReady() bool {
+	return s.State == ControllerReady
+}
+
+// ControllerTime is the clock of the controller compared to the local clock, for
+// detecting drift, which breaks certificate validation and scheduled rules.
+type ControllerTime struct {
+	// Time is the controller clock when it answered, with one-second resolution.
+	Time time.Time
+
+	// Timezone is the IANA timezone configured on the controller, such as "Europe/Berlin".
+	Timezone string
+
+	// Drift is the controller clock minus the local clock, accurate to about a second
+	// plus half of RoundTrip. A positive drift means the controller is ahead.
+	Drift time.Duration
+
+	// RoundTrip is the duration of the request the time was read from.
+	RoundTrip time.Duration
+
+	// NTP is how the controller synchronizes its clock.
+	NTP NTPStatus
+}
+
+// NTPStatus is the NTP configuration of the controller.
+type NTPStatus struct {
+	// Preference is NTPPreferenceAuto for the Ubiquiti pool or NTPPreferenceManual for
+	// custom servers.
+	Preference NTPSettingsSettingPreference
+
+	// Servers are the custom NTP servers, used only with NTPPreferenceManual.
+	Servers []string
+}
+
+// InSync reports whether the controller clock is within tolerance of the local clock.
+// The local clock is assumed to be synchronized.
+func (t *ControllerTime) InSync(tolerance time.Duration) bool {
+	drift := t.Drift
+	if drift < 0 {
+		drift = -drift
+	}
+	return drift <= tolerance
+}
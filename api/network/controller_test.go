@@ -0,0 +1,229 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestGetControllerStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		detectMaintenance bool
+		statusCode        int
+		body              string
+		wantState         ControllerState
+		wantVersion       string
+		wantRetryAfter    time.Duration
+	}{
+		{
+			name:        "ready",
+			statusCode:  http.StatusOK,
+			body:        testdata.LoadFixture(t, "controller/status_up.json"),
+			wantState:   ControllerReady,
+			wantVersion: "9.0.114",
+		},
+		{
+			name:        "starting",
+			statusCode:  http.StatusOK,
+			body:        `{"meta":{"rc":"ok","up":false,"server_version":"9.0.114"},"data":[]}`,
+			wantState:   ControllerStarting,
+			wantVersion: "9.0.114",
+		},
+		{
+			name:              "updating with detection",
+			statusCode:        http.StatusServiceUnavailable,
+			detectMaintenance: true,
+			body:              testdata.LoadFixture(t, "errors/maintenance.json"),
+			wantState:         ControllerUpdating,
+			wantRetryAfter:    time.Minute,
+		},
+		{
+			name:           "migrating without detection",
+			statusCode:     http.StatusServiceUnavailable,
+			body:           `{"meta":{"rc":"error","msg":"api.err.DatabaseMigrating"}}`,
+			wantState:      ControllerMigrating,
+			wantRetryAfter: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/proxy/network/status", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Retry-After", "60")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			})
+			defer server.Close()
+
+			client, err := NewWithConfig(&ClientConfig{
+				ControllerURL:     server.URL,
+				APIKey:            testAPIKey,
+				RetryWaitTime:     time.Millisecond,
+				DetectMaintenance: tt.detectMaintenance,
+			})
+			require.NoError(t, err)
+
+			status, err := client.GetControllerStatus(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantState, status.State)
+			assert.Equal(t, tt.wantState == ControllerReady, status.IsReady())
+			assert.Equal(t, tt.wantVersion, status.Version)
+			assert.Equal(t, tt.wantRetryAfter, status.RetryAfter)
+		})
+	}
+}
+
+func TestGetControllerStatusServerError(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/proxy/network/status", testAPIKey,
+		testdata.LoadFixture(t, "errors/server_error.json"), http.StatusServiceUnavailable)
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        testAPIKey,
+		RetryWaitTime: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	_, err = client.GetControllerStatus(context.Background())
+	require.ErrorIs(t, err, unifierr.ErrUnavailable)
+	assert.NotErrorIs(t, err, unifierr.ErrControllerMaintenance)
+}
+
+func TestDetectMaintenance(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/maintenance.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL:     server.URL,
+		APIKey:            testAPIKey,
+		RetryWaitTime:     time.Millisecond,
+		DetectMaintenance: true,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListSites(context.Background(), nil)
+	require.ErrorIs(t, err, unifierr.ErrControllerMaintenance)
+	require.ErrorIs(t, err, unifierr.ErrUnavailable)
+
+	var maintenance *unifierr.MaintenanceError
+	require.ErrorAs(t, err, &maintenance)
+	assert.Equal(t, "updating", maintenance.State)
+	assert.Equal(t, int32(1), attempts.Load(), "maintenance responses should not be retried")
+}
+
+func TestGetControllerTime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		offset      time.Duration
+		ntp         string
+		wantInSync  bool
+		wantPref    NTPSettingsSettingPreference
+		wantServers []string
+	}{
+		{
+			name:        "in sync",
+			ntp:         testdata.LoadFixture(t, "controller/ntp_settings.json"),
+			wantInSync:  true,
+			wantPref:    NTPPreferenceManual,
+			wantServers: []string{"0.pool.ntp.org", "1.pool.ntp.org"},
+		},
+		{
+			name:     "ahead with default NTP",
+			offset:   5 * time.Minute,
+			ntp:      `{"meta":{"rc":"ok"},"data":[]}`,
+			wantPref: NTPPreferenceAuto,
+		},
+		{
+			name:     "behind",
+			offset:   -time.Hour,
+			ntp:      `{"meta":{"rc":"ok"},"data":[{"key":"ntp","setting_preference":"auto"}]}`,
+			wantPref: NTPPreferenceAuto,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/proxy/network/api/s/" + testSiteInternal + "/stat/sysinfo":
+					w.Header().Set("Date", time.Now().Add(tt.offset).UTC().Format(http.TimeFormat))
+					_, _ = w.Write([]byte(testdata.LoadFixture(t, "controller/sysinfo.json")))
+				case "/proxy/network/api/s/" + testSiteInternal + "/get/setting/ntp":
+					_, _ = w.Write([]byte(tt.ntp))
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+			defer server.Close()
+
+			client := newTestClient(t, server.URL)
+
+			clock, err := client.GetControllerTime(context.Background(), testSiteInternal)
+			require.NoError(t, err)
+			assert.Equal(t, "Europe/Berlin", clock.Timezone)
+			assert.InDelta(t, tt.offset.Seconds(), clock.Drift.Seconds(), 1.5)
+			assert.Equal(t, tt.wantInSync, clock.InSync(2*time.Second))
+			assert.Equal(t, tt.wantPref, clock.NTP.Preference)
+			assert.Equal(t, tt.wantServers, clock.NTP.Servers)
+			assert.False(t, clock.Time.IsZero())
+		})
+	}
+}
+
+func TestGetControllerTimeBypassesCache(t *testing.T) {
+	t.Parallel()
+
+	var sysinfo atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/proxy/network/api/s/"+testSiteInternal+"/stat/sysinfo" {
+			sysinfo.Add(1)
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "controller/sysinfo.json")))
+			return
+		}
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "controller/ntp_settings.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, CacheTTL: time.Minute})
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err := client.GetControllerTime(context.Background(), testSiteInternal)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), sysinfo.Load(), "a cached Date header would hide drift")
+}
@@ -0,0 +1,110 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// redactedDiffFields are parts of the names of JSON fields whose values are never
+// logged in update diffs, only that they changed. Fields prefixed with "x_" hold
+// secrets on UniFi controllers and are redacted as well.
+var redactedDiffFields = []string{"password", "passphrase", "secret", "private_key"}
+
+// updateDiff holds an object as it was before an update, see ClientConfig.LogUpdateDiffs.
+type updateDiff struct {
+	operation string
+	site      string
+	id        string
+	before    any
+}
+
+// log logs at Info level the fields of the update input that differ from the object
+// before the update. d may be nil.
+func (d *updateDiff) log(logger observability.Logger, input any) {
+	if d == nil {
+		return
+	}
+	changes, err := fieldChanges(d.before, input)
+	if err != nil {
+		logger.Warn("cannot compute update diff",
+			observability.Field{Key: "operation", Value: d.operation},
+			observability.Field{Key: "error", Value: err.Error()},
+		)
+		return
+	}
+	logger.Info("object updated",
+		observability.Field{Key: "operation", Value: d.operation},
+		observability.Field{Key: "site", Value: d.site},
+		observability.Field{Key: "id", Value: d.id},
+		observability.Field{Key: "changes", Value: changes},
+	)
+}
+
+// fieldChanges compares the JSON fields of input, the body of an update, with the same
+// fields of before and describes every difference as "field: before -> after", sorted
+// by field. Fields missing from before are shown as null.
+func fieldChanges(before, input any) ([]string, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, err
+	}
+	inputFields, err := jsonFields(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	for field, after := range inputFields {
+		old, ok := beforeFields[field]
+		if !ok {
+			old = json.RawMessage("null")
+		}
+		if bytes.Equal(old, after) {
+			continue
+		}
+		if redactedDiffField(field) {
+			changes = append(changes, field+": [redacted]")
+			continue
+		}
+		changes = append(changes, field+": "+string(old)+" -> "+string(after))
+	}
+	slices.Sort(changes)
+	return changes, nil
+}
+
+// jsonFields returns the top-level fields of the JSON encoding of v, compacted so
+// that equal values compare equal.
+func jsonFields(v any) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode object")
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode object fields")
+	}
+	for field, value := range fields {
+		var compact bytes.Buffer
+		if json.Compact(&compact, value) == nil {
+			fields[field] = compact.Bytes()
+		}
+	}
+	return fields, nil
+}
+
+func redactedDiffField(field string) bool {
+	field = strings.ToLower(field)
+	if strings.HasPrefix(field, "x_") {
+		return true
+	}
+	return slices.ContainsFunc(redactedDiffFields, func(secret string) bool {
+		return strings.Contains(field, secret)
+	})
+}
@@ -0,0 +1,139 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// diffLogger records the fields of info entries on top of the noop logger.
+type diffLogger struct {
+	observability.Logger
+
+	mu      sync.Mutex
+	entries []map[string]any
+}
+
+func (l *diffLogger) Info(_ string, fields ...observability.Field) {
+	entry := make(map[string]any, len(fields))
+	for _, field := range fields {
+		entry[field.Key] = field.Value
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+func TestLogUpdateDiffs(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var requests []string
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/list_success.json")))
+			return
+		}
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/single_record.json")))
+	})
+	defer server.Close()
+
+	logger := &diffLogger{Logger: observability.NoopLogger()}
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL:  server.URL,
+		APIKey:         testAPIKey,
+		Logger:         logger,
+		LogUpdateDiffs: true,
+		CacheTTL:       time.Minute,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Warm the cache: the diff must not be computed against a cached list
+	_, err = client.ListDNSRecords(ctx, testSiteInternal)
+	require.NoError(t, err)
+
+	_, err = client.UpdateDNSRecord(ctx, testSiteInternal, testRecordID, &DNSRecordInput{
+		Key:        testHostKey,
+		RecordType: DNSRecordInputRecordTypeA,
+		Value:      "192.168.100.9",
+		Ttl:        ptr(300),
+	})
+	require.NoError(t, err)
+
+	mu.Lock()
+	assert.Equal(t, []string{http.MethodGet, http.MethodGet, http.MethodPut}, requests)
+	mu.Unlock()
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	require.Len(t, logger.entries, 1)
+	assert.Equal(t, map[string]any{
+		"operation": "UpdateDNSRecord",
+		"site":      testSiteInternal,
+		"id":        testRecordID,
+		"changes":   []string{"ttl: 0 -> 300", `value: "192.168.100.1" -> "192.168.100.9"`},
+	}, logger.entries[0])
+}
+
+func TestLogUpdateDiffsDisabled(t *testing.T) {
+	t.Parallel()
+
+	var methods []string
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/single_record.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	_, err := client.UpdateDNSRecord(context.Background(), testSiteInternal, testRecordID, &DNSRecordInput{
+		Key:        testHostKey,
+		RecordType: DNSRecordInputRecordTypeA,
+		Value:      testHostValue,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{http.MethodPut}, methods, "no object is fetched without LogUpdateDiffs")
+}
+
+func TestFieldChanges(t *testing.T) {
+	t.Parallel()
+
+	type object struct {
+		Name       string   `json:"name"`
+		Members    []string `json:"members,omitempty"`
+		Passphrase string   `json:"passphrase,omitempty"`
+		XSecretKey string   `json:"x_key,omitempty"`
+		Comment    *string  `json:"comment,omitempty"`
+	}
+
+	before := object{Name: "guests", Members: []string{"a", "b"}, Passphrase: "old", XSecretKey: "k1"}
+	input := object{Name: "guests", Members: []string{"a", "c"}, Passphrase: "new", XSecretKey: "k2", Comment: ptr("note")}
+
+	changes, err := fieldChanges(before, input)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`comment: null -> "note"`,
+		`members: ["a","b"] -> ["a","c"]`,
+		"passphrase: [redacted]",
+		"x_key: [redacted]",
+	}, changes)
+
+	changes, err = fieldChanges(before, before)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,170 @@
+package network
+
+import (
+	"math"
+	"net/netip"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidDNSRecord is returned when a DNSRecordInput cannot be applied by the controller.
+var ErrInvalidDNSRecord = errors.New("invalid DNS record")
+
+// maxTXTLength is the longest TXT value, a single DNS character-string.
+const maxTXTLength = 255
+
+// NewCNAMERecord returns an enabled record making name an alias of target.
+func NewCNAMERecord(name, target string) (*DNSRecordInput, error) {
+	return newDNSRecord(&DNSRecordInput{RecordType: DNSRecordInputRecordTypeCNAME, Key: name, Value: target})
+}
+
+// NewMXRecord returns an enabled record routing mail for domain to the mail server
+// host. Servers with a lower priority are tried first.
+func NewMXRecord(domain, host string, priority int) (*DNSRecordInput, error) {
+	return newDNSRecord(&DNSRecordInput{RecordType: DNSRecordInputRecordTypeMX, Key: domain, Value: host, Priority: &priority})
+}
+
+// NewTXTRecord returns an enabled record attaching text to name, such as an SPF
+// policy or a domain verification token.
+func NewTXTRecord(name, text string) (*DNSRecordInput, error) {
+	return newDNSRecord(&DNSRecordInput{RecordType: DNSRecordInputRecordTypeTXT, Key: name, Value: text})
+}
+
+// NewSRVRecord returns an enabled record locating service over proto for domain
+// at target:port, named "_service._proto.domain" as RFC 2782 requires. The
+// underscores may be included in service and proto or left out. Targets with a
+// lower priority are tried first; weight balances targets of equal priority.
+//
+// Example:
+//
+//	// _ldap._tcp.corp.example.com -> dc1.corp.example.com:389
+//	record, err := network.NewSRVRecord("ldap", "tcp", "corp.example.com", "dc1.corp.example.com", 389, 0, 100)
+//	if err != nil {
+//	    return err
+//	}
+//	_, err = client.CreateDNSRecord(ctx, "default", record)
+func NewSRVRecord(service, proto, domain, target string, port, priority, weight int) (*DNSRecordInput, error) {
+	name := "_" + strings.TrimPrefix(service, "_") + "._" + strings.TrimPrefix(proto, "_") + "." + domain
+	return newDNSRecord(&DNSRecordInput{
+		RecordType: DNSRecordInputRecordTypeSRV,
+		Key:        name,
+		Value:      target,
+		Port:       &port,
+		Priority:   &priority,
+		Weight:     &weight,
+	})
+}
+
+// newDNSRecord enables and validates record.
+func newDNSRecord(record *DNSRecordInput) (*DNSRecordInput, error) {
+	enabled := true
+	record.Enabled = &enabled
+	err := record.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Validate checks that the record has a valid name and a value of its type, and
+// that port, priority and weight are set exactly when the type uses them: port
+// and weight for SRV, priority for MX and SRV. Errors match ErrInvalidDNSRecord.
+func (r *DNSRecordInput) Validate() error {
+	if !validHostname(r.Key) {
+		return errors.Wrapf(ErrInvalidDNSRecord, "invalid name %q", r.Key)
+	}
+	if r.Ttl != nil && *r.Ttl < 0 {
+		return errors.Wrapf(ErrInvalidDNSRecord, "TTL must not be negative, got %d", *r.Ttl)
+	}
+
+	err := r.validateValue()
+	if err != nil {
+		return err
+	}
+
+	srv := r.RecordType == DNSRecordInputRecordTypeSRV
+	err = validateDNSField(r.RecordType, "port", r.Port, srv, 1)
+	if err != nil {
+		return err
+	}
+	err = validateDNSField(r.RecordType, "priority", r.Priority, srv || r.RecordType == DNSRecordInputRecordTypeMX, 0)
+	if err != nil {
+		return err
+	}
+	return validateDNSField(r.RecordType, "weight", r.Weight, srv, 0)
+}
+
+// validateValue checks the value of the record against its type.
+func (r *DNSRecordInput) validateValue() error {
+	switch r.RecordType {
+	case DNSRecordInputRecordTypeA, DNSRecordInputRecordTypeAAAA:
+		ipv4 := r.RecordType == DNSRecordInputRecordTypeA
+		addr, err := netip.ParseAddr(r.Value)
+		if err != nil || addr.Zone() != "" || addr.Is4() != ipv4 {
+			family := "IPv6"
+			if ipv4 {
+				family = "IPv4"
+			}
+			return errors.Wrapf(ErrInvalidDNSRecord, "%s record value %q is not an %s address", r.RecordType, r.Value, family)
+		}
+	case DNSRecordInputRecordTypeCNAME, DNSRecordInputRecordTypeMX, DNSRecordInputRecordTypeNS:
+		if !validHostname(r.Value) {
+			return errors.Wrapf(ErrInvalidDNSRecord, "%s record target %q is not a hostname", r.RecordType, r.Value)
+		}
+		if r.RecordType == DNSRecordInputRecordTypeCNAME && strings.EqualFold(strings.TrimSuffix(r.Key, "."), strings.TrimSuffix(r.Value, ".")) {
+			return errors.Wrapf(ErrInvalidDNSRecord, "CNAME record %q points to itself", r.Key)
+		}
+	case DNSRecordInputRecordTypeSRV:
+		labels := strings.SplitN(r.Key, ".", 3)
+		if len(labels) < 3 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return errors.Wrapf(ErrInvalidDNSRecord, "SRV record name %q is not _service._proto.domain", r.Key)
+		}
+		if !validHostname(r.Value) {
+			return errors.Wrapf(ErrInvalidDNSRecord, "SRV record target %q is not a hostname", r.Value)
+		}
+	case DNSRecordInputRecordTypeTXT:
+		if r.Value == "" || len(r.Value) > maxTXTLength {
+			return errors.Wrapf(ErrInvalidDNSRecord, "TXT record value must be 1 to %d bytes, got %d", maxTXTLength, len(r.Value))
+		}
+	default:
+		return errors.Wrapf(ErrInvalidDNSRecord, "unknown record type %q", r.RecordType)
+	}
+	return nil
+}
+
+// validateDNSField checks that an SRV or MX field is set if and only if used,
+// and holds a 16-bit value of at least lowest.
+func validateDNSField(recordType DNSRecordInputRecordType, field string, value *int, used bool, lowest int) error {
+	switch {
+	case !used && value != nil:
+		return errors.Wrapf(ErrInvalidDNSRecord, "%s records have no %s", recordType, field)
+	case used && value == nil:
+		return errors.Wrapf(ErrInvalidDNSRecord, "%s record requires a %s", recordType, field)
+	case used && (*value < lowest || *value > math.MaxUint16):
+		return errors.Wrapf(ErrInvalidDNSRecord, "%s record %s must be between %d and %d, got %d",
+			recordType, field, lowest, math.MaxUint16, *value)
+	}
+	return nil
+}
+
+// validHostname reports whether name is a DNS name, possibly of a single label as
+// local hostnames are, with an optional trailing dot. Underscores are accepted for
+// service labels.
+func validHostname(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > maxDomainLength {
+		return false
+	}
+	for label := range strings.SplitSeq(name, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,153 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestDNSRecordConstructors(t *testing.T) {
+	t.Parallel()
+
+	srv, err := NewSRVRecord("_ldap", "tcp", "corp.example.com", "dc1.corp.example.com", 389, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, DNSRecordInputRecordTypeSRV, srv.RecordType)
+	assert.Equal(t, "_ldap._tcp.corp.example.com", srv.Key)
+	assert.Equal(t, "dc1.corp.example.com", srv.Value)
+	assert.Equal(t, 389, *srv.Port)
+	assert.Equal(t, 0, *srv.Priority)
+	assert.Equal(t, 100, *srv.Weight)
+	assert.True(t, *srv.Enabled)
+
+	mx, err := NewMXRecord("example.com", "mail.example.com", 10)
+	require.NoError(t, err)
+	assert.Equal(t, 10, *mx.Priority)
+	assert.Nil(t, mx.Port)
+	assert.Nil(t, mx.Weight)
+
+	txt, err := NewTXTRecord("example.com", "v=spf1 mx -all")
+	require.NoError(t, err)
+	assert.Equal(t, "v=spf1 mx -all", txt.Value)
+
+	cname, err := NewCNAMERecord("www.example.com", "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, DNSRecordInputRecordTypeCNAME, cname.RecordType)
+
+	_, err = NewSRVRecord("sip", "udp", "example.com", "pbx.example.com", 0, 10, 5)
+	require.ErrorIs(t, err, ErrInvalidDNSRecord)
+	_, err = NewMXRecord("example.com", "10.0.0.25 ", 10)
+	require.ErrorIs(t, err, ErrInvalidDNSRecord)
+	_, err = NewTXTRecord("example.com", "")
+	require.ErrorIs(t, err, ErrInvalidDNSRecord)
+	_, err = NewCNAMERecord("www.example.com", "WWW.example.com.")
+	require.ErrorIs(t, err, ErrInvalidDNSRecord)
+}
+
+func TestDNSRecordInputValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		record  DNSRecordInput
+		wantErr bool
+	}{
+		{name: "A", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeA, Key: "nas", Value: "192.168.1.10"}},
+		{name: "AAAA", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeAAAA, Key: "nas.lan", Value: "fd00::10"}},
+		{name: "NS", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeNS, Key: "lab.example.com", Value: "ns1.example.com."}},
+		{name: "SRV", record: DNSRecordInput{
+			RecordType: DNSRecordInputRecordTypeSRV, Key: "_sip._udp.example.com", Value: "pbx.example.com",
+			Port: ptr(5060), Priority: ptr(10), Weight: ptr(0),
+		}},
+		{name: "zero TTL", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeA, Key: "nas", Value: "10.0.0.1", Ttl: ptr(0)}},
+		{name: "unknown type", record: DNSRecordInput{RecordType: "PTR", Key: "nas", Value: "10.0.0.1"}, wantErr: true},
+		{name: "empty name", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeA, Value: "10.0.0.1"}, wantErr: true},
+		{name: "invalid name", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeA, Key: "my nas", Value: "10.0.0.1"}, wantErr: true},
+		{name: "negative TTL", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeA, Key: "nas", Value: "10.0.0.1", Ttl: ptr(-1)}, wantErr: true},
+		{name: "A with IPv6", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeA, Key: "nas", Value: "fd00::10"}, wantErr: true},
+		{name: "AAAA with IPv4", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeAAAA, Key: "nas", Value: "10.0.0.1"}, wantErr: true},
+		{name: "A with hostname", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeA, Key: "nas", Value: "nas.lan"}, wantErr: true},
+		{name: "A with port", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeA, Key: "nas", Value: "10.0.0.1", Port: ptr(80)}, wantErr: true},
+		{name: "MX without priority", record: DNSRecordInput{RecordType: DNSRecordInputRecordTypeMX, Key: "example.com", Value: "mail.example.com"}, wantErr: true},
+		{name: "MX with weight", record: DNSRecordInput{
+			RecordType: DNSRecordInputRecordTypeMX, Key: "example.com", Value: "mail.example.com", Priority: ptr(10), Weight: ptr(1),
+		}, wantErr: true},
+		{name: "SRV without weight", record: DNSRecordInput{
+			RecordType: DNSRecordInputRecordTypeSRV, Key: "_sip._udp.example.com", Value: "pbx.example.com", Port: ptr(5060), Priority: ptr(10),
+		}, wantErr: true},
+		{name: "SRV with large port", record: DNSRecordInput{
+			RecordType: DNSRecordInputRecordTypeSRV, Key: "_sip._udp.example.com", Value: "pbx.example.com",
+			Port: ptr(70000), Priority: ptr(10), Weight: ptr(0),
+		}, wantErr: true},
+		{name: "SRV with negative priority", record: DNSRecordInput{
+			RecordType: DNSRecordInputRecordTypeSRV, Key: "_sip._udp.example.com", Value: "pbx.example.com",
+			Port: ptr(5060), Priority: ptr(-1), Weight: ptr(0),
+		}, wantErr: true},
+		{name: "SRV without service labels", record: DNSRecordInput{
+			RecordType: DNSRecordInputRecordTypeSRV, Key: "sip.example.com", Value: "pbx.example.com",
+			Port: ptr(5060), Priority: ptr(10), Weight: ptr(0),
+		}, wantErr: true},
+		{name: "long TXT", record: DNSRecordInput{
+			RecordType: DNSRecordInputRecordTypeTXT, Key: "example.com", Value: strings.Repeat("x", maxTXTLength+1),
+		}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.record.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidDNSRecord)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateDNSRecordValidates(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"enabled":     true,
+			"key":         "_minecraft._tcp.example.com",
+			"record_type": "SRV",
+			"value":       "mc.example.com",
+			"port":        float64(25565),
+			"priority":    float64(0),
+			"weight":      float64(5),
+		}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/single_record.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	record, err := NewSRVRecord("minecraft", "tcp", "example.com", "mc.example.com", 25565, 0, 5)
+	require.NoError(t, err)
+	_, err = client.CreateDNSRecord(context.Background(), testSiteInternal, record)
+	require.NoError(t, err)
+
+	record.Weight = nil
+	_, err = client.CreateDNSRecord(context.Background(), testSiteInternal, record)
+	require.ErrorIs(t, err, ErrInvalidDNSRecord)
+	_, err = client.UpdateDNSRecord(context.Background(), testSiteInternal, testRecordID, record)
+	require.ErrorIs(t, err, ErrInvalidDNSRecord)
+	assert.Equal(t, int32(1), requests.Load(), "invalid records must not reach the controller")
+}
@@ -22,9 +22,13 @@
 //
 //   - Site management and listing
 //   - Device inventory and monitoring (routers, switches, access points)
-//   - Client tracking and access control (wired/wireless)
+//   - Client tracking and access control (wired/wireless), including batch blocking
 //   - Real-time status information
 //   - Port and radio interface details
+//   - WLAN MAC allow/deny lists and client isolation
+//   - Access point groups with membership reconciliation
+//   - IP addressing audits: duplicate IPs and MACs, out-of-subnet reservations
+//   - Client connection history export as RADIUS-accounting style records
 //
 // # Basic Usage
 //
@@ -79,6 +83,22 @@
 //	    RetryWaitTime:      2 * time.Second,    // Custom retry wait
 //	})
 //
+// # Response Caching
+//
+// Setting CacheTTL caches successful GET responses. Mutations made through the
+// client invalidate the cached responses of the resources they touch (creating a
+// DNS record refetches DNS records but keeps firewall policies cached), so callers
+// never read lists older than their own writes. InvalidateCache drops everything,
+// e.g. after changes made in the UniFi UI.
+//
+// # Configuration Files and Environment
+//
+// NewFromEnv builds a client from UNIFI_* environment variables (UNIFI_CONTROLLER_URL,
+// UNIFI_API_KEY, UNIFI_TIMEOUT, ...), and NewFromConfigFile from a flat YAML, TOML or
+// JSON file using the same snake_case keys:
+//
+//	client, err := network.NewFromConfigFile("unifi.yaml")
+//
 // # Error Handling
 //
 // The client uses github.com/cockroachdb/errors for enhanced error handling:
@@ -90,12 +110,69 @@
 //	    return
 //	}
 //
+// Failed API calls match the sentinel errors in github.com/lexfrei/go-unifi/unifierr
+// (ErrNotFound, ErrUnauthorized, ErrRateLimited, ErrUnavailable, ErrValidation):
+//
+//	if errors.Is(err, unifierr.ErrNotFound) {
+//	    // Device no longer exists
+//	}
+//
+// # Schema Drift Detection
+//
+// Responses are decoded leniently by default: fields the client does not model are ignored.
+// To detect controller schema changes early, for example in staging, enable strict decoding:
+//
+//	client, err := network.NewWithConfig(&network.ClientConfig{
+//	    ControllerURL:  "https://unifi.local",
+//	    APIKey:         "your-api-key",
+//	    StrictDecoding: network.StrictDecodingFail, // or StrictDecodingLog to only warn
+//	})
+//
+// With StrictDecodingFail, calls whose responses contain unknown fields fail with an error
+// matching unifierr.ErrUnknownField.
+//
+// To read fields the client does not model yet without a second request, enable
+// RetainRawJSON. Decoded models such as Device, DeviceListItem, and ClientListItem then
+// carry their original JSON in the RawJSON field:
+//
+//	device, err := client.GetDeviceByID(ctx, siteID, deviceID)
+//	var extra struct {
+//	    Uptime int `json:"uptime"`
+//	}
+//	err = json.Unmarshal(device.RawJSON, &extra)
+//
+// A single malformed element fails a whole list by default. Monitoring loops that
+// prefer partial data can enable LenientDecoding: ListSiteClients and ListSiteDevices
+// then skip the elements that fail to decode, log them via Logger, and report them
+// in the Warnings field of the response:
+//
+//	clients, err := client.ListSiteClients(ctx, siteID, nil)
+//	for _, w := range clients.Warnings {
+//	    log.Printf("skipped client %d: %v", w.Index, w.Err)
+//	}
+//
+// # Site Identifiers
+//
+// Integration v1 endpoints identify sites by UUID (SiteId), while v2 endpoints use the
+// site internal reference (Site, e.g. "default"). Methods taking a Site also accept a
+// site UUID and translate it internally using a cached site list. To obtain the UUID
+// for v1 methods from either form, use ResolveSiteID:
+//
+//	siteID, err := client.ResolveSiteID(ctx, "default")
+//	if errors.Is(err, network.ErrSiteNotFound) {
+//	    // No site with this UUID or internal reference
+//	}
+//
 // # Rate Limiting
 //
 // The client automatically handles rate limiting with a default limit of 1000 requests/minute.
 // Requests are throttled locally to prevent hitting API rate limits, and retried automatically
 // if the API returns 429 (Too Many Requests).
 //
+// When the context deadline arrives before the local rate limiter would release a request,
+// the request fails immediately with an error matching unifierr.ErrWouldExceedDeadline.
+// Set ClientConfig.WaitPastDeadline to wait for the deadline instead.
+//
 // # Retry Logic
 //
 // Failed requests are automatically retried up to 3 times (configurable) with exponential backoff:
@@ -104,7 +181,24 @@
 //   - 5xx server errors
 //   - 429 rate limit errors (respects Retry-After header)
 //
-// Client errors (4xx) are not retried.
+// Client errors (4xx) are not retried. Set ClientConfig.OnRetryDecision to observe each
+// retry, including the failed response headers, or to stop retrying early.
+//
+// ClientConfig.RetryBudgetPerMinute caps the retries of all requests sharing the client,
+// so an outage does not multiply the request volume. Failures that find the budget empty
+// are returned as *unifierr.RetryBudgetError, matching unifierr.ErrRetryBudgetExhausted.
+//
+// # Controller Maintenance
+//
+// While the Network application starts, migrates its database, or updates, UniFi OS
+// answers with 503 maintenance pages. With ClientConfig.DetectMaintenance set, such
+// responses are not retried but fail immediately with an error matching
+// unifierr.ErrControllerMaintenance. GetControllerStatus reports the current state:
+//
+//	status, err := client.GetControllerStatus(ctx)
+//	if err == nil && !status.IsReady() {
+//	    // status.State is ControllerStarting, ControllerMigrating or ControllerUpdating
+//	}
 //
 // # TLS/SSL Certificates
 //
@@ -0,0 +1,129 @@
+package network
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// DownloadProgress is called by Download after each chunk written, with the number of
+// bytes written so far and the total size, or -1 if the server did not announce it.
+type DownloadProgress func(written, total int64)
+
+// Download streams a binary response, such as a backup, support file or firmware
+// image, to w and returns the number of bytes written. The generated clients only
+// handle JSON, so binary endpoints go through Download instead.
+//
+// urlOrPath is either a path relative to the Network application, e.g.
+// "/dl/autobackup/autobackup_9.0.114.unf", or an absolute URL. The API key is only
+// sent to the controller, never to other hosts such as firmware mirrors.
+//
+// The request goes through the same rate limiting, retries and observability as API
+// calls, but bypasses the response cache and ClientConfig.Timeout: bound it with ctx.
+// Retries cover failed responses; an error while streaming the body is returned
+// with the bytes written so far. A non-200 response is an error matching the
+// unifierr sentinel for its status. progress may be nil.
+//
+// Example:
+//
+//	f, err := os.Create("backup.unf")
+//	...
+//	n, err := client.Download(ctx, "/dl/autobackup/autobackup_9.0.114.unf", f,
+//	    func(written, total int64) { fmt.Printf("\r%d/%d bytes", written, total) })
+func (c *APIClient) Download(ctx context.Context, urlOrPath string, w io.Writer, progress DownloadProgress) (int64, error) {
+	ctx = middleware.WithOperation(ctx, "Download")
+	target, err := c.downloadURL(urlOrPath)
+	if err != nil {
+		return 0, err
+	}
+
+	errorMsg := "failed to download " + target.Redacted()
+	req, err := http.NewRequestWithContext(middleware.WithoutCache(ctx), http.MethodGet, target.String(), http.NoBody)
+	if err != nil {
+		return 0, errors.Wrap(err, errorMsg)
+	}
+	req.Header.Set("Accept", "*/*")
+	if strings.EqualFold(target.Host, c.baseURL.Host) {
+		req.Header.Set(APIKeyHeader, c.apiKey)
+	}
+
+	resp, err := c.downloader.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, errorMsg)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.Wrap(errors.WithStack(response.NewAPIError(resp, nil)), errorMsg)
+	}
+
+	if progress != nil {
+		w = &progressWriter{w: w, total: resp.ContentLength, progress: progress}
+	}
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return n, errors.Wrap(err, errorMsg)
+	}
+	return n, nil
+}
+
+// DownloadSupportFile generates a support file (see GenerateSupportFile) and streams
+// it to w, so that tooling can attach diagnostics to a ticket in one call. It returns
+// the number of bytes written.
+//
+// Example:
+//
+//	f, err := os.Create("support.tar.gz")
+//	...
+//	_, err = client.DownloadSupportFile(ctx, "default", f, nil)
+func (c *APIClient) DownloadSupportFile(ctx context.Context, site Site, w io.Writer, progress DownloadProgress) (int64, error) {
+	file, err := c.GenerateSupportFile(ctx, site)
+	if err != nil {
+		return 0, err
+	}
+	return c.Download(ctx, file.URL, w, progress)
+}
+
+// downloadURL resolves a Download target against the Network application base URL.
+func (c *APIClient) downloadURL(urlOrPath string) (*url.URL, error) {
+	if urlOrPath == "" {
+		return nil, errors.Wrap(unifierr.ErrValidation, "download URL or path is required")
+	}
+	ref, err := url.Parse(urlOrPath)
+	if err != nil {
+		return nil, errors.Wrapf(unifierr.ErrValidation, "invalid download URL %q: %v", urlOrPath, err)
+	}
+	if ref.IsAbs() {
+		return ref, nil
+	}
+
+	target := *c.baseURL
+	target.Path = strings.TrimSuffix(target.Path, "/") + "/" + strings.TrimPrefix(ref.Path, "/")
+	target.RawPath = ""
+	target.RawQuery = ref.RawQuery
+	return &target, nil
+}
+
+// progressWriter reports the bytes written through it to a DownloadProgress.
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	total    int64
+	progress DownloadProgress
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	p.progress(p.written, p.total)
+	//nolint:wrapcheck // Errors of the destination writer are returned as is to io.Copy
+	return n, err
+}
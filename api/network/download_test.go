@@ -0,0 +1,145 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestDownload(t *testing.T) {
+	t.Parallel()
+
+	blob := bytes.Repeat([]byte{0xde, 0xad, 0xbe, 0xef}, 64<<10)
+	const backupPath = "/dl/autobackup/autobackup_9.0.114.unf"
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/proxy/network" + backupPath:
+			assert.Equal(t, testAPIKey, r.Header.Get("X-API-KEY"))
+			assert.Equal(t, "v=1", r.URL.RawQuery)
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Length", strconv.Itoa(len(blob)))
+			_, _ = w.Write(blob)
+		case "/flaky":
+			if requests.Load() == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte("firmware"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	t.Run("relative path with progress", func(t *testing.T) {
+		client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, CacheTTL: time.Minute})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		var calls int
+		var last [2]int64
+		n, err := client.Download(context.Background(), backupPath+"?v=1", &buf, func(written, total int64) {
+			calls++
+			last = [2]int64{written, total}
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(blob)), n)
+		assert.Equal(t, blob, buf.Bytes())
+		assert.Greater(t, calls, 1, "progress should be reported per chunk")
+		assert.Equal(t, [2]int64{int64(len(blob)), int64(len(blob))}, last)
+	})
+
+	t.Run("retried absolute URL", func(t *testing.T) {
+		requests.Store(0)
+		client := newTestClient(t, server.URL)
+
+		var buf bytes.Buffer
+		n, err := client.Download(context.Background(), server.URL+"/flaky", &buf, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), n)
+		assert.Equal(t, "firmware", buf.String())
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client := newTestClient(t, server.URL)
+
+		_, err := client.Download(WithRequestID(context.Background(), "backup-7"), "/dl/missing.unf", &bytes.Buffer{}, nil)
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+		var apiErr *unifierr.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "backup-7", apiErr.RequestID)
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		client := newTestClient(t, server.URL)
+
+		_, err := client.Download(context.Background(), "", &bytes.Buffer{}, nil)
+		require.ErrorIs(t, err, unifierr.ErrValidation)
+	})
+}
+
+func TestDownloadAPIKeyScope(t *testing.T) {
+	t.Parallel()
+
+	mirror := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("X-API-KEY"), "the API key should not leak to other hosts")
+		_, _ = w.Write([]byte("firmware"))
+	})
+	defer mirror.Close()
+
+	client := newTestClient(t, "https://unifi.invalid")
+
+	var buf bytes.Buffer
+	_, err := client.Download(context.Background(), mirror.URL+"/fw/image.bin", &buf, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "firmware", buf.String())
+}
+
+func TestDownloadSupportFile(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/proxy/network/api/s/"+testSiteInternal+"/cmd/system":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"cmd": "gen-support-file"}`, string(body))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "controller/support_file.json")))
+		case r.Method == http.MethodGet && r.URL.Path == "/proxy/network/dl/support/support_20261016-0930.tar.gz":
+			_, _ = w.Write([]byte("diagnostics"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	file, err := client.GenerateSupportFile(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	assert.Equal(t, "/dl/support/support_20261016-0930.tar.gz", file.URL)
+
+	var buf bytes.Buffer
+	n, err := client.DownloadSupportFile(context.Background(), testSiteInternal, &buf, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len("diagnostics")), n)
+	assert.Equal(t, "diagnostics", buf.String())
+}
@@ -0,0 +1,138 @@
+package network
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Names of commonly blocked DPI applications, for DPICatalog.AppIDs.
+const (
+	DPIAppBitTorrent = "BitTorrent"
+	DPIAppDiscord    = "Discord"
+	DPIAppFacebook   = "Facebook"
+	DPIAppInstagram  = "Instagram"
+	DPIAppNetflix    = "Netflix"
+	DPIAppSteam      = "Steam"
+	DPIAppTikTok     = "TikTok"
+	DPIAppTwitch     = "Twitch"
+	DPIAppYouTube    = "YouTube"
+)
+
+// Names of DPI application categories, for DPICatalog.CategoryIDs.
+const (
+	DPICategoryGames         = "Games"
+	DPICategoryPeerToPeer    = "Peer-to-peer networks"
+	DPICategorySocialNetwork = "Social networks"
+	DPICategoryStreaming     = "Media streaming services"
+)
+
+// GetDPIApplicationCatalog retrieves the applications and categories recognized by
+// deep packet inspection. The catalog is shared by all sites and changes only with
+// controller updates, so callers building many rules should fetch it once.
+func (c *APIClient) GetDPIApplicationCatalog(ctx context.Context) (*DPICatalog, error) {
+	ctx = middleware.WithOperation(ctx, "GetDPIApplicationCatalog")
+	resp, err := c.client.GetDPIApplicationCatalogWithResponse(ctx)
+	var data *DPICatalog
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get DPI application catalog")
+}
+
+// App returns the application with the given name, compared case-insensitively.
+func (c *DPICatalog) App(name string) (*DPIApplication, bool) {
+	for i := range c.Applications {
+		if strings.EqualFold(c.Applications[i].Name, name) {
+			return &c.Applications[i], true
+		}
+	}
+	return nil, false
+}
+
+// Category returns the category with the given name, compared case-insensitively.
+func (c *DPICatalog) Category(name string) (*DPICategory, bool) {
+	for i := range c.Categories {
+		if strings.EqualFold(c.Categories[i].Name, name) {
+			return &c.Categories[i], true
+		}
+	}
+	return nil, false
+}
+
+// AppsInCategory returns the applications of a category.
+func (c *DPICatalog) AppsInCategory(categoryID int) []DPIApplication {
+	var apps []DPIApplication
+	for _, app := range c.Applications {
+		if app.CategoryID == categoryID {
+			apps = append(apps, app)
+		}
+	}
+	return apps
+}
+
+// AppIDs returns the IDs of the named applications in the format of
+// TrafficRuleInput.AppIds. Names the catalog does not know are reported together
+// in an error matching unifierr.ErrNotFound.
+//
+// Example:
+//
+//	catalog, err := client.GetDPIApplicationCatalog(ctx)
+//	...
+//	ids, err := catalog.AppIDs(network.DPIAppNetflix, network.DPIAppBitTorrent)
+//	...
+//	_, err = client.CreateTrafficRule(ctx, "default", &network.TrafficRuleInput{
+//	    Enabled:        true,
+//	    Action:         &block, // "BLOCK"
+//	    MatchingTarget: network.TrafficRuleInputMatchingTargetAPP,
+//	    AppIds:         &ids,
+//	})
+func (c *DPICatalog) AppIDs(names ...string) ([]string, error) {
+	return lookupDPIIDs("application", names, func(name string) (int, bool) {
+		app, ok := c.App(name)
+		if !ok {
+			return 0, false
+		}
+		return app.Id, true
+	})
+}
+
+// CategoryIDs returns the IDs of the named categories in the format of
+// TrafficRuleInput.AppCategoryIds. Names the catalog does not know are reported
+// together in an error matching unifierr.ErrNotFound.
+func (c *DPICatalog) CategoryIDs(names ...string) ([]string, error) {
+	return lookupDPIIDs("category", names, func(name string) (int, bool) {
+		category, ok := c.Category(name)
+		if !ok {
+			return 0, false
+		}
+		return category.Id, true
+	})
+}
+
+// lookupDPIIDs resolves names to traffic rule IDs, collecting unknown names.
+func lookupDPIIDs(kind string, names []string, lookup func(string) (int, bool)) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	var unknown []string
+	for _, name := range names {
+		id, ok := lookup(name)
+		if !ok {
+			unknown = append(unknown, strconv.Quote(name))
+			continue
+		}
+		ids = append(ids, strconv.Itoa(id))
+	}
+	if len(unknown) > 0 {
+		return nil, errors.Wrapf(unifierr.ErrNotFound, "unknown DPI %s %s", kind, strings.Join(unknown, ", "))
+	}
+	return ids, nil
+}
@@ -0,0 +1,80 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestGetDPIApplicationCatalog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		wantErr        bool
+	}{
+		{name: "success", mockResponse: testdata.LoadFixture(t, "traffic/dpi_catalog.json"), mockStatusCode: http.StatusOK},
+		{name: "unauthorized", mockResponse: testdata.LoadFixture(t, "errors/unauthorized.json"), mockStatusCode: http.StatusUnauthorized, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, V2BasePath+"/dpi/catalog", testAPIKey, tt.mockResponse, tt.mockStatusCode)
+			defer server.Close()
+
+			catalog, err := newTestClient(t, server.URL).GetDPIApplicationCatalog(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, catalog.Categories, 3)
+			assert.Len(t, catalog.Applications, 5)
+		})
+	}
+}
+
+func TestDPICatalogLookup(t *testing.T) {
+	t.Parallel()
+
+	var catalog DPICatalog
+	testdata.LoadFixtureJSON(t, "traffic/dpi_catalog.json", &catalog)
+
+	app, ok := catalog.App("netflix")
+	require.True(t, ok)
+	assert.Equal(t, 589885, app.Id)
+	_, ok = catalog.App("Hulu")
+	assert.False(t, ok)
+
+	category, ok := catalog.Category(DPICategoryPeerToPeer)
+	require.True(t, ok)
+	apps := catalog.AppsInCategory(category.Id)
+	require.Len(t, apps, 2)
+	assert.Equal(t, DPIAppBitTorrent, apps[0].Name)
+
+	ids, err := catalog.AppIDs(DPIAppNetflix, DPIAppBitTorrent)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"589885", "327681"}, ids)
+
+	categoryIDs, err := catalog.CategoryIDs(DPICategoryGames, DPICategoryStreaming)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"4", "9"}, categoryIDs)
+
+	_, err = catalog.AppIDs(DPIAppNetflix, "Hulu", "Myspace")
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+	assert.Contains(t, err.Error(), `"Hulu", "Myspace"`)
+
+	_, err = catalog.CategoryIDs("Astrology")
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+}
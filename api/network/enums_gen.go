@@ -0,0 +1,635 @@
+// Code generated by enumgen from generated.go. DO NOT EDIT.
+
+package network
+
+import "strconv"
+
+// IsKnown reports whether e is one of the values of AdminActivityAction defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e AdminActivityAction) IsKnown() bool {
+	switch e {
+	case AdminActionBackupCreated, AdminActionBackupRestored, AdminActionClientBlocked, AdminActionClientUnblocked, AdminActionDeviceAdopted, AdminActionDeviceForgotten, AdminActionDeviceRestarted, AdminActionDeviceUpgraded, AdminActionFirewallChanged, AdminActionLogin, AdminActionLoginFailed, AdminActionLogout, AdminActionNetworkChanged, AdminActionSettingsChanged, AdminActionWLANChanged:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e AdminActivityAction) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of ClientAccessType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e ClientAccessType) IsKnown() bool {
+	switch e {
+	case BLOCKED, DEFAULT, RESTRICTED:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e ClientAccessType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of ClientCommandRequestCmd defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e ClientCommandRequestCmd) IsKnown() bool {
+	switch e {
+	case ClientCommandBlock, ClientCommandKick, ClientCommandUnauthorizeGuest, ClientCommandUnblock:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e ClientCommandRequestCmd) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of ClientListItemType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e ClientListItemType) IsKnown() bool {
+	switch e {
+	case WIRED, WIRELESS:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e ClientListItemType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of ClientSessionsRequestType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e ClientSessionsRequestType) IsKnown() bool {
+	switch e {
+	case ClientSessionsAll, ClientSessionsGuest, ClientSessionsUser:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e ClientSessionsRequestType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DNSRecordInputRecordType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DNSRecordInputRecordType) IsKnown() bool {
+	switch e {
+	case DNSRecordInputRecordTypeA, DNSRecordInputRecordTypeAAAA, DNSRecordInputRecordTypeCNAME, DNSRecordInputRecordTypeMX, DNSRecordInputRecordTypeNS, DNSRecordInputRecordTypeSRV, DNSRecordInputRecordTypeTXT:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DNSRecordInputRecordType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DNSRecordRecordType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DNSRecordRecordType) IsKnown() bool {
+	switch e {
+	case DNSRecordRecordTypeA, DNSRecordRecordTypeAAAA, DNSRecordRecordTypeCNAME, DNSRecordRecordTypeMX, DNSRecordRecordTypeNS, DNSRecordRecordTypeSRV, DNSRecordRecordTypeTXT:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DNSRecordRecordType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DeviceCommandRequestCmd defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DeviceCommandRequestCmd) IsKnown() bool {
+	switch e {
+	case DeviceCommandAdopt:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DeviceCommandRequestCmd) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DeviceIPMode defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DeviceIPMode) IsKnown() bool {
+	switch e {
+	case DeviceIPDHCP, DeviceIPStatic:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DeviceIPMode) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DeviceListItemFeatures defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DeviceListItemFeatures) IsKnown() bool {
+	switch e {
+	case AccessPoint, Gateway, Routing, Switching:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DeviceListItemFeatures) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DeviceListItemInterfaces defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DeviceListItemInterfaces) IsKnown() bool {
+	switch e {
+	case Ports, Radios, Wan:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DeviceListItemInterfaces) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DeviceListItemState defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DeviceListItemState) IsKnown() bool {
+	switch e {
+	case DeviceListItemStateOFFLINE, DeviceListItemStateONLINE, DeviceListItemStatePROVISIONING, DeviceListItemStateUPGRADING:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DeviceListItemState) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of DeviceState defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e DeviceState) IsKnown() bool {
+	switch e {
+	case DeviceStateOFFLINE, DeviceStateONLINE, DeviceStatePROVISIONING, DeviceStateUPGRADING:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e DeviceState) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of FirewallPolicyAction defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e FirewallPolicyAction) IsKnown() bool {
+	switch e {
+	case FirewallPolicyActionALLOW, FirewallPolicyActionDROP, FirewallPolicyActionREJECT:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e FirewallPolicyAction) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of FirewallPolicyInputAction defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e FirewallPolicyInputAction) IsKnown() bool {
+	switch e {
+	case FirewallPolicyInputActionALLOW, FirewallPolicyInputActionDROP, FirewallPolicyInputActionREJECT:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e FirewallPolicyInputAction) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of FirewallPolicyInputIpVersion defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e FirewallPolicyInputIpVersion) IsKnown() bool {
+	switch e {
+	case FirewallPolicyInputIpVersionBOTH, FirewallPolicyInputIpVersionIPV4, FirewallPolicyInputIpVersionIPV6:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e FirewallPolicyInputIpVersion) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of FirewallPolicyIpVersion defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e FirewallPolicyIpVersion) IsKnown() bool {
+	switch e {
+	case FirewallPolicyIpVersionBOTH, FirewallPolicyIpVersionIPV4, FirewallPolicyIpVersionIPV6:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e FirewallPolicyIpVersion) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of GuestAuthMethod defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e GuestAuthMethod) IsKnown() bool {
+	switch e {
+	case GuestAuthAPI, GuestAuthNone, GuestAuthPassword, GuestAuthPayment, GuestAuthRADIUS, GuestAuthVoucher:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e GuestAuthMethod) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of HealthStatus defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e HealthStatus) IsKnown() bool {
+	switch e {
+	case HealthError, HealthOK, HealthUnknown, HealthWarning:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e HealthStatus) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of HealthSubsystem defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e HealthSubsystem) IsKnown() bool {
+	switch e {
+	case HealthLAN, HealthVPN, HealthWAN, HealthWLAN, HealthWWW:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e HealthSubsystem) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of HotspotVoucherStatus defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e HotspotVoucherStatus) IsKnown() bool {
+	switch e {
+	case EXPIRED, USED, VALIDMULTI, VALIDONE:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e HotspotVoucherStatus) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of IPsecEncryption defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e IPsecEncryption) IsKnown() bool {
+	switch e {
+	case IPsecEncryption3DES, IPsecEncryptionAES128, IPsecEncryptionAES192, IPsecEncryptionAES256:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e IPsecEncryption) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of IPsecHash defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e IPsecHash) IsKnown() bool {
+	switch e {
+	case IPsecHashMD5, IPsecHashSHA1, IPsecHashSHA256, IPsecHashSHA384, IPsecHashSHA512:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e IPsecHash) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of IPsecKeyExchange defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e IPsecKeyExchange) IsKnown() bool {
+	switch e {
+	case IPsecKeyExchangeIKEv1, IPsecKeyExchangeIKEv2:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e IPsecKeyExchange) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of MACFilterPolicy defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e MACFilterPolicy) IsKnown() bool {
+	switch e {
+	case MACFilterAllow, MACFilterDeny:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e MACFilterPolicy) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of NTPSettingsSettingPreference defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e NTPSettingsSettingPreference) IsKnown() bool {
+	switch e {
+	case NTPPreferenceAuto, NTPPreferenceManual:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e NTPSettingsSettingPreference) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of PoEStandard defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e PoEStandard) IsKnown() bool {
+	switch e {
+	case N8023af, N8023at, N8023bt:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e PoEStandard) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of PoEState defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e PoEState) IsKnown() bool {
+	switch e {
+	case PoEStateDISABLED, PoEStateDOWN, PoEStateUP:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e PoEState) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of PortConnector defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e PortConnector) IsKnown() bool {
+	switch e {
+	case RJ45, SFP, SFPPLUS:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e PortConnector) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of PortState defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e PortState) IsKnown() bool {
+	switch e {
+	case PortStateDOWN, PortStateUP:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e PortState) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of RadioBand defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e RadioBand) IsKnown() bool {
+	switch e {
+	case RadioBand2G, RadioBand5G, RadioBand6G:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e RadioBand) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of RadioChannelWidthMHz defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e RadioChannelWidthMHz) IsKnown() bool {
+	switch e {
+	case N160, N20, N40, N80:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e RadioChannelWidthMHz) Raw() string {
+	return strconv.FormatInt(int64(e), 10)
+}
+
+// IsKnown reports whether e is one of the values of RadioFrequencyGHz defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e RadioFrequencyGHz) IsKnown() bool {
+	switch e {
+	case N24, N5, N6:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e RadioFrequencyGHz) Raw() string {
+	return strconv.FormatFloat(float64(e), 'f', -1, 32)
+}
+
+// IsKnown reports whether e is one of the values of RadioWlanStandard defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e RadioWlanStandard) IsKnown() bool {
+	switch e {
+	case N80211a, N80211ac, N80211ax, N80211b, N80211be, N80211g, N80211n:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e RadioWlanStandard) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of STPState defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e STPState) IsKnown() bool {
+	switch e {
+	case STPStateBlocking, STPStateBroken, STPStateDisabled, STPStateDiscarding, STPStateForwarding, STPStateLearning, STPStateListening:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e STPState) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of ScheduleMode defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e ScheduleMode) IsKnown() bool {
+	switch e {
+	case ScheduleModeAlways, ScheduleModeCustom, ScheduleModeEveryDay, ScheduleModeEveryWeek, ScheduleModeOneTime:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e ScheduleMode) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of ScheduleWeekday defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e ScheduleWeekday) IsKnown() bool {
+	switch e {
+	case ScheduleFriday, ScheduleMonday, ScheduleSaturday, ScheduleSunday, ScheduleThursday, ScheduleTuesday, ScheduleWednesday:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e ScheduleWeekday) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of SystemCommandRequestCmd defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e SystemCommandRequestCmd) IsKnown() bool {
+	switch e {
+	case SystemCommandGenerateSupportFile:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e SystemCommandRequestCmd) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of TrafficRuleInputMatchingTarget defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e TrafficRuleInputMatchingTarget) IsKnown() bool {
+	switch e {
+	case TrafficRuleInputMatchingTargetAPP, TrafficRuleInputMatchingTargetAPPCATEGORY, TrafficRuleInputMatchingTargetCLIENT, TrafficRuleInputMatchingTargetDOMAIN, TrafficRuleInputMatchingTargetINTERNET, TrafficRuleInputMatchingTargetIP, TrafficRuleInputMatchingTargetNETWORK, TrafficRuleInputMatchingTargetREGION:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e TrafficRuleInputMatchingTarget) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of TrafficRuleMatchingTarget defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e TrafficRuleMatchingTarget) IsKnown() bool {
+	switch e {
+	case TrafficRuleMatchingTargetAPP, TrafficRuleMatchingTargetAPPCATEGORY, TrafficRuleMatchingTargetCLIENT, TrafficRuleMatchingTargetDOMAIN, TrafficRuleMatchingTargetINTERNET, TrafficRuleMatchingTargetIP, TrafficRuleMatchingTargetNETWORK, TrafficRuleMatchingTargetREGION:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e TrafficRuleMatchingTarget) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of TrafficRuleTargetType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e TrafficRuleTargetType) IsKnown() bool {
+	switch e {
+	case TrafficRuleTargetAllClients, TrafficRuleTargetClient, TrafficRuleTargetNetwork:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e TrafficRuleTargetType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of TxPowerMode defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e TxPowerMode) IsKnown() bool {
+	switch e {
+	case TxPowerAuto, TxPowerCustom, TxPowerHigh, TxPowerLow, TxPowerMedium:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e TxPowerMode) Raw() string {
+	return string(e)
+}
@@ -0,0 +1,25 @@
+package network
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumUnknownValues(t *testing.T) {
+	t.Parallel()
+
+	var device DeviceListItem
+	require.NoError(t, json.Unmarshal([]byte(`{"state": "ISOLATED", "features": ["switching", "mesh"]}`), &device))
+
+	assert.False(t, device.State.IsKnown())
+	assert.Equal(t, "ISOLATED", device.State.Raw(), "unknown values are preserved")
+	assert.True(t, device.Features[0].IsKnown())
+	assert.False(t, device.Features[1].IsKnown())
+	assert.True(t, DeviceListItemStateONLINE.IsKnown())
+
+	assert.Equal(t, "2.4", N24.Raw())
+	assert.False(t, RadioChannelWidthMHz(320).IsKnown())
+}
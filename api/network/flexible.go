@@ -0,0 +1,101 @@
+package network
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// FlexibleInt is an integer that also decodes from the forms some controller
+// firmware sends instead of a JSON number: numeric strings ("1000"), integral
+// floats (1000.0) and empty strings, which decode as zero. It encodes as a number.
+type FlexibleInt int64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *FlexibleInt) UnmarshalJSON(data []byte) error {
+	s, ok, err := flexibleScalar(data)
+	if err != nil || !ok {
+		return err
+	}
+	if s == "" {
+		*i = 0
+		return nil
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*i = FlexibleInt(n)
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return errors.Newf("cannot decode %s as integer", data)
+	}
+	*i = FlexibleInt(f)
+	return nil
+}
+
+// Int64 returns the value as int64.
+func (i FlexibleInt) Int64() int64 {
+	return int64(i)
+}
+
+// FlexibleBool is a boolean that also decodes from the forms some controller
+// firmware sends instead of a JSON boolean: strings such as "true", "1", "yes"
+// or "on", numbers (non-zero is true) and empty strings, which decode as false.
+// It encodes as a boolean.
+type FlexibleBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *FlexibleBool) UnmarshalJSON(data []byte) error {
+	s, ok, err := flexibleScalar(data)
+	if err != nil || !ok {
+		return err
+	}
+
+	switch strings.ToLower(s) {
+	case "true", "t", "yes", "y", "on":
+		*b = true
+		return nil
+	case "false", "f", "no", "n", "off", "":
+		*b = false
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) {
+		return errors.Newf("cannot decode %s as boolean", data)
+	}
+	*b = f != 0
+	return nil
+}
+
+// Bool returns the value as bool.
+func (b FlexibleBool) Bool() bool {
+	return bool(b)
+}
+
+// flexibleScalar returns the trimmed text of a JSON scalar: the contents of a string,
+// or the literal of a number or boolean. It reports false for null, which leaves the
+// target unchanged like encoding/json does.
+func flexibleScalar(data []byte) (string, bool, error) {
+	data = []byte(strings.TrimSpace(string(data)))
+	switch {
+	case len(data) == 0:
+		return "", false, errors.New("empty JSON value")
+	case string(data) == "null":
+		return "", false, nil
+	case data[0] == '"':
+		var s string
+		err := json.Unmarshal(data, &s)
+		if err != nil {
+			return "", false, errors.Wrap(err, "failed to decode string")
+		}
+		return strings.TrimSpace(s), true, nil
+	case data[0] == '{' || data[0] == '[':
+		return "", false, errors.Newf("cannot decode %s as scalar", data)
+	default:
+		return string(data), true, nil
+	}
+}
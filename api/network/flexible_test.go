@@ -0,0 +1,219 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestFlexibleInt_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    FlexibleInt
+		wantErr bool
+	}{
+		{name: "number", input: `1000`, want: 1000},
+		{name: "negative", input: `-1`, want: -1},
+		{name: "numeric string", input: `"1000"`, want: 1000},
+		{name: "padded string", input: `" 42 "`, want: 42},
+		{name: "integral float", input: `20000.0`, want: 20000},
+		{name: "exponent", input: `1e3`, want: 1000},
+		{name: "empty string", input: `""`, want: 0},
+		{name: "int64 counter", input: `"9007199254740993"`, want: 9007199254740993},
+		{name: "fraction", input: `1.5`, wantErr: true},
+		{name: "text", input: `"fast"`, wantErr: true},
+		{name: "boolean", input: `true`, wantErr: true},
+		{name: "object", input: `{}`, wantErr: true},
+		{name: "overflow", input: `1e30`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got FlexibleInt
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFlexibleBool_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    FlexibleBool
+		wantErr bool
+	}{
+		{name: "true", input: `true`, want: true},
+		{name: "false", input: `false`, want: false},
+		{name: "string true", input: `"true"`, want: true},
+		{name: "string upper", input: `"TRUE"`, want: true},
+		{name: "string false", input: `"false"`, want: false},
+		{name: "one", input: `1`, want: true},
+		{name: "zero", input: `0`, want: false},
+		{name: "string one", input: `"1"`, want: true},
+		{name: "string zero", input: `"0"`, want: false},
+		{name: "yes", input: `"yes"`, want: true},
+		{name: "off", input: `"off"`, want: false},
+		{name: "empty string", input: `""`, want: false},
+		{name: "text", input: `"maybe"`, wantErr: true},
+		{name: "array", input: `[]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got FlexibleBool
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFlexible_NullKeepsValue(t *testing.T) {
+	t.Parallel()
+
+	var port SwitchPortState
+	require.NoError(t, json.Unmarshal([]byte(`{"port_idx": 1, "speed": null, "up": null}`), &port))
+	assert.Nil(t, port.Speed)
+	assert.Nil(t, port.Up)
+
+	speed, up := FlexibleInt(100), FlexibleBool(true)
+	out, err := json.Marshal(SwitchPortState{PortIdx: 1, Speed: &speed, Up: &up})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"port_idx": 1, "speed": 100, "up": true}`, string(out))
+}
+
+func TestGetPortStates_FirmwareQuirks(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, neighborHandlers(t, testdata.LoadFixture(t, "devices/legacy_device_quirks.json")))
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	ports, err := client.GetPortStates(context.Background(), testSiteID, testNeighborDeviceID)
+	require.NoError(t, err)
+	require.Len(t, ports, 2)
+
+	uplink := ports[0]
+	assert.True(t, uplink.Enable.Bool())
+	assert.True(t, uplink.Up.Bool())
+	assert.False(t, uplink.FullDuplex.Bool())
+	assert.Equal(t, FlexibleInt(1000), *uplink.Speed)
+	assert.Equal(t, FlexibleInt(20000), *uplink.STPPathCost)
+	assert.Equal(t, int64(123456789), uplink.RxBytes.Int64())
+	assert.Equal(t, int64(4), uplink.Errors())
+	assert.Equal(t, int64(12), uplink.Drops())
+
+	assert.False(t, ports[1].Up.Bool())
+	assert.Equal(t, FlexibleInt(100), *ports[1].Speed)
+
+	neighbors, err := client.GetDeviceNeighbors(context.Background(), testSiteID, testNeighborDeviceID)
+	require.NoError(t, err)
+	require.NotEmpty(t, neighbors)
+	assert.Equal(t, FlexibleInt(1), *neighbors[0].LocalPortIdx)
+	assert.True(t, neighbors[0].IsWired.Bool())
+}
+
+func FuzzFlexibleInt(f *testing.F) {
+	for _, seed := range []string{`1000`, `"1000"`, `""`, `20000.0`, `-1`, `null`, `"x"`, `1e30`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v FlexibleInt
+		if json.Unmarshal(data, &v) != nil {
+			return
+		}
+		// Whatever decodes must survive an encode/decode round trip unchanged
+		out, err := json.Marshal(v)
+		require.NoError(t, err)
+		var again FlexibleInt
+		require.NoError(t, json.Unmarshal(out, &again))
+		assert.Equal(t, v, again)
+	})
+}
+
+func FuzzFlexibleBool(f *testing.F) {
+	for _, seed := range []string{`true`, `"false"`, `1`, `"0"`, `""`, `"yes"`, `null`, `{}`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v FlexibleBool
+		if json.Unmarshal(data, &v) != nil {
+			return
+		}
+		out, err := json.Marshal(v)
+		require.NoError(t, err)
+		var again FlexibleBool
+		require.NoError(t, json.Unmarshal(out, &again))
+		assert.Equal(t, v, again)
+	})
+}
+
+// FuzzDecodeResponses feeds fixture-derived documents to the response decoders:
+// malformed input may fail to decode but must never panic.
+func FuzzDecodeResponses(f *testing.F) {
+	err := fs.WalkDir(testdata.FS, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".json") {
+			return err
+		}
+		data, err := testdata.FS.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		f.Add(data)
+		return nil
+	})
+	require.NoError(f, err)
+
+	f.Fuzz(func(_ *testing.T, data []byte) {
+		targets := []any{
+			&SitesResponse{},
+			&DevicesResponse{},
+			&Device{},
+			&ClientsResponse{},
+			&Client{},
+			&LegacyDevicesResponse{},
+			&AdminActivityLogResponse{},
+			&UserGroupsResponse{},
+			&WLANsResponse{},
+			&ControllerStatusResponse{},
+			&DNSRecord{},
+			&FirewallPolicy{},
+			&TrafficRule{},
+			&HotspotVouchersResponse{},
+		}
+		for _, target := range targets {
+			_ = json.Unmarshal(data, target)
+		}
+	})
+}
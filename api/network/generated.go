@@ -26,6 +26,25 @@ const (
 	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 )
 
+// Defines values for AdminActivityAction.
+const (
+	AdminActionBackupCreated   AdminActivityAction = "ADMIN_BACKUP_CREATED"
+	AdminActionBackupRestored  AdminActivityAction = "ADMIN_BACKUP_RESTORED"
+	AdminActionClientBlocked   AdminActivityAction = "ADMIN_CLIENT_BLOCKED"
+	AdminActionClientUnblocked AdminActivityAction = "ADMIN_CLIENT_UNBLOCKED"
+	AdminActionDeviceAdopted   AdminActivityAction = "ADMIN_DEVICE_ADOPTED"
+	AdminActionDeviceForgotten AdminActivityAction = "ADMIN_DEVICE_FORGOTTEN"
+	AdminActionDeviceRestarted AdminActivityAction = "ADMIN_DEVICE_RESTARTED"
+	AdminActionDeviceUpgraded  AdminActivityAction = "ADMIN_DEVICE_UPGRADED"
+	AdminActionFirewallChanged AdminActivityAction = "ADMIN_FIREWALL_CHANGED"
+	AdminActionLogin           AdminActivityAction = "ADMIN_LOGIN"
+	AdminActionLoginFailed     AdminActivityAction = "ADMIN_LOGIN_FAILED"
+	AdminActionLogout          AdminActivityAction = "ADMIN_LOGOUT"
+	AdminActionNetworkChanged  AdminActivityAction = "ADMIN_NETWORK_CHANGED"
+	AdminActionSettingsChanged AdminActivityAction = "ADMIN_SETTINGS_CHANGED"
+	AdminActionWLANChanged     AdminActivityAction = "ADMIN_WLAN_CHANGED"
+)
+
 // Defines values for ClientAccessType.
 const (
 	BLOCKED    ClientAccessType = "BLOCKED"
@@ -33,12 +52,27 @@ const (
 	RESTRICTED ClientAccessType = "RESTRICTED"
 )
 
+// Defines values for ClientCommandRequestCmd.
+const (
+	ClientCommandBlock            ClientCommandRequestCmd = "block-sta"
+	ClientCommandKick             ClientCommandRequestCmd = "kick-sta"
+	ClientCommandUnauthorizeGuest ClientCommandRequestCmd = "unauthorize-guest"
+	ClientCommandUnblock          ClientCommandRequestCmd = "unblock-sta"
+)
+
 // Defines values for ClientListItemType.
 const (
 	WIRED    ClientListItemType = "WIRED"
 	WIRELESS ClientListItemType = "WIRELESS"
 )
 
+// Defines values for ClientSessionsRequestType.
+const (
+	ClientSessionsAll   ClientSessionsRequestType = "all"
+	ClientSessionsGuest ClientSessionsRequestType = "guest"
+	ClientSessionsUser  ClientSessionsRequestType = "user"
+)
+
 // Defines values for DNSRecordRecordType.
 const (
 	DNSRecordRecordTypeA     DNSRecordRecordType = "A"
@@ -69,6 +103,11 @@ const (
 	DeviceStateUPGRADING    DeviceState = "UPGRADING"
 )
 
+// Defines values for DeviceCommandRequestCmd.
+const (
+	DeviceCommandAdopt DeviceCommandRequestCmd = "adopt"
+)
+
 // Defines values for DeviceListItemFeatures.
 const (
 	AccessPoint DeviceListItemFeatures = "accessPoint"
@@ -92,6 +131,12 @@ const (
 	DeviceListItemStateUPGRADING    DeviceListItemState = "UPGRADING"
 )
 
+// Defines values for DeviceIPMode.
+const (
+	DeviceIPDHCP   DeviceIPMode = "dhcp"
+	DeviceIPStatic DeviceIPMode = "static"
+)
+
 // Defines values for FirewallPolicyAction.
 const (
 	FirewallPolicyActionALLOW  FirewallPolicyAction = "ALLOW"
@@ -120,6 +165,16 @@ const (
 	FirewallPolicyInputIpVersionIPV6 FirewallPolicyInputIpVersion = "IPV6"
 )
 
+// Defines values for GuestAuthMethod.
+const (
+	GuestAuthAPI      GuestAuthMethod = "api"
+	GuestAuthNone     GuestAuthMethod = "none"
+	GuestAuthPassword GuestAuthMethod = "password"
+	GuestAuthPayment  GuestAuthMethod = "payment"
+	GuestAuthRADIUS   GuestAuthMethod = "radius"
+	GuestAuthVoucher  GuestAuthMethod = "voucher"
+)
+
 // Defines values for HotspotVoucherStatus.
 const (
 	EXPIRED    HotspotVoucherStatus = "EXPIRED"
@@ -128,6 +183,41 @@ const (
 	VALIDONE   HotspotVoucherStatus = "VALID_ONE"
 )
 
+// Defines values for IPsecEncryption.
+const (
+	IPsecEncryption3DES   IPsecEncryption = "3des"
+	IPsecEncryptionAES128 IPsecEncryption = "aes128"
+	IPsecEncryptionAES192 IPsecEncryption = "aes192"
+	IPsecEncryptionAES256 IPsecEncryption = "aes256"
+)
+
+// Defines values for IPsecHash.
+const (
+	IPsecHashMD5    IPsecHash = "md5"
+	IPsecHashSHA1   IPsecHash = "sha1"
+	IPsecHashSHA256 IPsecHash = "sha256"
+	IPsecHashSHA384 IPsecHash = "sha384"
+	IPsecHashSHA512 IPsecHash = "sha512"
+)
+
+// Defines values for IPsecKeyExchange.
+const (
+	IPsecKeyExchangeIKEv1 IPsecKeyExchange = "ikev1"
+	IPsecKeyExchangeIKEv2 IPsecKeyExchange = "ikev2"
+)
+
+// Defines values for MACFilterPolicy.
+const (
+	MACFilterAllow MACFilterPolicy = "allow"
+	MACFilterDeny  MACFilterPolicy = "deny"
+)
+
+// Defines values for NTPSettingsSettingPreference.
+const (
+	NTPPreferenceAuto   NTPSettingsSettingPreference = "auto"
+	NTPPreferenceManual NTPSettingsSettingPreference = "manual"
+)
+
 // Defines values for PoEStandard.
 const (
 	N8023af PoEStandard = "802.3af"
@@ -142,6 +232,15 @@ const (
 	PoEStateUP       PoEState = "UP"
 )
 
+// Defines values for ScheduleMode.
+const (
+	ScheduleModeAlways    ScheduleMode = "ALWAYS"
+	ScheduleModeCustom    ScheduleMode = "CUSTOM"
+	ScheduleModeEveryDay  ScheduleMode = "EVERY_DAY"
+	ScheduleModeEveryWeek ScheduleMode = "EVERY_WEEK"
+	ScheduleModeOneTime   ScheduleMode = "ONE_TIME_ONLY"
+)
+
 // Defines values for PortConnector.
 const (
 	RJ45    PortConnector = "RJ45"
@@ -181,22 +280,200 @@ const (
 	N80211n  RadioWlanStandard = "802.11n"
 )
 
+// Defines values for RadioBand.
+const (
+	RadioBand2G RadioBand = "ng"
+	RadioBand5G RadioBand = "na"
+	RadioBand6G RadioBand = "6e"
+)
+
+// Defines values for TxPowerMode.
+const (
+	TxPowerAuto   TxPowerMode = "auto"
+	TxPowerCustom TxPowerMode = "custom"
+	TxPowerHigh   TxPowerMode = "high"
+	TxPowerLow    TxPowerMode = "low"
+	TxPowerMedium TxPowerMode = "medium"
+)
+
+// Defines values for STPState.
+const (
+	STPStateBlocking   STPState = "blocking"
+	STPStateBroken     STPState = "broken"
+	STPStateDisabled   STPState = "disabled"
+	STPStateDiscarding STPState = "discarding"
+	STPStateForwarding STPState = "forwarding"
+	STPStateLearning   STPState = "learning"
+	STPStateListening  STPState = "listening"
+)
+
+// Defines values for ScheduleWeekday.
+const (
+	ScheduleFriday    ScheduleWeekday = "fri"
+	ScheduleMonday    ScheduleWeekday = "mon"
+	ScheduleSaturday  ScheduleWeekday = "sat"
+	ScheduleSunday    ScheduleWeekday = "sun"
+	ScheduleThursday  ScheduleWeekday = "thu"
+	ScheduleTuesday   ScheduleWeekday = "tue"
+	ScheduleWednesday ScheduleWeekday = "wed"
+)
+
+// Defines values for HealthStatus.
+const (
+	HealthError   HealthStatus = "error"
+	HealthOK      HealthStatus = "ok"
+	HealthUnknown HealthStatus = "unknown"
+	HealthWarning HealthStatus = "warning"
+)
+
+// Defines values for HealthSubsystem.
+const (
+	HealthLAN  HealthSubsystem = "lan"
+	HealthVPN  HealthSubsystem = "vpn"
+	HealthWAN  HealthSubsystem = "wan"
+	HealthWLAN HealthSubsystem = "wlan"
+	HealthWWW  HealthSubsystem = "www"
+)
+
+// Defines values for SystemCommandRequestCmd.
+const (
+	SystemCommandGenerateSupportFile SystemCommandRequestCmd = "gen-support-file"
+)
+
 // Defines values for TrafficRuleMatchingTarget.
 const (
-	TrafficRuleMatchingTargetCLIENT   TrafficRuleMatchingTarget = "CLIENT"
-	TrafficRuleMatchingTargetINTERNET TrafficRuleMatchingTarget = "INTERNET"
-	TrafficRuleMatchingTargetNETWORK  TrafficRuleMatchingTarget = "NETWORK"
-	TrafficRuleMatchingTargetREGION   TrafficRuleMatchingTarget = "REGION"
+	TrafficRuleMatchingTargetAPP         TrafficRuleMatchingTarget = "APP"
+	TrafficRuleMatchingTargetAPPCATEGORY TrafficRuleMatchingTarget = "APP_CATEGORY"
+	TrafficRuleMatchingTargetCLIENT      TrafficRuleMatchingTarget = "CLIENT"
+	TrafficRuleMatchingTargetDOMAIN      TrafficRuleMatchingTarget = "DOMAIN"
+	TrafficRuleMatchingTargetINTERNET    TrafficRuleMatchingTarget = "INTERNET"
+	TrafficRuleMatchingTargetIP          TrafficRuleMatchingTarget = "IP"
+	TrafficRuleMatchingTargetNETWORK     TrafficRuleMatchingTarget = "NETWORK"
+	TrafficRuleMatchingTargetREGION      TrafficRuleMatchingTarget = "REGION"
 )
 
 // Defines values for TrafficRuleInputMatchingTarget.
 const (
-	TrafficRuleInputMatchingTargetCLIENT   TrafficRuleInputMatchingTarget = "CLIENT"
-	TrafficRuleInputMatchingTargetINTERNET TrafficRuleInputMatchingTarget = "INTERNET"
-	TrafficRuleInputMatchingTargetNETWORK  TrafficRuleInputMatchingTarget = "NETWORK"
-	TrafficRuleInputMatchingTargetREGION   TrafficRuleInputMatchingTarget = "REGION"
+	TrafficRuleInputMatchingTargetAPP         TrafficRuleInputMatchingTarget = "APP"
+	TrafficRuleInputMatchingTargetAPPCATEGORY TrafficRuleInputMatchingTarget = "APP_CATEGORY"
+	TrafficRuleInputMatchingTargetCLIENT      TrafficRuleInputMatchingTarget = "CLIENT"
+	TrafficRuleInputMatchingTargetDOMAIN      TrafficRuleInputMatchingTarget = "DOMAIN"
+	TrafficRuleInputMatchingTargetINTERNET    TrafficRuleInputMatchingTarget = "INTERNET"
+	TrafficRuleInputMatchingTargetIP          TrafficRuleInputMatchingTarget = "IP"
+	TrafficRuleInputMatchingTargetNETWORK     TrafficRuleInputMatchingTarget = "NETWORK"
+	TrafficRuleInputMatchingTargetREGION      TrafficRuleInputMatchingTarget = "REGION"
+)
+
+// Defines values for TrafficRuleTargetType.
+const (
+	TrafficRuleTargetAllClients TrafficRuleTargetType = "ALL_CLIENTS"
+	TrafficRuleTargetClient     TrafficRuleTargetType = "CLIENT"
+	TrafficRuleTargetNetwork    TrafficRuleTargetType = "NETWORK"
 )
 
+// APGroup defines model for APGroup.
+type APGroup struct {
+	// Id Unique identifier of the AP group
+	Id string `json:"_id"`
+
+	// AttrHiddenId Internal marker of built-in groups
+	AttrHiddenId *string `json:"attr_hidden_id,omitempty"`
+
+	// AttrNoDelete Whether the group is built in and cannot be deleted
+	AttrNoDelete *bool `json:"attr_no_delete,omitempty"`
+
+	// DeviceMACs MAC addresses of the access points in the group
+	DeviceMACs []string `json:"device_macs"`
+
+	// Name Display name of the AP group
+	Name string `json:"name"`
+}
+
+// APGroupInput defines model for APGroupInput.
+type APGroupInput struct {
+	// DeviceMACs MAC addresses of the access points in the group
+	DeviceMACs []string `json:"device_macs"`
+
+	// Name Display name of the AP group
+	Name string `json:"name"`
+}
+
+// AdminActivityAction Type of admin activity. Controllers may report actions not listed here;
+// such values are preserved as is.
+type AdminActivityAction string
+
+// AdminActivityEntry defines model for AdminActivityEntry.
+type AdminActivityEntry struct {
+	// Category Event category
+	Category *string `json:"category,omitempty"`
+
+	// Id Unique identifier of the entry
+	Id string `json:"id"`
+
+	// Key Type of admin activity. Controllers may report actions not listed here;
+	// such values are preserved as is.
+	Key AdminActivityAction `json:"key"`
+
+	// Message Human-readable description of the activity
+	Message *string `json:"message,omitempty"`
+
+	// MessageRaw Message template with parameter placeholders
+	MessageRaw *string `json:"message_raw,omitempty"`
+
+	// Parameters Objects referenced by the message, keyed by placeholder name (e.g. ADMIN, DEVICE, WLAN)
+	Parameters *map[string]AdminActivityParameter `json:"parameters,omitempty"`
+
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
+	// Severity Event severity
+	Severity *string `json:"severity,omitempty"`
+
+	// Subcategory Event subcategory
+	Subcategory *string `json:"subcategory,omitempty"`
+
+	// Timestamp Time of the activity (Unix timestamp in milliseconds)
+	Timestamp int64 `json:"timestamp"`
+}
+
+// AdminActivityLogRequest defines model for AdminActivityLogRequest.
+type AdminActivityLogRequest struct {
+	// PageNumber Zero-based page number
+	PageNumber *int `json:"pageNumber,omitempty"`
+
+	// PageSize Maximum number of entries per page
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TimestampFrom Start of the time range (Unix timestamp in milliseconds, inclusive)
+	TimestampFrom int64 `json:"timestampFrom"`
+
+	// TimestampTo End of the time range (Unix timestamp in milliseconds, inclusive)
+	TimestampTo int64 `json:"timestampTo"`
+}
+
+// AdminActivityLogResponse defines model for AdminActivityLogResponse.
+type AdminActivityLogResponse struct {
+	Data []AdminActivityEntry `json:"data"`
+
+	// PageNumber Zero-based number of the returned page
+	PageNumber *int `json:"page_number,omitempty"`
+
+	// TotalElementCount Total number of entries in the time range
+	TotalElementCount *int `json:"total_element_count,omitempty"`
+
+	// TotalPageCount Total number of pages
+	TotalPageCount *int `json:"total_page_count,omitempty"`
+}
+
+// AdminActivityParameter defines model for AdminActivityParameter.
+type AdminActivityParameter struct {
+	// Id Identifier of the referenced object
+	Id *string `json:"id,omitempty"`
+
+	// Name Display name of the referenced object
+	Name *string `json:"name,omitempty"`
+}
+
 // AggregatedDashboard Aggregated dashboard statistics and analytics
 type AggregatedDashboard struct {
 	// DashboardMeta Metadata about the dashboard view
@@ -250,6 +527,9 @@ type AggregatedDashboard struct {
 		UsageByClient *[]map[string]interface{} `json:"usage_by_client,omitempty"`
 	} `json:"most_active_clients,omitempty"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// WifiChannels WiFi channel usage information
 	WifiChannels *struct {
 		// RadioChannels Channel information per radio
@@ -272,6 +552,18 @@ type ClientAccess struct {
 // ClientAccessType Access control type
 type ClientAccessType string
 
+// ClientCommandRequest defines model for ClientCommandRequest.
+type ClientCommandRequest struct {
+	// Cmd Station manager command
+	Cmd ClientCommandRequestCmd `json:"cmd"`
+
+	// Mac MAC address of the target client (lowercase, colon-separated)
+	Mac string `json:"mac"`
+}
+
+// ClientCommandRequestCmd Station manager command
+type ClientCommandRequestCmd string
+
 // ClientListItem defines model for ClientListItem.
 type ClientListItem struct {
 	Access ClientAccess `json:"access"`
@@ -291,6 +583,9 @@ type ClientListItem struct {
 	// Name Display name or hostname of the client
 	Name string `json:"name"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// Type Connection type
 	Type ClientListItemType `json:"type"`
 
@@ -301,6 +596,78 @@ type ClientListItem struct {
 // ClientListItemType Connection type
 type ClientListItemType string
 
+// ClientSession defines model for ClientSession.
+type ClientSession struct {
+	// Id Legacy record identifier of the session
+	Id string `json:"_id"`
+
+	// APMac MAC address of the access point the client was associated with
+	APMac *string `json:"ap_mac,omitempty"`
+
+	// AssocTime Time the session started (Unix timestamp in seconds)
+	AssocTime int64 `json:"assoc_time"`
+
+	// DisassocTime Time the session ended (Unix timestamp in seconds), absent or 0 while connected
+	DisassocTime *int64 `json:"disassoc_time,omitempty"`
+
+	// Duration Length of the session in seconds
+	Duration *int64 `json:"duration,omitempty"`
+
+	// ESSID SSID the client was connected to
+	ESSID *string `json:"essid,omitempty"`
+
+	// Hostname Hostname reported by the client
+	Hostname *string `json:"hostname,omitempty"`
+
+	// IP IP address of the client during the session
+	IP *string `json:"ip,omitempty"`
+
+	// IsGuest Whether the client was connected as a guest
+	IsGuest *bool `json:"is_guest,omitempty"`
+
+	// IsWired Whether the client was connected by cable
+	IsWired *bool `json:"is_wired,omitempty"`
+
+	// Mac MAC address of the client
+	Mac string `json:"mac"`
+
+	// Name Alias assigned to the client
+	Name *string `json:"name,omitempty"`
+
+	// RxBytes Bytes received from the client during the session
+	RxBytes *int64 `json:"rx_bytes,omitempty"`
+
+	// TxBytes Bytes sent to the client during the session
+	TxBytes *int64 `json:"tx_bytes,omitempty"`
+
+	// UserID Legacy record identifier of the known client
+	UserID *string `json:"user_id,omitempty"`
+}
+
+// ClientSessionsRequest defines model for ClientSessionsRequest.
+type ClientSessionsRequest struct {
+	// End End of the time range (Unix timestamp in seconds, inclusive)
+	End int64 `json:"end"`
+
+	// Mac Only return sessions of the client with this MAC address
+	Mac *string `json:"mac,omitempty"`
+
+	// Start Start of the time range (Unix timestamp in seconds, inclusive)
+	Start int64 `json:"start"`
+
+	// Type Kind of sessions to return
+	Type *ClientSessionsRequestType `json:"type,omitempty"`
+}
+
+// ClientSessionsRequestType Kind of sessions to return
+type ClientSessionsRequestType string
+
+// ClientSessionsResponse defines model for ClientSessionsResponse.
+type ClientSessionsResponse struct {
+	Data []ClientSession `json:"data"`
+	Meta LegacyMeta      `json:"meta"`
+}
+
 // ClientsResponse defines model for ClientsResponse.
 type ClientsResponse struct {
 	// Count Number of items in current response
@@ -315,6 +682,66 @@ type ClientsResponse struct {
 
 	// TotalCount Total number of items available
 	TotalCount int `json:"totalCount"`
+
+	// Warnings List elements skipped because they could not be decoded. Client-side only, populated when lenient decoding is enabled.
+	Warnings []DecodeWarning `json:"-"`
+}
+
+// ControllerCertificate defines model for ControllerCertificate.
+type ControllerCertificate struct {
+	// DnsNames Subject alternative DNS names
+	DnsNames *[]string `json:"dns_names,omitempty"`
+
+	// FingerprintSha256 SHA-256 fingerprint of the leaf certificate in hexadecimal
+	FingerprintSha256 *string `json:"fingerprint_sha256,omitempty"`
+
+	// Issuer Common name of the certificate issuer
+	Issuer string `json:"issuer"`
+
+	// NotAfter End of the validity period
+	NotAfter time.Time `json:"not_after"`
+
+	// NotBefore Start of the validity period
+	NotBefore time.Time `json:"not_before"`
+
+	// SelfSigned Whether this is the certificate generated by the console
+	SelfSigned *bool `json:"self_signed,omitempty"`
+
+	// SerialNumber Serial number in hexadecimal
+	SerialNumber *string `json:"serial_number,omitempty"`
+
+	// Subject Common name of the certificate subject
+	Subject string `json:"subject"`
+}
+
+// ControllerCertificateInput defines model for ControllerCertificateInput.
+type ControllerCertificateInput struct {
+	// Certificate PEM certificate chain, leaf first
+	Certificate string `json:"certificate"`
+
+	// PrivateKey PEM private key of the leaf certificate
+	PrivateKey string `json:"private_key"`
+}
+
+// ControllerStatusMeta defines model for ControllerStatusMeta.
+type ControllerStatusMeta struct {
+	// Rc Result code, "ok" on success
+	Rc string `json:"rc"`
+
+	// ServerVersion Version of the Network application
+	ServerVersion *string `json:"server_version,omitempty"`
+
+	// Up Whether the Network application is serving requests
+	Up *FlexibleBool `json:"up,omitempty"`
+
+	// UUID Unique identifier of the Network application instance
+	UUID *string `json:"uuid,omitempty"`
+}
+
+// ControllerStatusResponse defines model for ControllerStatusResponse.
+type ControllerStatusResponse struct {
+	Data *[]map[string]interface{} `json:"data,omitempty"`
+	Meta ControllerStatusMeta      `json:"meta"`
 }
 
 // CreateVouchersRequest defines model for CreateVouchersRequest.
@@ -367,6 +794,9 @@ type DNSRecord struct {
 	// Priority Priority for MX and SRV records
 	Priority *int `json:"priority,omitempty"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// RecordType DNS record type
 	RecordType DNSRecordRecordType `json:"record_type"`
 
@@ -413,6 +843,33 @@ type DNSRecordInput struct {
 // DNSRecordInputRecordType DNS record type
 type DNSRecordInputRecordType string
 
+// DPIApplication defines model for DPIApplication.
+type DPIApplication struct {
+	// CategoryID ID of the category of the application
+	CategoryID int `json:"category_id"`
+
+	// Id Application ID, unique across categories
+	Id int `json:"id"`
+
+	// Name Application name
+	Name string `json:"name"`
+}
+
+// DPICatalog defines model for DPICatalog.
+type DPICatalog struct {
+	Applications []DPIApplication `json:"applications"`
+	Categories   []DPICategory    `json:"categories"`
+}
+
+// DPICategory defines model for DPICategory.
+type DPICategory struct {
+	// Id Category ID
+	Id int `json:"id"`
+
+	// Name Category name
+	Name string `json:"name"`
+}
+
 // Device defines model for Device.
 type Device struct {
 	// ConfigurationId Current configuration identifier
@@ -448,6 +905,9 @@ type Device struct {
 	// ProvisionedAt Timestamp when device was provisioned (RFC3339 format)
 	ProvisionedAt time.Time `json:"provisionedAt"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// State Current operational state
 	State DeviceState `json:"state"`
 
@@ -458,6 +918,18 @@ type Device struct {
 // DeviceState Current operational state
 type DeviceState string
 
+// DeviceCommandRequest defines model for DeviceCommandRequest.
+type DeviceCommandRequest struct {
+	// Cmd Device manager command
+	Cmd DeviceCommandRequestCmd `json:"cmd"`
+
+	// Mac MAC address of the target device (lowercase, colon-separated)
+	Mac string `json:"mac"`
+}
+
+// DeviceCommandRequestCmd Device manager command
+type DeviceCommandRequestCmd string
+
 // DeviceInterfaces Network interfaces available on the device
 type DeviceInterfaces struct {
 	// Ports Physical ethernet ports
@@ -490,6 +962,9 @@ type DeviceListItem struct {
 	// Name Display name of the device
 	Name string `json:"name"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// State Current operational state
 	State DeviceListItemState `json:"state"`
 }
@@ -503,6 +978,30 @@ type DeviceListItemInterfaces string
 // DeviceListItemState Current operational state
 type DeviceListItemState string
 
+// DeviceNetworkConfig Management IP configuration of a device
+type DeviceNetworkConfig struct {
+	// DNS1 Primary DNS server
+	DNS1 *string `json:"dns1,omitempty"`
+
+	// DNS2 Secondary DNS server
+	DNS2 *string `json:"dns2,omitempty"`
+
+	// Gateway Default gateway of the static address
+	Gateway *string `json:"gateway,omitempty"`
+
+	// IP Static IP address
+	IP *string `json:"ip,omitempty"`
+
+	// Netmask Subnet mask of the static address
+	Netmask *string `json:"netmask,omitempty"`
+
+	// Type Address assignment
+	Type DeviceIPMode `json:"type"`
+}
+
+// DeviceIPMode Address assignment
+type DeviceIPMode string
+
 // DevicesResponse defines model for DevicesResponse.
 type DevicesResponse struct {
 	// Count Number of items in current response
@@ -517,6 +1016,24 @@ type DevicesResponse struct {
 
 	// TotalCount Total number of items available
 	TotalCount int `json:"totalCount"`
+
+	// Warnings List elements skipped because they could not be decoded. Client-side only, populated when lenient decoding is enabled.
+	Warnings []DecodeWarning `json:"-"`
+}
+
+// DynamicRoutingSettings FRR configuration running BGP and OSPF on the gateway
+type DynamicRoutingSettings struct {
+	// Description Free-form description of the configuration
+	Description *string `json:"description,omitempty"`
+
+	// Enabled Whether the routing daemons run
+	Enabled bool `json:"enabled"`
+
+	// FRRConfig FRR configuration in the frr.conf format
+	FRRConfig *string `json:"frr_bgpd_config,omitempty"`
+
+	// UploadedFileName Name of the file the configuration was uploaded from in the UI
+	UploadedFileName *string `json:"uploaded_file_name,omitempty"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
@@ -569,8 +1086,11 @@ type FirewallPolicy struct {
 	// Protocol Protocol to match
 	Protocol *string `json:"protocol,omitempty"`
 
-	// Schedule Schedule configuration
-	Schedule *map[string]interface{} `json:"schedule,omitempty"`
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
+	// Schedule When a policy is active. Times are in the site time zone.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
 
 	// Source Source matching configuration
 	Source *map[string]interface{} `json:"source,omitempty"`
@@ -601,6 +1121,9 @@ type FirewallPolicyInput struct {
 
 	// Protocol Protocol to match
 	Protocol *string `json:"protocol,omitempty"`
+
+	// Schedule When a policy is active. Times are in the site time zone.
+	Schedule *PolicySchedule `json:"schedule,omitempty"`
 }
 
 // FirewallPolicyInputAction Action to take when traffic matches this policy
@@ -609,6 +1132,87 @@ type FirewallPolicyInputAction string
 // FirewallPolicyInputIpVersion IP version to match
 type FirewallPolicyInputIpVersion string
 
+// FirewallZone defines model for FirewallZone.
+type FirewallZone struct {
+	// Id Unique identifier of the zone
+	Id string `json:"_id"`
+
+	// DefaultZone Whether this is a built-in zone
+	DefaultZone *bool `json:"default_zone,omitempty"`
+
+	// Name Display name of the zone
+	Name string `json:"name"`
+
+	// NetworkIDs IDs of the networks in the zone
+	NetworkIDs *[]string `json:"network_ids,omitempty"`
+
+	// ZoneKey Key of a built-in zone (internal, external, gateway, vpn, hotspot, dmz); empty for custom zones
+	ZoneKey *string `json:"zone_key,omitempty"`
+}
+
+// GuestAuthorization defines model for GuestAuthorization.
+type GuestAuthorization struct {
+	// Id Legacy record identifier of the authorization
+	Id string `json:"_id"`
+
+	// Amount Amount paid, for payment authorizations
+	Amount *float64 `json:"amount,omitempty"`
+
+	// AuthorizedBy How the guest was authorized
+	AuthorizedBy *GuestAuthMethod `json:"authorized_by,omitempty"`
+
+	// Currency ISO 4217 currency of the amount
+	Currency *string `json:"currency,omitempty"`
+
+	// Duration Authorized duration in minutes
+	Duration *FlexibleInt `json:"duration,omitempty"`
+
+	// End Time the authorization expires or expired (Unix timestamp in seconds)
+	End *int64 `json:"end,omitempty"`
+
+	// Expired Whether the authorization has expired
+	Expired *FlexibleBool `json:"expired,omitempty"`
+
+	// Hostname Hostname reported by the guest
+	Hostname *string `json:"hostname,omitempty"`
+
+	// IP IP address of the guest
+	IP *string `json:"ip,omitempty"`
+
+	// Mac MAC address of the guest
+	Mac string `json:"mac"`
+
+	// RxBytes Bytes received by the guest
+	RxBytes *FlexibleInt `json:"rx_bytes,omitempty"`
+
+	// Start Time the authorization started (Unix timestamp in seconds)
+	Start int64 `json:"start"`
+
+	// TxBytes Bytes sent by the guest
+	TxBytes *FlexibleInt `json:"tx_bytes,omitempty"`
+
+	// VoucherCode Code of the redeemed voucher
+	VoucherCode *string `json:"voucher_code,omitempty"`
+
+	// VoucherID Identifier of the redeemed voucher
+	VoucherID *string `json:"voucher_id,omitempty"`
+}
+
+// GuestAuthMethod How the guest was authorized
+type GuestAuthMethod string
+
+// GuestAuthorizationsRequest defines model for GuestAuthorizationsRequest.
+type GuestAuthorizationsRequest struct {
+	// Within Only return authorizations that started within this many hours
+	Within int `json:"within"`
+}
+
+// GuestAuthorizationsResponse defines model for GuestAuthorizationsResponse.
+type GuestAuthorizationsResponse struct {
+	Data []GuestAuthorization `json:"data"`
+	Meta LegacyMeta           `json:"meta"`
+}
+
 // HotspotVoucher defines model for HotspotVoucher.
 type HotspotVoucher struct {
 	// UnderscoreId Unique identifier for the voucher
@@ -638,6 +1242,9 @@ type HotspotVoucher struct {
 	// Quota Maximum number of times the voucher can be used (0 = unlimited)
 	Quota *int `json:"quota,omitempty"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// SiteId The site this voucher belongs to
 	SiteId *openapi_types.UUID `json:"site_id,omitempty"`
 
@@ -667,72 +1274,352 @@ type HotspotVouchersResponse struct {
 	TotalCount int `json:"totalCount"`
 }
 
-// NetworkClient defines model for NetworkClient.
-type NetworkClient = ClientListItem
+// IPsecEncryption Encryption algorithm of an IPsec phase
+type IPsecEncryption string
 
-// PaginatedResponse defines model for PaginatedResponse.
-type PaginatedResponse struct {
-	// Count Number of items in current response
-	Count int `json:"count"`
+// IPsecHash Hash algorithm of an IPsec phase
+type IPsecHash string
 
-	// Limit Maximum number of items per page
-	Limit int `json:"limit"`
+// IPsecKeyExchange IKE version of a site-to-site VPN
+type IPsecKeyExchange string
 
-	// Offset Number of items skipped
-	Offset int `json:"offset"`
+// KnownClient defines model for KnownClient.
+type KnownClient struct {
+	// Id Legacy record identifier of the client
+	Id string `json:"_id"`
 
-	// TotalCount Total number of items available
-	TotalCount int `json:"totalCount"`
-}
+	// FixedAPEnabled Whether the client may only associate with the access point in fixed_ap_mac
+	FixedAPEnabled *bool `json:"fixed_ap_enabled,omitempty"`
 
-// PoE Power over Ethernet configuration and status
-type PoE struct {
-	// Enabled Whether PoE is enabled on this port
-	Enabled bool `json:"enabled"`
+	// FixedAPMAC MAC address of the access point the client is locked to
+	FixedAPMAC *string `json:"fixed_ap_mac,omitempty"`
 
-	// Standard PoE standard supported
-	Standard PoEStandard `json:"standard"`
+	// FixedIP Reserved IP address, applied only when use_fixedip is set
+	FixedIP *string `json:"fixed_ip,omitempty"`
 
-	// State Current PoE state
-	State PoEState `json:"state"`
+	// Hostname Hostname reported by the client
+	Hostname *string `json:"hostname,omitempty"`
 
-	// Type PoE type number
-	Type int `json:"type"`
-}
+	// Mac MAC address of the client
+	Mac string `json:"mac"`
 
-// PoEStandard PoE standard supported
-type PoEStandard string
+	// Name Alias assigned to the client
+	Name *string `json:"name,omitempty"`
 
-// PoEState Current PoE state
-type PoEState string
+	// NetworkID Identifier of the network the fixed IP is reserved in
+	NetworkID *string `json:"network_id,omitempty"`
 
-// Port defines model for Port.
-type Port struct {
-	// Connector Physical connector type
-	Connector PortConnector `json:"connector"`
+	// UseFixedIP Whether the client has a fixed IP reservation
+	UseFixedIP *bool `json:"use_fixedip,omitempty"`
 
-	// Idx Port index number (1-based)
-	Idx int `json:"idx"`
+	// UsergroupId Identifier of the assigned user group, empty for the default group
+	UsergroupId *string `json:"usergroup_id,omitempty"`
+}
 
-	// MaxSpeedMbps Maximum supported speed in Mbps
-	MaxSpeedMbps int `json:"maxSpeedMbps"`
+// KnownClientInput defines model for KnownClientInput.
+type KnownClientInput struct {
+	// FixedAPEnabled Whether to lock the client to the access point in fixed_ap_mac
+	FixedAPEnabled *bool `json:"fixed_ap_enabled,omitempty"`
 
-	// Poe Power over Ethernet configuration and status
-	Poe *PoE `json:"poe,omitempty"`
+	// FixedAPMAC MAC address of the access point to lock the client to
+	FixedAPMAC *string `json:"fixed_ap_mac,omitempty"`
 
-	// SpeedMbps Current negotiated speed in Mbps
-	SpeedMbps int `json:"speedMbps"`
+	// UsergroupId Identifier of the user group to assign
+	UsergroupId string `json:"usergroup_id"`
+}
 
-	// State Current port state
-	State PortState `json:"state"`
+// KnownClientsResponse defines model for KnownClientsResponse.
+type KnownClientsResponse struct {
+	Data []KnownClient `json:"data"`
+	Meta LegacyMeta    `json:"meta"`
 }
 
-// PortConnector Physical connector type
+// LLDPNeighbor defines model for LLDPNeighbor.
+type LLDPNeighbor struct {
+	// Capabilities Enabled system capabilities (bridge, router, wlan, phone, ...)
+	Capabilities *[]string `json:"capabilities,omitempty"`
+
+	// ChassisID Chassis identifier advertised by the neighbor, usually its MAC address
+	ChassisID *string `json:"chassis_id,omitempty"`
+
+	// ChassisIDSubtype Type of the chassis identifier (mac, ip, local, ...)
+	ChassisIDSubtype *string `json:"chassis_id_subtype,omitempty"`
+
+	// IsWired Whether the neighbor is connected by cable
+	IsWired *FlexibleBool `json:"is_wired,omitempty"`
+
+	// LocalPortIdx Index of the local port the neighbor was seen on
+	LocalPortIdx *FlexibleInt `json:"local_port_idx,omitempty"`
+
+	// LocalPortName Name of the local port
+	LocalPortName *string `json:"local_port_name,omitempty"`
+
+	// ManagementAddress Management IP address advertised by the neighbor
+	ManagementAddress *string `json:"management_address,omitempty"`
+
+	// PortDescription Remote port description
+	PortDescription *string `json:"port_descr,omitempty"`
+
+	// PortID Remote port identifier advertised by the neighbor
+	PortID *string `json:"port_id,omitempty"`
+
+	// SystemDescription System description advertised by the neighbor
+	SystemDescription *string `json:"system_descr,omitempty"`
+
+	// SystemName System name advertised by the neighbor
+	SystemName *string `json:"system_name,omitempty"`
+}
+
+// LegacyDevice defines model for LegacyDevice.
+type LegacyDevice struct {
+	// Id Legacy record identifier of the device
+	Id *string `json:"_id,omitempty"`
+
+	// Adopted Whether the device is adopted by the site
+	Adopted *bool `json:"adopted,omitempty"`
+
+	// ConfigNetwork Management IP configuration of a device
+	ConfigNetwork *DeviceNetworkConfig `json:"config_network,omitempty"`
+
+	// IP Management IP address of the device
+	IP *string `json:"ip,omitempty"`
+
+	// LLDPTable Neighbors discovered through LLDP or CDP on the device ports
+	LLDPTable *[]LLDPNeighbor `json:"lldp_table,omitempty"`
+
+	// Mac MAC address of the device
+	Mac string `json:"mac"`
+
+	// Model Device model code
+	Model *string `json:"model,omitempty"`
+
+	// Name Device name
+	Name *string `json:"name,omitempty"`
+
+	// PortOverrides Per-port configuration of a switch
+	PortOverrides *[]PortOverride `json:"port_overrides,omitempty"`
+
+	// PortTable State and counters of the device ports
+	PortTable *[]SwitchPortState `json:"port_table,omitempty"`
+
+	// RadioTable Configuration of the access point radios
+	RadioTable *[]RadioConfig `json:"radio_table,omitempty"`
+
+	// State Device state (1 connected, 2 pending adoption, 7 adopting, ...)
+	State *LegacyDeviceState `json:"state,omitempty"`
+
+	// Type Device type (uap, usw, ugw, udm, ...)
+	Type *string `json:"type,omitempty"`
+
+	// Version Firmware version
+	Version *string `json:"version,omitempty"`
+}
+
+// LegacyDeviceInput Device configuration fields to update
+type LegacyDeviceInput struct {
+	// ConfigNetwork Management IP configuration of a device
+	ConfigNetwork *DeviceNetworkConfig `json:"config_network,omitempty"`
+
+	// Name Device name
+	Name *string `json:"name,omitempty"`
+
+	// PortOverrides Per-port configuration of a switch, replacing the stored overrides
+	PortOverrides *[]PortOverride `json:"port_overrides,omitempty"`
+
+	// RadioTable Configuration of every radio of the access point
+	RadioTable *[]RadioConfig `json:"radio_table,omitempty"`
+}
+
+// LegacyDevicesResponse defines model for LegacyDevicesResponse.
+type LegacyDevicesResponse struct {
+	Data []LegacyDevice `json:"data"`
+	Meta LegacyMeta     `json:"meta"`
+}
+
+// LegacyMeta defines model for LegacyMeta.
+type LegacyMeta struct {
+	// Msg Error message key when rc is "error"
+	Msg *string `json:"msg,omitempty"`
+
+	// Rc Result code, "ok" on success
+	Rc string `json:"rc"`
+}
+
+// MACFilterPolicy How the MAC filter list is applied: allow admits only listed clients,
+// deny rejects listed clients.
+type MACFilterPolicy string
+
+// NTPSettings defines model for NTPSettings.
+type NTPSettings struct {
+	// NTPServer1 First custom NTP server
+	NTPServer1 *string `json:"ntp_server_1,omitempty"`
+
+	// NTPServer2 Second custom NTP server
+	NTPServer2 *string `json:"ntp_server_2,omitempty"`
+
+	// NTPServer3 Third custom NTP server
+	NTPServer3 *string `json:"ntp_server_3,omitempty"`
+
+	// NTPServer4 Fourth custom NTP server
+	NTPServer4 *string `json:"ntp_server_4,omitempty"`
+
+	// SettingPreference Whether the Ubiquiti NTP pool (auto) or the custom servers (manual) are used
+	SettingPreference *NTPSettingsSettingPreference `json:"setting_preference,omitempty"`
+}
+
+// NTPSettingsSettingPreference Whether the Ubiquiti NTP pool (auto) or the custom servers (manual) are used
+type NTPSettingsSettingPreference string
+
+// NTPSettingsResponse defines model for NTPSettingsResponse.
+type NTPSettingsResponse struct {
+	Data []NTPSettings `json:"data"`
+	Meta LegacyMeta    `json:"meta"`
+}
+
+// NetworkClient defines model for NetworkClient.
+type NetworkClient = ClientListItem
+
+// NetworkConf defines model for NetworkConf.
+type NetworkConf struct {
+	// Id Unique identifier of the network
+	Id string `json:"_id"`
+
+	// DHCPEnabled Whether the controller runs a DHCP server on the network
+	DHCPEnabled *bool `json:"dhcpd_enabled,omitempty"`
+
+	// DHCPStart First address of the DHCP range
+	DHCPStart *string `json:"dhcpd_start,omitempty"`
+
+	// DHCPStop Last address of the DHCP range
+	DHCPStop *string `json:"dhcpd_stop,omitempty"`
+
+	// IPSubnet Gateway address and prefix length of the network in CIDR notation
+	IPSubnet *string `json:"ip_subnet,omitempty"`
+
+	// Name Display name of the network
+	Name string `json:"name"`
+
+	// Purpose Network purpose (corporate, guest, vlan-only, wan, ...)
+	Purpose *string `json:"purpose,omitempty"`
+
+	// VLAN VLAN ID; older controllers return it as a string
+	VLAN *FlexibleInt `json:"vlan,omitempty"`
+
+	// VLANEnabled Whether the network is tagged with a VLAN
+	VLANEnabled *bool `json:"vlan_enabled,omitempty"`
+}
+
+// NetworkConfsResponse defines model for NetworkConfsResponse.
+type NetworkConfsResponse struct {
+	Data []NetworkConf `json:"data"`
+	Meta LegacyMeta    `json:"meta"`
+}
+
+// PageInfo Pagination metadata of offset/limit list responses.
+type PageInfo struct {
+	// Count Number of items in current response
+	Count int `json:"count"`
+
+	// Limit Maximum number of items per page
+	Limit int `json:"limit"`
+
+	// Offset Number of items skipped
+	Offset int `json:"offset"`
+
+	// TotalCount Total number of items available
+	TotalCount int `json:"totalCount"`
+}
+
+// PoE Power over Ethernet configuration and status
+type PoE struct {
+	// Enabled Whether PoE is enabled on this port
+	Enabled bool `json:"enabled"`
+
+	// Standard PoE standard supported
+	Standard PoEStandard `json:"standard"`
+
+	// State Current PoE state
+	State PoEState `json:"state"`
+
+	// Type PoE type number
+	Type int `json:"type"`
+}
+
+// PoEStandard PoE standard supported
+type PoEStandard string
+
+// PoEState Current PoE state
+type PoEState string
+
+// PolicySchedule When a policy is active. Times are in the site time zone.
+type PolicySchedule struct {
+	// Date Day of a ONE_TIME_ONLY schedule, as YYYY-MM-DD
+	Date *string `json:"date,omitempty"`
+
+	// DateEnd Last day of a CUSTOM schedule, as YYYY-MM-DD
+	DateEnd *string `json:"date_end,omitempty"`
+
+	// DateStart First day of a CUSTOM schedule, as YYYY-MM-DD
+	DateStart *string `json:"date_start,omitempty"`
+
+	// Mode How the schedule repeats
+	Mode ScheduleMode `json:"mode"`
+
+	// RepeatOnDays Days the schedule is active on, for EVERY_WEEK and CUSTOM
+	RepeatOnDays *[]ScheduleWeekday `json:"repeat_on_days,omitempty"`
+
+	// TimeAllDay Whether the schedule is active all day on the days it applies
+	TimeAllDay *bool `json:"time_all_day,omitempty"`
+
+	// TimeRangeEnd End of the active time range, as HH:MM
+	TimeRangeEnd *string `json:"time_range_end,omitempty"`
+
+	// TimeRangeStart Start of the active time range, as HH:MM
+	TimeRangeStart *string `json:"time_range_start,omitempty"`
+}
+
+// ScheduleMode How the schedule repeats
+type ScheduleMode string
+
+// Port defines model for Port.
+type Port struct {
+	// Connector Physical connector type
+	Connector PortConnector `json:"connector"`
+
+	// Idx Port index number (1-based)
+	Idx int `json:"idx"`
+
+	// MaxSpeedMbps Maximum supported speed in Mbps
+	MaxSpeedMbps int `json:"maxSpeedMbps"`
+
+	// Poe Power over Ethernet configuration and status
+	Poe *PoE `json:"poe,omitempty"`
+
+	// SpeedMbps Current negotiated speed in Mbps
+	SpeedMbps int `json:"speedMbps"`
+
+	// State Current port state
+	State PortState `json:"state"`
+}
+
+// PortConnector Physical connector type
 type PortConnector string
 
 // PortState Current port state
 type PortState string
 
+// PortOverride Configuration of a switch port
+type PortOverride struct {
+	// Name Port name
+	Name *string `json:"name,omitempty"`
+
+	// PortIdx Port index
+	PortIdx int `json:"port_idx"`
+
+	// PortProfileID Identifier of the port profile applied to the port
+	PortProfileID *string `json:"portconf_id,omitempty"`
+}
+
 // Radio defines model for Radio.
 type Radio struct {
 	// Channel WiFi channel number
@@ -757,6 +1644,99 @@ type RadioFrequencyGHz float32
 // RadioWlanStandard WiFi standard supported
 type RadioWlanStandard string
 
+// RadioConfig Channel, width and transmit power configuration of an access point radio
+type RadioConfig struct {
+	// Channel Channel number, or auto to let the controller choose
+	Channel *RadioChannel `json:"channel,omitempty"`
+
+	// Width Channel width in MHz
+	Width *FlexibleInt `json:"ht,omitempty"`
+
+	// MaxTxPower Highest transmit power the radio supports in dBm
+	MaxTxPower *FlexibleInt `json:"max_txpower,omitempty"`
+
+	// MinTxPower Lowest transmit power the radio supports in dBm
+	MinTxPower *FlexibleInt `json:"min_txpower,omitempty"`
+
+	// Name Interface name of the radio
+	Name *string `json:"name,omitempty"`
+
+	// Radio Radio band (ng for 2.4 GHz, na for 5 GHz, 6e for 6 GHz)
+	Radio RadioBand `json:"radio"`
+
+	// TxPower Transmit power in dBm, used with the custom power mode
+	TxPower *FlexibleInt `json:"tx_power,omitempty"`
+
+	// TxPowerMode Transmit power level
+	TxPowerMode *TxPowerMode `json:"tx_power_mode,omitempty"`
+}
+
+// RadioBand Radio band (ng for 2.4 GHz, na for 5 GHz, 6e for 6 GHz)
+type RadioBand string
+
+// TxPowerMode Transmit power level
+type TxPowerMode string
+
+// RegulatoryChannels Channels allowed by the regulatory domain of a country, per band and width
+type RegulatoryChannels struct {
+	// Channels6G20 6 GHz channels allowed at 20 MHz
+	Channels6G20 *[]int `json:"channels_6e,omitempty"`
+
+	// Channels6G160 6 GHz channels allowed at 160 MHz
+	Channels6G160 *[]int `json:"channels_6e_160,omitempty"`
+
+	// Channels6G40 6 GHz channels allowed at 40 MHz
+	Channels6G40 *[]int `json:"channels_6e_40,omitempty"`
+
+	// Channels6G80 6 GHz channels allowed at 80 MHz
+	Channels6G80 *[]int `json:"channels_6e_80,omitempty"`
+
+	// Channels5G20 5 GHz channels allowed at 20 MHz
+	Channels5G20 *[]int `json:"channels_na,omitempty"`
+
+	// Channels5G160 5 GHz channels allowed at 160 MHz
+	Channels5G160 *[]int `json:"channels_na_160,omitempty"`
+
+	// Channels5G40 5 GHz channels allowed at 40 MHz
+	Channels5G40 *[]int `json:"channels_na_40,omitempty"`
+
+	// Channels5G80 5 GHz channels allowed at 80 MHz
+	Channels5G80 *[]int `json:"channels_na_80,omitempty"`
+
+	// Channels2G20 2.4 GHz channels allowed at 20 MHz
+	Channels2G20 *[]int `json:"channels_ng,omitempty"`
+
+	// Channels2G40 2.4 GHz channels allowed at 40 MHz
+	Channels2G40 *[]int `json:"channels_ng_40,omitempty"`
+
+	// Code Numeric country code
+	Code *string `json:"code,omitempty"`
+
+	// Key ISO 3166 country code
+	Key *string `json:"key,omitempty"`
+
+	// Name Country name
+	Name *string `json:"name,omitempty"`
+}
+
+// RegulatoryChannelsResponse defines model for RegulatoryChannelsResponse.
+type RegulatoryChannelsResponse struct {
+	Data []RegulatoryChannels `json:"data"`
+	Meta LegacyMeta           `json:"meta"`
+}
+
+// STPState Spanning tree state of a port
+type STPState string
+
+// ScheduleWeekday Day of the week
+type ScheduleWeekday string
+
+// SiteHealthResponse defines model for SiteHealthResponse.
+type SiteHealthResponse struct {
+	Data []SubsystemHealth `json:"data"`
+	Meta LegacyMeta        `json:"meta"`
+}
+
 // SiteListItem defines model for SiteListItem.
 type SiteListItem struct {
 	// Id Unique identifier for the site
@@ -767,6 +1747,127 @@ type SiteListItem struct {
 
 	// Name Display name of the site
 	Name string `json:"name"`
+
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+}
+
+// SiteToSiteVPN Manual site-to-site IPsec VPN, a network with the site-vpn purpose
+type SiteToSiteVPN struct {
+	// Id Unique identifier of the VPN network
+	Id string `json:"_id"`
+
+	// Enabled Whether the tunnel is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// DynamicRouting Whether the tunnel is route-based (VTI) instead of policy-based
+	DynamicRouting *bool `json:"ipsec_dynamic_routing,omitempty"`
+
+	// ESPDHGroup Diffie-Hellman group of phase 2 perfect forward secrecy
+	ESPDHGroup    *FlexibleInt     `json:"ipsec_esp_dh_group,omitempty"`
+	ESPEncryption *IPsecEncryption `json:"ipsec_esp_encryption,omitempty"`
+	ESPHash       *IPsecHash       `json:"ipsec_esp_hash,omitempty"`
+
+	// ESPLifetime Lifetime of the phase 2 security association in seconds
+	ESPLifetime *FlexibleInt `json:"ipsec_esp_lifetime,omitempty"`
+
+	// IKEDHGroup Diffie-Hellman group of phase 1; controllers return it as a string
+	IKEDHGroup    *FlexibleInt     `json:"ipsec_ike_dh_group,omitempty"`
+	IKEEncryption *IPsecEncryption `json:"ipsec_ike_encryption,omitempty"`
+	IKEHash       *IPsecHash       `json:"ipsec_ike_hash,omitempty"`
+
+	// IKELifetime Lifetime of the phase 1 security association in seconds
+	IKELifetime *FlexibleInt `json:"ipsec_ike_lifetime,omitempty"`
+
+	// Interface WAN interface the tunnel is bound to
+	Interface   *string           `json:"ipsec_interface,omitempty"`
+	KeyExchange *IPsecKeyExchange `json:"ipsec_key_exchange,omitempty"`
+
+	// LocalIP Local address the tunnel is bound to
+	LocalIP *string `json:"ipsec_local_ip,omitempty"`
+
+	// PeerIP Public address of the remote gateway
+	PeerIP *string `json:"ipsec_peer_ip,omitempty"`
+
+	// PFS Whether phase 2 uses perfect forward secrecy
+	PFS *bool `json:"ipsec_pfs,omitempty"`
+
+	// Name Display name of the VPN
+	Name string `json:"name"`
+
+	// Purpose Network purpose, site-vpn for site-to-site VPNs
+	Purpose *string `json:"purpose,omitempty"`
+
+	// RemoteSubnets Subnets reachable through the tunnel in CIDR notation
+	RemoteSubnets *[]string `json:"remote_vpn_subnets,omitempty"`
+
+	// RouteDistance Administrative distance of the routes to the remote subnets
+	RouteDistance *FlexibleInt `json:"route_distance,omitempty"`
+
+	// VPNType VPN type, ipsec-vpn for manual IPsec tunnels
+	VPNType *string `json:"vpn_type,omitempty"`
+}
+
+// SiteToSiteVPNInput defines model for SiteToSiteVPNInput.
+type SiteToSiteVPNInput struct {
+	// Enabled Whether the tunnel is enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// DynamicRouting Whether the tunnel is route-based (VTI) instead of policy-based
+	DynamicRouting *bool `json:"ipsec_dynamic_routing,omitempty"`
+
+	// ESPDHGroup Diffie-Hellman group of phase 2 perfect forward secrecy
+	ESPDHGroup    *int             `json:"ipsec_esp_dh_group,omitempty"`
+	ESPEncryption *IPsecEncryption `json:"ipsec_esp_encryption,omitempty"`
+	ESPHash       *IPsecHash       `json:"ipsec_esp_hash,omitempty"`
+
+	// ESPLifetime Lifetime of the phase 2 security association in seconds
+	ESPLifetime *int `json:"ipsec_esp_lifetime,omitempty"`
+
+	// IKEDHGroup Diffie-Hellman group of phase 1
+	IKEDHGroup    *int             `json:"ipsec_ike_dh_group,omitempty"`
+	IKEEncryption *IPsecEncryption `json:"ipsec_ike_encryption,omitempty"`
+	IKEHash       *IPsecHash       `json:"ipsec_ike_hash,omitempty"`
+
+	// IKELifetime Lifetime of the phase 1 security association in seconds
+	IKELifetime *int `json:"ipsec_ike_lifetime,omitempty"`
+
+	// Interface WAN interface the tunnel is bound to
+	Interface   *string           `json:"ipsec_interface,omitempty"`
+	KeyExchange *IPsecKeyExchange `json:"ipsec_key_exchange,omitempty"`
+
+	// LocalIP Local address the tunnel is bound to
+	LocalIP *string `json:"ipsec_local_ip,omitempty"`
+
+	// PeerIP Public address of the remote gateway
+	PeerIP *string `json:"ipsec_peer_ip,omitempty"`
+
+	// PFS Whether phase 2 uses perfect forward secrecy
+	PFS *bool `json:"ipsec_pfs,omitempty"`
+
+	// Name Display name of the VPN
+	Name string `json:"name"`
+
+	// Purpose Network purpose, always site-vpn
+	Purpose string `json:"purpose"`
+
+	// RemoteSubnets Subnets reachable through the tunnel in CIDR notation
+	RemoteSubnets *[]string `json:"remote_vpn_subnets,omitempty"`
+
+	// RouteDistance Administrative distance of the routes to the remote subnets
+	RouteDistance *int `json:"route_distance,omitempty"`
+
+	// VPNType VPN type, always ipsec-vpn
+	VPNType string `json:"vpn_type"`
+
+	// PreSharedKey Pre-shared key; omitted to keep the current key on update
+	PreSharedKey *string `json:"x_ipsec_pre_shared_key,omitempty"`
+}
+
+// SiteToSiteVPNsResponse defines model for SiteToSiteVPNsResponse.
+type SiteToSiteVPNsResponse struct {
+	Data []SiteToSiteVPN `json:"data"`
+	Meta LegacyMeta      `json:"meta"`
 }
 
 // SitesResponse defines model for SitesResponse.
@@ -785,79 +1886,407 @@ type SitesResponse struct {
 	TotalCount int `json:"totalCount"`
 }
 
-// TrafficRule defines model for TrafficRule.
-type TrafficRule struct {
-	// UnderscoreId Unique identifier for the traffic rule
-	UnderscoreId string `json:"_id"`
+// SubsystemHealth Health of one site subsystem; counters apply to the subsystems noted
+type SubsystemHealth struct {
+	// Drops Internet connection drops (www)
+	Drops *FlexibleInt `json:"drops,omitempty"`
 
-	// Action Action to apply (e.g., bandwidth limit, block)
-	Action *string `json:"action,omitempty"`
+	// ISPName Name of the Internet provider (wan)
+	ISPName *string `json:"isp_name,omitempty"`
 
-	// AppCategoryIds List of application category IDs to match
-	AppCategoryIds *[]string `json:"app_category_ids,omitempty"`
+	// Latency Internet latency in milliseconds (www)
+	Latency *FlexibleInt `json:"latency,omitempty"`
 
-	// AppIds List of application IDs to match
-	AppIds *[]string `json:"app_ids,omitempty"`
+	// NumAdopted Adopted devices (wan, lan, wlan)
+	NumAdopted *FlexibleInt `json:"num_adopted,omitempty"`
 
-	// BandwidthLimit Bandwidth limiting configuration
-	BandwidthLimit *map[string]interface{} `json:"bandwidth_limit,omitempty"`
+	// NumAP Access points (wlan)
+	NumAP *FlexibleInt `json:"num_ap,omitempty"`
 
-	// Description User-provided description of the rule
-	Description *string `json:"description,omitempty"`
+	// NumDisconnected Adopted devices that are offline (wan, lan, wlan)
+	NumDisconnected *FlexibleInt `json:"num_disconnected,omitempty"`
 
-	// Domains List of domains to match
-	Domains *[]string `json:"domains,omitempty"`
+	// NumGuest Connected guests (lan, wlan)
+	NumGuest *FlexibleInt `json:"num_guest,omitempty"`
 
-	// Enabled Whether the traffic rule is enabled
-	Enabled bool `json:"enabled"`
+	// NumGateway Gateways (wan)
+	NumGateway *FlexibleInt `json:"num_gw,omitempty"`
 
-	// MatchingTarget What this rule matches against
-	MatchingTarget TrafficRuleMatchingTarget `json:"matching_target"`
+	// NumPending Devices waiting for adoption (wan, lan, wlan)
+	NumPending *FlexibleInt `json:"num_pending,omitempty"`
 
-	// Schedule Schedule configuration
-	Schedule *map[string]interface{} `json:"schedule,omitempty"`
+	// NumSwitch Switches (lan)
+	NumSwitch *FlexibleInt `json:"num_sw,omitempty"`
 
-	// TargetDevices Devices affected by this rule
-	TargetDevices *[]map[string]interface{} `json:"target_devices,omitempty"`
-}
+	// NumUser Connected clients (lan, wlan)
+	NumUser *FlexibleInt `json:"num_user,omitempty"`
 
-// TrafficRuleMatchingTarget What this rule matches against
-type TrafficRuleMatchingTarget string
+	// RemoteUsersActive Connected remote access VPN users (vpn)
+	RemoteUsersActive *FlexibleInt `json:"remote_user_num_active,omitempty"`
 
-// TrafficRuleInput defines model for TrafficRuleInput.
-type TrafficRuleInput struct {
-	// Action Action to apply
-	Action *string `json:"action,omitempty"`
+	// RxBytesRate Current receive rate in bytes per second (wan, lan, wlan)
+	RxBytesRate *FlexibleInt `json:"rx_bytes-r,omitempty"`
 
-	// Description User-provided description of the rule
-	Description *string `json:"description,omitempty"`
+	// Status Subsystem status; unknown when the subsystem is not set up
+	Status *HealthStatus `json:"status,omitempty"`
 
-	// Enabled Whether the traffic rule is enabled
-	Enabled bool `json:"enabled"`
+	// Subsystem Subsystem the entry describes
+	Subsystem HealthSubsystem `json:"subsystem"`
 
-	// MatchingTarget What this rule matches against
-	MatchingTarget TrafficRuleInputMatchingTarget `json:"matching_target"`
-}
+	// TxBytesRate Current transmit rate in bytes per second (wan, lan, wlan)
+	TxBytesRate *FlexibleInt `json:"tx_bytes-r,omitempty"`
 
-// TrafficRuleInputMatchingTarget What this rule matches against
-type TrafficRuleInputMatchingTarget string
+	// Uptime Internet uptime in seconds (www)
+	Uptime *FlexibleInt `json:"uptime,omitempty"`
 
-// ClientId defines model for ClientId.
-type ClientId = openapi_types.UUID
+	// WANIP Public IP address of the gateway (wan)
+	WANIP *string `json:"wan_ip,omitempty"`
 
-// DeviceId defines model for DeviceId.
-type DeviceId = openapi_types.UUID
+	// ThroughputDown Last measured download throughput in Mbit/s (www)
+	ThroughputDown *float64 `json:"xput_down,omitempty"`
 
-// Limit defines model for Limit.
-type Limit = int
+	// ThroughputUp Last measured upload throughput in Mbit/s (www)
+	ThroughputUp *float64 `json:"xput_up,omitempty"`
+}
 
-// Offset defines model for Offset.
-type Offset = int
+// HealthStatus Subsystem status; unknown when the subsystem is not set up
+type HealthStatus string
 
-// PolicyId defines model for PolicyId.
-type PolicyId = string
+// HealthSubsystem Subsystem the entry describes
+type HealthSubsystem string
 
-// RecordId defines model for RecordId.
+// SupportFile defines model for SupportFile.
+type SupportFile struct {
+	// URL Path of the generated support file, relative to the Network application
+	URL string `json:"url"`
+}
+
+// SupportFileResponse defines model for SupportFileResponse.
+type SupportFileResponse struct {
+	Data []SupportFile `json:"data"`
+	Meta LegacyMeta    `json:"meta"`
+}
+
+// SwitchPortState Link, spanning tree and counter state of a device port
+type SwitchPortState struct {
+	// Enable Whether the port is administratively enabled
+	Enable *FlexibleBool `json:"enable,omitempty"`
+
+	// FullDuplex Whether the link is full duplex
+	FullDuplex *FlexibleBool `json:"full_duplex,omitempty"`
+
+	// IsUplink Whether the port is the device uplink
+	IsUplink *FlexibleBool `json:"is_uplink,omitempty"`
+
+	// Media Port media (GE, 2P5GE, SFP+, ...)
+	Media *string `json:"media,omitempty"`
+
+	// Name Port name
+	Name *string `json:"name,omitempty"`
+
+	// PortIdx Port index
+	PortIdx int `json:"port_idx"`
+
+	// RxBytes Bytes received
+	RxBytes *FlexibleInt `json:"rx_bytes,omitempty"`
+
+	// RxDropped Received packets dropped
+	RxDropped *FlexibleInt `json:"rx_dropped,omitempty"`
+
+	// RxErrors Receive errors
+	RxErrors *FlexibleInt `json:"rx_errors,omitempty"`
+
+	// Speed Negotiated link speed in Mbps
+	Speed *FlexibleInt `json:"speed,omitempty"`
+
+	// STPPathCost Spanning tree path cost of the port
+	STPPathCost *FlexibleInt `json:"stp_pathcost,omitempty"`
+	STPState    *STPState    `json:"stp_state,omitempty"`
+
+	// TxBytes Bytes transmitted
+	TxBytes *FlexibleInt `json:"tx_bytes,omitempty"`
+
+	// TxDropped Transmitted packets dropped
+	TxDropped *FlexibleInt `json:"tx_dropped,omitempty"`
+
+	// TxErrors Transmit errors
+	TxErrors *FlexibleInt `json:"tx_errors,omitempty"`
+
+	// Up Whether the port link is up
+	Up *FlexibleBool `json:"up,omitempty"`
+}
+
+// SystemCommandRequest defines model for SystemCommandRequest.
+type SystemCommandRequest struct {
+	// Cmd System command
+	Cmd SystemCommandRequestCmd `json:"cmd"`
+}
+
+// SystemCommandRequestCmd System command
+type SystemCommandRequestCmd string
+
+// SystemInfo defines model for SystemInfo.
+type SystemInfo struct {
+	// Hostname Hostname of the console
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Name Name of the application
+	Name *string `json:"name,omitempty"`
+
+	// Timezone IANA timezone of the controller
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Uptime Uptime of the Network application in seconds
+	Uptime *FlexibleInt `json:"uptime,omitempty"`
+
+	// Version Version of the Network application
+	Version *string `json:"version,omitempty"`
+}
+
+// SystemInfoResponse defines model for SystemInfoResponse.
+type SystemInfoResponse struct {
+	Data []SystemInfo `json:"data"`
+	Meta LegacyMeta   `json:"meta"`
+}
+
+// TrafficRule defines model for TrafficRule.
+type TrafficRule struct {
+	// UnderscoreId Unique identifier for the traffic rule
+	UnderscoreId string `json:"_id"`
+
+	// Action Action to apply (e.g., bandwidth limit, block)
+	Action *string `json:"action,omitempty"`
+
+	// AppCategoryIds List of application category IDs to match
+	AppCategoryIds *[]string `json:"app_category_ids,omitempty"`
+
+	// AppIds List of application IDs to match
+	AppIds *[]string `json:"app_ids,omitempty"`
+
+	// BandwidthLimit Bandwidth limiting configuration
+	BandwidthLimit *map[string]interface{} `json:"bandwidth_limit,omitempty"`
+
+	// Description User-provided description of the rule
+	Description *string `json:"description,omitempty"`
+
+	// Domains List of domains to match
+	Domains *[]string `json:"domains,omitempty"`
+
+	// Enabled Whether the traffic rule is enabled
+	Enabled bool `json:"enabled"`
+
+	// IpAddresses IP addresses and CIDR subnets to match, with matching target IP
+	IpAddresses *[]string `json:"ip_addresses,omitempty"`
+
+	// MatchingTarget What this rule matches against
+	MatchingTarget TrafficRuleMatchingTarget `json:"matching_target"`
+
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
+	// Schedule Schedule configuration
+	Schedule *map[string]interface{} `json:"schedule,omitempty"`
+
+	// TargetDevices Devices affected by this rule
+	TargetDevices *[]TrafficRuleTarget `json:"target_devices,omitempty"`
+}
+
+// TrafficRuleMatchingTarget What this rule matches against
+type TrafficRuleMatchingTarget string
+
+// TrafficRuleInput defines model for TrafficRuleInput.
+type TrafficRuleInput struct {
+	// Action Action to apply
+	Action *string `json:"action,omitempty"`
+
+	// AppCategoryIds Application category IDs to match, from the DPI catalog, with matching target APP_CATEGORY
+	AppCategoryIds *[]string `json:"app_category_ids,omitempty"`
+
+	// AppIds Application IDs to match, from the DPI catalog, with matching target APP
+	AppIds *[]string `json:"app_ids,omitempty"`
+
+	// Description User-provided description of the rule
+	Description *string `json:"description,omitempty"`
+
+	// Domains Domains to match, with matching target DOMAIN
+	Domains *[]string `json:"domains,omitempty"`
+
+	// Enabled Whether the traffic rule is enabled
+	Enabled bool `json:"enabled"`
+
+	// IpAddresses IP addresses and CIDR subnets to match, with matching target IP
+	IpAddresses *[]string `json:"ip_addresses,omitempty"`
+
+	// MatchingTarget What this rule matches against
+	MatchingTarget TrafficRuleInputMatchingTarget `json:"matching_target"`
+
+	// TargetDevices Devices affected by this rule
+	TargetDevices *[]TrafficRuleTarget `json:"target_devices,omitempty"`
+}
+
+// TrafficRuleInputMatchingTarget What this rule matches against
+type TrafficRuleInputMatchingTarget string
+
+// TrafficRuleTarget defines model for TrafficRuleTarget.
+type TrafficRuleTarget struct {
+	// ClientMAC MAC address of the targeted client, with type CLIENT
+	ClientMAC *string `json:"client_mac,omitempty"`
+
+	// NetworkID Identifier of the targeted network, with type NETWORK
+	NetworkID *string `json:"network_id,omitempty"`
+
+	// Type Kind of target
+	Type TrafficRuleTargetType `json:"type"`
+}
+
+// TrafficRuleTargetType Kind of target
+type TrafficRuleTargetType string
+
+// UserGroup defines model for UserGroup.
+type UserGroup struct {
+	// Id Unique identifier of the user group
+	Id string `json:"_id"`
+
+	// AttrHiddenId Internal marker of built-in groups
+	AttrHiddenId *string `json:"attr_hidden_id,omitempty"`
+
+	// AttrNoDelete Whether the group is protected from deletion (site default group)
+	AttrNoDelete *bool `json:"attr_no_delete,omitempty"`
+
+	// Name Display name of the user group
+	Name string `json:"name"`
+
+	// QosRateMaxDown Download rate limit in Kbps, -1 for unlimited
+	QosRateMaxDown *FlexibleInt `json:"qos_rate_max_down,omitempty"`
+
+	// QosRateMaxUp Upload rate limit in Kbps, -1 for unlimited
+	QosRateMaxUp *FlexibleInt `json:"qos_rate_max_up,omitempty"`
+
+	// SiteId Legacy identifier of the site the group belongs to
+	SiteId *string `json:"site_id,omitempty"`
+}
+
+// UserGroupInput defines model for UserGroupInput.
+type UserGroupInput struct {
+	// Name Display name of the user group
+	Name string `json:"name"`
+
+	// QosRateMaxDown Download rate limit in Kbps, -1 for unlimited
+	QosRateMaxDown *int `json:"qos_rate_max_down,omitempty"`
+
+	// QosRateMaxUp Upload rate limit in Kbps, -1 for unlimited
+	QosRateMaxUp *int `json:"qos_rate_max_up,omitempty"`
+}
+
+// UserGroupsResponse defines model for UserGroupsResponse.
+type UserGroupsResponse struct {
+	Data []UserGroup `json:"data"`
+	Meta LegacyMeta  `json:"meta"`
+}
+
+// WLAN defines model for WLAN.
+type WLAN struct {
+	// Id Unique identifier of the WLAN
+	Id string `json:"_id"`
+
+	// Enabled Whether the WLAN is broadcast
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// HideSSID Whether the SSID is hidden from beacons
+	HideSSID *bool `json:"hide_ssid,omitempty"`
+
+	// ClientIsolation Whether wireless clients are isolated from each other (L2 isolation)
+	ClientIsolation *bool `json:"l2_isolation,omitempty"`
+
+	// MACFilterEnabled Whether MAC address filtering is enforced
+	MACFilterEnabled *bool `json:"mac_filter_enabled,omitempty"`
+
+	// MACFilterList MAC addresses the filter policy applies to
+	MACFilterList   *[]string        `json:"mac_filter_list,omitempty"`
+	MACFilterPolicy *MACFilterPolicy `json:"mac_filter_policy,omitempty"`
+
+	// Name SSID of the WLAN
+	Name string `json:"name"`
+
+	// NetworkID Identifier of the network (VLAN) the WLAN is bridged to
+	NetworkID *string `json:"networkconf_id,omitempty"`
+
+	// Security Security mode (open, wpapsk, wpaeap, ...)
+	Security *string `json:"security,omitempty"`
+
+	// Passphrase Pre-shared key of WPA personal WLANs
+	Passphrase *string `json:"x_passphrase,omitempty"`
+}
+
+// WLANInput Partial WLAN update; omitted fields are left unchanged
+type WLANInput struct {
+	// ClientIsolation Whether wireless clients are isolated from each other (L2 isolation)
+	ClientIsolation *bool `json:"l2_isolation,omitempty"`
+
+	// MACFilterEnabled Whether MAC address filtering is enforced
+	MACFilterEnabled *bool `json:"mac_filter_enabled,omitempty"`
+
+	// MACFilterList MAC addresses the filter policy applies to
+	MACFilterList   *[]string        `json:"mac_filter_list,omitempty"`
+	MACFilterPolicy *MACFilterPolicy `json:"mac_filter_policy,omitempty"`
+
+	// Passphrase Pre-shared key of WPA personal WLANs, 8 to 63 printable ASCII characters
+	Passphrase *string `json:"x_passphrase,omitempty"`
+}
+
+// WLANMACFilter MAC address filter of a WLAN
+type WLANMACFilter struct {
+	// Enabled Whether MAC address filtering is enforced
+	Enabled bool `json:"mac_filter_enabled"`
+
+	// MACs MAC addresses the filter policy applies to
+	MACs   []string        `json:"mac_filter_list"`
+	Policy MACFilterPolicy `json:"mac_filter_policy"`
+}
+
+// WLANsResponse defines model for WLANsResponse.
+type WLANsResponse struct {
+	Data []WLAN     `json:"data"`
+	Meta LegacyMeta `json:"meta"`
+}
+
+// APGroupId defines model for APGroupId.
+type APGroupId = string
+
+// ClientId defines model for ClientId.
+type ClientId = openapi_types.UUID
+
+// ClientMac defines model for ClientMac.
+type ClientMac = string
+
+// DeviceId defines model for DeviceId.
+type DeviceId = openapi_types.UUID
+
+// DeviceMac defines model for DeviceMac.
+type DeviceMac = string
+
+// Filter defines model for Filter.
+type Filter = string
+
+// KnownClientId defines model for KnownClientId.
+type KnownClientId = string
+
+// LegacyDeviceId defines model for LegacyDeviceId.
+type LegacyDeviceId = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// NetworkId defines model for NetworkId.
+type NetworkId = string
+
+// Offset defines model for Offset.
+type Offset = int
+
+// PolicyId defines model for PolicyId.
+type PolicyId = string
+
+// RecordId defines model for RecordId.
 type RecordId = string
 
 // RuleId defines model for RuleId.
@@ -869,6 +2298,12 @@ type Site = string
 // SiteId defines model for SiteId.
 type SiteId = openapi_types.UUID
 
+// UserGroupId defines model for UserGroupId.
+type UserGroupId = string
+
+// WLANId defines model for WLANId.
+type WLANId = string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = ErrorResponse
 
@@ -878,6 +2313,9 @@ type NotFound = ErrorResponse
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = ErrorResponse
 
+// CreateWLANJSONBody defines parameters for CreateWLAN.
+type CreateWLANJSONBody map[string]interface{}
+
 // ListSitesParams defines parameters for ListSites.
 type ListSitesParams struct {
 	// Offset Number of items to skip before starting to return results (for pagination)
@@ -894,6 +2332,13 @@ type ListSiteClientsParams struct {
 
 	// Limit Maximum number of items to return per page
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Filter Filter expression evaluated by the controller (UniFi Network 9.3 and later),
+	// written as `property.function(arguments)`, e.g. `type.eq('WIRELESS')`.
+	// Functions: eq, ne, gt, ge, lt, le, like, in, notIn, isNull, isNotNull;
+	// conditions combine with and(...), or(...) and not(...).
+	// Older controllers ignore or reject the parameter.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
 }
 
 // ListSiteDevicesParams defines parameters for ListSiteDevices.
@@ -920,9 +2365,60 @@ type GetAggregatedDashboardParams struct {
 	HistorySeconds *int `form:"historySeconds,omitempty" json:"historySeconds,omitempty"`
 }
 
+// ExecuteDeviceCommandJSONRequestBody defines body for ExecuteDeviceCommand for application/json ContentType.
+type ExecuteDeviceCommandJSONRequestBody = DeviceCommandRequest
+
+// ExecuteClientCommandJSONRequestBody defines body for ExecuteClientCommand for application/json ContentType.
+type ExecuteClientCommandJSONRequestBody = ClientCommandRequest
+
+// ExecuteSystemCommandJSONRequestBody defines body for ExecuteSystemCommand for application/json ContentType.
+type ExecuteSystemCommandJSONRequestBody = SystemCommandRequest
+
+// UpdateLegacyDeviceJSONRequestBody defines body for UpdateLegacyDevice for application/json ContentType.
+type UpdateLegacyDeviceJSONRequestBody = LegacyDeviceInput
+
+// CreateSiteToSiteVPNJSONRequestBody defines body for CreateSiteToSiteVPN for application/json ContentType.
+type CreateSiteToSiteVPNJSONRequestBody = SiteToSiteVPNInput
+
+// UpdateSiteToSiteVPNJSONRequestBody defines body for UpdateSiteToSiteVPN for application/json ContentType.
+type UpdateSiteToSiteVPNJSONRequestBody = SiteToSiteVPNInput
+
+// UpdateKnownClientJSONRequestBody defines body for UpdateKnownClient for application/json ContentType.
+type UpdateKnownClientJSONRequestBody = KnownClientInput
+
+// CreateUserGroupJSONRequestBody defines body for CreateUserGroup for application/json ContentType.
+type CreateUserGroupJSONRequestBody = UserGroupInput
+
+// UpdateUserGroupJSONRequestBody defines body for UpdateUserGroup for application/json ContentType.
+type UpdateUserGroupJSONRequestBody = UserGroupInput
+
+// CreateWLANJSONRequestBody defines body for CreateWLAN for application/json ContentType.
+type CreateWLANJSONRequestBody CreateWLANJSONBody
+
+// UpdateWLANJSONRequestBody defines body for UpdateWLAN for application/json ContentType.
+type UpdateWLANJSONRequestBody = WLANInput
+
+// ListGuestAuthorizationsJSONRequestBody defines body for ListGuestAuthorizations for application/json ContentType.
+type ListGuestAuthorizationsJSONRequestBody = GuestAuthorizationsRequest
+
+// ListClientSessionsJSONRequestBody defines body for ListClientSessions for application/json ContentType.
+type ListClientSessionsJSONRequestBody = ClientSessionsRequest
+
 // CreateHotspotVouchersJSONRequestBody defines body for CreateHotspotVouchers for application/json ContentType.
 type CreateHotspotVouchersJSONRequestBody = CreateVouchersRequest
 
+// UpdateControllerCertificateJSONRequestBody defines body for UpdateControllerCertificate for application/json ContentType.
+type UpdateControllerCertificateJSONRequestBody = ControllerCertificateInput
+
+// CreateAPGroupJSONRequestBody defines body for CreateAPGroup for application/json ContentType.
+type CreateAPGroupJSONRequestBody = APGroupInput
+
+// UpdateAPGroupJSONRequestBody defines body for UpdateAPGroup for application/json ContentType.
+type UpdateAPGroupJSONRequestBody = APGroupInput
+
+// UpdateDynamicRoutingConfigJSONRequestBody defines body for UpdateDynamicRoutingConfig for application/json ContentType.
+type UpdateDynamicRoutingConfigJSONRequestBody = DynamicRoutingSettings
+
 // CreateFirewallPolicyJSONRequestBody defines body for CreateFirewallPolicy for application/json ContentType.
 type CreateFirewallPolicyJSONRequestBody = FirewallPolicyInput
 
@@ -935,6 +2431,9 @@ type CreateDNSRecordJSONRequestBody = DNSRecordInput
 // UpdateDNSRecordJSONRequestBody defines body for UpdateDNSRecord for application/json ContentType.
 type UpdateDNSRecordJSONRequestBody = DNSRecordInput
 
+// ListAdminActivityLogJSONRequestBody defines body for ListAdminActivityLog for application/json ContentType.
+type ListAdminActivityLogJSONRequestBody = AdminActivityLogRequest
+
 // CreateTrafficRuleJSONRequestBody defines body for CreateTrafficRule for application/json ContentType.
 type CreateTrafficRuleJSONRequestBody = TrafficRuleInput
 
@@ -1014,6 +2513,113 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ExecuteDeviceCommandWithBody request with any body
+	ExecuteDeviceCommandWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExecuteDeviceCommand(ctx context.Context, site Site, body ExecuteDeviceCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExecuteClientCommandWithBody request with any body
+	ExecuteClientCommandWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExecuteClientCommand(ctx context.Context, site Site, body ExecuteClientCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExecuteSystemCommandWithBody request with any body
+	ExecuteSystemCommandWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExecuteSystemCommand(ctx context.Context, site Site, body ExecuteSystemCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNTPSettings request
+	GetNTPSettings(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateLegacyDeviceWithBody request with any body
+	UpdateLegacyDeviceWithBody(ctx context.Context, site Site, legacyDeviceId LegacyDeviceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateLegacyDevice(ctx context.Context, site Site, legacyDeviceId LegacyDeviceId, body UpdateLegacyDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNetworks request
+	ListNetworks(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSiteToSiteVPNWithBody request with any body
+	CreateSiteToSiteVPNWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSiteToSiteVPN(ctx context.Context, site Site, body CreateSiteToSiteVPNJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSiteToSiteVPN request
+	DeleteSiteToSiteVPN(ctx context.Context, site Site, networkId NetworkId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSiteToSiteVPNWithBody request with any body
+	UpdateSiteToSiteVPNWithBody(ctx context.Context, site Site, networkId NetworkId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSiteToSiteVPN(ctx context.Context, site Site, networkId NetworkId, body UpdateSiteToSiteVPNJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListKnownClients request
+	ListKnownClients(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateKnownClientWithBody request with any body
+	UpdateKnownClientWithBody(ctx context.Context, site Site, knownClientId KnownClientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateKnownClient(ctx context.Context, site Site, knownClientId KnownClientId, body UpdateKnownClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUserGroups request
+	ListUserGroups(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserGroupWithBody request with any body
+	CreateUserGroupWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUserGroup(ctx context.Context, site Site, body CreateUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUserGroup request
+	DeleteUserGroup(ctx context.Context, site Site, userGroupId UserGroupId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserGroupWithBody request with any body
+	UpdateUserGroupWithBody(ctx context.Context, site Site, userGroupId UserGroupId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUserGroup(ctx context.Context, site Site, userGroupId UserGroupId, body UpdateUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWLANs request
+	ListWLANs(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWLANWithBody request with any body
+	CreateWLANWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWLAN(ctx context.Context, site Site, body CreateWLANJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWLAN request
+	GetWLAN(ctx context.Context, site Site, wlanId WLANId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWLANWithBody request with any body
+	UpdateWLANWithBody(ctx context.Context, site Site, wlanId WLANId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWLAN(ctx context.Context, site Site, wlanId WLANId, body UpdateWLANJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRegulatoryChannels request
+	ListRegulatoryChannels(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLegacyDevices request
+	ListLegacyDevices(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLegacyDevice request
+	GetLegacyDevice(ctx context.Context, site Site, deviceMac DeviceMac, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListGuestAuthorizationsWithBody request with any body
+	ListGuestAuthorizationsWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ListGuestAuthorizations(ctx context.Context, site Site, body ListGuestAuthorizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSiteHealth request
+	GetSiteHealth(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClientSessionsWithBody request with any body
+	ListClientSessionsWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ListClientSessions(ctx context.Context, site Site, body ListClientSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSystemInfo request
+	GetSystemInfo(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetKnownClient request
+	GetKnownClient(ctx context.Context, site Site, clientMac ClientMac, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListSites request
 	ListSites(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1043,9 +2649,47 @@ type ClientInterface interface {
 	// GetHotspotVoucher request
 	GetHotspotVoucher(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetControllerStatus request
+	GetControllerStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetControllerCertificate request
+	GetControllerCertificate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateControllerCertificateWithBody request with any body
+	UpdateControllerCertificateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateControllerCertificate(ctx context.Context, body UpdateControllerCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDPIApplicationCatalog request
+	GetDPIApplicationCatalog(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAggregatedDashboard request
 	GetAggregatedDashboard(ctx context.Context, site Site, params *GetAggregatedDashboardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAPGroups request
+	ListAPGroups(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAPGroupWithBody request with any body
+	CreateAPGroupWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAPGroup(ctx context.Context, site Site, body CreateAPGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPGroup request
+	DeleteAPGroup(ctx context.Context, site Site, apGroupId APGroupId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAPGroupWithBody request with any body
+	UpdateAPGroupWithBody(ctx context.Context, site Site, apGroupId APGroupId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAPGroup(ctx context.Context, site Site, apGroupId APGroupId, body UpdateAPGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDynamicRoutingConfig request
+	GetDynamicRoutingConfig(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDynamicRoutingConfigWithBody request with any body
+	UpdateDynamicRoutingConfigWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDynamicRoutingConfig(ctx context.Context, site Site, body UpdateDynamicRoutingConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListFirewallPolicies request
 	ListFirewallPolicies(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1062,6 +2706,9 @@ type ClientInterface interface {
 
 	UpdateFirewallPolicy(ctx context.Context, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListFirewallZones request
+	ListFirewallZones(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDNSRecords request
 	ListDNSRecords(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1078,6 +2725,11 @@ type ClientInterface interface {
 
 	UpdateDNSRecord(ctx context.Context, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAdminActivityLogWithBody request with any body
+	ListAdminActivityLogWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ListAdminActivityLog(ctx context.Context, site Site, body ListAdminActivityLogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListTrafficRules request
 	ListTrafficRules(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -1095,8 +2747,8 @@ type ClientInterface interface {
 	UpdateTrafficRule(ctx context.Context, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) ListSites(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListSitesRequest(c.Server, params)
+func (c *Client) ExecuteDeviceCommandWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteDeviceCommandRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1107,8 +2759,8 @@ func (c *Client) ListSites(ctx context.Context, params *ListSitesParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListSiteClients(ctx context.Context, siteId SiteId, params *ListSiteClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListSiteClientsRequest(c.Server, siteId, params)
+func (c *Client) ExecuteDeviceCommand(ctx context.Context, site Site, body ExecuteDeviceCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteDeviceCommandRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1119,8 +2771,8 @@ func (c *Client) ListSiteClients(ctx context.Context, siteId SiteId, params *Lis
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetClientById(ctx context.Context, siteId SiteId, clientId ClientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClientByIdRequest(c.Server, siteId, clientId)
+func (c *Client) ExecuteClientCommandWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteClientCommandRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1131,8 +2783,8 @@ func (c *Client) GetClientById(ctx context.Context, siteId SiteId, clientId Clie
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListSiteDevices(ctx context.Context, siteId SiteId, params *ListSiteDevicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListSiteDevicesRequest(c.Server, siteId, params)
+func (c *Client) ExecuteClientCommand(ctx context.Context, site Site, body ExecuteClientCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteClientCommandRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1143,8 +2795,8 @@ func (c *Client) ListSiteDevices(ctx context.Context, siteId SiteId, params *Lis
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDeviceById(ctx context.Context, siteId SiteId, deviceId DeviceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDeviceByIdRequest(c.Server, siteId, deviceId)
+func (c *Client) ExecuteSystemCommandWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteSystemCommandRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1155,8 +2807,8 @@ func (c *Client) GetDeviceById(ctx context.Context, siteId SiteId, deviceId Devi
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListHotspotVouchers(ctx context.Context, siteId SiteId, params *ListHotspotVouchersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListHotspotVouchersRequest(c.Server, siteId, params)
+func (c *Client) ExecuteSystemCommand(ctx context.Context, site Site, body ExecuteSystemCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteSystemCommandRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1167,8 +2819,8 @@ func (c *Client) ListHotspotVouchers(ctx context.Context, siteId SiteId, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateHotspotVouchersWithBody(ctx context.Context, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateHotspotVouchersRequestWithBody(c.Server, siteId, contentType, body)
+func (c *Client) GetNTPSettings(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNTPSettingsRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
@@ -1179,8 +2831,8 @@ func (c *Client) CreateHotspotVouchersWithBody(ctx context.Context, siteId SiteI
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateHotspotVouchers(ctx context.Context, siteId SiteId, body CreateHotspotVouchersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateHotspotVouchersRequest(c.Server, siteId, body)
+func (c *Client) UpdateLegacyDeviceWithBody(ctx context.Context, site Site, legacyDeviceId LegacyDeviceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLegacyDeviceRequestWithBody(c.Server, site, legacyDeviceId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1191,8 +2843,8 @@ func (c *Client) CreateHotspotVouchers(ctx context.Context, siteId SiteId, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteHotspotVoucher(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteHotspotVoucherRequest(c.Server, siteId, voucherId)
+func (c *Client) UpdateLegacyDevice(ctx context.Context, site Site, legacyDeviceId LegacyDeviceId, body UpdateLegacyDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLegacyDeviceRequest(c.Server, site, legacyDeviceId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1203,8 +2855,8 @@ func (c *Client) DeleteHotspotVoucher(ctx context.Context, siteId SiteId, vouche
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetHotspotVoucher(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHotspotVoucherRequest(c.Server, siteId, voucherId)
+func (c *Client) ListNetworks(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNetworksRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
@@ -1215,8 +2867,8 @@ func (c *Client) GetHotspotVoucher(ctx context.Context, siteId SiteId, voucherId
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAggregatedDashboard(ctx context.Context, site Site, params *GetAggregatedDashboardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAggregatedDashboardRequest(c.Server, site, params)
+func (c *Client) CreateSiteToSiteVPNWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSiteToSiteVPNRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1227,8 +2879,8 @@ func (c *Client) GetAggregatedDashboard(ctx context.Context, site Site, params *
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListFirewallPolicies(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListFirewallPoliciesRequest(c.Server, site)
+func (c *Client) CreateSiteToSiteVPN(ctx context.Context, site Site, body CreateSiteToSiteVPNJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSiteToSiteVPNRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1239,8 +2891,8 @@ func (c *Client) ListFirewallPolicies(ctx context.Context, site Site, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateFirewallPolicyWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateFirewallPolicyRequestWithBody(c.Server, site, contentType, body)
+func (c *Client) DeleteSiteToSiteVPN(ctx context.Context, site Site, networkId NetworkId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSiteToSiteVPNRequest(c.Server, site, networkId)
 	if err != nil {
 		return nil, err
 	}
@@ -1251,8 +2903,8 @@ func (c *Client) CreateFirewallPolicyWithBody(ctx context.Context, site Site, co
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateFirewallPolicy(ctx context.Context, site Site, body CreateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateFirewallPolicyRequest(c.Server, site, body)
+func (c *Client) UpdateSiteToSiteVPNWithBody(ctx context.Context, site Site, networkId NetworkId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSiteToSiteVPNRequestWithBody(c.Server, site, networkId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1263,8 +2915,8 @@ func (c *Client) CreateFirewallPolicy(ctx context.Context, site Site, body Creat
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteFirewallPolicy(ctx context.Context, site Site, policyId PolicyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteFirewallPolicyRequest(c.Server, site, policyId)
+func (c *Client) UpdateSiteToSiteVPN(ctx context.Context, site Site, networkId NetworkId, body UpdateSiteToSiteVPNJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSiteToSiteVPNRequest(c.Server, site, networkId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1275,8 +2927,8 @@ func (c *Client) DeleteFirewallPolicy(ctx context.Context, site Site, policyId P
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFirewallPolicyWithBody(ctx context.Context, site Site, policyId PolicyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFirewallPolicyRequestWithBody(c.Server, site, policyId, contentType, body)
+func (c *Client) ListKnownClients(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListKnownClientsRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
@@ -1287,8 +2939,8 @@ func (c *Client) UpdateFirewallPolicyWithBody(ctx context.Context, site Site, po
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateFirewallPolicy(ctx context.Context, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateFirewallPolicyRequest(c.Server, site, policyId, body)
+func (c *Client) UpdateKnownClientWithBody(ctx context.Context, site Site, knownClientId KnownClientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateKnownClientRequestWithBody(c.Server, site, knownClientId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1299,8 +2951,8 @@ func (c *Client) UpdateFirewallPolicy(ctx context.Context, site Site, policyId P
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDNSRecords(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDNSRecordsRequest(c.Server, site)
+func (c *Client) UpdateKnownClient(ctx context.Context, site Site, knownClientId KnownClientId, body UpdateKnownClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateKnownClientRequest(c.Server, site, knownClientId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1311,8 +2963,8 @@ func (c *Client) ListDNSRecords(ctx context.Context, site Site, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDNSRecordWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDNSRecordRequestWithBody(c.Server, site, contentType, body)
+func (c *Client) ListUserGroups(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUserGroupsRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
@@ -1323,8 +2975,8 @@ func (c *Client) CreateDNSRecordWithBody(ctx context.Context, site Site, content
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDNSRecord(ctx context.Context, site Site, body CreateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDNSRecordRequest(c.Server, site, body)
+func (c *Client) CreateUserGroupWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserGroupRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1335,8 +2987,8 @@ func (c *Client) CreateDNSRecord(ctx context.Context, site Site, body CreateDNSR
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDNSRecord(ctx context.Context, site Site, recordId RecordId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDNSRecordRequest(c.Server, site, recordId)
+func (c *Client) CreateUserGroup(ctx context.Context, site Site, body CreateUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserGroupRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1347,8 +2999,8 @@ func (c *Client) DeleteDNSRecord(ctx context.Context, site Site, recordId Record
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDNSRecordWithBody(ctx context.Context, site Site, recordId RecordId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDNSRecordRequestWithBody(c.Server, site, recordId, contentType, body)
+func (c *Client) DeleteUserGroup(ctx context.Context, site Site, userGroupId UserGroupId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserGroupRequest(c.Server, site, userGroupId)
 	if err != nil {
 		return nil, err
 	}
@@ -1359,8 +3011,8 @@ func (c *Client) UpdateDNSRecordWithBody(ctx context.Context, site Site, recordI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDNSRecord(ctx context.Context, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDNSRecordRequest(c.Server, site, recordId, body)
+func (c *Client) UpdateUserGroupWithBody(ctx context.Context, site Site, userGroupId UserGroupId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserGroupRequestWithBody(c.Server, site, userGroupId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1371,8 +3023,8 @@ func (c *Client) UpdateDNSRecord(ctx context.Context, site Site, recordId Record
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTrafficRules(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTrafficRulesRequest(c.Server, site)
+func (c *Client) UpdateUserGroup(ctx context.Context, site Site, userGroupId UserGroupId, body UpdateUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserGroupRequest(c.Server, site, userGroupId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1383,8 +3035,8 @@ func (c *Client) ListTrafficRules(ctx context.Context, site Site, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTrafficRuleWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTrafficRuleRequestWithBody(c.Server, site, contentType, body)
+func (c *Client) ListWLANs(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWLANsRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
@@ -1395,8 +3047,8 @@ func (c *Client) CreateTrafficRuleWithBody(ctx context.Context, site Site, conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTrafficRule(ctx context.Context, site Site, body CreateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTrafficRuleRequest(c.Server, site, body)
+func (c *Client) CreateWLANWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWLANRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1407,8 +3059,8 @@ func (c *Client) CreateTrafficRule(ctx context.Context, site Site, body CreateTr
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTrafficRule(ctx context.Context, site Site, ruleId RuleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTrafficRuleRequest(c.Server, site, ruleId)
+func (c *Client) CreateWLAN(ctx context.Context, site Site, body CreateWLANJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWLANRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1419,8 +3071,8 @@ func (c *Client) DeleteTrafficRule(ctx context.Context, site Site, ruleId RuleId
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTrafficRuleWithBody(ctx context.Context, site Site, ruleId RuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTrafficRuleRequestWithBody(c.Server, site, ruleId, contentType, body)
+func (c *Client) GetWLAN(ctx context.Context, site Site, wlanId WLANId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWLANRequest(c.Server, site, wlanId)
 	if err != nil {
 		return nil, err
 	}
@@ -1431,8 +3083,8 @@ func (c *Client) UpdateTrafficRuleWithBody(ctx context.Context, site Site, ruleI
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTrafficRule(ctx context.Context, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTrafficRuleRequest(c.Server, site, ruleId, body)
+func (c *Client) UpdateWLANWithBody(ctx context.Context, site Site, wlanId WLANId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWLANRequestWithBody(c.Server, site, wlanId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1443,732 +3095,703 @@ func (c *Client) UpdateTrafficRule(ctx context.Context, site Site, ruleId RuleId
 	return c.Client.Do(req)
 }
 
-// NewListSitesRequest generates requests for ListSites
-func NewListSitesRequest(server string, params *ListSitesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateWLAN(ctx context.Context, site Site, wlanId WLANId, body UpdateWLANJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWLANRequest(c.Server, site, wlanId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/integration/v1/sites")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListRegulatoryChannels(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRegulatoryChannelsRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ListLegacyDevices(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLegacyDevicesRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListSiteClientsRequest generates requests for ListSiteClients
-func NewListSiteClientsRequest(server string, siteId SiteId, params *ListSiteClientsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+func (c *Client) GetLegacyDevice(ctx context.Context, site Site, deviceMac DeviceMac, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLegacyDeviceRequest(c.Server, site, deviceMac)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListGuestAuthorizationsWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListGuestAuthorizationsRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/integration/v1/sites/%s/clients", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListGuestAuthorizations(ctx context.Context, site Site, body ListGuestAuthorizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListGuestAuthorizationsRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetSiteHealth(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSiteHealthRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetClientByIdRequest generates requests for GetClientById
-func NewGetClientByIdRequest(server string, siteId SiteId, clientId ClientId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+func (c *Client) ListClientSessionsWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClientSessionsRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clientId", runtime.ParamLocationPath, clientId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListClientSessions(ctx context.Context, site Site, body ListClientSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClientSessionsRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/integration/v1/sites/%s/clients/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetSystemInfo(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSystemInfoRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListSiteDevicesRequest generates requests for ListSiteDevices
-func NewListSiteDevicesRequest(server string, siteId SiteId, params *ListSiteDevicesParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+func (c *Client) GetKnownClient(ctx context.Context, site Site, clientMac ClientMac, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKnownClientRequest(c.Server, site, clientMac)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListSites(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSitesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/integration/v1/sites/%s/devices", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListSiteClients(ctx context.Context, siteId SiteId, params *ListSiteClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSiteClientsRequest(c.Server, siteId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetClientById(ctx context.Context, siteId SiteId, clientId ClientId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientByIdRequest(c.Server, siteId, clientId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetDeviceByIdRequest generates requests for GetDeviceById
-func NewGetDeviceByIdRequest(server string, siteId SiteId, deviceId DeviceId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+func (c *Client) ListSiteDevices(ctx context.Context, siteId SiteId, params *ListSiteDevicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSiteDevicesRequest(c.Server, siteId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deviceId", runtime.ParamLocationPath, deviceId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDeviceById(ctx context.Context, siteId SiteId, deviceId DeviceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeviceByIdRequest(c.Server, siteId, deviceId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/integration/v1/sites/%s/devices/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListHotspotVouchers(ctx context.Context, siteId SiteId, params *ListHotspotVouchersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHotspotVouchersRequest(c.Server, siteId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListHotspotVouchersRequest generates requests for ListHotspotVouchers
-func NewListHotspotVouchersRequest(server string, siteId SiteId, params *ListHotspotVouchersParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+func (c *Client) CreateHotspotVouchersWithBody(ctx context.Context, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHotspotVouchersRequestWithBody(c.Server, siteId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/integration/v1/sites/%s/hotspot/vouchers", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateHotspotVouchers(ctx context.Context, siteId SiteId, body CreateHotspotVouchersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateHotspotVouchersRequest(c.Server, siteId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeleteHotspotVoucher(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHotspotVoucherRequest(c.Server, siteId, voucherId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateHotspotVouchersRequest calls the generic CreateHotspotVouchers builder with application/json body
-func NewCreateHotspotVouchersRequest(server string, siteId SiteId, body CreateHotspotVouchersJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetHotspotVoucher(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHotspotVoucherRequest(c.Server, siteId, voucherId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateHotspotVouchersRequestWithBody(server, siteId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateHotspotVouchersRequestWithBody generates requests for CreateHotspotVouchers with any type of body
-func NewCreateHotspotVouchersRequestWithBody(server string, siteId SiteId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+func (c *Client) GetControllerStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetControllerStatusRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetControllerCertificate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetControllerCertificateRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/integration/v1/sites/%s/hotspot/vouchers", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateControllerCertificateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateControllerCertificateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteHotspotVoucherRequest generates requests for DeleteHotspotVoucher
-func NewDeleteHotspotVoucherRequest(server string, siteId SiteId, voucherId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+func (c *Client) UpdateControllerCertificate(ctx context.Context, body UpdateControllerCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateControllerCertificateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "voucherId", runtime.ParamLocationPath, voucherId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDPIApplicationCatalog(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDPIApplicationCatalogRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/integration/v1/sites/%s/hotspot/vouchers/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetAggregatedDashboard(ctx context.Context, site Site, params *GetAggregatedDashboardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAggregatedDashboardRequest(c.Server, site, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetHotspotVoucherRequest generates requests for GetHotspotVoucher
-func NewGetHotspotVoucherRequest(server string, siteId SiteId, voucherId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+func (c *Client) ListAPGroups(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAPGroupsRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "voucherId", runtime.ParamLocationPath, voucherId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateAPGroupWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPGroupRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/integration/v1/sites/%s/hotspot/vouchers/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateAPGroup(ctx context.Context, site Site, body CreateAPGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAPGroupRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAggregatedDashboardRequest generates requests for GetAggregatedDashboard
-func NewGetAggregatedDashboardRequest(server string, site Site, params *GetAggregatedDashboardParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+func (c *Client) DeleteAPGroup(ctx context.Context, site Site, apGroupId APGroupId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPGroupRequest(c.Server, site, apGroupId)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/aggregated-dashboard", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateAPGroupWithBody(ctx context.Context, site Site, apGroupId APGroupId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAPGroupRequestWithBody(c.Server, site, apGroupId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.HistorySeconds != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "historySeconds", runtime.ParamLocationQuery, *params.HistorySeconds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListFirewallPoliciesRequest generates requests for ListFirewallPolicies
-func NewListFirewallPoliciesRequest(server string, site Site) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+func (c *Client) UpdateAPGroup(ctx context.Context, site Site, apGroupId APGroupId, body UpdateAPGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAPGroupRequest(c.Server, site, apGroupId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetDynamicRoutingConfig(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDynamicRoutingConfigRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall-policies", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateDynamicRoutingConfigWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDynamicRoutingConfigRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateFirewallPolicyRequest calls the generic CreateFirewallPolicy builder with application/json body
-func NewCreateFirewallPolicyRequest(server string, site Site, body CreateFirewallPolicyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateDynamicRoutingConfig(ctx context.Context, site Site, body UpdateDynamicRoutingConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDynamicRoutingConfigRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateFirewallPolicyRequestWithBody(server, site, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateFirewallPolicyRequestWithBody generates requests for CreateFirewallPolicy with any type of body
-func NewCreateFirewallPolicyRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+func (c *Client) ListFirewallPolicies(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFirewallPoliciesRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateFirewallPolicyWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFirewallPolicyRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall-policies", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateFirewallPolicy(ctx context.Context, site Site, body CreateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateFirewallPolicyRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteFirewallPolicyRequest generates requests for DeleteFirewallPolicy
-func NewDeleteFirewallPolicyRequest(server string, site Site, policyId PolicyId) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+func (c *Client) DeleteFirewallPolicy(ctx context.Context, site Site, policyId PolicyId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFirewallPolicyRequest(c.Server, site, policyId)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policyId", runtime.ParamLocationPath, policyId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateFirewallPolicyWithBody(ctx context.Context, site Site, policyId PolicyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFirewallPolicyRequestWithBody(c.Server, site, policyId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall-policies/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateFirewallPolicy(ctx context.Context, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateFirewallPolicyRequest(c.Server, site, policyId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateFirewallPolicyRequest calls the generic UpdateFirewallPolicy builder with application/json body
-func NewUpdateFirewallPolicyRequest(server string, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListFirewallZones(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFirewallZonesRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateFirewallPolicyRequestWithBody(server, site, policyId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateFirewallPolicyRequestWithBody generates requests for UpdateFirewallPolicy with any type of body
-func NewUpdateFirewallPolicyRequestWithBody(server string, site Site, policyId PolicyId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+func (c *Client) ListDNSRecords(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDNSRecordsRequest(c.Server, site)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policyId", runtime.ParamLocationPath, policyId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateDNSRecordWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDNSRecordRequestWithBody(c.Server, site, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall-policies/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateDNSRecord(ctx context.Context, site Site, body CreateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDNSRecordRequest(c.Server, site, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListDNSRecordsRequest generates requests for ListDNSRecords
-func NewListDNSRecordsRequest(server string, site Site) (*http.Request, error) {
+func (c *Client) DeleteDNSRecord(ctx context.Context, site Site, recordId RecordId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDNSRecordRequest(c.Server, site, recordId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDNSRecordWithBody(ctx context.Context, site Site, recordId RecordId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDNSRecordRequestWithBody(c.Server, site, recordId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateDNSRecord(ctx context.Context, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDNSRecordRequest(c.Server, site, recordId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAdminActivityLogWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAdminActivityLogRequestWithBody(c.Server, site, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAdminActivityLog(ctx context.Context, site Site, body ListAdminActivityLogJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAdminActivityLogRequest(c.Server, site, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTrafficRules(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTrafficRulesRequest(c.Server, site)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTrafficRuleWithBody(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTrafficRuleRequestWithBody(c.Server, site, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTrafficRule(ctx context.Context, site Site, body CreateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTrafficRuleRequest(c.Server, site, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTrafficRule(ctx context.Context, site Site, ruleId RuleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTrafficRuleRequest(c.Server, site, ruleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTrafficRuleWithBody(ctx context.Context, site Site, ruleId RuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTrafficRuleRequestWithBody(c.Server, site, ruleId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTrafficRule(ctx context.Context, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTrafficRuleRequest(c.Server, site, ruleId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewExecuteDeviceCommandRequest calls the generic ExecuteDeviceCommand builder with application/json body
+func NewExecuteDeviceCommandRequest(server string, site Site, body ExecuteDeviceCommandJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExecuteDeviceCommandRequestWithBody(server, site, "application/json", bodyReader)
+}
+
+// NewExecuteDeviceCommandRequestWithBody generates requests for ExecuteDeviceCommand with any type of body
+func NewExecuteDeviceCommandRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2183,7 +3806,7 @@ func NewListDNSRecordsRequest(server string, site Site) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/static-dns", pathParam0)
+	operationPath := fmt.Sprintf("/api/s/%s/cmd/devmgr", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2193,27 +3816,29 @@ func NewListDNSRecordsRequest(server string, site Site) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewCreateDNSRecordRequest calls the generic CreateDNSRecord builder with application/json body
-func NewCreateDNSRecordRequest(server string, site Site, body CreateDNSRecordJSONRequestBody) (*http.Request, error) {
+// NewExecuteClientCommandRequest calls the generic ExecuteClientCommand builder with application/json body
+func NewExecuteClientCommandRequest(server string, site Site, body ExecuteClientCommandJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateDNSRecordRequestWithBody(server, site, "application/json", bodyReader)
+	return NewExecuteClientCommandRequestWithBody(server, site, "application/json", bodyReader)
 }
 
-// NewCreateDNSRecordRequestWithBody generates requests for CreateDNSRecord with any type of body
-func NewCreateDNSRecordRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+// NewExecuteClientCommandRequestWithBody generates requests for ExecuteClientCommand with any type of body
+func NewExecuteClientCommandRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2228,7 +3853,7 @@ func NewCreateDNSRecordRequestWithBody(server string, site Site, contentType str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/static-dns", pathParam0)
+	operationPath := fmt.Sprintf("/api/s/%s/cmd/stamgr", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2248,8 +3873,19 @@ func NewCreateDNSRecordRequestWithBody(server string, site Site, contentType str
 	return req, nil
 }
 
-// NewDeleteDNSRecordRequest generates requests for DeleteDNSRecord
-func NewDeleteDNSRecordRequest(server string, site Site, recordId RecordId) (*http.Request, error) {
+// NewExecuteSystemCommandRequest calls the generic ExecuteSystemCommand builder with application/json body
+func NewExecuteSystemCommandRequest(server string, site Site, body ExecuteSystemCommandJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExecuteSystemCommandRequestWithBody(server, site, "application/json", bodyReader)
+}
+
+// NewExecuteSystemCommandRequestWithBody generates requests for ExecuteSystemCommand with any type of body
+func NewExecuteSystemCommandRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2259,9 +3895,38 @@ func NewDeleteDNSRecordRequest(server string, site Site, recordId RecordId) (*ht
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "recordId", runtime.ParamLocationPath, recordId)
+	operationPath := fmt.Sprintf("/api/s/%s/cmd/system", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetNTPSettingsRequest generates requests for GetNTPSettings
+func NewGetNTPSettingsRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
 	if err != nil {
 		return nil, err
 	}
@@ -2271,7 +3936,7 @@ func NewDeleteDNSRecordRequest(server string, site Site, recordId RecordId) (*ht
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/static-dns/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/s/%s/get/setting/ntp", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2281,7 +3946,7 @@ func NewDeleteDNSRecordRequest(server string, site Site, recordId RecordId) (*ht
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2289,19 +3954,19 @@ func NewDeleteDNSRecordRequest(server string, site Site, recordId RecordId) (*ht
 	return req, nil
 }
 
-// NewUpdateDNSRecordRequest calls the generic UpdateDNSRecord builder with application/json body
-func NewUpdateDNSRecordRequest(server string, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody) (*http.Request, error) {
+// NewUpdateLegacyDeviceRequest calls the generic UpdateLegacyDevice builder with application/json body
+func NewUpdateLegacyDeviceRequest(server string, site Site, legacyDeviceId LegacyDeviceId, body UpdateLegacyDeviceJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDNSRecordRequestWithBody(server, site, recordId, "application/json", bodyReader)
+	return NewUpdateLegacyDeviceRequestWithBody(server, site, legacyDeviceId, "application/json", bodyReader)
 }
 
-// NewUpdateDNSRecordRequestWithBody generates requests for UpdateDNSRecord with any type of body
-func NewUpdateDNSRecordRequestWithBody(server string, site Site, recordId RecordId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateLegacyDeviceRequestWithBody generates requests for UpdateLegacyDevice with any type of body
+func NewUpdateLegacyDeviceRequestWithBody(server string, site Site, legacyDeviceId LegacyDeviceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2313,7 +3978,7 @@ func NewUpdateDNSRecordRequestWithBody(server string, site Site, recordId Record
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "recordId", runtime.ParamLocationPath, recordId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "legacyDeviceId", runtime.ParamLocationPath, legacyDeviceId)
 	if err != nil {
 		return nil, err
 	}
@@ -2323,7 +3988,7 @@ func NewUpdateDNSRecordRequestWithBody(server string, site Site, recordId Record
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/static-dns/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/s/%s/rest/device/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2343,8 +4008,8 @@ func NewUpdateDNSRecordRequestWithBody(server string, site Site, recordId Record
 	return req, nil
 }
 
-// NewListTrafficRulesRequest generates requests for ListTrafficRules
-func NewListTrafficRulesRequest(server string, site Site) (*http.Request, error) {
+// NewListNetworksRequest generates requests for ListNetworks
+func NewListNetworksRequest(server string, site Site) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2359,7 +4024,7 @@ func NewListTrafficRulesRequest(server string, site Site) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/trafficrules", pathParam0)
+	operationPath := fmt.Sprintf("/api/s/%s/rest/networkconf", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2377,19 +4042,19 @@ func NewListTrafficRulesRequest(server string, site Site) (*http.Request, error)
 	return req, nil
 }
 
-// NewCreateTrafficRuleRequest calls the generic CreateTrafficRule builder with application/json body
-func NewCreateTrafficRuleRequest(server string, site Site, body CreateTrafficRuleJSONRequestBody) (*http.Request, error) {
+// NewCreateSiteToSiteVPNRequest calls the generic CreateSiteToSiteVPN builder with application/json body
+func NewCreateSiteToSiteVPNRequest(server string, site Site, body CreateSiteToSiteVPNJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateTrafficRuleRequestWithBody(server, site, "application/json", bodyReader)
+	return NewCreateSiteToSiteVPNRequestWithBody(server, site, "application/json", bodyReader)
 }
 
-// NewCreateTrafficRuleRequestWithBody generates requests for CreateTrafficRule with any type of body
-func NewCreateTrafficRuleRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateSiteToSiteVPNRequestWithBody generates requests for CreateSiteToSiteVPN with any type of body
+func NewCreateSiteToSiteVPNRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2404,7 +4069,7 @@ func NewCreateTrafficRuleRequestWithBody(server string, site Site, contentType s
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/trafficrules", pathParam0)
+	operationPath := fmt.Sprintf("/api/s/%s/rest/networkconf", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2424,8 +4089,8 @@ func NewCreateTrafficRuleRequestWithBody(server string, site Site, contentType s
 	return req, nil
 }
 
-// NewDeleteTrafficRuleRequest generates requests for DeleteTrafficRule
-func NewDeleteTrafficRuleRequest(server string, site Site, ruleId RuleId) (*http.Request, error) {
+// NewDeleteSiteToSiteVPNRequest generates requests for DeleteSiteToSiteVPN
+func NewDeleteSiteToSiteVPNRequest(server string, site Site, networkId NetworkId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2437,7 +4102,7 @@ func NewDeleteTrafficRuleRequest(server string, site Site, ruleId RuleId) (*http
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "networkId", runtime.ParamLocationPath, networkId)
 	if err != nil {
 		return nil, err
 	}
@@ -2447,7 +4112,7 @@ func NewDeleteTrafficRuleRequest(server string, site Site, ruleId RuleId) (*http
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/trafficrules/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/s/%s/rest/networkconf/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2465,19 +4130,19 @@ func NewDeleteTrafficRuleRequest(server string, site Site, ruleId RuleId) (*http
 	return req, nil
 }
 
-// NewUpdateTrafficRuleRequest calls the generic UpdateTrafficRule builder with application/json body
-func NewUpdateTrafficRuleRequest(server string, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody) (*http.Request, error) {
+// NewUpdateSiteToSiteVPNRequest calls the generic UpdateSiteToSiteVPN builder with application/json body
+func NewUpdateSiteToSiteVPNRequest(server string, site Site, networkId NetworkId, body UpdateSiteToSiteVPNJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTrafficRuleRequestWithBody(server, site, ruleId, "application/json", bodyReader)
+	return NewUpdateSiteToSiteVPNRequestWithBody(server, site, networkId, "application/json", bodyReader)
 }
 
-// NewUpdateTrafficRuleRequestWithBody generates requests for UpdateTrafficRule with any type of body
-func NewUpdateTrafficRuleRequestWithBody(server string, site Site, ruleId RuleId, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateSiteToSiteVPNRequestWithBody generates requests for UpdateSiteToSiteVPN with any type of body
+func NewUpdateSiteToSiteVPNRequestWithBody(server string, site Site, networkId NetworkId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2489,7 +4154,7 @@ func NewUpdateTrafficRuleRequestWithBody(server string, site Site, ruleId RuleId
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "networkId", runtime.ParamLocationPath, networkId)
 	if err != nil {
 		return nil, err
 	}
@@ -2499,7 +4164,7 @@ func NewUpdateTrafficRuleRequestWithBody(server string, site Site, ruleId RuleId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/api/site/%s/trafficrules/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/s/%s/rest/networkconf/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2519,931 +4184,6224 @@ func NewUpdateTrafficRuleRequestWithBody(server string, site Site, ruleId RuleId
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewListKnownClientsRequest generates requests for ListKnownClients
+func NewListKnownClientsRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/api/s/%s/rest/user", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListSitesWithResponse request
-	ListSitesWithResponse(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*ListSitesResponse, error)
+// NewUpdateKnownClientRequest calls the generic UpdateKnownClient builder with application/json body
+func NewUpdateKnownClientRequest(server string, site Site, knownClientId KnownClientId, body UpdateKnownClientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateKnownClientRequestWithBody(server, site, knownClientId, "application/json", bodyReader)
+}
 
-	// ListSiteClientsWithResponse request
-	ListSiteClientsWithResponse(ctx context.Context, siteId SiteId, params *ListSiteClientsParams, reqEditors ...RequestEditorFn) (*ListSiteClientsResponse, error)
+// NewUpdateKnownClientRequestWithBody generates requests for UpdateKnownClient with any type of body
+func NewUpdateKnownClientRequestWithBody(server string, site Site, knownClientId KnownClientId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetClientByIdWithResponse request
-	GetClientByIdWithResponse(ctx context.Context, siteId SiteId, clientId ClientId, reqEditors ...RequestEditorFn) (*GetClientByIdResponse, error)
+	var pathParam0 string
 
-	// ListSiteDevicesWithResponse request
-	ListSiteDevicesWithResponse(ctx context.Context, siteId SiteId, params *ListSiteDevicesParams, reqEditors ...RequestEditorFn) (*ListSiteDevicesResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDeviceByIdWithResponse request
-	GetDeviceByIdWithResponse(ctx context.Context, siteId SiteId, deviceId DeviceId, reqEditors ...RequestEditorFn) (*GetDeviceByIdResponse, error)
+	var pathParam1 string
 
-	// ListHotspotVouchersWithResponse request
-	ListHotspotVouchersWithResponse(ctx context.Context, siteId SiteId, params *ListHotspotVouchersParams, reqEditors ...RequestEditorFn) (*ListHotspotVouchersResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "knownClientId", runtime.ParamLocationPath, knownClientId)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateHotspotVouchersWithBodyWithResponse request with any body
-	CreateHotspotVouchersWithBodyWithResponse(ctx context.Context, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHotspotVouchersResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateHotspotVouchersWithResponse(ctx context.Context, siteId SiteId, body CreateHotspotVouchersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHotspotVouchersResponse, error)
+	operationPath := fmt.Sprintf("/api/s/%s/rest/user/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteHotspotVoucherWithResponse request
-	DeleteHotspotVoucherWithResponse(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteHotspotVoucherResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetHotspotVoucherWithResponse request
-	GetHotspotVoucherWithResponse(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHotspotVoucherResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAggregatedDashboardWithResponse request
-	GetAggregatedDashboardWithResponse(ctx context.Context, site Site, params *GetAggregatedDashboardParams, reqEditors ...RequestEditorFn) (*GetAggregatedDashboardResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ListFirewallPoliciesWithResponse request
-	ListFirewallPoliciesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListFirewallPoliciesResponse, error)
+	return req, nil
+}
 
-	// CreateFirewallPolicyWithBodyWithResponse request with any body
-	CreateFirewallPolicyWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFirewallPolicyResponse, error)
+// NewListUserGroupsRequest generates requests for ListUserGroups
+func NewListUserGroupsRequest(server string, site Site) (*http.Request, error) {
+	var err error
 
-	CreateFirewallPolicyWithResponse(ctx context.Context, site Site, body CreateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFirewallPolicyResponse, error)
+	var pathParam0 string
 
-	// DeleteFirewallPolicyWithResponse request
-	DeleteFirewallPolicyWithResponse(ctx context.Context, site Site, policyId PolicyId, reqEditors ...RequestEditorFn) (*DeleteFirewallPolicyResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateFirewallPolicyWithBodyWithResponse request with any body
-	UpdateFirewallPolicyWithBodyWithResponse(ctx context.Context, site Site, policyId PolicyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFirewallPolicyResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateFirewallPolicyWithResponse(ctx context.Context, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFirewallPolicyResponse, error)
+	operationPath := fmt.Sprintf("/api/s/%s/rest/usergroup", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListDNSRecordsWithResponse request
-	ListDNSRecordsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListDNSRecordsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateDNSRecordWithBodyWithResponse request with any body
-	CreateDNSRecordWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDNSRecordResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDNSRecordWithResponse(ctx context.Context, site Site, body CreateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDNSRecordResponse, error)
+	return req, nil
+}
 
-	// DeleteDNSRecordWithResponse request
-	DeleteDNSRecordWithResponse(ctx context.Context, site Site, recordId RecordId, reqEditors ...RequestEditorFn) (*DeleteDNSRecordResponse, error)
+// NewCreateUserGroupRequest calls the generic CreateUserGroup builder with application/json body
+func NewCreateUserGroupRequest(server string, site Site, body CreateUserGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserGroupRequestWithBody(server, site, "application/json", bodyReader)
+}
 
-	// UpdateDNSRecordWithBodyWithResponse request with any body
-	UpdateDNSRecordWithBodyWithResponse(ctx context.Context, site Site, recordId RecordId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDNSRecordResponse, error)
+// NewCreateUserGroupRequestWithBody generates requests for CreateUserGroup with any type of body
+func NewCreateUserGroupRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	UpdateDNSRecordWithResponse(ctx context.Context, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDNSRecordResponse, error)
+	var pathParam0 string
 
-	// ListTrafficRulesWithResponse request
-	ListTrafficRulesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListTrafficRulesResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateTrafficRuleWithBodyWithResponse request with any body
-	CreateTrafficRuleWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrafficRuleResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateTrafficRuleWithResponse(ctx context.Context, site Site, body CreateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrafficRuleResponse, error)
+	operationPath := fmt.Sprintf("/api/s/%s/rest/usergroup", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteTrafficRuleWithResponse request
-	DeleteTrafficRuleWithResponse(ctx context.Context, site Site, ruleId RuleId, reqEditors ...RequestEditorFn) (*DeleteTrafficRuleResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateTrafficRuleWithBodyWithResponse request with any body
-	UpdateTrafficRuleWithBodyWithResponse(ctx context.Context, site Site, ruleId RuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTrafficRuleResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateTrafficRuleWithResponse(ctx context.Context, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTrafficRuleResponse, error)
-}
+	req.Header.Add("Content-Type", contentType)
 
-type ListSitesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SitesResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListSitesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewDeleteUserGroupRequest generates requests for DeleteUserGroup
+func NewDeleteUserGroupRequest(server string, site Site, userGroupId UserGroupId) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListSitesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListSiteClientsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ClientsResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r ListSiteClientsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userGroupId", runtime.ParamLocationPath, userGroupId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListSiteClientsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetClientByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NetworkClient
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	operationPath := fmt.Sprintf("/api/s/%s/rest/usergroup/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetClientByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClientByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListSiteDevicesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DevicesResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListSiteDevicesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateUserGroupRequest calls the generic UpdateUserGroup builder with application/json body
+func NewUpdateUserGroupRequest(server string, site Site, userGroupId UserGroupId, body UpdateUserGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserGroupRequestWithBody(server, site, userGroupId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListSiteDevicesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateUserGroupRequestWithBody generates requests for UpdateUserGroup with any type of body
+func NewUpdateUserGroupRequestWithBody(server string, site Site, userGroupId UserGroupId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetDeviceByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Device
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetDeviceByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDeviceByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userGroupId", runtime.ParamLocationPath, userGroupId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListHotspotVouchersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HotspotVouchersResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListHotspotVouchersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/s/%s/rest/usergroup/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListHotspotVouchersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateHotspotVouchersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HotspotVouchersResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateHotspotVouchersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListWLANsRequest generates requests for ListWLANs
+func NewListWLANsRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateHotspotVouchersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteHotspotVoucherResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	operationPath := fmt.Sprintf("/api/s/%s/rest/wlanconf", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteHotspotVoucherResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteHotspotVoucherResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetHotspotVoucherResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *HotspotVoucher
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetHotspotVoucherResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateWLANRequest calls the generic CreateWLAN builder with application/json body
+func NewCreateWLANRequest(server string, site Site, body CreateWLANJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWLANRequestWithBody(server, site, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHotspotVoucherResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewCreateWLANRequestWithBody generates requests for CreateWLAN with any type of body
+func NewCreateWLANRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetAggregatedDashboardResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AggregatedDashboard
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetAggregatedDashboardResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAggregatedDashboardResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListFirewallPoliciesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]FirewallPolicy
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	operationPath := fmt.Sprintf("/api/s/%s/rest/wlanconf", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListFirewallPoliciesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListFirewallPoliciesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateFirewallPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FirewallPolicy
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r CreateFirewallPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateFirewallPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetWLANRequest generates requests for GetWLAN
+func NewGetWLANRequest(server string, site Site, wlanId WLANId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteFirewallPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteFirewallPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "wlanId", runtime.ParamLocationPath, wlanId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFirewallPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateFirewallPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FirewallPolicy
-	JSON400      *ErrorResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
-
-// Status returns HTTPResponse.Status
-func (r UpdateFirewallPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/s/%s/rest/wlanconf/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateFirewallPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type ListDNSRecordsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]DNSRecord
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
 
-// Status returns HTTPResponse.Status
-func (r ListDNSRecordsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDNSRecordsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewUpdateWLANRequest calls the generic UpdateWLAN builder with application/json body
+func NewUpdateWLANRequest(server string, site Site, wlanId WLANId, body UpdateWLANJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWLANRequestWithBody(server, site, wlanId, "application/json", bodyReader)
 }
 
-type CreateDNSRecordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DNSRecord
-	JSON400      *ErrorResponse
-	JSON401      *Unauthorized
-}
+// NewUpdateWLANRequestWithBody generates requests for UpdateWLAN with any type of body
+func NewUpdateWLANRequestWithBody(server string, site Site, wlanId WLANId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CreateDNSRecordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDNSRecordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDNSRecordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r DeleteDNSRecordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "wlanId", runtime.ParamLocationPath, wlanId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDNSRecordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateDNSRecordResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DNSRecord
-	JSON400      *ErrorResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	operationPath := fmt.Sprintf("/api/s/%s/rest/wlanconf/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateDNSRecordResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDNSRecordResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListTrafficRulesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TrafficRule
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r ListTrafficRulesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTrafficRulesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewListRegulatoryChannelsRequest generates requests for ListRegulatoryChannels
+func NewListRegulatoryChannelsRequest(server string, site Site) (*http.Request, error) {
+	var err error
 
-type CreateTrafficRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TrafficRule
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r CreateTrafficRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTrafficRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteTrafficRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-}
+	operationPath := fmt.Sprintf("/api/s/%s/stat/current-channel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteTrafficRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTrafficRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateTrafficRuleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TrafficRule
-	JSON400      *ErrorResponse
-	JSON401      *Unauthorized
-	JSON404      *NotFound
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTrafficRuleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewListLegacyDevicesRequest generates requests for ListLegacyDevices
+func NewListLegacyDevicesRequest(server string, site Site) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTrafficRuleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	var pathParam0 string
 
-// ListSitesWithResponse request returning *ListSitesResponse
-func (c *ClientWithResponses) ListSitesWithResponse(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*ListSitesResponse, error) {
-	rsp, err := c.ListSites(ctx, params, reqEditors...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListSitesResponse(rsp)
-}
 
-// ListSiteClientsWithResponse request returning *ListSiteClientsResponse
-func (c *ClientWithResponses) ListSiteClientsWithResponse(ctx context.Context, siteId SiteId, params *ListSiteClientsParams, reqEditors ...RequestEditorFn) (*ListSiteClientsResponse, error) {
-	rsp, err := c.ListSiteClients(ctx, siteId, params, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListSiteClientsResponse(rsp)
-}
 
-// GetClientByIdWithResponse request returning *GetClientByIdResponse
-func (c *ClientWithResponses) GetClientByIdWithResponse(ctx context.Context, siteId SiteId, clientId ClientId, reqEditors ...RequestEditorFn) (*GetClientByIdResponse, error) {
-	rsp, err := c.GetClientById(ctx, siteId, clientId, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/api/s/%s/stat/device", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return ParseGetClientByIdResponse(rsp)
-}
 
-// ListSiteDevicesWithResponse request returning *ListSiteDevicesResponse
-func (c *ClientWithResponses) ListSiteDevicesWithResponse(ctx context.Context, siteId SiteId, params *ListSiteDevicesParams, reqEditors ...RequestEditorFn) (*ListSiteDevicesResponse, error) {
-	rsp, err := c.ListSiteDevices(ctx, siteId, params, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListSiteDevicesResponse(rsp)
-}
 
-// GetDeviceByIdWithResponse request returning *GetDeviceByIdResponse
-func (c *ClientWithResponses) GetDeviceByIdWithResponse(ctx context.Context, siteId SiteId, deviceId DeviceId, reqEditors ...RequestEditorFn) (*GetDeviceByIdResponse, error) {
-	rsp, err := c.GetDeviceById(ctx, siteId, deviceId, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDeviceByIdResponse(rsp)
+
+	return req, nil
 }
 
-// ListHotspotVouchersWithResponse request returning *ListHotspotVouchersResponse
-func (c *ClientWithResponses) ListHotspotVouchersWithResponse(ctx context.Context, siteId SiteId, params *ListHotspotVouchersParams, reqEditors ...RequestEditorFn) (*ListHotspotVouchersResponse, error) {
-	rsp, err := c.ListHotspotVouchers(ctx, siteId, params, reqEditors...)
+// NewGetLegacyDeviceRequest generates requests for GetLegacyDevice
+func NewGetLegacyDeviceRequest(server string, site Site, deviceMac DeviceMac) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListHotspotVouchersResponse(rsp)
-}
 
-// CreateHotspotVouchersWithBodyWithResponse request with arbitrary body returning *CreateHotspotVouchersResponse
-func (c *ClientWithResponses) CreateHotspotVouchersWithBodyWithResponse(ctx context.Context, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHotspotVouchersResponse, error) {
-	rsp, err := c.CreateHotspotVouchersWithBody(ctx, siteId, contentType, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deviceMac", runtime.ParamLocationPath, deviceMac)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateHotspotVouchersResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateHotspotVouchersWithResponse(ctx context.Context, siteId SiteId, body CreateHotspotVouchersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHotspotVouchersResponse, error) {
-	rsp, err := c.CreateHotspotVouchers(ctx, siteId, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateHotspotVouchersResponse(rsp)
-}
 
-// DeleteHotspotVoucherWithResponse request returning *DeleteHotspotVoucherResponse
-func (c *ClientWithResponses) DeleteHotspotVoucherWithResponse(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteHotspotVoucherResponse, error) {
-	rsp, err := c.DeleteHotspotVoucher(ctx, siteId, voucherId, reqEditors...)
+	operationPath := fmt.Sprintf("/api/s/%s/stat/device/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteHotspotVoucherResponse(rsp)
-}
 
-// GetHotspotVoucherWithResponse request returning *GetHotspotVoucherResponse
-func (c *ClientWithResponses) GetHotspotVoucherWithResponse(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHotspotVoucherResponse, error) {
-	rsp, err := c.GetHotspotVoucher(ctx, siteId, voucherId, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetHotspotVoucherResponse(rsp)
+
+	return req, nil
 }
 
-// GetAggregatedDashboardWithResponse request returning *GetAggregatedDashboardResponse
-func (c *ClientWithResponses) GetAggregatedDashboardWithResponse(ctx context.Context, site Site, params *GetAggregatedDashboardParams, reqEditors ...RequestEditorFn) (*GetAggregatedDashboardResponse, error) {
-	rsp, err := c.GetAggregatedDashboard(ctx, site, params, reqEditors...)
+// NewListGuestAuthorizationsRequest calls the generic ListGuestAuthorizations builder with application/json body
+func NewListGuestAuthorizationsRequest(server string, site Site, body ListGuestAuthorizationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAggregatedDashboardResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewListGuestAuthorizationsRequestWithBody(server, site, "application/json", bodyReader)
 }
 
-// ListFirewallPoliciesWithResponse request returning *ListFirewallPoliciesResponse
-func (c *ClientWithResponses) ListFirewallPoliciesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListFirewallPoliciesResponse, error) {
-	rsp, err := c.ListFirewallPolicies(ctx, site, reqEditors...)
+// NewListGuestAuthorizationsRequestWithBody generates requests for ListGuestAuthorizations with any type of body
+func NewListGuestAuthorizationsRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListFirewallPoliciesResponse(rsp)
-}
 
-// CreateFirewallPolicyWithBodyWithResponse request with arbitrary body returning *CreateFirewallPolicyResponse
-func (c *ClientWithResponses) CreateFirewallPolicyWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFirewallPolicyResponse, error) {
-	rsp, err := c.CreateFirewallPolicyWithBody(ctx, site, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateFirewallPolicyResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateFirewallPolicyWithResponse(ctx context.Context, site Site, body CreateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFirewallPolicyResponse, error) {
-	rsp, err := c.CreateFirewallPolicy(ctx, site, body, reqEditors...)
+	operationPath := fmt.Sprintf("/api/s/%s/stat/guest", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateFirewallPolicyResponse(rsp)
-}
 
-// DeleteFirewallPolicyWithResponse request returning *DeleteFirewallPolicyResponse
-func (c *ClientWithResponses) DeleteFirewallPolicyWithResponse(ctx context.Context, site Site, policyId PolicyId, reqEditors ...RequestEditorFn) (*DeleteFirewallPolicyResponse, error) {
-	rsp, err := c.DeleteFirewallPolicy(ctx, site, policyId, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteFirewallPolicyResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// UpdateFirewallPolicyWithBodyWithResponse request with arbitrary body returning *UpdateFirewallPolicyResponse
-func (c *ClientWithResponses) UpdateFirewallPolicyWithBodyWithResponse(ctx context.Context, site Site, policyId PolicyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFirewallPolicyResponse, error) {
-	rsp, err := c.UpdateFirewallPolicyWithBody(ctx, site, policyId, contentType, body, reqEditors...)
+// NewGetSiteHealthRequest generates requests for GetSiteHealth
+func NewGetSiteHealthRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateFirewallPolicyResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateFirewallPolicyWithResponse(ctx context.Context, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFirewallPolicyResponse, error) {
-	rsp, err := c.UpdateFirewallPolicy(ctx, site, policyId, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateFirewallPolicyResponse(rsp)
-}
 
-// ListDNSRecordsWithResponse request returning *ListDNSRecordsResponse
-func (c *ClientWithResponses) ListDNSRecordsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListDNSRecordsResponse, error) {
-	rsp, err := c.ListDNSRecords(ctx, site, reqEditors...)
+	operationPath := fmt.Sprintf("/api/s/%s/stat/health", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListDNSRecordsResponse(rsp)
-}
 
-// CreateDNSRecordWithBodyWithResponse request with arbitrary body returning *CreateDNSRecordResponse
-func (c *ClientWithResponses) CreateDNSRecordWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDNSRecordResponse, error) {
-	rsp, err := c.CreateDNSRecordWithBody(ctx, site, contentType, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDNSRecordResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateDNSRecordWithResponse(ctx context.Context, site Site, body CreateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDNSRecordResponse, error) {
-	rsp, err := c.CreateDNSRecord(ctx, site, body, reqEditors...)
+// NewListClientSessionsRequest calls the generic ListClientSessions builder with application/json body
+func NewListClientSessionsRequest(server string, site Site, body ListClientSessionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDNSRecordResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewListClientSessionsRequestWithBody(server, site, "application/json", bodyReader)
 }
 
-// DeleteDNSRecordWithResponse request returning *DeleteDNSRecordResponse
-func (c *ClientWithResponses) DeleteDNSRecordWithResponse(ctx context.Context, site Site, recordId RecordId, reqEditors ...RequestEditorFn) (*DeleteDNSRecordResponse, error) {
-	rsp, err := c.DeleteDNSRecord(ctx, site, recordId, reqEditors...)
+// NewListClientSessionsRequestWithBody generates requests for ListClientSessions with any type of body
+func NewListClientSessionsRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDNSRecordResponse(rsp)
-}
 
-// UpdateDNSRecordWithBodyWithResponse request with arbitrary body returning *UpdateDNSRecordResponse
-func (c *ClientWithResponses) UpdateDNSRecordWithBodyWithResponse(ctx context.Context, site Site, recordId RecordId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDNSRecordResponse, error) {
-	rsp, err := c.UpdateDNSRecordWithBody(ctx, site, recordId, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDNSRecordResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDNSRecordWithResponse(ctx context.Context, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDNSRecordResponse, error) {
-	rsp, err := c.UpdateDNSRecord(ctx, site, recordId, body, reqEditors...)
+	operationPath := fmt.Sprintf("/api/s/%s/stat/session", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateDNSRecordResponse(rsp)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ListTrafficRulesWithResponse request returning *ListTrafficRulesResponse
-func (c *ClientWithResponses) ListTrafficRulesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListTrafficRulesResponse, error) {
-	rsp, err := c.ListTrafficRules(ctx, site, reqEditors...)
+// NewGetSystemInfoRequest generates requests for GetSystemInfo
+func NewGetSystemInfoRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListTrafficRulesResponse(rsp)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/s/%s/stat/sysinfo", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// CreateTrafficRuleWithBodyWithResponse request with arbitrary body returning *CreateTrafficRuleResponse
-func (c *ClientWithResponses) CreateTrafficRuleWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrafficRuleResponse, error) {
-	rsp, err := c.CreateTrafficRuleWithBody(ctx, site, contentType, body, reqEditors...)
+// NewGetKnownClientRequest generates requests for GetKnownClient
+func NewGetKnownClientRequest(server string, site Site, clientMac ClientMac) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTrafficRuleResponse(rsp)
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clientMac", runtime.ParamLocationPath, clientMac)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/s/%s/stat/user/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateTrafficRuleWithResponse(ctx context.Context, site Site, body CreateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrafficRuleResponse, error) {
-	rsp, err := c.CreateTrafficRule(ctx, site, body, reqEditors...)
+// NewListSitesRequest generates requests for ListSites
+func NewListSitesRequest(server string, params *ListSitesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTrafficRuleResponse(rsp)
+
+	operationPath := fmt.Sprintf("/integration/v1/sites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSiteClientsRequest generates requests for ListSiteClients
+func NewListSiteClientsRequest(server string, siteId SiteId, params *ListSiteClientsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integration/v1/sites/%s/clients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClientByIdRequest generates requests for GetClientById
+func NewGetClientByIdRequest(server string, siteId SiteId, clientId ClientId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "clientId", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integration/v1/sites/%s/clients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSiteDevicesRequest generates requests for ListSiteDevices
+func NewListSiteDevicesRequest(server string, siteId SiteId, params *ListSiteDevicesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integration/v1/sites/%s/devices", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDeviceByIdRequest generates requests for GetDeviceById
+func NewGetDeviceByIdRequest(server string, siteId SiteId, deviceId DeviceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deviceId", runtime.ParamLocationPath, deviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integration/v1/sites/%s/devices/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListHotspotVouchersRequest generates requests for ListHotspotVouchers
+func NewListHotspotVouchersRequest(server string, siteId SiteId, params *ListHotspotVouchersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integration/v1/sites/%s/hotspot/vouchers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateHotspotVouchersRequest calls the generic CreateHotspotVouchers builder with application/json body
+func NewCreateHotspotVouchersRequest(server string, siteId SiteId, body CreateHotspotVouchersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateHotspotVouchersRequestWithBody(server, siteId, "application/json", bodyReader)
+}
+
+// NewCreateHotspotVouchersRequestWithBody generates requests for CreateHotspotVouchers with any type of body
+func NewCreateHotspotVouchersRequestWithBody(server string, siteId SiteId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integration/v1/sites/%s/hotspot/vouchers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteHotspotVoucherRequest generates requests for DeleteHotspotVoucher
+func NewDeleteHotspotVoucherRequest(server string, siteId SiteId, voucherId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "voucherId", runtime.ParamLocationPath, voucherId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integration/v1/sites/%s/hotspot/vouchers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHotspotVoucherRequest generates requests for GetHotspotVoucher
+func NewGetHotspotVoucherRequest(server string, siteId SiteId, voucherId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "siteId", runtime.ParamLocationPath, siteId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "voucherId", runtime.ParamLocationPath, voucherId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/integration/v1/sites/%s/hotspot/vouchers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetControllerStatusRequest generates requests for GetControllerStatus
+func NewGetControllerStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetControllerCertificateRequest generates requests for GetControllerCertificate
+func NewGetControllerCertificateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/certificate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateControllerCertificateRequest calls the generic UpdateControllerCertificate builder with application/json body
+func NewUpdateControllerCertificateRequest(server string, body UpdateControllerCertificateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateControllerCertificateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateControllerCertificateRequestWithBody generates requests for UpdateControllerCertificate with any type of body
+func NewUpdateControllerCertificateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/certificate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDPIApplicationCatalogRequest generates requests for GetDPIApplicationCatalog
+func NewGetDPIApplicationCatalogRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/dpi/catalog")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAggregatedDashboardRequest generates requests for GetAggregatedDashboard
+func NewGetAggregatedDashboardRequest(server string, site Site, params *GetAggregatedDashboardParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/aggregated-dashboard", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.HistorySeconds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "historySeconds", runtime.ParamLocationQuery, *params.HistorySeconds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAPGroupsRequest generates requests for ListAPGroups
+func NewListAPGroupsRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/apgroups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAPGroupRequest calls the generic CreateAPGroup builder with application/json body
+func NewCreateAPGroupRequest(server string, site Site, body CreateAPGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAPGroupRequestWithBody(server, site, "application/json", bodyReader)
+}
+
+// NewCreateAPGroupRequestWithBody generates requests for CreateAPGroup with any type of body
+func NewCreateAPGroupRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/apgroups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAPGroupRequest generates requests for DeleteAPGroup
+func NewDeleteAPGroupRequest(server string, site Site, apGroupId APGroupId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apGroupId", runtime.ParamLocationPath, apGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/apgroups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateAPGroupRequest calls the generic UpdateAPGroup builder with application/json body
+func NewUpdateAPGroupRequest(server string, site Site, apGroupId APGroupId, body UpdateAPGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAPGroupRequestWithBody(server, site, apGroupId, "application/json", bodyReader)
+}
+
+// NewUpdateAPGroupRequestWithBody generates requests for UpdateAPGroup with any type of body
+func NewUpdateAPGroupRequestWithBody(server string, site Site, apGroupId APGroupId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "apGroupId", runtime.ParamLocationPath, apGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/apgroups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDynamicRoutingConfigRequest generates requests for GetDynamicRoutingConfig
+func NewGetDynamicRoutingConfigRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/bgp/config", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDynamicRoutingConfigRequest calls the generic UpdateDynamicRoutingConfig builder with application/json body
+func NewUpdateDynamicRoutingConfigRequest(server string, site Site, body UpdateDynamicRoutingConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDynamicRoutingConfigRequestWithBody(server, site, "application/json", bodyReader)
+}
+
+// NewUpdateDynamicRoutingConfigRequestWithBody generates requests for UpdateDynamicRoutingConfig with any type of body
+func NewUpdateDynamicRoutingConfigRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/bgp/config", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFirewallPoliciesRequest generates requests for ListFirewallPolicies
+func NewListFirewallPoliciesRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall-policies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateFirewallPolicyRequest calls the generic CreateFirewallPolicy builder with application/json body
+func NewCreateFirewallPolicyRequest(server string, site Site, body CreateFirewallPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateFirewallPolicyRequestWithBody(server, site, "application/json", bodyReader)
+}
+
+// NewCreateFirewallPolicyRequestWithBody generates requests for CreateFirewallPolicy with any type of body
+func NewCreateFirewallPolicyRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall-policies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteFirewallPolicyRequest generates requests for DeleteFirewallPolicy
+func NewDeleteFirewallPolicyRequest(server string, site Site, policyId PolicyId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policyId", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall-policies/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateFirewallPolicyRequest calls the generic UpdateFirewallPolicy builder with application/json body
+func NewUpdateFirewallPolicyRequest(server string, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateFirewallPolicyRequestWithBody(server, site, policyId, "application/json", bodyReader)
+}
+
+// NewUpdateFirewallPolicyRequestWithBody generates requests for UpdateFirewallPolicy with any type of body
+func NewUpdateFirewallPolicyRequestWithBody(server string, site Site, policyId PolicyId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policyId", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall-policies/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListFirewallZonesRequest generates requests for ListFirewallZones
+func NewListFirewallZonesRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/firewall/zone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDNSRecordsRequest generates requests for ListDNSRecords
+func NewListDNSRecordsRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/static-dns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateDNSRecordRequest calls the generic CreateDNSRecord builder with application/json body
+func NewCreateDNSRecordRequest(server string, site Site, body CreateDNSRecordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDNSRecordRequestWithBody(server, site, "application/json", bodyReader)
+}
+
+// NewCreateDNSRecordRequestWithBody generates requests for CreateDNSRecord with any type of body
+func NewCreateDNSRecordRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/static-dns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDNSRecordRequest generates requests for DeleteDNSRecord
+func NewDeleteDNSRecordRequest(server string, site Site, recordId RecordId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "recordId", runtime.ParamLocationPath, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/static-dns/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDNSRecordRequest calls the generic UpdateDNSRecord builder with application/json body
+func NewUpdateDNSRecordRequest(server string, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDNSRecordRequestWithBody(server, site, recordId, "application/json", bodyReader)
+}
+
+// NewUpdateDNSRecordRequestWithBody generates requests for UpdateDNSRecord with any type of body
+func NewUpdateDNSRecordRequestWithBody(server string, site Site, recordId RecordId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "recordId", runtime.ParamLocationPath, recordId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/static-dns/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListAdminActivityLogRequest calls the generic ListAdminActivityLog builder with application/json body
+func NewListAdminActivityLogRequest(server string, site Site, body ListAdminActivityLogJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewListAdminActivityLogRequestWithBody(server, site, "application/json", bodyReader)
+}
+
+// NewListAdminActivityLogRequestWithBody generates requests for ListAdminActivityLog with any type of body
+func NewListAdminActivityLogRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/system-log/admin-activity", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTrafficRulesRequest generates requests for ListTrafficRules
+func NewListTrafficRulesRequest(server string, site Site) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/trafficrules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTrafficRuleRequest calls the generic CreateTrafficRule builder with application/json body
+func NewCreateTrafficRuleRequest(server string, site Site, body CreateTrafficRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTrafficRuleRequestWithBody(server, site, "application/json", bodyReader)
+}
+
+// NewCreateTrafficRuleRequestWithBody generates requests for CreateTrafficRule with any type of body
+func NewCreateTrafficRuleRequestWithBody(server string, site Site, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/trafficrules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTrafficRuleRequest generates requests for DeleteTrafficRule
+func NewDeleteTrafficRuleRequest(server string, site Site, ruleId RuleId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/trafficrules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTrafficRuleRequest calls the generic UpdateTrafficRule builder with application/json body
+func NewUpdateTrafficRuleRequest(server string, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTrafficRuleRequestWithBody(server, site, ruleId, "application/json", bodyReader)
+}
+
+// NewUpdateTrafficRuleRequestWithBody generates requests for UpdateTrafficRule with any type of body
+func NewUpdateTrafficRuleRequestWithBody(server string, site Site, ruleId RuleId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "site", runtime.ParamLocationPath, site)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ruleId", runtime.ParamLocationPath, ruleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/api/site/%s/trafficrules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ExecuteDeviceCommandWithBodyWithResponse request with any body
+	ExecuteDeviceCommandWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecuteDeviceCommandResponse, error)
+
+	ExecuteDeviceCommandWithResponse(ctx context.Context, site Site, body ExecuteDeviceCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecuteDeviceCommandResponse, error)
+
+	// ExecuteClientCommandWithBodyWithResponse request with any body
+	ExecuteClientCommandWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecuteClientCommandResponse, error)
+
+	ExecuteClientCommandWithResponse(ctx context.Context, site Site, body ExecuteClientCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecuteClientCommandResponse, error)
+
+	// ExecuteSystemCommandWithBodyWithResponse request with any body
+	ExecuteSystemCommandWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecuteSystemCommandResponse, error)
+
+	ExecuteSystemCommandWithResponse(ctx context.Context, site Site, body ExecuteSystemCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecuteSystemCommandResponse, error)
+
+	// GetNTPSettingsWithResponse request
+	GetNTPSettingsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*GetNTPSettingsResponse, error)
+
+	// UpdateLegacyDeviceWithBodyWithResponse request with any body
+	UpdateLegacyDeviceWithBodyWithResponse(ctx context.Context, site Site, legacyDeviceId LegacyDeviceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLegacyDeviceResponse, error)
+
+	UpdateLegacyDeviceWithResponse(ctx context.Context, site Site, legacyDeviceId LegacyDeviceId, body UpdateLegacyDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLegacyDeviceResponse, error)
+
+	// ListNetworksWithResponse request
+	ListNetworksWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListNetworksResponse, error)
+
+	// CreateSiteToSiteVPNWithBodyWithResponse request with any body
+	CreateSiteToSiteVPNWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSiteToSiteVPNResponse, error)
+
+	CreateSiteToSiteVPNWithResponse(ctx context.Context, site Site, body CreateSiteToSiteVPNJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSiteToSiteVPNResponse, error)
+
+	// DeleteSiteToSiteVPNWithResponse request
+	DeleteSiteToSiteVPNWithResponse(ctx context.Context, site Site, networkId NetworkId, reqEditors ...RequestEditorFn) (*DeleteSiteToSiteVPNResponse, error)
+
+	// UpdateSiteToSiteVPNWithBodyWithResponse request with any body
+	UpdateSiteToSiteVPNWithBodyWithResponse(ctx context.Context, site Site, networkId NetworkId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteToSiteVPNResponse, error)
+
+	UpdateSiteToSiteVPNWithResponse(ctx context.Context, site Site, networkId NetworkId, body UpdateSiteToSiteVPNJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteToSiteVPNResponse, error)
+
+	// ListKnownClientsWithResponse request
+	ListKnownClientsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListKnownClientsResponse, error)
+
+	// UpdateKnownClientWithBodyWithResponse request with any body
+	UpdateKnownClientWithBodyWithResponse(ctx context.Context, site Site, knownClientId KnownClientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateKnownClientResponse, error)
+
+	UpdateKnownClientWithResponse(ctx context.Context, site Site, knownClientId KnownClientId, body UpdateKnownClientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateKnownClientResponse, error)
+
+	// ListUserGroupsWithResponse request
+	ListUserGroupsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListUserGroupsResponse, error)
+
+	// CreateUserGroupWithBodyWithResponse request with any body
+	CreateUserGroupWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserGroupResponse, error)
+
+	CreateUserGroupWithResponse(ctx context.Context, site Site, body CreateUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserGroupResponse, error)
+
+	// DeleteUserGroupWithResponse request
+	DeleteUserGroupWithResponse(ctx context.Context, site Site, userGroupId UserGroupId, reqEditors ...RequestEditorFn) (*DeleteUserGroupResponse, error)
+
+	// UpdateUserGroupWithBodyWithResponse request with any body
+	UpdateUserGroupWithBodyWithResponse(ctx context.Context, site Site, userGroupId UserGroupId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserGroupResponse, error)
+
+	UpdateUserGroupWithResponse(ctx context.Context, site Site, userGroupId UserGroupId, body UpdateUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserGroupResponse, error)
+
+	// ListWLANsWithResponse request
+	ListWLANsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListWLANsResponse, error)
+
+	// CreateWLANWithBodyWithResponse request with any body
+	CreateWLANWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWLANResponse, error)
+
+	CreateWLANWithResponse(ctx context.Context, site Site, body CreateWLANJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWLANResponse, error)
+
+	// GetWLANWithResponse request
+	GetWLANWithResponse(ctx context.Context, site Site, wlanId WLANId, reqEditors ...RequestEditorFn) (*GetWLANResponse, error)
+
+	// UpdateWLANWithBodyWithResponse request with any body
+	UpdateWLANWithBodyWithResponse(ctx context.Context, site Site, wlanId WLANId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWLANResponse, error)
+
+	UpdateWLANWithResponse(ctx context.Context, site Site, wlanId WLANId, body UpdateWLANJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWLANResponse, error)
+
+	// ListRegulatoryChannelsWithResponse request
+	ListRegulatoryChannelsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListRegulatoryChannelsResponse, error)
+
+	// ListLegacyDevicesWithResponse request
+	ListLegacyDevicesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListLegacyDevicesResponse, error)
+
+	// GetLegacyDeviceWithResponse request
+	GetLegacyDeviceWithResponse(ctx context.Context, site Site, deviceMac DeviceMac, reqEditors ...RequestEditorFn) (*GetLegacyDeviceResponse, error)
+
+	// ListGuestAuthorizationsWithBodyWithResponse request with any body
+	ListGuestAuthorizationsWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListGuestAuthorizationsResponse, error)
+
+	ListGuestAuthorizationsWithResponse(ctx context.Context, site Site, body ListGuestAuthorizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListGuestAuthorizationsResponse, error)
+
+	// GetSiteHealthWithResponse request
+	GetSiteHealthWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*GetSiteHealthResponse, error)
+
+	// ListClientSessionsWithBodyWithResponse request with any body
+	ListClientSessionsWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListClientSessionsResponse, error)
+
+	ListClientSessionsWithResponse(ctx context.Context, site Site, body ListClientSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListClientSessionsResponse, error)
+
+	// GetSystemInfoWithResponse request
+	GetSystemInfoWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*GetSystemInfoResponse, error)
+
+	// GetKnownClientWithResponse request
+	GetKnownClientWithResponse(ctx context.Context, site Site, clientMac ClientMac, reqEditors ...RequestEditorFn) (*GetKnownClientResponse, error)
+
+	// ListSitesWithResponse request
+	ListSitesWithResponse(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*ListSitesResponse, error)
+
+	// ListSiteClientsWithResponse request
+	ListSiteClientsWithResponse(ctx context.Context, siteId SiteId, params *ListSiteClientsParams, reqEditors ...RequestEditorFn) (*ListSiteClientsResponse, error)
+
+	// GetClientByIdWithResponse request
+	GetClientByIdWithResponse(ctx context.Context, siteId SiteId, clientId ClientId, reqEditors ...RequestEditorFn) (*GetClientByIdResponse, error)
+
+	// ListSiteDevicesWithResponse request
+	ListSiteDevicesWithResponse(ctx context.Context, siteId SiteId, params *ListSiteDevicesParams, reqEditors ...RequestEditorFn) (*ListSiteDevicesResponse, error)
+
+	// GetDeviceByIdWithResponse request
+	GetDeviceByIdWithResponse(ctx context.Context, siteId SiteId, deviceId DeviceId, reqEditors ...RequestEditorFn) (*GetDeviceByIdResponse, error)
+
+	// ListHotspotVouchersWithResponse request
+	ListHotspotVouchersWithResponse(ctx context.Context, siteId SiteId, params *ListHotspotVouchersParams, reqEditors ...RequestEditorFn) (*ListHotspotVouchersResponse, error)
+
+	// CreateHotspotVouchersWithBodyWithResponse request with any body
+	CreateHotspotVouchersWithBodyWithResponse(ctx context.Context, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHotspotVouchersResponse, error)
+
+	CreateHotspotVouchersWithResponse(ctx context.Context, siteId SiteId, body CreateHotspotVouchersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHotspotVouchersResponse, error)
+
+	// DeleteHotspotVoucherWithResponse request
+	DeleteHotspotVoucherWithResponse(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteHotspotVoucherResponse, error)
+
+	// GetHotspotVoucherWithResponse request
+	GetHotspotVoucherWithResponse(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHotspotVoucherResponse, error)
+
+	// GetControllerStatusWithResponse request
+	GetControllerStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetControllerStatusResponse, error)
+
+	// GetControllerCertificateWithResponse request
+	GetControllerCertificateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetControllerCertificateResponse, error)
+
+	// UpdateControllerCertificateWithBodyWithResponse request with any body
+	UpdateControllerCertificateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateControllerCertificateResponse, error)
+
+	UpdateControllerCertificateWithResponse(ctx context.Context, body UpdateControllerCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateControllerCertificateResponse, error)
+
+	// GetDPIApplicationCatalogWithResponse request
+	GetDPIApplicationCatalogWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDPIApplicationCatalogResponse, error)
+
+	// GetAggregatedDashboardWithResponse request
+	GetAggregatedDashboardWithResponse(ctx context.Context, site Site, params *GetAggregatedDashboardParams, reqEditors ...RequestEditorFn) (*GetAggregatedDashboardResponse, error)
+
+	// ListAPGroupsWithResponse request
+	ListAPGroupsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListAPGroupsResponse, error)
+
+	// CreateAPGroupWithBodyWithResponse request with any body
+	CreateAPGroupWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPGroupResponse, error)
+
+	CreateAPGroupWithResponse(ctx context.Context, site Site, body CreateAPGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPGroupResponse, error)
+
+	// DeleteAPGroupWithResponse request
+	DeleteAPGroupWithResponse(ctx context.Context, site Site, apGroupId APGroupId, reqEditors ...RequestEditorFn) (*DeleteAPGroupResponse, error)
+
+	// UpdateAPGroupWithBodyWithResponse request with any body
+	UpdateAPGroupWithBodyWithResponse(ctx context.Context, site Site, apGroupId APGroupId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAPGroupResponse, error)
+
+	UpdateAPGroupWithResponse(ctx context.Context, site Site, apGroupId APGroupId, body UpdateAPGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAPGroupResponse, error)
+
+	// GetDynamicRoutingConfigWithResponse request
+	GetDynamicRoutingConfigWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*GetDynamicRoutingConfigResponse, error)
+
+	// UpdateDynamicRoutingConfigWithBodyWithResponse request with any body
+	UpdateDynamicRoutingConfigWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDynamicRoutingConfigResponse, error)
+
+	UpdateDynamicRoutingConfigWithResponse(ctx context.Context, site Site, body UpdateDynamicRoutingConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDynamicRoutingConfigResponse, error)
+
+	// ListFirewallPoliciesWithResponse request
+	ListFirewallPoliciesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListFirewallPoliciesResponse, error)
+
+	// CreateFirewallPolicyWithBodyWithResponse request with any body
+	CreateFirewallPolicyWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFirewallPolicyResponse, error)
+
+	CreateFirewallPolicyWithResponse(ctx context.Context, site Site, body CreateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFirewallPolicyResponse, error)
+
+	// DeleteFirewallPolicyWithResponse request
+	DeleteFirewallPolicyWithResponse(ctx context.Context, site Site, policyId PolicyId, reqEditors ...RequestEditorFn) (*DeleteFirewallPolicyResponse, error)
+
+	// UpdateFirewallPolicyWithBodyWithResponse request with any body
+	UpdateFirewallPolicyWithBodyWithResponse(ctx context.Context, site Site, policyId PolicyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFirewallPolicyResponse, error)
+
+	UpdateFirewallPolicyWithResponse(ctx context.Context, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFirewallPolicyResponse, error)
+
+	// ListFirewallZonesWithResponse request
+	ListFirewallZonesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListFirewallZonesResponse, error)
+
+	// ListDNSRecordsWithResponse request
+	ListDNSRecordsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListDNSRecordsResponse, error)
+
+	// CreateDNSRecordWithBodyWithResponse request with any body
+	CreateDNSRecordWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDNSRecordResponse, error)
+
+	CreateDNSRecordWithResponse(ctx context.Context, site Site, body CreateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDNSRecordResponse, error)
+
+	// DeleteDNSRecordWithResponse request
+	DeleteDNSRecordWithResponse(ctx context.Context, site Site, recordId RecordId, reqEditors ...RequestEditorFn) (*DeleteDNSRecordResponse, error)
+
+	// UpdateDNSRecordWithBodyWithResponse request with any body
+	UpdateDNSRecordWithBodyWithResponse(ctx context.Context, site Site, recordId RecordId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDNSRecordResponse, error)
+
+	UpdateDNSRecordWithResponse(ctx context.Context, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDNSRecordResponse, error)
+
+	// ListAdminActivityLogWithBodyWithResponse request with any body
+	ListAdminActivityLogWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListAdminActivityLogResponse, error)
+
+	ListAdminActivityLogWithResponse(ctx context.Context, site Site, body ListAdminActivityLogJSONRequestBody, reqEditors ...RequestEditorFn) (*ListAdminActivityLogResponse, error)
+
+	// ListTrafficRulesWithResponse request
+	ListTrafficRulesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListTrafficRulesResponse, error)
+
+	// CreateTrafficRuleWithBodyWithResponse request with any body
+	CreateTrafficRuleWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrafficRuleResponse, error)
+
+	CreateTrafficRuleWithResponse(ctx context.Context, site Site, body CreateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrafficRuleResponse, error)
+
+	// DeleteTrafficRuleWithResponse request
+	DeleteTrafficRuleWithResponse(ctx context.Context, site Site, ruleId RuleId, reqEditors ...RequestEditorFn) (*DeleteTrafficRuleResponse, error)
+
+	// UpdateTrafficRuleWithBodyWithResponse request with any body
+	UpdateTrafficRuleWithBodyWithResponse(ctx context.Context, site Site, ruleId RuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTrafficRuleResponse, error)
+
+	UpdateTrafficRuleWithResponse(ctx context.Context, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTrafficRuleResponse, error)
+}
+
+type ExecuteDeviceCommandResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ExecuteDeviceCommandResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExecuteDeviceCommandResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExecuteClientCommandResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ExecuteClientCommandResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExecuteClientCommandResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExecuteSystemCommandResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupportFileResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ExecuteSystemCommandResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExecuteSystemCommandResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNTPSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NTPSettingsResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNTPSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNTPSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateLegacyDeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LegacyDevicesResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateLegacyDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateLegacyDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNetworksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NetworkConfsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNetworksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNetworksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSiteToSiteVPNResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SiteToSiteVPNsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSiteToSiteVPNResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSiteToSiteVPNResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSiteToSiteVPNResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSiteToSiteVPNResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSiteToSiteVPNResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSiteToSiteVPNResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SiteToSiteVPNsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSiteToSiteVPNResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSiteToSiteVPNResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListKnownClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KnownClientsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListKnownClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListKnownClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateKnownClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KnownClientsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateKnownClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateKnownClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUserGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserGroupsResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUserGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUserGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserGroupsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserGroupsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWLANsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WLANsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWLANsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWLANsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWLANResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WLANsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWLANResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWLANResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWLANResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WLANsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWLANResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWLANResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWLANResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WLANsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWLANResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWLANResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRegulatoryChannelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RegulatoryChannelsResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRegulatoryChannelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRegulatoryChannelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLegacyDevicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LegacyDevicesResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLegacyDevicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLegacyDevicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLegacyDeviceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LegacyDevicesResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLegacyDeviceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLegacyDeviceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListGuestAuthorizationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GuestAuthorizationsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r ListGuestAuthorizationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListGuestAuthorizationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSiteHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SiteHealthResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSiteHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSiteHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClientSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientSessionsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClientSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClientSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSystemInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SystemInfoResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSystemInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSystemInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKnownClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KnownClientsResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKnownClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKnownClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SitesResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSiteClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientsResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSiteClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSiteClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NetworkClient
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSiteDevicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DevicesResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSiteDevicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSiteDevicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeviceByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Device
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeviceByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeviceByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHotspotVouchersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HotspotVouchersResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHotspotVouchersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHotspotVouchersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateHotspotVouchersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HotspotVouchersResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateHotspotVouchersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateHotspotVouchersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHotspotVoucherResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHotspotVoucherResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHotspotVoucherResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHotspotVoucherResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HotspotVoucher
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHotspotVoucherResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHotspotVoucherResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetControllerStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControllerStatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetControllerStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetControllerStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetControllerCertificateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControllerCertificate
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetControllerCertificateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetControllerCertificateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateControllerCertificateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControllerCertificate
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateControllerCertificateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateControllerCertificateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDPIApplicationCatalogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DPICatalog
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDPIApplicationCatalogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDPIApplicationCatalogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAggregatedDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AggregatedDashboard
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAggregatedDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAggregatedDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAPGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]APGroup
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAPGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAPGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAPGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APGroup
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAPGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAPGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAPGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APGroup
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAPGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAPGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDynamicRoutingConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DynamicRoutingSettings
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDynamicRoutingConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDynamicRoutingConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDynamicRoutingConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DynamicRoutingSettings
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDynamicRoutingConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDynamicRoutingConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFirewallPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FirewallPolicy
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFirewallPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFirewallPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateFirewallPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FirewallPolicy
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateFirewallPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateFirewallPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFirewallPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFirewallPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFirewallPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateFirewallPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FirewallPolicy
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateFirewallPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateFirewallPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFirewallZonesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FirewallZone
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFirewallZonesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFirewallZonesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDNSRecordsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DNSRecord
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDNSRecordsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDNSRecordsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDNSRecordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DNSRecord
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDNSRecordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDNSRecordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDNSRecordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDNSRecordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDNSRecordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDNSRecordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DNSRecord
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDNSRecordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDNSRecordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAdminActivityLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdminActivityLogResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAdminActivityLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAdminActivityLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTrafficRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TrafficRule
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTrafficRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTrafficRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTrafficRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrafficRule
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTrafficRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTrafficRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTrafficRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTrafficRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTrafficRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTrafficRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrafficRule
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTrafficRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTrafficRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ExecuteDeviceCommandWithBodyWithResponse request with arbitrary body returning *ExecuteDeviceCommandResponse
+func (c *ClientWithResponses) ExecuteDeviceCommandWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecuteDeviceCommandResponse, error) {
+	rsp, err := c.ExecuteDeviceCommandWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteDeviceCommandResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExecuteDeviceCommandWithResponse(ctx context.Context, site Site, body ExecuteDeviceCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecuteDeviceCommandResponse, error) {
+	rsp, err := c.ExecuteDeviceCommand(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteDeviceCommandResponse(rsp)
+}
+
+// ExecuteClientCommandWithBodyWithResponse request with arbitrary body returning *ExecuteClientCommandResponse
+func (c *ClientWithResponses) ExecuteClientCommandWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecuteClientCommandResponse, error) {
+	rsp, err := c.ExecuteClientCommandWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteClientCommandResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExecuteClientCommandWithResponse(ctx context.Context, site Site, body ExecuteClientCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecuteClientCommandResponse, error) {
+	rsp, err := c.ExecuteClientCommand(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteClientCommandResponse(rsp)
+}
+
+// ExecuteSystemCommandWithBodyWithResponse request with arbitrary body returning *ExecuteSystemCommandResponse
+func (c *ClientWithResponses) ExecuteSystemCommandWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecuteSystemCommandResponse, error) {
+	rsp, err := c.ExecuteSystemCommandWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteSystemCommandResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExecuteSystemCommandWithResponse(ctx context.Context, site Site, body ExecuteSystemCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecuteSystemCommandResponse, error) {
+	rsp, err := c.ExecuteSystemCommand(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteSystemCommandResponse(rsp)
+}
+
+// GetNTPSettingsWithResponse request returning *GetNTPSettingsResponse
+func (c *ClientWithResponses) GetNTPSettingsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*GetNTPSettingsResponse, error) {
+	rsp, err := c.GetNTPSettings(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNTPSettingsResponse(rsp)
+}
+
+// UpdateLegacyDeviceWithBodyWithResponse request with arbitrary body returning *UpdateLegacyDeviceResponse
+func (c *ClientWithResponses) UpdateLegacyDeviceWithBodyWithResponse(ctx context.Context, site Site, legacyDeviceId LegacyDeviceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLegacyDeviceResponse, error) {
+	rsp, err := c.UpdateLegacyDeviceWithBody(ctx, site, legacyDeviceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLegacyDeviceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateLegacyDeviceWithResponse(ctx context.Context, site Site, legacyDeviceId LegacyDeviceId, body UpdateLegacyDeviceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLegacyDeviceResponse, error) {
+	rsp, err := c.UpdateLegacyDevice(ctx, site, legacyDeviceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLegacyDeviceResponse(rsp)
+}
+
+// ListNetworksWithResponse request returning *ListNetworksResponse
+func (c *ClientWithResponses) ListNetworksWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListNetworksResponse, error) {
+	rsp, err := c.ListNetworks(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNetworksResponse(rsp)
+}
+
+// CreateSiteToSiteVPNWithBodyWithResponse request with arbitrary body returning *CreateSiteToSiteVPNResponse
+func (c *ClientWithResponses) CreateSiteToSiteVPNWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSiteToSiteVPNResponse, error) {
+	rsp, err := c.CreateSiteToSiteVPNWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSiteToSiteVPNResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSiteToSiteVPNWithResponse(ctx context.Context, site Site, body CreateSiteToSiteVPNJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSiteToSiteVPNResponse, error) {
+	rsp, err := c.CreateSiteToSiteVPN(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSiteToSiteVPNResponse(rsp)
+}
+
+// DeleteSiteToSiteVPNWithResponse request returning *DeleteSiteToSiteVPNResponse
+func (c *ClientWithResponses) DeleteSiteToSiteVPNWithResponse(ctx context.Context, site Site, networkId NetworkId, reqEditors ...RequestEditorFn) (*DeleteSiteToSiteVPNResponse, error) {
+	rsp, err := c.DeleteSiteToSiteVPN(ctx, site, networkId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSiteToSiteVPNResponse(rsp)
+}
+
+// UpdateSiteToSiteVPNWithBodyWithResponse request with arbitrary body returning *UpdateSiteToSiteVPNResponse
+func (c *ClientWithResponses) UpdateSiteToSiteVPNWithBodyWithResponse(ctx context.Context, site Site, networkId NetworkId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSiteToSiteVPNResponse, error) {
+	rsp, err := c.UpdateSiteToSiteVPNWithBody(ctx, site, networkId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSiteToSiteVPNResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSiteToSiteVPNWithResponse(ctx context.Context, site Site, networkId NetworkId, body UpdateSiteToSiteVPNJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSiteToSiteVPNResponse, error) {
+	rsp, err := c.UpdateSiteToSiteVPN(ctx, site, networkId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSiteToSiteVPNResponse(rsp)
+}
+
+// ListKnownClientsWithResponse request returning *ListKnownClientsResponse
+func (c *ClientWithResponses) ListKnownClientsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListKnownClientsResponse, error) {
+	rsp, err := c.ListKnownClients(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListKnownClientsResponse(rsp)
+}
+
+// UpdateKnownClientWithBodyWithResponse request with arbitrary body returning *UpdateKnownClientResponse
+func (c *ClientWithResponses) UpdateKnownClientWithBodyWithResponse(ctx context.Context, site Site, knownClientId KnownClientId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateKnownClientResponse, error) {
+	rsp, err := c.UpdateKnownClientWithBody(ctx, site, knownClientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateKnownClientResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateKnownClientWithResponse(ctx context.Context, site Site, knownClientId KnownClientId, body UpdateKnownClientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateKnownClientResponse, error) {
+	rsp, err := c.UpdateKnownClient(ctx, site, knownClientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateKnownClientResponse(rsp)
+}
+
+// ListUserGroupsWithResponse request returning *ListUserGroupsResponse
+func (c *ClientWithResponses) ListUserGroupsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListUserGroupsResponse, error) {
+	rsp, err := c.ListUserGroups(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUserGroupsResponse(rsp)
+}
+
+// CreateUserGroupWithBodyWithResponse request with arbitrary body returning *CreateUserGroupResponse
+func (c *ClientWithResponses) CreateUserGroupWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserGroupResponse, error) {
+	rsp, err := c.CreateUserGroupWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserGroupWithResponse(ctx context.Context, site Site, body CreateUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserGroupResponse, error) {
+	rsp, err := c.CreateUserGroup(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserGroupResponse(rsp)
+}
+
+// DeleteUserGroupWithResponse request returning *DeleteUserGroupResponse
+func (c *ClientWithResponses) DeleteUserGroupWithResponse(ctx context.Context, site Site, userGroupId UserGroupId, reqEditors ...RequestEditorFn) (*DeleteUserGroupResponse, error) {
+	rsp, err := c.DeleteUserGroup(ctx, site, userGroupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserGroupResponse(rsp)
+}
+
+// UpdateUserGroupWithBodyWithResponse request with arbitrary body returning *UpdateUserGroupResponse
+func (c *ClientWithResponses) UpdateUserGroupWithBodyWithResponse(ctx context.Context, site Site, userGroupId UserGroupId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserGroupResponse, error) {
+	rsp, err := c.UpdateUserGroupWithBody(ctx, site, userGroupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserGroupWithResponse(ctx context.Context, site Site, userGroupId UserGroupId, body UpdateUserGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserGroupResponse, error) {
+	rsp, err := c.UpdateUserGroup(ctx, site, userGroupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserGroupResponse(rsp)
+}
+
+// ListWLANsWithResponse request returning *ListWLANsResponse
+func (c *ClientWithResponses) ListWLANsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListWLANsResponse, error) {
+	rsp, err := c.ListWLANs(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWLANsResponse(rsp)
+}
+
+// CreateWLANWithBodyWithResponse request with arbitrary body returning *CreateWLANResponse
+func (c *ClientWithResponses) CreateWLANWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWLANResponse, error) {
+	rsp, err := c.CreateWLANWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWLANResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWLANWithResponse(ctx context.Context, site Site, body CreateWLANJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWLANResponse, error) {
+	rsp, err := c.CreateWLAN(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWLANResponse(rsp)
+}
+
+// GetWLANWithResponse request returning *GetWLANResponse
+func (c *ClientWithResponses) GetWLANWithResponse(ctx context.Context, site Site, wlanId WLANId, reqEditors ...RequestEditorFn) (*GetWLANResponse, error) {
+	rsp, err := c.GetWLAN(ctx, site, wlanId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWLANResponse(rsp)
+}
+
+// UpdateWLANWithBodyWithResponse request with arbitrary body returning *UpdateWLANResponse
+func (c *ClientWithResponses) UpdateWLANWithBodyWithResponse(ctx context.Context, site Site, wlanId WLANId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWLANResponse, error) {
+	rsp, err := c.UpdateWLANWithBody(ctx, site, wlanId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWLANResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWLANWithResponse(ctx context.Context, site Site, wlanId WLANId, body UpdateWLANJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWLANResponse, error) {
+	rsp, err := c.UpdateWLAN(ctx, site, wlanId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWLANResponse(rsp)
+}
+
+// ListRegulatoryChannelsWithResponse request returning *ListRegulatoryChannelsResponse
+func (c *ClientWithResponses) ListRegulatoryChannelsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListRegulatoryChannelsResponse, error) {
+	rsp, err := c.ListRegulatoryChannels(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRegulatoryChannelsResponse(rsp)
+}
+
+// ListLegacyDevicesWithResponse request returning *ListLegacyDevicesResponse
+func (c *ClientWithResponses) ListLegacyDevicesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListLegacyDevicesResponse, error) {
+	rsp, err := c.ListLegacyDevices(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLegacyDevicesResponse(rsp)
+}
+
+// GetLegacyDeviceWithResponse request returning *GetLegacyDeviceResponse
+func (c *ClientWithResponses) GetLegacyDeviceWithResponse(ctx context.Context, site Site, deviceMac DeviceMac, reqEditors ...RequestEditorFn) (*GetLegacyDeviceResponse, error) {
+	rsp, err := c.GetLegacyDevice(ctx, site, deviceMac, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLegacyDeviceResponse(rsp)
+}
+
+// ListGuestAuthorizationsWithBodyWithResponse request with arbitrary body returning *ListGuestAuthorizationsResponse
+func (c *ClientWithResponses) ListGuestAuthorizationsWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListGuestAuthorizationsResponse, error) {
+	rsp, err := c.ListGuestAuthorizationsWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListGuestAuthorizationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ListGuestAuthorizationsWithResponse(ctx context.Context, site Site, body ListGuestAuthorizationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListGuestAuthorizationsResponse, error) {
+	rsp, err := c.ListGuestAuthorizations(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListGuestAuthorizationsResponse(rsp)
+}
+
+// GetSiteHealthWithResponse request returning *GetSiteHealthResponse
+func (c *ClientWithResponses) GetSiteHealthWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*GetSiteHealthResponse, error) {
+	rsp, err := c.GetSiteHealth(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSiteHealthResponse(rsp)
+}
+
+// ListClientSessionsWithBodyWithResponse request with arbitrary body returning *ListClientSessionsResponse
+func (c *ClientWithResponses) ListClientSessionsWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListClientSessionsResponse, error) {
+	rsp, err := c.ListClientSessionsWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClientSessionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ListClientSessionsWithResponse(ctx context.Context, site Site, body ListClientSessionsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListClientSessionsResponse, error) {
+	rsp, err := c.ListClientSessions(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClientSessionsResponse(rsp)
+}
+
+// GetSystemInfoWithResponse request returning *GetSystemInfoResponse
+func (c *ClientWithResponses) GetSystemInfoWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*GetSystemInfoResponse, error) {
+	rsp, err := c.GetSystemInfo(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSystemInfoResponse(rsp)
+}
+
+// GetKnownClientWithResponse request returning *GetKnownClientResponse
+func (c *ClientWithResponses) GetKnownClientWithResponse(ctx context.Context, site Site, clientMac ClientMac, reqEditors ...RequestEditorFn) (*GetKnownClientResponse, error) {
+	rsp, err := c.GetKnownClient(ctx, site, clientMac, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKnownClientResponse(rsp)
+}
+
+// ListSitesWithResponse request returning *ListSitesResponse
+func (c *ClientWithResponses) ListSitesWithResponse(ctx context.Context, params *ListSitesParams, reqEditors ...RequestEditorFn) (*ListSitesResponse, error) {
+	rsp, err := c.ListSites(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSitesResponse(rsp)
+}
+
+// ListSiteClientsWithResponse request returning *ListSiteClientsResponse
+func (c *ClientWithResponses) ListSiteClientsWithResponse(ctx context.Context, siteId SiteId, params *ListSiteClientsParams, reqEditors ...RequestEditorFn) (*ListSiteClientsResponse, error) {
+	rsp, err := c.ListSiteClients(ctx, siteId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSiteClientsResponse(rsp)
+}
+
+// GetClientByIdWithResponse request returning *GetClientByIdResponse
+func (c *ClientWithResponses) GetClientByIdWithResponse(ctx context.Context, siteId SiteId, clientId ClientId, reqEditors ...RequestEditorFn) (*GetClientByIdResponse, error) {
+	rsp, err := c.GetClientById(ctx, siteId, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientByIdResponse(rsp)
+}
+
+// ListSiteDevicesWithResponse request returning *ListSiteDevicesResponse
+func (c *ClientWithResponses) ListSiteDevicesWithResponse(ctx context.Context, siteId SiteId, params *ListSiteDevicesParams, reqEditors ...RequestEditorFn) (*ListSiteDevicesResponse, error) {
+	rsp, err := c.ListSiteDevices(ctx, siteId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSiteDevicesResponse(rsp)
+}
+
+// GetDeviceByIdWithResponse request returning *GetDeviceByIdResponse
+func (c *ClientWithResponses) GetDeviceByIdWithResponse(ctx context.Context, siteId SiteId, deviceId DeviceId, reqEditors ...RequestEditorFn) (*GetDeviceByIdResponse, error) {
+	rsp, err := c.GetDeviceById(ctx, siteId, deviceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceByIdResponse(rsp)
+}
+
+// ListHotspotVouchersWithResponse request returning *ListHotspotVouchersResponse
+func (c *ClientWithResponses) ListHotspotVouchersWithResponse(ctx context.Context, siteId SiteId, params *ListHotspotVouchersParams, reqEditors ...RequestEditorFn) (*ListHotspotVouchersResponse, error) {
+	rsp, err := c.ListHotspotVouchers(ctx, siteId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHotspotVouchersResponse(rsp)
+}
+
+// CreateHotspotVouchersWithBodyWithResponse request with arbitrary body returning *CreateHotspotVouchersResponse
+func (c *ClientWithResponses) CreateHotspotVouchersWithBodyWithResponse(ctx context.Context, siteId SiteId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateHotspotVouchersResponse, error) {
+	rsp, err := c.CreateHotspotVouchersWithBody(ctx, siteId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHotspotVouchersResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateHotspotVouchersWithResponse(ctx context.Context, siteId SiteId, body CreateHotspotVouchersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateHotspotVouchersResponse, error) {
+	rsp, err := c.CreateHotspotVouchers(ctx, siteId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateHotspotVouchersResponse(rsp)
+}
+
+// DeleteHotspotVoucherWithResponse request returning *DeleteHotspotVoucherResponse
+func (c *ClientWithResponses) DeleteHotspotVoucherWithResponse(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteHotspotVoucherResponse, error) {
+	rsp, err := c.DeleteHotspotVoucher(ctx, siteId, voucherId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteHotspotVoucherResponse(rsp)
+}
+
+// GetHotspotVoucherWithResponse request returning *GetHotspotVoucherResponse
+func (c *ClientWithResponses) GetHotspotVoucherWithResponse(ctx context.Context, siteId SiteId, voucherId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHotspotVoucherResponse, error) {
+	rsp, err := c.GetHotspotVoucher(ctx, siteId, voucherId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHotspotVoucherResponse(rsp)
+}
+
+// GetControllerStatusWithResponse request returning *GetControllerStatusResponse
+func (c *ClientWithResponses) GetControllerStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetControllerStatusResponse, error) {
+	rsp, err := c.GetControllerStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetControllerStatusResponse(rsp)
+}
+
+// GetControllerCertificateWithResponse request returning *GetControllerCertificateResponse
+func (c *ClientWithResponses) GetControllerCertificateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetControllerCertificateResponse, error) {
+	rsp, err := c.GetControllerCertificate(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetControllerCertificateResponse(rsp)
+}
+
+// UpdateControllerCertificateWithBodyWithResponse request with arbitrary body returning *UpdateControllerCertificateResponse
+func (c *ClientWithResponses) UpdateControllerCertificateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateControllerCertificateResponse, error) {
+	rsp, err := c.UpdateControllerCertificateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateControllerCertificateResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateControllerCertificateWithResponse(ctx context.Context, body UpdateControllerCertificateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateControllerCertificateResponse, error) {
+	rsp, err := c.UpdateControllerCertificate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateControllerCertificateResponse(rsp)
+}
+
+// GetDPIApplicationCatalogWithResponse request returning *GetDPIApplicationCatalogResponse
+func (c *ClientWithResponses) GetDPIApplicationCatalogWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDPIApplicationCatalogResponse, error) {
+	rsp, err := c.GetDPIApplicationCatalog(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDPIApplicationCatalogResponse(rsp)
+}
+
+// GetAggregatedDashboardWithResponse request returning *GetAggregatedDashboardResponse
+func (c *ClientWithResponses) GetAggregatedDashboardWithResponse(ctx context.Context, site Site, params *GetAggregatedDashboardParams, reqEditors ...RequestEditorFn) (*GetAggregatedDashboardResponse, error) {
+	rsp, err := c.GetAggregatedDashboard(ctx, site, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAggregatedDashboardResponse(rsp)
+}
+
+// ListAPGroupsWithResponse request returning *ListAPGroupsResponse
+func (c *ClientWithResponses) ListAPGroupsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListAPGroupsResponse, error) {
+	rsp, err := c.ListAPGroups(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAPGroupsResponse(rsp)
+}
+
+// CreateAPGroupWithBodyWithResponse request with arbitrary body returning *CreateAPGroupResponse
+func (c *ClientWithResponses) CreateAPGroupWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAPGroupResponse, error) {
+	rsp, err := c.CreateAPGroupWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAPGroupWithResponse(ctx context.Context, site Site, body CreateAPGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAPGroupResponse, error) {
+	rsp, err := c.CreateAPGroup(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAPGroupResponse(rsp)
+}
+
+// DeleteAPGroupWithResponse request returning *DeleteAPGroupResponse
+func (c *ClientWithResponses) DeleteAPGroupWithResponse(ctx context.Context, site Site, apGroupId APGroupId, reqEditors ...RequestEditorFn) (*DeleteAPGroupResponse, error) {
+	rsp, err := c.DeleteAPGroup(ctx, site, apGroupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPGroupResponse(rsp)
+}
+
+// UpdateAPGroupWithBodyWithResponse request with arbitrary body returning *UpdateAPGroupResponse
+func (c *ClientWithResponses) UpdateAPGroupWithBodyWithResponse(ctx context.Context, site Site, apGroupId APGroupId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAPGroupResponse, error) {
+	rsp, err := c.UpdateAPGroupWithBody(ctx, site, apGroupId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAPGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAPGroupWithResponse(ctx context.Context, site Site, apGroupId APGroupId, body UpdateAPGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAPGroupResponse, error) {
+	rsp, err := c.UpdateAPGroup(ctx, site, apGroupId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAPGroupResponse(rsp)
+}
+
+// GetDynamicRoutingConfigWithResponse request returning *GetDynamicRoutingConfigResponse
+func (c *ClientWithResponses) GetDynamicRoutingConfigWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*GetDynamicRoutingConfigResponse, error) {
+	rsp, err := c.GetDynamicRoutingConfig(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDynamicRoutingConfigResponse(rsp)
+}
+
+// UpdateDynamicRoutingConfigWithBodyWithResponse request with arbitrary body returning *UpdateDynamicRoutingConfigResponse
+func (c *ClientWithResponses) UpdateDynamicRoutingConfigWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDynamicRoutingConfigResponse, error) {
+	rsp, err := c.UpdateDynamicRoutingConfigWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDynamicRoutingConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDynamicRoutingConfigWithResponse(ctx context.Context, site Site, body UpdateDynamicRoutingConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDynamicRoutingConfigResponse, error) {
+	rsp, err := c.UpdateDynamicRoutingConfig(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDynamicRoutingConfigResponse(rsp)
+}
+
+// ListFirewallPoliciesWithResponse request returning *ListFirewallPoliciesResponse
+func (c *ClientWithResponses) ListFirewallPoliciesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListFirewallPoliciesResponse, error) {
+	rsp, err := c.ListFirewallPolicies(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFirewallPoliciesResponse(rsp)
+}
+
+// CreateFirewallPolicyWithBodyWithResponse request with arbitrary body returning *CreateFirewallPolicyResponse
+func (c *ClientWithResponses) CreateFirewallPolicyWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateFirewallPolicyResponse, error) {
+	rsp, err := c.CreateFirewallPolicyWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFirewallPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateFirewallPolicyWithResponse(ctx context.Context, site Site, body CreateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateFirewallPolicyResponse, error) {
+	rsp, err := c.CreateFirewallPolicy(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateFirewallPolicyResponse(rsp)
+}
+
+// DeleteFirewallPolicyWithResponse request returning *DeleteFirewallPolicyResponse
+func (c *ClientWithResponses) DeleteFirewallPolicyWithResponse(ctx context.Context, site Site, policyId PolicyId, reqEditors ...RequestEditorFn) (*DeleteFirewallPolicyResponse, error) {
+	rsp, err := c.DeleteFirewallPolicy(ctx, site, policyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFirewallPolicyResponse(rsp)
+}
+
+// UpdateFirewallPolicyWithBodyWithResponse request with arbitrary body returning *UpdateFirewallPolicyResponse
+func (c *ClientWithResponses) UpdateFirewallPolicyWithBodyWithResponse(ctx context.Context, site Site, policyId PolicyId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateFirewallPolicyResponse, error) {
+	rsp, err := c.UpdateFirewallPolicyWithBody(ctx, site, policyId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFirewallPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateFirewallPolicyWithResponse(ctx context.Context, site Site, policyId PolicyId, body UpdateFirewallPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateFirewallPolicyResponse, error) {
+	rsp, err := c.UpdateFirewallPolicy(ctx, site, policyId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateFirewallPolicyResponse(rsp)
+}
+
+// ListFirewallZonesWithResponse request returning *ListFirewallZonesResponse
+func (c *ClientWithResponses) ListFirewallZonesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListFirewallZonesResponse, error) {
+	rsp, err := c.ListFirewallZones(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFirewallZonesResponse(rsp)
+}
+
+// ListDNSRecordsWithResponse request returning *ListDNSRecordsResponse
+func (c *ClientWithResponses) ListDNSRecordsWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListDNSRecordsResponse, error) {
+	rsp, err := c.ListDNSRecords(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDNSRecordsResponse(rsp)
+}
+
+// CreateDNSRecordWithBodyWithResponse request with arbitrary body returning *CreateDNSRecordResponse
+func (c *ClientWithResponses) CreateDNSRecordWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDNSRecordResponse, error) {
+	rsp, err := c.CreateDNSRecordWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDNSRecordResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDNSRecordWithResponse(ctx context.Context, site Site, body CreateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDNSRecordResponse, error) {
+	rsp, err := c.CreateDNSRecord(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDNSRecordResponse(rsp)
+}
+
+// DeleteDNSRecordWithResponse request returning *DeleteDNSRecordResponse
+func (c *ClientWithResponses) DeleteDNSRecordWithResponse(ctx context.Context, site Site, recordId RecordId, reqEditors ...RequestEditorFn) (*DeleteDNSRecordResponse, error) {
+	rsp, err := c.DeleteDNSRecord(ctx, site, recordId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDNSRecordResponse(rsp)
+}
+
+// UpdateDNSRecordWithBodyWithResponse request with arbitrary body returning *UpdateDNSRecordResponse
+func (c *ClientWithResponses) UpdateDNSRecordWithBodyWithResponse(ctx context.Context, site Site, recordId RecordId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDNSRecordResponse, error) {
+	rsp, err := c.UpdateDNSRecordWithBody(ctx, site, recordId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDNSRecordResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDNSRecordWithResponse(ctx context.Context, site Site, recordId RecordId, body UpdateDNSRecordJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDNSRecordResponse, error) {
+	rsp, err := c.UpdateDNSRecord(ctx, site, recordId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDNSRecordResponse(rsp)
+}
+
+// ListAdminActivityLogWithBodyWithResponse request with arbitrary body returning *ListAdminActivityLogResponse
+func (c *ClientWithResponses) ListAdminActivityLogWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListAdminActivityLogResponse, error) {
+	rsp, err := c.ListAdminActivityLogWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAdminActivityLogResponse(rsp)
+}
+
+func (c *ClientWithResponses) ListAdminActivityLogWithResponse(ctx context.Context, site Site, body ListAdminActivityLogJSONRequestBody, reqEditors ...RequestEditorFn) (*ListAdminActivityLogResponse, error) {
+	rsp, err := c.ListAdminActivityLog(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAdminActivityLogResponse(rsp)
+}
+
+// ListTrafficRulesWithResponse request returning *ListTrafficRulesResponse
+func (c *ClientWithResponses) ListTrafficRulesWithResponse(ctx context.Context, site Site, reqEditors ...RequestEditorFn) (*ListTrafficRulesResponse, error) {
+	rsp, err := c.ListTrafficRules(ctx, site, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTrafficRulesResponse(rsp)
+}
+
+// CreateTrafficRuleWithBodyWithResponse request with arbitrary body returning *CreateTrafficRuleResponse
+func (c *ClientWithResponses) CreateTrafficRuleWithBodyWithResponse(ctx context.Context, site Site, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrafficRuleResponse, error) {
+	rsp, err := c.CreateTrafficRuleWithBody(ctx, site, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTrafficRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTrafficRuleWithResponse(ctx context.Context, site Site, body CreateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrafficRuleResponse, error) {
+	rsp, err := c.CreateTrafficRule(ctx, site, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTrafficRuleResponse(rsp)
+}
+
+// DeleteTrafficRuleWithResponse request returning *DeleteTrafficRuleResponse
+func (c *ClientWithResponses) DeleteTrafficRuleWithResponse(ctx context.Context, site Site, ruleId RuleId, reqEditors ...RequestEditorFn) (*DeleteTrafficRuleResponse, error) {
+	rsp, err := c.DeleteTrafficRule(ctx, site, ruleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTrafficRuleResponse(rsp)
+}
+
+// UpdateTrafficRuleWithBodyWithResponse request with arbitrary body returning *UpdateTrafficRuleResponse
+func (c *ClientWithResponses) UpdateTrafficRuleWithBodyWithResponse(ctx context.Context, site Site, ruleId RuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTrafficRuleResponse, error) {
+	rsp, err := c.UpdateTrafficRuleWithBody(ctx, site, ruleId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTrafficRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTrafficRuleWithResponse(ctx context.Context, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTrafficRuleResponse, error) {
+	rsp, err := c.UpdateTrafficRule(ctx, site, ruleId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTrafficRuleResponse(rsp)
+}
+
+// ParseExecuteDeviceCommandResponse parses an HTTP response from a ExecuteDeviceCommandWithResponse call
+func ParseExecuteDeviceCommandResponse(rsp *http.Response) (*ExecuteDeviceCommandResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExecuteDeviceCommandResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExecuteClientCommandResponse parses an HTTP response from a ExecuteClientCommandWithResponse call
+func ParseExecuteClientCommandResponse(rsp *http.Response) (*ExecuteClientCommandResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExecuteClientCommandResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExecuteSystemCommandResponse parses an HTTP response from a ExecuteSystemCommandWithResponse call
+func ParseExecuteSystemCommandResponse(rsp *http.Response) (*ExecuteSystemCommandResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExecuteSystemCommandResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupportFileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNTPSettingsResponse parses an HTTP response from a GetNTPSettingsWithResponse call
+func ParseGetNTPSettingsResponse(rsp *http.Response) (*GetNTPSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNTPSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NTPSettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateLegacyDeviceResponse parses an HTTP response from a UpdateLegacyDeviceWithResponse call
+func ParseUpdateLegacyDeviceResponse(rsp *http.Response) (*UpdateLegacyDeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateLegacyDeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LegacyDevicesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNetworksResponse parses an HTTP response from a ListNetworksWithResponse call
+func ParseListNetworksResponse(rsp *http.Response) (*ListNetworksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNetworksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NetworkConfsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSiteToSiteVPNResponse parses an HTTP response from a CreateSiteToSiteVPNWithResponse call
+func ParseCreateSiteToSiteVPNResponse(rsp *http.Response) (*CreateSiteToSiteVPNResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSiteToSiteVPNResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SiteToSiteVPNsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSiteToSiteVPNResponse parses an HTTP response from a DeleteSiteToSiteVPNWithResponse call
+func ParseDeleteSiteToSiteVPNResponse(rsp *http.Response) (*DeleteSiteToSiteVPNResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSiteToSiteVPNResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSiteToSiteVPNResponse parses an HTTP response from a UpdateSiteToSiteVPNWithResponse call
+func ParseUpdateSiteToSiteVPNResponse(rsp *http.Response) (*UpdateSiteToSiteVPNResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSiteToSiteVPNResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SiteToSiteVPNsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListKnownClientsResponse parses an HTTP response from a ListKnownClientsWithResponse call
+func ParseListKnownClientsResponse(rsp *http.Response) (*ListKnownClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListKnownClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KnownClientsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateKnownClientResponse parses an HTTP response from a UpdateKnownClientWithResponse call
+func ParseUpdateKnownClientResponse(rsp *http.Response) (*UpdateKnownClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateKnownClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KnownClientsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUserGroupsResponse parses an HTTP response from a ListUserGroupsWithResponse call
+func ParseListUserGroupsResponse(rsp *http.Response) (*ListUserGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUserGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserGroupsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserGroupResponse parses an HTTP response from a CreateUserGroupWithResponse call
+func ParseCreateUserGroupResponse(rsp *http.Response) (*CreateUserGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserGroupsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserGroupResponse parses an HTTP response from a DeleteUserGroupWithResponse call
+func ParseDeleteUserGroupResponse(rsp *http.Response) (*DeleteUserGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUserGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateUserGroupResponse parses an HTTP response from a UpdateUserGroupWithResponse call
+func ParseUpdateUserGroupResponse(rsp *http.Response) (*UpdateUserGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateUserGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserGroupsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWLANsResponse parses an HTTP response from a ListWLANsWithResponse call
+func ParseListWLANsResponse(rsp *http.Response) (*ListWLANsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWLANsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WLANsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWLANResponse parses an HTTP response from a CreateWLANWithResponse call
+func ParseCreateWLANResponse(rsp *http.Response) (*CreateWLANResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWLANResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WLANsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWLANResponse parses an HTTP response from a GetWLANWithResponse call
+func ParseGetWLANResponse(rsp *http.Response) (*GetWLANResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWLANResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WLANsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWLANResponse parses an HTTP response from a UpdateWLANWithResponse call
+func ParseUpdateWLANResponse(rsp *http.Response) (*UpdateWLANResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWLANResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WLANsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRegulatoryChannelsResponse parses an HTTP response from a ListRegulatoryChannelsWithResponse call
+func ParseListRegulatoryChannelsResponse(rsp *http.Response) (*ListRegulatoryChannelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRegulatoryChannelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RegulatoryChannelsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLegacyDevicesResponse parses an HTTP response from a ListLegacyDevicesWithResponse call
+func ParseListLegacyDevicesResponse(rsp *http.Response) (*ListLegacyDevicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLegacyDevicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LegacyDevicesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLegacyDeviceResponse parses an HTTP response from a GetLegacyDeviceWithResponse call
+func ParseGetLegacyDeviceResponse(rsp *http.Response) (*GetLegacyDeviceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLegacyDeviceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LegacyDevicesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListGuestAuthorizationsResponse parses an HTTP response from a ListGuestAuthorizationsWithResponse call
+func ParseListGuestAuthorizationsResponse(rsp *http.Response) (*ListGuestAuthorizationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListGuestAuthorizationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GuestAuthorizationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSiteHealthResponse parses an HTTP response from a GetSiteHealthWithResponse call
+func ParseGetSiteHealthResponse(rsp *http.Response) (*GetSiteHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSiteHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SiteHealthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClientSessionsResponse parses an HTTP response from a ListClientSessionsWithResponse call
+func ParseListClientSessionsResponse(rsp *http.Response) (*ListClientSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClientSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSystemInfoResponse parses an HTTP response from a GetSystemInfoWithResponse call
+func ParseGetSystemInfoResponse(rsp *http.Response) (*GetSystemInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSystemInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SystemInfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetKnownClientResponse parses an HTTP response from a GetKnownClientWithResponse call
+func ParseGetKnownClientResponse(rsp *http.Response) (*GetKnownClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetKnownClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KnownClientsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSitesResponse parses an HTTP response from a ListSitesWithResponse call
+func ParseListSitesResponse(rsp *http.Response) (*ListSitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SitesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSiteClientsResponse parses an HTTP response from a ListSiteClientsWithResponse call
+func ParseListSiteClientsResponse(rsp *http.Response) (*ListSiteClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSiteClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientByIdResponse parses an HTTP response from a GetClientByIdWithResponse call
+func ParseGetClientByIdResponse(rsp *http.Response) (*GetClientByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NetworkClient
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSiteDevicesResponse parses an HTTP response from a ListSiteDevicesWithResponse call
+func ParseListSiteDevicesResponse(rsp *http.Response) (*ListSiteDevicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSiteDevicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DevicesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeviceByIdResponse parses an HTTP response from a GetDeviceByIdWithResponse call
+func ParseGetDeviceByIdResponse(rsp *http.Response) (*GetDeviceByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeviceByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Device
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHotspotVouchersResponse parses an HTTP response from a ListHotspotVouchersWithResponse call
+func ParseListHotspotVouchersResponse(rsp *http.Response) (*ListHotspotVouchersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHotspotVouchersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HotspotVouchersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateHotspotVouchersResponse parses an HTTP response from a CreateHotspotVouchersWithResponse call
+func ParseCreateHotspotVouchersResponse(rsp *http.Response) (*CreateHotspotVouchersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateHotspotVouchersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HotspotVouchersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteTrafficRuleWithResponse request returning *DeleteTrafficRuleResponse
-func (c *ClientWithResponses) DeleteTrafficRuleWithResponse(ctx context.Context, site Site, ruleId RuleId, reqEditors ...RequestEditorFn) (*DeleteTrafficRuleResponse, error) {
-	rsp, err := c.DeleteTrafficRule(ctx, site, ruleId, reqEditors...)
+// ParseDeleteHotspotVoucherResponse parses an HTTP response from a DeleteHotspotVoucherWithResponse call
+func ParseDeleteHotspotVoucherResponse(rsp *http.Response) (*DeleteHotspotVoucherResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteTrafficRuleResponse(rsp)
-}
 
-// UpdateTrafficRuleWithBodyWithResponse request with arbitrary body returning *UpdateTrafficRuleResponse
-func (c *ClientWithResponses) UpdateTrafficRuleWithBodyWithResponse(ctx context.Context, site Site, ruleId RuleId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTrafficRuleResponse, error) {
-	rsp, err := c.UpdateTrafficRuleWithBody(ctx, site, ruleId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteHotspotVoucherResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTrafficRuleResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTrafficRuleWithResponse(ctx context.Context, site Site, ruleId RuleId, body UpdateTrafficRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTrafficRuleResponse, error) {
-	rsp, err := c.UpdateTrafficRule(ctx, site, ruleId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseUpdateTrafficRuleResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListSitesResponse parses an HTTP response from a ListSitesWithResponse call
-func ParseListSitesResponse(rsp *http.Response) (*ListSitesResponse, error) {
+// ParseGetHotspotVoucherResponse parses an HTTP response from a GetHotspotVoucherWithResponse call
+func ParseGetHotspotVoucherResponse(rsp *http.Response) (*GetHotspotVoucherResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListSitesResponse{
+	response := &GetHotspotVoucherResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SitesResponse
+		var dest HotspotVoucher
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3468,102 +10426,121 @@ func ParseListSitesResponse(rsp *http.Response) (*ListSitesResponse, error) {
 	return response, nil
 }
 
-// ParseListSiteClientsResponse parses an HTTP response from a ListSiteClientsWithResponse call
-func ParseListSiteClientsResponse(rsp *http.Response) (*ListSiteClientsResponse, error) {
+// ParseGetControllerStatusResponse parses an HTTP response from a GetControllerStatusWithResponse call
+func ParseGetControllerStatusResponse(rsp *http.Response) (*GetControllerStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListSiteClientsResponse{
+	response := &GetControllerStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ClientsResponse
+		var dest ControllerStatusResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	}
+
+	return response, nil
+}
+
+// ParseGetControllerCertificateResponse parses an HTTP response from a GetControllerCertificateWithResponse call
+func ParseGetControllerCertificateResponse(rsp *http.Response) (*GetControllerCertificateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetControllerCertificateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ControllerCertificate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetClientByIdResponse parses an HTTP response from a GetClientByIdWithResponse call
-func ParseGetClientByIdResponse(rsp *http.Response) (*GetClientByIdResponse, error) {
+// ParseUpdateControllerCertificateResponse parses an HTTP response from a UpdateControllerCertificateWithResponse call
+func ParseUpdateControllerCertificateResponse(rsp *http.Response) (*UpdateControllerCertificateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetClientByIdResponse{
+	response := &UpdateControllerCertificateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NetworkClient
+		var dest ControllerCertificate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON401 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListSiteDevicesResponse parses an HTTP response from a ListSiteDevicesWithResponse call
-func ParseListSiteDevicesResponse(rsp *http.Response) (*ListSiteDevicesResponse, error) {
+// ParseGetDPIApplicationCatalogResponse parses an HTTP response from a GetDPIApplicationCatalogWithResponse call
+func ParseGetDPIApplicationCatalogResponse(rsp *http.Response) (*GetDPIApplicationCatalogResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListSiteDevicesResponse{
+	response := &GetDPIApplicationCatalogResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DevicesResponse
+		var dest DPICatalog
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3576,34 +10553,27 @@ func ParseListSiteDevicesResponse(rsp *http.Response) (*ListSiteDevicesResponse,
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetDeviceByIdResponse parses an HTTP response from a GetDeviceByIdWithResponse call
-func ParseGetDeviceByIdResponse(rsp *http.Response) (*GetDeviceByIdResponse, error) {
+// ParseGetAggregatedDashboardResponse parses an HTTP response from a GetAggregatedDashboardWithResponse call
+func ParseGetAggregatedDashboardResponse(rsp *http.Response) (*GetAggregatedDashboardResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDeviceByIdResponse{
+	response := &GetAggregatedDashboardResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Device
+		var dest AggregatedDashboard
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3628,22 +10598,22 @@ func ParseGetDeviceByIdResponse(rsp *http.Response) (*GetDeviceByIdResponse, err
 	return response, nil
 }
 
-// ParseListHotspotVouchersResponse parses an HTTP response from a ListHotspotVouchersWithResponse call
-func ParseListHotspotVouchersResponse(rsp *http.Response) (*ListHotspotVouchersResponse, error) {
+// ParseListAPGroupsResponse parses an HTTP response from a ListAPGroupsWithResponse call
+func ParseListAPGroupsResponse(rsp *http.Response) (*ListAPGroupsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListHotspotVouchersResponse{
+	response := &ListAPGroupsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HotspotVouchersResponse
+		var dest []APGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3668,22 +10638,22 @@ func ParseListHotspotVouchersResponse(rsp *http.Response) (*ListHotspotVouchersR
 	return response, nil
 }
 
-// ParseCreateHotspotVouchersResponse parses an HTTP response from a CreateHotspotVouchersWithResponse call
-func ParseCreateHotspotVouchersResponse(rsp *http.Response) (*CreateHotspotVouchersResponse, error) {
+// ParseCreateAPGroupResponse parses an HTTP response from a CreateAPGroupWithResponse call
+func ParseCreateAPGroupResponse(rsp *http.Response) (*CreateAPGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateHotspotVouchersResponse{
+	response := &CreateAPGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HotspotVouchersResponse
+		var dest APGroup
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3703,6 +10673,32 @@ func ParseCreateHotspotVouchersResponse(rsp *http.Response) (*CreateHotspotVouch
 		}
 		response.JSON401 = &dest
 
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPGroupResponse parses an HTTP response from a DeleteAPGroupWithResponse call
+func ParseDeleteAPGroupResponse(rsp *http.Response) (*DeleteAPGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3715,20 +10711,34 @@ func ParseCreateHotspotVouchersResponse(rsp *http.Response) (*CreateHotspotVouch
 	return response, nil
 }
 
-// ParseDeleteHotspotVoucherResponse parses an HTTP response from a DeleteHotspotVoucherWithResponse call
-func ParseDeleteHotspotVoucherResponse(rsp *http.Response) (*DeleteHotspotVoucherResponse, error) {
+// ParseUpdateAPGroupResponse parses an HTTP response from a UpdateAPGroupWithResponse call
+func ParseUpdateAPGroupResponse(rsp *http.Response) (*UpdateAPGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteHotspotVoucherResponse{
+	response := &UpdateAPGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -3748,22 +10758,22 @@ func ParseDeleteHotspotVoucherResponse(rsp *http.Response) (*DeleteHotspotVouche
 	return response, nil
 }
 
-// ParseGetHotspotVoucherResponse parses an HTTP response from a GetHotspotVoucherWithResponse call
-func ParseGetHotspotVoucherResponse(rsp *http.Response) (*GetHotspotVoucherResponse, error) {
+// ParseGetDynamicRoutingConfigResponse parses an HTTP response from a GetDynamicRoutingConfigWithResponse call
+func ParseGetDynamicRoutingConfigResponse(rsp *http.Response) (*GetDynamicRoutingConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHotspotVoucherResponse{
+	response := &GetDynamicRoutingConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HotspotVoucher
+		var dest DynamicRoutingSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3788,27 +10798,34 @@ func ParseGetHotspotVoucherResponse(rsp *http.Response) (*GetHotspotVoucherRespo
 	return response, nil
 }
 
-// ParseGetAggregatedDashboardResponse parses an HTTP response from a GetAggregatedDashboardWithResponse call
-func ParseGetAggregatedDashboardResponse(rsp *http.Response) (*GetAggregatedDashboardResponse, error) {
+// ParseUpdateDynamicRoutingConfigResponse parses an HTTP response from a UpdateDynamicRoutingConfigWithResponse call
+func ParseUpdateDynamicRoutingConfigResponse(rsp *http.Response) (*UpdateDynamicRoutingConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAggregatedDashboardResponse{
+	response := &UpdateDynamicRoutingConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AggregatedDashboard
+		var dest DynamicRoutingSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4002,6 +11019,46 @@ func ParseUpdateFirewallPolicyResponse(rsp *http.Response) (*UpdateFirewallPolic
 	return response, nil
 }
 
+// ParseListFirewallZonesResponse parses an HTTP response from a ListFirewallZonesWithResponse call
+func ParseListFirewallZonesResponse(rsp *http.Response) (*ListFirewallZonesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFirewallZonesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FirewallZone
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListDNSRecordsResponse parses an HTTP response from a ListDNSRecordsWithResponse call
 func ParseListDNSRecordsResponse(rsp *http.Response) (*ListDNSRecordsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4162,6 +11219,53 @@ func ParseUpdateDNSRecordResponse(rsp *http.Response) (*UpdateDNSRecordResponse,
 	return response, nil
 }
 
+// ParseListAdminActivityLogResponse parses an HTTP response from a ListAdminActivityLogWithResponse call
+func ParseListAdminActivityLogResponse(rsp *http.Response) (*ListAdminActivityLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAdminActivityLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdminActivityLogResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListTrafficRulesResponse parses an HTTP response from a ListTrafficRulesWithResponse call
 func ParseListTrafficRulesResponse(rsp *http.Response) (*ListTrafficRulesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4332,133 +11436,363 @@ func ParseUpdateTrafficRuleResponse(rsp *http.Response) (*UpdateTrafficRuleRespo
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9e1MbOfboV1H13qpLUu0XNi9XbdV1gCT+DTFcbJLZHVIgd8u2Nm2pR1JDvBTf/Vd6",
-	"9Fttt4GEbM3OHxOg9Tg6OufovHT04Hh0GVKCiOBO/8EJIYNLJBBTvx0HGBEx9OXPPuIew6HAlDh9Z7JA",
-	"ICL4zwgB7CMi8AwjBugMiAUCnuoGdq6uhidgRtkSijeO66DvcBkGyOk7s6M92EbTXsP3Z0eN7qzXaRz1",
-	"dr1G5+CoC71u2+95R47rYDlTCMXCcR0Cl7KnF0PkOgz9GWGGfKcvWIRch3sLtIQSVD2l03eiCMuWYhXK",
-	"vlwwTObO46PrnKA77KGtF+arbmsWdtDxprt7PdiYtvcPG92j2VHjqNM9bLRn09nhDHU6HvTsC/NjiJ63",
-	"sDO8xKK8qk/wO15GS0Ci5VQvBwu05EBQwJCIGAEhYiCEc5Rdzu6eAfXPCLFVCmugJskC5qMZjAKhuyz1",
-	"ZE6/0267zhIT81sCLyYCzRFTAJ/PZhxZIB6VIeXfcAimaEYZAlxAJjCZZ1bAEI8CwcHOjKqlYALlWLkN",
-	"atsXRDUQ1hVll9C2LuGCBthbbU1MM8zQPQwCEKr+OTraP4S9o/2D9iHab/e6B0dTtN+dHXa6VX/f7fQO",
-	"eofd/d6BnbrCGMR11FWmpkvkUeZvvbKT0Rgw1bWwKNTuoaOjTntv3/N7+wgeId/ze3aQWTz3liBHwfZ8",
-	"LRiczbAHWBTkGMDZax/MOrODg6k3O9z3/IOjo173qN3pVICs594O4DEWyA4uxwIBSWiMwAAwNEMMEU/S",
-	"vuwMdiSaBxdDcLf7pnlNJgvMAeZqPbdxr8u40y2YYRT4YMboUjVRg9Ppv5Anmtfk7dvhMqRMQCLevu2D",
-	"eGSfIg5G5xMAPQ+FAki5x0EDRNwKGCXBqnlNjulySQm4g0GE+uDWcNLtNbniCNx+OJ2AlmIfpvizdddp",
-	"SWD4reTlORJV6+bNa5LbnJhFrXshB3nCTmxNOgZYkDkSwM4wXZ7eoU55h/wNW7INstS+FNFzeDg7gLO9",
-	"XuPocHbY6Lb3YQN2vIOGd9TtHR3s7k47s/1q3D3zJHqUnXlICUdKk3gH/Uv0Z4S4EvUeJQIR9SMMwwB7",
-	"enH/4hLfD+kaHpwl4lyeSn1nSO5ggH3A9DB94NGICLCMuABTBKZI3CNEQAdA4oNOu9028CMuLuTq+o4V",
-	"ka06aGotqOAhFa07GnkLqRy5DhdQRPyY+sjp9+RBp/8w0ih8Nzi5uTz9/1en44nEDl4iLuAydPrObnt3",
-	"r9HpNDqdSWe/32732+1/Oo9Z3P4fhmZO3/lbK1XNWvorb50yRtmlwazGc55Y30EfGEyDBoiRRhlYwkBu",
-	"GkowCHwooJx5RMV7GhH/qTszogARP6SYCFBJsC2sQWlgv+bG5Drksd0rYHt0Prl5f341Ovm5uB5RARTm",
-	"QANcIk4jJoUgS7Gh5CehAqDvmAs58xWBkVhQhv+N/OdygpQs39CqHjpLOOwUcHg1GlxNPp5fDv95+pPR",
-	"mMVJgWYx5/Koi1f6mEyqhMpgPmdoDgXyTyBfTClkFumdNgJ+3EqqjwJzgT2uxAUkMFjJ3xzXCRkNERNY",
-	"y62ky80SCWhRrJGAko8AnNJIaCMhmeUOo/vSiIj4NxnkFgc8Jb46WvASAQbJXFobBH8HSRew5DmVtnOw",
-	"v3t42OkdtA/2LCq26wRwRSOLhp3gDOgWQHXNniASa/dwVRbvinSYWLeOsWyw/UoOjg722/I/20rusT9H",
-	"2k7NT3aGuZoLETgNkA/ihpnB/3CMkncTn+Ga1Rw57AzfCOQtCA3oXC53Sbm4gZ7Ad+hGW5zc+eo6yhKx",
-	"6A4JrJAxqKnU/EGf5rKF1mdsls7QfAEeJQTJSbFYgQWCgTqT89Sj/3yzwFxQtioP9lF9wB4MzAhKygMl",
-	"jiQ+kiUUhsXzxU0ABSKeZdAvCyQWiAHTANxDDmSPlDCmlAYIErnQEHrfkLgJKOfVI+lGQDYC1PMiJpUM",
-	"22hrKKxATDuamixUA8mNT++JbFoN0ZfBSK1LtrRAYtvSzZuepSMYWvDxiXIBdAOlY3OeblV+hwQVMLiZ",
-	"rgSyDDORH4H6CKDHJFalYTm4yLHAweF+r9M72D/Y3bfhKZLHy810dQMtyL5ArDG4AKpNRnpmKQr6Ppat",
-	"YXCRgVwrjs/EXcyDa/FnGuWhez4S47mzgqp90O52u932ejzqnnZcGhfZT8SnknLeAhKCAhtn4vcYmM8G",
-	"LEy0lq+lZB6TDPqYrhnu2IyUGUO5mFS/H73KjCy3rzNtAHwspfg0UhDuqK+91l5rv7V/+qa0ah4tl9Am",
-	"difpgGZLTcsftVLb2rW3dqDESFnE6+Yl7UgLHamGMhokKgCJlvLIPDl9P7g6kxbM5el4cjk8nijd8N3Z",
-	"+fFvpyfySEyVhbRt2bJO7cg/9NevleDLo3wo0LK8AJgsbJ22mUPCo+uYQxX5AwsnTpLT436BSOy3TrqA",
-	"ncv3x91u98jq69VacbvROZp02v32Ub/b+afjppaxDwVqqEPHoj9h33qgFXwMM8oyDvWnuNA32Omug8OB",
-	"7zNkO6yHFwDqbwByjucE+UDQKoA6B7vNzn6z0252jmwTLaFXOdOnwXEyVS6GkHdotPtw1vdgH/r99l7/",
-	"0Loe7cIo6bqYhwFcAflVGhYLyoX+uXI2yZgEclA5k52hjo0SR0mRmb4MLxX3yH/PTsfjPPvEX0vTRGGA",
-	"ybfqgMXwpBCdEAvMY1LGPEPNgj4lVrE55lDibkXeZivyHJiltxxJlNbpxvxeLSp4YlRK6RAE5zOn/8d6",
-	"6XChwwLIT+1R96Fk8mlDLxHcm8VNIrRqSO6vEn6GoECfjTMp4xjLQ7JWUVF6/Z8RFRBgAj69Aztt8HcQ",
-	"ERWcQX7esmrv9taHMeQ2RWRtHCb2fUkZ4KkF5KfIB342RH5cRynaZUal9ySg0AdTSPx77IsFUAuSa/xt",
-	"GnKwE6A59Fau8kH/SfkNgwLdLOF3peMXVp0Hw7psP9KOkjIon2GAfWmKhYhh6ksIlphEUj3cMa5n8HfQ",
-	"6fXaLqhGfe9wIwiE2iIA56FWFoD8rE4CpY0qxPsg44hMOfqYktgVP1cePqnO2GSKxBu9Q+yeWYMPsU0k",
-	"KIBhGKyAF3FBl8U9yU2e02MyBlxpi6qDk3689zxEyE93fB1d19jhHARRWD1/FG43+16dySWDrpmSI670",
-	"YrOfOcpaR1adTRPbFnoVPpG1onDLhRdOBS1bbJL8ZDTWQcay9LvZTkfaPuhYYgvjPlrDELl55Okad6nB",
-	"Cd+QxXDIjKb0kZ1UM2HAp0uI8zLNedtc0CVqBuh7M4C2RYSU2WxOykQc/ZcYG19+NvPyQny8TEohw5Rh",
-	"YYH+wnxRQ376XflStxlZt7uxa1IZ1BQ0qYHjOoPBQP5zPBp8OnVc59PvjuuMxo7rjC8/O64z+X2S168G",
-	"VhVOBMVYf9lAkGIwwHcqyseRR4lvpILp9mbjMlUkdO0CVQuwk2rcLhCQzZFINFUXIOE139hV7nZzd69t",
-	"W+A9wvOFhRy+qL9vSQkFpr5RSl7KAHEoIt3SeOVrGX9Iwsii++R40WyP5qxarMkXNAp8MEU/n0NhiJvm",
-	"t6ZHly/Oo71e94dxaee/bPoD2PRIsulhsyM59WW5dG8jl27Jlcr8KnOjR8kMz42qbLNCjyPGjO8kbZg5",
-	"pnMI8XY7u1PU6bb3DvcQOuracDJDUEQMrfGcPZTBz8P0Xg/R4CHy8Ax7BeAkG3gwhFMcYDWimw23auvz",
-	"gmJlFD26Dr/HwltI6PoPVvfbDLPlPWToKpSm2TRYo1jHTUEk2yKpSMA7iAPVKwPGDAbcKqniAT4jxq3G",
-	"S7wfyUx3pmV2H3rNbvPo+d4p7Xf4Ab4FEzWbQQ9tNMSN4yBtX9u3lfOe5D18nYPmwWGzcyj5t/MCTi3L",
-	"HEe9/i7s78/6Hurv7vf3dq3TUB8FFsmkHT7qaxWvXZ1cHjzVT1YJ9Bn6/p4h/H85kMqo9YRj9A5Lgqvl",
-	"eDWeq3vIQaZjHfdrp9HuTnY7/V6n3+7Vd79yAW2Gb8w1UshAY37rpumpdj46G47kWXb+/r356eriw+Xg",
-	"ZDj64LjOxeX55+F4eD6Sv+aOtqRjGZoolBrBessD8xhNWNLTDHsYBsEKpJ03ajiFoyHrpNMUlgWl4J7L",
-	"+u1ilBSlkE0GFknBLZ0lGVmfY/jq82mYkwoFfxUS95R9A+lAqWgFlOQpOn/IyYVbRrxYrLgKqKudIEgA",
-	"3dCt5yGUWl3ZL+jqCJo1EMdQIGWGapBZR90JL1WIrVa0TKOzOuSSPYTtGRdxi5QMwXSVpdZ8DkZ6iLq5",
-	"EzabXBEzWlVb12E0EvrvcYbKV3dTTsYve6gVBOMqROq4IGvoOI/TmBoNQdlQWWiiciLq4ey/J+hrnaC/",
-	"0hFV4+DYfFhsKeR/hehOQT7WjO7kEx5LQjVJ5CzlbkVLSBoMQV8dVkgOA+LW2W16QsJtibxyKaO2jPc4",
-	"SziEYgHEAgrgwYgjX1G0gi0H01NgyCaklpAxmVwA3QB4skXWA9Lu2ez3bDrruuEM5WbwmU0fLonE6gy0",
-	"ghabICZJaaunwebSautpsAWGzCAyhwbXScknXUd+820c+N5cTdI3m57tmv9hN51KmwU9eyxvYCLyFAj4",
-	"DZntMpd+llB4C8S10pJCGDuxzs7Ovziuc3J5fqHyYP7n9LjoszJNStD4iAtzC21TAlDxWEo6avAwmee9",
-	"F45l12qFL/QCtwxdYOKj72sci+p7fNqVNzndMxvb4vDmrsqNMbyIHRdy7xQqMnszvPjcc1z5z77jOu/O",
-	"Jx/zG6P+YtmXgM7n2pFTHfgM6DxFvSGVWq4Zu1owyqgD69hhEAT0HgyCAEySOS3GNfLRDJONBiPmAIK0",
-	"NeArLtAypoEdDxJC1e2ZJfUly+ZjjFXUEDIqqEcDG0HoL7nNSh3jQWA9BbwF8iPjdavNImPTazNb6HsZ",
-	"W46u73LU5T1rZMTIomyIRNHGZoFbERL5tYTbD5Q2BYFgvP4xO/90CWHmNxz/q0mMTytwrNMzLuKPNm/c",
-	"y3Fsgdi3IfOP+hqdSXx6tl5hkibqxvs3GuaeVROdpDMpTVQrwyrBhqtUCUHj3H0JlDHc8xGg3W5vr7F/",
-	"cHhkjf/opJ4b+wWFwjUHxd0xOPeQxxlB+Ys07aP9vV6v/YIZTxsynJ6W1UQZyHxeu68fkoQm1cxLU50Y",
-	"pUsweEaaU0V2E4AMqfwnXE9s/YxMp5+e3bR1RlNaYULRbHY/gQeJVDaUFbmzNrfJYtxhgaziIbkpr464",
-	"eKopCiiZ82LOa8070Rslhbaxqr0zxtI04jtDz+Zc+jw4G57cnCtfi/7509XZZOi4ztVYpQGf/n6hEoJz",
-	"p1W2VzlPmNsO49Ga7VhADqYIEbUhT8n9MHZ5Vnxtlvq/gl+ncA7V9OuY0MJxcq2nHvTFFGE5VHlFlpD7",
-	"hnxcXRcFE+AZoosv3G/kpWC7IjFVpWHKA9OaxVz4NxyGG2nO1fe1ju2I0FnQRVitPvLORnpOCsDEpW00",
-	"9nMQuJrGbBR+QU9t6Tz3ErI7xMBpHDkqZyAYSeKuS3+yHVoX9DSjVuvIllLxmahzWHEBiW+9Ly0Hjr/m",
-	"g4tGdh22d5tdOHNc85OIf5qKvLhKG27r3DYw5JzaV9JCOTn/MpL/DMeDd2dF8aha1LymIWeQXwwBbUct",
-	"CfJcJ0l5inVfDbadSJiwZtYQ5AnK1gQekzbFDKvL/+ntOa4zfn9xcXY11j/lcWJaWDI8vlckoGlfjuGr",
-	"nU5jCnmd83kJv4+lavFpar3nakRLGiVM9BDVISdZ7HpHSNHmUOupIq5qOGICI2hOBYZrAelUKEAbaFeu",
-	"bw3xbqTYUqzleyaIklJLAePZVduITweFy9Snr2luuA5a5hHrxVfT/IvUoT99/Hf1pVCtZUuUf/x3iqTd",
-	"tttru4dtt7PfzmJp17oLM+XDJt7qg22mcx0dI3OQtJPzfcjN1+y5e+5+bqpmL6P8zQKqhJuZ3GDh0XXu",
-	"A0jGlQJUoW6jBO10oJGbnc40+Wme/ESSn1RQ2fz4Pe2DysJW/XUTQeWAL+CxvIfJX6xUNcZiTfbAdsa8",
-	"Kdj08rp6qRZWVQmGXC0rFb9Vlgom4IoodtCXZAPEwNXlGa+oRfWMOHEJBSdVo9oCsuV1rvHEyJ37FTTx",
-	"HAXV1MONZ/zS+Iyf5UJ6SuW3J0Sd9DWqHdScN92io8EF04B63/KxQXXF2jpXGN54UKA5Zasb7K9JzcnU",
-	"EwJxDzA84VlXX92KJnre2tM9eZYENTeJjVLfaf8uj9daMbPcCCWy4Yg1VPqaj/ycp8owbIlqVPlNwAVD",
-	"cCnnT9ZjDQ+qywNrUGoaPA2VtfzzWfLf0ksfu7pvdAa8bR4otFGiRo8jEXAu1yQyx+Hx2fB0NHFcZ3Q6",
-	"+XJ+Kcl+OJqcXo5OdeWBD8Pzgt6U+fzTw0l6uTc6p4dXpRRxAGczfe06zoczxPJC9SDW3cQpbo3tAMgI",
-	"0SeHm5RUy4utwejky/Bk8vHmbPhpOKkIir8ax/01eaJALdvQieQn5EUMi5XkkKWmjEGIf0OrQWRLHTKl",
-	"2sAcEamCI1PfsqRB7YyRkCKag+uo3e4icGxKkFwEkKD4j5lCmqq6kypWuUDQV+aIKVf5e2NwMWz8dvqP",
-	"dOlQQaiLzWEyo3GpPeipTUFLiAOp4f+/5B6lGWsQoG8cYTC+wwz73zBxLOXq5FLiPGO5XkOwKnl9zuBy",
-	"CQX2kpgQNYuPMzqN5HDjykIuOBmNXX0dJSt8+DVhESGSqCkBAfVgUEIjb16TfGHRM9VukDmMBxdD1wCj",
-	"srcYjeYL1ba0KVCA21bI6PdVy0DbulUz/O1vQG63VJ/0qNdkEARxjhgHhr4AJHGtPhBCNd8dhmquZJOA",
-	"3r5k2IshMPnj/Jo0wNu3xeKpO3edN2/f9kuQ5RPObkEDKKXWBScxgk2ZCD1sXNN2527XOtzdbguGWOWt",
-	"tR7k/x9bqi6T1/AJV6Or3zKXDLlZQlI6t68gAMNEzeTX5ATPlDoudCFYs1IVN/STT7qIbNqtL0e24eKu",
-	"8/atvjR+q4u03uaLkfevCQANcKqlQh/c1jGibnWnLcrTxuClFYhzYN2CncqyxWUQ0/rAZSi2KWOs+799",
-	"e2IrWvz2rSpbLJlJ4eseK/JVhcOvlTVUKNZ57SjO0kV2p1QssvvjAg8GwdryvPcL7C3MDHI/b29v/8Ul",
-	"3zxIOK8d7F87fXBdy8q9dlzTqYgPPYbBYNJMyjL95ST+ck0eFQyGZM3VOMUaavFLSOAcLSUxSkEUYC6F",
-	"s/xsUqQxuUNESBNCfl9SggVlponmM3lwet8khlVJzVxhKdlKR3BNJd0kCJVOrGAp8ljh+/t8IkTh6yR7",
-	"cudkqfx6iWCg0inj6Fy2NlmuCqgqpBxgDxnb2JwN78YnjW7jOICRirFETB4hCyFC3m+1aIiIzjRqUjZv",
-	"md68leuk0kmFdv0VTxHHdZKsF6fTbDfbKqISIgJD7PSdbrPd7DquKtWsTmF7mdf+g2PVPC6RYBjdIZUR",
-	"Flvxap+V8RbEZ4saJUFeHFlAmVNCSe5T6C008zEUMsRV5T0IAjpXHus5o1GoTq5Z8dzTB52WzLpkdZLK",
-	"PvSN8TM2FWuzr1FUeCbSJi3zlMCju7GlfiXh8WuhSPVuu12jJm+9qrd5B4ul6u04Uhwyi4IkcAfusTJe",
-	"9Z7o/Xx0nV67UzVbAn4rV1hYdept7pRUflaKXlzcTtufkiTiysECzuUOaK+R81W2tud7P+hT6bGVqdj4",
-	"RHI0FaxiqtmRC4iEEr7hghJ5vg/pJP7+5pqYMGiwApRJ6aF/zpa/0ieGvg2MfLW6dRR4nFR+3I4OTR35",
-	"GnT4S1FssajWU2g23vZXo9q4CKiqlxQ7VGPyjTd0CwJuPcQvzjzWoGUfCYgD5TPOHC2qMjQE6TX0LGW7",
-	"ABMviHxM5n11lubrx4Gde2m3te7NrcA3sk18api7UZI7hhcu+DQ4Vp+vVCW15LZoCor8WCj4yI0VVpw6",
-	"zjfjFv74gITG5Dv9osiP4o7k9aEfSvb5rI5tiD7ZR7npr0PzH5AogvE0cs+4s54or4vH/A6jRlzri5xS",
-	"YOdKHL+5JpBz6ukwrMLodvLZ2Hl/FflcvBb3FPkcb/OryeeYOqzyOd7QLQi29RA/nPVy8jlPyUUB/REy",
-	"X1XUiNtrZ40xi3wUGDslV3ZDfTXFPrT1kZXjmTu+O+qqrqsvf2tpf1686ZnkDema0UZ0Z/KKjCiwi26N",
-	"5B8supMynj+BI7ZiBHMovrbMLoDxNBYoPUrzdOEdW+W6bGRS6TOJzRdl8jX5mLfjeewEBQItQ8ogWyV8",
-	"lDpCTdK83AnJc9prqOJ0DCnvCgwqbcJCFulfRepXJc8+RfonhPJq4r/g/clSvlmo81XlenELGeuquRxQ",
-	"om4OLClDawm3ghAV+cb4jHPS9R0A9QKNlhNGlpai9Vx76nWVd4a4YFipzFa61RC/FOV+TS7uvqP+6uXs",
-	"Pmsx4sd82EiwCD3+4mReqo2rKLa9mWIzj5O9BmfoDajHG9ufCq0H85NRkXwUIFv+4gViS0i000S3kcdF",
-	"ASgXMHRHlZNXc5xhqRLln6gRCnn2zxDZm/J58jct5Flj1ml94C7ByLPeuKsQ+4U7VQYinlBtgl//VahN",
-	"70xxYysE8VP0aaPax9p0YaKmTSd9LTp5Ber4AdJyKyEZc8hra8DFONB0BYYnlSLPEpyFybtuDT/7+tsG",
-	"kpVwMrRAhOM7ZH8RLm/vWZ4Ykn8uv5eT/LXidZniYDlniOo8GOlvWKzk78nDYOYlL/PoVzbiDwFD0G9Q",
-	"EqzS8FVSHKZptwBtz+Y9heUsDJde9IlLsNJZDLcUyhq1KC1zfrjfa7fB38FuDyxoxNIkj8ILzWaMsR7V",
-	"/lKzGiuTEmN+L13m+JGcacPtVvaphSBfjUfh+qcTM9w6SB5QrOTX+A57Q4VucT1fYxAU7r7jfHByjYE6",
-	"1KTGdfA+UwRDxR/1ld94yCqbM1eWAT/R1fh8+7BWJnWhZk85Z3B7e7GE+tczHMugpMQXr7yG6QgBQffF",
-	"cgrrqOhSn/8c6Ni+CzI1fVwQ55pqmzBTiiKXfFBtGRb27BnU9fJmoa0kyU82CoskvY6Eg1Vi/xWrZfxn",
-	"mYHlWh8WOq8rY1sP8cv/T7D9CpAofhhRgfrgHzQCpniQbp6Vr4mcbqgH4WNZSwniYCU76m2ycYW2TV6E",
-	"KzY77wxhV/uo15CaMeDWkNqLMMDGp5OP127C6jUNzFp07Dqh7WliVT5YpQrpZDx1ka8ONZoQy8tQo4bi",
-	"dajxv/J8ZTbz9ZksfpAcS5wByjYQ2+twnSbWZ50eabpzTdWclzKia+rmY30pNhlFX5UtvCTC+2DggsFg",
-	"MHCBej/EBZ9+d8Fo7ILx5WcXTH6fVOntyeMyv7TGnr599RLKemYXXk9NzwKRCWqOxrV18xJNraOj9/mX",
-	"UdwkCBk/huMC/baKVtDV1XyVQL4mWpPuyi+ljhfeS/rJkjtDqjWV8MxzbK8qr5/ICzbNPPfCXJ62N0rU",
-	"1oPuuUERP0mU7ywDZC87VOjMz6XazQqKoT6rutyrqS4XieJ1NNM1+7iFPpobxaY4/vQt+esKnVhT/A8X",
-	"Oi+iAW4vpcyFVxYFtZ2y2TuydZW+SbGPSkBO0itMGQRM5vqwNo98qBGTYGJmA7muHqnSpar0wMwl519a",
-	"E8xWtHgJXTC3Pa+nDebBSKkxLm1dVyfM3ciu46xNy/Cqi80u0HfZNWGZF/viTNSartrsFv1SimHpIv9P",
-	"ltI52q2pHOaqrvxnuWcLBWPKJF1DyLYe5D9P8skWprepgs+n1Bqah4L/OZ7TMgm8jjK4cT+3UAlF5f3T",
-	"ChXxp2/VX1v8xGpihfj5iymKmyVZpvqHoshs3Y8/vkqK4ojdxfRaqGFkrVdRujb9kH57zBdkUO/UMgyn",
-	"QVIoMx4k9ypARPAMN1V5jNLjAB8zTzXn3ytb0YhZipLoAl2ZIV2Qeb33jdzPrwmqSnKu+iJ9+lwXT3Na",
-	"xuaCb9UThuaSQGHE9Op9OtJJcq2npEhl7xquu6GfDnac3OEsDrbpBn86Rpy9VR5j3Q3/zIJGY0vf6tv/",
-	"5eop6ViJ47s8YK5gQNbosMEUM0Z5mBNbFll+r4CqipeWmUnyZR6/Pv5vAAAA//+IqBhZhKEAAA==",
+	"H4sIAAAAAAAC/+z9C3PbuLIoCv8VlM5XtZ35KFmS5eeqU3UV20m049i6lp2sWeMpBSIhCTsUyCFIPyaV",
+	"/36r8SBBEhQp24kze+acvSYWCQINoNHod39tucEqDBhhMW8dfW2FOMIrEpNI/BqO30ZBEo48+OER7kY0",
+	"jGnAWketqyVBCaN/JARRj7CYzimJUDBH8ZKg4Rgt4MOW0yL3eBX6pHXU2p0f4N6s7+54A7I738P7swP3",
+	"0Ot3W06LQo8hjpctp8XwClrjUA/ttCLyR0Ij4rWO4ighTou7S7LCAFP8EEJjHkeULVrfvjmtY58SFm8M",
+	"sSs+Q1vX16MTNA+iFY5f5aCfH+7iLpkN2p43P2zvzAe99uGg77Z7+4c72N3pegP30D4TV0O0biJyyNZR",
+	"K0kotKya2Afslmf2YXiMsOdFhPPifPzgjkQu5sRBbuAHrM0JbHFMvPz0DrpHeH7k4iPsHXV3jw68dXMB",
+	"IDbblRNyS12y8a544rM1u7Lfc2f93QFuz7p7B+2dw/lh+7C3c9Duzmfzgznp9Vzs2mfiaYietityYk13",
+	"Rc+n6a7MB0ekf+TuHXXxUW921F87l8135Q31YxKVIZfPEbkPAXgaMERusZ8AfGj2IPErYHEU+D6J0NY1",
+	"o28oOifxXRB9QYedHYSZh3wck+iVc8PuIhrHhCHM0ecwCkISxQ+decJcGG0LR4tkBdTn1WcHkc6igz4D",
+	"oB3yx9Z/fRpdnp6dTib/9epz54a9UZ/wI0T+cBAjDlrEDloQB/mxg3z4l34hDqLMQSyIR8xBlJ8nvi/+",
+	"DWL48183zA2YR0VHyA1WM8oIuqPxEoDe6nQ6rxwUROIPMQ0WxOJH54Zd+B6JjJlzRBcsiAgKIhSR/yFu",
+	"LJYmJaGdG5bbTRjAMjfAAcaIGxNvGHcWZKvf7e+2u712t3fV7R6J//vPq1d66/9ISPSQ7f1c7uH6jX7P",
+	"gju2njL6ZIHdBxQRN4i8wlHE6At0kBKVz1PqfS5QGvlhHn33uhaC3yU9OxZ/yQG5GSafCeirqcxZ1ezk",
+	"XF7lD2iTSfTtk/DzgGw4C7qisYWQ4Hu6SlaIJauZ3BAakxVHcYAiEicRQyGJUIgXOcD7u3aE8cUgJiAe",
+	"mePEj+UnKzlY66jX7TqtFWXqV0r8KIvJgkQCYHXmN6brTH7XgD3oVSALS0febIkv5nNOLGt8Xl5b/oWG",
+	"aEbmcMJ5jKOYsoWx5hHhiR9ztDUPxOJThqGv3Ano2rcgkEBY98Bc9K510ceBT92Hjdd8TiNyh30fheL7",
+	"PI4f4MHh3n73gOx1Bzv7hzOytzM/6O1UPe/3BvuDg529wb59d0IN4mabcymO58YzOzmfqJNdmBTpDsjh",
+	"Ya+7u+d6gz2CD4nnegM7yJEee0OQE39ztiaO8HxOXRQlfp7W7Hb35735/v7MnR/sud7+4eFg57BbdQYi",
+	"OfZmAE9oTOzgchoTBIgWMeyjiMxJRJhLkPwYbcEyD8cjdNuHy/BqSTmiXMzns/7qUn/0Gc0p8T00j4IV",
+	"inXnwQwuyc4N++WX0SoMohiz+JdfjpDu2QsIR+cXVwi7LgljBGwfR22UcCtgAfMfOjfsOFitAoaAQyFH",
+	"6LM6SZ9v2DUn6PPb0yu0LY5PJM7n9m1vG4Dhn+EsL0hcNW9evL5Vx/a9gE4esRMbo44CFhkcMdoaZdOT",
+	"O9Qr75BXsyWbLJbYl+LyHBzM9/F8d9A+PJgftHe6e7iNe+5+2z3cGRzu9/uz3nyveu2ezIhfcxI9TlhN",
+	"OIkai6tdYp9DYgy/GRp8OhuebwwzfNTk9qwQru98zDYE9Bs05mHAOBGqgdfYuyR/JISLyxSYYsLEnzgM",
+	"fepK9PkfDlP5msH5tbUinOMFdD1it9inHopkN0fIDRIWo1XCYzQjaEbiO0IY6gk2vNftdhW8hMdjmM1R",
+	"y4qq200QcXsZxDwM4u3bIHGXJOItp8VjHCf8OPBI62jQ7eoH53LJXg9Pppen/+/16eSq5bRiuiI8xquw",
+	"ddQS7Hqv1+71rnp7il1vfTPX8v8XkXnrqPV/tjNdy7Z8y7dPoyiILtXKynXO48Fr7CG10qiN9KIFEVph",
+	"H44FSVcQeTjGMPJ5EL8JEuY9dmfOA0SYFwaUxaiSJGxTCUqbeg03JvdBfrUHhdU+v7iavrm4Pj/5sWt9",
+	"HsRIrBxqo0vCgyRyhXSXroa4oVgQI3JPeQwjXzOcxMsgon8S76knAWj3F/LQbDlLa9grrOH1+fD66t3F",
+	"5eg/pz94Gc01KeAs5RyYCT3Tb+mgpr4R/lSaAiqpzZRaKOT1c6kf87TOad23F0FbkcqRBwuD4ziaLqnn",
+	"EWYFZaTZhxWOvkhAZgn14zZlEhRewUoUyKwaiQVTj/jExql9WpJ4SSIxT9EzXPFiLGALgFS6mAGGzgiS",
+	"feS44jn2OUmHnQWBTzBriR0EkXW6wi5fq8oiqTILmDTOkTgYHAZPQTIH/M1QYkllBnCzxWf91u9OS0he",
+	"lrsnBRdHEX4o7I9SwA2POTSUD4vgn1Ae+vgBwdu1OALYxzw094MgsnIZ2X35m8BJNWJ++X5Pv5TcFQCm",
+	"NeksTOIyev/Ixf/rLXTTJfZWlA3dmN7S+GHoSpBKXNVDKCDD0Bhh1bqDjg2t3gqDpgjkE9EAFIVwoHzK",
+	"Y+KhJYnIv24YT9yllDk4whFBYUQ4iW6JB3pOqrljBmL8b63hyYfR+fTs4u0IuDbj1/TNcHR2emI+vLi+",
+	"Sn9OTq+uRudvJ9Pjd8Pzt0a7k9OPo+PT6fDkYnxVfvzm4vLtxdXV6XnxxeXp5Gp4afnievz2cnhiPD8+",
+	"G52eX01fn10cvy8/vj4vvgCGtATl+enVp4vL96Xnb0aXp5+GZ2elF6+Hx++vx9Pjy9PhVfkxQH9xeXrS",
+	"+t1EpcqVKlN12I72LY4AobjYF40yATsLFhS2rPjoDaY+8UovgiTOP5uQGBRE/HiJ2aL4gTw7Qy8IY/ur",
+	"N0G0COKYMNvLSyLUT/Yvr8NFhL3iO6lGfe0H7hf7q2s2s72EbbTOQGn6rO/eKM2S9eVr7H5JwuOI4Nj+",
+	"CmYXwEn/vXiGT1kcPZSJpYtjsgiih/LhPr0lLEbp+xKW2O7bjRgLwuJCv3sH867b8/pkZz7Au7M9d987",
+	"IIfzrm0oYHhqWCsbDfvmZKxiEdJ3yQqzdkSwh2c+QcbL7KKQneVXQ1C//w6WDJ0EBLly4xBXOAzffgKb",
+	"zrtgRWwzUfBMI3xnua/kSxSTVejjWFlXUqsICn3skmUAxhRugeqr2Kpv1UB9BST9ZgMrb7zGnjTzYH+c",
+	"w5/G6z/W3bVKHO6FuHV4pi1KDWNqaRxgb+VTY77yYtwSZi4xTQdJAuwIif5Vy3KtRfjuvycX5+V1vsR3",
+	"CN4oHQ7cO1I1nQEzHI86SB74NqeeVJk5KAzCxBfWvLslYSjSHUUkBowPGPCUhAFKeR3NBoAE05YGL60t",
+	"MNmDSwWmeqqmAR91LvGdwgnzbRv06+0glFvUFpwMiWTXIBWQWxIB3lac8vS9iUFnF59seMGTWR3RMJuU",
+	"b5fh8fHpZGLr2pCqSqwGXZHiKRTG0nuUfgWc24r6PuUEzJI8Zz3o7e919/pd+f+cTAdGWbw3aFltAybb",
+	"JNhTKU5mUNYyTmfBwtDr5CkvmJikoaRossjP/D8kCtozzIknrFLKcFUwjBShd0T3E/onyXXe65a6L9vD",
+	"gC5Twq12sF7XOli6JG+iYFXevAncuHr3oC2KgBzV7R8Yn10/4fSWlLZyd79/0HQrDfiuAgvOMu95Ydvb",
+	"Pew/Es3yC5kHvBm2KUVCWSrCsdBFpOJKY8otOYeiPKNQbMoMFK5A2wy1YI1TslrELTtmBTH2p8QnK8Li",
+	"qVBqWogDNLJgsJLksl3NmXSrhxMTazgWtM3dvL3aTRZbUbub2YVZ2kur0qTEXxnXqRqioUa+RJeby6fr",
+	"x9Q8klVILa/GYhGRBdysJ5gvZwGOLNPOGiFPtwIDc0x5TF0udDiYYf8BfrWc0qFQn0xXJMY27ivGsFsI",
+	"z4JEOqRko9xSclfqkTBvuuYa07Smms6sStdW/+CgN9jv7u/2bBjr44cgseBpumZItkDiU3M3YNXuhGai",
+	"fMcDwV43j4yibzST/cP9PUUZyzO5o96CxBadzRnlsTzWgolCumFON6PMwFMtZ0hVcQu6ndNpTNwlC/xg",
+	"AdNdBTyeCiaCTKXDDd9AkWPFVWnxJHGVMpPE2i9Jci5Lgv14WcIe+Xi6pDy2slfvxAvqYl/1IKwUSnHV",
+	"MqZQ6JYullPgUZn7UK0EVQ3QHeYIvmjZNJshdr+QeOoHnFf3JBshaIQC102iiHjW3tZgWAGZtiQ2WbAG",
+	"s6kX3DFoWg3Rp+G5mBe0tEBi29L6TTfxCIc2ZWPApdbrtqBjLG28vHdmDzHhVVeOeImwG8GqguvJcJw7",
+	"AvsHe4PeYH9vv79nW6dEyJizhym2LPaYRO3hGIk2BvU0McouAErR5Ylrp8/g2vVTjfLQPX0R9dg5Hne/",
+	"u7Ozs9Ndv47yS/taync/cj3/poKtIO6g3GDEtxEkUHGo12o3KJM8ubwc8ggUYY8Ga7o7Vj0ZfQgpSXz3",
+	"HTe3eIXZ55k1QB6Fy2uWCAi3xNvB9u723vbe6avSrHmyWmHbbXOVdagwWbX8XjO1zV3i5VBQz/LNJpuX",
+	"mELRWjsXp5yPsh+cnL4ZXp+BXQB04JejY6kd10r4nD48a7veqiLe/l4JPnhVYeZV6gLclWflseAvtMIM",
+	"L4SvtOjEmInQOrd5jFtOK2Hmry/UeJHaj9sLMXxuhuZXDZT8ufkIlXirMEmlDC8+fk9tTVPQ3krI4GZo",
+	"6P4f42hB4meJzVi/t7A5EqzqDQYWdRSTVXlrcYq56wTuHJZ/c1qGE7tdFya5IkGY1Qqkn6CtyzfHOzs7",
+	"h9YgD+mt0G33Dq963aPu4dFO7z8tQ1Hh4Zi0BTP1aP0++PBmzuyPCfyp8VBzWjQcSmywMNzjFFMw53QB",
+	"F10cVAHU2+93enudXrfTO7QNtMJu5UiVkUKbIlwzCTtCy4DHprRtGQ0oL8McVY70N2UU7BfFsZLJAla8",
+	"JCCiBG4FHVmSJ5r6bWl1k9Cn7Et16MTopBC9FINfqTrBlBuHOA4eE5tV79pZurWclul6YRIe85jlTkJp",
+	"no4mc9UUciKDnxp6I52tCaCB1eOqt6L10KbSauaTFE6bXjymJGcG7YB8iTkPXCrPAo2XOfhsrjvrABuO",
+	"IQgNYINOp3YBV9hFjBVByrpt019XmkWaK9I9yjeChjBvPSwOwjMOqxdEqIvultQn2SEoA7qz1xTQRHr4",
+	"2VCLLeJlAZEMkMxBGw8HvdhYuMnopIgilUfcah/Oo8QpdAjj6ZvAoiJSb5TTjRFkWL4svlCPt2Ogy7H9",
+	"kl17u+ZD1rwkEiE9FYezdwj37EGn19mtPZBjMTifLjSzXO20Z11XOIdIftzEWY/y6Z2kiBuPNHtALixf",
+	"o3FWG0Ub51YP46PZ7Mh1j3qDo27vaHevOQ8x9CluxAldVeJBdF+lVHkNj4FKE3pLjGiIRjjRAxve3qCh",
+	"Ba8GBkFD4qD56Lv9Qf/goCndSziJHnVRmbGeTcM51x0OiMwAEmB1n1wJHsAg0LXXMa8USQnznmAqbWwl",
+	"bbT61pNzwfwHHT6o9rdIk4SvjOCwjGO2+cESV+qTrNqNDdrNzsJDmDfrt7Dvt4qG/fdUbla6Nmm0pcHm",
+	"yg81rQQszzO68n1jvYDGqqH4LP/srRok//RaDFlEZ7nijkDCJjj8LEbvXKc2e7e2Ea7rRNIDsBuWzqj4",
+	"3Km2/srxc7PBvn8xbx39tn7MsYyXJV766TfnGVYi1WlYluIORwz8yCqMdcpez0XYbwgXJXFxwgVv+ACh",
+	"Sb6HUrd6N/CI10Cu9AmTdB2+AMq+uVT5SYOdFyt/+/1EQKFeNxYry/pKUGBl3s/HJFKmSBtuMj5Vx6dE",
+	"WRIlgvsiEELYPyCsUzbPmT0TRue0ox503GC1iSXTac0pW5AojCiLp3yJ+7t7FmjeDdv93T1ktNVUzyd4",
+	"jtxslkDrluQee8SlK+yboLZ25j13l3Tx4Wzf67sDsjc/wN1Zz+17O2Qw38V7s333wDsk3XkP92c77sDb",
+	"JXvzfXwwO3S7Xs+uj+I8sTmfqCjWnK7EhFJ+ZkJ32evZBmBBPMVza14P4y4UETlg1g1JRAPPom/rtXe6",
+	"Wf6Jxvo2GF4GzddcP7UQdA9zGTAaQ8CJP59K3nEdf5wFx5rrvCCMRIVEJzxoyC5zElHsV/oXTcRr7Yiz",
+	"Bu+6A5PR6u73DvqHO3gw23X3vH1yMD/E3VmFn6M405sil/7OhKF8SutUM1kvKbIayGAipvUqsZGginAZ",
+	"N0+kCsbM0w+5yblLTJkjz/2cRtwqL4QRvcUxmSoX7XKPqgF49VZRknqtfK6tOeT6BZmIqL4P6iov2P8s",
+	"bOalyEyB4Hpw0E0r+HLTQqBmSaTCy9zl4Iv9BEW3JJrekohb9REf5Qu9EDrzjxHvmBvksNPt9HoDu/Jx",
+	"vQhr6RpOLQAI16kKk8zNKWfI04czf3m+8ck9nfnkdRD4AopkoygAK1CMx5gVUsd05z3S93bc9mC2i9t7",
+	"h/sH7YP9w7023p0N3B2vT3rzOs0ChNqXEClyG2HMBkxmjd2zGSNpxVgrS2mFXoSIfFTx2JVC3lpfCZgX",
+	"+iMJYgzk9cNrtNVF/xclTOS8KZjVet3+YH2uFadV4VCZJYvR4eMgqbhiAvkh8vl0ahLqOC3h61O2qQR3",
+	"zA+wh2aYeXfUi5dITAjm+H4WcrQlsw45IlHGHwGfwg02XeF74WZUmHUejO5mmsiP+UtbuhizJCYcbSnZ",
+	"Dv1f1BsMug6qXvrBQS0ILLDR9gvF1AInToTRTjjEiIX3kBHLnw4Fh0LnCxEyo4ghsZEiWLfglkSQrmxd",
+	"3G0gzv0DchMeB6vintSTIjVUbouqcz55eu95SIiX7fg6vG6wwzkIkrB6/CTcbPTdJoPDAV0zJFee/Go/",
+	"c5i1Dq16dQPbJnodPvJoJeGGEy9yA4K22CjhyflEZkJ6dBy8NmdvnhmpdCyUuLr+ms7GMSTcJifBym8Z",
+	"vcl4qcyIHCEvWGGap2mtXzrLYEU6Prnv+NjK3gU2bdg4iGLNjcOKTS4/qnF5faxKRAN7eNJYvRFdfvi3",
+	"cOfepOe/qbVbLs/UbvQ2MKJg9B62nNZwOIR/js+HH05bTuvDv1tO63zSclqTy48tp3X176tCxLANReLY",
+	"Xx/UJA2FAfJBtZEpSCUxVJ+9qt1dETG+doKiBdrKrFaO9hvSx8BBJHY7r+xOId1Of9cafXpH6GJps02J",
+	"5xseAKsuPzv3OolJtqV65mvpXYW4lyNBanskQjaiSHwplHcz8uMJEw5rROgnkqbBYOe7EaeenTr9c0yf",
+	"dExTm3Kv+8yndLf2lG54KsejoSHQV4bhT+lapyHdTP+uUBIclqDPXzfHqhvpS2Ab0gAWjU4cnTtNOc4r",
+	"MGheGb17cHhwsGuVP+yWaWMM0cLc23MSz316X6sGyvkuGYtYsQnHOMZ+sChvgLGSvLGdpLCrFknfWKkN",
+	"OtX7Y/WZzrG95kbkplA9/zRyuz6mULdGo5P16FW5x2kPpQ3+QDyKEY8jglegfRJaKFdMpOmOW+cocw6X",
+	"D1jA5nShRHGbZ95xEkXKjTZraIgBOeDdfq8/I72d7u7BLiGHOzbiMyc4TiKyNntCCfxC6nDZRZuHxAVd",
+	"ZwE4mZ8qxDPq07hwGLXj8Rg4w9bRV1BE3tHYXQJ0R1+trvZzGq3ucESuQ1D9zPw1grtuipLQkxYVhG8x",
+	"9Rs7w+gOPlapRfV+pCNpBaq5D4POTufw6Y7KlkTVz+NvqQID59itz5ahnCmz9o3dnKvTbfd7+539g07v",
+	"AC7K3jP4N1vGOBwc9fHR3vzIJUf9vaPdvnWYwCO+hQUQ3SHxtuqsXZ9c7j8tKNkC9Bm5fxMR+l8cLSuy",
+	"soRRcEsB4Rr54MshhHeY8WETT/xeu7tz1e8dDXpH3UFzu9zfNZFJbDVTaWIBtBXLT5FsmnHNF+dno3Pg",
+	"lS/evFF/yURdo/O3Lac1vrz4OJqMLs7hZ451Tj+0mAhD6WNZYxpV2EHhGM2pS7HvP6Ds41oJag3PIw+W",
+	"CUrBU9t04dZLUiS+NtJfPAFO6Qo1rrgcnau+lh8VBqVpRGUUFPaCsBDUJB81clzKQTaUXW0ehfQctUie",
+	"EoVUujzKZhZlZ8s2KruxwaCZI5T5bQHEsvQ4Xj5wEYouMJ2RGMmGTjM+F6RyG8ssgimtsZwR8WHlRQNj",
+	"Hk0HvITvmgVcyuWsDuoyeTt7rgLdIjvmkvqm1CDvxpPxZk6OcTOdeTS+V7V1WlGQxPK5zu3wu1PvA/ST",
+	"8krlVJPi0LE1eJxfU42NCqFsS1loIrIJNFuzfxizl2LM/uF8XpzzacCP1PMgG/IOOmemYEJs9k5gD1aw",
+	"FKNxQU4WRZcq7jaP8Z5VswuB7kLxLf13qhSPNR4nJ+eTnpDrGe/bfNhAnbpuoE6v2SD91reM6FtOszT5",
+	"qgZp7FOMY+pa/eLXzbAiMmgiexuNazrsN4v/YSReYf7F6hzLSIzgZYOJ9Hd3O/p/Vh1xRQaBXATxSgVv",
+	"qPPkLd1QYTJ18ydGPduA8xyNT94dj1spCzeepN1mpCGXNXo0/hB4pHkKAvnVz+BXXmCr/vEr38iv/OSB",
+	"4RV1LyWTp1MkWwoOXl4WCGCUMBgfvX47FkrDi8n4jeb5syxghY01+ywNERHSBn7OliQ3N3buMJ7gGCOX",
+	"wEwR8RYEhYSo8/E4VwnF8CIPkxWEuEQJa2KPnEfRdLYIvalbcZuUl1ClEpxHUQdeKIVObnYADInQbBGi",
+	"vd1ut3fDxN/ycZtC2ZUO/H94wcACNQsi/ayPIrIKYtLGXHzcv2HknsY3rIZWvrm8VDeiDD4PsEe86Zz6",
+	"ZGpnw84N9gualXdMKLF0VzKkT03+epSXYdVa1PIHeittxClf/qIkaDXN1UygG50uOG9I2rz8SpnlNAuI",
+	"2EoLqQYICgOheIljJMiSJ5ZNwJaD6TEwmOVJSotxdTVGsoFwVM5ZtbuDtDfDXGMWN1nXXdkwZxaT2TCB",
+	"r6EwTRcmTRDXTFmaK7LSTFlaji3TC5lbhiwxuDmP/ObbMFgnbJeVBJ/sZfbdKguWNgtXVHWQmdJFMC3+",
+	"QtR2qSJ7Kxy7S8KlIiODUDsmnMmc0SeXF2ORXum/T4+LfghnFWmlPcJjVfWxLq9UkblNP5TgwYVQvINK",
+	"u9boepET3NALjzKP3K9xFhHvNQkub3K2Z7ZjS8PqQILRWNvIYO/EUhh7Mxp/HLQc+GcPkl1dXL3Lb4x4",
+	"YtkXP1gspM2w2ofXDxbZ0itUaWQFbHJHVR+Hoe8Hd2jo++gqHdNixyEemdNG8UsYZa0Rf+AxWWkc2MoK",
+	"Aa0CD46s96oJNoRREAdu4NsQQr7JbdbawNu/sfLDXRIv8UmdgCFp8ES3hi9F2bHNKMpEfNOYmFjd9xRx",
+	"Nf34Kn0W8jdIhd/ez0WtvyP5LFA4HeKu6NMPJ3lqfEXCfjYS+OEBHcvQibF+abNkPycJeuxZLByTxxyQ",
+	"/wSMPLWc3Z/QR46p2j9wXbeP+2Tg7ri7pE8GeH/Wa5Y2SuHH9E8FWd39ktaxK4JRdRyaK9NLE9Nl9Kw6",
+	"eqlNnVLPZsE44YXa4mkq/eIgv1XlkX98aTRdBv1EeMDAgPZA0vcyeLSwpmhLFx92ELnXfyklh4NuQ+Yg",
+	"Va7UQd7qz1f/QmQVKh9eFYUE/eS1iLRyKavL2NkQWeTAGKqclxWuoI9KM4NzfRayollj7BuhN17ZY/aG",
+	"4jkKMfUcJEu2Pwjdew6OvHd15/DQlNeCRBrsFBAqzBvGVF0QbzqzpUAP7qTeSgjcIuda+oFB+5lE1BBz",
+	"ficjdlQAlHj4oDS6YPdLpO8kzd8NWesGmtx0W8/lqOnvcTZ8+uxj2rPRTAOUPgLrzPXEfDIcj6xK4bTB",
+	"BxIvA7FtQqC25nofTS7QoN/bR7pJikByp3P2vYlVxq+OZxymG4E8Q2+mYhrNvkVMY4WPciGseMSUvOat",
+	"STeXQztE7kMaES5qzYo/N8uGBzmpGmZ9k72v54LysC0x10DVswfrA60fkQOulBRNpv5ph0uJuI9MAVfu",
+	"Vqg1+93OTq+Rsaept015oDSBE7jQ7Rzt7T4pgVnVMhnJwjZB2orEURVo+30zNzZKoFY3/82mr2jo1LUq",
+	"LUEDl5WN8QhZZVHHeSffg73eoHu427Pa7/QgTWvirBkIEojaEtLUoLAi6DVZ2SQuNGMKqgP2IaMZZeuT",
+	"oeXvYKmO1rglv5cM6QqzB7QMknyMd39QG/qigGg8l2fJzlXu+QVSdL2TnKO+wp+q67UjYWU4ca0Dlf2g",
+	"XWUjCeuAxAhxxrmIxI4DndPWYPnz5Ly/M9ht7+0fHFrPoMwZUJETtkDNhIJCgyOyaabVTA3S1j3c2x0M",
+	"us+YUKEmgcLjkiZApGL2eu2+vk3zJYhmbpZJIQqCFRo+IYtCRfIEUUlZBAU107z8iEQKPzx5wsYJE4xi",
+	"dYCz5n4iFzNQAAvL3tba1An/RKJrzS2NiZUqAlWCl/Iu0is8I34A5WkLqZEPDub7eL47aB8ezA/aO909",
+	"3MY9d7/tHu4MDvf7/VlvvteEQEpzX7UXnXyfJlXLjrGSKj8Oz0Yn0wvhEyf//nB9dgVm8euJSAh/+u/x",
+	"qFRU2/yqBBIg07qkOGUsBBFiRggTePiY0HJlIjapdv1l9zP4D+UhauLKDX4zozEn7ilzo4cKb5bsHcI+",
+	"hFPGy5VQLTEkvkXhEnPToRIT3usftBzxx2Ff/tEXyWN3POnFaEgo+lUDRUIB1OHpRI5jeX7Ytz6XQxWe",
+	"75ycTlrpUrzD3OLGAE8bTn/lgZTFl7gn/5Fj8iXeORjIP3Z7/fwipK2aLgKA8+Fkt2XAPHk37BV+G7NV",
+	"TyQI5hMBi577e/Jwei+LYVvEhfenqTlBaBY5jUk7DtrwL/o4PjfWgH4htwAN/FuYq3zUfKoGTKP3p7fp",
+	"HAvP5SzeQz7p47T02DOoDp8vN7XUGc7pPfGmOJw2shHJ0dEKvFJBhkkLKOjkzYV6C5ShdAApWDXToWh/",
+	"Kfh2OD5VoJngPrX8A+UI6h6V8/qnKorDwyOCj/ZmdT5dEsYPw+MMPpse5pIIj2EPmWkTFL8nF1OwBQkn",
+	"U9EJDWW2v/ixifkFYKPxI3VPm9cfeELC/CcU3XmGhPmZdaWJTkK1Vra+e7mhFLhAtb+UNajc26s7nald",
+	"RXEdGikanU/gOXAGnQStZGxYly0yy19voFHCSbSIgiRsuFTprsCHSHzpGPabWMSUKF93ePnIiseVahwb",
+	"m2RQ5ArT/QYUMRA0xFz5OChTnTVEsMEWPDsNtAH9jBRwUyTJcANAkyjzPIiQg6QGF55L72V0+QIKr7Oz",
+	"k/G58lW2Za0xkk9Y2GqBYNqRymyMtmYR9RbEUd7RDrrzMXOQsEM4qNPJZ/r5rSWbt5wWtNssxbm7BBTg",
+	"VuQ5lu9Mrgh7tzBBnl1c2lcb0hYmInSbxtUVJfqDo118NHCPer2jfv9oZ6cG1xUIo5M8rFOezOyhKRAJ",
+	"md58Zfi3Vth1EA0dOJTYLy+m0kc3gmmigGhcJUevVb6Wma1MziMyC4sJTYGrmFLv3lZq2/CsFI1FRHIe",
+	"MFA5ckIYKpSDqUmZdAbdQcjyyLtfb/UwoKz37cmgzG0RDIR27TyRjnOb4srw0FwsXFoMsRKxmwdqqRRr",
+	"UzGejSNdBTGRi26+KU2tjleBRidGB3pc6q0ftNE5XntcBw0gk2dVkrWqpZiItzntcEOIrofj9vC4PY4C",
+	"tNfZ6+zv10AkRyqslgLOjoAKNni5OVDtC3Bts9+V5etD3DxVOZkeJa5aYoYrxNV+MxcXLwjjOrKWJfBQ",
+	"zfVicRo3cuOSLqRTbVtpFCeXj3itMMbbz3r1Wm0ch+n7XjityAalOQOOPMpdsFSIkJcoSBZLBKwDGEiO",
+	"4R8zv8RmaSJyHMh6zzFoKoSyTYRHyxo1SM7RLHC+GIvTup58GJ2PNgial72VgnDkGUQTkQmikkrDhkTU",
+	"I9xepB7a2CKlue61cRqPCzWQjf0SkFTgD4S6EpnGDLyPSFTYlc0wRa4GwCP6rcwtUgXNcXEpSpJOmiai",
+	"eb6R7PQWYakI6FdbLt6irV7GRTmoj0LCRISqoEI0YA7aV3+zRZnT69W4a5jUubBkFVDBS7SV4BB44TsH",
+	"JQv4j7cqj91K+J3VXaMqeuXNujxvcBHu9Tq93b6NKSnKNBWCujndVFK3TjN/KOaU+J4oSiCT3ZVCZZ+F",
+	"uP90BMBBEQl97KY1FOMAyHvW5zMRiM3OJLkl0YM8iLYj+ixns46TeS7R3uzzJWT77NPSPFbcEvF1agbZ",
+	"iuI90u7sAmN00xLhnDetcs7mKOqMZGzrhRzc5qL3/KV3mtV5+TA8fkP9mERZ/Kbd4Rh4h7loiXzKhb5f",
+	"KduPoHBGcIewJ9wuhOodmhBP6cO4c8M8woCrhWF54W3nplCSMQDSCR+UijEGd80MS+mshuqb9MGJ6Pab",
+	"0zq/Gps5BPL7z+JwqgoX9ay0msfa7eQcgoXLqUu6nTAI/A6Lw04QLep00wALdCGSpRiDVyZNqRm996jR",
+	"+4XRd2xuCzSyD95ohJ3CCAPL4gZJFC8fP8QAhuByZ6dhRJSb0XoB53pG/0hoTMV4sHRoCydx8AoprbqC",
+	"RkLCQcnEEuy/Ei5G2g1B428itL+yRR6B1bNGGHx+NR6nwA9ln7lnH9QAVnJtIPczEWujxxeg1ZpdSA2/",
+	"zTwwioU8fze6Ctj8qbFUNlfBR1qnVDjV0g29hobjtD4WZP0A6xTk0VEYqgVOC4ANDCTQkWEdkUBV+HJL",
+	"UliQKQUkohiwXfbudvbqUjq9Ox6LAo8mAIFF/j/Djxm+vztoBEAQqpBMLpIulYd/qzJKaQhAlgOaQ++R",
+	"n6uzn6XtQ8ejk0vEgrgcq2RA2NvuD2r1EzIV1GZhcjacHQVXVh46icKAk+pcmqoB2nKDKAwiHBNH+tU6",
+	"6NbHrC3d9O4ws8hH6SdWKQmsHGX31rPhORqd/AsFvkci4wRoj0FEY1n8Pl2vdQUoCl7sZ8PzGnd+H7Nm",
+	"ZzPdbI5ivFgov3OEkRpkk8MIn6SHcbNoO4PSPdstkHX5ArdA2aGuLNfJJiIZBokxdAWYH8znnMTbshyX",
+	"YF8j1QXvWOTZmkJ9YqngKLvKb1J3VusFKwBo4nwrhwgJhBbm6VjfWmJCTrAeaJU4rLZmSRzE2D+2L4Qs",
+	"j1iE1ZqFtFfrkqkA10ujyyTmIKhCiYJpAi/IiM1FittxcGrBDUhOLAR4dKpT9pYrCigfWWdd3SDbwR8H",
+	"p4a/sbyBKS/Ztqo05DzGzMORZwP7FOm3+azZiuM86PY7O3jectRfsf5rVkgLnTW0egavSa+pYMil1bwW",
+	"WfsuPgFFOxlNhq/Pio6/12PbUHYNG4wAbxRebYZE6eKplmZUvQTbSk7yEfq2nWUIG2kcsBvTW9JBIpeT",
+	"4P0pS80gwlNZxE6XKYpnV3ViFcF9cX46vRp9OJ1enJ/9inSCAQfusl9//fXX9ocP7ZMTSzqofrtvLbMH",
+	"w02tEaOCXfL0uMfXk6uLD2sGXMsh4ZicMi8dby2D+HxDplzhyp4KTGkr9AAoIiHBMTfwdnj2afjrBJzV",
+	"P55e/jo9Gf6a/v3p9PR9y2nl9qPltCTQedzOfdBAntNoBrkrh/4dfgCQzIenoNk7wQ+2x58I+VJ4fsHI",
+	"lUz3ZT6VyTCscdJmM5nUDRZmGrCpB8DYsJPnlzI9AQg07+Bwli2CIJ1qnZraK1S/MDkPP9QYty4FuBcM",
+	"wNKp1qbY9wH69dyYBX7IJiJQUtnlYKo0Vvos3irR5zwosO5D3z/BDykgQtqwHzmjTr4aHb6Q8olA/3fv",
+	"jj58KNTfO+rWWScBiEvoQ51BA4yKk5grmN8UlO7hBqCos1nk6gKviv5GsbVSESNuHERrMu6nbYql4S7/",
+	"ewBuI5M34/GZSB8weTPOn1vVwlIx576icp5MWKbYna1ee4Z5k4CnFb6fhIR4H2Yhr+b4svT4aWCX+CDH",
+	"8NkDucKgQQ6aU2jJq+HQFzwjiyCmeC0gvYqIshreAea3hnmo5RhK2bbvjTTaGbYUVtycdRXypWaZeruL",
+	"tgppfq6gLraK3wJ7SqarY7wiUWVh2WkNHtYU6ZOdAFPb0FlUbE4YBSIXqnaej4P0XQPd1k4Tb6axHMIS",
+	"JJ7O2rZLsmZFmUYsMWM29wMRX6reWjjJPduKqeafIHT0w7s/rV6Soj8ZXAoH492fGSr3u86g6xx0nd5e",
+	"18TlvvWszGHqhLkPb20jXcgs9myB0nYw3tvceJ2Bs+vs5YbqDIzgv7kf4NiWWQZ8RyeVYoZYulo5o9fD",
+	"Srro9WbpX4v0L5b+hd3sz/vsG1IWScTTumOfA76wjuU9TJ9UY1VVJn21247abuBr4ggzvqJAyu6k4qlA",
+	"HJjFT6KsVqhC2uMcvjpgcwAjgnAsJ3FR2+sugyCvaWjtVKpT1UM5YTU+hK/EzbFcj3JQp0ETi79ehQYx",
+	"zvG9WEUL604XS8Lj4mrD9KW9W+Gk0Lx4r1e5O3IHsAV7kAeiIGPbgf2A76/uhUKgBmLKqiE+C+4eC/De",
+	"pvBS1ghe+02UFkbKqYI1mmaIdEfntGfPu6nocDF2G6YJgfdoC4hWEKF+ZwD0ykEMi9+78tceEb/24Ncr",
+	"M0/VQmgwW05rr0AZGG4mWAkYXmPm9aGYR/prN/dr761VJkrfqwwxFft8ld9guZuOjINPw/SUiVA2WRU8",
+	"33r7m6WO0bBM7VJuASCf3BK/bIKUlu8V8WgCuLekCziebioiZmutnjVab4WGyiSpfp0Fd9mPD3pE9fud",
+	"HFj9WiOi6u9t5R0kBlqpOVlAGoAgelAkjFcSNy49FTL/1Sj9VpXclrye0EFGkGKARBK94X93isBZyTqf",
+	"7lk2SuA7couj4xj1u4rAFiM5TCVstTisJ7T3tt81mBiAYtrb624CSW/vuUDp7ZVgGWwEyuC5IBmUADnY",
+	"CJCD5wLkIA8Is2Te2P3eOLJbxBGG7Tiy+91xZLeEIwxPBxuBMnguSAYlQA42AuTguQAp4oiFKVW36vfE",
+	"kn4JSxbWnVkHyuC5QFF7Y738zpMViairiXTZy/xgYI3c+UIqEkLu9Pb2qnu7ntg6qyj3rTopCf3XjMbE",
+	"Q8LLmDeLHSlfa89kwy13/AKm3MmVKPRkc4oPsazWE0dE+4GLS1mrI3QVKsq1iWcG8beSYRGeharYkE/S",
+	"ukPzILrDkSd/eJS76Y9ZFHwhLM8O5Vo30e2ryZxkIOlHrzPQ9KMzA8T0WQaqfvTGBMIYwS09fK2m8M1p",
+	"FXXqVTYn4H3upFFBr+dK5nZPRJSpmEO8TISkTVtOiwuVAk8s5SFrzB3My9k0rhLC808+EY8Vn10tk6jw",
+	"6E1E8w8mOE6iwqNEjCZWgsbkHcF+vHymYzNJZjKqTPb6EmeGxmtqsm6Weq8YuPVsKaZ0qujLasdLnZgb",
+	"pb6MUiwV8hRl6JoJLV6m8ri+PMu7O+u09E+qvllagpPqXv+W2ctsZS7L27vGAQkQ9iqA/34cW9ZOerDm",
+	"swzJjEsfx+cOwqkfVSpji6a3IdOeZyVhbDP/zY/j8w18OHea+XA28hCLE6Fr27Q0BCfu1JNl8Ka62HHD",
+	"caA5kbYrtPXxavQKUcZjgoVxUro4yLebphTKl+XL4CQ8nHrLqcxDYjmQ8zkl7XfE91eYqXQVwVzm2hKR",
+	"XtEczpO6ihEnbkTyxR96gxqd2elkfPLurcqDskbZksFLclnSmvkYF9Orffu9BIX51hxuqTKRbTCQSF5m",
+	"GUI+Nzv36ZzYM5KeqTepDUitOSduIko06SRUKoG5yr5srv3OXrdbv/p6oCbLT7+QR6NL718bOoPWYc7o",
+	"/ekGmAOgfw/MGb0/tWEODPdcmDN6f5rHHOh8U8zpbYI5a/NoZ1BthDpau22hhcPzrFJ6gSLOgoSVkpXd",
+	"4bqSk6kuPQPgC3mYEiOt3ga7YibkK25O7p0eS6bXsIXCixQdqSd6g7n2Dg86u71Or9vt1OUKEH2PxhkY",
+	"ISGRFYpxMvOzWsRZ3nGRpCIrtmq6su1AUdDeTqe25syYkCgHxJxXX3+aqiWc8CbXSQNf7PGbyWYspsqZ",
+	"mFVcijBzISbAnkKiucu9k7FCwNEXEzXmuWXd1Dag3JjpbchUjAOvqjgNdBW7S1FuVOc4MJFsTWDDb1AP",
+	"oS8qvW739h5dGUdmOlHQCOCBp5l6FKzWrrWK9YoyyuMIC2cn3TBFykCkxlbeDgpF9SqYl92mef9DNrU7",
+	"uQLDCW8cJBA43T8ZGKaYX7mg+S1Mm9fl4h+fQ36kTSMFcox6RRa3f/jan5Cv/Yd7/QHc6/PwqI9lQP9h",
+	"M38Em/kPM/kPM/n3ZSaxCEhABqP4D/f4HbnHhjyi2hWT93sCR+i07qcK0yMy5UscEc9eWXIckbZ8D0lO",
+	"/oWCFY1j6Rb8hZBQeTtJ527IghKwLDOQ4ZR4P1iF/k47jHbafDmIdrz2l52HukMZkYkY+T15KDGxyqqa",
+	"6X7TRazlaZ/Ldprr9IVMQD9DJYqcKaphHYqiAa3sACqei3BdpmLquP7mX1mKNPBSf9DnLm3AgXYILr8w",
+	"rSiwxT1IG5QMAGVE1pAWTdHW3d3dRmnEijopHjZIfJqOH0bBLfUgsuQOs9y4rVP5FxpNxnVsxGQMncPw",
+	"Po4rSl/qEVULWR3K96niniwT7282c5asppXpJIfyhcpsx8V0HSSyHoNrd27gwSPGtXAPQ8MnGwYsDrNT",
+	"wx+eJ6vhuH5okftRJamrn7coPoYjQIS5TxlZuxDdzRdioQvnlQJaJIS68NmWfcSdR4x4V5kXg5exule/",
+	"6G9Txq5mZJUOsCptHEd3mMpoCnCpVzkDn3vBuWX6Mi8dkaucG6BfP/00qV3NwAkn0bqNVtm1qnZ6sOHp",
+	"VrwejDoVJ05EEK4DQH6hQyOAr4GPOdq6DTdbFMm6XcPHQznqekhVqc92VB2UpkqeogjHIopbfCDcfSU5",
+	"XIslPQjJqxUvL+9FZdFLyRitL5NqrcqVXpgqJ8G/UMK+QDp86TGQu/5ALmNBjDiJURIabj0iM9xd6l0k",
+	"ktS1nJbqKe/3ZMkiZ3XukRf1BUQ+yz8/pf3L36dqFPnrOhur5PItW0zkCsBa6AmtWw6YORGOdrLFjJjx",
+	"3VLqvru7a8FtyHTSfMEu5udbJZ9XTPiTSN6i/v70Kf37zHxu/gAecc2k06ka5WnX4WwaZ/IEpK3B2aum",
+	"OJuEdtVNymXIBoZ2psxfiELTG1LcO8zWaQostZlVhqYye7WBruDT8FyqCu7DJK6oDSlSK6wI5gkITmmF",
+	"SCXshkksQ2ppvG1ZikGv39mpLw9f2Ky06xOASIOXhHXAJeEmoB10dh8P2XVYTtaR4r1VwpExU2+ob5HV",
+	"ksgSPjfGWZqtBWEkklHMsh9IWUkcFBFfSvFKbNAaEBHx6pYzcW17/rbqQf877Xf7e+1et93b68Q46iz+",
+	"rEGa68uz0txhAjWzfjZ3xWwdX0BOLeSitqh42RcH8Zyvr5EI2/T7NXJhV2TlWW8rEmggUteb+hz/obGJ",
+	"an1VjnkCSSiS0Cf36+HwKRMpweADpD542tCUT5MQum22AEZicfXZEyvwQ2gZrohWF+/Q1ttTB/XHu/DP",
+	"5M34/29JBPf2tLkbpz2ivrpayLNE1Detnp+/bHcGu1Avur42fR0rC1qJkFgLf8hxUYjdLyTmSLcsiPBP",
+	"BUCwjLxyfKTe56WoJw0qMjfY1NZpjgpxljZLVLF+xDichjheugGP60ISoB2ChmYOhZw400A4mFyN4d46",
+	"hvFqIUsTbDTT9qWxFUUbUPYiYzirsFozm4XS5IcH+3u7g51+74lbHK9B7Kts6HW43X06CFWorSH4Drid",
+	"hA2Itb4qkvBJN8QmmTZkUZzjYLXCzLuE5Arclpxn5VXW03Hlt4YktiCsrbinNvBheemr9LZZYIkJ51vF",
+	"7pnMzu/FaQPM1TMWGQNL82xQ31MdfjdgPPAL0V4nH6BUUPN7zdQMV7Gk56mjeqlTkLQg8ZxFHBueD5F+",
+	"bYCsfGXz6uYElmD7NYl8arX2Vcl816FprLew1hU2+l6/e7i3KaWuLKDxMatc3ITDPxSy36BZLF6GKs/F",
+	"nacdvgBzfhVhsBlfJj55dAZsHUcUy75QlBTOwG53f96b7+/P3PnBnuvtHx4Odg67PWt2CezaC5MPxXNR",
+	"xVLYfLZIZ9FxREC+zFIicoY6SMT/5bnK12cXx++tY4Xh1MUxWQTRw5R63CaayKvdRGD9BRqdCJPvChdK",
+	"BNWWYYRxGw/36FHSpZmmmWax51EZ3jM2NlreJYWLP7+uwPDkUt20LLiU66GENpxEbWXg8nK14LQNvYg1",
+	"ZzAw4nFE8ArGT+dj20qZsWHNkqoGj1vKZv6OBvpv7PWoKxjamLBMkUVkVnHhHqHcC9IJOTI0Svwt+FMc",
+	"LQhUQttopvrzqfzcNmMcy2y2Yp6iPYC1gNU1Y3KPz0an51ctp3V+evXp4hIO4Oj86vTy/BQeXp6+HYng",
+	"tOF4LP87PR5enb69uPxVJIP7MBzB21EhY5/Rwz9xeRCX57S4kbO2+fnWUbL1p1oiwlTZLKvta3g+Tyuc",
+	"pvjRNP+ncQ1difGsjgRlL+bsjBURt+ayq3Bsbnj75K+X4fnJp9HJ1bvp2ejD6OpxF82w7oJx0DwKpLnj",
+	"ZDyCNtgPFhWHvnCcnuFmGlbcSJuCtRE0P+WFclK4SCrmmpKwf26Z/1W3zE9KDh9NCK/SHShI9+IybFwF",
+	"Xw6WehooNIGBUbpFliL43a74v7poankzqxr4Kkq7YVLTFDD1mQlZhjPrBlfSo6x0bPfTfE9VYme5mGZ+",
+	"8bOpnP+k5ZSRNYd16dsmideKGzj0fblIvGXZXvnK9kZNzp6CrdjYGk5U6XQJBPqtDo94Smh+wkkkQyga",
+	"ROZ3SbNSx3EcTZfU8wizI5LOS7HC0RcJyiyhftymKpqDN00XIUZiwdQjPolrzFOiZ6DqYRTEknyI61V8",
+	"K/yVhCekSnkhm7+qt9xs5Fpesdrvqcdt0/sj4NMIx2QK+Tzt1vATbf+GdlKeBC3Q+1nIHdTuCf1BwsTz",
+	"gsWiu7HyPgeOTb96HT4KlP7GkMBOravqXcZzsbcZGsyIH7AFLwZXVKB9fS3Ymri/9LhWsMb/izDoR+OJ",
+	"1Xl97R48l4962uELqBaF+9MTSf+nQpGsqpJ63edLxwJDisimKMCei3mjij1L6pEp57Sm78lkdAJ9y4tH",
+	"0vYZwW7A+KYhqe+oR6A7GN3vTykPfGwXlDQAdzQiPuE8dQYVRWvEd/qeIRiCiETrrbM+Sjt9tSl0kuEY",
+	"pUDJIvRTWTO2vnCayWDKb0DWENLOPIjc5n4aOmOyrvhqlDI04PGpzdJrAEGkw4RsrosAqQohBfr8m4W5",
+	"fWyYUgo2KDELMIdpid5mhuBibd+iPbj0vopnEDhcdTwrqgcq3nuDWgTqC7QFNe9eFQ4m9RbEa3Yv1hbb",
+	"zHH3OqjUWmlXvBHZnNFWEBLwqwxxyL+IfwkOLf4ssoFtRe6nIeY8XEaYk7qoK1iTT+MhCknEQbcnVoIX",
+	"KyhGxI3byyDiEAEfxySqja/KANiQVYDxK6rGj3EUUwWiigTLgsZU9XigOz6ZxyhhMgi1HKnzQyjaS1Ow",
+	"FyZZf0WS9PRj46ADUGbt7aAwokyU2kfDyfFoBElsI+zGJOKbHRzr8UhhX689UdsjfBsVMS0Un3/BW/Mv",
+	"d1ny74yQ+nGRES7vkQ2M8ipW0dbn4v6hrx/O+BvXKBiZVhLuYUjfk4dhYov1HI5H4rxmDtuCdJdSf27p",
+	"ouPoJul2dwg6lu/Q2MeM6IeguVlIcxYHKk9hiCXBnhDY1U7+uz0cj9rvT3/NjjoWELa+fROJS6UjEAyO",
+	"XYHuZIWp3zpqzf8fn9x3fJz1NfTJF04omtzSiHpfKCuZLlpyKtoNBearDBdC07OI8GqFY+rq8Kg4UJPX",
+	"XJDSNjv6onPQyfnEUU7Shv2O37Aoke6JAUMio0NpGXnnht2wK1BJKz9gmd/BtPAMxyNHAUO8XIx+aVNw",
+	"jD5vh1Fw/7CtoN3+LEb4P/8HwXYTFqteb9jQ91Ek3ck4UhiFMEMaAYC2Ew/dUizGSjcJye1Lux2PkPLw",
+	"4TesjX75xdhz8Xbrtvfql1+OSpDRrN32be8zaiMRRO2gE73ASokquz05n6ju+tbubvvbOKTbnMZk+yv8",
+	"99s2j2Ej2x7jonfxCzYLRcQNIo+rKYxWYRDFmMVHAgKUMcD8hkHyGCKifGBw5eTEUcIJ8tJXMJwhMPOj",
+	"GyaBLq7Fbe+XX5DIVfEZvhl5n9HW9fXoBEnXxVdHNwyhNlIBxkfoc5Psv5/lRyYWfabeZ8nhZSZBAaQk",
+	"DBo8vaa3/RxYn9EWLacCloS/DKJSgFqhKCalXQ8UfP/LLycB4ej84krgfBgjWB/+yy+ojRIOh0ms1x31",
+	"feU4gG5E9D3yAiJD7cg95fFNS5ysAIFFbBbES3N/HORCpcnPb0+vUAEPBQLxz+huSd2lGgH28/Pnz+AW",
+	"cMO+Apw3LerdtI7QTaP0zDctR31UXA/Zh1rBtBnQMvnmRL+5Yd8EDApl3xAcJxERR0NMfoUZXpAVICMQ",
+	"IrjRKFvAa3maEGW3hIl6KvB+FTAaB5FqIs8ZWDNFSnTRQlE/RVyg1VsgFWgZxDwMYnQbJC7wOdnAN8xy",
+	"xgrv39CI3MHSK04k//bKNKfmaCm8vSTYbwuHRhl1iSiTp0YXiMYM+w8xdfkNEwnnXaJubXU3vJ6ctHfa",
+	"xz5OOGk5MmaptYzjkB9tbwchYTxIIpd0gmixrb7m27mPhEtn7BPbLdIyXCBbPUiKAs2hWxxSSLvR6XZ2",
+	"Wk4LvNTFLSzJlaZV7srb9sjtaiGY1NDq7n56T1yR2ySNvZHrF2kXX22TRRjBUfF1WImTIT/YQG+YwSF2",
+	"kIiEF/uuu43xF8JlQW4ac2OX/mXEqtywiMyCQFTR0kkfaFzYOEcdJNljEgqvDTQnd2hFGcylIzYrkPX1",
+	"AjbysnlKzD1OvZdDHOEVETJBFfOYNRGZMATTqC6514H3oNkIIqunG55923C44ZlkueoYshxo2in7W543",
+	"Uy5BaT176LPf7doCs+XmETltweMPut0qGNIOt1/jbGz4pFf/yTXDSbwMIvon8SR7mKxWOHrIVl3jQOY1",
+	"HuMFrLi2pktH7jL28hg3xV4ukwnVo682YOfQ15StBBuiazkgrD5AWeoHWT+YeSiMyK3QS9BYXj8RUU3g",
+	"S0BL9qB5vBtmWoESFlMfPgPHfyZ8WonXQVdLkgGeSlSp+iPNJ8GC+IaptDz+A0pTUiDM0R3xfTGF93T9",
+	"DATEcBZz9QwD5hJ5JOVXcCR1CjY4wEx8Etwx8J7jnM5kfhZssC75/mRRrTVnUl4VP+WZzIH21z2T8NGg",
+	"/qPzIH4D6d4qDrHCovIh1o4JVYc4jfGvPcSZ3NEWde70YB1UDCBBLjRzxaEgN8yjeMECDjd1OfJBnFXA",
+	"Wp2XRVwuuBA2LC6VG7bCD+JmsV0sUiJTDJwYRMSHxUEWAq7O1Rp0zwW1/Fzobo0Lao7uzwODJTpagPBT",
+	"X3C8GBaVno0UC23HY0HibS6VHtssFlZqq7vcJYkjSm6V5u38aow4iYA1rMN0/sDcZRQw+ie5YfGS0Ai5",
+	"cNlw4SXVQSMmy88Kq4eQ/2f0j4TGFIVBIBJAikIt0K04Z6rwpR5d6OOFJ7SVwL8l8fnVWGt1noLr3wnZ",
+	"DOjWIZtccDWLZ8CatyRGuT6b4ktEeLwt93b7qy80eJKDGnnfBIFdY7LxH5S9JkWaYtVz2TFwQ4BCsnst",
+	"c41OOjdsCApGjnjiLhGW3cjit1LNHpHQ19lSeQxIgW6xnxBZmuFuGQCZ5cENI7ckelCfrhIeoxlBXDBl",
+	"IGuDa74EURqPUMDsPP21mM6ZsQyPRDGntt1Zbq2/GwHODSMMcD+Y+poArD0Sk0QwefMkwyud9+yvwtdI",
+	"9NF4nooohXCJekFFHErDAt6AioO6Qn3B0ZY0W4EhXDpmfxqe81cpJOIApJJDp3QKwFCndAY/JYmVoEEF",
+	"9oYIhTQsWS0ovVYvdMvDEmcwZEiRLvvv35wK5vY4Iljytqt1FbA6aGI+hyymUtDTSKKX4oYV62JJYS3M",
+	"m0YpR3cRNBTEFJBKEN00LspGTiWo+aynPxd7Wq5c8KOZU3ue2Tr66IqV9UqlO14IneVGl6GxInYtudv+",
+	"qn4oHqTKD/pEPK89CZLXJDjiQqgSrIhMHF0mfbLL58DYeg5A+xF5leSygACF1UXcxAi5SH8ZFYBc6IYY",
+	"49jZ0GvFfNZSwisrOftCwjjNhQhKKbEamYWGMtdPRGAnqeYVXwZV/qGEJU7x5Sjhs/CMTyGdOpVrA0Ff",
+	"CVElUU3KT0ofJ7OEGtYSR50FYYnTmYdTxwKpBMA+xZxwx/Dll7znnN4TD1IrRgSEfDGkVfgCnug9DJ2F",
+	"Jf1srKcJ3qNZT7XKyrz/kgyo3Gg3Xe4G6tcU4ba/fskWY52y4NpQEVShH86B4iDI1CHu6QyZOhUE2NiS",
+	"70Z+35sz/W4k2BzlJQjw5shtkN8cUv/FaK+Je8YpyEJr1h6EtJ5TAwndpI2G8a6Dro0XIKVlSWrCKABr",
+	"Anh35Ig05pwumKyrgbMwQBVgpKm3eP5fPLMmYJYmcI1whWUbaIMx+Z+PDFuCnjYhwr5K8GLsxougn6DB",
+	"JhAVuFevCWDkzugou2oW9FYkIdERabxTIaOn4/1c8nkhwvAHE8RN0cyQy7PNeFmJPBdY+RjCtv01Sfeg",
+	"qTRuXNraOzJHrrKA1TkQxRl2v5jcZj5YuQOep4VnyMUMJLQZ0WKvjYxJgJ6K2fXcQYakTSX5jNr/b5Dh",
+	"63HMqecMRTSwsIVnxEpwhkx6SoLbST0z+BKb/Q/VS5nAl6B6z8IBPpZMQpmEDQw0afRZZqmB2MgNTTM6",
+	"lu9n48rycSibSsVyVi8oDOtl1dsvfzfhvorbKv3gsEzVDt3oNHKUFbwr/ovfMCZLC0gvYaeYas7XGy5W",
+	"KkhiNKWSi1fZIqrtLjo67Meyc+sSyhXjfn4oXdsAPQ1GTgdEvRwLp7axiJbrCdL2V/hLcWx1lEn7sZbw",
+	"eAYJ5To2J5wn4Fb9HSuChb2fnVj9Va44cA2qwCGnqXtPmcZ10IV2qlEx2mFEOGEpkVP044bhKPW7qTaj",
+	"/Dh8en52LYtt/6kpmmbS/kq4q9izpiSQxzjeVk7sbcA5RvyGhhHVmivcXUASV4hDkkkdc/mWRJEZCFHy",
+	"/eCO30B8j0waIN3PZjLKx9NdIqHHq9KzXaZDHSsIfkLOrgzkOqwbwroQL1tSy+I9G9eG1WBy7d1sDRu4",
+	"WQl0UR5mzbBEeXbBh1S6hitnxtSEJrszp+zcsNR6lq/jCfUlc8Ulq5Ak50P3E+LHI3z8LJeqWrqcXewZ",
+	"MCTvjad73xRBtr/Kfz9g99szIIvpGmsECuVwhcZc1vOQvrBAUs7OTsaIEbpYzoJIPq/wkv4hPqwnekn+",
+	"cuj1Qiw98GJ5fMywYwOUTKv02iXTPBrqWFjxEdKgiX3ghTyCOAZ4IpG9nMZLym5YZkWQpfrgi2WQRPwI",
+	"LYM7ee/Jnu8wR9nE0ZaKvXVEjP5dEHnODQvxw0oYeCHvkSNoH/QCWQsdHRKQ5iumwjjiVRFFEek7zE3n",
+	"5zJeWAB8oQgYKySPOEY2FHpJlY0VnuwYvZOYX3mMlmkl+QbUfJmWl08PTFY9/gg8rJ2sLLsog+mgs+G5",
+	"c8OEBgiQ++P43JEnRqwm1iHi8C7tq81D4lLA/7RkvYqOuGGKZkD7NHQuET44pfLs0s4b01XVBQGor0rp",
+	"/3wMRQbcOjzNKv5L3oubJXGfg1SLXV7qRdJoNdQR/JWIxQnXVYaaUGgd4RswtKRciB0Gmh2hgBGkuhTl",
+	"ejMPABFDSwPm3DCBUsAyCAou9p8AQaUr4mR5lhSVlRRWxPROZMfS7UCmGpKaR6N70Yv4UteU8B+giQIk",
+	"H+lsJdbSDKjH+hlDcjVsL0Sii0A8gjqr3eB6kf8iMr6g5EXYG/miybP2wHXupRoqnsvEMQNVel4ZfySO",
+	"j67d5ui0GTfM2FWdW8dRxDWfUYl4abW0Dhr6oK9fLG+YPn1ZXiSs3CoBABMsys2A5TvqVVLvrALYT0i9",
+	"y/XObHgsWpnTfzayXe65aTikQCnp3ihRcgN5r5lzY1HiM11rbUGS8ibPHAakIwVw0RWo8SMcIo/12vzV",
+	"3G1fUO6zIME6Y7Mt4VMjY06IF5SpMreqNpyvc7qJXizG5ozoCJ7gFHhUaIsiotT60LMfLCjkfROIKDLG",
+	"zYv55gzetJIXmIipbIqaF/M5F1VN6iNrRe26786fPs33UO7ni923gBJc7YNGQbkv1dgnaeTI+7atNvgJ",
+	"6Kjzxyis2YIJJLFIehYuAwaM6ii40u9fmalpggjOcjFNjfYfk8KTigdZh4FPCTIQueGfH2PrG6oEsd8V",
+	"t59Cb/UWawR5YX6SS+26wIVKlrIRqmt2oJlF3SMxpj7xTBZEsZwYZfK9eQYMPuBIZLvLZEJReGgLDLDe",
+	"tjbDvoI2aZHaNIvu1mjswMUiXl+LKv2qfxMUeDnMZc5L80YUh6YrwmO8Crmd15Ar+fph5H3Hc1QIvPjO",
+	"UeVisMfIXXLT+YvZ+AtgPA7djSJlj6TsRYZgKwoUYed3VNRkc3LpvACV02xgXsa2NafkT7GLfSdK/j0R",
+	"9Sl2EL1ReptfjD5r7LDS57z5oxHCavPcc9LnPCYXCfQ7HHl3OEoR1VUWFZm41CO+yiS6Eo2U2kCpZWXE",
+	"p1D+mnQcZhrNsTg1YRCBXleYtSW1v9DID4HOMfQAnWX2I0W6DdlTkQI76ZaL/J1JdyG9zXc9ERsdBHUp",
+	"vjTNLoDxuCOgDHzbyuD2FOKdtxXqDtNy7SWafMPe5TPtcp2mHMVkFQYRjtJ0lUaq8oXM5609WqWWVRQM",
+	"j4jIoYn9SulRDfhRT/ZvQvUL034S9U8R5cXIfyE/s91oV+d4HTACwuAqiMhaxK1ARIG+ej2RixmEE8ls",
+	"dDBPRSfSDMGzfHl7ZUlJOF4QWOY4om5lZLuE+Lkw93uZQQSQGYK9iBnkOdBcu27n0fznN4TIDWh2Nja/",
+	"Fba/qr9qwvjGJFphJtUrXhrSVwDKQRG5DUQuYGWDl0eqIgYvv6tPIdkNiwAqMEUeVTlPVdUjxMKUq3Kt",
+	"pyvSKuK4Y+CrZBFbR60koZ6lZmajkD819zXxfi8TvFfY2ApC/Bh+WrH2mpsuDGSNKngpPHkB7PgO1HIj",
+	"IqlPyEtzwAW0kCEnlSRPykxrGFwhNqE7Vd5KpLbVl362yiIJbZhmtlWyWQd9WlKfyGRQhdbCp4KCk9qK",
+	"CpILfwaRFOXED2lfuZggzPgdiSRze8N2uztoQiLB5V8zfIupnzpzQtoqCliAmUuAISeIMh4TXJX5NjNd",
+	"TuQ6fE8tcGGstclsLUusduqb09rt7pR36qp6Zygz18Wm6EpB06Osse6qgjsuiWIppDd19b46myDjq2Ja",
+	"ZJGvmKvS8AKLsE89Gj+gkEQ08G4YbDFQEt4BsZwHPtG+Odr7i/jztoqKN0b6F8KKDRUcLoUQQUI8aeOI",
+	"hPtYvCQrWfgCozCZ+dRN3RZSoeoORww0urW4dJwN/WMQyhzQFj7ginhIY63NzXsmY2xF7xWIVBm/DnlU",
+	"gD0an37IIYu7xJSlBCaM6C08hbxz8GwlyozkEeqGCYwSW6urNqQgRkQgDhe93ZGZxL7IQTwwnMc4gkzz",
+	"XhSEMiZFJprnxA0YADmPFUXUi1QdjVWNHN9B7LCN9SLhVI0x1HgtKDb2/RdLF3+pMmRvhtAGZfSAOuIY",
+	"+8GiIWU0VliqO40HCEZdBBElMtphwYQsPQNel4TgAO5+ITGsWiiRtpOrpwQoHLtLUWPEHCWr7yVIn4xL",
+	"lYlBFOy6mhcHGgjYr1xCReWuednJwaoSHY+MknbHalG+p+JyPNKjWNDsZDwqLq1s+jwksKr7DGXUzuTx",
+	"xSxdhxeLiCxA2G57mC9nAY68BkgE0EZkSRgHQp9+aUbK5HXtHwIhYsp7ISu390kUFlRRdkITkz6Nibtk",
+	"gR8sHpBHeRzRWaItn2ZnOUOU+Hh4Lt/R+AF+p37Vyv1b+eea9RAxXMqeTousXIMRYZ7otQLVhunKnaQL",
+	"92hnrQI3lsZpaNIfzDXcwELotKZbOqXOwd6g20X/F/UHMrIjLYH5RyJLQit5SPUxkb22TCFIddU6En0Z",
+	"5VrVbyUUCY0Fib6zVGRb241sAxaEfDH5KDtidriq3dJt5zVUicaapSsxT4c1a51MTKFUp/nMczfM/JpL",
+	"dPSlr47sqkrBPxy/aN65RmVyFYzlSrmP0MYPxy+egy4DwUCn8Yb558rYUsxDtyJAmSpz0OlF/amCAxRQ",
+	"L8KQpljWMFeJ3saXzVeSQmHFpRrKtP0Vhxslm2MWvJPy0yyhftymTD5Dy8AXUbVFwsZv2AbZ5J6Go/WW",
+	"RI1uTTPJ6cX+6fTK67GgQqBWwkwhI5wiG8VscJZ9rxBnf/Sm/T2pkM4v8uOp0LPkGHkU2Zotwm3pTd9Q",
+	"dn5zeVkIEBFR11HCOHr9dizw/WIyfoMChoDru5PVsUQBOxEi4j0wvAJpOUjgQQe91a204q/QQimkxWs0",
+	"6A7QeRAjsQ5VUrD8/lJ+Lj2KfsIgozyYaUW4jbMCFFYrtzkv5xe0FqoMQ9XsG1LUMvIpbl5hmrw31Q8U",
+	"EandhAYKjhvmYbIKGHfSyuOcxBwRDqkoKF8ST2AxT2NKNTpj73+wS5hL19Vde27M+w4Vldcg3Y+jyI9B",
+	"fbOmWj3K/2Wo9uYHpYKSz1XN97ao+U5JUxl5nqsVT/PRVWv85kZSC8Nl1f8wIh6ZU6YKYyrzj+6ySlLW",
+	"derHGuSfWGLOwfrwLIJzaelfToAug5Khnp55Y0E639nDOiy6lDSHIx4kEfgoe4THlCmXNVh7L/FVkPJo",
+	"nHoh505JtcNaYc9+Kkqch+1FGOMiSjeU0gvb+xfzTitCb8XzpjR2+6vs5VEuaQVIxHk4D2JyhH4NEp0h",
+	"XjY36WtKp9uyLGxmaufoAT6U21StAniWU1EvVCrEblwcziL/r0G1ZzkAp1EURGtLaq/dhIeX1E80wuO6",
+	"unOGNqIRNqrIj+fBRgnFy2DjP/Q8Y6df+pCNmHA9QhTWDAVRDbI9vCS7/hy3xzakV2mobknH+1OcqGBu",
+	"YaSMwC4UG3rjGyY+6qD/BIyg0QlHHp3PwUWRxHeEMPExd8DRQDq76g/lYHVMO/T6l+DYAdDn5dfF+vwE",
+	"zPqfagua46AwvLptjzUVD+UH6OR8ojOPNpQPJ1Lnl/bCPDD8Zv2IqHB+hIYOGg6HQwcdnw8/nDrow78d",
+	"dD5x0OTyo4Ou/n1VhYYn55PLNBXqT4uDKZTPgoDGLrwc9plAGPF+55PG8mEJp9bh0ZsgAlzQQzppfF4Y",
+	"0SCi8YOD7iCfbCyFRMA5ldC9Wi7MduXnUs5psF6EezBQtaEgmG3gy/IMz2j7NaZUxO1airr9VX7ZuMyY",
+	"eQCiYJX6pVTIbU/F2nomWWGfVWQbNBTZikjxMtLRmn3cQCbK9WJV+P/oLfn7Eh0trfzFic6zSCGPoFIy",
+	"Na8fLLaxt6KsrX1EN0jxmpZ8QqKL1M0UbeHEo/ErSK92hO6Wga6Wgu6WWF3LUKVeZmZjsfCtxhHJykQx",
+	"ckckX8tjJ5fDVeRtjaA3ebvroP9K3zuAbKgAOwt+MutbEboXilEug/GIIOUCDhC5r3+pnK2FKeR9x2V2",
+	"T0ChykOlshALv/+G4lScixVoJkldFb+Bg5iF8zto5gfuF6oz0GtLIvSYBq/mghGCSKevqTpGashLMbOf",
+	"WLwy4HwWASu3PS+HmHkwbPEMzQQts59GVrg0eCXG0YIA7XalJQ4QSz7TqNPUBmdu0U9FjA3AXoT1yeFu",
+	"Q4nL3NC/mN0tB/oGITomkd3+Cv88ythWGN4mXz0dUxuw8wL+p5jEyijwMhJW7X5uIGfl6FTRA8Ymd/3w",
+	"rfp7kx8te1WQn7+Z9FVPyeAr4iaRkK9++9oahvQ9eYD6Na2j334HjJLxzxJf89M8CyBDtczGkAldLaeV",
+	"RH7rqLWM45AfbW9/zd592w6j4P5hW2WHajmtWxxRyNLA9e6oTsxAt1bC6Jx2fBiuVSpMooPxgwjcblR+",
+	"VuCQHoIkKkGHtkhn0XGQ0aWDeof9Tm/voNPr9F7Bfv6eLlWJztGYoBVmeEFWoi4Ik0ndgDSkp59ncXwT",
+	"lXr6a0UaB5WUrtDjKmA0DkRSk7SnkzSNZImRMnPbwpYLDlt0hHOZZ7POjtOcwcXORPmiUqaQDL6sD50t",
+	"pNzHpKQ0t30PSoDyt28KDlmFlSlSXNWX/srSoSmS5IQOG0yqsaWbE1vkbH6vkIdjnPWVxQiWe7tOawxw",
+	"tJVlOwujYE59wl+ZZYeyIgRZ30YGews+ZMgutB0SE2wCpEbSVH6sRtSts+H59sez4fmrqj1QLW0QfSoW",
+	"O4aiDFJ3ojFVTZbywC/0q4uVl8JxLBGTCZdBkdwNQlnKFM2iAHsuFkfU2Jxx5fKdFNxct8xYgVe5YIEo",
+	"YQyavLm8zLrW7q/Va2nJ3ZKdzIwEfvv92/83AA6LBzen4wEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,101 @@
+package network
+
+import (
+	"context"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+// SiteHealth is the health of the subsystems of a site. Subsystems the controller
+// does not report are nil.
+type SiteHealth struct {
+	// WAN is the gateway uplink: gateway counts, public IP and ISP.
+	WAN *SubsystemHealth
+
+	// WWW is the Internet connection: latency, uptime, drops and throughput.
+	WWW *SubsystemHealth
+
+	// LAN is the wired network: switches and wired clients.
+	LAN *SubsystemHealth
+
+	// WLAN is the wireless network: access points and wireless clients.
+	WLAN *SubsystemHealth
+
+	// VPN is the remote access and site-to-site VPN.
+	VPN *SubsystemHealth
+}
+
+// Status returns the worst status of the reported subsystems: HealthError if any
+// has an error, then HealthWarning, then HealthOK. Subsystems with an unknown
+// status, typically ones that are not set up, are ignored; HealthUnknown is
+// returned only when no subsystem has a known status.
+func (h *SiteHealth) Status() HealthStatus {
+	status := HealthUnknown
+	for _, s := range []*SubsystemHealth{h.WAN, h.WWW, h.LAN, h.WLAN, h.VPN} {
+		if s == nil || s.Status == nil {
+			continue
+		}
+		switch *s.Status {
+		case HealthError:
+			return HealthError
+		case HealthWarning:
+			status = HealthWarning
+		case HealthOK:
+			if status == HealthUnknown {
+				status = HealthOK
+			}
+		case HealthUnknown:
+		}
+	}
+	return status
+}
+
+// GetSiteHealth retrieves the health of the WAN, Internet, LAN, WLAN and VPN
+// subsystems of a site. It is a single lightweight request, suited to frequent
+// polling by status pages where GetAggregatedDashboard would be too heavy.
+//
+// Example:
+//
+//	health, err := client.GetSiteHealth(ctx, "default")
+//	if err == nil && health.Status() != network.HealthOK {
+//	    log.Printf("site is %s", health.Status())
+//	}
+func (c *APIClient) GetSiteHealth(ctx context.Context, site Site) (*SiteHealth, error) {
+	ctx = middleware.WithOperation(ctx, "GetSiteHealth")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.GetSiteHealthWithResponse(ctx, site)
+	var data *SiteHealthResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	result, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get health of site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+
+	health := &SiteHealth{}
+	for i := range result.Data {
+		subsystem := &result.Data[i]
+		switch subsystem.Subsystem {
+		case HealthWAN:
+			health.WAN = subsystem
+		case HealthWWW:
+			health.WWW = subsystem
+		case HealthLAN:
+			health.LAN = subsystem
+		case HealthWLAN:
+			health.WLAN = subsystem
+		case HealthVPN:
+			health.VPN = subsystem
+		}
+	}
+	return health, nil
+}
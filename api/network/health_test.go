@@ -0,0 +1,79 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestGetSiteHealth(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/proxy/network/api/s/"+testSiteInternal+"/stat/health", testAPIKey,
+		testdata.LoadFixture(t, "dashboard/health.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	health, err := client.GetSiteHealth(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+
+	require.NotNil(t, health.WLAN)
+	assert.Equal(t, FlexibleInt(3), *health.WLAN.NumAP)
+	assert.Equal(t, FlexibleInt(38), *health.WLAN.NumUser)
+
+	require.NotNil(t, health.WAN)
+	assert.Equal(t, "203.0.113.10", *health.WAN.WANIP)
+	assert.Equal(t, FlexibleInt(1100000), *health.WAN.RxBytesRate)
+
+	require.NotNil(t, health.WWW)
+	assert.Equal(t, HealthWarning, *health.WWW.Status)
+	assert.Equal(t, FlexibleInt(87), *health.WWW.Latency)
+	assert.Equal(t, FlexibleInt(864000), *health.WWW.Uptime)
+	assert.InDelta(t, 412.3, *health.WWW.ThroughputDown, 1e-9)
+
+	require.NotNil(t, health.LAN)
+	assert.Equal(t, FlexibleInt(2), *health.LAN.NumSwitch)
+
+	require.NotNil(t, health.VPN)
+	assert.Equal(t, HealthWarning, health.Status())
+}
+
+func TestSiteHealthStatus(t *testing.T) {
+	t.Parallel()
+
+	subsystem := func(status HealthStatus) *SubsystemHealth {
+		return &SubsystemHealth{Status: &status}
+	}
+
+	tests := []struct {
+		name   string
+		health SiteHealth
+		want   HealthStatus
+	}{
+		{name: "nothing reported", health: SiteHealth{}, want: HealthUnknown},
+		{name: "only unknown", health: SiteHealth{VPN: subsystem(HealthUnknown)}, want: HealthUnknown},
+		{name: "unknown ignored", health: SiteHealth{WAN: subsystem(HealthOK), VPN: subsystem(HealthUnknown)}, want: HealthOK},
+		{name: "warning", health: SiteHealth{WAN: subsystem(HealthOK), WLAN: subsystem(HealthWarning)}, want: HealthWarning},
+		{
+			name:   "error wins",
+			health: SiteHealth{WAN: subsystem(HealthError), WWW: subsystem(HealthWarning), LAN: subsystem(HealthOK)},
+			want:   HealthError,
+		},
+		{name: "missing status", health: SiteHealth{LAN: &SubsystemHealth{}}, want: HealthUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.health.Status())
+		})
+	}
+}
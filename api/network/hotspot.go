@@ -0,0 +1,140 @@
+package network
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"time"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+// HotspotStats summarizes how guests used the hotspot of a site over a time range.
+type HotspotStats struct {
+	// Range is the time window the statistics cover.
+	Range TimeRange
+
+	// Authorizations is the number of guest authorizations that started within Range.
+	Authorizations int
+
+	// ByMethod counts the authorizations by how the guest was authorized.
+	ByMethod map[GuestAuthMethod]int
+
+	// Guests is the number of distinct guest devices.
+	Guests int
+
+	// VoucherRedemptions is the number of authorizations granted by a voucher.
+	VoucherRedemptions int
+
+	// Vouchers is the number of distinct vouchers redeemed; a multi-use voucher counts once.
+	Vouchers int
+
+	// TxBytes is the traffic sent by guests.
+	TxBytes int64
+
+	// RxBytes is the traffic received by guests.
+	RxBytes int64
+
+	// Revenue is the amount paid for payment authorizations, by currency.
+	Revenue map[string]float64
+}
+
+// NewGuestAuthorizationsRequest builds a request covering every authorization that
+// started within r. The controller filters by whole hours back from now, so the
+// result may include older authorizations; SummarizeHotspot drops them.
+func NewGuestAuthorizationsRequest(r TimeRange) *GuestAuthorizationsRequest {
+	within := max(int(math.Ceil(time.Since(r.Start).Hours())), 1)
+	return &GuestAuthorizationsRequest{Within: within}
+}
+
+// ListGuestAuthorizations retrieves the hotspot guest authorizations of a site.
+// Use NewGuestAuthorizationsRequest to select a time range.
+func (c *APIClient) ListGuestAuthorizations(ctx context.Context, site Site, request *GuestAuthorizationsRequest) ([]GuestAuthorization, error) {
+	ctx = middleware.WithOperation(ctx, "ListGuestAuthorizations")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListGuestAuthorizationsWithResponse(ctx, site, *request)
+	var data *GuestAuthorizationsResponse
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	guests, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list guest authorizations in site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return guests.Data, nil
+}
+
+// GetHotspotStats reports voucher redemptions, guest authorizations, payments and
+// guest traffic of a site for the authorizations that started within r.
+//
+// Example:
+//
+//	stats, err := client.GetHotspotStats(ctx, siteID, network.LastDuration(7*24*time.Hour))
+//	if err == nil {
+//	    fmt.Printf("%d guests, %d vouchers redeemed\n", stats.Guests, stats.VoucherRedemptions)
+//	}
+func (c *APIClient) GetHotspotStats(ctx context.Context, siteID SiteId, r TimeRange) (*HotspotStats, error) {
+	auths, err := c.ListGuestAuthorizations(ctx, siteID.String(), NewGuestAuthorizationsRequest(r))
+	if err != nil {
+		return nil, err
+	}
+	return SummarizeHotspot(auths, r), nil
+}
+
+// SummarizeHotspot computes hotspot statistics from guest authorizations, counting
+// those that started within r. Authorizations without a method count as GuestAuthNone.
+func SummarizeHotspot(auths []GuestAuthorization, r TimeRange) *HotspotStats {
+	stats := &HotspotStats{
+		Range:    r,
+		ByMethod: make(map[GuestAuthMethod]int),
+		Revenue:  make(map[string]float64),
+	}
+	guests := make(map[string]struct{})
+	vouchers := make(map[string]struct{})
+
+	for i := range auths {
+		auth := &auths[i]
+		start := time.Unix(auth.Start, 0)
+		if start.Before(r.Start) || start.After(r.End) {
+			continue
+		}
+
+		stats.Authorizations++
+		method := valueOrZero(auth.AuthorizedBy)
+		if method == "" {
+			method = GuestAuthNone
+		}
+		stats.ByMethod[method]++
+
+		mac, err := NormalizeMAC(auth.Mac)
+		if err != nil {
+			mac = auth.Mac
+		}
+		guests[mac] = struct{}{}
+
+		voucher := cmp.Or(valueOrZero(auth.VoucherID), valueOrZero(auth.VoucherCode))
+		if method == GuestAuthVoucher || voucher != "" {
+			stats.VoucherRedemptions++
+			if voucher != "" {
+				vouchers[voucher] = struct{}{}
+			}
+		}
+		if auth.Amount != nil && *auth.Amount > 0 {
+			stats.Revenue[valueOrZero(auth.Currency)] += *auth.Amount
+		}
+		stats.TxBytes += valueOrZero(auth.TxBytes).Int64()
+		stats.RxBytes += valueOrZero(auth.RxBytes).Int64()
+	}
+
+	stats.Guests = len(guests)
+	stats.Vouchers = len(vouchers)
+	return stats
+}
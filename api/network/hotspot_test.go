@@ -0,0 +1,85 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+const testGuestPath = "/proxy/network/api/s/" + testSiteInternal + "/stat/guest"
+
+func testHotspotWindow() TimeRange {
+	return TimeRange{Start: time.Unix(1760590000, 0), End: time.Unix(1760700000, 0)}
+}
+
+func TestGetHotspotStats(t *testing.T) {
+	t.Parallel()
+
+	window := testHotspotWindow()
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		testSitesPath: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+		},
+		testGuestPath: func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+
+			var body GuestAuthorizationsRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.GreaterOrEqual(t, float64(body.Within), time.Since(window.Start).Hours())
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "hotspot/guest_authorizations.json")))
+		},
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	stats, err := client.GetHotspotStats(context.Background(), testSiteID, window)
+	require.NoError(t, err)
+
+	assert.Equal(t, window, stats.Range)
+	assert.Equal(t, 4, stats.Authorizations, "authorizations before the window are dropped")
+	assert.Equal(t, map[GuestAuthMethod]int{
+		GuestAuthVoucher:  2,
+		GuestAuthPayment:  1,
+		GuestAuthPassword: 1,
+	}, stats.ByMethod)
+	assert.Equal(t, 3, stats.Guests, "MAC addresses are compared normalized")
+	assert.Equal(t, 2, stats.VoucherRedemptions)
+	assert.Equal(t, 1, stats.Vouchers, "a multi-use voucher counts once")
+	assert.Equal(t, int64(55574528), stats.TxBytes)
+	assert.Equal(t, int64(555745280), stats.RxBytes)
+	assert.InDelta(t, 4.99, stats.Revenue["USD"], 1e-9)
+}
+
+func TestSummarizeHotspotEmpty(t *testing.T) {
+	t.Parallel()
+
+	stats := SummarizeHotspot([]GuestAuthorization{{Id: "1", Mac: "aa:bb:cc:00:00:01", Start: 1760600000}}, testHotspotWindow())
+	assert.Equal(t, 1, stats.Authorizations)
+	assert.Equal(t, map[GuestAuthMethod]int{GuestAuthNone: 1}, stats.ByMethod)
+	assert.Zero(t, stats.VoucherRedemptions)
+	assert.Empty(t, stats.Revenue)
+
+	stats = SummarizeHotspot(nil, testHotspotWindow())
+	assert.Zero(t, stats.Authorizations)
+	assert.NotNil(t, stats.ByMethod)
+}
+
+func TestNewGuestAuthorizationsRequest(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 24, NewGuestAuthorizationsRequest(LastDuration(24*time.Hour-time.Minute)).Within)
+	assert.Equal(t, 1, NewGuestAuthorizationsRequest(LastDuration(0)).Within)
+}
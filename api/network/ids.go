@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/lexfrei/go-unifi/internal/ids"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// ParseSiteID parses the UUID of a site, as found in SiteListItem.Id. Anything else is
+// an error matching unifierr.ErrValidation; methods taking a Site accept site names and
+// internal references as well, and SiteResolver resolves them to IDs.
+func ParseSiteID(id string) (SiteId, error) {
+	return parseUUID("site", id, "resolve site names and internal references with SiteResolver().SiteID")
+}
+
+// ParseDeviceID parses the UUID of a device, as found in DeviceListItem.Id. Anything
+// else is an error matching unifierr.ErrValidation that names the likely mistake, e.g.
+// "device ID must be a UUID, got MAC address ...: use FindDeviceByMAC".
+func ParseDeviceID(id string) (DeviceId, error) {
+	return parseUUID("device", id, "use FindDeviceByMAC")
+}
+
+// ParseClientID parses the UUID of a client, as found in ClientListItem.Id. Anything
+// else is an error matching unifierr.ErrValidation that names the likely mistake.
+func ParseClientID(id string) (ClientId, error) {
+	return parseUUID("client", id, "use FindClientByMAC")
+}
+
+func parseUUID(kind, id, macHint string) (openapi_types.UUID, error) {
+	var parsed openapi_types.UUID
+	if !ids.IsUUID(id) || parsed.UnmarshalText([]byte(id)) != nil {
+		return parsed, invalidID(kind, "a UUID", id, macHint)
+	}
+	return parsed, validateUUID(kind, parsed)
+}
+
+// validateUUID rejects the zero UUID, which is what an ID field that was never set holds.
+func validateUUID(kind string, id openapi_types.UUID) error {
+	if id == (openapi_types.UUID{}) {
+		return errors.Wrapf(unifierr.ErrValidation, "%s ID is required, got the zero UUID", kind)
+	}
+	return nil
+}
+
+// validateObjectID rejects IDs of legacy objects, such as DNS records and WLANs, that
+// are empty or are obviously of another kind: a MAC address or a UUID. Other values are
+// left for the controller to judge.
+func validateObjectID(kind, id string) error {
+	switch {
+	case id == "":
+		return errors.Wrapf(unifierr.ErrValidation, "%s ID is required", kind)
+	case ids.IsMAC(id), ids.IsUUID(id):
+		return invalidID(kind, "an object ID such as 5f8a1b2c3d4e5f6a7b8c9d01", id, "")
+	}
+	return nil
+}
+
+// invalidID describes an ID of the wrong format. macHint, if set, tells how to look up
+// the object by MAC address, the most common mistake.
+func invalidID(kind, want, id, macHint string) error {
+	msg := fmt.Sprintf("%s ID must be %s, got %s", kind, want, ids.Describe(id))
+	if macHint != "" && ids.IsMAC(id) {
+		msg += ": " + macHint
+	}
+	return errors.Wrap(unifierr.ErrValidation, msg)
+}
+
+// FindDeviceByMAC returns the device of a site with the given MAC address, in any format
+// accepted by NormalizeMAC. Its ID is the one methods such as GetDeviceByID take.
+// Errors for unknown devices match unifierr.ErrNotFound.
+func (c *APIClient) FindDeviceByMAC(ctx context.Context, siteID SiteId, mac string) (*DeviceListItem, error) {
+	mac, err := NormalizeMAC(mac)
+	if err != nil {
+		return nil, err
+	}
+	for device, err := range c.AllSiteDevices(ctx, siteID) {
+		if err != nil {
+			return nil, err
+		}
+		normalized, _ := NormalizeMAC(device.MacAddress)
+		if normalized == mac {
+			return &device, nil
+		}
+	}
+	return nil, errors.Wrapf(unifierr.ErrNotFound, "no device with MAC address %s in site %s", mac, siteID)
+}
+
+// FindClientByMAC returns the connected client of a site with the given MAC address, in
+// any format accepted by NormalizeMAC. Its ID is the one GetClientByID takes.
+// Errors for clients that are not connected match unifierr.ErrNotFound.
+func (c *APIClient) FindClientByMAC(ctx context.Context, siteID SiteId, mac string) (*ClientListItem, error) {
+	mac, err := NormalizeMAC(mac)
+	if err != nil {
+		return nil, err
+	}
+	for client, err := range c.AllSiteClients(ctx, siteID) {
+		if err != nil {
+			return nil, err
+		}
+		normalized, _ := NormalizeMAC(client.MacAddress)
+		if normalized == mac {
+			return &client, nil
+		}
+	}
+	return nil, errors.Wrapf(unifierr.ErrNotFound, "no connected client with MAC address %s in site %s", mac, siteID)
+}
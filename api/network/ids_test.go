@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestParseDeviceID(t *testing.T) {
+	t.Parallel()
+
+	id, err := ParseDeviceID("6204b587-7215-235b-d068-f96ca12eab52")
+	require.NoError(t, err)
+	assert.Equal(t, "6204b587-7215-235b-d068-f96ca12eab52", id.String())
+
+	tests := []struct {
+		id      string
+		wantErr string
+	}{
+		{id: "aa:bb:cc:99:ea:6b", wantErr: `device ID must be a UUID, got MAC address "aa:bb:cc:99:ea:6b": use FindDeviceByMAC`},
+		{id: "AA-BB-CC-99-EA-6B", wantErr: `got MAC address "AA-BB-CC-99-EA-6B": use FindDeviceByMAC`},
+		{id: testRecordID, wantErr: `device ID must be a UUID, got object ID "` + testRecordID + `"`},
+		{id: "", wantErr: "device ID must be a UUID, got an empty string"},
+		{id: "00000000-0000-0000-0000-000000000000", wantErr: "device ID is required, got the zero UUID"},
+		{id: "{6204b587-7215-235b-d068-f96ca12eab52}", wantErr: "device ID must be a UUID"},
+	}
+	for _, tt := range tests {
+		_, err := ParseDeviceID(tt.id)
+		require.ErrorIs(t, err, unifierr.ErrValidation, tt.id)
+		assert.Contains(t, err.Error(), tt.wantErr)
+	}
+
+	_, err = ParseClientID("aa:bb:cc:14:01:56")
+	require.ErrorContains(t, err, "use FindClientByMAC")
+	_, err = ParseSiteID("default")
+	require.ErrorContains(t, err, `site ID must be a UUID, got "default"`)
+}
+
+func TestIDValidationSkipsRequest(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	ctx := context.Background()
+
+	_, err := client.GetDeviceByID(ctx, testSiteID, DeviceId{})
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+	assert.Contains(t, err.Error(), "device ID is required")
+
+	_, err = client.ListSiteClients(ctx, SiteId{}, nil)
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+
+	err = client.DeleteDNSRecord(ctx, testSiteInternal, "aa:bb:cc:99:ea:6b")
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+	assert.Contains(t, err.Error(), `DNS record ID must be an object ID such as 5f8a1b2c3d4e5f6a7b8c9d01, got MAC address "aa:bb:cc:99:ea:6b"`)
+
+	_, err = client.UpdateTrafficRule(ctx, testSiteInternal, testSiteID.String(), &TrafficRuleInput{})
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+	assert.Contains(t, err.Error(), "got UUID")
+
+	err = client.SetWLANClientIsolation(ctx, testSiteInternal, "", true)
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+
+	assert.Zero(t, requests.Load(), "invalid IDs must not reach the controller")
+}
+
+func TestFindDeviceByMAC(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites/"+testSiteID.String()+"/devices", testAPIKey,
+		testdata.LoadFixture(t, "devices/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	device, err := client.FindDeviceByMAC(context.Background(), testSiteID, "AA-BB-CC-99-EA-6B")
+	require.NoError(t, err)
+	assert.Equal(t, "6204b587-7215-235b-d068-f96ca12eab52", device.Id.String())
+
+	_, err = client.FindDeviceByMAC(context.Background(), testSiteID, "aa:bb:cc:00:00:01")
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+	_, err = client.FindDeviceByMAC(context.Background(), testSiteID, "not-a-mac")
+	require.ErrorIs(t, err, ErrInvalidMAC)
+}
+
+func TestFindClientByMAC(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites/"+testSiteID.String()+"/clients", testAPIKey,
+		testdata.LoadFixture(t, "clients/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	found, err := client.FindClientByMAC(context.Background(), testSiteID, "aa:bb:cc:14:01:56")
+	require.NoError(t, err)
+	assert.Equal(t, "7fe038e8-946b-fa53-7335-6c00bee84657", found.Id.String())
+}
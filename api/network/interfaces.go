@@ -10,13 +10,20 @@ import (
 // This interface enables consumers to create mock implementations for testing.
 //
 // The Network API provides access to a local UniFi controller for managing:
-//   - Sites and devices
+//   - Sites and devices, including adoption
 //   - Network clients
 //   - DNS records
 //   - Firewall policies
 //   - Traffic rules (QoS)
 //   - Hotspot vouchers
+//   - User groups (bandwidth profiles)
+//   - Networks (LANs and VLANs) and site-to-site VPNs
+//   - Dynamic routing (BGP and OSPF)
+//   - WLAN MAC filtering and client isolation
+//   - Access point groups
 //   - Dashboard statistics
+//   - Admin activity (audit) log
+//   - Client connection history
 //
 // All methods mirror the corresponding methods in APIClient to ensure
 // compatibility and ease of use.
@@ -37,7 +44,7 @@ import (
 //	}
 //
 //nolint:revive // NetworkAPIClient is intentionally explicit to avoid confusion with APIClient struct
-type NetworkAPIClient interface { //nolint:interfacebloat // This interface mirrors the full API client with 22 methods
+type NetworkAPIClient interface { //nolint:interfacebloat // This interface mirrors the full API client with 63 methods
 	// Sites operations
 
 	// ListSites retrieves a list of all sites configured on the controller.
@@ -51,6 +58,21 @@ type NetworkAPIClient interface { //nolint:interfacebloat // This interface mirr
 	// GetDeviceByID retrieves detailed information about a specific device.
 	GetDeviceByID(ctx context.Context, siteID SiteId, deviceID DeviceId) (*Device, error)
 
+	// GetDeviceNeighbors retrieves the LLDP/CDP neighbors seen on the ports of a device.
+	GetDeviceNeighbors(ctx context.Context, siteID SiteId, deviceID DeviceId) ([]LLDPNeighbor, error)
+
+	// GetPortStates retrieves the link, spanning tree and error counter state of every port of a device.
+	GetPortStates(ctx context.Context, siteID SiteId, deviceID DeviceId) ([]SwitchPortState, error)
+
+	// ListRegulatoryChannels retrieves the channels the site country allows for each radio band and width.
+	ListRegulatoryChannels(ctx context.Context, site Site) ([]RegulatoryChannels, error)
+
+	// ListPendingAdoptionDevices lists the devices of a site that wait for adoption.
+	ListPendingAdoptionDevices(ctx context.Context, site Site) ([]LegacyDevice, error)
+
+	// AdoptWithSettings adopts a device and applies its initial name, IP address and port profile.
+	AdoptWithSettings(ctx context.Context, site Site, mac string, settings *AdoptionSettings) (*LegacyDevice, error)
+
 	// Clients operations
 
 	// ListSiteClients retrieves a list of all clients for a specific site.
@@ -59,6 +81,15 @@ type NetworkAPIClient interface { //nolint:interfacebloat // This interface mirr
 	// GetClientByID retrieves detailed information about a specific client.
 	GetClientByID(ctx context.Context, siteID SiteId, clientID ClientId) (*NetworkClient, error)
 
+	// BlockClient blocks the client with the given MAC address from connecting to the site.
+	BlockClient(ctx context.Context, site Site, mac string) error
+
+	// UnblockClient allows a previously blocked client to connect to the site again.
+	UnblockClient(ctx context.Context, site Site, mac string) error
+
+	// KickClient disconnects a wireless client so that it reassociates.
+	KickClient(ctx context.Context, site Site, mac string) error
+
 	// Hotspot vouchers operations
 
 	// ListHotspotVouchers retrieves a list of all hotspot vouchers for a specific site.
@@ -73,15 +104,20 @@ type NetworkAPIClient interface { //nolint:interfacebloat // This interface mirr
 	// DeleteHotspotVoucher permanently deletes a hotspot voucher.
 	DeleteHotspotVoucher(ctx context.Context, siteID SiteId, voucherID openapi_types.UUID) error
 
+	// ListGuestAuthorizations retrieves the hotspot guest authorizations of a site.
+	ListGuestAuthorizations(ctx context.Context, site Site, request *GuestAuthorizationsRequest) ([]GuestAuthorization, error)
+
 	// DNS records operations
 
 	// ListDNSRecords lists all static DNS records for a site.
 	ListDNSRecords(ctx context.Context, site Site) ([]DNSRecord, error)
 
 	// CreateDNSRecord creates a new static DNS record.
+	// The record is validated first; see DNSRecordInput.Validate.
 	CreateDNSRecord(ctx context.Context, site Site, record *DNSRecordInput) (*DNSRecord, error)
 
 	// UpdateDNSRecord updates an existing DNS record.
+	// The record is validated first; see DNSRecordInput.Validate.
 	UpdateDNSRecord(ctx context.Context, site Site, recordID RecordId, record *DNSRecordInput) (*DNSRecord, error)
 
 	// DeleteDNSRecord deletes a DNS record.
@@ -101,6 +137,9 @@ type NetworkAPIClient interface { //nolint:interfacebloat // This interface mirr
 	// DeleteFirewallPolicy permanently deletes a firewall policy.
 	DeleteFirewallPolicy(ctx context.Context, site Site, policyID PolicyId) error
 
+	// ListFirewallZones lists the firewall zones of a site.
+	ListFirewallZones(ctx context.Context, site Site) ([]FirewallZone, error)
+
 	// Traffic rules operations
 
 	// ListTrafficRules lists all traffic rules for a site.
@@ -115,8 +154,115 @@ type NetworkAPIClient interface { //nolint:interfacebloat // This interface mirr
 	// DeleteTrafficRule permanently deletes a traffic rule.
 	DeleteTrafficRule(ctx context.Context, site Site, ruleID RuleId) error
 
+	// GetDPIApplicationCatalog retrieves the applications and categories recognized by deep packet inspection.
+	GetDPIApplicationCatalog(ctx context.Context) (*DPICatalog, error)
+
+	// System log operations
+
+	// ListAdminActivityLog retrieves one page of the controller's admin activity (audit) log.
+	ListAdminActivityLog(ctx context.Context, site Site, request *AdminActivityLogRequest) (*AdminActivityLogResponse, error)
+
+	// User groups operations
+
+	// ListUserGroups lists all user groups (bandwidth profiles) for a site.
+	ListUserGroups(ctx context.Context, site Site) ([]UserGroup, error)
+
+	// CreateUserGroup creates a new user group.
+	CreateUserGroup(ctx context.Context, site Site, group *UserGroupInput) (*UserGroup, error)
+
+	// UpdateUserGroup updates the name and rate limits of an existing user group.
+	UpdateUserGroup(ctx context.Context, site Site, groupID UserGroupId, group *UserGroupInput) (*UserGroup, error)
+
+	// DeleteUserGroup deletes a user group.
+	DeleteUserGroup(ctx context.Context, site Site, groupID UserGroupId) error
+
+	// AssignClientToUserGroup assigns the client with the given MAC address to a user group.
+	AssignClientToUserGroup(ctx context.Context, site Site, mac string, groupID UserGroupId) error
+
+	// ListKnownClients lists the stored configuration of every client known to a site.
+	ListKnownClients(ctx context.Context, site Site) ([]KnownClient, error)
+
+	// ListClientSessions retrieves the connection history of a site: one session per client association.
+	ListClientSessions(ctx context.Context, site Site, request *ClientSessionsRequest) ([]ClientSession, error)
+
+	// Networks operations
+
+	// ListNetworks lists all networks (LANs, VLANs and WANs) configured on a site.
+	ListNetworks(ctx context.Context, site Site) ([]NetworkConf, error)
+
+	// ListSiteToSiteVPNs lists the manual site-to-site IPsec VPNs of a site.
+	ListSiteToSiteVPNs(ctx context.Context, site Site) ([]SiteToSiteVPN, error)
+
+	// CreateSiteToSiteVPN creates a manual site-to-site IPsec VPN.
+	CreateSiteToSiteVPN(ctx context.Context, site Site, vpn *SiteToSiteVPNInput) (*SiteToSiteVPN, error)
+
+	// UpdateSiteToSiteVPN updates a manual site-to-site IPsec VPN.
+	UpdateSiteToSiteVPN(ctx context.Context, site Site, networkID NetworkId, vpn *SiteToSiteVPNInput) (*SiteToSiteVPN, error)
+
+	// DeleteSiteToSiteVPN deletes a manual site-to-site IPsec VPN.
+	DeleteSiteToSiteVPN(ctx context.Context, site Site, networkID NetworkId) error
+
+	// Routing operations
+
+	// GetDynamicRoutingConfig retrieves the BGP and OSPF configuration of the gateway of a site.
+	GetDynamicRoutingConfig(ctx context.Context, site Site) (*DynamicRoutingConfig, error)
+
+	// UpdateDynamicRoutingConfig replaces the BGP and OSPF configuration of the gateway of a site.
+	UpdateDynamicRoutingConfig(ctx context.Context, site Site, cfg *DynamicRoutingConfig) (*DynamicRoutingConfig, error)
+
+	// WLAN operations
+
+	// ListWLANs lists all wireless networks (SSIDs) configured on a site.
+	ListWLANs(ctx context.Context, site Site) ([]WLAN, error)
+
+	// GetWLANMACFilter retrieves the MAC address filter of a WLAN.
+	GetWLANMACFilter(ctx context.Context, site Site, wlanID WLANId) (*WLANMACFilter, error)
+
+	// UpdateWLANMACFilter replaces the MAC address filter of a WLAN.
+	UpdateWLANMACFilter(ctx context.Context, site Site, wlanID WLANId, filter *WLANMACFilter) (*WLANMACFilter, error)
+
+	// SetWLANClientIsolation enables or disables client isolation on a WLAN.
+	SetWLANClientIsolation(ctx context.Context, site Site, wlanID WLANId, enabled bool) error
+
+	// AP groups operations
+
+	// ListAPGroups lists all access point groups of a site.
+	ListAPGroups(ctx context.Context, site Site) ([]APGroup, error)
+
+	// CreateAPGroup creates a new access point group.
+	CreateAPGroup(ctx context.Context, site Site, group *APGroupInput) (*APGroup, error)
+
+	// UpdateAPGroup replaces the name and members of an existing access point group.
+	UpdateAPGroup(ctx context.Context, site Site, groupID APGroupId, group *APGroupInput) (*APGroup, error)
+
+	// DeleteAPGroup deletes an access point group.
+	DeleteAPGroup(ctx context.Context, site Site, groupID APGroupId) error
+
+	// AssignDeviceToGroup adds the access point with the given MAC address to an AP group.
+	AssignDeviceToGroup(ctx context.Context, site Site, groupID APGroupId, mac string) error
+
+	// Controller operations
+
+	// GetControllerStatus reports whether the Network application is ready, starting, migrating, or updating.
+	GetControllerStatus(ctx context.Context) (*ControllerStatus, error)
+
+	// GenerateSupportFile collects the diagnostics of the controller and its devices into a support file.
+	GenerateSupportFile(ctx context.Context, site Site) (*SupportFile, error)
+
+	// GetControllerTime reads the controller clock, its drift from the local clock, and the NTP settings.
+	GetControllerTime(ctx context.Context, site Site) (*ControllerTime, error)
+
+	// GetControllerCertificate retrieves the TLS certificate served by the controller.
+	GetControllerCertificate(ctx context.Context) (*ControllerCertificate, error)
+
+	// UpdateControllerCertificate installs a PEM certificate chain and private key as the controller certificate.
+	UpdateControllerCertificate(ctx context.Context, certPEM, keyPEM []byte) (*ControllerCertificate, error)
+
 	// Dashboard operations
 
 	// GetAggregatedDashboard retrieves aggregated dashboard statistics.
 	GetAggregatedDashboard(ctx context.Context, site Site, params *GetAggregatedDashboardParams) (*AggregatedDashboard, error)
+
+	// GetSiteHealth retrieves the health of the WAN, Internet, LAN, WLAN and VPN subsystems of a site.
+	GetSiteHealth(ctx context.Context, site Site) (*SiteHealth, error)
 }
@@ -0,0 +1,112 @@
+package network
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// DefaultPageSize is the page size used by the All* iterators, the maximum
+// accepted by integration v1 list endpoints.
+const DefaultPageSize = 100
+
+// offsetPages iterates over the items of an offset/limit paginated endpoint.
+// fetch returns one page and its pagination metadata. Pages are requested
+// lazily; a failed request ends the sequence with the error.
+func offsetPages[T any](fetch func(offset, limit int) (items []T, page PageInfo, err error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for offset := 0; ; {
+			items, page, err := fetch(offset, DefaultPageSize)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// Stop on a page that does not advance, rather than fetching it forever.
+			if !page.HasMore() || page.NextOffset() <= offset {
+				return
+			}
+			offset = page.NextOffset()
+		}
+	}
+}
+
+// AllSites iterates over all sites, fetching pages as needed.
+// See package github.com/lexfrei/go-unifi/seq for slice and channel adapters.
+func (c *APIClient) AllSites(ctx context.Context) iter.Seq2[SiteListItem, error] {
+	return offsetPages(func(offset, limit int) ([]SiteListItem, PageInfo, error) {
+		resp, err := c.ListSites(ctx, &ListSitesParams{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return resp.Data, resp.PageInfo(), nil
+	})
+}
+
+// AllSiteDevices iterates over all devices of a site, fetching pages as needed.
+func (c *APIClient) AllSiteDevices(ctx context.Context, siteID SiteId) iter.Seq2[DeviceListItem, error] {
+	return offsetPages(func(offset, limit int) ([]DeviceListItem, PageInfo, error) {
+		resp, err := c.ListSiteDevices(ctx, siteID, &ListSiteDevicesParams{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return resp.Data, resp.PageInfo(), nil
+	})
+}
+
+// AllSiteClients iterates over all connected clients of a site, fetching pages as needed.
+func (c *APIClient) AllSiteClients(ctx context.Context, siteID SiteId) iter.Seq2[ClientListItem, error] {
+	return offsetPages(func(offset, limit int) ([]ClientListItem, PageInfo, error) {
+		resp, err := c.ListSiteClients(ctx, siteID, &ListSiteClientsParams{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return resp.Data, resp.PageInfo(), nil
+	})
+}
+
+// AllHotspotVouchers iterates over all hotspot vouchers of a site, fetching pages as needed.
+func (c *APIClient) AllHotspotVouchers(ctx context.Context, siteID SiteId) iter.Seq2[HotspotVoucher, error] {
+	return offsetPages(func(offset, limit int) ([]HotspotVoucher, PageInfo, error) {
+		resp, err := c.ListHotspotVouchers(ctx, siteID, &ListHotspotVouchersParams{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, PageInfo{}, err
+		}
+		return resp.Data, resp.PageInfo(), nil
+	})
+}
+
+// AllAdminActivity iterates over admin activity between since and until, newest
+// first, fetching pages of DefaultAdminActivityPageSize entries as needed.
+func (c *APIClient) AllAdminActivity(ctx context.Context, site Site, since, until time.Time) iter.Seq2[AdminActivityEntry, error] {
+	return func(yield func(AdminActivityEntry, error) bool) {
+		request := NewAdminActivityLogRequest(since, until)
+		pageSize := DefaultAdminActivityPageSize
+		request.PageSize = &pageSize
+
+		for page := 0; ; page++ {
+			request.PageNumber = &page
+
+			resp, err := c.ListAdminActivityLog(ctx, site, request)
+			if err != nil {
+				yield(AdminActivityEntry{}, err)
+				return
+			}
+			for _, entry := range resp.Data {
+				if !yield(entry, nil) {
+					return
+				}
+			}
+
+			if len(resp.Data) < pageSize || (resp.TotalPageCount != nil && page+1 >= *resp.TotalPageCount) {
+				return
+			}
+		}
+	}
+}
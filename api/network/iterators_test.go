@@ -0,0 +1,123 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/seq"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// pagedDevicesHandler serves total devices in pages honoring the offset and limit
+// query parameters, counting the requests in requests.
+func pagedDevicesHandler(t *testing.T, total int, requests *atomic.Int32) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "/proxy/network/integration/v1/sites/"+testSiteID.String()+"/devices", r.URL.Path)
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		assert.Equal(t, DefaultPageSize, limit)
+
+		resp := DevicesResponse{Offset: offset, Limit: limit, TotalCount: total, Data: []DeviceListItem{}}
+		for i := offset; i < min(offset+limit, total); i++ {
+			resp.Data = append(resp.Data, DeviceListItem{Name: fmt.Sprintf("device-%d", i)})
+		}
+		resp.Count = len(resp.Data)
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(resp))
+	}
+}
+
+func TestAllSiteDevices(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		total        int
+		wantRequests int32
+	}{
+		{name: "empty", total: 0, wantRequests: 1},
+		{name: "single page", total: 2, wantRequests: 1},
+		{name: "exact pages", total: 2 * DefaultPageSize, wantRequests: 2},
+		{name: "partial last page", total: 2*DefaultPageSize + 1, wantRequests: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := testutil.NewMockServerWithHandler(t, pagedDevicesHandler(t, tt.total, &requests))
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			devices, err := seq.Collect(client.AllSiteDevices(context.Background(), testSiteID))
+			require.NoError(t, err)
+			require.Len(t, devices, tt.total)
+			if tt.total > 0 {
+				assert.Equal(t, fmt.Sprintf("device-%d", tt.total-1), devices[tt.total-1].Name)
+			}
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
+
+func TestAllSiteDevicesStopsEarly(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, pagedDevicesHandler(t, 5*DefaultPageSize, &requests))
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	seen := 0
+	for device, err := range client.AllSiteDevices(context.Background(), testSiteID) {
+		require.NoError(t, err)
+		seen++
+		if device.Name == fmt.Sprintf("device-%d", DefaultPageSize+1) {
+			break
+		}
+	}
+	assert.Equal(t, DefaultPageSize+2, seen)
+	assert.Equal(t, int32(2), requests.Load(), "no pages should be fetched after the loop breaks")
+}
+
+func TestAllIteratorsSurfaceErrors(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/not_found.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = seq.Collect(client.AllSites(ctx))
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+	_, err = seq.Collect(client.AllSiteClients(ctx, testSiteID))
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+	_, err = seq.Collect(client.AllHotspotVouchers(ctx, testSiteID))
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+}
@@ -0,0 +1,14 @@
+package network
+
+// RemoteMAC returns the neighbor's MAC address in normalized form if the neighbor
+// identifies its chassis by MAC address, as UniFi devices and most switches do.
+func (n *LLDPNeighbor) RemoteMAC() (string, bool) {
+	if n.ChassisID == nil || (n.ChassisIDSubtype != nil && *n.ChassisIDSubtype != "mac") {
+		return "", false
+	}
+	mac, err := NormalizeMAC(*n.ChassisID)
+	if err != nil {
+		return "", false
+	}
+	return mac, true
+}
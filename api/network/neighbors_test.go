@@ -0,0 +1,95 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+var testNeighborDeviceID = types.UUID{0x62, 0x04, 0xb5, 0x87, 0x72, 0x15, 0x23, 0x5b, 0xd0, 0x68, 0xf9, 0x6c, 0xa1, 0x2e, 0xab, 0x52}
+
+func neighborHandlers(t *testing.T, legacyBody string) map[string]http.HandlerFunc {
+	t.Helper()
+	respond := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		}
+	}
+	fixture := func(name string) http.HandlerFunc {
+		return respond(testdata.LoadFixture(t, name))
+	}
+	return map[string]http.HandlerFunc{
+		testSitesPath: fixture("sites/list_success.json"),
+		testSitesPath + "/" + testSiteID.String() + "/devices/" + testNeighborDeviceID.String(): fixture("devices/single_device.json"),
+		"/proxy/network/api/s/" + testSiteInternal + "/stat/device/aa:bb:cc:99:ea:6b":           respond(legacyBody),
+	}
+}
+
+func TestGetDeviceNeighbors(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, neighborHandlers(t, testdata.LoadFixture(t, "devices/legacy_device.json")))
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	neighbors, err := client.GetDeviceNeighbors(context.Background(), testSiteID, testNeighborDeviceID)
+	require.NoError(t, err)
+	require.Len(t, neighbors, 2)
+
+	ap := neighbors[0]
+	assert.Equal(t, FlexibleInt(1), *ap.LocalPortIdx)
+	assert.Equal(t, "UAP-AC-Pro-Office", *ap.SystemName)
+	assert.Equal(t, "192.168.1.20", *ap.ManagementAddress)
+	assert.Equal(t, []string{"bridge", "wlan"}, *ap.Capabilities)
+	mac, ok := ap.RemoteMAC()
+	assert.True(t, ok)
+	assert.Equal(t, "24:5a:4c:11:22:33", mac)
+
+	_, ok = neighbors[1].RemoteMAC()
+	assert.False(t, ok, "IP chassis IDs are not MAC addresses")
+}
+
+func TestGetDeviceNeighborsErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{name: "no neighbors", body: `{"meta":{"rc":"ok"},"data":[{"mac":"aa:bb:cc:99:ea:6b"}]}`},
+		{name: "unknown device", body: `{"meta":{"rc":"ok"},"data":[]}`, wantErr: unifierr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerMulti(t, neighborHandlers(t, tt.body))
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			neighbors, err := client.GetDeviceNeighbors(context.Background(), testSiteID, testNeighborDeviceID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, neighbors)
+			assert.Empty(t, neighbors)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package network
+
+import (
+	"reflect"
+	"slices"
+)
+
+// extraOperations are the operations of APIClient methods outside NetworkAPIClient
+// that make requests of their own.
+var extraOperations = []string{
+	"ApplyChannelPlan",
+	"BindTrafficRuleToUserGroup",
+	"CloneSiteConfig",
+	"Download",
+	"ExportTrafficRules",
+	"ImportTrafficRules",
+	"PlanSiteClone",
+	"SteerClient",
+	"SyncTrafficRuleTargets",
+	"UnauthorizeGuest",
+	"UserGroupTargets",
+}
+
+// postReadOperations are the operations that read through POST requests, which
+// read-only clients let through.
+var postReadOperations = []string{
+	"ListAdminActivityLog",
+	"ListClientSessions",
+	"ListGuestAuthorizations",
+}
+
+// Operations returns the sorted names of the operations the client attributes its
+// requests to, such as "ListSiteClients". Each is the name of the client method
+// making the request. Metrics recorders implementing
+// observability.OperationMetricsRecorder receive these names and can use the list to
+// pre-register label values. Requests made with DoRaw have no operation.
+func Operations() []string {
+	api := reflect.TypeFor[NetworkAPIClient]()
+	names := slices.Clone(extraOperations)
+	for i := range api.NumMethod() {
+		names = append(names, api.Method(i).Name)
+	}
+	slices.Sort(names)
+	return names
+}
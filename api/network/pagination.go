@@ -0,0 +1,34 @@
+package network
+
+// HasMore reports whether items remain after this page.
+func (p PageInfo) HasMore() bool {
+	return p.Count > 0 && p.NextOffset() < p.TotalCount
+}
+
+// NextOffset returns the offset of the page following this one.
+func (p PageInfo) NextOffset() int {
+	return p.Offset + p.Count
+}
+
+// The list responses of offset/limit paginated endpoints carry the fields of
+// PageInfo inline, as the generator flattens them; PageInfo returns them together.
+
+// PageInfo returns the pagination metadata of the response.
+func (r *SitesResponse) PageInfo() PageInfo {
+	return PageInfo{Offset: r.Offset, Limit: r.Limit, Count: r.Count, TotalCount: r.TotalCount}
+}
+
+// PageInfo returns the pagination metadata of the response.
+func (r *DevicesResponse) PageInfo() PageInfo {
+	return PageInfo{Offset: r.Offset, Limit: r.Limit, Count: r.Count, TotalCount: r.TotalCount}
+}
+
+// PageInfo returns the pagination metadata of the response.
+func (r *ClientsResponse) PageInfo() PageInfo {
+	return PageInfo{Offset: r.Offset, Limit: r.Limit, Count: r.Count, TotalCount: r.TotalCount}
+}
+
+// PageInfo returns the pagination metadata of the response.
+func (r *HotspotVouchersResponse) PageInfo() PageInfo {
+	return PageInfo{Offset: r.Offset, Limit: r.Limit, Count: r.Count, TotalCount: r.TotalCount}
+}
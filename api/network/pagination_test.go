@@ -0,0 +1,43 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		page     PageInfo
+		wantMore bool
+		wantNext int
+	}{
+		{name: "empty list", page: PageInfo{Limit: 25}, wantNext: 0},
+		{name: "first of two pages", page: PageInfo{Limit: 25, Count: 25, TotalCount: 30}, wantMore: true, wantNext: 25},
+		{name: "last page", page: PageInfo{Offset: 25, Limit: 25, Count: 5, TotalCount: 30}, wantNext: 30},
+		{name: "exact last page", page: PageInfo{Offset: 25, Limit: 25, Count: 25, TotalCount: 50}, wantNext: 50},
+		{name: "empty page before total", page: PageInfo{Offset: 25, Limit: 25, TotalCount: 30}, wantNext: 25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.wantMore, tt.page.HasMore())
+			assert.Equal(t, tt.wantNext, tt.page.NextOffset())
+		})
+	}
+}
+
+func TestListResponsePageInfo(t *testing.T) {
+	t.Parallel()
+
+	want := PageInfo{Offset: 100, Limit: 100, Count: 42, TotalCount: 142}
+	assert.Equal(t, want, (&SitesResponse{Offset: 100, Limit: 100, Count: 42, TotalCount: 142}).PageInfo())
+	assert.Equal(t, want, (&DevicesResponse{Offset: 100, Limit: 100, Count: 42, TotalCount: 142}).PageInfo())
+	assert.Equal(t, want, (&ClientsResponse{Offset: 100, Limit: 100, Count: 42, TotalCount: 142}).PageInfo())
+	assert.Equal(t, want, (&HotspotVouchersResponse{Offset: 100, Limit: 100, Count: 42, TotalCount: 142}).PageInfo())
+}
@@ -0,0 +1,95 @@
+package network
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Base paths of the APIs served by the Network application, relative to the controller URL.
+const (
+	// NetworkBasePath is the path of the Network application on the controller.
+	NetworkBasePath = "/proxy/network"
+	// IntegrationBasePath is the base path of the official Integration v1 API.
+	IntegrationBasePath = NetworkBasePath + "/integration/v1"
+	// V2BasePath is the base path of the v2 API used by the Network web UI.
+	V2BasePath = NetworkBasePath + "/v2/api"
+	// LegacyBasePath is the base path of the legacy API, whose site paths start with /s/{site}.
+	LegacyBasePath = NetworkBasePath + "/api"
+)
+
+// Header names used by the client.
+const (
+	// APIKeyHeader carries the API key of every request to the controller.
+	APIKeyHeader = "X-API-KEY"
+	// RequestIDHeader carries the ID correlating a request with client logs and errors.
+	RequestIDHeader = middleware.RequestIDHeader
+)
+
+// BuildURL joins a controller URL, a base path such as IntegrationBasePath, and path
+// elements, escaping each element. It is meant for raw requests and log correlation
+// where no client is at hand; see APIClient.BuildURL otherwise.
+//
+// Example:
+//
+//	u, err := network.BuildURL("https://unifi.local", network.IntegrationBasePath, "sites", siteID.String(), "devices")
+//	// https://unifi.local/proxy/network/integration/v1/sites/<siteID>/devices
+func BuildURL(controllerURL, basePath string, elems ...string) (string, error) {
+	base, err := url.Parse(controllerURL)
+	if err != nil || base.Scheme == "" || base.Host == "" {
+		return "", errors.Wrapf(unifierr.ErrValidation, "invalid controller URL %q", controllerURL)
+	}
+	return joinURL(base, basePath, elems), nil
+}
+
+// BuildURL returns the URL of a controller path, made of a base path such as
+// IntegrationBasePath or V2BasePath and path elements, escaping each element.
+//
+// Example:
+//
+//	u := client.BuildURL(network.V2BasePath, "site", "default", "trafficrules")
+//	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+//	resp, err := client.DoRaw(req)
+func (c *APIClient) BuildURL(basePath string, elems ...string) string {
+	controller := *c.baseURL
+	controller.Path = strings.TrimSuffix(controller.Path, NetworkBasePath)
+	controller.RawPath = ""
+	return joinURL(&controller, basePath, elems)
+}
+
+// DoRaw sends a request built by the caller, e.g. for an endpoint the client does not
+// wrap yet, through the same rate limiting, retries, caching and observability as API
+// calls. The API key is added for requests to the controller, never to other hosts,
+// and Accept defaults to JSON. The response is returned as is, whatever its status;
+// the caller must close its body.
+func (c *APIClient) DoRaw(req *http.Request) (*http.Response, error) {
+	if req.URL == nil || !req.URL.IsAbs() {
+		return nil, errors.Wrap(unifierr.ErrValidation, "raw request URL must be absolute, see BuildURL")
+	}
+	if strings.EqualFold(req.URL.Host, c.baseURL.Host) {
+		req.Header.Set(APIKeyHeader, c.apiKey)
+	}
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send %s %s", req.Method, req.URL.Redacted())
+	}
+	return resp, nil
+}
+
+// joinURL appends basePath and the escaped elems to base.
+func joinURL(base *url.URL, basePath string, elems []string) string {
+	escaped := make([]string, len(elems))
+	for i, elem := range elems {
+		escaped[i] = url.PathEscape(elem)
+	}
+	return base.JoinPath(append([]string{basePath}, escaped...)...).String()
+}
@@ -0,0 +1,127 @@
+package network
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestBuildURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		controllerURL string
+		basePath      string
+		elems         []string
+		want          string
+		wantErr       bool
+	}{
+		{
+			name:          "integration path",
+			controllerURL: "https://unifi.local",
+			basePath:      IntegrationBasePath,
+			elems:         []string{"sites", testSiteID.String(), "devices"},
+			want:          "https://unifi.local/proxy/network/integration/v1/sites/" + testSiteID.String() + "/devices",
+		},
+		{
+			name:          "v2 path with trailing slash",
+			controllerURL: "https://192.168.1.1:8443/",
+			basePath:      V2BasePath,
+			elems:         []string{"site", "default", "trafficrules"},
+			want:          "https://192.168.1.1:8443/proxy/network/v2/api/site/default/trafficrules",
+		},
+		{
+			name:          "elements are escaped",
+			controllerURL: "https://unifi.local",
+			basePath:      LegacyBasePath,
+			elems:         []string{"s", "my site/1"},
+			want:          "https://unifi.local/proxy/network/api/s/my%20site%2F1",
+		},
+		{
+			name:          "relative controller URL",
+			controllerURL: "unifi.local",
+			basePath:      IntegrationBasePath,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := BuildURL(tt.controllerURL, tt.basePath, tt.elems...)
+			if tt.wantErr {
+				require.ErrorIs(t, err, unifierr.ErrValidation)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAPIClientBuildURL(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, "https://unifi.local:8443")
+	assert.Equal(t, "https://unifi.local:8443/proxy/network/integration/v1/sites",
+		client.BuildURL(IntegrationBasePath, "sites"))
+	assert.Equal(t, "https://unifi.local:8443/proxy/network/v2/api/site/default/static-dns",
+		client.BuildURL(V2BasePath, "site", "default", "static-dns"))
+}
+
+func TestDoRaw(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, IntegrationBasePath+"/sites", testAPIKey,
+		testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, client.BuildURL(IntegrationBasePath, "sites"), http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := client.DoRaw(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", req.Header.Get("Accept"))
+	assert.NotEmpty(t, resp.Request.Header.Get(RequestIDHeader))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"data"`)
+}
+
+func TestDoRawErrors(t *testing.T) {
+	t.Parallel()
+
+	other := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get(APIKeyHeader), "API key must not leak to other hosts")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer other.Close()
+
+	client := newTestClient(t, "https://unifi.local")
+
+	relative, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/proxy/network/status", http.NoBody)
+	require.NoError(t, err)
+	_, err = client.DoRaw(relative)
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+
+	foreign, err := http.NewRequestWithContext(context.Background(), http.MethodGet, other.URL+"/file", http.NoBody)
+	require.NoError(t, err)
+	resp, err := client.DoRaw(foreign)
+	require.NoError(t, err, "non-2xx statuses are returned, not converted to errors")
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,33 @@
+package network
+
+// IsBlocked reports whether spanning tree keeps the port from forwarding traffic.
+// Disabled ports and ports without spanning tree information are not blocked.
+func (p *SwitchPortState) IsBlocked() bool {
+	if p.STPState == nil {
+		return false
+	}
+	switch *p.STPState {
+	case STPStateBlocking, STPStateDiscarding, STPStateBroken:
+		return true
+	default:
+		return false
+	}
+}
+
+// Errors returns the sum of receive and transmit errors.
+func (p *SwitchPortState) Errors() int64 {
+	return int64(valueOrZero(p.RxErrors) + valueOrZero(p.TxErrors))
+}
+
+// Drops returns the sum of received and transmitted packets dropped.
+func (p *SwitchPortState) Drops() int64 {
+	return int64(valueOrZero(p.RxDropped) + valueOrZero(p.TxDropped))
+}
+
+func valueOrZero[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,80 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestGetPortStates(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, neighborHandlers(t, testdata.LoadFixture(t, "devices/legacy_device.json")))
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	ports, err := client.GetPortStates(context.Background(), testSiteID, testNeighborDeviceID)
+	require.NoError(t, err)
+	require.Len(t, ports, 2)
+
+	uplink := ports[0]
+	assert.Equal(t, 1, uplink.PortIdx)
+	assert.True(t, uplink.Up.Bool())
+	assert.Equal(t, FlexibleInt(1000), *uplink.Speed)
+	assert.True(t, uplink.FullDuplex.Bool())
+	assert.Equal(t, STPStateForwarding, *uplink.STPState)
+	assert.False(t, uplink.IsBlocked())
+	assert.Equal(t, int64(4), uplink.Errors())
+	assert.Equal(t, int64(12), uplink.Drops())
+
+	blocked := ports[1]
+	assert.Equal(t, STPStateBlocking, *blocked.STPState)
+	assert.True(t, blocked.IsBlocked())
+	assert.Zero(t, blocked.Errors())
+}
+
+func TestGetPortStatesNoPorts(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, neighborHandlers(t, `{"meta":{"rc":"ok"},"data":[{"mac":"aa:bb:cc:99:ea:6b"}]}`))
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	ports, err := client.GetPortStates(context.Background(), testSiteID, testNeighborDeviceID)
+	require.NoError(t, err)
+	assert.NotNil(t, ports)
+	assert.Empty(t, ports)
+}
+
+func TestSwitchPortStateIsBlocked(t *testing.T) {
+	t.Parallel()
+
+	stp := func(s STPState) *STPState { return &s }
+
+	tests := []struct {
+		state *STPState
+		want  bool
+	}{
+		{state: nil, want: false},
+		{state: stp(STPStateForwarding), want: false},
+		{state: stp(STPStateDisabled), want: false},
+		{state: stp(STPStateLearning), want: false},
+		{state: stp(STPStateBlocking), want: true},
+		{state: stp(STPStateDiscarding), want: true},
+		{state: stp(STPStateBroken), want: true},
+	}
+
+	for _, tt := range tests {
+		port := SwitchPortState{STPState: tt.state}
+		assert.Equal(t, tt.want, port.IsBlocked())
+	}
+}
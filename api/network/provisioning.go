@@ -0,0 +1,116 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/backoff"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// ErrProvisioningTimeout is returned by WaitForProvisioning when devices are still
+// not ready when the timeout elapses.
+var ErrProvisioningTimeout = errors.New("devices did not finish provisioning")
+
+// provisioningPoll paces WaitForProvisioning: frequent polls at first, as most
+// provisioning finishes within seconds, backing off for longer upgrades.
+var provisioningPoll = backoff.Policy{Initial: time.Second, Max: 5 * time.Second, Multiplier: 1.5}
+
+// WaitForProvisioning polls the devices of a site until every device in deviceIDs
+// reports DeviceStateONLINE, so that a sequence of configuration changes can be
+// applied in order without racing the re-provisioning each change triggers.
+// An empty deviceIDs waits for all devices of the site.
+//
+// A device may take a moment to start provisioning after a change, so the devices
+// must report ONLINE in two consecutive polls. A timeout of 0 waits until ctx is
+// done. When the timeout elapses, the error matches ErrProvisioningTimeout and lists
+// the devices still pending with their states; a device missing from the site is
+// an error matching unifierr.ErrNotFound.
+//
+// Example:
+//
+//	_, err := client.UpdateTrafficRule(ctx, "default", ruleID, rule)
+//	...
+//	err = client.WaitForProvisioning(ctx, siteID, nil, 2*time.Minute)
+func (c *APIClient) WaitForProvisioning(ctx context.Context, siteID SiteId, deviceIDs []DeviceId, timeout time.Duration) error {
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var pending []string
+	readyPolls := 0
+	for attempt := 0; ; attempt++ {
+		current, err := c.pendingDevices(waitCtx, siteID, deviceIDs)
+		switch {
+		case err == nil:
+			pending = current
+		case ctx.Err() == nil && waitCtx.Err() != nil:
+			return provisioningTimeout(timeout, pending)
+		default:
+			return errors.Wrap(err, "failed to wait for provisioning")
+		}
+
+		if len(pending) == 0 {
+			readyPolls++
+			if readyPolls == 2 {
+				return nil
+			}
+		} else {
+			readyPolls = 0
+		}
+
+		if backoff.Sleep(waitCtx, provisioningPoll.Delay(attempt)) != nil {
+			if ctx.Err() != nil {
+				return errors.Wrap(ctx.Err(), "failed to wait for provisioning")
+			}
+			return provisioningTimeout(timeout, pending)
+		}
+	}
+}
+
+func provisioningTimeout(timeout time.Duration, pending []string) error {
+	if len(pending) == 0 {
+		return errors.Wrapf(ErrProvisioningTimeout, "after %s", timeout)
+	}
+	return errors.Wrapf(ErrProvisioningTimeout, "after %s: %s", timeout, strings.Join(pending, ", "))
+}
+
+// pendingDevices returns "id (STATE)" for each device in deviceIDs, or each device of
+// the site if deviceIDs is empty, that is not online.
+func (c *APIClient) pendingDevices(ctx context.Context, siteID SiteId, deviceIDs []DeviceId) ([]string, error) {
+	states := make(map[DeviceId]DeviceListItemState)
+	for device, err := range c.AllSiteDevices(ctx, siteID) {
+		if err != nil {
+			return nil, err
+		}
+		states[device.Id] = device.State
+	}
+
+	if len(deviceIDs) == 0 {
+		deviceIDs = make([]DeviceId, 0, len(states))
+		for id := range states {
+			deviceIDs = append(deviceIDs, id)
+		}
+	}
+
+	var pending []string
+	for _, id := range deviceIDs {
+		state, found := states[id]
+		if !found {
+			return nil, errors.Wrapf(unifierr.ErrNotFound, "device %s", id)
+		}
+		if state != DeviceListItemStateONLINE {
+			pending = append(pending, fmt.Sprintf("%s (%s)", id, state))
+		}
+	}
+	slices.Sort(pending)
+	return pending, nil
+}
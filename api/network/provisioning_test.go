@@ -0,0 +1,100 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/backoff"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Not parallel: it shortens the shared polling policy.
+func TestWaitForProvisioning(t *testing.T) {
+	saved := provisioningPoll
+	provisioningPoll = backoff.Policy{Initial: time.Millisecond}
+	t.Cleanup(func() { provisioningPoll = saved })
+
+	device1 := types.UUID{0x62, 0x04, 0xb5, 0x87, 0x72, 0x15, 0x23, 0x5b, 0xd0, 0x68, 0xf9, 0x6c, 0xa1, 0x2e, 0xab, 0x52}
+	device2 := types.UUID{0x0c, 0xd2, 0x46, 0x18, 0x87, 0x45, 0xb6, 0x26, 0xb3, 0xc3, 0x57, 0x69, 0x2a, 0x02, 0x43, 0x3e}
+	unknown := types.UUID{0x01}
+
+	tests := []struct {
+		name      string
+		deviceIDs []DeviceId
+		// provisioningPolls is the number of polls in which the first device is provisioning (-1 for always)
+		provisioningPolls int32
+		timeout           time.Duration
+		wantErr           error
+		wantPolls         int32
+	}{
+		{name: "already online", deviceIDs: []DeviceId{device1}, wantPolls: 2},
+		{name: "waits for provisioning", deviceIDs: []DeviceId{device1, device2}, provisioningPolls: 2, wantPolls: 4},
+		{name: "all devices of the site", provisioningPolls: 1, wantPolls: 3},
+		{name: "other device unaffected", deviceIDs: []DeviceId{device2}, provisioningPolls: -1, wantPolls: 2},
+		{name: "timeout", deviceIDs: []DeviceId{device1}, provisioningPolls: -1, timeout: 50 * time.Millisecond, wantErr: ErrProvisioningTimeout},
+		{name: "unknown device", deviceIDs: []DeviceId{unknown}, wantErr: unifierr.ErrNotFound, wantPolls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls atomic.Int32
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+				body := testdata.LoadFixture(t, "devices/list_success.json")
+				if poll := polls.Add(1); tt.provisioningPolls < 0 || poll <= tt.provisioningPolls {
+					body = strings.Replace(body, `"state": "ONLINE"`, `"state": "PROVISIONING"`, 1)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(body))
+			})
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			err = client.WaitForProvisioning(context.Background(), testSiteID, tt.deviceIDs, tt.timeout)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				if tt.wantErr == ErrProvisioningTimeout {
+					assert.Contains(t, err.Error(), device1.String()+" (PROVISIONING)")
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantPolls > 0 {
+				assert.Equal(t, tt.wantPolls, polls.Load())
+			}
+		})
+	}
+}
+
+func TestWaitForProvisioningCanceled(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		body := strings.Replace(testdata.LoadFixture(t, "devices/list_success.json"),
+			`"state": "ONLINE"`, `"state": "UPGRADING"`, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = client.WaitForProvisioning(ctx, testSiteID, nil, time.Minute)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotErrorIs(t, err, ErrProvisioningTimeout)
+}
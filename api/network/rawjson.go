@@ -0,0 +1,67 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+// retainRawJSON populates the RawJSON field of decoded models from the response body.
+// It is installed as the decoder hook when ClientConfig.RetainRawJSON is enabled.
+//
+//nolint:cyclop // Flat type switch over all response types with raw JSON support
+func retainRawJSON(data any, body []byte) error {
+	switch v := data.(type) {
+	case *SitesResponse:
+		return retainDataItems(body, v.Data, func(item *SiteListItem, raw json.RawMessage) { item.RawJSON = raw })
+	case *DevicesResponse:
+		return retainDataItems(body, v.Data, func(item *DeviceListItem, raw json.RawMessage) { item.RawJSON = raw })
+	case *ClientsResponse:
+		return retainDataItems(body, v.Data, func(item *ClientListItem, raw json.RawMessage) { item.RawJSON = raw })
+	case *HotspotVouchersResponse:
+		return retainDataItems(body, v.Data, func(item *HotspotVoucher, raw json.RawMessage) { item.RawJSON = raw })
+	case *AdminActivityLogResponse:
+		return retainDataItems(body, v.Data, func(item *AdminActivityEntry, raw json.RawMessage) { item.RawJSON = raw })
+	case *[]DNSRecord:
+		return retainArrayItems(body, *v, func(item *DNSRecord, raw json.RawMessage) { item.RawJSON = raw })
+	case *[]FirewallPolicy:
+		return retainArrayItems(body, *v, func(item *FirewallPolicy, raw json.RawMessage) { item.RawJSON = raw })
+	case *[]TrafficRule:
+		return retainArrayItems(body, *v, func(item *TrafficRule, raw json.RawMessage) { item.RawJSON = raw })
+	case *Device:
+		v.RawJSON = body
+	case *ClientListItem:
+		v.RawJSON = body
+	case *HotspotVoucher:
+		v.RawJSON = body
+	case *DNSRecord:
+		v.RawJSON = body
+	case *FirewallPolicy:
+		v.RawJSON = body
+	case *TrafficRule:
+		v.RawJSON = body
+	case *AggregatedDashboard:
+		v.RawJSON = body
+	}
+	return nil
+}
+
+func retainDataItems[T any](body []byte, items []T, set func(*T, json.RawMessage)) error {
+	raws, err := response.RawDataArray(body)
+	if err != nil {
+		//nolint:wrapcheck // response.RawDataArray returns wrapped errors
+		return err
+	}
+	//nolint:wrapcheck // response.AssignRaw returns descriptive errors
+	return response.AssignRaw(raws, items, set)
+}
+
+func retainArrayItems[T any](body []byte, items []T, set func(*T, json.RawMessage)) error {
+	raws, err := response.RawArray(body)
+	if err != nil {
+		//nolint:wrapcheck // response.RawArray returns wrapped errors
+		return err
+	}
+	//nolint:wrapcheck // response.AssignRaw returns descriptive errors
+	return response.AssignRaw(raws, items, set)
+}
@@ -0,0 +1,96 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func newRawJSONClient(t *testing.T, serverURL string, retain bool) *APIClient {
+	t.Helper()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: serverURL,
+		APIKey:        testAPIKey,
+		RetainRawJSON: retain,
+	})
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestRetainRawJSONList(t *testing.T) {
+	t.Parallel()
+
+	body := `{"offset":0,"limit":25,"count":2,"totalCount":2,"data":[
+		{"id":"88f7af54-98f8-306a-a1c7-c9349722b1f6","internalReference":"default","name":"Default","futureField":"a"},
+		{"id":"00000000-0000-0000-0000-000000000001","internalReference":"lab","name":"Lab","futureField":"b"}
+	]}`
+
+	server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites", testAPIKey, body, http.StatusOK)
+	defer server.Close()
+
+	resp, err := newRawJSONClient(t, server.URL, true).ListSites(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, resp.Data, 2)
+
+	for i, want := range []string{"a", "b"} {
+		var extra struct {
+			FutureField string `json:"futureField"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Data[i].RawJSON, &extra))
+		assert.Equal(t, want, extra.FutureField)
+	}
+}
+
+func TestRetainRawJSONArray(t *testing.T) {
+	t.Parallel()
+
+	expectedPath := "/proxy/network/v2/api/site/" + testSiteInternal + "/static-dns"
+	server := testutil.NewMockServer(t, expectedPath, testAPIKey,
+		testdata.LoadFixture(t, "dns/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	records, err := newRawJSONClient(t, server.URL, true).ListDNSRecords(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.NotEmpty(t, records)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(records[0].RawJSON, &raw))
+	assert.Equal(t, records[0].Key, raw["key"])
+}
+
+func TestRetainRawJSONSingle(t *testing.T) {
+	t.Parallel()
+
+	testDeviceID := types.UUID{0x62, 0x04, 0xb5, 0x87, 0x72, 0x15, 0x23, 0x5b, 0xd0, 0x68, 0xf9, 0x6c, 0xa1, 0x2e, 0xab, 0x52}
+	expectedPath := "/proxy/network/integration/v1/sites/" + testSiteID.String() + "/devices/" + testDeviceID.String()
+	fixture := testdata.LoadFixture(t, "devices/single_device.json")
+	server := testutil.NewMockServer(t, expectedPath, testAPIKey, fixture, http.StatusOK)
+	defer server.Close()
+
+	device, err := newRawJSONClient(t, server.URL, true).GetDeviceByID(context.Background(), testSiteID, testDeviceID)
+	require.NoError(t, err)
+	assert.JSONEq(t, fixture, string(device.RawJSON))
+}
+
+func TestRetainRawJSONDisabled(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/proxy/network/integration/v1/sites", testAPIKey,
+		testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	resp, err := newRawJSONClient(t, server.URL, false).ListSites(context.Background(), nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Data)
+	assert.Nil(t, resp.Data[0].RawJSON)
+}
@@ -0,0 +1,133 @@
+package network
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+type expectedRevisionKey struct{}
+
+// WithExpectedRevision returns a context whose UpdateDNSRecord, UpdateFirewallPolicy
+// and UpdateTrafficRule calls only update the object if it is still at revision, as
+// returned by its Revision method when the caller read it. Otherwise the update is not
+// sent and fails with a *unifierr.ConflictError, matching unifierr.ErrConflict, so that
+// automation does not overwrite manual edits made in the meantime.
+//
+// The Network API does not expose revisions, so the client fetches the object right
+// before updating it and compares revisions computed from its content. This narrows
+// the window for lost updates to the time between both requests, but cannot close it.
+//
+// Example:
+//
+//	records, err := client.ListDNSRecords(ctx, "default")
+//	// ... pick record, compute the new input
+//	ctx := network.WithExpectedRevision(ctx, record.Revision())
+//	_, err = client.UpdateDNSRecord(ctx, "default", record.UnderscoreId, input)
+//	if errors.Is(err, unifierr.ErrConflict) {
+//	    // someone else changed the record: read it again and reconsider
+//	}
+func WithExpectedRevision(ctx context.Context, revision string) context.Context {
+	return context.WithValue(ctx, expectedRevisionKey{}, revision)
+}
+
+// expectedRevision returns the revision set with WithExpectedRevision, if any.
+func expectedRevision(ctx context.Context) (string, bool) {
+	revision, ok := ctx.Value(expectedRevisionKey{}).(string)
+	return revision, ok
+}
+
+// Revision returns a fingerprint of the content of the record, which changes whenever
+// the record is changed. See WithExpectedRevision.
+func (r *DNSRecord) Revision() string {
+	return revision(r)
+}
+
+// Revision returns a fingerprint of the content of the policy, which changes whenever
+// the policy is changed. See WithExpectedRevision.
+func (p *FirewallPolicy) Revision() string {
+	return revision(p)
+}
+
+// Revision returns a fingerprint of the content of the rule, which changes whenever
+// the rule is changed. See WithExpectedRevision.
+func (r *TrafficRule) Revision() string {
+	return revision(r)
+}
+
+// revision returns the first 16 hex digits of the SHA-256 of the JSON encoding of v.
+// Fields unknown to the client, kept in RawJSON, are not part of it.
+func revision(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// beginUpdate fetches the object with the given ID through list, bypassing the response
+// cache, before operation updates it, if the update needs it: to check the revision set
+// with WithExpectedRevision, or to log a diff with ClientConfig.LogUpdateDiffs.
+//
+// A revision check that cannot be made, or fails, returns an error and the update must
+// not be sent. Otherwise the update goes ahead, with the diff returned if it is to be
+// logged, or nil.
+func beginUpdate[T any](ctx context.Context, c *APIClient, operation, resource, site, id string,
+	list func(context.Context, Site) ([]T, error), idOf func(*T) string,
+) (*updateDiff, error) {
+	expected, check := expectedRevision(ctx)
+	if !check && !c.logDiffs {
+		return nil, nil
+	}
+
+	items, err := list(middleware.WithoutCache(ctx), site)
+	if err != nil {
+		if check {
+			return nil, errors.Wrapf(err, "failed to check revision of %s %s", resource, id)
+		}
+		c.logger.Warn("cannot fetch object for update diff",
+			observability.Field{Key: "operation", Value: operation},
+			observability.Field{Key: "site", Value: site},
+			observability.Field{Key: "id", Value: id},
+			observability.Field{Key: "error", Value: err.Error()},
+		)
+		return nil, nil
+	}
+
+	var current *T
+	for i := range items {
+		if idOf(&items[i]) == id {
+			current = &items[i]
+			break
+		}
+	}
+	if current == nil {
+		if check {
+			return nil, errors.Wrapf(unifierr.ErrNotFound, "%s %s not found in site %s", resource, id, site)
+		}
+		c.logger.Warn("object for update diff not found",
+			observability.Field{Key: "operation", Value: operation},
+			observability.Field{Key: "site", Value: site},
+			observability.Field{Key: "id", Value: id},
+		)
+		return nil, nil
+	}
+
+	if check {
+		if actual := revision(current); actual != expected {
+			return nil, errors.WithStack(&unifierr.ConflictError{Resource: resource, ID: id, Expected: expected, Actual: actual})
+		}
+	}
+	if !c.logDiffs {
+		return nil, nil
+	}
+	return &updateDiff{operation: operation, site: site, id: id, before: current}, nil
+}
@@ -0,0 +1,89 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestRevision(t *testing.T) {
+	t.Parallel()
+
+	record := DNSRecord{UnderscoreId: testRecordID, Key: testHostKey, Value: testHostValue}
+	same := record
+	same.RawJSON = []byte(`{"unknown": true}`)
+	changed := record
+	changed.Value = "192.168.100.9"
+
+	assert.Len(t, record.Revision(), 16)
+	assert.Equal(t, record.Revision(), same.Revision(), "raw JSON is not part of the revision")
+	assert.NotEqual(t, record.Revision(), changed.Revision())
+}
+
+func TestWithExpectedRevision(t *testing.T) {
+	t.Parallel()
+
+	var updates atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/list_success.json")))
+			return
+		}
+		updates.Add(1)
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/single_record.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	ctx := context.Background()
+	records, err := client.ListDNSRecords(ctx, testSiteInternal)
+	require.NoError(t, err)
+	current := records[0]
+	input := &DNSRecordInput{Key: testHostKey, RecordType: DNSRecordInputRecordTypeA, Value: "192.168.100.9"}
+
+	_, err = client.UpdateDNSRecord(WithExpectedRevision(ctx, current.Revision()), testSiteInternal, testRecordID, input)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), updates.Load())
+
+	stale := current
+	stale.Value = "192.168.100.7"
+	_, err = client.UpdateDNSRecord(WithExpectedRevision(ctx, stale.Revision()), testSiteInternal, testRecordID, input)
+	require.ErrorIs(t, err, unifierr.ErrConflict)
+	var conflictErr *unifierr.ConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, unifierr.ConflictError{
+		Resource: "DNS record",
+		ID:       testRecordID,
+		Expected: stale.Revision(),
+		Actual:   current.Revision(),
+	}, *conflictErr)
+
+	_, err = client.UpdateDNSRecord(WithExpectedRevision(ctx, current.Revision()), testSiteInternal, "000000000000000000000000", input)
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+	assert.Equal(t, int32(1), updates.Load(), "conflicting updates must not reach the controller")
+}
+
+func TestUpdateConflictStatus(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"code": "api.err.Conflict"}`))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	_, err := client.UpdateTrafficRule(context.Background(), testSiteInternal, "rule", &TrafficRuleInput{})
+	require.ErrorIs(t, err, unifierr.ErrConflict, "409 responses match ErrConflict")
+}
@@ -0,0 +1,561 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// BGP address families rendered in address-family blocks.
+const (
+	bgpIPv4Unicast = "ipv4 unicast"
+	bgpIPv6Unicast = "ipv6 unicast"
+)
+
+// DynamicRoutingConfig is the dynamic routing configuration of a gateway. Gateways
+// run FRR and store its configuration as text; GetDynamicRoutingConfig parses the
+// BGP and OSPF statements it knows into BGP and OSPF, and UpdateDynamicRoutingConfig
+// renders them back. Statements it does not know, such as route maps and prefix
+// lists, are kept in the Extra fields and rendered back unchanged.
+type DynamicRoutingConfig struct {
+	// Enabled runs the routing daemons
+	Enabled bool
+
+	// Description is a free-form description of the configuration
+	Description string
+
+	// BGP is the BGP instance of the default VRF, or nil for none
+	BGP *BGPConfig
+
+	// OSPF is the OSPFv2 instance of the default VRF, or nil for none
+	OSPF *OSPFConfig
+
+	// Extra holds the statements outside the BGP and OSPF instances, such as route maps,
+	// prefix lists and instances of other VRFs, verbatim with their indentation
+	Extra []string
+}
+
+// BGPConfig is a BGP instance.
+type BGPConfig struct {
+	// ASN is the local autonomous system number
+	ASN uint32
+
+	// RouterID is the BGP identifier, an IPv4 address (optional, FRR picks one)
+	RouterID netip.Addr
+
+	// RequirePolicy keeps the FRR default of not exchanging routes with eBGP neighbors
+	// that have no route maps (RFC 8212); it is off by default so that Networks are
+	// announced without further configuration
+	RequirePolicy bool
+
+	// Neighbors are the BGP peers
+	Neighbors []BGPNeighbor
+
+	// Networks are the prefixes announced to neighbors; they must be in the routing table
+	Networks []netip.Prefix
+
+	// Extra holds the statements of the instance that the fields above do not represent,
+	// such as neighbor route maps, by address family, e.g. "ipv4 unicast", with "" for
+	// statements outside address-family blocks
+	Extra map[string][]string
+}
+
+// BGPNeighbor is a BGP peer.
+type BGPNeighbor struct {
+	// Address is the address of the peer
+	Address netip.Addr
+
+	// RemoteASN is the autonomous system number of the peer
+	RemoteASN uint32
+
+	// Description is a free-form description of the peer (optional)
+	Description string
+
+	// Password enables TCP MD5 authentication of the session (optional)
+	Password string
+
+	// EBGPMultihop is the maximum hop count to eBGP peers that are not directly
+	// connected (optional)
+	EBGPMultihop int
+
+	// UpdateSource is the interface or address sessions are sourced from (optional)
+	UpdateSource string
+}
+
+// OSPFConfig is an OSPFv2 instance.
+type OSPFConfig struct {
+	// RouterID is the OSPF router ID, an IPv4 address (optional, FRR picks one)
+	RouterID netip.Addr
+
+	// Networks enable OSPF on the interfaces with addresses in their prefixes
+	Networks []OSPFNetwork
+
+	// PassiveInterfaces are advertised without forming adjacencies on them
+	PassiveInterfaces []string
+
+	// Extra holds the statements of the instance that the fields above do not
+	// represent, such as redistribution
+	Extra []string
+}
+
+// OSPFNetwork enables OSPF in an area on the interfaces within a prefix.
+type OSPFNetwork struct {
+	Prefix netip.Prefix
+
+	// Area is the area ID, as a number ("0") or in dotted form ("0.0.0.0")
+	Area string
+}
+
+// GetDynamicRoutingConfig retrieves the BGP and OSPF configuration of the gateway of
+// a site. Gateways without dynamic routing fail with an error matching
+// unifierr.ErrNotFound.
+func (c *APIClient) GetDynamicRoutingConfig(ctx context.Context, site Site) (*DynamicRoutingConfig, error) {
+	ctx = middleware.WithOperation(ctx, "GetDynamicRoutingConfig")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.GetDynamicRoutingConfigWithResponse(ctx, site)
+	var data *DynamicRoutingSettings
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	settings, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get dynamic routing configuration for site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return parseDynamicRoutingSettings(settings), nil
+}
+
+// UpdateDynamicRoutingConfig replaces the BGP and OSPF configuration of the gateway of
+// a site and returns the configuration the gateway runs. The configuration is
+// validated first; invalid fields fail the call with an error matching
+// unifierr.ErrValidation. The gateway reloads its routing daemons, which resets
+// established sessions.
+//
+// Example:
+//
+//	cfg, err := client.GetDynamicRoutingConfig(ctx, "default")
+//	if err != nil {
+//	    return err
+//	}
+//	cfg.BGP.Neighbors = append(cfg.BGP.Neighbors, network.BGPNeighbor{
+//	    Address:   netip.MustParseAddr("10.0.0.3"),
+//	    RemoteASN: 65003,
+//	})
+//	_, err = client.UpdateDynamicRoutingConfig(ctx, "default", cfg)
+func (c *APIClient) UpdateDynamicRoutingConfig(ctx context.Context, site Site, cfg *DynamicRoutingConfig) (*DynamicRoutingConfig, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateDynamicRoutingConfig")
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	frrConfig := cfg.FRRConfig()
+	request := DynamicRoutingSettings{Enabled: cfg.Enabled, FRRConfig: &frrConfig}
+	if cfg.Description != "" {
+		request.Description = &cfg.Description
+	}
+	resp, err := c.client.UpdateDynamicRoutingConfigWithResponse(ctx, site, request)
+	var data *DynamicRoutingSettings
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	settings, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to update dynamic routing configuration for site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return parseDynamicRoutingSettings(settings), nil
+}
+
+func parseDynamicRoutingSettings(settings *DynamicRoutingSettings) *DynamicRoutingConfig {
+	cfg := ParseFRRConfig(valueOrZero(settings.FRRConfig))
+	cfg.Enabled = settings.Enabled
+	cfg.Description = valueOrZero(settings.Description)
+	return cfg
+}
+
+// Validate checks the BGP and OSPF instances and reports every problem found in an
+// error matching unifierr.ErrValidation.
+func (c *DynamicRoutingConfig) Validate() error {
+	var errs []error
+	if c.BGP != nil {
+		errs = append(errs, c.BGP.validate())
+	}
+	if c.OSPF != nil {
+		errs = append(errs, c.OSPF.validate())
+	}
+	return errors.Join(errs...)
+}
+
+func (b *BGPConfig) validate() error {
+	var errs []error
+	if b.ASN == 0 {
+		errs = append(errs, errors.Wrap(unifierr.ErrValidation, "BGP ASN is required"))
+	}
+	errs = append(errs, validateRouterID("BGP", b.RouterID))
+
+	seen := make(map[netip.Addr]bool, len(b.Neighbors))
+	for _, neighbor := range b.Neighbors {
+		switch {
+		case !neighbor.Address.IsValid():
+			errs = append(errs, errors.Wrap(unifierr.ErrValidation, "BGP neighbor address is required"))
+			continue
+		case seen[neighbor.Address]:
+			errs = append(errs, errors.Wrapf(unifierr.ErrValidation, "BGP neighbor %s is defined twice", neighbor.Address))
+		}
+		seen[neighbor.Address] = true
+		if neighbor.RemoteASN == 0 {
+			errs = append(errs, errors.Wrapf(unifierr.ErrValidation, "BGP neighbor %s needs a remote ASN", neighbor.Address))
+		}
+		if neighbor.EBGPMultihop < 0 || neighbor.EBGPMultihop > 255 {
+			errs = append(errs, errors.Wrapf(unifierr.ErrValidation, "BGP neighbor %s eBGP multihop must be 1 to 255, got %d",
+				neighbor.Address, neighbor.EBGPMultihop))
+		}
+		errs = append(errs,
+			validateFRRArgument(fmt.Sprintf("BGP neighbor %s description", neighbor.Address), neighbor.Description, true),
+			validateFRRArgument(fmt.Sprintf("BGP neighbor %s password", neighbor.Address), neighbor.Password, false),
+			validateFRRArgument(fmt.Sprintf("BGP neighbor %s update source", neighbor.Address), neighbor.UpdateSource, false),
+		)
+	}
+	for _, prefix := range b.Networks {
+		if !prefix.IsValid() {
+			errs = append(errs, errors.Wrap(unifierr.ErrValidation, "BGP network must be a valid prefix"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (o *OSPFConfig) validate() error {
+	errs := []error{validateRouterID("OSPF", o.RouterID)}
+	for _, network := range o.Networks {
+		if !network.Prefix.IsValid() {
+			errs = append(errs, errors.Wrap(unifierr.ErrValidation, "OSPF network must be a valid prefix"))
+		} else if !network.Prefix.Addr().Is4() {
+			errs = append(errs, errors.Wrapf(unifierr.ErrValidation, "OSPF network must be an IPv4 prefix, got %s", network.Prefix))
+		}
+		if !validOSPFArea(network.Area) {
+			errs = append(errs, errors.Wrapf(unifierr.ErrValidation, "OSPF area must be a number or an IPv4 address, got %q", network.Area))
+		}
+	}
+	for _, iface := range o.PassiveInterfaces {
+		errs = append(errs, validateFRRArgument("OSPF passive interface", iface, false))
+	}
+	return errors.Join(errs...)
+}
+
+// validateRouterID rejects router IDs that are set but not IPv4 addresses.
+func validateRouterID(protocol string, id netip.Addr) error {
+	if id.IsValid() && !id.Is4() {
+		return errors.Wrapf(unifierr.ErrValidation, "%s router ID must be an IPv4 address, got %s", protocol, id)
+	}
+	return nil
+}
+
+// validateFRRArgument rejects values that would break the statement they are
+// rendered into: line breaks, and spaces unless the value ends the statement.
+func validateFRRArgument(field, value string, spaces bool) error {
+	if strings.ContainsAny(value, "\r\n") || (!spaces && strings.ContainsAny(value, " \t")) {
+		return errors.Wrapf(unifierr.ErrValidation, "%s must not contain line breaks or spaces, got %q", field, value)
+	}
+	return nil
+}
+
+func validOSPFArea(area string) bool {
+	if _, err := strconv.ParseUint(area, 10, 32); err == nil {
+		return true
+	}
+	addr, err := netip.ParseAddr(area)
+	return err == nil && addr.Is4()
+}
+
+// ParseFRRConfig parses an FRR configuration into a DynamicRoutingConfig. The first
+// BGP and OSPF instances of the default VRF are parsed into BGP and OSPF; every
+// statement that is not represented is kept in an Extra field, so that rendering the
+// result with FRRConfig preserves it. Enabled and Description are left unset.
+func ParseFRRConfig(text string) *DynamicRoutingConfig {
+	parser := frrParser{cfg: &DynamicRoutingConfig{}}
+	for line := range strings.Lines(text) {
+		parser.parseLine(strings.TrimRight(line, " \t\r\n"))
+	}
+	return parser.cfg
+}
+
+// frrParser parses FRR configurations line by line. FRR writes the statements of a
+// block indented below the line opening it.
+type frrParser struct {
+	cfg *DynamicRoutingConfig
+
+	// section is the block being parsed: "bgp", "ospf" or "" for other blocks
+	section string
+
+	// addressFamily is the address-family block being parsed in the BGP instance
+	addressFamily string
+}
+
+func (p *frrParser) parseLine(line string) {
+	statement := strings.TrimSpace(line)
+	if statement == "" || statement == "!" {
+		return
+	}
+
+	if !strings.HasPrefix(line, " ") {
+		p.parseTopLevel(line)
+		return
+	}
+	switch p.section {
+	case "bgp":
+		p.parseBGP(statement)
+	case "ospf":
+		p.parseOSPF(statement)
+	default:
+		p.cfg.Extra = append(p.cfg.Extra, line)
+	}
+}
+
+func (p *frrParser) parseTopLevel(line string) {
+	fields := strings.Fields(line)
+	switch {
+	case line == "exit" && p.section != "":
+		p.section = ""
+		return
+	case len(fields) == 3 && fields[0] == "router" && fields[1] == "bgp" && p.cfg.BGP == nil:
+		asn, err := strconv.ParseUint(fields[2], 10, 32)
+		if err == nil {
+			p.cfg.BGP = &BGPConfig{ASN: uint32(asn), RequirePolicy: true}
+			p.section, p.addressFamily = "bgp", ""
+			return
+		}
+	case line == "router ospf" && p.cfg.OSPF == nil:
+		p.cfg.OSPF = &OSPFConfig{}
+		p.section = "ospf"
+		return
+	}
+	p.cfg.Extra = append(p.cfg.Extra, line)
+	p.section = ""
+}
+
+func (p *frrParser) parseBGP(statement string) {
+	bgp := p.cfg.BGP
+	fields := strings.Fields(statement)
+	switch {
+	case fields[0] == "address-family" && p.addressFamily == "":
+		p.addressFamily = strings.Join(fields[1:], " ")
+		return
+	case statement == "exit-address-family":
+		p.addressFamily = ""
+		return
+	case len(fields) == 2 && fields[0] == "network":
+		if prefix, err := netip.ParsePrefix(fields[1]); err == nil {
+			bgp.Networks = append(bgp.Networks, prefix)
+			return
+		}
+	case p.addressFamily == "" && statement == "no bgp ebgp-requires-policy":
+		bgp.RequirePolicy = false
+		return
+	case p.addressFamily == "" && len(fields) == 3 && fields[0] == "bgp" && fields[1] == "router-id":
+		if id, err := netip.ParseAddr(fields[2]); err == nil {
+			bgp.RouterID = id
+			return
+		}
+	case len(fields) >= 3 && fields[0] == "neighbor":
+		if p.parseBGPNeighbor(fields) {
+			return
+		}
+	}
+	if bgp.Extra == nil {
+		bgp.Extra = make(map[string][]string)
+	}
+	bgp.Extra[p.addressFamily] = append(bgp.Extra[p.addressFamily], statement)
+}
+
+// parseBGPNeighbor parses a neighbor statement and reports whether it is represented.
+func (p *frrParser) parseBGPNeighbor(fields []string) bool {
+	addr, err := netip.ParseAddr(fields[1])
+	if err != nil {
+		// Peer groups and interface neighbors
+		return false
+	}
+	if p.addressFamily == bgpIPv6Unicast && len(fields) == 3 && fields[2] == "activate" && addr.Is6() {
+		// Rendered for every IPv6 neighbor
+		return true
+	}
+	if p.addressFamily != "" || len(fields) < 4 {
+		return false
+	}
+
+	bgp := p.cfg.BGP
+	i := slices.IndexFunc(bgp.Neighbors, func(n BGPNeighbor) bool { return n.Address == addr })
+	if i < 0 {
+		if fields[2] != "remote-as" {
+			return false
+		}
+		bgp.Neighbors = append(bgp.Neighbors, BGPNeighbor{Address: addr})
+		i = len(bgp.Neighbors) - 1
+	}
+	neighbor := &bgp.Neighbors[i]
+
+	value := fields[3]
+	switch fields[2] {
+	case "remote-as":
+		asn, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || len(fields) != 4 {
+			return false
+		}
+		neighbor.RemoteASN = uint32(asn)
+	case "description":
+		neighbor.Description = strings.Join(fields[3:], " ")
+	case "password":
+		neighbor.Password = value
+	case "ebgp-multihop":
+		hops, err := strconv.Atoi(value)
+		if err != nil {
+			return false
+		}
+		neighbor.EBGPMultihop = hops
+	case "update-source":
+		neighbor.UpdateSource = value
+	default:
+		return false
+	}
+	return true
+}
+
+func (p *frrParser) parseOSPF(statement string) {
+	ospf := p.cfg.OSPF
+	fields := strings.Fields(statement)
+	switch {
+	case len(fields) == 3 && fields[0] == "ospf" && fields[1] == "router-id":
+		if id, err := netip.ParseAddr(fields[2]); err == nil {
+			ospf.RouterID = id
+			return
+		}
+	case len(fields) == 4 && fields[0] == "network" && fields[2] == "area":
+		if prefix, err := netip.ParsePrefix(fields[1]); err == nil {
+			ospf.Networks = append(ospf.Networks, OSPFNetwork{Prefix: prefix, Area: fields[3]})
+			return
+		}
+	case len(fields) == 2 && fields[0] == "passive-interface":
+		ospf.PassiveInterfaces = append(ospf.PassiveInterfaces, fields[1])
+		return
+	}
+	ospf.Extra = append(ospf.Extra, statement)
+}
+
+// FRRConfig renders the configuration in the frr.conf format the gateway runs. It
+// does not validate the configuration; see Validate.
+func (c *DynamicRoutingConfig) FRRConfig() string {
+	var out strings.Builder
+	for _, line := range c.Extra {
+		out.WriteString(line + "\n")
+	}
+	if len(c.Extra) > 0 {
+		out.WriteString("!\n")
+	}
+	if c.BGP != nil {
+		c.BGP.render(&out)
+	}
+	if c.OSPF != nil {
+		c.OSPF.render(&out)
+	}
+	return out.String()
+}
+
+func (b *BGPConfig) render(out *strings.Builder) {
+	fmt.Fprintf(out, "router bgp %d\n", b.ASN)
+	if b.RouterID.IsValid() {
+		fmt.Fprintf(out, " bgp router-id %s\n", b.RouterID)
+	}
+	if !b.RequirePolicy {
+		out.WriteString(" no bgp ebgp-requires-policy\n")
+	}
+	for _, neighbor := range b.Neighbors {
+		fmt.Fprintf(out, " neighbor %s remote-as %d\n", neighbor.Address, neighbor.RemoteASN)
+		if neighbor.Description != "" {
+			fmt.Fprintf(out, " neighbor %s description %s\n", neighbor.Address, neighbor.Description)
+		}
+		if neighbor.Password != "" {
+			fmt.Fprintf(out, " neighbor %s password %s\n", neighbor.Address, neighbor.Password)
+		}
+		if neighbor.EBGPMultihop > 0 {
+			fmt.Fprintf(out, " neighbor %s ebgp-multihop %d\n", neighbor.Address, neighbor.EBGPMultihop)
+		}
+		if neighbor.UpdateSource != "" {
+			fmt.Fprintf(out, " neighbor %s update-source %s\n", neighbor.Address, neighbor.UpdateSource)
+		}
+	}
+	for _, statement := range b.Extra[""] {
+		out.WriteString(" " + statement + "\n")
+	}
+
+	families := []string{bgpIPv4Unicast, bgpIPv6Unicast}
+	for family := range b.Extra {
+		if family != "" && !slices.Contains(families, family) {
+			families = append(families, family)
+		}
+	}
+	slices.Sort(families[2:])
+	for _, family := range families {
+		var statements []string
+		for _, prefix := range b.Networks {
+			if (family == bgpIPv4Unicast && prefix.Addr().Is4()) || (family == bgpIPv6Unicast && prefix.Addr().Is6()) {
+				statements = append(statements, "network "+prefix.String())
+			}
+		}
+		if family == bgpIPv6Unicast {
+			for _, neighbor := range b.Neighbors {
+				if neighbor.Address.Is6() {
+					statements = append(statements, fmt.Sprintf("neighbor %s activate", neighbor.Address))
+				}
+			}
+		}
+		statements = append(statements, b.Extra[family]...)
+		if len(statements) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(out, " !\n address-family %s\n", family)
+		for _, statement := range statements {
+			out.WriteString("  " + statement + "\n")
+		}
+		out.WriteString(" exit-address-family\n")
+	}
+	out.WriteString("exit\n!\n")
+}
+
+func (o *OSPFConfig) render(out *strings.Builder) {
+	out.WriteString("router ospf\n")
+	if o.RouterID.IsValid() {
+		fmt.Fprintf(out, " ospf router-id %s\n", o.RouterID)
+	}
+	for _, network := range o.Networks {
+		fmt.Fprintf(out, " network %s area %s\n", network.Prefix, network.Area)
+	}
+	for _, iface := range o.PassiveInterfaces {
+		fmt.Fprintf(out, " passive-interface %s\n", iface)
+	}
+	for _, statement := range o.Extra {
+		out.WriteString(" " + statement + "\n")
+	}
+	out.WriteString("exit\n!\n")
+}
@@ -0,0 +1,172 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const testRoutingPath = "/proxy/network/v2/api/site/" + testSiteInternal + "/bgp/config"
+
+func TestGetDynamicRoutingConfig(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testRoutingPath, testAPIKey,
+		testdata.LoadFixture(t, "routing/config.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	cfg, err := client.GetDynamicRoutingConfig(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	assert.True(t, cfg.Enabled)
+	assert.Equal(t, "Data center edge peering", cfg.Description)
+
+	require.NotNil(t, cfg.BGP)
+	assert.Equal(t, uint32(65001), cfg.BGP.ASN)
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), cfg.BGP.RouterID)
+	assert.True(t, cfg.BGP.RequirePolicy)
+	assert.Equal(t, []BGPNeighbor{
+		{Address: netip.MustParseAddr("10.0.0.2"), RemoteASN: 65002, Description: "dc-edge-1", Password: "s3cret"},
+		{Address: netip.MustParseAddr("2001:db8::2"), RemoteASN: 65002, UpdateSource: "eth8"},
+	}, cfg.BGP.Neighbors)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16"), netip.MustParsePrefix("2001:db8:1::/48")}, cfg.BGP.Networks)
+	assert.Equal(t, map[string][]string{
+		"":             {"neighbor UPSTREAM peer-group"},
+		"ipv4 unicast": {"neighbor 10.0.0.2 route-map FROM-DC in"},
+	}, cfg.BGP.Extra)
+
+	require.NotNil(t, cfg.OSPF)
+	assert.Equal(t, []OSPFNetwork{{Prefix: netip.MustParsePrefix("10.1.0.0/24"), Area: "0"}}, cfg.OSPF.Networks)
+	assert.Equal(t, []string{"br0"}, cfg.OSPF.PassiveInterfaces)
+	assert.Equal(t, []string{"redistribute connected"}, cfg.OSPF.Extra)
+
+	assert.Equal(t, []string{
+		"frr version 8.1",
+		"frr defaults traditional",
+		"hostname UDM-Pro",
+		"ip prefix-list DC seq 5 permit 10.50.0.0/16",
+		"route-map FROM-DC permit 10",
+		" match ip address prefix-list DC",
+		"exit",
+	}, cfg.Extra)
+}
+
+func TestFRRConfigRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var settings DynamicRoutingSettings
+	require.NoError(t, json.Unmarshal([]byte(testdata.LoadFixture(t, "routing/config.json")), &settings))
+	cfg := ParseFRRConfig(*settings.FRRConfig)
+
+	rendered := cfg.FRRConfig()
+	assert.Equal(t, cfg, ParseFRRConfig(rendered), "rendering keeps every statement")
+	assert.Contains(t, rendered, " address-family ipv6 unicast\n  network 2001:db8:1::/48\n  neighbor 2001:db8::2 activate\n exit-address-family\n")
+
+	cfg.BGP.RequirePolicy = false
+	assert.Contains(t, cfg.FRRConfig(), "router bgp 65001\n bgp router-id 10.0.0.1\n no bgp ebgp-requires-policy\n")
+}
+
+func TestUpdateDynamicRoutingConfig(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testRoutingPath, r.URL.Path)
+
+		var body DynamicRoutingSettings
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.True(t, body.Enabled)
+		assert.Equal(t, "router bgp 65001\n"+
+			" no bgp ebgp-requires-policy\n"+
+			" neighbor 10.0.0.2 remote-as 65002\n"+
+			" neighbor 10.0.0.2 ebgp-multihop 2\n"+
+			" !\n"+
+			" address-family ipv4 unicast\n"+
+			"  network 10.1.0.0/16\n"+
+			" exit-address-family\n"+
+			"exit\n"+
+			"!\n", *body.FRRConfig)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	cfg, err := client.UpdateDynamicRoutingConfig(context.Background(), testSiteInternal, &DynamicRoutingConfig{
+		Enabled: true,
+		BGP: &BGPConfig{
+			ASN:       65001,
+			Neighbors: []BGPNeighbor{{Address: netip.MustParseAddr("10.0.0.2"), RemoteASN: 65002, EBGPMultihop: 2}},
+			Networks:  []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cfg.BGP)
+	assert.Len(t, cfg.BGP.Neighbors, 1)
+	assert.Nil(t, cfg.OSPF)
+}
+
+func TestDynamicRoutingConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	peer := netip.MustParseAddr("10.0.0.2")
+	tests := []struct {
+		name    string
+		cfg     DynamicRoutingConfig
+		wantMsg string
+	}{
+		{name: "no ASN", cfg: DynamicRoutingConfig{BGP: &BGPConfig{}}, wantMsg: "ASN is required"},
+		{
+			name:    "IPv6 router ID",
+			cfg:     DynamicRoutingConfig{BGP: &BGPConfig{ASN: 65001, RouterID: netip.MustParseAddr("2001:db8::1")}},
+			wantMsg: "router ID must be an IPv4 address",
+		},
+		{
+			name:    "no remote ASN",
+			cfg:     DynamicRoutingConfig{BGP: &BGPConfig{ASN: 65001, Neighbors: []BGPNeighbor{{Address: peer}}}},
+			wantMsg: "needs a remote ASN",
+		},
+		{
+			name: "duplicate neighbor",
+			cfg: DynamicRoutingConfig{BGP: &BGPConfig{ASN: 65001, Neighbors: []BGPNeighbor{
+				{Address: peer, RemoteASN: 65002}, {Address: peer, RemoteASN: 65003},
+			}}},
+			wantMsg: "defined twice",
+		},
+		{
+			name:    "password with spaces",
+			cfg:     DynamicRoutingConfig{BGP: &BGPConfig{ASN: 65001, Neighbors: []BGPNeighbor{{Address: peer, RemoteASN: 65002, Password: "a b"}}}},
+			wantMsg: "password must not contain",
+		},
+		{
+			name:    "bad area",
+			cfg:     DynamicRoutingConfig{OSPF: &OSPFConfig{Networks: []OSPFNetwork{{Prefix: netip.MustParsePrefix("10.1.0.0/24"), Area: "backbone"}}}},
+			wantMsg: "OSPF area",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.cfg.Validate()
+			require.ErrorIs(t, err, unifierr.ErrValidation)
+			assert.Contains(t, err.Error(), tt.wantMsg)
+		})
+	}
+
+	assert.NoError(t, (&DynamicRoutingConfig{}).Validate())
+}
@@ -0,0 +1,156 @@
+package network
+
+import (
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidSchedule is returned when a PolicySchedule cannot be applied by the controller.
+var ErrInvalidSchedule = errors.New("invalid policy schedule")
+
+// Layouts of the times and dates of a PolicySchedule.
+const (
+	scheduleTimeLayout = "15:04"
+	scheduleDateLayout = "2006-01-02"
+)
+
+// Weekdays are Monday to Friday.
+var Weekdays = []ScheduleWeekday{ScheduleMonday, ScheduleTuesday, ScheduleWednesday, ScheduleThursday, ScheduleFriday}
+
+// Weekend is Saturday and Sunday.
+var Weekend = []ScheduleWeekday{ScheduleSaturday, ScheduleSunday}
+
+// allWeekdays lists the valid days in week order.
+var allWeekdays = append(slices.Clone(Weekdays), Weekend...)
+
+// ScheduleAlways returns a schedule keeping a policy active at all times.
+func ScheduleAlways() *PolicySchedule {
+	return &PolicySchedule{Mode: ScheduleModeAlways}
+}
+
+// ScheduleDaily returns a schedule active every day from start to end, given as HH:MM.
+func ScheduleDaily(start, end string) *PolicySchedule {
+	return &PolicySchedule{Mode: ScheduleModeEveryDay, TimeRangeStart: &start, TimeRangeEnd: &end}
+}
+
+// ScheduleWeekly returns a schedule active on days from start to end, given as HH:MM.
+//
+// Example:
+//
+//	// Block gaming consoles on school nights
+//	policy.Schedule = network.ScheduleWeekly("21:00", "07:00", network.ScheduleSunday,
+//	    network.ScheduleMonday, network.ScheduleTuesday, network.ScheduleWednesday, network.ScheduleThursday)
+func ScheduleWeekly(start, end string, days ...ScheduleWeekday) *PolicySchedule {
+	repeat := slices.Clone(days)
+	return &PolicySchedule{Mode: ScheduleModeEveryWeek, RepeatOnDays: &repeat, TimeRangeStart: &start, TimeRangeEnd: &end}
+}
+
+// ScheduleBusinessHours returns a schedule active Monday to Friday from 09:00 to 17:00.
+func ScheduleBusinessHours() *PolicySchedule {
+	return ScheduleWeekly("09:00", "17:00", Weekdays...)
+}
+
+// Validate checks that the schedule has the fields its mode requires, with valid
+// values. A time range may wrap past midnight, e.g. 22:00 to 06:00. Errors match
+// ErrInvalidSchedule.
+func (s *PolicySchedule) Validate() error {
+	switch s.Mode {
+	case ScheduleModeAlways:
+		return nil
+	case ScheduleModeEveryDay:
+		return s.validateTimeRange()
+	case ScheduleModeEveryWeek:
+		err := s.validateDays()
+		if err != nil {
+			return err
+		}
+		return s.validateTimeRange()
+	case ScheduleModeOneTime:
+		_, err := parseScheduleDate("date", s.Date)
+		if err != nil {
+			return err
+		}
+		return s.validateTimeRange()
+	case ScheduleModeCustom:
+		return s.validateCustom()
+	default:
+		return errors.Wrapf(ErrInvalidSchedule, "unknown mode %q", s.Mode)
+	}
+}
+
+// validateCustom checks a CUSTOM schedule: a date range, days and a time range.
+func (s *PolicySchedule) validateCustom() error {
+	first, err := parseScheduleDate("start date", s.DateStart)
+	if err != nil {
+		return err
+	}
+	last, err := parseScheduleDate("end date", s.DateEnd)
+	if err != nil {
+		return err
+	}
+	if last.Before(first) {
+		return errors.Wrapf(ErrInvalidSchedule, "end date %s is before start date %s", *s.DateEnd, *s.DateStart)
+	}
+
+	err = s.validateDays()
+	if err != nil {
+		return err
+	}
+	return s.validateTimeRange()
+}
+
+// validateDays checks that the schedule repeats on at least one day, each valid and listed once.
+func (s *PolicySchedule) validateDays() error {
+	if s.RepeatOnDays == nil || len(*s.RepeatOnDays) == 0 {
+		return errors.Wrapf(ErrInvalidSchedule, "%s schedule requires at least one day", s.Mode)
+	}
+
+	seen := make(map[ScheduleWeekday]bool, len(*s.RepeatOnDays))
+	for _, day := range *s.RepeatOnDays {
+		if !slices.Contains(allWeekdays, day) {
+			return errors.Wrapf(ErrInvalidSchedule, "unknown weekday %q", day)
+		}
+		if seen[day] {
+			return errors.Wrapf(ErrInvalidSchedule, "weekday %s is listed twice", day)
+		}
+		seen[day] = true
+	}
+	return nil
+}
+
+// validateTimeRange checks that the schedule is active all day or has a valid time range.
+func (s *PolicySchedule) validateTimeRange() error {
+	if s.TimeAllDay != nil && *s.TimeAllDay {
+		return nil
+	}
+	if s.TimeRangeStart == nil || s.TimeRangeEnd == nil {
+		return errors.Wrapf(ErrInvalidSchedule, "%s schedule requires a time range or all day", s.Mode)
+	}
+
+	start, err := time.Parse(scheduleTimeLayout, *s.TimeRangeStart)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSchedule, "start time %q is not HH:MM", *s.TimeRangeStart)
+	}
+	end, err := time.Parse(scheduleTimeLayout, *s.TimeRangeEnd)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSchedule, "end time %q is not HH:MM", *s.TimeRangeEnd)
+	}
+	if start.Equal(end) {
+		return errors.Wrapf(ErrInvalidSchedule, "time range %s-%s is empty", *s.TimeRangeStart, *s.TimeRangeEnd)
+	}
+	return nil
+}
+
+// parseScheduleDate parses a required YYYY-MM-DD schedule date.
+func parseScheduleDate(name string, value *string) (time.Time, error) {
+	if value == nil || *value == "" {
+		return time.Time{}, errors.Wrapf(ErrInvalidSchedule, "%s is required", name)
+	}
+	date, err := time.Parse(scheduleDateLayout, *value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ErrInvalidSchedule, "%s %q is not YYYY-MM-DD", name, *value)
+	}
+	return date, nil
+}
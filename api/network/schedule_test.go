@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestPolicyScheduleValidate(t *testing.T) {
+	t.Parallel()
+
+	allDay := true
+	days := func(d ...ScheduleWeekday) *[]ScheduleWeekday { return &d }
+
+	tests := []struct {
+		name     string
+		schedule *PolicySchedule
+		wantErr  bool
+	}{
+		{name: "always", schedule: ScheduleAlways()},
+		{name: "business hours", schedule: ScheduleBusinessHours()},
+		{name: "daily overnight", schedule: ScheduleDaily("22:00", "06:00")},
+		{name: "weekly all day", schedule: &PolicySchedule{Mode: ScheduleModeEveryWeek, RepeatOnDays: days(ScheduleSaturday), TimeAllDay: &allDay}},
+		{name: "one time", schedule: &PolicySchedule{Mode: ScheduleModeOneTime, Date: ptr("2025-12-24"), TimeAllDay: &allDay}},
+		{
+			name: "custom",
+			schedule: &PolicySchedule{
+				Mode: ScheduleModeCustom, DateStart: ptr("2025-06-01"), DateEnd: ptr("2025-08-31"),
+				RepeatOnDays: days(Weekend...), TimeRangeStart: ptr("10:00"), TimeRangeEnd: ptr("14:00"),
+			},
+		},
+		{name: "unknown mode", schedule: &PolicySchedule{Mode: "HOURLY"}, wantErr: true},
+		{name: "daily without times", schedule: &PolicySchedule{Mode: ScheduleModeEveryDay}, wantErr: true},
+		{name: "invalid time", schedule: ScheduleDaily("9am", "17:00"), wantErr: true},
+		{name: "out of range time", schedule: ScheduleDaily("09:00", "24:30"), wantErr: true},
+		{name: "empty time range", schedule: ScheduleDaily("09:00", "09:00"), wantErr: true},
+		{name: "weekly without days", schedule: ScheduleWeekly("09:00", "17:00"), wantErr: true},
+		{name: "unknown weekday", schedule: ScheduleWeekly("09:00", "17:00", "monday"), wantErr: true},
+		{name: "duplicate weekday", schedule: ScheduleWeekly("09:00", "17:00", ScheduleMonday, ScheduleMonday), wantErr: true},
+		{name: "one time without date", schedule: &PolicySchedule{Mode: ScheduleModeOneTime, TimeAllDay: &allDay}, wantErr: true},
+		{name: "one time with invalid date", schedule: &PolicySchedule{Mode: ScheduleModeOneTime, Date: ptr("24.12.2025"), TimeAllDay: &allDay}, wantErr: true},
+		{
+			name: "custom with reversed dates",
+			schedule: &PolicySchedule{
+				Mode: ScheduleModeCustom, DateStart: ptr("2025-08-31"), DateEnd: ptr("2025-06-01"),
+				RepeatOnDays: days(ScheduleMonday), TimeAllDay: &allDay,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.schedule.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidSchedule)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateFirewallPolicySchedule(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		var body struct {
+			Schedule map[string]any `json:"schedule"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"mode":             "EVERY_WEEK",
+			"repeat_on_days":   []any{"mon", "tue", "wed", "thu", "fri"},
+			"time_range_start": "09:00",
+			"time_range_end":   "17:00",
+		}, body.Schedule)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "firewall/single_policy.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	input := &FirewallPolicyInput{
+		Action:   FirewallPolicyInputActionDROP,
+		Enabled:  true,
+		Name:     testPolicyName,
+		Schedule: ScheduleBusinessHours(),
+	}
+
+	_, err := client.CreateFirewallPolicy(context.Background(), testSiteInternal, input)
+	require.NoError(t, err)
+
+	input.Schedule = ScheduleWeekly("09:00", "17:00")
+	_, err = client.CreateFirewallPolicy(context.Background(), testSiteInternal, input)
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+	assert.Equal(t, int32(1), requests.Load(), "invalid schedules must not reach the controller")
+}
@@ -0,0 +1,283 @@
+package network
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Access levels of capabilities.
+const (
+	AccessRead  = "read"
+	AccessWrite = "write"
+)
+
+// operationResources maps every operation to the resource it reads or writes.
+// Whether it reads or writes is given by operationWrites.
+var operationResources = map[string]string{
+	"ListSites":                   "sites",
+	"ListSiteDevices":             "devices",
+	"GetDeviceByID":               "devices",
+	"GetDeviceNeighbors":          "devices",
+	"GetPortStates":               "devices",
+	"ListRegulatoryChannels":      "devices",
+	"ListPendingAdoptionDevices":  "devices",
+	"AdoptWithSettings":           "devices",
+	"ApplyChannelPlan":            "devices",
+	"ListSiteClients":             "clients",
+	"GetClientByID":               "clients",
+	"ListKnownClients":            "clients",
+	"ListClientSessions":          "clients",
+	"BlockClient":                 "clients",
+	"UnblockClient":               "clients",
+	"KickClient":                  "clients",
+	"SteerClient":                 "clients",
+	"AssignClientToUserGroup":     "clients",
+	"ListHotspotVouchers":         "vouchers",
+	"GetHotspotVoucher":           "vouchers",
+	"CreateHotspotVouchers":       "vouchers",
+	"DeleteHotspotVoucher":        "vouchers",
+	"ListGuestAuthorizations":     "guests",
+	"UnauthorizeGuest":            "guests",
+	"ListDNSRecords":              "dns",
+	"CreateDNSRecord":             "dns",
+	"UpdateDNSRecord":             "dns",
+	"DeleteDNSRecord":             "dns",
+	"ListFirewallPolicies":        "firewall",
+	"ListFirewallZones":           "firewall",
+	"CreateFirewallPolicy":        "firewall",
+	"UpdateFirewallPolicy":        "firewall",
+	"DeleteFirewallPolicy":        "firewall",
+	"ListTrafficRules":            "traffic",
+	"ExportTrafficRules":          "traffic",
+	"GetDPIApplicationCatalog":    "traffic",
+	"CreateTrafficRule":           "traffic",
+	"UpdateTrafficRule":           "traffic",
+	"DeleteTrafficRule":           "traffic",
+	"ImportTrafficRules":          "traffic",
+	"BindTrafficRuleToUserGroup":  "traffic",
+	"SyncTrafficRuleTargets":      "traffic",
+	"UserGroupTargets":            "clients",
+	"ListUserGroups":              "usergroups",
+	"CreateUserGroup":             "usergroups",
+	"UpdateUserGroup":             "usergroups",
+	"DeleteUserGroup":             "usergroups",
+	"ListAPGroups":                "apgroups",
+	"CreateAPGroup":               "apgroups",
+	"UpdateAPGroup":               "apgroups",
+	"DeleteAPGroup":               "apgroups",
+	"AssignDeviceToGroup":         "apgroups",
+	"ListWLANs":                   "wlans",
+	"GetWLANMACFilter":            "wlans",
+	"UpdateWLANMACFilter":         "wlans",
+	"SetWLANClientIsolation":      "wlans",
+	"ListNetworks":                "networks",
+	"ListSiteToSiteVPNs":          "networks",
+	"CreateSiteToSiteVPN":         "networks",
+	"UpdateSiteToSiteVPN":         "networks",
+	"DeleteSiteToSiteVPN":         "networks",
+	"GetDynamicRoutingConfig":     "routing",
+	"UpdateDynamicRoutingConfig":  "routing",
+	"GetAggregatedDashboard":      "stats",
+	"GetSiteHealth":               "stats",
+	"ListAdminActivityLog":        "audit",
+	"PlanSiteClone":               "clone",
+	"CloneSiteConfig":             "clone",
+	"GetControllerStatus":         "controller",
+	"GetControllerTime":           "controller",
+	"GetControllerCertificate":    "controller",
+	"GenerateSupportFile":         "controller",
+	"UpdateControllerCertificate": "controller",
+	"Download":                    "downloads",
+}
+
+// operationWrites lists the operations that change the controller.
+var operationWrites = []string{
+	"AdoptWithSettings",
+	"ApplyChannelPlan",
+	"AssignClientToUserGroup",
+	"AssignDeviceToGroup",
+	"BindTrafficRuleToUserGroup",
+	"BlockClient",
+	"CloneSiteConfig",
+	"CreateAPGroup",
+	"CreateDNSRecord",
+	"CreateFirewallPolicy",
+	"CreateHotspotVouchers",
+	"CreateSiteToSiteVPN",
+	"CreateTrafficRule",
+	"CreateUserGroup",
+	"DeleteAPGroup",
+	"DeleteDNSRecord",
+	"DeleteFirewallPolicy",
+	"DeleteHotspotVoucher",
+	"DeleteSiteToSiteVPN",
+	"DeleteTrafficRule",
+	"DeleteUserGroup",
+	"GenerateSupportFile",
+	"ImportTrafficRules",
+	"KickClient",
+	"SetWLANClientIsolation",
+	"SteerClient",
+	"SyncTrafficRuleTargets",
+	"UnauthorizeGuest",
+	"UnblockClient",
+	"UpdateAPGroup",
+	"UpdateControllerCertificate",
+	"UpdateDNSRecord",
+	"UpdateDynamicRoutingConfig",
+	"UpdateFirewallPolicy",
+	"UpdateSiteToSiteVPN",
+	"UpdateTrafficRule",
+	"UpdateUserGroup",
+	"UpdateWLANMACFilter",
+}
+
+// rawResource is the resource of raw requests, which have no operation.
+const rawResource = "raw"
+
+// OperationCapability returns the capability a scoped client needs for operation,
+// such as "dns.write" for "CreateDNSRecord", and whether the operation is known.
+// See Operations for the operation names.
+func OperationCapability(operation string) (string, bool) {
+	resource, ok := operationResources[operation]
+	if !ok {
+		return "", false
+	}
+	if slices.Contains(operationWrites, operation) {
+		return resource + "." + AccessWrite, true
+	}
+	return resource + "." + AccessRead, true
+}
+
+// Scope is a set of capabilities granted to a scoped client, see NewScopedClient.
+type Scope struct {
+	patterns []string
+}
+
+// AllowOnly returns a scope granting the capabilities matching patterns. A pattern
+// is a resource and an access level, "read" or "write", joined by a dot, such as
+// "vouchers.read". Either part may be "*", and "*" alone grants everything; write
+// access does not imply read access. The resources are those of OperationCapability,
+// plus "raw" for DoRaw requests, whose access is read for GET, HEAD and OPTIONS.
+func AllowOnly(patterns ...string) Scope {
+	return Scope{patterns: slices.Clone(patterns)}
+}
+
+// Allows reports whether the scope grants capability.
+func (s Scope) Allows(capability string) bool {
+	resource, access, _ := strings.Cut(capability, ".")
+	for _, pattern := range s.patterns {
+		if pattern == "*" {
+			return true
+		}
+		wantResource, wantAccess, _ := strings.Cut(pattern, ".")
+		if (wantResource == "*" || wantResource == resource) && (wantAccess == "*" || wantAccess == access) {
+			return true
+		}
+	}
+	return false
+}
+
+// validate checks that every pattern names a known resource and access level.
+func (s Scope) validate() error {
+	resources := []string{"*", rawResource}
+	for _, resource := range operationResources {
+		resources = append(resources, resource)
+	}
+
+	for _, pattern := range s.patterns {
+		if pattern == "*" {
+			continue
+		}
+		resource, access, found := strings.Cut(pattern, ".")
+		if !found || !slices.Contains(resources, resource) || !slices.Contains([]string{"*", AccessRead, AccessWrite}, access) {
+			return errors.Wrapf(unifierr.ErrValidation, "invalid scope pattern %q", pattern)
+		}
+	}
+	return nil
+}
+
+// NewScopedClient returns a client sharing the configuration, middleware, cache and
+// site list of client, which refuses operations outside scope. Refused requests are
+// not sent: they fail with a *unifierr.ScopeError, matching unifierr.ErrOutOfScope,
+// and are logged as warnings through the Logger of client for auditing. Patterns
+// naming unknown resources or access levels return an error matching
+// unifierr.ErrValidation.
+//
+// Scopes are checked for each request, by the operation making it, so methods
+// combining several operations need the capabilities of all of them; for example,
+// DownloadSupportFile needs "controller.write" and "downloads.read". Resolving site
+// IDs goes through client and needs no capability.
+//
+// Example:
+//
+//	// Hand a DNS plugin a client that cannot touch anything else
+//	dnsClient, err := network.NewScopedClient(client, network.AllowOnly("dns.*", "vouchers.read"))
+//	...
+//	err = dnsClient.DeleteFirewallPolicy(ctx, "default", policyID) // errors.Is(err, unifierr.ErrOutOfScope)
+func NewScopedClient(client *APIClient, scope Scope) (*APIClient, error) {
+	err := scope.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	scoped := *client
+	transport := &scopeTransport{next: client.httpClient.Transport, scope: scope, logger: client.logger}
+
+	httpClient := *client.httpClient
+	httpClient.Transport = transport
+	downloader := *client.downloader
+	downloader.Transport = transport
+	scoped.httpClient = &httpClient
+	scoped.downloader = &downloader
+
+	generated, ok := client.client.ClientInterface.(*Client)
+	if !ok {
+		return nil, errors.New("scoped clients require a client created by New or NewWithConfig")
+	}
+	scopedGenerated := *generated
+	scopedGenerated.Client = &httpClient
+	scoped.client = &ClientWithResponses{&scopedGenerated}
+
+	return &scoped, nil
+}
+
+// scopeTransport refuses requests whose operation is outside scope.
+type scopeTransport struct {
+	next   http.RoundTripper
+	scope  Scope
+	logger observability.Logger
+}
+
+func (t *scopeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operation := middleware.Operation(req.Context())
+	capability, ok := OperationCapability(operation)
+	if !ok {
+		access := AccessWrite
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			access = AccessRead
+		}
+		capability = rawResource + "." + access
+	}
+
+	if !t.scope.Allows(capability) {
+		t.logger.Warn("operation refused by client scope",
+			observability.Field{Key: "operation", Value: operation},
+			observability.Field{Key: "capability", Value: capability},
+			observability.Field{Key: "method", Value: req.Method},
+			observability.Field{Key: "path", Value: req.URL.Path},
+		)
+		return nil, errors.WithStack(&unifierr.ScopeError{Operation: operation, Capability: capability})
+	}
+
+	//nolint:wrapcheck // Middleware passes through errors from next transport
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,150 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestOperationCapabilities(t *testing.T) {
+	t.Parallel()
+
+	for _, operation := range Operations() {
+		_, ok := OperationCapability(operation)
+		assert.True(t, ok, "operation %s has no capability", operation)
+	}
+	assert.Len(t, operationResources, len(Operations()), "capabilities of operations that do not exist")
+	for _, operation := range operationWrites {
+		assert.Contains(t, operationResources, operation)
+	}
+
+	capability, _ := OperationCapability("DeleteHotspotVoucher")
+	assert.Equal(t, "vouchers.write", capability)
+	capability, _ = OperationCapability("ListDNSRecords")
+	assert.Equal(t, "dns.read", capability)
+}
+
+func TestScopeAllows(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		patterns   []string
+		capability string
+		want       bool
+	}{
+		{name: "exact", patterns: []string{"vouchers.read"}, capability: "vouchers.read", want: true},
+		{name: "write does not imply read", patterns: []string{"vouchers.write"}, capability: "vouchers.read"},
+		{name: "any access", patterns: []string{"dns.*"}, capability: "dns.write", want: true},
+		{name: "any resource", patterns: []string{"*.read"}, capability: "firewall.read", want: true},
+		{name: "any resource read only", patterns: []string{"*.read"}, capability: "firewall.write"},
+		{name: "everything", patterns: []string{"*"}, capability: "raw.write", want: true},
+		{name: "other resource", patterns: []string{"dns.*", "vouchers.read"}, capability: "devices.read"},
+		{name: "empty", capability: "sites.read"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, AllowOnly(tt.patterns...).Allows(tt.capability))
+		})
+	}
+}
+
+func TestNewScopedClientInvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://unifi.local", testAPIKey)
+	require.NoError(t, err)
+
+	for _, pattern := range []string{"dsn.*", "dns", "dns.admin", ""} {
+		_, err = NewScopedClient(client, AllowOnly("vouchers.read", pattern))
+		require.ErrorIs(t, err, unifierr.ErrValidation, pattern)
+	}
+}
+
+// auditLogger records the fields of warnings on top of the noop logger.
+type auditLogger struct {
+	observability.Logger
+
+	mu       sync.Mutex
+	warnings []map[string]any
+}
+
+func (l *auditLogger) Warn(_ string, fields ...observability.Field) {
+	entry := make(map[string]any, len(fields))
+	for _, field := range fields {
+		entry[field.Key] = field.Value
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warnings = append(l.warnings, entry)
+}
+
+func TestNewScopedClient(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var paths []string
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/list_success.json")))
+	})
+	defer server.Close()
+
+	logger := &auditLogger{Logger: observability.NoopLogger()}
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, Logger: logger})
+	require.NoError(t, err)
+	scoped, err := NewScopedClient(client, AllowOnly("dns.read"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = scoped.ListDNSRecords(ctx, testSiteInternal)
+	require.NoError(t, err)
+
+	err = scoped.DeleteDNSRecord(ctx, testSiteInternal, testRecordID)
+	require.ErrorIs(t, err, unifierr.ErrOutOfScope)
+	var scopeErr *unifierr.ScopeError
+	require.ErrorAs(t, err, &scopeErr)
+	assert.Equal(t, unifierr.ScopeError{Operation: "DeleteDNSRecord", Capability: "dns.write"}, *scopeErr)
+
+	_, err = scoped.ListFirewallPolicies(ctx, testSiteInternal)
+	require.ErrorIs(t, err, unifierr.ErrOutOfScope)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, scoped.BuildURL(V2BasePath, "site", "default", "static-dns"), http.NoBody)
+	require.NoError(t, err)
+	_, err = scoped.DoRaw(req)
+	require.ErrorAs(t, err, &scopeErr)
+	assert.Equal(t, "raw.read", scopeErr.Capability)
+
+	// The parent client is not restricted
+	err = client.DeleteDNSRecord(ctx, testSiteInternal, testRecordID)
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{
+		"GET /proxy/network/v2/api/site/" + testSiteInternal + "/static-dns",
+		"DELETE /proxy/network/v2/api/site/" + testSiteInternal + "/static-dns/" + testRecordID,
+	}, paths)
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	require.Len(t, logger.warnings, 3)
+	assert.Equal(t, "DeleteDNSRecord", logger.warnings[0]["operation"])
+	assert.Equal(t, "dns.write", logger.warnings[0]["capability"])
+	assert.Equal(t, http.MethodDelete, logger.warnings[0]["method"])
+}
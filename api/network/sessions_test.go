@@ -0,0 +1,158 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+const testSessionsPath = "/proxy/network/api/s/" + testSiteInternal + "/stat/session"
+
+func sessionsServer(t *testing.T, window TimeRange) *APIClient {
+	t.Helper()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, testSessionsPath, r.URL.Path)
+
+		var body ClientSessionsRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, window.Start.Unix(), body.Start)
+		assert.Equal(t, window.End.Unix(), body.End)
+		if assert.NotNil(t, body.Type) {
+			assert.Equal(t, ClientSessionsAll, *body.Type)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sessions/list_success.json")))
+	})
+	t.Cleanup(server.Close)
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+	return client
+}
+
+func testSessionWindow() TimeRange {
+	start := time.Date(2025, time.October, 16, 0, 0, 0, 0, time.UTC)
+	return TimeRange{Start: start, End: start.Add(24 * time.Hour)}
+}
+
+func TestListClientSessions(t *testing.T) {
+	t.Parallel()
+
+	window := testSessionWindow()
+	client := sessionsServer(t, window)
+
+	sessions, err := client.ListClientSessions(context.Background(), testSiteInternal, NewClientSessionsRequest(window))
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "AA:BB:CC:14:01:56", sessions[0].Mac)
+	assert.Equal(t, int64(3600), *sessions[0].Duration)
+	assert.Nil(t, sessions[1].DisassocTime)
+}
+
+func TestAccountingRecords(t *testing.T) {
+	t.Parallel()
+
+	var resp ClientSessionsResponse
+	testdata.LoadFixtureJSON(t, "sessions/list_success.json", &resp)
+
+	records := AccountingRecords(resp.Data)
+	require.Len(t, records, 3)
+
+	tabletStart, cameraStart, tabletStop := records[0], records[1], records[2]
+	assert.Equal(t, AccountingStart, tabletStart.Status)
+	assert.Equal(t, "aa:bb:cc:14:01:56", tabletStart.MAC, "MAC should be normalized")
+	assert.Equal(t, "Tablet", tabletStart.Hostname, "alias should win over hostname")
+	assert.Equal(t, time.Unix(1760600000, 0).UTC(), tabletStart.Time)
+	assert.Zero(t, tabletStart.InputBytes)
+
+	assert.Equal(t, AccountingStart, cameraStart.Status)
+	assert.Equal(t, "camera", cameraStart.Hostname)
+	assert.True(t, cameraStart.Wired)
+
+	assert.Equal(t, AccountingStop, tabletStop.Status)
+	assert.Equal(t, tabletStart.SessionID, tabletStop.SessionID)
+	assert.Equal(t, time.Unix(1760603600, 0).UTC(), tabletStop.Time)
+	assert.Equal(t, int64(3600), tabletStop.SessionTime)
+	assert.Equal(t, int64(15728640), tabletStop.InputBytes)
+	assert.Equal(t, int64(524288000), tabletStop.OutputBytes)
+	assert.Equal(t, "Home", tabletStop.SSID)
+}
+
+func TestAccountingRecordsDurationFallback(t *testing.T) {
+	t.Parallel()
+
+	end := int64(1500)
+	records := AccountingRecords([]ClientSession{{Id: "s1", Mac: "not-a-mac", AssocTime: 1000, DisassocTime: &end}})
+	require.Len(t, records, 2)
+	assert.Equal(t, "not-a-mac", records[0].MAC, "invalid MACs should be kept as reported")
+	assert.Equal(t, int64(500), records[1].SessionTime)
+}
+
+func TestExportClientSessions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+
+		window := testSessionWindow()
+		client := sessionsServer(t, window)
+
+		var buf bytes.Buffer
+		n, err := client.ExportClientSessions(context.Background(), testSiteInternal, window, &buf, SessionExportCSV)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		rows, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 4)
+		assert.Equal(t, accountingCSVHeader, rows[0])
+		assert.Equal(t, []string{
+			"Stop", "2025-10-16T08:33:20Z", "68f0a1b2c3d4e5f6a7b8c9d1", "aa:bb:cc:14:01:56", "10.222.189.242",
+			"Tablet", "f4:e2:c6:00:00:01", "Home", "false", "3600", "15728640", "524288000",
+		}, rows[3])
+	})
+
+	t.Run("json lines", func(t *testing.T) {
+		t.Parallel()
+
+		window := testSessionWindow()
+		client := sessionsServer(t, window)
+
+		var buf bytes.Buffer
+		n, err := client.ExportClientSessions(context.Background(), testSiteInternal, window, &buf, SessionExportJSON)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 3)
+		var record AccountingRecord
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+		assert.Equal(t, AccountingStart, record.Status)
+		assert.Equal(t, "aa:bb:cc:20:00:01", record.MAC)
+		assert.True(t, record.Wired)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := New("https://unifi.invalid", testAPIKey)
+		require.NoError(t, err)
+
+		_, err = client.ExportClientSessions(context.Background(), testSiteInternal, testSessionWindow(), &bytes.Buffer{}, "xml")
+		require.ErrorIs(t, err, ErrUnsupportedExportFormat)
+	})
+}
@@ -0,0 +1,359 @@
+package network
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// siteListPageSize is the page size used when loading the full site list.
+// It matches the maximum limit accepted by the Integration API.
+const siteListPageSize = 100
+
+// ErrSiteNotFound is returned when a site identifier matches neither a site UUID
+// nor a site internal reference known to the controller.
+var ErrSiteNotFound = errors.New("site not found")
+
+// SiteResolver translates between the two site identifiers used by the Network API:
+// the UUID used by Integration v1 endpoints (SiteId) and the internal reference
+// used by v2 endpoints (Site, e.g. "default").
+//
+// The site list is loaded lazily on first use and cached. An identifier that is not
+// found in the cache triggers a single refresh before ErrSiteNotFound is returned,
+// so sites created after the cache was filled are still resolved. Concurrent callers
+// that find the cache missing or stale share one reload.
+//
+// SiteResolver is safe for concurrent use.
+type SiteResolver struct {
+	client NetworkAPIClient
+
+	// ttl is the age after which Sites reloads the list; zero keeps it until refreshed.
+	ttl time.Duration
+
+	// loadMu serializes reloads, so that concurrent callers do not list sites twice.
+	loadMu sync.Mutex
+
+	mu       sync.RWMutex
+	sites    []SiteListItem
+	loadedAt time.Time
+	loads    uint64 // number of completed loads, to detect concurrent reloads
+
+	// known holds the sites last reported to OnChange callbacks; it survives Invalidate.
+	known     map[SiteId]SiteListItem
+	callbacks []siteCallback
+	nextID    int
+}
+
+// SiteChange describes how the site list changed between two loads.
+type SiteChange struct {
+	// Added are the sites that appeared, in controller order.
+	Added []SiteListItem
+
+	// Removed are the sites that disappeared.
+	Removed []SiteListItem
+}
+
+type siteCallback struct {
+	id int
+	fn func(SiteChange)
+}
+
+// NewSiteResolver creates a SiteResolver that loads sites through the given client.
+// Its site list is kept until refreshed; clients created with NewWithConfig expire
+// theirs after ClientConfig.SiteListTTL.
+func NewSiteResolver(client NetworkAPIClient) *SiteResolver {
+	return &SiteResolver{client: client}
+}
+
+// Sites returns a snapshot of all sites, loading the list on first use and again
+// once it is older than the TTL. Callers may modify the returned slice.
+func (r *SiteResolver) Sites(ctx context.Context) ([]SiteListItem, error) {
+	r.mu.RLock()
+	sites, loads := r.sites, r.loads
+	fresh := sites != nil && (r.ttl <= 0 || time.Since(r.loadedAt) < r.ttl)
+	r.mu.RUnlock()
+
+	if !fresh {
+		err := r.reload(ctx, loads)
+		if err != nil {
+			return nil, err
+		}
+		r.mu.RLock()
+		sites = r.sites
+		r.mu.RUnlock()
+	}
+	return slices.Clone(sites), nil
+}
+
+// OnChange registers fn to be called after a load that added or removed sites,
+// including the first one, which reports every site as added. fn runs synchronously
+// in the goroutine that loaded the list, so it should not block. The returned
+// function unregisters fn.
+func (r *SiteResolver) OnChange(fn func(SiteChange)) (unregister func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.nextID++
+	id := r.nextID
+	r.callbacks = append(r.callbacks, siteCallback{id: id, fn: fn})
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.callbacks = slices.DeleteFunc(r.callbacks, func(cb siteCallback) bool { return cb.id == id })
+	}
+}
+
+// Watch refreshes the site list every interval until ctx is done, so that OnChange
+// callbacks fire without other activity. Failed refreshes are retried at the next
+// tick. It returns the context error.
+//
+// Example:
+//
+//	resolver.OnChange(func(change network.SiteChange) { ... })
+//	go resolver.Watch(ctx, time.Minute)
+func (r *SiteResolver) Watch(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "site watch stopped")
+		case <-ticker.C:
+			_ = r.Refresh(ctx) //nolint:errcheck // Retried at the next tick
+		}
+	}
+}
+
+// Resolve returns the site matching ref, which may be either a site UUID
+// or a site internal reference.
+func (r *SiteResolver) Resolve(ctx context.Context, ref string) (*SiteListItem, error) {
+	if ref == "" {
+		return nil, errors.New("site identifier is required")
+	}
+
+	r.mu.RLock()
+	loads := r.loads
+	r.mu.RUnlock()
+
+	if site, ok := r.lookup(ref); ok {
+		return site, nil
+	}
+
+	err := r.reload(ctx, loads)
+	if err != nil {
+		return nil, err
+	}
+
+	if site, ok := r.lookup(ref); ok {
+		return site, nil
+	}
+
+	return nil, errors.Wrapf(ErrSiteNotFound, "unknown site identifier %q", ref)
+}
+
+// SiteID resolves ref to the site UUID used by Integration v1 endpoints.
+func (r *SiteResolver) SiteID(ctx context.Context, ref string) (SiteId, error) {
+	site, err := r.Resolve(ctx, ref)
+	if err != nil {
+		return SiteId{}, err
+	}
+	return site.Id, nil
+}
+
+// InternalReference resolves ref to the site internal reference used by v2 endpoints.
+func (r *SiteResolver) InternalReference(ctx context.Context, ref string) (Site, error) {
+	site, err := r.Resolve(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	return site.InternalReference, nil
+}
+
+// Refresh reloads the full site list from the controller, replacing the cache.
+// Concurrent refreshes share one reload.
+func (r *SiteResolver) Refresh(ctx context.Context) error {
+	r.mu.RLock()
+	loads := r.loads
+	r.mu.RUnlock()
+	return r.reload(ctx, loads)
+}
+
+// Invalidate drops the cached site list. The next resolution reloads it.
+func (r *SiteResolver) Invalidate() {
+	r.mu.Lock()
+	r.sites = nil
+	r.loadedAt = time.Time{}
+	r.mu.Unlock()
+}
+
+// reload loads the site list unless another caller completed a load since the
+// caller observed seen loads, in which case the list is already up to date.
+func (r *SiteResolver) reload(ctx context.Context, seen uint64) error {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+
+	r.mu.RLock()
+	reloaded := r.loads != seen
+	r.mu.RUnlock()
+	if reloaded {
+		return nil
+	}
+
+	var sites []SiteListItem
+
+	offset := 0
+	limit := siteListPageSize
+	for {
+		page, err := r.client.ListSites(ctx, &ListSitesParams{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return errors.Wrap(err, "failed to load sites for resolution")
+		}
+
+		sites = append(sites, page.Data...)
+		offset += len(page.Data)
+
+		if len(page.Data) == 0 || offset >= page.TotalCount {
+			break
+		}
+	}
+	if sites == nil {
+		sites = []SiteListItem{}
+	}
+
+	r.mu.Lock()
+	r.sites = sites
+	r.loadedAt = time.Now()
+	r.loads++
+	change := r.diffLocked(sites)
+	callbacks := slices.Clone(r.callbacks)
+	r.mu.Unlock()
+
+	if len(change.Added) > 0 || len(change.Removed) > 0 {
+		for _, cb := range callbacks {
+			cb.fn(change)
+		}
+	}
+	return nil
+}
+
+// diffLocked compares sites with the sites last reported and records them as known.
+func (r *SiteResolver) diffLocked(sites []SiteListItem) SiteChange {
+	var change SiteChange
+	current := make(map[SiteId]SiteListItem, len(sites))
+	for _, site := range sites {
+		current[site.Id] = site
+		if _, found := r.known[site.Id]; !found {
+			change.Added = append(change.Added, site)
+		}
+	}
+	for id, site := range r.known {
+		if _, found := current[id]; !found {
+			change.Removed = append(change.Removed, site)
+		}
+	}
+	slices.SortFunc(change.Removed, func(a, b SiteListItem) int {
+		return strings.Compare(a.InternalReference, b.InternalReference)
+	})
+	r.known = current
+	return change
+}
+
+func (r *SiteResolver) lookup(ref string) (*SiteListItem, bool) {
+	id, isUUID := parseSiteUUID(ref)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for i := range r.sites {
+		site := r.sites[i]
+		if (isUUID && site.Id == id) || site.InternalReference == ref {
+			return &site, true
+		}
+	}
+
+	return nil, false
+}
+
+// siteScopedPaths are the path prefixes, under the Network application, that are
+// followed by a site identifier.
+var siteScopedPaths = []string{
+	NetworkBasePath + "/integration/v1/sites/",
+	NetworkBasePath + "/api/s/",
+	NetworkBasePath + "/v2/api/site/",
+}
+
+// mutationKey returns the site a request path targets, as its internal reference,
+// or "" for paths outside any site. Sites missing from the cached site list are
+// resolved, loading the list, so that a site addressed by UUID and by internal
+// reference gets one key. If resolution fails, the identifier from the path is used.
+func (r *SiteResolver) mutationKey(ctx context.Context, path string) string {
+	for _, prefix := range siteScopedPaths {
+		rest, found := strings.CutPrefix(path, prefix)
+		if !found {
+			continue
+		}
+		ref, _, _ := strings.Cut(rest, "/")
+		site, err := r.Resolve(ctx, ref)
+		if err != nil {
+			return ref
+		}
+		return site.InternalReference
+	}
+	return ""
+}
+
+// parseSiteUUID parses ref as a site UUID, reporting whether it is one
+// rather than an internal reference.
+func parseSiteUUID(ref string) (SiteId, bool) {
+	var id SiteId
+	err := id.UnmarshalText([]byte(ref))
+	return id, err == nil
+}
+
+// SiteResolver returns the resolver used by the client to translate site identifiers.
+func (c *APIClient) SiteResolver() *SiteResolver {
+	return c.sites
+}
+
+// Sites returns a snapshot of all sites from the client's memoized site list, which
+// is shared with site resolution and reloaded once older than ClientConfig.SiteListTTL.
+// Unlike ListSites it does not call the controller on every use. Register OnChange
+// callbacks on SiteResolver to be notified of added and removed sites.
+//
+// Example:
+//
+//	sites, err := client.Sites(ctx)
+//	for _, site := range sites {
+//	    fmt.Println(site.InternalReference, site.Name)
+//	}
+func (c *APIClient) Sites(ctx context.Context) ([]SiteListItem, error) {
+	//nolint:wrapcheck // SiteResolver returns wrapped errors
+	return c.sites.Sites(ctx)
+}
+
+// ResolveSiteID resolves a site UUID or internal reference to the site UUID
+// expected by Integration v1 methods such as ListSiteDevices.
+func (c *APIClient) ResolveSiteID(ctx context.Context, ref string) (SiteId, error) {
+	//nolint:wrapcheck // SiteResolver returns wrapped errors
+	return c.sites.SiteID(ctx, ref)
+}
+
+// resolveSite translates a site UUID passed to a v2 method into the internal reference
+// expected by the controller. Internal references are passed through without a lookup.
+func (c *APIClient) resolveSite(ctx context.Context, site Site) (Site, error) {
+	if _, isUUID := parseSiteUUID(site); !isUUID {
+		return site, nil
+	}
+
+	ref, err := c.sites.InternalReference(ctx, site)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve site %s", site)
+	}
+	return ref, nil
+}
@@ -0,0 +1,403 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+const testSitesPath = "/proxy/network/integration/v1/sites"
+
+func TestSiteResolverResolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		ref     string
+		wantErr error
+	}{
+		{
+			name: "by UUID",
+			ref:  testSiteID.String(),
+		},
+		{
+			name: "by internal reference",
+			ref:  testSiteInternal,
+		},
+		{
+			name:    "unknown internal reference",
+			ref:     "missing",
+			wantErr: ErrSiteNotFound,
+		},
+		{
+			name:    "unknown UUID",
+			ref:     "00000000-0000-0000-0000-000000000001",
+			wantErr: ErrSiteNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, testSitesPath, testAPIKey,
+				testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			site, err := client.SiteResolver().Resolve(context.Background(), tt.ref)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testSiteID, site.Id)
+			assert.Equal(t, testSiteInternal, site.InternalReference)
+		})
+	}
+}
+
+func TestSiteResolverEmptyIdentifier(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://test.local", testAPIKey)
+	require.NoError(t, err)
+
+	_, err = client.SiteResolver().Resolve(context.Background(), "")
+	require.Error(t, err)
+}
+
+func TestSiteResolverCaching(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	resolver := client.SiteResolver()
+	ctx := context.Background()
+
+	for range 3 {
+		siteID, err := client.ResolveSiteID(ctx, testSiteInternal)
+		require.NoError(t, err)
+		assert.Equal(t, testSiteID, siteID)
+	}
+	assert.Equal(t, int32(1), calls.Load(), "known sites should be served from cache")
+
+	_, err = resolver.Resolve(ctx, "missing")
+	require.ErrorIs(t, err, ErrSiteNotFound)
+	assert.Equal(t, int32(2), calls.Load(), "unknown site should trigger one refresh")
+
+	resolver.Invalidate()
+	_, err = resolver.InternalReference(ctx, testSiteID.String())
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), calls.Load(), "invalidated cache should be reloaded")
+}
+
+func TestSites(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond) // Keep the load in flight for concurrent callers
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, SiteListTTL: 50 * time.Millisecond})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			sites, err := client.Sites(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, sites, 1)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load(), "concurrent callers should share one load")
+
+	sites, err := client.Sites(ctx)
+	require.NoError(t, err)
+	sites[0].Name = "changed"
+	_, err = client.ResolveSiteID(ctx, testSiteInternal)
+	require.NoError(t, err)
+	again, err := client.Sites(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Default", again[0].Name, "snapshots should not share memory with the cache")
+	assert.Equal(t, int32(1), calls.Load(), "resolution should use the memoized list")
+
+	time.Sleep(60 * time.Millisecond)
+	_, err = client.Sites(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load(), "an expired list should be reloaded")
+}
+
+func TestSiteResolverOnChange(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	refs := []string{"default", "branch"}
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		items := make([]string, len(refs))
+		for i, ref := range refs {
+			items[i] = fmt.Sprintf(`{"id":"00000000-0000-0000-0000-%012d","internalReference":%q,"name":%q}`, len(ref), ref, ref)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"offset":0,"limit":100,"count":%d,"totalCount":%d,"data":[%s]}`,
+			len(refs), len(refs), strings.Join(items, ","))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+	resolver := client.SiteResolver()
+	ctx := context.Background()
+
+	var changes []SiteChange
+	unregister := resolver.OnChange(func(change SiteChange) { changes = append(changes, change) })
+
+	names := func(sites []SiteListItem) []string {
+		var refs []string
+		for _, site := range sites {
+			refs = append(refs, site.InternalReference)
+		}
+		return refs
+	}
+
+	_, err := client.Sites(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, []string{"default", "branch"}, names(changes[0].Added), "the first load should report all sites")
+
+	require.NoError(t, resolver.Refresh(ctx))
+	assert.Len(t, changes, 1, "an unchanged list should not be reported")
+
+	mu.Lock()
+	refs = []string{"default", "warehouse"}
+	mu.Unlock()
+	resolver.Invalidate()
+	_, err = resolver.Resolve(ctx, "warehouse")
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, []string{"warehouse"}, names(changes[1].Added))
+	assert.Equal(t, []string{"branch"}, names(changes[1].Removed))
+
+	unregister()
+	mu.Lock()
+	refs = []string{"default"}
+	mu.Unlock()
+	require.NoError(t, resolver.Refresh(ctx))
+	assert.Len(t, changes, 2, "unregistered callbacks should not be called")
+}
+
+func TestSiteResolverWatch(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := client.SiteResolver().Watch(ctx, 10*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.GreaterOrEqual(t, calls.Load(), int32(2))
+}
+
+func TestSiteResolverPagination(t *testing.T) {
+	t.Parallel()
+
+	const totalSites = 150
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		items := ""
+		count := 0
+		for i := offset; i < totalSites && count < limit; i++ {
+			if count > 0 {
+				items += ","
+			}
+			items += fmt.Sprintf(`{"id":"00000000-0000-0000-0000-%012d","internalReference":"site%d","name":"Site %d"}`, i, i, i)
+			count++
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"offset":%d,"limit":%d,"count":%d,"totalCount":%d,"data":[%s]}`,
+			offset, limit, count, totalSites, items)
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	ref, err := client.SiteResolver().InternalReference(context.Background(), "00000000-0000-0000-0000-000000000149")
+	require.NoError(t, err)
+	assert.Equal(t, "site149", ref)
+}
+
+func TestSiteResolverListError(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testSitesPath, testAPIKey,
+		testdata.LoadFixture(t, "errors/unauthorized.json"), http.StatusUnauthorized)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	_, err = client.ResolveSiteID(context.Background(), testSiteInternal)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrSiteNotFound))
+}
+
+func TestV2MethodAcceptsSiteUUID(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		testSitesPath: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+		},
+		"/proxy/network/v2/api/site/" + testSiteInternal + "/static-dns": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "dns/list_success.json")))
+		},
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	records, err := client.ListDNSRecords(context.Background(), testSiteID.String())
+	require.NoError(t, err)
+	assert.Len(t, records, 3)
+
+	err = client.DeleteDNSRecord(context.Background(), "00000000-0000-0000-0000-000000000001", testRecordID)
+	require.ErrorIs(t, err, ErrSiteNotFound)
+}
+
+func TestSiteResolverMutationKey(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testSitesPath, testAPIKey,
+		testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+	resolver := client.SiteResolver()
+
+	ctx := context.Background()
+	integrationPath := "/proxy/network/integration/v1/sites/" + testSiteID.String() + "/devices"
+	assert.Equal(t, testSiteInternal, resolver.mutationKey(ctx, integrationPath),
+		"UUIDs are resolved even before the site list is cached")
+	assert.Equal(t, "unknown", resolver.mutationKey(ctx, "/proxy/network/api/s/unknown/rest/user"),
+		"unknown sites keep the identifier from the path")
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "integration", path: integrationPath, want: testSiteInternal},
+		{name: "legacy", path: "/proxy/network/api/s/" + testSiteInternal + "/rest/user", want: testSiteInternal},
+		{name: "v2", path: "/proxy/network/v2/api/site/" + testSiteInternal + "/trafficrules/1", want: testSiteInternal},
+		{name: "site collection", path: "/proxy/network/integration/v1/sites", want: ""},
+		{name: "outside sites", path: "/proxy/network/api/self", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, resolver.mutationKey(ctx, tt.path))
+		})
+	}
+}
+
+func TestSerializeSiteMutationsColdResolver(t *testing.T) {
+	t.Parallel()
+
+	voucherID := types.UUID{0x50, 0x7f, 0x1f, 0x77, 0xbc, 0xf8, 0x6c, 0xd7, 0x99, 0x43, 0x90, 0x13, 0x00, 0x00, 0x00, 0x00}
+
+	var inFlight, maxInFlight atomic.Int32
+	mutate := func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		testSitesPath: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+		},
+		testSitesPath + "/" + testSiteID.String() + "/hotspot/vouchers/" + voucherID.String(): mutate,
+		"/proxy/network/v2/api/site/" + testSiteInternal + "/static-dns/" + testRecordID:      mutate,
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL:          server.URL,
+		APIKey:                 testAPIKey,
+		SerializeSiteMutations: true,
+	})
+	require.NoError(t, err)
+
+	// One mutation addresses the site by UUID, the other by internal reference,
+	// before the site list has been loaded.
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	wg.Go(func() { errs[0] = client.DeleteHotspotVoucher(ctx, testSiteID, voucherID) })
+	wg.Go(func() { errs[1] = client.DeleteDNSRecord(ctx, testSiteInternal, testRecordID) })
+	wg.Wait()
+
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	assert.Equal(t, int32(1), maxInFlight.Load(), "mutations of one site must not overlap")
+}
@@ -0,0 +1,225 @@
+package network
+
+import (
+	"cmp"
+	"net/netip"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Purpose and VPN type of the networks that are manual site-to-site IPsec VPNs.
+const (
+	siteToSiteVPNPurpose = "site-vpn"
+	siteToSiteVPNType    = "ipsec-vpn"
+)
+
+// IPsecPhase1 are the IKE (phase 1) parameters of a site-to-site VPN, which
+// authenticate the peers and protect the key exchange.
+type IPsecPhase1 struct {
+	KeyExchange IPsecKeyExchange
+	Encryption  IPsecEncryption
+	Hash        IPsecHash
+
+	// DHGroup is the Diffie-Hellman group, e.g. 14 for 2048-bit MODP
+	DHGroup int
+
+	// Lifetime is the lifetime of the security association, in whole seconds
+	Lifetime time.Duration
+}
+
+// IPsecPhase2 are the ESP (phase 2) parameters of a site-to-site VPN, which
+// protect the tunneled traffic.
+type IPsecPhase2 struct {
+	Encryption IPsecEncryption
+	Hash       IPsecHash
+
+	// PFSGroup is the Diffie-Hellman group of perfect forward secrecy; zero disables it
+	PFSGroup int
+
+	// Lifetime is the lifetime of the security association, in whole seconds
+	Lifetime time.Duration
+}
+
+// DefaultIPsecPhase1 returns the phase 1 parameters NewSiteToSiteVPNInput uses:
+// IKEv2 with AES-256, SHA-256 and DH group 14, rekeyed every 8 hours.
+func DefaultIPsecPhase1() IPsecPhase1 {
+	return IPsecPhase1{
+		KeyExchange: IPsecKeyExchangeIKEv2,
+		Encryption:  IPsecEncryptionAES256,
+		Hash:        IPsecHashSHA256,
+		DHGroup:     14,
+		Lifetime:    8 * time.Hour,
+	}
+}
+
+// DefaultIPsecPhase2 returns the phase 2 parameters NewSiteToSiteVPNInput uses:
+// AES-256 and SHA-256 with perfect forward secrecy over DH group 14, rekeyed every hour.
+func DefaultIPsecPhase2() IPsecPhase2 {
+	return IPsecPhase2{
+		Encryption: IPsecEncryptionAES256,
+		Hash:       IPsecHashSHA256,
+		PFSGroup:   14,
+		Lifetime:   time.Hour,
+	}
+}
+
+// NewSiteToSiteVPNInput builds an enabled site-to-site IPsec VPN to the gateway at
+// peerIP, authenticated by the pre-shared key psk and routing remoteSubnets through
+// the tunnel, with the DefaultIPsecPhase1 and DefaultIPsecPhase2 parameters. Both
+// ends of the tunnel must use the same parameters; change them with SetPhase1 and
+// SetPhase2. RandomPassphrase makes a suitable key.
+//
+// Example:
+//
+//	psk, _ := network.RandomPassphrase(32)()
+//	vpn, err := client.CreateSiteToSiteVPN(ctx, "default",
+//	    network.NewSiteToSiteVPNInput("Branch office", "203.0.113.10", psk, "10.20.0.0/16"))
+func NewSiteToSiteVPNInput(name, peerIP, psk string, remoteSubnets ...string) *SiteToSiteVPNInput {
+	enabled := true
+	input := &SiteToSiteVPNInput{
+		Name:          name,
+		Purpose:       siteToSiteVPNPurpose,
+		VPNType:       siteToSiteVPNType,
+		Enabled:       &enabled,
+		PeerIP:        &peerIP,
+		PreSharedKey:  &psk,
+		RemoteSubnets: &remoteSubnets,
+	}
+	return input.SetPhase1(DefaultIPsecPhase1()).SetPhase2(DefaultIPsecPhase2())
+}
+
+// SetPhase1 sets the phase 1 parameters of the VPN and returns the input for chaining.
+func (in *SiteToSiteVPNInput) SetPhase1(phase IPsecPhase1) *SiteToSiteVPNInput {
+	dhGroup, lifetime := phase.DHGroup, int(phase.Lifetime/time.Second)
+	in.KeyExchange = &phase.KeyExchange
+	in.IKEEncryption = &phase.Encryption
+	in.IKEHash = &phase.Hash
+	in.IKEDHGroup = &dhGroup
+	in.IKELifetime = &lifetime
+	return in
+}
+
+// SetPhase2 sets the phase 2 parameters of the VPN and returns the input for chaining.
+func (in *SiteToSiteVPNInput) SetPhase2(phase IPsecPhase2) *SiteToSiteVPNInput {
+	pfs, dhGroup, lifetime := phase.PFSGroup > 0, phase.PFSGroup, int(phase.Lifetime/time.Second)
+	in.ESPEncryption = &phase.Encryption
+	in.ESPHash = &phase.Hash
+	in.PFS = &pfs
+	in.ESPDHGroup = &dhGroup
+	in.ESPLifetime = &lifetime
+	return in
+}
+
+// Phase1 returns the phase 1 parameters of the VPN. Unset fields read as zero values.
+func (v *SiteToSiteVPN) Phase1() IPsecPhase1 {
+	var phase IPsecPhase1
+	if v.KeyExchange != nil {
+		phase.KeyExchange = *v.KeyExchange
+	}
+	if v.IKEEncryption != nil {
+		phase.Encryption = *v.IKEEncryption
+	}
+	if v.IKEHash != nil {
+		phase.Hash = *v.IKEHash
+	}
+	if v.IKEDHGroup != nil {
+		phase.DHGroup = int(*v.IKEDHGroup)
+	}
+	if v.IKELifetime != nil {
+		phase.Lifetime = time.Duration(*v.IKELifetime) * time.Second
+	}
+	return phase
+}
+
+// Phase2 returns the phase 2 parameters of the VPN. Unset fields read as zero values,
+// and PFSGroup is zero when perfect forward secrecy is disabled.
+func (v *SiteToSiteVPN) Phase2() IPsecPhase2 {
+	var phase IPsecPhase2
+	if v.ESPEncryption != nil {
+		phase.Encryption = *v.ESPEncryption
+	}
+	if v.ESPHash != nil {
+		phase.Hash = *v.ESPHash
+	}
+	if v.PFS != nil && *v.PFS && v.ESPDHGroup != nil {
+		phase.PFSGroup = int(*v.ESPDHGroup)
+	}
+	if v.ESPLifetime != nil {
+		phase.Lifetime = time.Duration(*v.ESPLifetime) * time.Second
+	}
+	return phase
+}
+
+// isSiteToSiteVPN reports whether the network is a manual site-to-site IPsec VPN.
+func (v *SiteToSiteVPN) isSiteToSiteVPN() bool {
+	return v.Purpose != nil && *v.Purpose == siteToSiteVPNPurpose &&
+		v.VPNType != nil && *v.VPNType == siteToSiteVPNType
+}
+
+// siteToSiteVPNRequest returns a copy of vpn with the purpose and VPN type of
+// site-to-site VPNs filled in, after checking the fields that are set. A pre-shared
+// key is required when requirePSK is true, as it is on creation.
+func siteToSiteVPNRequest(vpn *SiteToSiteVPNInput, requirePSK bool) (SiteToSiteVPNInput, error) {
+	in := *vpn
+	in.Purpose = cmp.Or(in.Purpose, siteToSiteVPNPurpose)
+	in.VPNType = cmp.Or(in.VPNType, siteToSiteVPNType)
+
+	var errs []error
+	if in.Name == "" {
+		errs = append(errs, errors.Wrap(unifierr.ErrValidation, "VPN name is required"))
+	}
+	if in.Purpose != siteToSiteVPNPurpose || in.VPNType != siteToSiteVPNType {
+		errs = append(errs, errors.Wrapf(unifierr.ErrValidation, "VPN purpose and type must be %s and %s, got %s and %s",
+			siteToSiteVPNPurpose, siteToSiteVPNType, in.Purpose, in.VPNType))
+	}
+	if in.PeerIP != nil {
+		_, err := netip.ParseAddr(*in.PeerIP)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(unifierr.ErrValidation, "peer IP must be an IP address, got %q", *in.PeerIP))
+		}
+	}
+	if in.RemoteSubnets != nil {
+		for _, subnet := range *in.RemoteSubnets {
+			_, err := netip.ParsePrefix(subnet)
+			if err != nil {
+				errs = append(errs, errors.Wrapf(unifierr.ErrValidation, "remote subnet must be in CIDR notation, got %q", subnet))
+			}
+		}
+	}
+	if (in.PreSharedKey == nil && requirePSK) || (in.PreSharedKey != nil && *in.PreSharedKey == "") {
+		errs = append(errs, errors.Wrap(unifierr.ErrValidation, "pre-shared key is required"))
+	}
+
+	errs = append(errs,
+		validateIPsecEnum("key exchange", in.KeyExchange),
+		validateIPsecEnum("phase 1 encryption", in.IKEEncryption),
+		validateIPsecEnum("phase 1 hash", in.IKEHash),
+		validateIPsecEnum("phase 2 encryption", in.ESPEncryption),
+		validateIPsecEnum("phase 2 hash", in.ESPHash),
+		validateIPsecLifetime("phase 1", in.IKELifetime),
+		validateIPsecLifetime("phase 2", in.ESPLifetime),
+	)
+	return in, errors.Join(errs...)
+}
+
+// validateIPsecEnum rejects algorithms the controller does not know, if set.
+func validateIPsecEnum[E interface {
+	~string
+	IsKnown() bool
+}](field string, value *E) error {
+	if value == nil || (*value).IsKnown() {
+		return nil
+	}
+	return errors.Wrapf(unifierr.ErrValidation, "unknown %s %q", field, string(*value))
+}
+
+// validateIPsecLifetime rejects lifetimes shorter than a second, if set.
+func validateIPsecLifetime(phase string, seconds *int) error {
+	if seconds == nil || *seconds > 0 {
+		return nil
+	}
+	return errors.Wrapf(unifierr.ErrValidation, "%s lifetime must be at least a second, got %ds", phase, *seconds)
+}
@@ -0,0 +1,184 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const testSiteVPNID = "5f8a1b2c3d4e5f6a7b8c9d31"
+
+func TestNewSiteToSiteVPNInput(t *testing.T) {
+	t.Parallel()
+
+	input := NewSiteToSiteVPNInput("Branch office", "203.0.113.10", "secret", "10.20.0.0/16").
+		SetPhase2(IPsecPhase2{Encryption: IPsecEncryptionAES128, Hash: IPsecHashSHA1, Lifetime: 30 * time.Minute})
+	assert.Equal(t, siteToSiteVPNPurpose, input.Purpose)
+	assert.Equal(t, siteToSiteVPNType, input.VPNType)
+	assert.Equal(t, "secret", *input.PreSharedKey)
+	assert.Equal(t, IPsecKeyExchangeIKEv2, *input.KeyExchange)
+	assert.Equal(t, 14, *input.IKEDHGroup)
+	assert.Equal(t, 28800, *input.IKELifetime)
+	assert.Equal(t, IPsecEncryptionAES128, *input.ESPEncryption)
+	assert.False(t, *input.PFS, "no PFS group disables PFS")
+	assert.Equal(t, 1800, *input.ESPLifetime)
+}
+
+func TestListSiteToSiteVPNs(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testNetworksPath, testAPIKey,
+		testdata.LoadFixture(t, "networks/site_vpns.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	vpns, err := client.ListSiteToSiteVPNs(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.Len(t, vpns, 2, "other networks and VPN types are skipped")
+	assert.Equal(t, testSiteVPNID, vpns[0].Id)
+	assert.Equal(t, []string{"10.20.0.0/16"}, *vpns[0].RemoteSubnets)
+	assert.Equal(t, DefaultIPsecPhase1(), vpns[0].Phase1(), "numbers sent as strings are decoded")
+	assert.Equal(t, DefaultIPsecPhase2(), vpns[0].Phase2())
+
+	assert.Equal(t, IPsecPhase1{
+		KeyExchange: IPsecKeyExchangeIKEv1,
+		Encryption:  IPsecEncryption3DES,
+		Hash:        IPsecHashSHA1,
+		DHGroup:     2,
+		Lifetime:    8 * time.Hour,
+	}, vpns[1].Phase1())
+	assert.Zero(t, vpns[1].Phase2().PFSGroup, "disabled PFS has no group")
+}
+
+func TestCreateAndUpdateSiteToSiteVPN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		wantMethod string
+		wantPath   string
+		input      *SiteToSiteVPNInput
+		wantPSK    bool
+		call       func(client *APIClient, input *SiteToSiteVPNInput) (*SiteToSiteVPN, error)
+	}{
+		{
+			name:       "create",
+			wantMethod: http.MethodPost,
+			wantPath:   testNetworksPath,
+			input:      NewSiteToSiteVPNInput("Branch office", "203.0.113.10", "secret", "10.20.0.0/16"),
+			wantPSK:    true,
+			call: func(client *APIClient, input *SiteToSiteVPNInput) (*SiteToSiteVPN, error) {
+				return client.CreateSiteToSiteVPN(context.Background(), testSiteInternal, input)
+			},
+		},
+		{
+			name:       "update keeps the key",
+			wantMethod: http.MethodPut,
+			wantPath:   testNetworksPath + "/" + testSiteVPNID,
+			input:      (&SiteToSiteVPNInput{Name: "Branch office"}).SetPhase1(DefaultIPsecPhase1()),
+			call: func(client *APIClient, input *SiteToSiteVPNInput) (*SiteToSiteVPN, error) {
+				return client.UpdateSiteToSiteVPN(context.Background(), testSiteInternal, testSiteVPNID, input)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.wantMethod, r.Method)
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+
+				var body map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "site-vpn", body["purpose"])
+				assert.Equal(t, "ipsec-vpn", body["vpn_type"])
+				assert.Equal(t, "aes256", body["ipsec_ike_encryption"])
+				_, hasPSK := body["x_ipsec_pre_shared_key"]
+				assert.Equal(t, tt.wantPSK, hasPSK)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(testdata.LoadFixture(t, "networks/single_site_vpn.json")))
+			})
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			vpn, err := tt.call(client, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, testSiteVPNID, vpn.Id)
+			assert.Equal(t, DefaultIPsecPhase1(), vpn.Phase1())
+		})
+	}
+}
+
+func TestCreateSiteToSiteVPNValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://unifi.invalid", testAPIKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		input   *SiteToSiteVPNInput
+		wantMsg string
+	}{
+		{name: "missing key", input: NewSiteToSiteVPNInput("VPN", "203.0.113.10", ""), wantMsg: "pre-shared key is required"},
+		{name: "bad peer", input: NewSiteToSiteVPNInput("VPN", "gateway", "secret"), wantMsg: "peer IP"},
+		{name: "bad subnet", input: NewSiteToSiteVPNInput("VPN", "203.0.113.10", "secret", "10.20.0.0"), wantMsg: "CIDR"},
+		{
+			name:    "unknown algorithm",
+			input:   NewSiteToSiteVPNInput("VPN", "203.0.113.10", "secret").SetPhase1(IPsecPhase1{Encryption: "blowfish", Lifetime: time.Hour}),
+			wantMsg: "unknown phase 1 encryption",
+		},
+		{
+			name:    "short lifetime",
+			input:   NewSiteToSiteVPNInput("VPN", "203.0.113.10", "secret").SetPhase2(IPsecPhase2{Lifetime: time.Millisecond}),
+			wantMsg: "phase 2 lifetime",
+		},
+		{name: "other network", input: &SiteToSiteVPNInput{Name: "LAN", Purpose: "corporate"}, wantMsg: "purpose"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := client.CreateSiteToSiteVPN(context.Background(), testSiteInternal, tt.input)
+			require.ErrorIs(t, err, unifierr.ErrValidation)
+			assert.Contains(t, err.Error(), tt.wantMsg)
+		})
+	}
+}
+
+func TestDeleteSiteToSiteVPN(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, testNetworksPath+"/"+testSiteVPNID, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "clients/command_success.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteSiteToSiteVPN(context.Background(), testSiteInternal, testSiteVPNID))
+
+	err = client.DeleteSiteToSiteVPN(context.Background(), testSiteInternal, "")
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+}
@@ -0,0 +1,234 @@
+package network
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// MaxTrafficRuleEntries is the number of domains or IP entries the controller accepts
+// in a single traffic rule. DomainRules and IPRules split longer lists across rules.
+const MaxTrafficRuleEntries = 500
+
+// maxDomainLength is the longest valid DNS name, without the trailing dot.
+const maxDomainLength = 253
+
+// OpenListSource opens a domain or IP list by file path, or by http:// or https://
+// URL, for ReadDomainList and ReadIPList. URLs are fetched with httpClient, or
+// http.DefaultClient if it is nil. The caller must close the returned reader.
+func OpenListSource(ctx context.Context, httpClient *http.Client, source string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		f, err := os.Open(source)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open list %s", source)
+		}
+		return f, nil
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, http.NoBody)
+	if err != nil {
+		return nil, errors.Wrapf(unifierr.ErrValidation, "invalid list URL %q: %v", source, err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch list %s", req.URL.Redacted())
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Wrapf(errors.WithStack(response.NewAPIError(resp, nil)), "failed to fetch list %s", req.URL.Redacted())
+	}
+	return resp.Body, nil
+}
+
+// ReadDomainList reads one domain per line, in plain or hosts file format
+// ("0.0.0.0 example.com"). Blank lines, comments starting with # or ;, and local
+// hosts file names such as localhost are skipped. Domains are lowercased, stripped of a trailing dot and of a leading
+// "*." wildcard, which the controller implies, and deduplicated in order.
+// Invalid entries are reported together with their line numbers in an error
+// matching unifierr.ErrValidation.
+func ReadDomainList(r io.Reader) ([]string, error) {
+	var domains []string
+	seen := make(map[string]bool)
+	err := scanList(r, "domain", func(entry string) error {
+		hostsFormat := false
+		if fields := strings.Fields(entry); len(fields) == 2 {
+			if _, err := netip.ParseAddr(fields[0]); err == nil {
+				entry, hostsFormat = fields[1], true
+			}
+		}
+		domain := strings.TrimPrefix(strings.TrimSuffix(strings.ToLower(entry), "."), "*.")
+		if hostsFormat && !strings.Contains(domain, ".") {
+			return nil // local names such as localhost
+		}
+		if !validDomain(domain) {
+			return errors.Newf("invalid domain %q", entry)
+		}
+		if seen[domain] {
+			return nil
+		}
+		seen[domain] = true
+		domains = append(domains, domain)
+		return nil
+	})
+	return domains, err
+}
+
+// ReadIPList reads one IP address or CIDR subnet per line. Blank lines and comments
+// starting with # or ; are skipped; a comment may also follow the entry. Addresses
+// become single-host prefixes, subnets are masked to their network address, and
+// duplicates are dropped in order. Invalid entries are reported together with their
+// line numbers in an error matching unifierr.ErrValidation.
+func ReadIPList(r io.Reader) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	seen := make(map[netip.Prefix]bool)
+	err := scanList(r, "IP", func(entry string) error {
+		var prefix netip.Prefix
+		if strings.Contains(entry, "/") {
+			p, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return errors.Newf("invalid subnet %q", entry)
+			}
+			prefix = p.Masked()
+		} else {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return errors.Newf("invalid IP address %q", entry)
+			}
+			prefix = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+		}
+		if seen[prefix] {
+			return nil
+		}
+		seen[prefix] = true
+		prefixes = append(prefixes, prefix)
+		return nil
+	})
+	return prefixes, err
+}
+
+// scanList calls add with the entry of every non-blank, non-comment line of r,
+// collecting the errors it returns with their line numbers.
+func scanList(r io.Reader, kind string, add func(entry string) error) error {
+	var problems []string
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		entry := scanner.Text()
+		if i := strings.IndexAny(entry, "#;"); i >= 0 {
+			entry = entry[:i]
+		}
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		err := add(entry)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %v", line, err))
+		}
+	}
+	err := scanner.Err()
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s list", kind)
+	}
+	if len(problems) > 0 {
+		return errors.Wrapf(unifierr.ErrValidation, "invalid %s list: %s", kind, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// validDomain reports whether domain is a DNS name of at least two labels.
+func validDomain(domain string) bool {
+	if len(domain) == 0 || len(domain) > maxDomainLength {
+		return false
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// DomainRules returns traffic rules matching domains, copying everything else from
+// template, such as the action, target devices and schedule. Lists longer than
+// maxPerRule (MaxTrafficRuleEntries if zero or less) are split across rules whose
+// descriptions get a " (n/total)" suffix. No rules are returned for an empty list.
+//
+// Example:
+//
+//	src, err := network.OpenListSource(ctx, nil, "https://example.com/threat-domains.txt")
+//	...
+//	domains, err := network.ReadDomainList(src)
+//	...
+//	block, description := "BLOCK", "Threat feed"
+//	for _, rule := range network.DomainRules(network.TrafficRuleInput{
+//	    Enabled: true, Action: &block, Description: &description,
+//	}, domains, 0) {
+//	    _, err = client.CreateTrafficRule(ctx, "default", &rule)
+//	}
+func DomainRules(template TrafficRuleInput, domains []string, maxPerRule int) []TrafficRuleInput {
+	return chunkRules(template, domains, maxPerRule, func(rule *TrafficRuleInput, chunk []string) {
+		rule.MatchingTarget = TrafficRuleInputMatchingTargetDOMAIN
+		rule.Domains = &chunk
+	})
+}
+
+// IPRules returns traffic rules matching IP addresses and subnets, copying everything
+// else from template. Lists are split across rules like DomainRules does.
+func IPRules(template TrafficRuleInput, prefixes []netip.Prefix, maxPerRule int) []TrafficRuleInput {
+	entries := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		if prefix.IsSingleIP() {
+			entries[i] = prefix.Addr().String()
+		} else {
+			entries[i] = prefix.String()
+		}
+	}
+	return chunkRules(template, entries, maxPerRule, func(rule *TrafficRuleInput, chunk []string) {
+		rule.MatchingTarget = TrafficRuleInputMatchingTargetIP
+		rule.IpAddresses = &chunk
+	})
+}
+
+// chunkRules splits entries into rules built from template by set.
+func chunkRules(template TrafficRuleInput, entries []string, maxPerRule int,
+	set func(rule *TrafficRuleInput, chunk []string),
+) []TrafficRuleInput {
+	if maxPerRule <= 0 {
+		maxPerRule = MaxTrafficRuleEntries
+	}
+	total := (len(entries) + maxPerRule - 1) / maxPerRule
+	rules := make([]TrafficRuleInput, 0, total)
+	for i := 0; i < len(entries); i += maxPerRule {
+		rule := template
+		set(&rule, slices.Clone(entries[i:min(i+maxPerRule, len(entries))]))
+		if total > 1 {
+			description := strings.TrimSpace(fmt.Sprintf("%s (%d/%d)", valueOrZero(template.Description), len(rules)+1, total))
+			rule.Description = &description
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
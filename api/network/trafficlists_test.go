@@ -0,0 +1,145 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestReadDomainList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:  "plain list",
+			input: "# threat feed\nExample.COM\n\nmalware.example.net.\n*.tracker.example.org ; wildcard\nexample.com\n",
+			want:  []string{"example.com", "malware.example.net", "tracker.example.org"},
+		},
+		{
+			name:  "hosts file",
+			input: "127.0.0.1 localhost\n0.0.0.0 ads.example.com\n::1 ip6-localhost\n0.0.0.0 ads.example.com # again\n",
+			want:  []string{"ads.example.com"},
+		},
+		{
+			name:    "invalid entries",
+			input:   "good.example.com\nlocalhost\nbad domain.com\n-bad.example.com\n",
+			wantErr: `line 2: invalid domain "localhost"; line 3: invalid domain "bad domain.com"; line 4: invalid domain "-bad.example.com"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ReadDomainList(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, unifierr.ErrValidation)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadIPList(t *testing.T) {
+	t.Parallel()
+
+	got, err := ReadIPList(strings.NewReader("; DROP list\n192.0.2.0/24 ; SBL1\n198.51.100.7\n192.0.2.9/24\n2001:db8::/32\n198.51.100.7 # dup\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("192.0.2.0/24"),
+		netip.MustParsePrefix("198.51.100.7/32"),
+		netip.MustParsePrefix("2001:db8::/32"),
+	}, got)
+
+	_, err = ReadIPList(strings.NewReader("192.0.2.0/33\nexample.com\n"))
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+	assert.Contains(t, err.Error(), `line 1: invalid subnet "192.0.2.0/33"; line 2: invalid IP address "example.com"`)
+}
+
+func TestOpenListSource(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/feed.txt" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("192.0.2.0/24\n"))
+	})
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "feed.txt")
+	require.NoError(t, os.WriteFile(path, []byte("198.51.100.0/24\n"), 0o600))
+
+	for source, want := range map[string]string{server.URL + "/feed.txt": "192.0.2.0/24", path: "198.51.100.0/24"} {
+		src, err := OpenListSource(context.Background(), nil, source)
+		require.NoError(t, err)
+		prefixes, err := ReadIPList(src)
+		src.Close()
+		require.NoError(t, err)
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix(want)}, prefixes)
+	}
+
+	_, err := OpenListSource(context.Background(), server.Client(), server.URL+"/missing.txt")
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+	_, err = OpenListSource(context.Background(), nil, filepath.Join(t.TempDir(), "missing.txt"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestDomainRules(t *testing.T) {
+	t.Parallel()
+
+	block := "BLOCK"
+	description := "Threat feed"
+	template := TrafficRuleInput{Enabled: true, Action: &block, Description: &description}
+	domains := []string{"a.example.com", "b.example.com", "c.example.com", "d.example.com", "e.example.com"}
+
+	rules := DomainRules(template, domains, 2)
+	require.Len(t, rules, 3)
+	for i, want := range [][]string{{"a.example.com", "b.example.com"}, {"c.example.com", "d.example.com"}, {"e.example.com"}} {
+		assert.Equal(t, TrafficRuleInputMatchingTargetDOMAIN, rules[i].MatchingTarget)
+		assert.Equal(t, want, *rules[i].Domains)
+		assert.Equal(t, &block, rules[i].Action)
+		assert.True(t, rules[i].Enabled)
+	}
+	assert.Equal(t, "Threat feed (1/3)", *rules[0].Description)
+	assert.Equal(t, "Threat feed (3/3)", *rules[2].Description)
+	assert.Equal(t, "Threat feed", description, "template must not change")
+
+	single := DomainRules(template, domains, 0)
+	require.Len(t, single, 1)
+	assert.Equal(t, &description, single[0].Description)
+
+	assert.Empty(t, DomainRules(template, nil, 0))
+}
+
+func TestIPRules(t *testing.T) {
+	t.Parallel()
+
+	prefixes := []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24"), netip.MustParsePrefix("198.51.100.7/32"), netip.MustParsePrefix("2001:db8::1/128")}
+	rules := IPRules(TrafficRuleInput{Enabled: true}, prefixes, 2)
+	require.Len(t, rules, 2)
+	assert.Equal(t, TrafficRuleInputMatchingTargetIP, rules[0].MatchingTarget)
+	assert.Equal(t, []string{"192.0.2.0/24", "198.51.100.7"}, *rules[0].IpAddresses)
+	assert.Equal(t, []string{"2001:db8::1"}, *rules[1].IpAddresses)
+	assert.Equal(t, "(2/2)", *rules[1].Description)
+}
@@ -0,0 +1,258 @@
+package network
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// TrafficRuleImportMode selects what ImportTrafficRules does with rules of the site
+// that are missing from the import.
+type TrafficRuleImportMode string
+
+// Traffic rule import modes.
+const (
+	// TrafficRuleImportMerge creates and updates the imported rules and keeps the others.
+	TrafficRuleImportMerge TrafficRuleImportMode = "merge"
+
+	// TrafficRuleImportReplace also deletes the rules missing from the import,
+	// making the site match it exactly.
+	TrafficRuleImportReplace TrafficRuleImportMode = "replace"
+)
+
+// TrafficRuleImportResult lists the IDs of the rules changed by ImportTrafficRules.
+type TrafficRuleImportResult struct {
+	// Created holds the IDs assigned by the controller to created rules.
+	Created []RuleId
+
+	// Updated holds the IDs of existing rules overwritten by the import.
+	Updated []RuleId
+
+	// Deleted holds the IDs of rules removed in TrafficRuleImportReplace mode.
+	Deleted []RuleId
+}
+
+// ExportTrafficRules writes the traffic rules of a site to w as a JSON array in the
+// controller's native format, including fields not modeled by TrafficRule. Rules are
+// sorted by ID and indented, so successive exports diff cleanly in version control.
+// It returns the number of rules written.
+//
+// Example:
+//
+//	f, err := os.Create("trafficrules.json")
+//	...
+//	n, err := client.ExportTrafficRules(ctx, "default", f)
+func (c *APIClient) ExportTrafficRules(ctx context.Context, site Site, w io.Writer) (int, error) {
+	ctx = middleware.WithOperation(ctx, "ExportTrafficRules")
+	rules, err := c.rawTrafficRules(ctx, site)
+	if err != nil {
+		return 0, err
+	}
+	slices.SortFunc(rules, func(a, b rawTrafficRule) int { return cmp.Compare(a.id, b.id) })
+
+	out := make([]json.RawMessage, len(rules))
+	for i := range rules {
+		out[i] = rules[i].raw
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to encode traffic rules")
+	}
+	_, err = w.Write(append(data, '\n'))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to export traffic rules")
+	}
+	return len(rules), nil
+}
+
+// ImportTrafficRules applies traffic rules read from r, a JSON array as written by
+// ExportTrafficRules, to a site. Rules whose ID exists on the site are updated in
+// place, preserving their IDs; the others, including rules exported from another
+// site or controller, are created and get new IDs. In TrafficRuleImportReplace mode,
+// rules of the site missing from the import are deleted afterwards.
+//
+// The whole input is validated before the first change: a malformed document or a
+// rule that is not a JSON object is an error matching unifierr.ErrValidation. On a
+// failed request, the result lists the changes applied before it.
+//
+// Example:
+//
+//	f, err := os.Open("trafficrules.json")
+//	...
+//	result, err := client.ImportTrafficRules(ctx, "default", f, network.TrafficRuleImportReplace)
+func (c *APIClient) ImportTrafficRules(ctx context.Context, site Site, r io.Reader, mode TrafficRuleImportMode) (*TrafficRuleImportResult, error) {
+	ctx = middleware.WithOperation(ctx, "ImportTrafficRules")
+	if mode != TrafficRuleImportMerge && mode != TrafficRuleImportReplace {
+		return nil, errors.Wrapf(unifierr.ErrValidation, "unknown traffic rule import mode %q", mode)
+	}
+
+	imported, err := decodeTrafficRules(r)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.rawTrafficRules(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[RuleId]bool, len(current))
+	for _, rule := range current {
+		existing[rule.id] = true
+	}
+
+	result := &TrafficRuleImportResult{}
+	kept := make(map[RuleId]bool, len(imported))
+	for _, rule := range imported {
+		if rule.id != "" && existing[rule.id] {
+			err := c.putRawTrafficRule(ctx, site, rule)
+			if err != nil {
+				return result, err
+			}
+			result.Updated = append(result.Updated, rule.id)
+			kept[rule.id] = true
+			continue
+		}
+
+		id, err := c.postRawTrafficRule(ctx, site, rule)
+		if err != nil {
+			return result, err
+		}
+		result.Created = append(result.Created, id)
+	}
+
+	if mode == TrafficRuleImportReplace {
+		for _, rule := range current {
+			if kept[rule.id] {
+				continue
+			}
+			err := c.DeleteTrafficRule(ctx, site, rule.id)
+			if err != nil {
+				return result, errors.Wrap(err, "failed to import traffic rules")
+			}
+			result.Deleted = append(result.Deleted, rule.id)
+		}
+	}
+	return result, nil
+}
+
+// rawTrafficRule is a traffic rule in the controller's native JSON.
+type rawTrafficRule struct {
+	id  RuleId
+	raw json.RawMessage
+}
+
+// rawTrafficRules lists the traffic rules of a site as returned by the controller.
+func (c *APIClient) rawTrafficRules(ctx context.Context, site Site) ([]rawTrafficRule, error) {
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	errorMsg := "failed to list traffic rules for site " + site
+	resp, err := c.client.ListTrafficRulesWithResponse(ctx, site)
+	var data *[]TrafficRule
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	_, err = response.HandleDecoded(c.decoder, resp, body, data, err, errorMsg)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+
+	raws, err := response.RawArray(body)
+	if err != nil {
+		return nil, errors.Wrap(err, errorMsg)
+	}
+	rules := make([]rawTrafficRule, len(raws))
+	for i, raw := range raws {
+		rules[i] = rawTrafficRule{id: (*data)[i].UnderscoreId, raw: raw}
+	}
+	return rules, nil
+}
+
+// decodeTrafficRules reads an exported JSON array of traffic rules.
+func decodeTrafficRules(r io.Reader) ([]rawTrafficRule, error) {
+	var raws []json.RawMessage
+	err := json.NewDecoder(r).Decode(&raws)
+	if err != nil {
+		return nil, errors.Wrapf(unifierr.ErrValidation, "invalid traffic rule import: %v", err)
+	}
+
+	rules := make([]rawTrafficRule, len(raws))
+	for i, raw := range raws {
+		var rule struct {
+			ID RuleId `json:"_id"`
+		}
+		err := json.Unmarshal(raw, &rule)
+		if err != nil || !bytes.HasPrefix(bytes.TrimSpace(raw), []byte("{")) {
+			return nil, errors.Wrapf(unifierr.ErrValidation, "invalid traffic rule import: rule %d is not a JSON object", i)
+		}
+		rules[i] = rawTrafficRule{id: rule.ID, raw: raw}
+	}
+	return rules, nil
+}
+
+func (c *APIClient) putRawTrafficRule(ctx context.Context, site Site, rule rawTrafficRule) error {
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.UpdateTrafficRuleWithBodyWithResponse(ctx, site, rule.id, "application/json", bytes.NewReader(rule.raw))
+	var data *TrafficRule
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	_, err = response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to update traffic rule %s in site %s", rule.id, site))
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return err
+}
+
+// postRawTrafficRule creates a rule from its exported JSON without the ID, which the
+// controller assigns, and returns the new ID.
+func (c *APIClient) postRawTrafficRule(ctx context.Context, site Site, rule rawTrafficRule) (RuleId, error) {
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return "", err
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(rule.raw, &fields)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create traffic rule in site "+site)
+	}
+	delete(fields, "_id")
+	payload, err := json.Marshal(fields)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create traffic rule in site "+site)
+	}
+
+	resp, err := c.client.CreateTrafficRuleWithBodyWithResponse(ctx, site, "application/json", bytes.NewReader(payload))
+	var data *TrafficRule
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	created, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to create traffic rule in site "+site)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return "", err
+	}
+	return created.UnderscoreId, nil
+}
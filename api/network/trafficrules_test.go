@@ -0,0 +1,153 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestExportTrafficRules(t *testing.T) {
+	t.Parallel()
+
+	expectedPath := "/proxy/network/v2/api/site/" + testSiteInternal + "/trafficrules"
+	server := testutil.NewMockServer(t, expectedPath, testAPIKey, testdata.LoadFixture(t, "traffic/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	var buf bytes.Buffer
+	n, err := client.ExportTrafficRules(context.Background(), testSiteInternal, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	var rules []map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rules))
+	require.Len(t, rules, 2)
+	assert.Equal(t, "507f1f77bcf86cd799439012", rules[0]["_id"], "rules should be sorted by ID")
+	assert.Equal(t, "507f1f77bcf86cd799439013", rules[1]["_id"])
+	assert.Equal(t, []any{"KP"}, rules[0]["regions"], "unmodeled fields should be preserved")
+	assert.Contains(t, buf.String(), "\n  {\n", "export should be indented")
+}
+
+func TestImportTrafficRules(t *testing.T) {
+	t.Parallel()
+
+	const (
+		existingID = "507f1f77bcf86cd799439013"
+		removedID  = "507f1f77bcf86cd799439012"
+		createdID  = "507f1f77bcf86cd799439099"
+	)
+	input := `[
+		{"_id": "` + existingID + `", "action": "BLOCK", "matching_target": "INTERNET", "regions": []},
+		{"_id": "0123456789abcdef01234567", "action": "ALLOW", "matching_target": "REGION", "regions": ["NZ"]}
+	]`
+
+	tests := []struct {
+		name        string
+		mode        TrafficRuleImportMode
+		wantDeleted []RuleId
+	}{
+		{name: "merge", mode: TrafficRuleImportMerge},
+		{name: "replace", mode: TrafficRuleImportReplace, wantDeleted: []RuleId{removedID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			basePath := "/proxy/network/v2/api/site/" + testSiteInternal + "/trafficrules"
+			var mu sync.Mutex
+			var writes []string
+			bodies := map[string]map[string]any{}
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					_, _ = w.Write([]byte(testdata.LoadFixture(t, "traffic/list_success.json")))
+					return
+				}
+
+				call := r.Method + " " + strings.TrimPrefix(r.URL.Path, basePath)
+				var body map[string]any
+				if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+					_ = json.Unmarshal(data, &body)
+				}
+				mu.Lock()
+				writes = append(writes, call)
+				bodies[call] = body
+				mu.Unlock()
+
+				switch r.Method {
+				case http.MethodDelete:
+					w.WriteHeader(http.StatusOK)
+				case http.MethodPost:
+					_ = json.NewEncoder(w).Encode(map[string]any{"_id": createdID})
+				default:
+					_ = json.NewEncoder(w).Encode(body)
+				}
+			})
+			defer server.Close()
+
+			client := newTestClient(t, server.URL)
+
+			result, err := client.ImportTrafficRules(context.Background(), testSiteInternal, strings.NewReader(input), tt.mode)
+			require.NoError(t, err)
+			assert.Equal(t, []RuleId{existingID}, result.Updated)
+			assert.Equal(t, []RuleId{createdID}, result.Created)
+			assert.Equal(t, tt.wantDeleted, result.Deleted)
+
+			wantWrites := []string{"PUT /" + existingID, "POST "}
+			for _, id := range tt.wantDeleted {
+				wantWrites = append(wantWrites, "DELETE /"+id)
+			}
+			assert.Equal(t, wantWrites, writes)
+
+			assert.Equal(t, []any{}, bodies["PUT /"+existingID]["regions"], "updates should send the rule as exported")
+			assert.NotContains(t, bodies["POST "], "_id", "created rules should get a new ID")
+			assert.Equal(t, []any{"NZ"}, bodies["POST "]["regions"])
+		})
+	}
+}
+
+func TestImportTrafficRulesInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		mode  TrafficRuleImportMode
+	}{
+		{name: "malformed", input: `[{"_id": `, mode: TrafficRuleImportMerge},
+		{name: "not an array", input: `{"_id": "1"}`, mode: TrafficRuleImportMerge},
+		{name: "not an object", input: `[{"_id": "1"}, "rule"]`, mode: TrafficRuleImportMerge},
+		{name: "unknown mode", input: `[]`, mode: "sync"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusInternalServerError)
+			})
+			defer server.Close()
+
+			client := newTestClient(t, server.URL)
+
+			_, err := client.ImportTrafficRules(context.Background(), testSiteInternal, strings.NewReader(tt.input), tt.mode)
+			require.ErrorIs(t, err, unifierr.ErrValidation)
+		})
+	}
+}
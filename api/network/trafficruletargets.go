@@ -0,0 +1,290 @@
+package network
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// ClientTargets returns the traffic rule targets matching the clients with the given
+// MAC addresses, normalized, deduplicated and sorted, for TrafficRuleInput.TargetDevices.
+// Invalid addresses are an error matching ErrInvalidMAC.
+//
+// Example:
+//
+//	targets, err := network.ClientTargets("AA:BB:CC:00:00:01", "aa:bb:cc:00:00:02")
+//	...
+//	rule.TargetDevices = &targets
+func ClientTargets(macs ...string) ([]TrafficRuleTarget, error) {
+	normalized := make([]string, 0, len(macs))
+	for _, mac := range macs {
+		mac, err := NormalizeMAC(mac)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, mac)
+	}
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+
+	targets := make([]TrafficRuleTarget, len(normalized))
+	for i, mac := range normalized {
+		targets[i] = TrafficRuleTarget{Type: TrafficRuleTargetClient, ClientMAC: &mac}
+	}
+	return targets, nil
+}
+
+// UserGroupTargets returns the traffic rule targets matching the clients assigned to
+// a user group, sorted by MAC address. Offline clients are included, so rules keep
+// applying to them when they reconnect. A group without clients has no targets.
+func (c *APIClient) UserGroupTargets(ctx context.Context, site Site, groupID UserGroupId) ([]TrafficRuleTarget, error) {
+	ctx = middleware.WithOperation(ctx, "UserGroupTargets")
+	err := validateObjectID("user group", groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	known, err := c.ListKnownClients(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	return userGroupTargets(known, groupID)
+}
+
+func userGroupTargets(known []KnownClient, groupID UserGroupId) ([]TrafficRuleTarget, error) {
+	var macs []string
+	for i := range known {
+		if valueOrZero(known[i].UsergroupId) == groupID {
+			macs = append(macs, known[i].Mac)
+		}
+	}
+	return ClientTargets(macs...)
+}
+
+// BindTrafficRuleToUserGroup sets the targets of a traffic rule to the clients of a
+// user group and keeps them in sync: AssignClientToUserGroup updates the rules bound
+// in its site, so that they follow the membership of their groups. Only the targets
+// of the rule are changed; its other fields, including those not modeled by
+// TrafficRule, are preserved. A rule is bound to at most one group, and binding it
+// again replaces the previous group.
+//
+// Bindings are kept by the client, not the controller: they last until
+// UnbindTrafficRule, DeleteTrafficRule or the end of the client, and memberships
+// changed elsewhere, e.g. in the UniFi console, are picked up by
+// SyncTrafficRuleTargets. An empty group is an error matching unifierr.ErrValidation,
+// since a rule without targets would apply to no client.
+//
+// Example:
+//
+//	err := client.BindTrafficRuleToUserGroup(ctx, "default", ruleID, kidsGroupID)
+//	...
+//	// The rule now also targets the laptop
+//	err = client.AssignClientToUserGroup(ctx, "default", "aa:bb:cc:00:00:03", kidsGroupID)
+func (c *APIClient) BindTrafficRuleToUserGroup(ctx context.Context, site Site, ruleID RuleId, groupID UserGroupId) error {
+	ctx = middleware.WithOperation(ctx, "BindTrafficRuleToUserGroup")
+	err := validateObjectID("traffic rule", ruleID)
+	if err != nil {
+		return err
+	}
+	err = validateObjectID("user group", groupID)
+	if err != nil {
+		return err
+	}
+	site, err = c.resolveSite(ctx, site)
+	if err != nil {
+		return err
+	}
+
+	binding := ruleGroupBinding{site: site, group: groupID}
+	if _, err := c.syncTrafficRules(ctx, site, map[RuleId]ruleGroupBinding{ruleID: binding}); err != nil {
+		return err
+	}
+	c.ruleGroups.bind(ruleID, binding)
+	return nil
+}
+
+// UnbindTrafficRule stops keeping the targets of a traffic rule in sync with a user
+// group. The targets of the rule are left as they are. It reports whether the rule
+// was bound.
+func (c *APIClient) UnbindTrafficRule(ruleID RuleId) bool {
+	return c.ruleGroups.unbind(ruleID)
+}
+
+// SyncTrafficRuleTargets updates the targets of the traffic rules bound in a site with
+// BindTrafficRuleToUserGroup to the current clients of their groups, and returns the
+// IDs of the rules that changed. Call it after changing group memberships outside the
+// client, or periodically. Rules deleted from the controller are unbound and
+// reported as errors matching unifierr.ErrNotFound; the errors of rules that could
+// not be synced are joined, and the others are synced regardless.
+func (c *APIClient) SyncTrafficRuleTargets(ctx context.Context, site Site) ([]RuleId, error) {
+	ctx = middleware.WithOperation(ctx, "SyncTrafficRuleTargets")
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+	return c.syncTrafficRules(ctx, site, c.ruleGroups.inSite(site))
+}
+
+// syncTrafficRules sets the targets of rules in a resolved site to the clients of
+// their groups, updating only the rules whose targets differ.
+func (c *APIClient) syncTrafficRules(ctx context.Context, site Site, bindings map[RuleId]ruleGroupBinding) ([]RuleId, error) {
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+
+	known, err := c.ListKnownClients(ctx, site)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sync traffic rule targets")
+	}
+	rules, err := c.rawTrafficRules(ctx, site)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sync traffic rule targets")
+	}
+	byID := make(map[RuleId]rawTrafficRule, len(rules))
+	for _, rule := range rules {
+		byID[rule.id] = rule
+	}
+
+	var updated []RuleId
+	var errs []error
+	for _, ruleID := range slices.Sorted(maps.Keys(bindings)) {
+		groupID := bindings[ruleID].group
+		rule, ok := byID[ruleID]
+		if !ok {
+			if c.ruleGroups.unbind(ruleID) {
+				c.logger.Warn("traffic rule bound to a user group no longer exists, unbinding it",
+					observability.Field{Key: "rule_id", Value: ruleID},
+					observability.Field{Key: "site", Value: site},
+				)
+			}
+			errs = append(errs, errors.Wrapf(unifierr.ErrNotFound, "traffic rule %s in site %s", ruleID, site))
+			continue
+		}
+
+		targets, err := userGroupTargets(known, groupID)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to sync traffic rule %s", ruleID))
+			continue
+		}
+		if len(targets) == 0 {
+			errs = append(errs, errors.Wrapf(unifierr.ErrValidation,
+				"failed to sync traffic rule %s: user group %s has no clients", ruleID, groupID))
+			continue
+		}
+
+		changed, err := c.setTrafficRuleTargets(ctx, site, rule, targets)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if changed {
+			updated = append(updated, ruleID)
+		}
+	}
+	return updated, errors.Join(errs...)
+}
+
+// setTrafficRuleTargets replaces the targets of rule in its native JSON, keeping the
+// other fields, and reports whether it had to update the rule.
+func (c *APIClient) setTrafficRuleTargets(ctx context.Context, site Site, rule rawTrafficRule, targets []TrafficRuleTarget) (bool, error) {
+	errorMsg := "failed to set targets of traffic rule " + rule.id
+
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(rule.raw, &fields)
+	if err != nil {
+		return false, errors.Wrap(err, errorMsg)
+	}
+	var current []TrafficRuleTarget
+	if raw, ok := fields["target_devices"]; ok {
+		err := json.Unmarshal(raw, &current)
+		if err != nil {
+			return false, errors.Wrap(err, errorMsg)
+		}
+	}
+	if slices.EqualFunc(sortedTargets(current), targets, equalTargets) {
+		return false, nil
+	}
+
+	raw, err := json.Marshal(targets)
+	if err != nil {
+		return false, errors.Wrap(err, errorMsg)
+	}
+	fields["target_devices"] = raw
+	rule.raw, err = json.Marshal(fields)
+	if err != nil {
+		return false, errors.Wrap(err, errorMsg)
+	}
+	err = c.putRawTrafficRule(ctx, site, rule)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// sortedTargets returns targets ordered like ClientTargets, by type then MAC address.
+func sortedTargets(targets []TrafficRuleTarget) []TrafficRuleTarget {
+	return slices.SortedFunc(slices.Values(targets), func(a, b TrafficRuleTarget) int {
+		return cmp.Or(
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(strings.ToLower(valueOrZero(a.ClientMAC)), strings.ToLower(valueOrZero(b.ClientMAC))),
+			cmp.Compare(valueOrZero(a.NetworkID), valueOrZero(b.NetworkID)),
+		)
+	})
+}
+
+func equalTargets(a, b TrafficRuleTarget) bool {
+	return a.Type == b.Type && strings.EqualFold(valueOrZero(a.ClientMAC), valueOrZero(b.ClientMAC)) &&
+		valueOrZero(a.NetworkID) == valueOrZero(b.NetworkID)
+}
+
+// ruleGroupBinding is the user group whose clients a traffic rule targets.
+type ruleGroupBinding struct {
+	site  Site
+	group UserGroupId
+}
+
+// trafficRuleBindings holds the traffic rules bound to user groups.
+type trafficRuleBindings struct {
+	mu       sync.Mutex
+	bindings map[RuleId]ruleGroupBinding
+}
+
+func (b *trafficRuleBindings) bind(ruleID RuleId, binding ruleGroupBinding) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.bindings == nil {
+		b.bindings = make(map[RuleId]ruleGroupBinding)
+	}
+	b.bindings[ruleID] = binding
+}
+
+func (b *trafficRuleBindings) unbind(ruleID RuleId) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := b.bindings[ruleID]
+	delete(b.bindings, ruleID)
+	return ok
+}
+
+// inSite returns the bindings of the rules of a resolved site.
+func (b *trafficRuleBindings) inSite(site Site) map[RuleId]ruleGroupBinding {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	bindings := make(map[RuleId]ruleGroupBinding)
+	for ruleID, binding := range b.bindings {
+		if binding.site == site {
+			bindings[ruleID] = binding
+		}
+	}
+	return bindings
+}
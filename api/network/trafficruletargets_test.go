@@ -0,0 +1,244 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const (
+	testBoundRuleID    = "507f1f77bcf86cd799439013"
+	testOtherGroupID   = "5f8a1b2c3d4e5f6a7b8c9d0f"
+	testTrafficRuleAPI = "/proxy/network/v2/api/site/" + testSiteInternal + "/trafficrules"
+	testLegacyAPI      = "/proxy/network/api/s/" + testSiteInternal
+)
+
+// ruleTargetsController is a stateful mock of the traffic rule and known client
+// endpoints, recording the rules written.
+type ruleTargetsController struct {
+	t *testing.T
+
+	mu     sync.Mutex
+	rules  []map[string]any
+	known  []KnownClient
+	writes []map[string]any
+}
+
+func newRuleTargetsController(t *testing.T) *ruleTargetsController {
+	t.Helper()
+
+	c := &ruleTargetsController{t: t}
+	require.NoError(t, json.Unmarshal([]byte(testdata.LoadFixture(t, "traffic/list_success.json")), &c.rules))
+	group, other := testUserGroupID, testOtherGroupID
+	c.known = []KnownClient{
+		{Id: "60a1b2c3d4e5f6a7b8c9d0e1", Mac: "aa:bb:cc:00:00:01", UsergroupId: &group},
+		{Id: "60a1b2c3d4e5f6a7b8c9d0e2", Mac: "AA:BB:CC:00:00:02", UsergroupId: &group},
+		{Id: "60a1b2c3d4e5f6a7b8c9d0e3", Mac: "aa:bb:cc:00:00:03", UsergroupId: &other},
+		{Id: "60a1b2c3d4e5f6a7b8c9d0e4", Mac: "aa:bb:cc:00:00:04"},
+	}
+	return c
+}
+
+func (c *ruleTargetsController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == testTrafficRuleAPI:
+		_ = json.NewEncoder(w).Encode(c.rules)
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, testTrafficRuleAPI+"/"):
+		var rule map[string]any
+		assert.NoError(c.t, json.NewDecoder(r.Body).Decode(&rule))
+		for i := range c.rules {
+			if c.rules[i]["_id"] == strings.TrimPrefix(r.URL.Path, testTrafficRuleAPI+"/") {
+				c.rules[i] = rule
+			}
+		}
+		c.writes = append(c.writes, rule)
+		_ = json.NewEncoder(w).Encode(rule)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, testTrafficRuleAPI+"/"):
+		id := strings.TrimPrefix(r.URL.Path, testTrafficRuleAPI+"/")
+		for i := range c.rules {
+			if c.rules[i]["_id"] == id {
+				c.rules = append(c.rules[:i], c.rules[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == testLegacyAPI+"/rest/user":
+		_ = json.NewEncoder(w).Encode(KnownClientsResponse{Data: c.known, Meta: LegacyMeta{Rc: "ok"}})
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, testLegacyAPI+"/stat/user/"):
+		mac := strings.TrimPrefix(r.URL.Path, testLegacyAPI+"/stat/user/")
+		data := []KnownClient{}
+		for _, known := range c.known {
+			if strings.EqualFold(known.Mac, mac) {
+				data = append(data, known)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(KnownClientsResponse{Data: data, Meta: LegacyMeta{Rc: "ok"}})
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, testLegacyAPI+"/rest/user/"):
+		var input KnownClientInput
+		assert.NoError(c.t, json.NewDecoder(r.Body).Decode(&input))
+		for i := range c.known {
+			if c.known[i].Id == strings.TrimPrefix(r.URL.Path, testLegacyAPI+"/rest/user/") {
+				c.known[i].UsergroupId = &input.UsergroupId
+			}
+		}
+		_ = json.NewEncoder(w).Encode(KnownClientsResponse{Meta: LegacyMeta{Rc: "ok"}})
+	default:
+		c.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// lastWrite returns the last rule written and the number of writes.
+func (c *ruleTargetsController) lastWrite() (map[string]any, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.writes) == 0 {
+		return nil, 0
+	}
+	return c.writes[len(c.writes)-1], len(c.writes)
+}
+
+// targetMACs returns the client MAC addresses targeted by a written rule.
+func targetMACs(rule map[string]any) []string {
+	var macs []string
+	targets, _ := rule["target_devices"].([]any)
+	for _, target := range targets {
+		mac, _ := target.(map[string]any)["client_mac"].(string)
+		macs = append(macs, mac)
+	}
+	return macs
+}
+
+func TestClientTargets(t *testing.T) {
+	t.Parallel()
+
+	targets, err := ClientTargets("AA-BB-CC-00-00-02", "aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02")
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	for i, mac := range []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02"} {
+		assert.Equal(t, TrafficRuleTargetClient, targets[i].Type)
+		require.NotNil(t, targets[i].ClientMAC)
+		assert.Equal(t, mac, *targets[i].ClientMAC)
+	}
+
+	_, err = ClientTargets("bogus")
+	require.ErrorIs(t, err, ErrInvalidMAC)
+}
+
+func TestUserGroupTargets(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(newRuleTargetsController(t))
+	defer server.Close()
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	targets, err := client.UserGroupTargets(context.Background(), testSiteInternal, testUserGroupID)
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	assert.Equal(t, "aa:bb:cc:00:00:02", *targets[1].ClientMAC, "MAC addresses are normalized")
+
+	_, err = client.UserGroupTargets(context.Background(), testSiteInternal, "")
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+}
+
+func TestBindTrafficRuleToUserGroup(t *testing.T) {
+	t.Parallel()
+
+	controller := newRuleTargetsController(t)
+	server := httptest.NewServer(controller)
+	defer server.Close()
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, client.BindTrafficRuleToUserGroup(ctx, testSiteInternal, testBoundRuleID, testUserGroupID))
+	written, writes := controller.lastWrite()
+	require.Equal(t, 1, writes)
+	assert.Equal(t, []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02"}, targetMACs(written))
+	assert.Equal(t, "EVERY_DAY", written["schedule"].(map[string]any)["mode"], "fields not modeled are preserved")
+	assert.Equal(t, []any{"589885", "589886"}, written["app_ids"])
+
+	updated, err := client.SyncTrafficRuleTargets(ctx, testSiteInternal)
+	require.NoError(t, err)
+	assert.Empty(t, updated)
+	_, writes = controller.lastWrite()
+	assert.Equal(t, 1, writes, "rules already in sync are not written")
+
+	require.NoError(t, client.AssignClientToUserGroup(ctx, testSiteInternal, "aa:bb:cc:00:00:03", testUserGroupID))
+	written, writes = controller.lastWrite()
+	require.Equal(t, 2, writes, "assigning a client re-syncs the bound rules")
+	assert.Equal(t, []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02", "aa:bb:cc:00:00:03"}, targetMACs(written))
+
+	require.NoError(t, client.AssignClientToUserGroup(ctx, testSiteInternal, "aa:bb:cc:00:00:01", testOtherGroupID))
+	written, _ = controller.lastWrite()
+	assert.Equal(t, []string{"aa:bb:cc:00:00:02", "aa:bb:cc:00:00:03"}, targetMACs(written), "clients leaving the group are removed")
+
+	require.NoError(t, client.DeleteTrafficRule(ctx, testSiteInternal, testBoundRuleID))
+	assert.False(t, client.UnbindTrafficRule(testBoundRuleID), "deleting a rule unbinds it")
+}
+
+func TestBindTrafficRuleToUserGroupErrors(t *testing.T) {
+	t.Parallel()
+
+	controller := newRuleTargetsController(t)
+	server := httptest.NewServer(controller)
+	defer server.Close()
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = client.BindTrafficRuleToUserGroup(ctx, testSiteInternal, testBoundRuleID, "5f8a1b2c3d4e5f6a7b8c9d10")
+	require.ErrorIs(t, err, unifierr.ErrValidation, "empty groups are rejected")
+
+	err = client.BindTrafficRuleToUserGroup(ctx, testSiteInternal, "507f1f77bcf86cd799439099", testUserGroupID)
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+	err = client.BindTrafficRuleToUserGroup(ctx, testSiteInternal, testBoundRuleID, testClientMAC)
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+
+	_, writes := controller.lastWrite()
+	assert.Zero(t, writes)
+	assert.False(t, client.UnbindTrafficRule(testBoundRuleID), "failed bindings are not kept")
+}
+
+func TestSyncTrafficRuleTargetsDeletedRule(t *testing.T) {
+	t.Parallel()
+
+	controller := newRuleTargetsController(t)
+	server := httptest.NewServer(controller)
+	defer server.Close()
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, client.BindTrafficRuleToUserGroup(ctx, testSiteInternal, testBoundRuleID, testUserGroupID))
+
+	// The rule is deleted in the console
+	controller.mu.Lock()
+	controller.rules = controller.rules[1:]
+	controller.mu.Unlock()
+
+	_, err = client.SyncTrafficRuleTargets(ctx, testSiteInternal)
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+	assert.False(t, client.UnbindTrafficRule(testBoundRuleID))
+
+	updated, err := client.SyncTrafficRuleTargets(ctx, testSiteInternal)
+	require.NoError(t, err)
+	assert.Empty(t, updated)
+}
@@ -0,0 +1,28 @@
+package network
+
+// UserGroupUnlimited is the rate limit value the controller uses for "no limit".
+const UserGroupUnlimited = -1
+
+// NewUserGroupInput builds a UserGroupInput from typed rate limits.
+// A zero rate leaves that direction unlimited.
+//
+// Example:
+//
+//	group, err := client.CreateUserGroup(ctx, "default",
+//	    network.NewUserGroupInput("Kids", 10*network.Mbps, 2*network.Mbps))
+func NewUserGroupInput(name string, down, up Bandwidth) *UserGroupInput {
+	downKbps := userGroupRate(down)
+	upKbps := userGroupRate(up)
+	return &UserGroupInput{
+		Name:           name,
+		QosRateMaxDown: &downKbps,
+		QosRateMaxUp:   &upKbps,
+	}
+}
+
+func userGroupRate(rate Bandwidth) int {
+	if rate <= 0 {
+		return UserGroupUnlimited
+	}
+	return rate.Kbps()
+}
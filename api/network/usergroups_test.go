@@ -0,0 +1,170 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const (
+	testUserGroupsPath = "/proxy/network/api/s/" + testSiteInternal + "/rest/usergroup"
+	testUserGroupID    = "5f8a1b2c3d4e5f6a7b8c9d0e"
+	testClientMAC      = "80:af:ca:ad:05:8d"
+)
+
+func TestNewUserGroupInput(t *testing.T) {
+	t.Parallel()
+
+	input := NewUserGroupInput("Kids", 10*Mbps, 0)
+	assert.Equal(t, "Kids", input.Name)
+	assert.Equal(t, 10000, *input.QosRateMaxDown)
+	assert.Equal(t, UserGroupUnlimited, *input.QosRateMaxUp)
+}
+
+func TestListUserGroups(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testUserGroupsPath, testAPIKey,
+		testdata.LoadFixture(t, "usergroups/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	groups, err := client.ListUserGroups(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "Default", groups[0].Name)
+	assert.Equal(t, FlexibleInt(UserGroupUnlimited), *groups[0].QosRateMaxDown)
+	assert.Equal(t, testUserGroupID, groups[1].Id)
+	assert.Equal(t, FlexibleInt(2000), *groups[1].QosRateMaxUp)
+}
+
+func TestCreateAndUpdateUserGroup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		wantMethod string
+		wantPath   string
+		call       func(client *APIClient, input *UserGroupInput) (*UserGroup, error)
+	}{
+		{
+			name:       "create",
+			wantMethod: http.MethodPost,
+			wantPath:   testUserGroupsPath,
+			call: func(client *APIClient, input *UserGroupInput) (*UserGroup, error) {
+				return client.CreateUserGroup(context.Background(), testSiteInternal, input)
+			},
+		},
+		{
+			name:       "update",
+			wantMethod: http.MethodPut,
+			wantPath:   testUserGroupsPath + "/" + testUserGroupID,
+			call: func(client *APIClient, input *UserGroupInput) (*UserGroup, error) {
+				return client.UpdateUserGroup(context.Background(), testSiteInternal, testUserGroupID, input)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.wantMethod, r.Method)
+				assert.Equal(t, tt.wantPath, r.URL.Path)
+
+				var body UserGroupInput
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "Kids", body.Name)
+				assert.Equal(t, 10000, *body.QosRateMaxDown)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(testdata.LoadFixture(t, "usergroups/single_group.json")))
+			})
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			group, err := tt.call(client, NewUserGroupInput("Kids", 10*Mbps, 2*Mbps))
+			require.NoError(t, err)
+			assert.Equal(t, testUserGroupID, group.Id)
+		})
+	}
+}
+
+func TestDeleteUserGroup(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, testUserGroupsPath+"/"+testUserGroupID, r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "clients/command_success.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	err = client.DeleteUserGroup(context.Background(), testSiteInternal, testUserGroupID)
+	require.NoError(t, err)
+}
+
+func TestAssignClientToUserGroup(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		"/proxy/network/api/s/" + testSiteInternal + "/stat/user/" + testClientMAC: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "usergroups/known_client.json")))
+		},
+		"/proxy/network/api/s/" + testSiteInternal + "/rest/user/60a1b2c3d4e5f6a7b8c9d0e1": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+
+			var body KnownClientInput
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, testUserGroupID, body.UsergroupId)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "usergroups/known_client.json")))
+		},
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	err = client.AssignClientToUserGroup(context.Background(), testSiteInternal, "80-AF-CA-AD-05-8D", testUserGroupID)
+	require.NoError(t, err)
+
+	err = client.AssignClientToUserGroup(context.Background(), testSiteInternal, "bogus", testUserGroupID)
+	require.ErrorIs(t, err, ErrInvalidMAC)
+}
+
+func TestAssignClientToUserGroupUnknownClient(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"rc":"ok"},"data":[]}`))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	err = client.AssignClientToUserGroup(context.Background(), testSiteInternal, testClientMAC, testUserGroupID)
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+}
@@ -0,0 +1,275 @@
+package network
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// V2PathRule serves a v2 API resource at another path on a range of Network
+// application versions, for endpoints that moved between releases. The client
+// requests the paths of the releases it is tested against; rules map them to the
+// paths of the other releases.
+type V2PathRule struct {
+	// Resource is the path the client requests, relative to the site for site
+	// resources and to V2BasePath otherwise, e.g. "trafficrules"
+	Resource string
+
+	// Path replaces Resource on matching controllers, e.g. "traffic-rules"
+	Path string
+
+	// Since is the first version the rule applies to, e.g. "8.0" (optional, defaults to
+	// every version before Before)
+	Since string
+
+	// Before is the first version the rule no longer applies to, e.g. "9.0" (optional,
+	// defaults to every version from Since on)
+	Before string
+}
+
+// DefaultV2PathRules are the v2 API path differences between the Network application
+// versions the client supports, used unless ClientConfig.V2PathRules is set.
+var DefaultV2PathRules = []V2PathRule{
+	// Network 8 serves traffic rules at traffic-rules
+	{Resource: "trafficrules", Path: "traffic-rules", Before: "9.0"},
+}
+
+// controllerVersion is the numeric part of a Network application version.
+type controllerVersion [3]int
+
+// parseControllerVersion parses versions such as "9.0.114", "8.6" or "9.1.0-beta.2".
+// Missing components count as zero, and prereleases as their release.
+func parseControllerVersion(s string) (controllerVersion, error) {
+	numeric, _, _ := strings.Cut(strings.TrimPrefix(strings.TrimSpace(s), "v"), "-")
+	parts := strings.Split(numeric, ".")
+	if len(parts) > 4 {
+		return controllerVersion{}, errors.Wrapf(unifierr.ErrValidation, "invalid controller version %q", s)
+	}
+
+	var v controllerVersion
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return controllerVersion{}, errors.Wrapf(unifierr.ErrValidation, "invalid controller version %q", s)
+		}
+		if i < len(v) {
+			v[i] = n
+		}
+	}
+	return v, nil
+}
+
+func (v controllerVersion) compare(other controllerVersion) int {
+	return slices.Compare(v[:], other[:])
+}
+
+// v2PathRule is a V2PathRule with parsed bounds.
+type v2PathRule struct {
+	V2PathRule
+
+	since, before controllerVersion
+}
+
+func (r *v2PathRule) applies(version controllerVersion) bool {
+	return (r.Since == "" || version.compare(r.since) >= 0) && (r.Before == "" || version.compare(r.before) < 0)
+}
+
+// v2Paths rewrites the paths of v2 requests for the version of the controller. Until
+// the version is configured or known, requests are sent unchanged, and the version is
+// detected with GetControllerStatus when a request a rule is about returns 404.
+type v2Paths struct {
+	rules  []v2PathRule
+	client *APIClient
+
+	mu      sync.Mutex
+	version string // empty until configured or detected
+	parsed  controllerVersion
+}
+
+// newV2Paths validates rules and a configured controller version, if any.
+func newV2Paths(rules []V2PathRule, version string) (*v2Paths, error) {
+	p := &v2Paths{rules: make([]v2PathRule, len(rules))}
+	for i, rule := range rules {
+		if rule.Resource == "" || rule.Path == "" {
+			return nil, errors.Wrapf(unifierr.ErrValidation, "v2 path rule %d needs a resource and a path", i)
+		}
+		p.rules[i] = v2PathRule{V2PathRule: rule}
+		var err error
+		if rule.Since != "" {
+			p.rules[i].since, err = parseControllerVersion(rule.Since)
+			if err != nil {
+				return nil, errors.Wrapf(err, "v2 path rule %d", i)
+			}
+		}
+		if rule.Before != "" {
+			p.rules[i].before, err = parseControllerVersion(rule.Before)
+			if err != nil {
+				return nil, errors.Wrapf(err, "v2 path rule %d", i)
+			}
+		}
+	}
+	if version != "" {
+		parsed, err := parseControllerVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		p.version, p.parsed = version, parsed
+	}
+	return p, nil
+}
+
+// known returns the controller version if configured or already detected.
+func (p *v2Paths) known() (controllerVersion, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.parsed, p.version != ""
+}
+
+// detect returns the controller version, detecting it if unknown. Failed detections
+// are not remembered, so the next request tries again.
+func (p *v2Paths) detect(ctx context.Context) (string, controllerVersion, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.version != "" {
+		return p.version, p.parsed, nil
+	}
+
+	status, err := p.client.GetControllerStatus(ctx)
+	if err != nil {
+		return "", controllerVersion{}, errors.Wrap(err, "failed to detect controller version")
+	}
+	if status.Version == "" {
+		return "", controllerVersion{}, errors.Newf("failed to detect controller version: controller is %s", status.State)
+	}
+	parsed, err := parseControllerVersion(status.Version)
+	if err != nil {
+		return "", controllerVersion{}, errors.Wrap(err, "failed to detect controller version")
+	}
+	p.version, p.parsed = status.Version, parsed
+	return p.version, p.parsed, nil
+}
+
+// v2Resource splits a v2 request path into its prefix, up to the site for site
+// resources, and the resource path, or reports false for other paths.
+func v2Resource(path string) (prefix, resource string, ok bool) {
+	rest, ok := strings.CutPrefix(path, V2BasePath+"/")
+	if !ok {
+		return "", "", false
+	}
+	prefix = V2BasePath + "/"
+	if site, found := strings.CutPrefix(rest, "site/"); found {
+		ref, resource, _ := strings.Cut(site, "/")
+		return prefix + "site/" + ref + "/", resource, true
+	}
+	return prefix, rest, true
+}
+
+// rewrite returns the resource path with the rule applied, or false if the rule is
+// about another resource.
+func (r *v2PathRule) rewrite(resource string) (string, bool) {
+	rest, ok := strings.CutPrefix(resource, r.Resource)
+	if !ok || (rest != "" && rest[0] != '/') {
+		return "", false
+	}
+	return r.Path + rest, true
+}
+
+// about reports whether a rule is about a resource path.
+func (p *v2Paths) about(resource string) bool {
+	return slices.ContainsFunc(p.rules, func(rule v2PathRule) bool {
+		_, ok := rule.rewrite(resource)
+		return ok
+	})
+}
+
+// rewrite returns the path to request on a controller of the given version.
+func (p *v2Paths) rewrite(prefix, resource string, version controllerVersion) string {
+	for i := range p.rules {
+		if rewritten, ok := p.rules[i].rewrite(resource); ok && p.rules[i].applies(version) {
+			return prefix + rewritten
+		}
+	}
+	return prefix + resource
+}
+
+// middleware returns the middleware rewriting request paths.
+func (p *v2Paths) middleware(next http.RoundTripper) http.RoundTripper {
+	return &v2PathTransport{paths: p, next: next}
+}
+
+type v2PathTransport struct {
+	paths *v2Paths
+	next  http.RoundTripper
+}
+
+func (t *v2PathTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	prefix, resource, ok := v2Resource(req.URL.Path)
+	if !ok || !t.paths.about(resource) {
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return t.next.RoundTrip(req)
+	}
+	if version, known := t.paths.known(); known {
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return t.next.RoundTrip(withPath(req, t.paths.rewrite(prefix, resource, version)))
+	}
+
+	// The path may have moved on this controller: detect its version and try the
+	// path of that version. Bodies that cannot be sent again keep the 404.
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusNotFound {
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return resp, err
+	}
+	_, version, err := t.paths.detect(req.Context())
+	if err != nil {
+		t.paths.client.logger.Warn("v2 request returned 404 and its path could not be adapted to the controller version",
+			observability.Field{Key: "path", Value: req.URL.Path},
+			observability.Field{Key: "error", Value: err.Error()},
+		)
+		return resp, nil
+	}
+	path := t.paths.rewrite(prefix, resource, version)
+	if path == req.URL.Path || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, nil
+	}
+
+	retry := withPath(req, path)
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	//nolint:wrapcheck // Middleware passes through errors from next transport
+	return t.next.RoundTrip(retry)
+}
+
+// withPath returns a copy of req for another path.
+func withPath(req *http.Request, path string) *http.Request {
+	if path == req.URL.Path {
+		return req
+	}
+	req = req.Clone(req.Context())
+	req.URL.Path, req.URL.RawPath = path, ""
+	return req
+}
+
+// ControllerVersion returns the Network application version that v2 API paths are
+// adapted to (see ClientConfig.V2PathRules): ClientConfig.ControllerVersion if set,
+// otherwise the version detected with GetControllerStatus, which it detects now if
+// no request needed it yet. Once known, requests are adapted before being sent.
+func (c *APIClient) ControllerVersion(ctx context.Context) (string, error) {
+	version, _, err := c.v2Paths.detect(ctx)
+	return version, err
+}
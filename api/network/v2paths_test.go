@@ -0,0 +1,275 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// newV2PathsServer returns a client of a server reporting version in its status, or
+// a starting controller without version if empty, and serving traffic rules at
+// rulesPath and DNS records. Other paths return 404. It counts status requests and
+// the rule bodies it received.
+func newV2PathsServer(t *testing.T, version, rulesPath string) (*APIClient, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+
+	var statusRequests, ruleBodies atomic.Int32
+	status := `{"meta":{"rc":"ok","up":false},"data":[]}`
+	if version != "" {
+		status = `{"meta":{"rc":"ok","up":true,"server_version":"` + version + `"},"data":[]}`
+	}
+	v2 := "/proxy/network/v2/api/site/" + testSiteInternal
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/proxy/network/status":
+			statusRequests.Add(1)
+			_, _ = w.Write([]byte(status))
+		case v2 + "/" + rulesPath:
+			if r.Method == http.MethodPost {
+				var rule TrafficRule
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&rule))
+				ruleBodies.Add(1)
+				_ = json.NewEncoder(w).Encode(rule)
+				return
+			}
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "traffic/list_success.json")))
+		case v2 + "/" + rulesPath + "/" + testBoundRuleID:
+			w.WriteHeader(http.StatusNoContent)
+		case v2 + "/static-dns":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/not_found.json")))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+	return client, &statusRequests, &ruleBodies
+}
+
+func TestV2PathsDetectedVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version        string
+		rulesPath      string
+		statusRequests int32
+	}{
+		{version: "8.7.11", rulesPath: "traffic-rules", statusRequests: 1},
+		{version: "9.0.114", rulesPath: "trafficrules"},
+		{version: "9.5.21", rulesPath: "trafficrules"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			t.Parallel()
+
+			client, statusRequests, ruleBodies := newV2PathsServer(t, tt.version, tt.rulesPath)
+			ctx := context.Background()
+
+			_, err := client.ListDNSRecords(ctx, testSiteInternal)
+			require.NoError(t, err)
+
+			// A create tried at the moved path is sent again with its body
+			description := "Block social"
+			created, err := client.CreateTrafficRule(ctx, testSiteInternal, &TrafficRuleInput{
+				Description:    &description,
+				Enabled:        true,
+				MatchingTarget: TrafficRuleInputMatchingTargetINTERNET,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, description, *created.Description)
+			assert.Equal(t, int32(1), ruleBodies.Load())
+
+			rules, err := client.ListTrafficRules(ctx, testSiteInternal)
+			require.NoError(t, err)
+			assert.NotEmpty(t, rules)
+			require.NoError(t, client.DeleteTrafficRule(ctx, testSiteInternal, testBoundRuleID))
+
+			// Raw requests are adapted too
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+				client.BuildURL(V2BasePath, "site", testSiteInternal, "trafficrules"), http.NoBody)
+			require.NoError(t, err)
+			resp, err := client.DoRaw(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+			assert.Equal(t, tt.statusRequests, statusRequests.Load(), "the version is detected on the first 404 only")
+			version, err := client.ControllerVersion(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.version, version)
+		})
+	}
+}
+
+func TestV2PathsNotFound(t *testing.T) {
+	t.Parallel()
+
+	client, statusRequests, _ := newV2PathsServer(t, "9.5.21", "trafficrules")
+	ctx := context.Background()
+
+	for range 2 {
+		err := client.DeleteTrafficRule(ctx, testSiteInternal, "507f1f77bcf86cd799439099")
+		require.ErrorIs(t, err, unifierr.ErrNotFound, "404 of a path with no other version keeps its error")
+	}
+	assert.Equal(t, int32(1), statusRequests.Load())
+}
+
+func TestV2PathsConfiguredVersion(t *testing.T) {
+	t.Parallel()
+
+	var statusRequests atomic.Int32
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		"/proxy/network/status": func(w http.ResponseWriter, _ *http.Request) {
+			statusRequests.Add(1)
+		},
+		"/proxy/network/v2/api/site/" + testSiteInternal + "/traffic-rules": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[]`))
+		},
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, ControllerVersion: "8.6.9"})
+	require.NoError(t, err)
+
+	_, err = client.ListTrafficRules(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	version, err := client.ControllerVersion(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "8.6.9", version)
+	assert.Zero(t, statusRequests.Load())
+}
+
+func TestV2PathsCustomRules(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		"/proxy/network/v2/api/site/" + testSiteInternal + "/trafficrules": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[]`))
+		},
+		"/proxy/network/v2/api/site/" + testSiteInternal + "/static-dns-records": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[]`))
+		},
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL:     server.URL,
+		APIKey:            testAPIKey,
+		ControllerVersion: "8.7.11",
+		V2PathRules:       []V2PathRule{{Resource: "static-dns", Path: "static-dns-records", Since: "8.7"}},
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListTrafficRules(context.Background(), testSiteInternal)
+	require.NoError(t, err, "custom rules replace the default rules")
+	_, err = client.ListDNSRecords(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+
+	disabled, err := NewWithConfig(&ClientConfig{
+		ControllerURL:     server.URL,
+		APIKey:            testAPIKey,
+		ControllerVersion: "8.7.11",
+		V2PathRules:       []V2PathRule{},
+	})
+	require.NoError(t, err)
+	_, err = disabled.ListTrafficRules(context.Background(), testSiteInternal)
+	require.NoError(t, err, "an empty rule list disables the mapping")
+}
+
+func TestV2PathsDetectionFailure(t *testing.T) {
+	t.Parallel()
+
+	client, statusRequests, _ := newV2PathsServer(t, "", "traffic-rules")
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := client.ListTrafficRules(ctx, testSiteInternal)
+		require.ErrorIs(t, err, unifierr.ErrNotFound, "the 404 is returned as is")
+	}
+	assert.Equal(t, int32(2), statusRequests.Load(), "failed detections are retried")
+
+	_, err := client.ControllerVersion(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "controller is starting")
+}
+
+func TestNewV2PathsInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rules   []V2PathRule
+		version string
+	}{
+		{name: "no resource", rules: []V2PathRule{{Path: "traffic-rules"}}},
+		{name: "no path", rules: []V2PathRule{{Resource: "trafficrules"}}},
+		{name: "invalid since", rules: []V2PathRule{{Resource: "a", Path: "b", Since: "nine"}}},
+		{name: "invalid before", rules: []V2PathRule{{Resource: "a", Path: "b", Before: "9.x"}}},
+		{name: "invalid version", version: "latest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newV2Paths(tt.rules, tt.version)
+			require.ErrorIs(t, err, unifierr.ErrValidation)
+		})
+	}
+
+	_, err := NewWithConfig(&ClientConfig{ControllerURL: "https://unifi.local", APIKey: testAPIKey, ControllerVersion: "latest"})
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+}
+
+func TestV2PathsRewrite(t *testing.T) {
+	t.Parallel()
+
+	paths, err := newV2Paths([]V2PathRule{
+		{Resource: "trafficrules", Path: "traffic-rules", Since: "8.0", Before: "9.0"},
+		{Resource: "firewall/zone", Path: "firewall-zones"},
+	}, "8.7.11-beta.1")
+	require.NoError(t, err)
+
+	version, _ := paths.known()
+	site := V2BasePath + "/site/default/"
+	tests := map[string]string{
+		site + "trafficrules":             site + "traffic-rules",
+		site + "trafficrules/abc":         site + "traffic-rules/abc",
+		site + "firewall/zone":            site + "firewall-zones",
+		V2BasePath + "/trafficrules":      V2BasePath + "/traffic-rules",
+		site + "trafficrules-extra":       "",
+		V2BasePath + "/site/trafficrules": "",
+		LegacyBasePath + "/trafficrules":  "",
+	}
+	for path, want := range tests {
+		prefix, resource, ok := v2Resource(path)
+		if want == "" {
+			assert.False(t, ok && paths.about(resource), path)
+			continue
+		}
+		require.True(t, paths.about(resource), path)
+		assert.Equal(t, want, paths.rewrite(prefix, resource, version), path)
+	}
+
+	for version, applies := range map[string]bool{"7.5": false, "8.0": true, "8.7.11": true, "v9": false, "9.0.0-beta.3": false} {
+		parsed, err := parseControllerVersion(version)
+		require.NoError(t, err)
+		assert.Equal(t, applies, paths.rules[0].applies(parsed), version)
+	}
+}
@@ -0,0 +1,388 @@
+package network
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// DefaultVoucherExpiryRetryInterval is how long VoucherExpiryScheduler.Run waits
+// before trying again to enforce expiries that failed.
+const DefaultVoucherExpiryRetryInterval = time.Minute
+
+// VoucherExpiry is the absolute expiry of a hotspot voucher, enforced by a
+// VoucherExpiryScheduler.
+type VoucherExpiry struct {
+	SiteID    SiteId             `json:"siteId"`
+	VoucherID openapi_types.UUID `json:"voucherId"`
+
+	// Code is the voucher code, identifying the guests that redeemed the voucher
+	Code string `json:"code"`
+
+	// CreatedAt bounds the guest authorizations searched for redemptions
+	CreatedAt time.Time `json:"createdAt"`
+
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// VoucherExpiryStore keeps the expiries a VoucherExpiryScheduler has yet to enforce.
+// Implementations backed by files or databases let expiries survive restarts; they
+// must be safe for concurrent use.
+type VoucherExpiryStore interface {
+	// Pending returns the expiries not enforced yet.
+	Pending(ctx context.Context) ([]VoucherExpiry, error)
+
+	// Add records expiries, replacing those of the same vouchers.
+	Add(ctx context.Context, expiries ...VoucherExpiry) error
+
+	// Remove forgets the expiries of the given vouchers.
+	Remove(ctx context.Context, voucherIDs ...openapi_types.UUID) error
+}
+
+// MemoryVoucherExpiryStore is a VoucherExpiryStore keeping expiries in memory, lost
+// when the process exits.
+type MemoryVoucherExpiryStore struct {
+	mu       sync.RWMutex
+	expiries map[openapi_types.UUID]VoucherExpiry
+}
+
+// NewMemoryVoucherExpiryStore creates an empty in-memory expiry store.
+func NewMemoryVoucherExpiryStore() *MemoryVoucherExpiryStore {
+	return &MemoryVoucherExpiryStore{expiries: make(map[openapi_types.UUID]VoucherExpiry)}
+}
+
+// Pending implements VoucherExpiryStore, returning expiries in expiry order.
+func (s *MemoryVoucherExpiryStore) Pending(context.Context) ([]VoucherExpiry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pending := make([]VoucherExpiry, 0, len(s.expiries))
+	for _, expiry := range s.expiries {
+		pending = append(pending, expiry)
+	}
+	slices.SortFunc(pending, func(a, b VoucherExpiry) int {
+		return cmp.Or(a.ExpiresAt.Compare(b.ExpiresAt), cmp.Compare(a.VoucherID.String(), b.VoucherID.String()))
+	})
+	return pending, nil
+}
+
+// Add implements VoucherExpiryStore.
+func (s *MemoryVoucherExpiryStore) Add(_ context.Context, expiries ...VoucherExpiry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, expiry := range expiries {
+		s.expiries[expiry.VoucherID] = expiry
+	}
+	return nil
+}
+
+// Remove implements VoucherExpiryStore.
+func (s *MemoryVoucherExpiryStore) Remove(_ context.Context, voucherIDs ...openapi_types.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range voucherIDs {
+		delete(s.expiries, id)
+	}
+	return nil
+}
+
+// VoucherExpiryConfig configures a VoucherExpiryScheduler.
+type VoucherExpiryConfig struct {
+	// Store keeps pending expiries (optional, defaults to a MemoryVoucherExpiryStore)
+	Store VoucherExpiryStore
+
+	// KeepGuests only deletes expired vouchers, leaving guests that redeemed them
+	// connected until their own authorization ends (defaults to false, revoking them)
+	KeepGuests bool
+
+	// RetryInterval is the wait before enforcing failed expiries again (defaults to
+	// DefaultVoucherExpiryRetryInterval)
+	RetryInterval time.Duration
+
+	// Logger receives enforced and failed expiries (optional)
+	Logger observability.Logger
+}
+
+// VoucherExpiryScheduler gives hotspot vouchers an absolute expiry, e.g. the end of
+// a conference, where the controller only knows validity periods counted from
+// first use. It creates vouchers whose validity period ends no later than the
+// expiry counted from creation, and Run deletes them at the expiry and revokes the
+// guests that redeemed them.
+//
+// Example:
+//
+//	expiries := network.NewVoucherExpiryScheduler(client, nil)
+//	go expiries.Run(ctx)
+//
+//	conferenceEnd := time.Date(2025, 10, 24, 18, 0, 0, 0, time.Local)
+//	vouchers, err := expiries.CreateVouchers(ctx, siteID,
+//	    network.NewVoucherSpec(200).Note("DevConf"), conferenceEnd)
+type VoucherExpiryScheduler struct {
+	client        *APIClient
+	store         VoucherExpiryStore
+	keepGuests    bool
+	retryInterval time.Duration
+	logger        observability.Logger
+
+	// wake interrupts the wait of Run when expiries are added
+	wake chan struct{}
+}
+
+// NewVoucherExpiryScheduler creates a scheduler enforcing voucher expiries through
+// client. cfg may be nil.
+func NewVoucherExpiryScheduler(client *APIClient, cfg *VoucherExpiryConfig) *VoucherExpiryScheduler {
+	if cfg == nil {
+		cfg = &VoucherExpiryConfig{}
+	}
+	s := &VoucherExpiryScheduler{
+		client:        client,
+		store:         cfg.Store,
+		keepGuests:    cfg.KeepGuests,
+		retryInterval: cmp.Or(cfg.RetryInterval, DefaultVoucherExpiryRetryInterval),
+		logger:        cfg.Logger,
+		wake:          make(chan struct{}, 1),
+	}
+	if s.store == nil {
+		s.store = NewMemoryVoucherExpiryStore()
+	}
+	if s.logger == nil {
+		s.logger = observability.NoopLogger()
+	}
+	return s
+}
+
+// CreateVouchers creates the vouchers described by spec and schedules their expiry
+// at expiresAt, which must be at least a minute away. Validity periods longer than
+// the time left until expiresAt, including the default 24 hours and NoExpiry, are
+// shortened to it; spec itself is not changed.
+func (s *VoucherExpiryScheduler) CreateVouchers(
+	ctx context.Context, siteID SiteId, spec *VoucherSpec, expiresAt time.Time,
+) (*HotspotVouchersResponse, error) {
+	remaining := time.Until(expiresAt)
+	if remaining < time.Minute {
+		return nil, errors.Wrapf(ErrInvalidVoucherSpec, "expiry %s must be at least a minute away", expiresAt.Format(time.RFC3339))
+	}
+
+	// Count whole minutes, rounded up so that guests keep access until the expiry
+	if rounded := remaining.Truncate(time.Minute); rounded < remaining {
+		remaining = rounded + time.Minute
+	}
+	capped := *spec
+	if remaining <= MaxVoucherDuration && (capped.duration == nil || *capped.duration == 0 || *capped.duration > remaining) {
+		capped.duration = &remaining
+	}
+
+	created, err := s.client.CreateHotspotVouchersFromSpec(ctx, siteID, &capped)
+	if err != nil {
+		return nil, err
+	}
+	err = s.Schedule(ctx, siteID, created.Data, expiresAt)
+	if err != nil {
+		return created, errors.Wrap(err, "vouchers created without expiry")
+	}
+	return created, nil
+}
+
+// Schedule schedules the expiry of existing vouchers of a site at expiresAt. Vouchers
+// whose expiry has passed are enforced by the next run.
+func (s *VoucherExpiryScheduler) Schedule(ctx context.Context, siteID SiteId, vouchers []HotspotVoucher, expiresAt time.Time) error {
+	expiries := make([]VoucherExpiry, 0, len(vouchers))
+	for _, voucher := range vouchers {
+		createdAt := time.Now()
+		if voucher.CreateTime > 0 {
+			createdAt = time.Unix(int64(voucher.CreateTime), 0)
+		}
+		expiries = append(expiries, VoucherExpiry{
+			SiteID:    siteID,
+			VoucherID: voucher.UnderscoreId,
+			Code:      voucher.Code,
+			CreatedAt: createdAt,
+			ExpiresAt: expiresAt,
+		})
+	}
+	err := s.store.Add(ctx, expiries...)
+	if err != nil {
+		return errors.Wrap(err, "failed to store voucher expiries")
+	}
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Pending returns the expiries not enforced yet.
+func (s *VoucherExpiryScheduler) Pending(ctx context.Context) ([]VoucherExpiry, error) {
+	pending, err := s.store.Pending(ctx)
+	return pending, errors.Wrap(err, "failed to load voucher expiries")
+}
+
+// Run enforces expiries as they fall due, until ctx is canceled. Expiries that
+// passed while no scheduler ran, e.g. during a restart with a persistent store, are
+// enforced right away; failed ones are tried again after RetryInterval.
+func (s *VoucherExpiryScheduler) Run(ctx context.Context) error {
+	for {
+		wait := s.retryInterval
+		err := s.ExpireDue(ctx, time.Now())
+		if err != nil {
+			s.logger.Warn("failed to enforce voucher expiries", observability.Field{Key: "error", Value: err.Error()})
+		} else {
+			next, ok, err := s.nextExpiry(ctx)
+			switch {
+			case err != nil:
+				s.logger.Warn("failed to load voucher expiries", observability.Field{Key: "error", Value: err.Error()})
+			case ok:
+				wait = max(time.Until(next), 0)
+			default:
+				wait = math.MaxInt64
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrap(ctx.Err(), "voucher expiry scheduler stopped")
+		case <-s.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *VoucherExpiryScheduler) nextExpiry(ctx context.Context) (time.Time, bool, error) {
+	pending, err := s.Pending(ctx)
+	if err != nil || len(pending) == 0 {
+		return time.Time{}, false, err
+	}
+	next := pending[0].ExpiresAt
+	for _, expiry := range pending[1:] {
+		if expiry.ExpiresAt.Before(next) {
+			next = expiry.ExpiresAt
+		}
+	}
+	return next, true, nil
+}
+
+// ExpireDue enforces the expiries due at now: it deletes the vouchers and, unless
+// KeepGuests is set, revokes the guests that redeemed them. Enforced expiries are
+// removed from the store; failed ones stay pending. Run calls it as expiries fall
+// due; call it directly to enforce expiries from another scheduler.
+func (s *VoucherExpiryScheduler) ExpireDue(ctx context.Context, now time.Time) error {
+	pending, err := s.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	bySite := make(map[SiteId][]VoucherExpiry)
+	for _, expiry := range pending {
+		if !expiry.ExpiresAt.After(now) {
+			bySite[expiry.SiteID] = append(bySite[expiry.SiteID], expiry)
+		}
+	}
+
+	var errs []error
+	for siteID, due := range bySite {
+		enforced, err := s.expireSite(ctx, siteID, due, now)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to expire vouchers in site %s", siteID))
+		}
+		if len(enforced) == 0 {
+			continue
+		}
+		err = s.store.Remove(ctx, enforced...)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, "failed to remove enforced voucher expiries"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// expireSite enforces the due expiries of a site and returns the vouchers whose
+// expiry was enforced in full.
+func (s *VoucherExpiryScheduler) expireSite(ctx context.Context, siteID SiteId, due []VoucherExpiry, now time.Time) ([]openapi_types.UUID, error) {
+	var errs []error
+	deleted := make([]VoucherExpiry, 0, len(due))
+	for _, expiry := range due {
+		err := s.client.DeleteHotspotVoucher(ctx, siteID, expiry.VoucherID)
+		if err != nil && !errors.Is(err, unifierr.ErrNotFound) {
+			errs = append(errs, err)
+			continue
+		}
+		deleted = append(deleted, expiry)
+	}
+
+	if !s.keepGuests && len(deleted) > 0 {
+		err := s.revokeGuests(ctx, siteID, deleted, now)
+		if err != nil {
+			// The vouchers are gone; keep them pending until their guests are revoked
+			return nil, errors.Join(append(errs, err)...)
+		}
+	}
+
+	enforced := make([]openapi_types.UUID, 0, len(deleted))
+	for _, expiry := range deleted {
+		enforced = append(enforced, expiry.VoucherID)
+		s.logger.Info("voucher expired",
+			observability.Field{Key: "site_id", Value: siteID.String()},
+			observability.Field{Key: "voucher_id", Value: expiry.VoucherID.String()},
+			observability.Field{Key: "expires_at", Value: expiry.ExpiresAt},
+		)
+	}
+	return enforced, errors.Join(errs...)
+}
+
+// revokeGuests revokes the active guest authorizations granted by the given vouchers.
+func (s *VoucherExpiryScheduler) revokeGuests(ctx context.Context, siteID SiteId, expired []VoucherExpiry, now time.Time) error {
+	codes := make(map[string]bool, len(expired))
+	ids := make(map[string]bool, len(expired))
+	oldest := now
+	for _, expiry := range expired {
+		if code, err := NormalizeVoucherCode(expiry.Code); err == nil {
+			codes[code] = true
+		}
+		ids[expiry.VoucherID.String()] = true
+		if !expiry.CreatedAt.IsZero() && expiry.CreatedAt.Before(oldest) {
+			oldest = expiry.CreatedAt
+		}
+	}
+
+	within := max(int(math.Ceil(now.Sub(oldest).Hours())), 1)
+	auths, err := s.client.ListGuestAuthorizations(ctx, siteID.String(), &GuestAuthorizationsRequest{Within: within})
+	if err != nil {
+		return err
+	}
+
+	revoked := make(map[string]bool)
+	var errs []error
+	for _, auth := range auths {
+		code, _ := NormalizeVoucherCode(valueOrZero(auth.VoucherCode)) //nolint:errcheck // Invalid codes match no voucher
+		if !codes[code] && !ids[valueOrZero(auth.VoucherID)] {
+			continue
+		}
+		mac := strings.ToLower(auth.Mac)
+		if bool(valueOrZero(auth.Expired)) || (auth.End != nil && *auth.End <= now.Unix()) || revoked[mac] {
+			continue
+		}
+		err := s.client.UnauthorizeGuest(ctx, siteID.String(), mac)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		revoked[mac] = true
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,238 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+var (
+	// testRedeemedVoucherID has the code redeemed in hotspot/guest_authorizations.json
+	testRedeemedVoucherID = types.UUID{0x0b, 0x1c, 0x2d, 0x3e, 0x4f, 0x50, 0x41, 0x62, 0x93, 0x04, 0x15, 0x26, 0x37, 0x48, 0x59, 0x6a}
+	testUnusedVoucherID   = types.UUID{0x1b, 0x1c, 0x2d, 0x3e, 0x4f, 0x50, 0x41, 0x62, 0x93, 0x04, 0x15, 0x26, 0x37, 0x48, 0x59, 0x6b}
+	testLaterVoucherID    = types.UUID{0x2b, 0x1c, 0x2d, 0x3e, 0x4f, 0x50, 0x41, 0x62, 0x93, 0x04, 0x15, 0x26, 0x37, 0x48, 0x59, 0x6c}
+)
+
+// voucherExpiryServer records the vouchers deleted and the guests unauthorized.
+type voucherExpiryServer struct {
+	mu           sync.Mutex
+	deleted      []string
+	unauthorized []string
+	failDeletes  bool
+}
+
+func (s *voucherExpiryServer) start(t *testing.T) *APIClient {
+	t.Helper()
+
+	vouchersPath := "/proxy/network/integration/v1/sites/" + testSiteID.String() + "/hotspot/vouchers"
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		testSitesPath: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+		},
+		vouchersPath: func(w http.ResponseWriter, r *http.Request) {
+			var request CreateVouchersRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(HotspotVouchersResponse{Count: 1, Data: []HotspotVoucher{{
+				UnderscoreId: testRedeemedVoucherID,
+				Code:         "48614-09510",
+				CreateTime:   int(time.Now().Unix()),
+				Duration:     request.Duration,
+			}}})
+		},
+		vouchersPath + "/" + testRedeemedVoucherID.String(): s.deleteVoucher(t, testRedeemedVoucherID),
+		vouchersPath + "/" + testUnusedVoucherID.String():   s.deleteVoucher(t, testUnusedVoucherID),
+		testGuestPath: func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "hotspot/guest_authorizations.json")))
+		},
+		testStamgrPath: func(w http.ResponseWriter, r *http.Request) {
+			var body ClientCommandRequest
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, ClientCommandUnauthorizeGuest, body.Cmd)
+			s.mu.Lock()
+			s.unauthorized = append(s.unauthorized, body.Mac)
+			s.mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "clients/command_success.json")))
+		},
+	})
+	t.Cleanup(server.Close)
+
+	client, err := NewWithConfig(&ClientConfig{ControllerURL: server.URL, APIKey: testAPIKey, RetryWaitTime: time.Millisecond})
+	require.NoError(t, err)
+	return client
+}
+
+func (s *voucherExpiryServer) deleteVoucher(t *testing.T, id types.UUID) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case s.failDeletes:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/bad_request.json")))
+		case id == testUnusedVoucherID:
+			// Deleted by hand in the meantime
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/not_found.json")))
+		default:
+			s.deleted = append(s.deleted, id.String())
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}
+}
+
+func TestVoucherExpiryCreateVouchers(t *testing.T) {
+	t.Parallel()
+
+	server := &voucherExpiryServer{}
+	expiries := NewVoucherExpiryScheduler(server.start(t), nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		spec         *VoucherSpec
+		expiresIn    time.Duration
+		wantDuration int
+	}{
+		{name: "default validity is shortened", spec: NewVoucherSpec(1), expiresIn: 2*time.Hour - 30*time.Second, wantDuration: 120},
+		{name: "no expiry is shortened", spec: NewVoucherSpec(1).NoExpiry(), expiresIn: 90 * time.Minute, wantDuration: 90},
+		{name: "shorter validity is kept", spec: NewVoucherSpec(1).ValidFor(time.Hour), expiresIn: 3 * time.Hour, wantDuration: 60},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiresAt := time.Now().Add(tt.expiresIn)
+			created, err := expiries.CreateVouchers(ctx, testSiteID, tt.spec, expiresAt)
+			require.NoError(t, err)
+			require.Len(t, created.Data, 1)
+			assert.Equal(t, tt.wantDuration, *created.Data[0].Duration)
+
+			pending, err := expiries.Pending(ctx)
+			require.NoError(t, err)
+			require.Len(t, pending, 1)
+			assert.Equal(t, testRedeemedVoucherID, pending[0].VoucherID)
+			assert.True(t, expiresAt.Equal(pending[0].ExpiresAt))
+		})
+	}
+
+	spec := NewVoucherSpec(1)
+	_, err := expiries.CreateVouchers(ctx, testSiteID, spec, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, spec.duration, "the spec is not changed")
+
+	_, err = expiries.CreateVouchers(ctx, testSiteID, spec, time.Now().Add(30*time.Second))
+	require.ErrorIs(t, err, ErrInvalidVoucherSpec)
+}
+
+func TestVoucherExpiryExpireDue(t *testing.T) {
+	t.Parallel()
+
+	server := &voucherExpiryServer{}
+	client := server.start(t)
+	ctx := context.Background()
+
+	// Between the start and end of the authorizations of the fixture
+	now := time.Unix(1760650000, 0)
+	created := int(now.Add(-2 * time.Hour).Unix())
+
+	tests := []struct {
+		name             string
+		keepGuests       bool
+		wantUnauthorized []string
+	}{
+		{name: "revoke guests", wantUnauthorized: []string{"aa:bb:cc:21:43:65"}},
+		{name: "keep guests", keepGuests: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.mu.Lock()
+			server.deleted, server.unauthorized = nil, nil
+			server.mu.Unlock()
+
+			expiries := NewVoucherExpiryScheduler(client, &VoucherExpiryConfig{KeepGuests: tt.keepGuests})
+			require.NoError(t, expiries.Schedule(ctx, testSiteID, []HotspotVoucher{
+				{UnderscoreId: testRedeemedVoucherID, Code: "4861409510", CreateTime: created},
+				{UnderscoreId: testUnusedVoucherID, Code: "1111122222", CreateTime: created},
+			}, now))
+			later := HotspotVoucher{UnderscoreId: testLaterVoucherID, Code: "3333344444", CreateTime: created}
+			require.NoError(t, expiries.Schedule(ctx, testSiteID, []HotspotVoucher{later}, now.Add(time.Hour)))
+
+			require.NoError(t, expiries.ExpireDue(ctx, now))
+
+			assert.Equal(t, []string{testRedeemedVoucherID.String()}, server.deleted)
+			assert.Equal(t, tt.wantUnauthorized, server.unauthorized, "active guests of the voucher are revoked once")
+			pending, err := expiries.Pending(ctx)
+			require.NoError(t, err)
+			require.Len(t, pending, 1, "vouchers deleted by hand count as expired")
+			assert.Equal(t, later.UnderscoreId, pending[0].VoucherID)
+		})
+	}
+}
+
+func TestVoucherExpiryFailure(t *testing.T) {
+	t.Parallel()
+
+	server := &voucherExpiryServer{failDeletes: true}
+	expiries := NewVoucherExpiryScheduler(server.start(t), nil)
+	ctx := context.Background()
+
+	now := time.Now()
+	require.NoError(t, expiries.Schedule(ctx, testSiteID, []HotspotVoucher{
+		{UnderscoreId: testRedeemedVoucherID, Code: "4861409510"},
+	}, now))
+
+	err := expiries.ExpireDue(ctx, now)
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+	assert.Contains(t, err.Error(), testSiteID.String())
+
+	pending, err := expiries.Pending(ctx)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1, "failed expiries stay pending")
+	assert.Empty(t, server.unauthorized)
+}
+
+func TestVoucherExpiryRun(t *testing.T) {
+	t.Parallel()
+
+	server := &voucherExpiryServer{}
+	expiries := NewVoucherExpiryScheduler(server.start(t), &VoucherExpiryConfig{KeepGuests: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- expiries.Run(ctx) }()
+
+	// Scheduled while Run waits without pending expiries
+	require.NoError(t, expiries.Schedule(ctx, testSiteID, []HotspotVoucher{
+		{UnderscoreId: testRedeemedVoucherID, Code: "4861409510"},
+	}, time.Now().Add(20*time.Millisecond)))
+
+	assert.Eventually(t, func() bool {
+		pending, err := expiries.Pending(ctx)
+		return err == nil && len(pending) == 0
+	}, 5*time.Second, 5*time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Equal(t, []string{testRedeemedVoucherID.String()}, server.deleted)
+}
@@ -0,0 +1,308 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Bandwidth is a data rate with kilobit-per-second precision.
+// Use the unit constants to build values, e.g. 10 * network.Mbps.
+type Bandwidth int64
+
+// Bandwidth units.
+const (
+	Kbps Bandwidth = 1
+	Mbps           = 1000 * Kbps
+	Gbps           = 1000 * Mbps
+)
+
+// Kbps returns the bandwidth in kilobits per second, the unit used by the controller.
+func (b Bandwidth) Kbps() int {
+	return int(b)
+}
+
+// String returns the bandwidth in the largest whole unit, e.g. "10 Mbps".
+func (b Bandwidth) String() string {
+	switch {
+	case b != 0 && b%Gbps == 0:
+		return fmt.Sprintf("%d Gbps", b/Gbps)
+	case b != 0 && b%Mbps == 0:
+		return fmt.Sprintf("%d Mbps", b/Mbps)
+	default:
+		return fmt.Sprintf("%d Kbps", int64(b))
+	}
+}
+
+// DataSize is an amount of data with megabyte precision.
+// Use the unit constants to build values, e.g. 2 * network.Gigabyte.
+type DataSize int64
+
+// DataSize units. The controller counts data quotas in binary megabytes.
+const (
+	Megabyte DataSize = 1
+	Gigabyte          = 1024 * Megabyte
+)
+
+// Megabytes returns the size in megabytes, the unit used by the controller.
+func (s DataSize) Megabytes() int {
+	return int(s)
+}
+
+// Controller limits for hotspot voucher creation.
+const (
+	// MaxVoucherCount is the maximum number of vouchers created by one request.
+	MaxVoucherCount = 1000
+	// MaxVoucherDuration is the longest voucher validity period.
+	MaxVoucherDuration = 1_000_000 * time.Minute
+	// MinVoucherBandwidth is the lowest accepted voucher rate limit.
+	MinVoucherBandwidth = 2 * Kbps
+	// MaxVoucherBandwidth is the highest accepted voucher rate limit.
+	MaxVoucherBandwidth = 100 * Mbps
+	// MaxVoucherDataLimit is the largest accepted voucher data quota.
+	MaxVoucherDataLimit = 1024 * Gigabyte
+)
+
+// ErrInvalidVoucherSpec is returned when a VoucherSpec violates controller limits.
+var ErrInvalidVoucherSpec = errors.New("invalid voucher spec")
+
+// VoucherSpec builds a CreateVouchersRequest from typed values, so that durations
+// and rates cannot be confused with the minutes and Kbps expected by the controller.
+//
+// Unset options are omitted from the request and the controller defaults apply
+// (24 hour validity, single use, no rate or data limits).
+//
+// Example:
+//
+//	spec := network.NewVoucherSpec(10).
+//	    ValidFor(8 * time.Hour).
+//	    RateLimit(10*network.Mbps, 5*network.Mbps).
+//	    Note("Conference guest WiFi")
+//
+//	vouchers, err := client.CreateHotspotVouchersFromSpec(ctx, siteID, spec)
+type VoucherSpec struct {
+	count     int
+	duration  *time.Duration
+	uses      *int
+	note      *string
+	down      *Bandwidth
+	up        *Bandwidth
+	dataLimit *DataSize
+}
+
+// NewVoucherSpec starts a spec for count vouchers.
+func NewVoucherSpec(count int) *VoucherSpec {
+	return &VoucherSpec{count: count}
+}
+
+// ValidFor sets how long each voucher stays valid after first use.
+// The controller counts whole minutes, so d must be a multiple of time.Minute.
+func (s *VoucherSpec) ValidFor(d time.Duration) *VoucherSpec {
+	s.duration = &d
+	return s
+}
+
+// NoExpiry makes vouchers valid indefinitely.
+func (s *VoucherSpec) NoExpiry() *VoucherSpec {
+	var unlimited time.Duration
+	s.duration = &unlimited
+	return s
+}
+
+// UsesPerVoucher sets how many times each voucher can be redeemed (0 = unlimited).
+func (s *VoucherSpec) UsesPerVoucher(n int) *VoucherSpec {
+	s.uses = &n
+	return s
+}
+
+// Note attaches a note to all created vouchers.
+func (s *VoucherSpec) Note(note string) *VoucherSpec {
+	s.note = &note
+	return s
+}
+
+// RateLimit caps download and upload speed for guests using the vouchers.
+// A zero value leaves that direction unlimited.
+func (s *VoucherSpec) RateLimit(down, up Bandwidth) *VoucherSpec {
+	s.down = &down
+	s.up = &up
+	return s
+}
+
+// DataLimit caps the total data transferred per voucher.
+func (s *VoucherSpec) DataLimit(size DataSize) *VoucherSpec {
+	s.dataLimit = &size
+	return s
+}
+
+// Validate checks the spec against controller limits.
+func (s *VoucherSpec) Validate() error {
+	if s.count < 1 || s.count > MaxVoucherCount {
+		return errors.Wrapf(ErrInvalidVoucherSpec, "count must be between 1 and %d, got %d", MaxVoucherCount, s.count)
+	}
+
+	if s.duration != nil {
+		d := *s.duration
+		if d < 0 || d > MaxVoucherDuration {
+			return errors.Wrapf(ErrInvalidVoucherSpec, "duration must be between 0 and %s, got %s", MaxVoucherDuration, d)
+		}
+		if d%time.Minute != 0 {
+			return errors.Wrapf(ErrInvalidVoucherSpec, "duration must be a whole number of minutes, got %s", d)
+		}
+	}
+
+	if s.uses != nil && *s.uses < 0 {
+		return errors.Wrapf(ErrInvalidVoucherSpec, "uses per voucher must not be negative, got %d", *s.uses)
+	}
+
+	if s.down != nil {
+		err := validateVoucherRate("download", *s.down)
+		if err != nil {
+			return err
+		}
+		err = validateVoucherRate("upload", *s.up)
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.dataLimit != nil && (*s.dataLimit < Megabyte || *s.dataLimit > MaxVoucherDataLimit) {
+		return errors.Wrapf(ErrInvalidVoucherSpec, "data limit must be between 1 and %d MB, got %d MB",
+			MaxVoucherDataLimit.Megabytes(), s.dataLimit.Megabytes())
+	}
+
+	return nil
+}
+
+func validateVoucherRate(direction string, rate Bandwidth) error {
+	if rate != 0 && (rate < MinVoucherBandwidth || rate > MaxVoucherBandwidth) {
+		return errors.Wrapf(ErrInvalidVoucherSpec, "%s rate must be between %s and %s, got %s",
+			direction, MinVoucherBandwidth, MaxVoucherBandwidth, rate)
+	}
+	return nil
+}
+
+// Build validates the spec and converts it to a CreateVouchersRequest.
+func (s *VoucherSpec) Build() (*CreateVouchersRequest, error) {
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &CreateVouchersRequest{
+		Count: s.count,
+		Quota: s.uses,
+		Note:  s.note,
+	}
+
+	if s.duration != nil {
+		minutes := int(*s.duration / time.Minute)
+		req.Duration = &minutes
+	}
+
+	if s.down != nil {
+		overwrite := true
+		down := s.down.Kbps()
+		up := s.up.Kbps()
+		req.QosOverwrite = &overwrite
+		req.QosRateMaxDown = &down
+		req.QosRateMaxUp = &up
+	}
+
+	if s.dataLimit != nil {
+		megabytes := s.dataLimit.Megabytes()
+		req.Bytes = &megabytes
+	}
+
+	return req, nil
+}
+
+// CreateHotspotVouchersFromSpec validates spec and creates the described hotspot vouchers.
+func (c *APIClient) CreateHotspotVouchersFromSpec(ctx context.Context, siteID SiteId, spec *VoucherSpec) (*HotspotVouchersResponse, error) {
+	req, err := spec.Build()
+	if err != nil {
+		return nil, err
+	}
+	return c.CreateHotspotVouchers(ctx, siteID, req)
+}
+
+// VoucherCodeLength is the number of digits in a hotspot voucher code.
+const VoucherCodeLength = 10
+
+// ErrInvalidVoucherCode is returned when a voucher code is not VoucherCodeLength digits.
+var ErrInvalidVoucherCode = errors.New("invalid voucher code")
+
+// NormalizeVoucherCode returns code as the plain digits stored by the controller,
+// dropping the whitespace and dashes guests type or copy from printed vouchers, so that
+// "12345-67890" and " 12345 67890" both become "1234567890". Codes that are not
+// VoucherCodeLength digits return ErrInvalidVoucherCode.
+func NormalizeVoucherCode(code string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		if r == '-' || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, code)
+	if len(digits) != VoucherCodeLength {
+		return "", errors.Wrapf(ErrInvalidVoucherCode, "code must have %d digits, got %q", VoucherCodeLength, code)
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", errors.Wrapf(ErrInvalidVoucherCode, "code must only contain digits, got %q", code)
+		}
+	}
+	return digits, nil
+}
+
+// ValidVoucherCode reports whether code is a voucher code, as accepted by NormalizeVoucherCode.
+func ValidVoucherCode(code string) bool {
+	_, err := NormalizeVoucherCode(code)
+	return err == nil
+}
+
+// FormatVoucherCode returns code grouped for display as the controller prints it,
+// e.g. "12345-67890". Invalid codes are returned unchanged.
+func FormatVoucherCode(code string) string {
+	digits, err := NormalizeVoucherCode(code)
+	if err != nil {
+		return code
+	}
+	half := VoucherCodeLength / 2
+	return digits[:half] + "-" + digits[half:]
+}
+
+// FindVoucherByCode returns the voucher of a site with the given code, in any format
+// accepted by NormalizeVoucherCode. The API only looks vouchers up by ID, so this
+// pages through the site's vouchers until it finds a match. It returns an error
+// matching ErrInvalidVoucherCode for malformed codes and unifierr.ErrNotFound when
+// no voucher has the code.
+//
+// Example:
+//
+//	voucher, err := client.FindVoucherByCode(ctx, siteID, userInput)
+//	if errors.Is(err, unifierr.ErrNotFound) {
+//	    // unknown or already deleted voucher
+//	}
+//	...
+//	err = client.DeleteHotspotVoucher(ctx, siteID, voucher.UnderscoreId)
+func (c *APIClient) FindVoucherByCode(ctx context.Context, siteID SiteId, code string) (*HotspotVoucher, error) {
+	want, err := NormalizeVoucherCode(code)
+	if err != nil {
+		return nil, err
+	}
+	for voucher, err := range c.AllHotspotVouchers(ctx, siteID) {
+		if err != nil {
+			return nil, err
+		}
+		if got, err := NormalizeVoucherCode(voucher.Code); err == nil && got == want {
+			return &voucher, nil
+		}
+	}
+	return nil, errors.Wrapf(unifierr.ErrNotFound, "no voucher with code %s", FormatVoucherCode(want))
+}
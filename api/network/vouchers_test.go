@@ -0,0 +1,228 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestVoucherSpecBuild(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		spec    *VoucherSpec
+		wantErr bool
+		check   func(t *testing.T, req *CreateVouchersRequest)
+	}{
+		{
+			name: "defaults omit optional fields",
+			spec: NewVoucherSpec(5),
+			check: func(t *testing.T, req *CreateVouchersRequest) {
+				t.Helper()
+				assert.Equal(t, 5, req.Count)
+				assert.Nil(t, req.Duration)
+				assert.Nil(t, req.QosOverwrite)
+				assert.Nil(t, req.Bytes)
+			},
+		},
+		{
+			name: "typed units are converted",
+			spec: NewVoucherSpec(10).
+				ValidFor(8*time.Hour).
+				RateLimit(10*Mbps, 512*Kbps).
+				DataLimit(2 * Gigabyte).
+				UsesPerVoucher(3).
+				Note("guests"),
+			check: func(t *testing.T, req *CreateVouchersRequest) {
+				t.Helper()
+				assert.Equal(t, 480, *req.Duration)
+				assert.True(t, *req.QosOverwrite)
+				assert.Equal(t, 10000, *req.QosRateMaxDown)
+				assert.Equal(t, 512, *req.QosRateMaxUp)
+				assert.Equal(t, 2048, *req.Bytes)
+				assert.Equal(t, 3, *req.Quota)
+				assert.Equal(t, "guests", *req.Note)
+			},
+		},
+		{
+			name: "no expiry",
+			spec: NewVoucherSpec(1).NoExpiry(),
+			check: func(t *testing.T, req *CreateVouchersRequest) {
+				t.Helper()
+				assert.Equal(t, 0, *req.Duration)
+			},
+		},
+		{
+			name: "unlimited upload",
+			spec: NewVoucherSpec(1).RateLimit(5*Mbps, 0),
+			check: func(t *testing.T, req *CreateVouchersRequest) {
+				t.Helper()
+				assert.Equal(t, 0, *req.QosRateMaxUp)
+			},
+		},
+		{
+			name:    "zero count",
+			spec:    NewVoucherSpec(0),
+			wantErr: true,
+		},
+		{
+			name:    "count above limit",
+			spec:    NewVoucherSpec(MaxVoucherCount + 1),
+			wantErr: true,
+		},
+		{
+			name:    "sub-minute duration",
+			spec:    NewVoucherSpec(1).ValidFor(90 * time.Second),
+			wantErr: true,
+		},
+		{
+			name:    "duration above limit",
+			spec:    NewVoucherSpec(1).ValidFor(MaxVoucherDuration + time.Minute),
+			wantErr: true,
+		},
+		{
+			name:    "rate below limit",
+			spec:    NewVoucherSpec(1).RateLimit(1*Kbps, 0),
+			wantErr: true,
+		},
+		{
+			name:    "rate above limit",
+			spec:    NewVoucherSpec(1).RateLimit(0, 1*Gbps),
+			wantErr: true,
+		},
+		{
+			name:    "data limit above limit",
+			spec:    NewVoucherSpec(1).DataLimit(MaxVoucherDataLimit + Megabyte),
+			wantErr: true,
+		},
+		{
+			name:    "negative uses",
+			spec:    NewVoucherSpec(1).UsesPerVoucher(-1),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req, err := tt.spec.Build()
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidVoucherSpec)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.check(t, req)
+		})
+	}
+}
+
+func TestBandwidthString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0 Kbps", Bandwidth(0).String())
+	assert.Equal(t, "1500 Kbps", (1500 * Kbps).String())
+	assert.Equal(t, "10 Mbps", (10 * Mbps).String())
+	assert.Equal(t, "1 Gbps", Gbps.String())
+}
+
+func TestCreateHotspotVouchersFromSpec(t *testing.T) {
+	t.Parallel()
+
+	expectedPath := "/proxy/network/integration/v1/sites/" + testSiteID.String() + "/hotspot/vouchers"
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, expectedPath, r.URL.Path)
+
+		var body CreateVouchersRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, 60, *body.Duration)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "hotspot/list_vouchers_success.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	resp, err := client.CreateHotspotVouchersFromSpec(context.Background(), testSiteID,
+		NewVoucherSpec(1).ValidFor(time.Hour))
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	_, err = client.CreateHotspotVouchersFromSpec(context.Background(), testSiteID, NewVoucherSpec(0))
+	require.ErrorIs(t, err, ErrInvalidVoucherSpec)
+}
+
+func TestVoucherCodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input      string
+		normalized string
+		formatted  string
+	}{
+		{input: "1234567890", normalized: "1234567890", formatted: "12345-67890"},
+		{input: "12345-67890", normalized: "1234567890", formatted: "12345-67890"},
+		{input: " 12345 67890\n", normalized: "1234567890", formatted: "12345-67890"},
+		{input: "12345 67890", normalized: "1234567890", formatted: "12345-67890"},
+		{input: "123456789", formatted: "123456789"},
+		{input: "12345678901", formatted: "12345678901"},
+		{input: "12345-6789O", formatted: "12345-6789O"},
+		{input: "", formatted: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.formatted, FormatVoucherCode(tt.input))
+			assert.Equal(t, tt.normalized != "", ValidVoucherCode(tt.input))
+
+			normalized, err := NormalizeVoucherCode(tt.input)
+			if tt.normalized == "" {
+				require.ErrorIs(t, err, ErrInvalidVoucherCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.normalized, normalized)
+		})
+	}
+}
+
+func TestFindVoucherByCode(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "hotspot/list_vouchers_success.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	voucher, err := client.FindVoucherByCode(context.Background(), testSiteID, "9876543210")
+	require.NoError(t, err)
+	assert.Equal(t, "98765-43210", voucher.Code)
+
+	_, err = client.FindVoucherByCode(context.Background(), testSiteID, "11111-11111")
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+	_, err = client.FindVoucherByCode(context.Background(), testSiteID, "1111")
+	require.ErrorIs(t, err, ErrInvalidVoucherCode)
+	assert.Equal(t, int32(2), requests.Load(), "malformed codes must not reach the controller")
+}
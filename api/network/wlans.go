@@ -0,0 +1,183 @@
+package network
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// MACFilter returns the MAC address filter of the WLAN. Unset fields read as a
+// disabled filter with the allow policy and an empty list.
+func (w *WLAN) MACFilter() WLANMACFilter {
+	filter := WLANMACFilter{Policy: MACFilterAllow, MACs: []string{}}
+	if w.MACFilterEnabled != nil {
+		filter.Enabled = *w.MACFilterEnabled
+	}
+	if w.MACFilterPolicy != nil {
+		filter.Policy = *w.MACFilterPolicy
+	}
+	if w.MACFilterList != nil {
+		filter.MACs = *w.MACFilterList
+	}
+	return filter
+}
+
+// IsClientIsolated reports whether wireless clients of the WLAN are isolated from each other.
+func (w *WLAN) IsClientIsolated() bool {
+	return w.ClientIsolation != nil && *w.ClientIsolation
+}
+
+// WLAN security modes that determine the authentication type of WiFi QR codes.
+const (
+	wlanSecurityOpen = "open"
+	wlanSecurityWPA  = "wpapsk"
+)
+
+// Passphrase length limits of WPA personal networks (IEEE 802.11i).
+const (
+	minPassphraseLength = 8
+	maxPassphraseLength = 63
+)
+
+// DefaultPassphraseLength is the length of passphrases generated when
+// RotateWLANPassphrase is given no generator.
+const DefaultPassphraseLength = 16
+
+// passphraseAlphabet omits characters that are easily confused when read off a
+// screen (0/O, 1/l/I) and those that need escaping in WiFi QR codes.
+const passphraseAlphabet = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// PassphraseGenerator returns a new WLAN passphrase.
+type PassphraseGenerator func() (string, error)
+
+// RandomPassphrase returns a PassphraseGenerator of random passphrases of the given
+// length, drawn from crypto/rand over letters and digits that are hard to confuse.
+func RandomPassphrase(length int) PassphraseGenerator {
+	return func() (string, error) {
+		passphrase := make([]byte, length)
+		limit := big.NewInt(int64(len(passphraseAlphabet)))
+		for i := range passphrase {
+			n, err := rand.Int(rand.Reader, limit)
+			if err != nil {
+				return "", errors.Wrap(err, "failed to generate passphrase")
+			}
+			passphrase[i] = passphraseAlphabet[n.Int64()]
+		}
+		return string(passphrase), nil
+	}
+}
+
+// WLANCredentials are the credentials of a WLAN, as shared with its users.
+type WLANCredentials struct {
+	// SSID is the name of the WLAN.
+	SSID string
+
+	// Passphrase is the pre-shared key, empty for open WLANs.
+	Passphrase string
+
+	// QRPayload is the WiFi QR code payload joining the WLAN, see WLAN.QRPayload.
+	QRPayload string
+}
+
+// QRPayload returns the payload of a QR code that joins the WLAN when scanned with a
+// phone camera, e.g. "WIFI:T:WPA;S:Guest;P:secret;;". Render it with any QR code
+// library. Special characters in the SSID and passphrase are escaped.
+func (w *WLAN) QRPayload() string {
+	var payload strings.Builder
+	payload.WriteString("WIFI:")
+	if w.Security != nil && *w.Security == wlanSecurityOpen {
+		payload.WriteString("T:nopass;")
+	} else {
+		payload.WriteString("T:WPA;")
+	}
+	payload.WriteString("S:" + escapeQRField(w.Name) + ";")
+	if w.Passphrase != nil && *w.Passphrase != "" {
+		payload.WriteString("P:" + escapeQRField(*w.Passphrase) + ";")
+	}
+	if w.HideSSID != nil && *w.HideSSID {
+		payload.WriteString("H:true;")
+	}
+	payload.WriteString(";")
+	return payload.String()
+}
+
+// qrFieldEscaper escapes the characters with a special meaning in WiFi QR codes.
+var qrFieldEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, `:`, `\:`, `"`, `\"`)
+
+func escapeQRField(value string) string {
+	return qrFieldEscaper.Replace(value)
+}
+
+// RotateWLANPassphrase sets a new passphrase, made by generator, on a WPA personal
+// WLAN and returns its credentials with a QR code payload for display, e.g. for
+// periodic rotation of guest networks. A nil generator uses
+// RandomPassphrase(DefaultPassphraseLength). Connected clients must rejoin with the
+// new passphrase.
+//
+// Generated passphrases must be 8 to 63 printable ASCII characters; other
+// passphrases and WLANs that do not use WPA personal security fail the call with
+// an error matching unifierr.ErrValidation without changing the WLAN.
+//
+// Example:
+//
+//	creds, err := client.RotateWLANPassphrase(ctx, "default", guestID, nil)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(creds.QRPayload) // render with any QR code library
+func (c *APIClient) RotateWLANPassphrase(ctx context.Context, site Site, wlanID WLANId, generator PassphraseGenerator) (*WLANCredentials, error) {
+	site, err := c.resolveSite(ctx, site)
+	if err != nil {
+		return nil, err
+	}
+
+	wlan, err := c.getWLAN(ctx, site, wlanID, "failed to rotate passphrase of WLAN")
+	if err != nil {
+		return nil, err
+	}
+	if wlan.Security == nil || *wlan.Security != wlanSecurityWPA {
+		return nil, errors.Wrapf(unifierr.ErrValidation, "WLAN %q does not use WPA personal security", wlan.Name)
+	}
+
+	if generator == nil {
+		generator = RandomPassphrase(DefaultPassphraseLength)
+	}
+	passphrase, err := generator()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate passphrase")
+	}
+	err = validatePassphrase(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := c.updateWLAN(ctx, site, wlanID, &WLANInput{Passphrase: &passphrase}, "failed to rotate passphrase of WLAN")
+	if err != nil {
+		return nil, err
+	}
+	if updated.Passphrase == nil {
+		// Controllers may omit the secret from responses
+		updated.Passphrase = &passphrase
+	}
+
+	return &WLANCredentials{SSID: updated.Name, Passphrase: *updated.Passphrase, QRPayload: updated.QRPayload()}, nil
+}
+
+// validatePassphrase checks that passphrase is a valid WPA personal passphrase.
+func validatePassphrase(passphrase string) error {
+	if len(passphrase) < minPassphraseLength || len(passphrase) > maxPassphraseLength {
+		return errors.Wrapf(unifierr.ErrValidation, "passphrase must be %d to %d characters, got %d",
+			minPassphraseLength, maxPassphraseLength, len(passphrase))
+	}
+	for _, r := range passphrase {
+		if r < ' ' || r > '~' {
+			return errors.Wrap(unifierr.ErrValidation, "passphrase must contain printable ASCII characters only")
+		}
+	}
+	return nil
+}
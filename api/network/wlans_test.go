@@ -0,0 +1,304 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const (
+	testWLANsPath = "/proxy/network/api/s/" + testSiteInternal + "/rest/wlanconf"
+	testWLANID    = "5f8a1b2c3d4e5f6a7b8c9d12"
+)
+
+func TestListWLANs(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testWLANsPath, testAPIKey,
+		testdata.LoadFixture(t, "wlans/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	wlans, err := client.ListWLANs(context.Background(), testSiteInternal)
+	require.NoError(t, err)
+	require.Len(t, wlans, 2)
+	assert.Equal(t, "Home", wlans[0].Name)
+	assert.False(t, wlans[0].IsClientIsolated())
+	assert.Equal(t, testWLANID, wlans[1].Id)
+	assert.True(t, wlans[1].IsClientIsolated())
+}
+
+func TestGetWLANMACFilter(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testWLANsPath+"/"+testWLANID, testAPIKey,
+		testdata.LoadFixture(t, "wlans/single_wlan.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	filter, err := client.GetWLANMACFilter(context.Background(), testSiteInternal, testWLANID)
+	require.NoError(t, err)
+	assert.True(t, filter.Enabled)
+	assert.Equal(t, MACFilterAllow, filter.Policy)
+	assert.Equal(t, []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02"}, filter.MACs)
+}
+
+func TestGetWLANMACFilterNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, testWLANsPath+"/"+testWLANID, testAPIKey,
+		`{"meta":{"rc":"ok"},"data":[]}`, http.StatusOK)
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	_, err = client.GetWLANMACFilter(context.Background(), testSiteInternal, testWLANID)
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+}
+
+func TestUpdateWLANMACFilter(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, testWLANsPath+"/"+testWLANID, r.URL.Path)
+
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"mac_filter_enabled": true,
+			"mac_filter_policy":  "allow",
+			"mac_filter_list":    []any{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02"},
+		}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "wlans/single_wlan.json")))
+	})
+	defer server.Close()
+
+	client, err := New(server.URL, testAPIKey)
+	require.NoError(t, err)
+
+	filter, err := client.UpdateWLANMACFilter(context.Background(), testSiteInternal, testWLANID, &WLANMACFilter{
+		Enabled: true,
+		Policy:  MACFilterAllow,
+		MACs:    []string{"AA-BB-CC-00-00-01", "aabb.cc00.0002"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, filter.MACs, 2)
+}
+
+func TestUpdateWLANMACFilterInvalidMAC(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://unifi.invalid", testAPIKey)
+	require.NoError(t, err)
+
+	_, err = client.UpdateWLANMACFilter(context.Background(), testSiteInternal, testWLANID, &WLANMACFilter{
+		Enabled: true,
+		Policy:  MACFilterDeny,
+		MACs:    []string{"bogus"},
+	})
+	require.ErrorIs(t, err, ErrInvalidMAC)
+}
+
+func TestSetWLANClientIsolation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		enabled bool
+	}{
+		{name: "enable", enabled: true},
+		{name: "disable", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+
+				var body map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]any{"l2_isolation": tt.enabled}, body)
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(testdata.LoadFixture(t, "wlans/single_wlan.json")))
+			})
+			defer server.Close()
+
+			client, err := New(server.URL, testAPIKey)
+			require.NoError(t, err)
+
+			err = client.SetWLANClientIsolation(context.Background(), testSiteInternal, testWLANID, tt.enabled)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWLANMACFilterDefaults(t *testing.T) {
+	t.Parallel()
+
+	wlan := WLAN{Id: testWLANID, Name: "Guest"}
+	filter := wlan.MACFilter()
+	assert.False(t, filter.Enabled)
+	assert.Equal(t, MACFilterAllow, filter.Policy)
+	assert.NotNil(t, filter.MACs)
+	assert.Empty(t, filter.MACs)
+	assert.False(t, wlan.IsClientIsolated())
+}
+
+// ptr returns a pointer to v, for optional model fields.
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestWLANQRPayload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		wlan WLAN
+		want string
+	}{
+		{
+			name: "WPA",
+			wlan: WLAN{Name: "Guest", Security: ptr("wpapsk"), Passphrase: ptr("correct-horse")},
+			want: "WIFI:T:WPA;S:Guest;P:correct-horse;;",
+		},
+		{
+			name: "open",
+			wlan: WLAN{Name: "Cafe", Security: ptr("open")},
+			want: "WIFI:T:nopass;S:Cafe;;",
+		},
+		{
+			name: "hidden",
+			wlan: WLAN{Name: "Lab", Security: ptr("wpapsk"), Passphrase: ptr("secret-lab"), HideSSID: ptr(true)},
+			want: "WIFI:T:WPA;S:Lab;P:secret-lab;H:true;;",
+		},
+		{
+			name: "escaped",
+			wlan: WLAN{Name: `Bob's "Net"; 2,4`, Security: ptr("wpapsk"), Passphrase: ptr(`a:b\c;d`)},
+			want: `WIFI:T:WPA;S:Bob's \"Net\"\; 2\,4;P:a\:b\\c\;d;;`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.wlan.QRPayload())
+		})
+	}
+}
+
+func TestRandomPassphrase(t *testing.T) {
+	t.Parallel()
+
+	generate := RandomPassphrase(DefaultPassphraseLength)
+	first, err := generate()
+	require.NoError(t, err)
+	second, err := generate()
+	require.NoError(t, err)
+
+	assert.Len(t, first, DefaultPassphraseLength)
+	assert.NotEqual(t, first, second)
+	for _, r := range first + second {
+		assert.Contains(t, passphraseAlphabet, string(r))
+	}
+}
+
+func TestRotateWLANPassphrase(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testWLANsPath+"/"+testWLANID, r.URL.Path)
+		if r.Method == http.MethodPut {
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]any{"x_passphrase": "guest;2026"}, body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "wlans/single_wlan.json")))
+	})
+	defer server.Close()
+
+	client := newTestClient(t, server.URL)
+
+	creds, err := client.RotateWLANPassphrase(context.Background(), testSiteInternal, testWLANID,
+		func() (string, error) { return "guest;2026", nil })
+	require.NoError(t, err)
+	assert.Equal(t, "IoT", creds.SSID)
+	assert.Equal(t, "guest;2026", creds.Passphrase)
+	assert.Equal(t, `WIFI:T:WPA;S:IoT;P:guest\;2026;;`, creds.QRPayload)
+}
+
+func TestRotateWLANPassphraseInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		wlan      string
+		generator PassphraseGenerator
+		wantErr   error
+	}{
+		{
+			name:    "open WLAN",
+			wlan:    `{"_id": "5f8a1b2c3d4e5f6a7b8c9d12", "name": "Cafe", "security": "open"}`,
+			wantErr: unifierr.ErrValidation,
+		},
+		{
+			name:      "too short",
+			generator: func() (string, error) { return "short", nil },
+			wantErr:   unifierr.ErrValidation,
+		},
+		{
+			name:      "not ASCII",
+			generator: func() (string, error) { return "pässwörter", nil },
+			wantErr:   unifierr.ErrValidation,
+		},
+		{
+			name:      "generator failure",
+			generator: func() (string, error) { return "", assert.AnError },
+			wantErr:   assert.AnError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Errorf("the WLAN should not be changed, got %s", r.Method)
+				}
+				body := testdata.LoadFixture(t, "wlans/single_wlan.json")
+				if tt.wlan != "" {
+					body = `{"meta": {"rc": "ok"}, "data": [` + tt.wlan + `]}`
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(body))
+			})
+			defer server.Close()
+
+			client := newTestClient(t, server.URL)
+
+			_, err := client.RotateWLANPassphrase(context.Background(), testSiteInternal, testWLANID, tt.generator)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
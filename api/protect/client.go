@@ -0,0 +1,417 @@
+package protect
+
+//go:generate oapi-codegen -config .oapi-codegen.yaml openapi.yaml
+//go:generate go run ../../internal/enumgen
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	unifi "github.com/lexfrei/go-unifi"
+	"github.com/lexfrei/go-unifi/internal/httpclient"
+	"github.com/lexfrei/go-unifi/internal/ids"
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/internal/ratelimit"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Base paths of the APIs served by the Protect application, relative to the controller URL.
+const (
+	// ProtectBasePath is the path of the Protect application on the controller.
+	ProtectBasePath = "/proxy/protect"
+	// IntegrationBasePath is the base path of the official Integration v1 API.
+	IntegrationBasePath = ProtectBasePath + "/integration/v1"
+
+	// APIKeyHeader carries the API key of every request to the controller.
+	APIKeyHeader = "X-API-KEY"
+)
+
+const (
+	// DefaultRateLimit is the default rate limit for the Protect API (requests per minute).
+	DefaultRateLimit = 1000
+	// RateLimitDisabled turns off client-side rate limiting when used as RateLimitPerMinute.
+	RateLimitDisabled = -1
+
+	// DefaultMaxRetries is the default number of retries for failed requests.
+	DefaultMaxRetries = 3
+	// DefaultRetryWaitTime is the default wait time between retries.
+	DefaultRetryWaitTime = 1 * time.Second
+	// DefaultTimeout is the default HTTP client timeout.
+	DefaultTimeout = 30 * time.Second
+)
+
+// APIClient wraps the generated API client with composable middleware.
+type APIClient struct {
+	client  *ClientWithResponses
+	decoder *response.Decoder
+	paths   *middleware.PathCache
+}
+
+// Compile-time check to ensure APIClient implements ProtectAPIClient interface.
+var _ ProtectAPIClient = (*APIClient)(nil)
+
+// ClientConfig holds configuration for the Protect API client. It mirrors the
+// configuration of the Network API client, so both can be built from the same
+// console settings.
+type ClientConfig struct {
+	// ControllerURL is the base URL of the UniFi console (e.g., "https://unifi.local" or "https://192.168.1.1")
+	ControllerURL string
+
+	// APIKey is the API key for authentication; keys created in the UniFi OS settings
+	// grant access to both the Network and the Protect API
+	APIKey string
+
+	// InsecureSkipVerify disables TLS certificate verification (useful for self-signed certs)
+	InsecureSkipVerify bool
+
+	// RootCAs sets the certificate authorities that verify the server certificate, e.g. a
+	// private CA of the controller (optional, defaults to the system pool)
+	RootCAs *x509.CertPool
+
+	// RateLimitPerMinute sets the rate limit (defaults to 1000); RateLimitDisabled, or any
+	// negative value, removes client-side rate limiting
+	RateLimitPerMinute int
+
+	// MaxRetries sets maximum number of retries for failed requests
+	MaxRetries int
+
+	// RetryWaitTime sets the wait time between retries
+	RetryWaitTime time.Duration
+
+	// RetryMaxWaitTime caps the exponentially growing wait between retries; Retry-After
+	// headers are honored even above it (defaults to 0, uncapped)
+	RetryMaxWaitTime time.Duration
+
+	// RetryJitter is the fraction of each wait between retries that is randomized, from 0
+	// (fixed waits) to 1, spreading out clients that failed at the same time (defaults to 0)
+	RetryJitter float64
+
+	// RetryBudgetPerMinute caps the retries of all requests made through the client,
+	// refilled continuously; a failure that finds the budget empty is returned as
+	// *unifierr.RetryBudgetError instead of being retried (defaults to 0, unlimited)
+	RetryBudgetPerMinute int
+
+	// OnRetryDecision is called before every retry with the failed response (nil on network
+	// errors), the retry number, and the wait time; returning false stops retrying (optional)
+	OnRetryDecision RetryDecisionFunc
+
+	// WaitPastDeadline makes requests wait for the rate limiter even when the wait
+	// ends after the context deadline. By default such requests fail immediately
+	// with an error matching unifierr.ErrWouldExceedDeadline (defaults to false)
+	WaitPastDeadline bool
+
+	// UserAgent identifies the application in the User-Agent header of requests, e.g.
+	// "my-exporter/1.2", for controller-side log forensics and support requests.
+	// go-unifi/<version> is always appended (optional)
+	UserAgent string
+
+	// Timeout bounds each request as a whole, from dialing to reading the end of the
+	// response body (defaults to 30 seconds). Any negative value removes it, leaving
+	// only the context deadline and the phase timeouts below
+	Timeout time.Duration
+
+	// DialTimeout bounds establishing a connection, including DNS resolution
+	// (defaults to 30 seconds, as http.DefaultTransport)
+	DialTimeout time.Duration
+
+	// TLSHandshakeTimeout bounds the TLS handshake (defaults to 10 seconds, as
+	// http.DefaultTransport)
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout bounds waiting for the response headers once the request is
+	// sent, without limiting how long reading the body may take (defaults to 0, unbounded)
+	ResponseHeaderTimeout time.Duration
+
+	// Logger for observability (optional, uses noop logger if nil)
+	Logger observability.Logger
+
+	// Metrics recorder for observability (optional, uses noop recorder if nil).
+	// Recorders implementing observability.PathCacheMetricsRecorder also receive
+	// statistics of the normalized-path cache
+	Metrics observability.MetricsRecorder
+
+	// PathCacheSize bounds the number of request paths whose normalized form is cached
+	// for metrics (defaults to 4096)
+	PathCacheSize int
+
+	// KnownPaths pre-seeds the normalized-path cache with request paths that are never
+	// evicted, e.g. the CachedPaths of a previous run (optional)
+	KnownPaths []string
+
+	// StrictDecoding controls how response fields unknown to the client are handled
+	// (defaults to StrictDecodingOff; use StrictDecodingLog or StrictDecodingFail to detect schema drift)
+	StrictDecoding StrictDecodingMode
+}
+
+// RetryDecisionFunc decides whether a failed request is retried. It receives the failed
+// response (nil on network errors), the number of the upcoming retry starting at 1, and
+// the wait time before it, e.g. to record telemetry from response headers or to give up
+// early on specific responses.
+type RetryDecisionFunc = middleware.RetryDecisionFunc
+
+// StrictDecodingMode controls how response fields that are not part of the client's models are handled.
+type StrictDecodingMode = response.StrictMode
+
+// Strict decoding modes.
+const (
+	// StrictDecodingOff ignores unknown response fields.
+	StrictDecodingOff = response.StrictOff
+	// StrictDecodingLog logs unknown response fields as warnings via the configured Logger.
+	StrictDecodingLog = response.StrictLog
+	// StrictDecodingFail fails calls whose responses contain unknown fields with unifierr.ErrUnknownField.
+	StrictDecodingFail = response.StrictFail
+)
+
+// New creates a new UniFi Protect API client with default settings.
+//
+// Default settings:
+//   - Rate limit: 1000 requests/minute
+//   - Max retries: 3
+//   - Retry wait time: 1 second
+//   - Timeout: 30 seconds
+//   - TLS verification: disabled (for self-signed certificates)
+//
+// For custom configuration, use NewWithConfig.
+//
+// Example:
+//
+//	client, err := protect.New("https://unifi.local", "your-api-key")
+func New(controllerURL, apiKey string) (*APIClient, error) {
+	return NewWithConfig(&ClientConfig{
+		ControllerURL:      controllerURL,
+		APIKey:             apiKey,
+		InsecureSkipVerify: true, // Default to true for self-signed certs
+	})
+}
+
+// NewWithConfig creates a new UniFi Protect API client with custom configuration.
+//
+// Example:
+//
+//	client, err := protect.NewWithConfig(&protect.ClientConfig{
+//	    ControllerURL:      "https://unifi.local",
+//	    APIKey:             "your-api-key",
+//	    InsecureSkipVerify: true,
+//	    Logger:             myLogger,
+//	    Metrics:            myMetrics,
+//	})
+func NewWithConfig(cfg *ClientConfig) (*APIClient, error) {
+	if cfg == nil {
+		return nil, errors.New("config is required")
+	}
+	if cfg.ControllerURL == "" {
+		return nil, errors.New("controller URL is required")
+	}
+	if cfg.APIKey == "" {
+		return nil, errors.New("API key is required")
+	}
+
+	// Set defaults
+	if cfg.RateLimitPerMinute == 0 {
+		cfg.RateLimitPerMinute = DefaultRateLimit
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.RetryWaitTime == 0 {
+		cfg.RetryWaitTime = DefaultRetryWaitTime
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	// Create rate limiter (nil when disabled, which removes the middleware from the chain)
+	rateLimiter := ratelimit.NewOptionalRateLimiter(cfg.RateLimitPerMinute)
+
+	paths := middleware.NewPathCache(cfg.PathCacheSize, cfg.KnownPaths)
+
+	// Build middleware chain (applied in reverse order: last = innermost, applied first)
+	// Order from outside to inside: Recover -> UserAgent -> RequestID -> Observability -> RateLimit -> Retry -> TLS -> Timeouts.
+	// TLS and Timeouts must stay innermost: they configure the base *http.Transport and would
+	// replace, rather than wrap, any middleware below it.
+	httpClient := httpclient.New(
+		httpclient.WithTimeout(max(cfg.Timeout, 0)),
+		httpclient.WithMiddleware(
+			middleware.Recover(cfg.Logger, cfg.Metrics),
+			middleware.UserAgent(cfg.UserAgent, "go-unifi/"+unifi.Version()),
+			middleware.RequestID(),
+			middleware.Observability(cfg.Logger, cfg.Metrics, paths),
+			middleware.RateLimit(middleware.RateLimitConfig{
+				Limiter:          rateLimiter,
+				Logger:           cfg.Logger,
+				Metrics:          cfg.Metrics,
+				WaitPastDeadline: cfg.WaitPastDeadline,
+			}),
+			middleware.Retry(middleware.RetryConfig{
+				MaxRetries:      cfg.MaxRetries,
+				InitialWait:     cfg.RetryWaitTime,
+				MaxWait:         cfg.RetryMaxWaitTime,
+				Jitter:          cfg.RetryJitter,
+				Logger:          cfg.Logger,
+				Metrics:         cfg.Metrics,
+				OnRetryDecision: cfg.OnRetryDecision,
+				Budget:          ratelimit.NewRetryBudget(cfg.RetryBudgetPerMinute),
+			}),
+			middleware.TLSConfig(&tls.Config{
+				InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // User-configurable
+				RootCAs:            cfg.RootCAs,
+			}),
+			middleware.Timeouts(middleware.TransportTimeouts{
+				Dial:           cfg.DialTimeout,
+				TLSHandshake:   cfg.TLSHandshakeTimeout,
+				ResponseHeader: cfg.ResponseHeaderTimeout,
+			}),
+		),
+	)
+
+	// Create request editor to add API key and Accept headers
+	requestEditor := func(_ context.Context, req *http.Request) error {
+		req.Header.Set(APIKeyHeader, cfg.APIKey)
+		req.Header.Set("Accept", "application/json")
+		return nil
+	}
+
+	// Create generated client
+	generatedClient, err := NewClientWithResponses(
+		strings.TrimSuffix(cfg.ControllerURL, "/")+ProtectBasePath,
+		WithHTTPClient(httpClient.HTTPClient()),
+		WithRequestEditorFn(requestEditor),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create API client")
+	}
+
+	return &APIClient{
+		client:  generatedClient,
+		decoder: &response.Decoder{Mode: cfg.StrictDecoding, Logger: cfg.Logger},
+		paths:   paths,
+	}, nil
+}
+
+// CachedPaths returns the request paths in the normalized-path cache: the KnownPaths,
+// then the others from most to least recently used. Passing them as
+// ClientConfig.KnownPaths on the next start keeps the paths of regular requests
+// cached however many one-off paths are requested.
+func (c *APIClient) CachedPaths() []string {
+	return c.paths.Paths()
+}
+
+// validateCameraID rejects camera IDs that are empty or MAC addresses, the most common
+// mistake, with an error matching unifierr.ErrValidation instead of sending a request
+// the API rejects with an opaque error.
+func validateCameraID(cameraID string) error {
+	switch {
+	case cameraID == "":
+		return errors.Wrap(unifierr.ErrValidation, "camera ID is required")
+	case ids.IsMAC(cameraID):
+		return errors.Wrapf(unifierr.ErrValidation, "camera ID must be the ID reported by the API, got %s: look the camera up in ListCameras",
+			ids.Describe(cameraID))
+	}
+	return nil
+}
+
+// GetApplicationInfo retrieves the version of the Protect application.
+func (c *APIClient) GetApplicationInfo(ctx context.Context) (*ApplicationInfo, error) {
+	ctx = middleware.WithOperation(ctx, "GetApplicationInfo")
+	resp, err := c.client.GetApplicationInfoWithResponse(ctx)
+	var data *ApplicationInfo
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get application info")
+}
+
+// ListCameras retrieves all cameras adopted by the Protect application.
+func (c *APIClient) ListCameras(ctx context.Context) ([]Camera, error) {
+	ctx = middleware.WithOperation(ctx, "ListCameras")
+	resp, err := c.client.ListCamerasWithResponse(ctx)
+	var data *[]Camera
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	cameras, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list cameras")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	return *cameras, nil
+}
+
+// GetCamera retrieves a camera by ID.
+func (c *APIClient) GetCamera(ctx context.Context, cameraID string) (*Camera, error) {
+	ctx = middleware.WithOperation(ctx, "GetCamera")
+	err := validateCameraID(cameraID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.GetCameraWithResponse(ctx, cameraID)
+	var data *Camera
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get camera "+cameraID)
+}
+
+// UpdateCamera changes the settings of a camera present in input, leaving the others
+// unchanged, and returns the updated camera.
+func (c *APIClient) UpdateCamera(ctx context.Context, cameraID string, input CameraInput) (*Camera, error) {
+	ctx = middleware.WithOperation(ctx, "UpdateCamera")
+	err := validateCameraID(cameraID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.UpdateCameraWithResponse(ctx, cameraID, input)
+	var data *Camera
+	var body []byte
+	if resp != nil {
+		data = resp.JSON200
+		body = resp.Body
+	}
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to update camera "+cameraID)
+}
+
+// GetCameraSnapshot captures a JPEG snapshot of the live stream of a camera. With
+// highQuality, cameras whose feature flags report SupportFullHDSnapshot capture it in
+// full resolution.
+func (c *APIClient) GetCameraSnapshot(ctx context.Context, cameraID string, highQuality bool) ([]byte, error) {
+	ctx = middleware.WithOperation(ctx, "GetCameraSnapshot")
+	err := validateCameraID(cameraID)
+	if err != nil {
+		return nil, err
+	}
+	var params *GetCameraSnapshotParams
+	if highQuality {
+		params = &GetCameraSnapshotParams{HighQuality: &highQuality}
+	}
+	acceptJPEG := func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "image/jpeg")
+		return nil
+	}
+	resp, err := c.client.GetCameraSnapshotWithResponse(ctx, cameraID, params, acceptJPEG)
+	err = response.HandleNoContentWithStatus(resp, err, "failed to get snapshot of camera "+cameraID, http.StatusOK)
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleNoContentWithStatus
+		return nil, err
+	}
+	if len(resp.Body) == 0 {
+		return nil, errors.New("empty snapshot from API")
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,266 @@
+package protect
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/protect/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Test constants.
+const (
+	testAPIKey   = "test-api-key"
+	testCameraID = "66d025b301ebc903e80003ea"
+)
+
+// newTestClient creates a client for the mock server at serverURL.
+func newTestClient(t *testing.T, serverURL string) *APIClient {
+	t.Helper()
+
+	client, err := NewWithConfig(&ClientConfig{
+		ControllerURL: serverURL,
+		APIKey:        testAPIKey,
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://unifi.local", testAPIKey)
+	require.NoError(t, err)
+	require.NotNil(t, client)
+	assert.NotNil(t, client.client)
+}
+
+func TestNewWithConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		config  *ClientConfig
+		wantErr bool
+	}{
+		{
+			name:   "minimal config",
+			config: &ClientConfig{ControllerURL: "https://unifi.local", APIKey: "test-key"},
+		},
+		{
+			name: "custom settings",
+			config: &ClientConfig{
+				ControllerURL:      "https://unifi.local/",
+				APIKey:             "test-key",
+				RateLimitPerMinute: RateLimitDisabled,
+				MaxRetries:         5,
+				RetryWaitTime:      2 * time.Second,
+			},
+		},
+		{name: "nil config", config: nil, wantErr: true},
+		{name: "empty controller URL", config: &ClientConfig{APIKey: "test-key"}, wantErr: true},
+		{name: "empty API key", config: &ClientConfig{ControllerURL: "https://unifi.local"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewWithConfig(tt.config)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, client)
+		})
+	}
+}
+
+func TestGetApplicationInfo(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, IntegrationBasePath+"/meta/info", testAPIKey,
+		testdata.LoadFixture(t, "meta/info_success.json"), http.StatusOK)
+	defer server.Close()
+
+	info, err := newTestClient(t, server.URL).GetApplicationInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "5.1.200", info.ApplicationVersion)
+}
+
+func TestListCameras(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, IntegrationBasePath+"/cameras", testAPIKey,
+		testdata.LoadFixture(t, "cameras/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	cameras, err := newTestClient(t, server.URL).ListCameras(context.Background())
+	require.NoError(t, err)
+	require.Len(t, cameras, 2)
+
+	assert.Equal(t, testCameraID, cameras[0].Id)
+	assert.Equal(t, "Front Door", cameras[0].Name)
+	assert.Equal(t, CameraConnected, cameras[0].State)
+	assert.Equal(t, "E438830F1A2B", cameras[0].MAC)
+	require.NotNil(t, cameras[0].FeatureFlags)
+	assert.True(t, *cameras[0].FeatureFlags.SupportFullHDSnapshot)
+	assert.Equal(t, []string{"person", "vehicle", "animal", "package"}, *cameras[0].FeatureFlags.SmartDetectTypes)
+	assert.Equal(t, CameraDisconnected, cameras[1].State)
+	assert.False(t, cameras[1].LedSettings.IsEnabled)
+}
+
+func TestGetCamera(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, IntegrationBasePath+"/cameras/"+testCameraID, testAPIKey,
+			testdata.LoadFixture(t, "cameras/get_success.json"), http.StatusOK)
+		defer server.Close()
+
+		camera, err := newTestClient(t, server.URL).GetCamera(context.Background(), testCameraID)
+		require.NoError(t, err)
+		assert.Equal(t, "Front Door", camera.Name)
+		require.NotNil(t, camera.OsdSettings)
+		assert.True(t, camera.OsdSettings.IsNameEnabled)
+		assert.False(t, camera.OsdSettings.IsLogoEnabled)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, IntegrationBasePath+"/cameras/"+testCameraID, testAPIKey,
+			testdata.LoadFixture(t, "errors/not_found.json"), http.StatusNotFound)
+		defer server.Close()
+
+		_, err := newTestClient(t, server.URL).GetCamera(context.Background(), testCameraID)
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, IntegrationBasePath+"/cameras/"+testCameraID, testAPIKey,
+			testdata.LoadFixture(t, "errors/unauthorized.json"), http.StatusUnauthorized)
+		defer server.Close()
+
+		_, err := newTestClient(t, server.URL).GetCamera(context.Background(), testCameraID)
+		require.ErrorIs(t, err, unifierr.ErrUnauthorized)
+	})
+
+	t.Run("invalid IDs are rejected before sending", func(t *testing.T) {
+		t.Parallel()
+
+		client := newTestClient(t, "https://unifi.invalid")
+		for _, id := range []string{"", "e4:38:83:0f:1a:2b"} {
+			_, err := client.GetCamera(context.Background(), id)
+			require.ErrorIs(t, err, unifierr.ErrValidation, id)
+		}
+	})
+}
+
+func TestUpdateCamera(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, IntegrationBasePath+"/cameras/"+testCameraID, r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Porch","ledSettings":{"isEnabled":false}}`, string(body),
+			"only the fields to change are sent")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "cameras/get_success.json")))
+	})
+	defer server.Close()
+
+	name := "Porch"
+	camera, err := newTestClient(t, server.URL).UpdateCamera(context.Background(), testCameraID, CameraInput{
+		Name:        &name,
+		LedSettings: &LEDSettings{IsEnabled: false},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testCameraID, camera.Id)
+}
+
+func TestGetCameraSnapshot(t *testing.T) {
+	t.Parallel()
+
+	jpeg := []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10, 'J', 'F', 'I', 'F', 0xFF, 0xD9}
+
+	tests := []struct {
+		name        string
+		highQuality bool
+		wantQuery   string
+	}{
+		{name: "default quality", highQuality: false, wantQuery: ""},
+		{name: "high quality", highQuality: true, wantQuery: "highQuality=true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, IntegrationBasePath+"/cameras/"+testCameraID+"/snapshot", r.URL.Path)
+				assert.Equal(t, tt.wantQuery, r.URL.RawQuery)
+				assert.Equal(t, "image/jpeg", r.Header.Get("Accept"))
+				assert.Equal(t, testAPIKey, r.Header.Get(APIKeyHeader))
+
+				w.Header().Set("Content-Type", "image/jpeg")
+				_, _ = w.Write(jpeg)
+			})
+			defer server.Close()
+
+			snapshot, err := newTestClient(t, server.URL).GetCameraSnapshot(context.Background(), testCameraID, tt.highQuality)
+			require.NoError(t, err)
+			assert.Equal(t, jpeg, snapshot)
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServer(t, IntegrationBasePath+"/cameras/"+testCameraID+"/snapshot", testAPIKey,
+			testdata.LoadFixture(t, "errors/not_found.json"), http.StatusNotFound)
+		defer server.Close()
+
+		_, err := newTestClient(t, server.URL).GetCameraSnapshot(context.Background(), testCameraID, false)
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+	})
+
+	t.Run("empty snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "image/jpeg")
+		})
+		defer server.Close()
+
+		_, err := newTestClient(t, server.URL).GetCameraSnapshot(context.Background(), testCameraID, false)
+		require.Error(t, err)
+	})
+}
+
+func TestGetSwagger(t *testing.T) {
+	t.Parallel()
+
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	assert.Equal(t, "UniFi Protect API", swagger.Info.Title)
+	assert.NotNil(t, swagger.Paths.Find("/integration/v1/cameras/{id}/snapshot"))
+}
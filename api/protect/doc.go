@@ -0,0 +1,64 @@
+// Package protect provides a Go client for the UniFi Protect API.
+//
+// The UniFi Protect API (Local Application API) allows programmatic access to the
+// Protect application of UniFi OS consoles. It provides endpoints for listing and
+// configuring cameras and capturing snapshots.
+//
+// The client is built on the same middleware stack as the Network API client, so a
+// console running both applications is managed with one SDK, one API key, and the
+// same logging, metrics, rate limiting and retry settings.
+//
+// # API Access
+//
+// This API is accessed locally through your UniFi console at the path:
+//
+//	https://<controller-ip>/proxy/protect/integration/v1/
+//
+// # Authentication
+//
+// All requests require an API key generated in the UniFi OS settings of the console,
+// sent in the X-API-KEY header. The same key grants access to the Network API.
+//
+// # Basic Usage
+//
+//	client, err := protect.New("https://unifi.local", "your-api-key")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	cameras, err := client.ListCameras(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	for _, camera := range cameras {
+//	    fmt.Printf("Camera: %s (%s)\n", camera.Name, camera.State)
+//
+//	    jpeg, err := client.GetCameraSnapshot(ctx, camera.Id, true)
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    _ = os.WriteFile(camera.Name+".jpg", jpeg, 0o600)
+//	}
+//
+// # Events
+//
+// The Integration API v1 does not serve motion, smart detection or doorbell events,
+// so the client cannot list them. The web UI reads events from a private API that
+// expects the session of a signed-in user rather than an API key.
+//
+// # Error Handling
+//
+// Failed API calls match the sentinel errors in github.com/lexfrei/go-unifi/unifierr
+// (ErrNotFound, ErrUnauthorized, ErrRateLimited, ErrUnavailable, ErrValidation):
+//
+//	if errors.Is(err, unifierr.ErrNotFound) {
+//	    // Camera no longer exists
+//	}
+//
+// # Rate Limiting and Retries
+//
+// The client throttles requests locally to 1000 requests/minute by default and retries
+// network errors, 5xx server errors and 429 responses up to 3 times with exponential
+// backoff, as the Network API client does.
+package protect
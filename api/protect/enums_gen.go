@@ -0,0 +1,18 @@
+// Code generated by enumgen from generated.go. DO NOT EDIT.
+
+package protect
+
+// IsKnown reports whether e is one of the values of CameraState defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e CameraState) IsKnown() bool {
+	switch e {
+	case CameraConnected, CameraConnecting, CameraDisconnected:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e CameraState) Raw() string {
+	return string(e)
+}
@@ -0,0 +1,51 @@
+package protect
+
+import "context"
+
+// ProtectAPIClient defines the interface for UniFi Protect API operations.
+// This interface enables consumers to create mock implementations for testing.
+//
+// The Protect API provides local access to the Protect application of a UniFi OS
+// console for managing:
+//   - Cameras and their settings
+//   - Snapshots of camera streams
+//
+// All methods mirror the corresponding methods in APIClient to ensure
+// compatibility and ease of use.
+//
+// Example usage with mocking frameworks:
+//
+//	// Using gomock:
+//	//go:generate mockgen -destination=mocks/protect_client.go -package=mocks github.com/lexfrei/go-unifi/api/protect ProtectAPIClient
+//
+//	// Using testify/mock:
+//	type MockClient struct {
+//	    mock.Mock
+//	}
+//
+//	func (m *MockClient) ListCameras(ctx context.Context) ([]Camera, error) {
+//	    args := m.Called(ctx)
+//	    return args.Get(0).([]Camera), args.Error(1)
+//	}
+//
+//nolint:revive // ProtectAPIClient is intentionally explicit to avoid confusion with APIClient struct
+type ProtectAPIClient interface {
+	// Application operations
+
+	// GetApplicationInfo retrieves the version of the Protect application.
+	GetApplicationInfo(ctx context.Context) (*ApplicationInfo, error)
+
+	// Cameras operations
+
+	// ListCameras retrieves all cameras adopted by the Protect application.
+	ListCameras(ctx context.Context) ([]Camera, error)
+
+	// GetCamera retrieves a camera by ID.
+	GetCamera(ctx context.Context, cameraID string) (*Camera, error)
+
+	// UpdateCamera changes the settings of a camera present in input.
+	UpdateCamera(ctx context.Context, cameraID string, input CameraInput) (*Camera, error)
+
+	// GetCameraSnapshot captures a JPEG snapshot of the live stream of a camera.
+	GetCameraSnapshot(ctx context.Context, cameraID string, highQuality bool) ([]byte, error)
+}
@@ -1,17 +1,23 @@
 package sitemanager
 
 //go:generate oapi-codegen -config .oapi-codegen.yaml openapi.yaml
+//go:generate go run ../../internal/enumgen
 
 import (
+	"cmp"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"golang.org/x/time/rate"
 
+	unifi "github.com/lexfrei/go-unifi"
 	"github.com/lexfrei/go-unifi/internal/httpclient"
 	"github.com/lexfrei/go-unifi/internal/middleware"
 	"github.com/lexfrei/go-unifi/internal/ratelimit"
@@ -27,6 +33,9 @@ const (
 	V1RateLimit = 10000
 	// EARateLimit is the rate limit for EA endpoints (requests per minute).
 	EARateLimit = 100
+	// RateLimitDisabled turns off client-side rate limiting when used as
+	// V1RateLimitPerMinute or EARateLimitPerMinute.
+	RateLimitDisabled = -1
 
 	// DefaultMaxRetries is the default number of retries for failed requests.
 	DefaultMaxRetries = 3
@@ -39,7 +48,9 @@ const (
 // UnifiClient wraps the generated API client with composable middleware.
 // It uses separate rate limiters for v1 and Early Access endpoints.
 type UnifiClient struct {
-	client *ClientWithResponses
+	client  *ClientWithResponses
+	decoder *response.Decoder
+	paths   *middleware.PathCache
 }
 
 // Compile-time check to ensure UnifiClient implements SiteManagerAPIClient interface.
@@ -50,16 +61,27 @@ type ClientConfig struct {
 	// APIKey is the Unifi API key for authentication
 	APIKey string
 
-	// BaseURL is the base URL for the API (defaults to https://api.ui.com)
+	// BaseURL is the base URL for the API (defaults to https://api.ui.com). It may include
+	// a path, e.g. for a console exposing the Site Manager API locally; a trailing slash is ignored
 	BaseURL string
 
+	// InsecureSkipVerify disables TLS certificate verification, for consoles with
+	// self-signed certificates (defaults to false)
+	InsecureSkipVerify bool
+
+	// RootCAs sets the certificate authorities that verify the server certificate, e.g. a
+	// private CA of the controller (optional, defaults to the system pool)
+	RootCAs *x509.CertPool
+
 	// HTTPClient is the HTTP client to use (optional)
 	HTTPClient *http.Client
 
-	// V1RateLimitPerMinute sets the rate limit for v1 endpoints (defaults to 10000)
+	// V1RateLimitPerMinute sets the rate limit for v1 endpoints (defaults to 10000);
+	// RateLimitDisabled, or any negative value, turns it off
 	V1RateLimitPerMinute int
 
-	// EARateLimitPerMinute sets the rate limit for Early Access endpoints (defaults to 100)
+	// EARateLimitPerMinute sets the rate limit for Early Access endpoints (defaults to 100);
+	// RateLimitDisabled, or any negative value, turns it off
 	EARateLimitPerMinute int
 
 	// MaxRetries sets maximum number of retries for failed requests
@@ -68,16 +90,149 @@ type ClientConfig struct {
 	// RetryWaitTime sets the wait time between retries
 	RetryWaitTime time.Duration
 
-	// Timeout sets the HTTP client timeout
+	// RetryMaxWaitTime caps the exponentially growing wait between retries; Retry-After
+	// headers are honored even above it (defaults to 0, uncapped)
+	RetryMaxWaitTime time.Duration
+
+	// RetryJitter is the fraction of each wait between retries that is randomized, from 0
+	// (fixed waits) to 1, spreading out clients that failed at the same time (defaults to 0)
+	RetryJitter float64
+
+	// RetryBudgetPerMinute caps the retries of all requests made through the client,
+	// refilled continuously; a failure that finds the budget empty is returned as
+	// *unifierr.RetryBudgetError instead of being retried (defaults to 0, unlimited)
+	RetryBudgetPerMinute int
+
+	// OnRetryDecision is called before every retry with the failed response (nil on network
+	// errors), the retry number, and the wait time; returning false stops retrying (optional)
+	OnRetryDecision RetryDecisionFunc
+
+	// WaitPastDeadline makes requests wait for the rate limiter even when the wait
+	// ends after the context deadline. By default such requests fail immediately
+	// with an error matching unifierr.ErrWouldExceedDeadline (defaults to false)
+	WaitPastDeadline bool
+
+	// UserAgent identifies the application in the User-Agent header of requests, e.g.
+	// "my-exporter/1.2", for controller-side log forensics and support requests.
+	// go-unifi/<version> is always appended (optional)
+	UserAgent string
+
+	// Timeout bounds each request as a whole, from dialing to reading the end of the
+	// response body (defaults to 30 seconds). Any negative value removes it, leaving
+	// only the context deadline and the phase timeouts below, e.g. for large downloads
 	Timeout time.Duration
 
+	// DialTimeout bounds establishing a connection, including DNS resolution
+	// (defaults to 30 seconds, as http.DefaultTransport)
+	DialTimeout time.Duration
+
+	// TLSHandshakeTimeout bounds the TLS handshake (defaults to 10 seconds, as
+	// http.DefaultTransport)
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout bounds waiting for the response headers once the request is
+	// sent, without limiting how long reading the body may take (defaults to 0, unbounded)
+	ResponseHeaderTimeout time.Duration
+
 	// Logger for observability (optional, uses noop logger if nil)
 	Logger observability.Logger
 
-	// Metrics recorder for observability (optional, uses noop recorder if nil)
+	// Metrics recorder for observability (optional, uses noop recorder if nil).
+	// Recorders implementing observability.PathCacheMetricsRecorder also receive
+	// statistics of the normalized-path cache
 	Metrics observability.MetricsRecorder
+
+	// PathCacheSize bounds the number of request paths whose normalized form is cached
+	// for metrics (defaults to 4096)
+	PathCacheSize int
+
+	// KnownPaths pre-seeds the normalized-path cache with request paths that are never
+	// evicted, e.g. the CachedPaths of a previous run (optional)
+	KnownPaths []string
+
+	// StrictDecoding controls how response fields unknown to the client are handled
+	// (defaults to StrictDecodingOff; use StrictDecodingLog or StrictDecodingFail to detect schema drift)
+	StrictDecoding StrictDecodingMode
+
+	// RetainRawJSON keeps the raw JSON of decoded models in their RawJSON field,
+	// giving access to fields not yet modeled by the client (defaults to false)
+	RetainRawJSON bool
+
+	// LenientDecoding makes ListHosts, ListSites and ListDevices skip list elements that
+	// fail to decode instead of failing the call. Skipped elements are logged as warnings
+	// via Logger and reported in the Warnings field of the response (defaults to false)
+	LenientDecoding bool
+
+	// JSONUnmarshal replaces encoding/json.Unmarshal for the potentially large responses
+	// of ListHosts, ListSites and ListDevices, e.g. with the Unmarshal function of go-json or sonic (optional)
+	JSONUnmarshal func(data []byte, v any) error
+
+	// Fault injects delays, error responses and connection resets into requests at the
+	// given rates, below retries, to test how automation copes with a flaky controller
+	// using the client it runs in production (optional, never set it in production)
+	Fault *FaultConfig
 }
 
+// RetryDecisionFunc decides whether a failed request is retried. It receives the failed
+// response (nil on network errors), the number of the upcoming retry starting at 1, and
+// the wait time before it, e.g. to record telemetry from response headers or to give up
+// early on specific responses.
+type RetryDecisionFunc = middleware.RetryDecisionFunc
+
+// WaitStats reports how long the requests made with a context waited on the client's
+// rate limiter and between retries, see WithWaitStats.
+type WaitStats = middleware.WaitStats
+
+// FaultConfig configures the faults injected into requests, see ClientConfig.Fault.
+// Faults are logged at debug level via ClientConfig.Logger unless FaultConfig.Logger is set.
+type FaultConfig = middleware.FaultConfig
+
+// WithWaitStats returns a context that records the waits of every request made with it,
+// so batch schedulers can adapt their own pacing to client-side throttling instead of
+// queueing work blindly.
+//
+// Example:
+//
+//	ctx, waits := sitemanager.WithWaitStats(ctx)
+//	_, err := client.ListHosts(ctx, nil)
+//	if waits.RateLimitWait() > time.Second {
+//	    // slow down
+//	}
+func WithWaitStats(ctx context.Context) (context.Context, *WaitStats) {
+	return middleware.WithWaitStats(ctx)
+}
+
+// WithUserAgent returns a context whose requests identify themselves with userAgent
+// instead of ClientConfig.UserAgent, e.g. to tell the jobs of a shared client apart in
+// controller logs. go-unifi/<version> is still appended.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return middleware.WithUserAgent(ctx, userAgent)
+}
+
+// WithRequestID returns a context whose requests are sent with id in their X-Request-ID
+// header instead of a generated UUID, e.g. to propagate the ID of an incoming request
+// into distributed logs. Failed calls report the request ID, and the trace ID returned
+// by the API, in their *unifierr.APIError.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return middleware.WithRequestID(ctx, id)
+}
+
+// StrictDecodingMode controls how response fields that are not part of the client's models are handled.
+type StrictDecodingMode = response.StrictMode
+
+// Strict decoding modes.
+const (
+	// StrictDecodingOff ignores unknown response fields.
+	StrictDecodingOff = response.StrictOff
+	// StrictDecodingLog logs unknown response fields as warnings via the configured Logger.
+	StrictDecodingLog = response.StrictLog
+	// StrictDecodingFail fails calls whose responses contain unknown fields with unifierr.ErrUnknownField.
+	StrictDecodingFail = response.StrictFail
+)
+
+// DecodeWarning reports a list element skipped by lenient decoding.
+type DecodeWarning = response.DecodeWarning
+
 // New creates a new Unifi API client with default settings.
 // This is the recommended way to create a client for most use cases.
 //
@@ -102,6 +257,28 @@ func New(apiKey string) (*UnifiClient, error) {
 	})
 }
 
+// NewOnPremise creates a client for a console that exposes the Site Manager API
+// locally instead of through api.ui.com. baseURL is the URL of the API on the
+// console, including any path under which it is served; the v1 and Early Access
+// paths are appended to it.
+//
+// As consoles usually have self-signed certificates, TLS certificate verification
+// is disabled, as in network.New. Use NewWithConfig with BaseURL to verify them.
+//
+// Example:
+//
+//	client, err := sitemanager.NewOnPremise("https://192.168.1.1/proxy/site-manager", "your-api-key")
+func NewOnPremise(baseURL, apiKey string) (*UnifiClient, error) {
+	if baseURL == "" {
+		return nil, errors.New("base URL is required")
+	}
+	return NewWithConfig(&ClientConfig{
+		APIKey:             apiKey,
+		BaseURL:            baseURL,
+		InsecureSkipVerify: true,
+	})
+}
+
 // NewWithConfig creates a new Unifi API client with custom configuration.
 // Use this when you need to customize rate limits, timeouts, or other settings.
 //
@@ -129,6 +306,11 @@ func NewWithConfig(cfg *ClientConfig) (*UnifiClient, error) {
 	if cfg.BaseURL == "" {
 		cfg.BaseURL = DefaultBaseURL
 	}
+	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
+	base, err := url.Parse(cfg.BaseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid base URL")
+	}
 	if cfg.V1RateLimitPerMinute == 0 {
 		cfg.V1RateLimitPerMinute = V1RateLimit
 	}
@@ -145,35 +327,70 @@ func NewWithConfig(cfg *ClientConfig) (*UnifiClient, error) {
 		cfg.Timeout = DefaultTimeout
 	}
 
-	// Create separate rate limiters for v1 and EA endpoints
-	v1RateLimiter := ratelimit.NewRateLimiter(cfg.V1RateLimitPerMinute)
-	eaRateLimiter := ratelimit.NewRateLimiter(cfg.EARateLimitPerMinute)
+	// Create separate rate limiters for v1 and EA endpoints (nil when disabled)
+	v1RateLimiter := ratelimit.NewOptionalRateLimiter(cfg.V1RateLimitPerMinute)
+	eaRateLimiter := ratelimit.NewOptionalRateLimiter(cfg.EARateLimitPerMinute)
 
 	// Create selector function for dual rate limiters
-	// EA endpoints start with /api/ea/, all others use v1 limiter
-	rateLimiterSelector := func(req *http.Request) (*rate.Limiter, string) {
-		if strings.HasPrefix(req.URL.Path, "/api/ea/") {
-			return eaRateLimiter, "ea"
+	// EA endpoints start with /ea/ under the base URL path, all others use v1 limiter.
+	// With both limiters disabled the rate limit middleware is left out of the chain.
+	eaPrefix := base.Path + "/ea/"
+	var rateLimiterSelector middleware.RateLimiterSelector
+	if v1RateLimiter != nil || eaRateLimiter != nil {
+		rateLimiterSelector = func(req *http.Request) (*rate.Limiter, string) {
+			if strings.HasPrefix(req.URL.Path, eaPrefix) {
+				return eaRateLimiter, "ea"
+			}
+			return v1RateLimiter, "v1"
 		}
-		return v1RateLimiter, "v1"
 	}
 
+	// Faults are injected below Retry, so that injected failures are retried like real ones
+	faultMiddleware := func(next http.RoundTripper) http.RoundTripper { return next }
+	if cfg.Fault != nil {
+		fault := *cfg.Fault
+		fault.Logger = cmp.Or(fault.Logger, cfg.Logger)
+		faultMiddleware = middleware.Fault(fault)
+	}
+
+	paths := middleware.NewPathCache(cfg.PathCacheSize, cfg.KnownPaths)
+
 	// Build middleware chain (applied in reverse order: last = innermost, applied first)
-	// Order from outside to inside: Observability -> RateLimit -> Retry
+	// Order from outside to inside: Recover -> UserAgent -> RequestID -> Observability -> RateLimit -> Retry -> Fault -> TLS -> Timeouts.
+	// TLS and Timeouts must stay innermost: they configure the base *http.Transport and would
+	// replace, rather than wrap, any middleware below it.
 	httpClient := httpclient.New(
-		httpclient.WithTimeout(cfg.Timeout),
+		httpclient.WithTimeout(max(cfg.Timeout, 0)),
 		httpclient.WithMiddleware(
-			middleware.Observability(cfg.Logger, cfg.Metrics),
+			middleware.Recover(cfg.Logger, cfg.Metrics),
+			middleware.UserAgent(cfg.UserAgent, "go-unifi/"+unifi.Version()),
+			middleware.RequestID(),
+			middleware.Observability(cfg.Logger, cfg.Metrics, paths),
 			middleware.RateLimit(middleware.RateLimitConfig{
-				Selector: rateLimiterSelector,
-				Logger:   cfg.Logger,
-				Metrics:  cfg.Metrics,
+				Selector:         rateLimiterSelector,
+				Logger:           cfg.Logger,
+				Metrics:          cfg.Metrics,
+				WaitPastDeadline: cfg.WaitPastDeadline,
 			}),
 			middleware.Retry(middleware.RetryConfig{
-				MaxRetries:  cfg.MaxRetries,
-				InitialWait: cfg.RetryWaitTime,
-				Logger:      cfg.Logger,
-				Metrics:     cfg.Metrics,
+				MaxRetries:      cfg.MaxRetries,
+				InitialWait:     cfg.RetryWaitTime,
+				MaxWait:         cfg.RetryMaxWaitTime,
+				Jitter:          cfg.RetryJitter,
+				Logger:          cfg.Logger,
+				Metrics:         cfg.Metrics,
+				OnRetryDecision: cfg.OnRetryDecision,
+				Budget:          ratelimit.NewRetryBudget(cfg.RetryBudgetPerMinute),
+			}),
+			faultMiddleware,
+			middleware.TLSConfig(&tls.Config{
+				InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec // User-configurable
+				RootCAs:            cfg.RootCAs,
+			}),
+			middleware.Timeouts(middleware.TransportTimeouts{
+				Dial:           cfg.DialTimeout,
+				TLSHandshake:   cfg.TLSHandshakeTimeout,
+				ResponseHeader: cfg.ResponseHeaderTimeout,
 			}),
 		),
 	)
@@ -196,105 +413,192 @@ func NewWithConfig(cfg *ClientConfig) (*UnifiClient, error) {
 	}
 
 	return &UnifiClient{
-		client: generatedClient,
+		client:  generatedClient,
+		decoder: newDecoder(cfg),
+		paths:   paths,
 	}, nil
 }
 
+// newDecoder builds the response decoder from the decoding options in cfg.
+func newDecoder(cfg *ClientConfig) *response.Decoder {
+	dec := &response.Decoder{
+		Mode:      cfg.StrictDecoding,
+		Logger:    cfg.Logger,
+		Lenient:   cfg.LenientDecoding,
+		Unmarshal: cfg.JSONUnmarshal,
+	}
+	if cfg.RetainRawJSON {
+		dec.OnDecoded = retainRawJSON
+	}
+	return dec
+}
+
+// CachedPaths returns the request paths in the normalized-path cache: the KnownPaths,
+// then the others from most to least recently used. Passing them as
+// ClientConfig.KnownPaths on the next start keeps the paths of regular requests
+// cached however many one-off paths are requested.
+func (c *UnifiClient) CachedPaths() []string {
+	return c.paths.Paths()
+}
+
 // ListHosts retrieves a list of all hosts across all sites.
+// With ClientConfig.LenientDecoding, hosts that fail to decode are skipped and
+// reported in the Warnings field of the response.
 func (c *UnifiClient) ListHosts(ctx context.Context, params *ListHostsParams) (*HostsResponse, error) {
-	resp, err := c.client.ListHostsWithResponse(ctx, params)
+	ctx = middleware.WithOperation(ctx, "ListHosts")
+	raw, err := c.client.ListHosts(ctx, params)
+	resp, warnings, err := response.ParseList[HostsResponse](c.decoder, raw, err, "failed to list hosts")
 	var data *HostsResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to list hosts")
+	hosts, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list hosts")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	hosts.Warnings = warnings
+	return hosts, nil
 }
 
 // GetHostByID retrieves detailed information about a specific host.
 func (c *UnifiClient) GetHostByID(ctx context.Context, hostID string) (*HostResponse, error) {
+	ctx = middleware.WithOperation(ctx, "GetHostByID")
+	err := validateID("host", hostID, "look the host up in ListHosts")
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetHostByIdWithResponse(ctx, hostID)
 	var data *HostResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to get host "+hostID)
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get host "+hostID)
 }
 
 // ListSites retrieves a list of all sites configured on the controller.
+// With ClientConfig.LenientDecoding, sites that fail to decode are skipped and
+// reported in the Warnings field of the response.
 func (c *UnifiClient) ListSites(ctx context.Context) (*SitesResponse, error) {
-	resp, err := c.client.ListSitesWithResponse(ctx)
+	ctx = middleware.WithOperation(ctx, "ListSites")
+	raw, err := c.client.ListSites(ctx)
+	resp, warnings, err := response.ParseList[SitesResponse](c.decoder, raw, err, "failed to list sites")
 	var data *SitesResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to list sites")
+	sites, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list sites")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
+	}
+	sites.Warnings = warnings
+	return sites, nil
 }
 
 // ListDevices retrieves a list of all devices across all sites.
+// With ClientConfig.LenientDecoding, devices that fail to decode are skipped and
+// reported in the Warnings field of the response.
 func (c *UnifiClient) ListDevices(ctx context.Context, params *ListDevicesParams) (*DevicesResponse, error) {
-	resp, err := c.client.ListDevicesWithResponse(ctx, params)
+	ctx = middleware.WithOperation(ctx, "ListDevices")
+	raw, err := c.client.ListDevices(ctx, params)
+	resp, warnings, err := response.ParseList[DevicesResponse](c.decoder, raw, err, "failed to list devices")
 	var data *DevicesResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
+	}
+	devices, err := response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list devices")
+	if err != nil {
+		//nolint:wrapcheck // err is already wrapped by response.HandleDecoded
+		return nil, err
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to list devices")
+	devices.Warnings = warnings
+	return devices, nil
 }
 
 // GetISPMetrics retrieves ISP performance metrics.
 func (c *UnifiClient) GetISPMetrics(ctx context.Context, metricType GetISPMetricsParamsType, params *GetISPMetricsParams) (*ISPMetricsResponse, error) {
+	ctx = middleware.WithOperation(ctx, "GetISPMetrics")
 	resp, err := c.client.GetISPMetricsWithResponse(ctx, metricType, params)
 	var data *ISPMetricsResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, fmt.Sprintf("failed to get ISP metrics of type %s", metricType))
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, fmt.Sprintf("failed to get ISP metrics of type %s", metricType))
 }
 
 // QueryISPMetrics queries ISP metrics with custom parameters.
 func (c *UnifiClient) QueryISPMetrics(ctx context.Context, metricType string, query ISPMetricsQuery) (*ISPMetricsQueryResponse, error) {
+	ctx = middleware.WithOperation(ctx, "QueryISPMetrics")
 	resp, err := c.client.QueryISPMetricsWithResponse(ctx, metricType, query)
 	var data *ISPMetricsQueryResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to query ISP metrics of type "+metricType)
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to query ISP metrics of type "+metricType)
 }
 
 // ListSDWANConfigs retrieves a list of all SD-WAN configurations.
 func (c *UnifiClient) ListSDWANConfigs(ctx context.Context) (*SDWANConfigsResponse, error) {
+	ctx = middleware.WithOperation(ctx, "ListSDWANConfigs")
 	resp, err := c.client.ListSDWANConfigsWithResponse(ctx)
 	var data *SDWANConfigsResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to list SD-WAN configs")
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to list SD-WAN configs")
 }
 
 // GetSDWANConfigByID retrieves detailed information about a specific SD-WAN configuration.
 func (c *UnifiClient) GetSDWANConfigByID(ctx context.Context, configID string) (*SDWANConfigResponse, error) {
+	ctx = middleware.WithOperation(ctx, "GetSDWANConfigByID")
+	err := validateID("SD-WAN config", configID, "look the configuration up in ListSDWANConfigs")
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetSDWANConfigByIdWithResponse(ctx, configID)
 	var data *SDWANConfigResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to get SD-WAN config "+configID)
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get SD-WAN config "+configID)
 }
 
 // GetSDWANConfigStatus retrieves the status of a specific SD-WAN configuration.
 func (c *UnifiClient) GetSDWANConfigStatus(ctx context.Context, configID string) (*SDWANConfigStatusResponse, error) {
+	ctx = middleware.WithOperation(ctx, "GetSDWANConfigStatus")
+	err := validateID("SD-WAN config", configID, "look the configuration up in ListSDWANConfigs")
+	if err != nil {
+		return nil, err
+	}
 	resp, err := c.client.GetSDWANConfigStatusWithResponse(ctx, configID)
 	var data *SDWANConfigStatusResponse
+	var body []byte
 	if resp != nil {
 		data = resp.JSON200
+		body = resp.Body
 	}
-	//nolint:wrapcheck // response.Handle wraps errors internally
-	return response.Handle(resp, data, err, "failed to get SD-WAN config status for "+configID)
+	//nolint:wrapcheck // response.HandleDecoded wraps errors internally
+	return response.HandleDecoded(c.decoder, resp, body, data, err, "failed to get SD-WAN config status for "+configID)
 }
@@ -2,6 +2,7 @@ package sitemanager
 
 import (
 	"context"
+	"crypto/x509"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -12,8 +13,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	unifi "github.com/lexfrei/go-unifi"
 	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
 	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
 )
 
 // Test constants.
@@ -81,6 +84,14 @@ func TestNewWithConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid base URL",
+			config: &ClientConfig{
+				APIKey:  "test-key",
+				BaseURL: "://console",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -101,6 +112,104 @@ func TestNewWithConfig(t *testing.T) {
 	}
 }
 
+func TestNewOnPremise(t *testing.T) {
+	t.Parallel()
+
+	// TLS server with a self-signed certificate, serving the API under a path
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/proxy/site-manager/v1/hosts", r.URL.Path)
+		assert.Equal(t, testAPIKey, r.Header.Get("X-Api-Key"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "hosts/list_success_console.json")))
+	}))
+	defer server.Close()
+
+	client, err := NewOnPremise(server.URL+"/proxy/site-manager/", testAPIKey)
+	require.NoError(t, err)
+
+	resp, err := client.ListHosts(context.Background(), nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Data)
+
+	// Certificate verification stays on with NewWithConfig by default
+	verifying, err := NewWithConfig(&ClientConfig{
+		APIKey:        testAPIKey,
+		BaseURL:       server.URL + "/proxy/site-manager",
+		RetryWaitTime: time.Millisecond,
+	})
+	require.NoError(t, err)
+	_, err = verifying.ListHosts(context.Background(), nil)
+	require.Error(t, err)
+
+	_, err = NewOnPremise("", testAPIKey)
+	require.Error(t, err)
+}
+
+func TestRootCAs(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "hosts/list_success_console.json")))
+	}))
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	client, err := NewWithConfig(&ClientConfig{
+		APIKey:  testAPIKey,
+		BaseURL: server.URL,
+		RootCAs: pool,
+	})
+	require.NoError(t, err)
+
+	resp, err := client.ListHosts(context.Background(), nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Data)
+}
+
+func TestUserAgent(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-dashboard/2.0 go-unifi/"+unifi.Version(), r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "hosts/list_success_console.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL, UserAgent: "my-dashboard/2.0"})
+	require.NoError(t, err)
+
+	_, err = client.ListHosts(context.Background(), nil)
+	require.NoError(t, err)
+}
+
+func TestRequestCorrelation(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "incoming-42", r.Header.Get("X-Request-ID"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/not_found.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	_, err = client.GetHostByID(WithRequestID(context.Background(), "incoming-42"), testHostID)
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+	var apiErr *unifierr.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "incoming-42", apiErr.RequestID)
+	assert.Equal(t, "a7dc15e0eb4527142d7823515b15f87d", apiErr.TraceID)
+	assert.Contains(t, err.Error(), "trace_id=a7dc15e0eb4527142d7823515b15f87d")
+}
+
 func TestListHosts(t *testing.T) {
 	t.Parallel()
 
@@ -194,6 +303,47 @@ func TestListHosts(t *testing.T) {
 	}
 }
 
+func TestListHostsLenient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		lenient bool
+		wantErr bool
+	}{
+		{name: "strict fails on malformed host", wantErr: true},
+		{name: "lenient skips malformed host", lenient: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, "/v1/hosts", testAPIKey, testdata.LoadFixture(t, "hosts/list_malformed.json"), http.StatusOK)
+			defer server.Close()
+
+			client, err := NewWithConfig(&ClientConfig{
+				APIKey:          testAPIKey,
+				BaseURL:         server.URL,
+				LenientDecoding: tt.lenient,
+			})
+			require.NoError(t, err)
+
+			resp, err := client.ListHosts(context.Background(), nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, resp.Data, 1)
+			assert.Equal(t, "203.0.113.1", *resp.Data[0].IpAddress)
+			require.Len(t, resp.Warnings, 1)
+			assert.Equal(t, 1, resp.Warnings[0].Index)
+		})
+	}
+}
+
 func TestGetHostByID(t *testing.T) {
 	t.Parallel()
 
@@ -1053,7 +1203,107 @@ func TestQueryISPMetrics(t *testing.T) {
 	}
 }
 
-// Edge case tests.
+func TestOnRetryDecisionAbortsRetries(t *testing.T) {
+	t.Parallel()
+
+	var attempts int32
+	var mu sync.Mutex
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		attempts++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "errors/server_error.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		APIKey:        testAPIKey,
+		BaseURL:       server.URL,
+		RetryWaitTime: time.Millisecond,
+		OnRetryDecision: func(resp *http.Response, attempt int, _ time.Duration) bool {
+			assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+			assert.Equal(t, 1, attempt)
+			return false
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListSites(context.Background())
+	require.ErrorIs(t, err, unifierr.ErrUnavailable)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, int32(1), attempts, "retries should stop when the callback returns false")
+}
+
+func TestStrictDecoding(t *testing.T) {
+	t.Parallel()
+
+	body := `{"data":[],"httpStatusCode":200,"traceId":"abc","futureField":true}`
+
+	server := testutil.NewMockServer(t, "/v1/sites", testAPIKey, body, http.StatusOK)
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		APIKey:         testAPIKey,
+		BaseURL:        server.URL,
+		StrictDecoding: StrictDecodingFail,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListSites(context.Background())
+	require.ErrorIs(t, err, unifierr.ErrUnknownField)
+}
+
+func TestErrorTaxonomy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		wantErr        error
+	}{
+		{
+			name:           "unauthorized",
+			mockResponse:   testdata.LoadFixture(t, "errors/unauthorized.json"),
+			mockStatusCode: http.StatusUnauthorized,
+			wantErr:        unifierr.ErrUnauthorized,
+		},
+		{
+			name:           "not found",
+			mockResponse:   testdata.LoadFixture(t, "errors/not_found.json"),
+			mockStatusCode: http.StatusNotFound,
+			wantErr:        unifierr.ErrNotFound,
+		},
+		{
+			name:           "bad request",
+			mockResponse:   testdata.LoadFixture(t, "errors/invalid_parameter.json"),
+			mockStatusCode: http.StatusBadRequest,
+			wantErr:        unifierr.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, "/v1/sites", testAPIKey, tt.mockResponse, tt.mockStatusCode)
+			defer server.Close()
+
+			client, err := NewWithConfig(&ClientConfig{
+				APIKey:  testAPIKey,
+				BaseURL: server.URL,
+			})
+			require.NoError(t, err)
+
+			_, err = client.ListSites(context.Background())
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
 
 func TestContextTimeout(t *testing.T) {
 	t.Parallel()
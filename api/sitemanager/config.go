@@ -0,0 +1,150 @@
+package sitemanager
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/config"
+)
+
+// EnvPrefix is the prefix of the environment variables read by NewFromEnv.
+const EnvPrefix = "UNIFI_"
+
+// configKeys lists the settings accepted by NewFromEnv and NewFromConfigFile.
+var configKeys = []string{
+	"base_url",
+	"insecure_skip_verify",
+	"ca_cert_file",
+	"api_key",
+	"api_key_file",
+	"api_key_env",
+	"api_key_credential",
+	"v1_rate_limit_per_minute",
+	"ea_rate_limit_per_minute",
+	"max_retries",
+	"retry_wait_time",
+	"retry_max_wait_time",
+	"retry_jitter",
+	"retry_budget_per_minute",
+	"timeout",
+	"dial_timeout",
+	"tls_handshake_timeout",
+	"response_header_timeout",
+	"user_agent",
+	"strict_decoding",
+	"retain_raw_json",
+	"lenient_decoding",
+	"path_cache_size",
+	"wait_past_deadline",
+	"log_level",
+}
+
+// NewFromEnv creates a client configured from UNIFI_* environment variables.
+//
+// Each setting accepted by NewFromConfigFile is read from the variable named
+// UNIFI_ followed by the upper-cased key, e.g. UNIFI_API_KEY and UNIFI_BASE_URL.
+//
+// Example:
+//
+//	client, err := sitemanager.NewFromEnv()
+func NewFromEnv() (*UnifiClient, error) {
+	return newFromValues(config.FromEnv(EnvPrefix, configKeys))
+}
+
+// NewFromConfigFile creates a client configured from a flat YAML, TOML or JSON file,
+// chosen by the file extension. Nested sections are not supported.
+//
+// Supported keys:
+//
+//	base_url                  API base URL (defaults to https://api.ui.com)
+//	insecure_skip_verify      skip TLS certificate verification (defaults to false)
+//	ca_cert_file              PEM file of the CAs verifying the server certificate
+//	api_key                   API key (required); alternatively api_key_file (path to a
+//	                          file containing the key), api_key_env (environment variable
+//	                          name) or api_key_credential (account in the credentials.Default store)
+//	v1_rate_limit_per_minute  rate limit for v1 endpoints (-1 disables)
+//	ea_rate_limit_per_minute  rate limit for Early Access endpoints (-1 disables)
+//	max_retries               maximum number of retries
+//	retry_wait_time           wait between retries, e.g. "2s"
+//	retry_max_wait_time       cap of the growing wait between retries, e.g. "30s"
+//	retry_jitter              randomized fraction of each retry wait, from 0 to 1
+//	retry_budget_per_minute   retries allowed per minute across all requests (unlimited by default)
+//	timeout                   overall request timeout, e.g. "30s" (negative disables it)
+//	dial_timeout              connection timeout, e.g. "5s"
+//	tls_handshake_timeout     TLS handshake timeout, e.g. "5s"
+//	response_header_timeout   timeout waiting for response headers, e.g. "30s"
+//	user_agent                application identifier for the User-Agent header, e.g. "my-exporter/1.2"
+//	strict_decoding           off, log or fail
+//	retain_raw_json           keep raw JSON of decoded models
+//	lenient_decoding          skip list elements that fail to decode instead of failing
+//	path_cache_size           request paths whose normalized form is cached for metrics
+//	wait_past_deadline        wait for the rate limiter even past the context deadline
+//	log_level                 debug, info, warn, error or off; logs to stderr via log/slog
+//
+// Example config.toml:
+//
+//	api_key_file = "/run/secrets/unifi_api_key"
+//	v1_rate_limit_per_minute = 5000
+//	log_level = "warn"
+func NewFromConfigFile(path string) (*UnifiClient, error) {
+	values, err := config.Load(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load client config")
+	}
+	return newFromValues(values)
+}
+
+func newFromValues(values config.Values) (*UnifiClient, error) {
+	cfg, err := configFromValues(values)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load client config")
+	}
+	return NewWithConfig(cfg)
+}
+
+func configFromValues(values config.Values) (*ClientConfig, error) {
+	err := values.CheckKeys(configKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check config keys")
+	}
+
+	apiKey, err := values.Secret("api_key")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve API key")
+	}
+	logger, err := values.Logger("log_level")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure logger")
+	}
+
+	cfg := &ClientConfig{
+		APIKey: apiKey,
+		Logger: logger,
+	}
+	values.String("base_url", &cfg.BaseURL)
+	values.String("user_agent", &cfg.UserAgent)
+
+	err = errors.Join(
+		values.Bool("insecure_skip_verify", &cfg.InsecureSkipVerify),
+		values.CertPool("ca_cert_file", &cfg.RootCAs),
+		values.Int("v1_rate_limit_per_minute", &cfg.V1RateLimitPerMinute),
+		values.Int("ea_rate_limit_per_minute", &cfg.EARateLimitPerMinute),
+		values.Int("max_retries", &cfg.MaxRetries),
+		values.Duration("retry_wait_time", &cfg.RetryWaitTime),
+		values.Duration("retry_max_wait_time", &cfg.RetryMaxWaitTime),
+		values.Float("retry_jitter", &cfg.RetryJitter),
+		values.Int("retry_budget_per_minute", &cfg.RetryBudgetPerMinute),
+		values.Duration("timeout", &cfg.Timeout),
+		values.Duration("dial_timeout", &cfg.DialTimeout),
+		values.Duration("tls_handshake_timeout", &cfg.TLSHandshakeTimeout),
+		values.Duration("response_header_timeout", &cfg.ResponseHeaderTimeout),
+		values.StrictMode("strict_decoding", &cfg.StrictDecoding),
+		values.Bool("retain_raw_json", &cfg.RetainRawJSON),
+		values.Bool("lenient_decoding", &cfg.LenientDecoding),
+		values.Int("path_cache_size", &cfg.PathCacheSize),
+		values.Bool("wait_past_deadline", &cfg.WaitPastDeadline),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse config values")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,152 @@
+package sitemanager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+	"github.com/lexfrei/go-unifi/internal/config"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestNewFromConfigFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name:    "yaml",
+			file:    "unifi.yaml",
+			content: "base_url: %s\napi_key: %s\nlog_level: off\n",
+		},
+		{
+			name:    "toml",
+			file:    "unifi.toml",
+			content: "base_url = \"%s\"\napi_key = \"%s\"\nv1_rate_limit_per_minute = 100\n",
+		},
+		{
+			name:    "json",
+			file:    "unifi.json",
+			content: `{"base_url": "%s", "api_key": "%s", "timeout": "5s"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, "/v1/sites", testAPIKey,
+				testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+			defer server.Close()
+
+			content := fmt.Sprintf(tt.content, server.URL, testAPIKey)
+			client, err := NewFromConfigFile(writeConfigFile(t, tt.file, content))
+			require.NoError(t, err)
+
+			resp, err := client.ListSites(context.Background())
+			require.NoError(t, err)
+			assert.NotEmpty(t, resp.Data)
+		})
+	}
+}
+
+func TestNewFromConfigFileErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{name: "unknown key", content: "api_key: x\ncontroller_url: https://unifi.local\n", wantErr: config.ErrInvalidConfig},
+		{name: "invalid value", content: "api_key: x\nmax_retries: many\n", wantErr: config.ErrInvalidConfig},
+		{name: "missing API key", content: "base_url: https://api.ui.com\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewFromConfigFile(writeConfigFile(t, "unifi.yaml", tt.content))
+			require.Error(t, err)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigFromValues(t *testing.T) {
+	t.Parallel()
+
+	keyFile := writeConfigFile(t, "api_key", testAPIKey+"\n")
+	cfg, err := configFromValues(config.Values{
+		"api_key_file":             keyFile,
+		"v1_rate_limit_per_minute": "5000",
+		"ea_rate_limit_per_minute": "50",
+		"max_retries":              "2",
+		"retry_wait_time":          "500ms",
+		"retry_max_wait_time":      "5s",
+		"retry_jitter":             "0.2",
+		"timeout":                  "10s",
+		"dial_timeout":             "2s",
+		"tls_handshake_timeout":    "3s",
+		"response_header_timeout":  "20s",
+		"user_agent":               "my-exporter/1.2",
+		"strict_decoding":          "log",
+		"retain_raw_json":          "true",
+		"log_level":                "info",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, testAPIKey, cfg.APIKey)
+	assert.Empty(t, cfg.BaseURL, "base URL should fall back to the client default")
+	assert.Equal(t, 5000, cfg.V1RateLimitPerMinute)
+	assert.Equal(t, 50, cfg.EARateLimitPerMinute)
+	assert.Equal(t, 2, cfg.MaxRetries)
+	assert.Equal(t, 500*time.Millisecond, cfg.RetryWaitTime)
+	assert.Equal(t, 5*time.Second, cfg.RetryMaxWaitTime)
+	assert.InDelta(t, 0.2, cfg.RetryJitter, 0)
+	assert.Equal(t, 10*time.Second, cfg.Timeout)
+	assert.Equal(t, 2*time.Second, cfg.DialTimeout)
+	assert.Equal(t, 3*time.Second, cfg.TLSHandshakeTimeout)
+	assert.Equal(t, 20*time.Second, cfg.ResponseHeaderTimeout)
+	assert.Equal(t, "my-exporter/1.2", cfg.UserAgent)
+	assert.Equal(t, StrictDecodingLog, cfg.StrictDecoding)
+	assert.True(t, cfg.RetainRawJSON)
+	assert.NotNil(t, cfg.Logger)
+}
+
+func TestNewFromEnv(t *testing.T) {
+	server := testutil.NewMockServer(t, "/v1/sites", testAPIKey,
+		testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+	defer server.Close()
+
+	t.Setenv("UNIFI_BASE_URL", server.URL)
+	t.Setenv("UNIFI_API_KEY", testAPIKey)
+
+	client, err := NewFromEnv()
+	require.NoError(t, err)
+
+	resp, err := client.ListSites(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Data)
+}
@@ -0,0 +1,61 @@
+package sitemanager
+
+// ControllerName identifies a UniFi application a host can run, as reported in
+// the controllers of its ReportedState.
+type ControllerName string
+
+// Applications reported by UniFi OS consoles.
+const (
+	ControllerNetwork ControllerName = "network"
+	ControllerProtect ControllerName = "protect"
+	ControllerTalk    ControllerName = "talk"
+	ControllerAccess  ControllerName = "access"
+	ControllerConnect ControllerName = "connect"
+)
+
+// Controller returns the reported state of the application with the given name,
+// whether or not it is installed.
+func (h *Host) Controller(name ControllerName) (*Controller, bool) {
+	if h.ReportedState == nil || h.ReportedState.Controllers == nil {
+		return nil, false
+	}
+	controllers := *h.ReportedState.Controllers
+	for i := range controllers {
+		if valueOrZero(controllers[i].Name) == string(name) {
+			return &controllers[i], true
+		}
+	}
+	return nil, false
+}
+
+// HasController reports whether the host has the application installed, e.g.
+// host.HasController(sitemanager.ControllerProtect). Consoles also list applications
+// they offer but do not run.
+func (h *Host) HasController(name ControllerName) bool {
+	controller, ok := h.Controller(name)
+	return ok && controllerInstalled(controller)
+}
+
+// InstalledControllers returns the names of the applications installed on the host,
+// in the order the host reports them.
+func (h *Host) InstalledControllers() []ControllerName {
+	if h.ReportedState == nil || h.ReportedState.Controllers == nil {
+		return nil
+	}
+	var names []ControllerName
+	for _, controller := range *h.ReportedState.Controllers {
+		if controller.Name != nil && controllerInstalled(&controller) {
+			names = append(names, ControllerName(*controller.Name))
+		}
+	}
+	return names
+}
+
+// controllerInstalled reports whether controller is installed. Hosts that do not
+// say are taken to have it installed if they report its version.
+func controllerInstalled(controller *Controller) bool {
+	if controller.IsInstalled != nil {
+		return *controller.IsInstalled
+	}
+	return valueOrZero(controller.Version) != ""
+}
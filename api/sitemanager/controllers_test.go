@@ -0,0 +1,64 @@
+package sitemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostControllers(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(s string) *string { return &s }
+	installed, uninstalled := true, false
+
+	host := &Host{
+		Type: Console,
+		ReportedState: &ReportedState{
+			Controllers: &[]Controller{
+				{Name: ptr("network"), Version: ptr("9.0.114"), IsInstalled: &installed},
+				{Name: ptr("talk"), Version: ptr("3.20.4"), IsInstalled: &uninstalled},
+				{Name: ptr("protect"), Version: ptr("5.3.41")},
+				{Name: ptr("access")},
+			},
+		},
+	}
+
+	assert.Equal(t, []ControllerName{ControllerNetwork, ControllerProtect}, host.InstalledControllers())
+
+	tests := []struct {
+		name        ControllerName
+		wantListed  bool
+		wantHas     bool
+		wantVersion string
+	}{
+		{name: ControllerNetwork, wantListed: true, wantHas: true, wantVersion: "9.0.114"},
+		{name: ControllerProtect, wantListed: true, wantHas: true, wantVersion: "5.3.41"},
+		{name: ControllerTalk, wantListed: true},
+		{name: ControllerAccess, wantListed: true},
+		{name: ControllerConnect},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.name), func(t *testing.T) {
+			t.Parallel()
+
+			controller, ok := host.Controller(tt.name)
+			assert.Equal(t, tt.wantListed, ok)
+			if ok {
+				assert.Equal(t, string(tt.name), *controller.Name)
+			}
+			assert.Equal(t, tt.wantHas, host.HasController(tt.name))
+
+			version, ok := host.ControllerVersion(tt.name)
+			require.Equal(t, tt.wantVersion != "", ok)
+			if ok {
+				assert.Equal(t, tt.wantVersion, version.String())
+			}
+		})
+	}
+
+	bare := &Host{Type: Console}
+	assert.Nil(t, bare.InstalledControllers())
+	assert.False(t, bare.HasController(ControllerNetwork))
+}
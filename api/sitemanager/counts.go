@@ -0,0 +1,111 @@
+package sitemanager
+
+// SiteCounts holds the device, client and notification counts of one or more sites,
+// as reported in Site.Statistics. Counts a site does not report are zero.
+type SiteCounts struct {
+	TotalDevices          int
+	GatewayDevices        int
+	WiFiDevices           int
+	WiredDevices          int
+	OfflineDevices        int
+	OfflineGatewayDevices int
+	OfflineWiFiDevices    int
+	OfflineWiredDevices   int
+	PendingUpdateDevices  int
+	WiFiClients           int
+	WiredClients          int
+	GuestClients          int
+	CriticalNotifications int
+}
+
+// Clients returns the number of WiFi and wired clients.
+func (c SiteCounts) Clients() int {
+	return c.WiFiClients + c.WiredClients
+}
+
+// OnlineDevices returns the number of devices that are not offline.
+func (c SiteCounts) OnlineDevices() int {
+	return c.TotalDevices - c.OfflineDevices
+}
+
+// add adds the counts of other to c.
+func (c *SiteCounts) add(other SiteCounts) {
+	c.TotalDevices += other.TotalDevices
+	c.GatewayDevices += other.GatewayDevices
+	c.WiFiDevices += other.WiFiDevices
+	c.WiredDevices += other.WiredDevices
+	c.OfflineDevices += other.OfflineDevices
+	c.OfflineGatewayDevices += other.OfflineGatewayDevices
+	c.OfflineWiFiDevices += other.OfflineWiFiDevices
+	c.OfflineWiredDevices += other.OfflineWiredDevices
+	c.PendingUpdateDevices += other.PendingUpdateDevices
+	c.WiFiClients += other.WiFiClients
+	c.WiredClients += other.WiredClients
+	c.GuestClients += other.GuestClients
+	c.CriticalNotifications += other.CriticalNotifications
+}
+
+// Counts returns the statistics counts of the site, zero where not reported.
+func (s *Site) Counts() SiteCounts {
+	if s.Statistics == nil || s.Statistics.Counts == nil {
+		return SiteCounts{}
+	}
+	counts := s.Statistics.Counts
+	return SiteCounts{
+		TotalDevices:          valueOrZero(counts.TotalDevice),
+		GatewayDevices:        valueOrZero(counts.GatewayDevice),
+		WiFiDevices:           valueOrZero(counts.WifiDevice),
+		WiredDevices:          valueOrZero(counts.WiredDevice),
+		OfflineDevices:        valueOrZero(counts.OfflineDevice),
+		OfflineGatewayDevices: valueOrZero(counts.OfflineGatewayDevice),
+		OfflineWiFiDevices:    valueOrZero(counts.OfflineWifiDevice),
+		OfflineWiredDevices:   valueOrZero(counts.OfflineWiredDevice),
+		PendingUpdateDevices:  valueOrZero(counts.PendingUpdateDevice),
+		WiFiClients:           valueOrZero(counts.WifiClient),
+		WiredClients:          valueOrZero(counts.WiredClient),
+		GuestClients:          valueOrZero(counts.GuestClient),
+		CriticalNotifications: valueOrZero(counts.CriticalNotification),
+	}
+}
+
+// TotalDevices returns the number of devices of the site, or 0 if not reported.
+func (s *Site) TotalDevices() int {
+	return s.Counts().TotalDevices
+}
+
+// OfflineDevices returns the number of offline devices of the site, or 0 if not reported.
+func (s *Site) OfflineDevices() int {
+	return s.Counts().OfflineDevices
+}
+
+// WiFiClients returns the number of WiFi clients of the site, or 0 if not reported.
+func (s *Site) WiFiClients() int {
+	return s.Counts().WiFiClients
+}
+
+// WiredClients returns the number of wired clients of the site, or 0 if not reported.
+func (s *Site) WiredClients() int {
+	return s.Counts().WiredClients
+}
+
+// GuestClients returns the number of guest clients of the site, or 0 if not reported.
+func (s *Site) GuestClients() int {
+	return s.Counts().GuestClients
+}
+
+// AggregateCounts returns the sum of the statistics counts of sites.
+//
+// Example:
+//
+//	resp, err := client.ListSites(ctx)
+//	...
+//	total := sitemanager.AggregateCounts(resp.Data)
+//	fmt.Printf("%d/%d devices online, %d clients\n",
+//	    total.OnlineDevices(), total.TotalDevices, total.Clients())
+func AggregateCounts(sites []Site) SiteCounts {
+	var total SiteCounts
+	for i := range sites {
+		total.add(sites[i].Counts())
+	}
+	return total
+}
@@ -0,0 +1,75 @@
+package sitemanager
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+)
+
+// siteWithCounts decodes a site whose statistics report counts.
+func siteWithCounts(t *testing.T, counts string) Site {
+	t.Helper()
+
+	var site Site
+	require.NoError(t, json.Unmarshal([]byte(`{"statistics":{"counts":`+counts+`}}`), &site))
+	return site
+}
+
+func TestSiteCounts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		site Site
+		want SiteCounts
+	}{
+		{name: "no statistics", site: Site{}},
+		{name: "no counts", site: Site{Statistics: &SiteStatistics{}}},
+		{
+			name: "partial counts",
+			site: siteWithCounts(t, `{"totalDevice":5,"offlineDevice":1,"wifiClient":12,"wiredClient":3}`),
+			want: SiteCounts{TotalDevices: 5, OfflineDevices: 1, WiFiClients: 12, WiredClients: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.site.Counts())
+			assert.Equal(t, tt.want.TotalDevices, tt.site.TotalDevices())
+			assert.Equal(t, tt.want.OfflineDevices, tt.site.OfflineDevices())
+			assert.Equal(t, tt.want.WiFiClients, tt.site.WiFiClients())
+			assert.Equal(t, tt.want.WiredClients, tt.site.WiredClients())
+			assert.Equal(t, tt.want.GuestClients, tt.site.GuestClients())
+		})
+	}
+}
+
+func TestAggregateCounts(t *testing.T) {
+	t.Parallel()
+
+	var resp SitesResponse
+	testdata.LoadFixtureJSON(t, "sites/list_success.json", &resp)
+	sites := append(resp.Data,
+		siteWithCounts(t, `{"totalDevice":4,"offlineDevice":1,"wifiDevice":3,"wifiClient":20,"guestClient":2}`),
+		Site{},
+	)
+
+	total := AggregateCounts(sites)
+	assert.Equal(t, SiteCounts{
+		TotalDevices:   5,
+		GatewayDevices: 1,
+		WiFiDevices:    3,
+		OfflineDevices: 1,
+		WiFiClients:    20,
+		GuestClients:   2,
+	}, total)
+	assert.Equal(t, 4, total.OnlineDevices())
+	assert.Equal(t, 20, total.Clients())
+	assert.Equal(t, SiteCounts{}, AggregateCounts(nil))
+}
@@ -7,16 +7,27 @@
 //
 // The client automatically manages separate rate limiters for different endpoint types:
 //   - v1 endpoints: 10,000 requests per minute
-//   - Early Access endpoints (paths starting with /api/ea/): 100 requests per minute
+//   - Early Access endpoints (paths starting with /ea/): 100 requests per minute
 //
 // Rate limiter selection is automatic based on request URL - no manual configuration needed.
 //
+// When the context deadline arrives before the local rate limiter would release a request,
+// the request fails immediately with an error matching unifierr.ErrWouldExceedDeadline.
+// Set ClientConfig.WaitPastDeadline to wait for the deadline instead.
+//
 // # Retry Logic
 //
 // Automatic exponential backoff retry for:
 //   - 5xx server errors
 //   - 429 rate limit errors (respects Retry-After header)
 //
+// Set ClientConfig.OnRetryDecision to observe each retry, including the failed
+// response headers, or to stop retrying early.
+//
+// ClientConfig.RetryBudgetPerMinute caps the retries of all requests sharing the client,
+// so an outage does not multiply the request volume. Failures that find the budget empty
+// are returned as *unifierr.RetryBudgetError, matching unifierr.ErrRetryBudgetExhausted.
+//
 // # Example Usage
 //
 //	// Simple: create client with defaults
@@ -43,4 +54,30 @@
 //	    V1RateLimitPerMinute: 5000,  // Custom v1 rate limit
 //	    EARateLimitPerMinute: 50,    // Custom EA rate limit
 //	})
+//
+// # Configuration Files and Environment
+//
+// NewFromEnv builds a client from UNIFI_* environment variables (UNIFI_API_KEY,
+// UNIFI_BASE_URL, UNIFI_TIMEOUT, ...), and NewFromConfigFile from a flat YAML, TOML or
+// JSON file using the same snake_case keys:
+//
+//	client, err := sitemanager.NewFromConfigFile("unifi.toml")
+//
+// # Notifications
+//
+// The Site Manager API has no notifications resource, so the client cannot list
+// notifications or mark them as read. Site.Counts reports the number of critical
+// notifications of each site.
+//
+// # Host Updates
+//
+// The Site Manager API does not start UniFi OS or application updates, so the client
+// cannot install them. Host.AvailableUpdates lists the updates a host reports, to be
+// installed on the console or in the UniFi Site Manager web UI.
+//
+// # Console Settings
+//
+// The Site Manager API is read-only: like updates, settings of the consoles themselves,
+// such as display brightness or LEDs, cannot be changed through it. The LEDs of Protect
+// cameras are set with UpdateCamera of the protect package.
 package sitemanager
@@ -0,0 +1,78 @@
+// Code generated by enumgen from generated.go. DO NOT EDIT.
+
+package sitemanager
+
+// IsKnown reports whether e is one of the values of GetISPMetricsParamsDuration defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e GetISPMetricsParamsDuration) IsKnown() bool {
+	switch e {
+	case N24h, N30d, N7d:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e GetISPMetricsParamsDuration) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of GetISPMetricsParamsType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e GetISPMetricsParamsType) IsKnown() bool {
+	switch e {
+	case N1h, N5m:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e GetISPMetricsParamsType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of HostType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e HostType) IsKnown() bool {
+	switch e {
+	case Console, NetworkServer:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e HostType) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of ISPMetricsQueryResponseDataStatus defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e ISPMetricsQueryResponseDataStatus) IsKnown() bool {
+	switch e {
+	case PartialSuccess, Success:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e ISPMetricsQueryResponseDataStatus) Raw() string {
+	return string(e)
+}
+
+// IsKnown reports whether e is one of the values of SDWANConfigType defined in the API
+// specification. Values added by newer versions of the API are not known.
+func (e SDWANConfigType) IsKnown() bool {
+	switch e {
+	case SdwanHbsp:
+		return true
+	}
+	return false
+}
+
+// Raw returns the value as received from the API.
+func (e SDWANConfigType) Raw() string {
+	return string(e)
+}
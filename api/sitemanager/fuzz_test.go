@@ -0,0 +1,54 @@
+package sitemanager
+
+import (
+	"encoding/json"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+)
+
+// FuzzDecodeResponses feeds fixture-derived documents to the response decoders:
+// malformed input may fail to decode but must never panic.
+func FuzzDecodeResponses(f *testing.F) {
+	err := fs.WalkDir(testdata.FS, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".json") {
+			return err
+		}
+		data, err := testdata.FS.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		f.Add(data)
+		return nil
+	})
+	require.NoError(f, err)
+
+	f.Fuzz(func(_ *testing.T, data []byte) {
+		targets := []any{
+			&HostsResponse{},
+			&HostResponse{},
+			&SitesResponse{},
+			&DevicesResponse{},
+			&ISPMetricsResponse{},
+			&ISPMetricsQueryResponse{},
+			&SDWANConfigsResponse{},
+			&SDWANConfigResponse{},
+			&SDWANConfigStatusResponse{},
+		}
+		for _, target := range targets {
+			_ = json.Unmarshal(data, target)
+		}
+
+		// Helpers built on the decoded types must cope with whatever decoded
+		var metrics ISPMetricsResponse
+		if json.Unmarshal(data, &metrics) == nil {
+			for _, series := range GroupByWAN(metrics.Data) {
+				SummarizeWAN(series)
+			}
+		}
+	})
+}
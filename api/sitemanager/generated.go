@@ -151,8 +151,11 @@ type Device struct {
 	HostId *string `json:"hostId,omitempty"`
 
 	// HostName Name of the host device
-	HostName *string   `json:"hostName,omitempty"`
-	Uidb     *UidbInfo `json:"uidb,omitempty"`
+	HostName *string `json:"hostName,omitempty"`
+
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+	Uidb    *UidbInfo       `json:"uidb,omitempty"`
 
 	// UpdatedAt Last update time in RFC3339 format
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
@@ -281,6 +284,9 @@ type DevicesResponse struct {
 
 	// TraceId Unique identifier for debugging purposes
 	TraceId string `json:"traceId"`
+
+	// Warnings List elements skipped because they could not be decoded. Client-side only, populated when lenient decoding is enabled.
+	Warnings []DecodeWarning `json:"-"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
@@ -371,116 +377,14 @@ type Host struct {
 	// Owner Indicates if the current user is the owner of this device
 	Owner *bool `json:"owner,omitempty"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// RegistrationTime Time in RFC3339 format when the device was registered to the cloud
 	RegistrationTime *time.Time `json:"registrationTime,omitempty"`
 
 	// ReportedState Device's reported state information
-	ReportedState *struct {
-		// Anonid Anonymous device identifier
-		Anonid *openapi_types.UUID `json:"anonid,omitempty"`
-
-		// Apps Installed applications with their status and features
-		Apps       *[]ReportedStateApp `json:"apps,omitempty"`
-		AutoUpdate *AutoUpdateConfig   `json:"autoUpdate,omitempty"`
-
-		// AvailableChannels Available release channels
-		AvailableChannels *[]string `json:"availableChannels,omitempty"`
-
-		// ConsolesOnSameLocalNetwork Other consoles on the same local network
-		ConsolesOnSameLocalNetwork *[]map[string]interface{} `json:"consolesOnSameLocalNetwork,omitempty"`
-
-		// ControllerUuid Controller UUID
-		ControllerUuid *string `json:"controller_uuid,omitempty"`
-
-		// Controllers Installed controllers with their configuration and status
-		Controllers *[]Controller `json:"controllers,omitempty"`
-
-		// Country Country code
-		Country *int `json:"country,omitempty"`
-
-		// DeviceErrorCode Device error code if any
-		DeviceErrorCode *string `json:"deviceErrorCode"`
-
-		// DeviceState Current device state
-		DeviceState *string `json:"deviceState,omitempty"`
-
-		// DeviceStateLastChanged Unix timestamp when device state last changed
-		DeviceStateLastChanged *int `json:"deviceStateLastChanged,omitempty"`
-
-		// DirectConnectDomain Direct connect domain for remote access
-		DirectConnectDomain *string             `json:"directConnectDomain,omitempty"`
-		Features            *DeviceFeatures     `json:"features,omitempty"`
-		FirmwareUpdate      *FirmwareUpdateInfo `json:"firmwareUpdate,omitempty"`
-		Hardware            *HardwareInfo       `json:"hardware,omitempty"`
-
-		// HostType Host type identifier
-		HostType *int `json:"host_type,omitempty"`
-
-		// Hostname Device hostname
-		Hostname           *string         `json:"hostname,omitempty"`
-		InternetIssues5min *InternetIssues `json:"internetIssues5min,omitempty"`
-
-		// Ip Public IP address
-		Ip *string `json:"ip,omitempty"`
-
-		// IpAddrs List of all IP addresses assigned to the device
-		IpAddrs *[]string `json:"ipAddrs,omitempty"`
-
-		// IsStacked Indicates if device is part of a stack
-		IsStacked *bool `json:"isStacked,omitempty"`
-
-		// Location Physical location of the device
-		Location *struct {
-			// Lat Latitude
-			Lat *float32 `json:"lat,omitempty"`
-
-			// Long Longitude
-			Long *float32 `json:"long,omitempty"`
-
-			// Radius Location radius in meters
-			Radius *float32 `json:"radius,omitempty"`
-
-			// Text Location description
-			Text *string `json:"text,omitempty"`
-		} `json:"location,omitempty"`
-
-		// Mac MAC address
-		Mac *string `json:"mac,omitempty"`
-
-		// MgmtPort Management port number
-		MgmtPort *int `json:"mgmt_port,omitempty"`
-
-		// Name Device name
-		Name *string `json:"name,omitempty"`
-
-		// ReleaseChannel Current release channel
-		ReleaseChannel *string `json:"releaseChannel,omitempty"`
-
-		// State Connection state
-		State *string `json:"state,omitempty"`
-
-		// Timezone Device timezone
-		Timezone *string   `json:"timezone,omitempty"`
-		Uidb     *UidbInfo `json:"uidb,omitempty"`
-
-		// UnadoptedUnifiOSDevices List of unadopted UniFi OS devices
-		UnadoptedUnifiOSDevices *[]map[string]interface{} `json:"unadoptedUnifiOSDevices,omitempty"`
-
-		// Version UniFi OS version
-		Version *string `json:"version,omitempty"`
-
-		// Wans WAN interfaces configuration
-		Wans *[]struct {
-			AssociatedInterface *string `json:"associatedInterface,omitempty"`
-			Enabled             *bool   `json:"enabled,omitempty"`
-			Interface           *string `json:"interface,omitempty"`
-			Ipv4                *string `json:"ipv4,omitempty"`
-			Mac                 *string `json:"mac,omitempty"`
-			Plugged             *bool   `json:"plugged,omitempty"`
-			Port                *int    `json:"port,omitempty"`
-			Type                *string `json:"type,omitempty"`
-		} `json:"wans,omitempty"`
-	} `json:"reportedState,omitempty"`
+	ReportedState *ReportedState `json:"reportedState,omitempty"`
 
 	// Type Type of the device (console, network-server)
 	Type HostType `json:"type"`
@@ -546,6 +450,9 @@ type HostsResponse struct {
 
 	// TraceId Unique identifier for debugging purposes
 	TraceId string `json:"traceId"`
+
+	// Warnings List elements skipped because they could not be decoded. Client-side only, populated when lenient decoding is enabled.
+	Warnings []DecodeWarning `json:"-"`
 }
 
 // ISPMetricItem ISP metric entry for a specific site and host
@@ -559,13 +466,17 @@ type ISPMetricItem struct {
 	// Periods Array of metric periods
 	Periods *[]ISPMetricPeriod `json:"periods,omitempty"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// SiteId Site identifier
 	SiteId *string `json:"siteId,omitempty"`
 }
 
 // ISPMetricPeriod Metric period information
 type ISPMetricPeriod struct {
-	// Data Period-specific metrics data
+	// Data Period-specific metrics data. The primary WAN is reported under "wan"; consoles
+	// with several WAN interfaces report the others under "wan2", "wan3", and so on.
 	Data *ISPMetricPeriodData `json:"data,omitempty"`
 
 	// MetricTime Timestamp of the metric
@@ -575,10 +486,12 @@ type ISPMetricPeriod struct {
 	Version *string `json:"version,omitempty"`
 }
 
-// ISPMetricPeriodData Period-specific metrics data
+// ISPMetricPeriodData Period-specific metrics data. The primary WAN is reported under "wan"; consoles
+// with several WAN interfaces report the others under "wan2", "wan3", and so on.
 type ISPMetricPeriodData struct {
 	// Wan WAN interface metrics data
-	Wan *ISPMetricWanData `json:"wan,omitempty"`
+	Wan                  *ISPMetricWanData           `json:"wan,omitempty"`
+	AdditionalProperties map[string]ISPMetricWanData `json:"-"`
 }
 
 // ISPMetricWanData WAN interface metrics data
@@ -674,6 +587,114 @@ type InternetIssues struct {
 	} `json:"periods,omitempty"`
 }
 
+// ReportedState Device's reported state information
+type ReportedState struct {
+	// Anonid Anonymous device identifier
+	Anonid *openapi_types.UUID `json:"anonid,omitempty"`
+
+	// Apps Installed applications with their status and features
+	Apps       *[]ReportedStateApp `json:"apps,omitempty"`
+	AutoUpdate *AutoUpdateConfig   `json:"autoUpdate,omitempty"`
+
+	// AvailableChannels Available release channels
+	AvailableChannels *[]string `json:"availableChannels,omitempty"`
+
+	// ConsolesOnSameLocalNetwork Other consoles on the same local network
+	ConsolesOnSameLocalNetwork *[]map[string]interface{} `json:"consolesOnSameLocalNetwork,omitempty"`
+
+	// ControllerUuid Controller UUID
+	ControllerUuid *string `json:"controller_uuid,omitempty"`
+
+	// Controllers Installed controllers with their configuration and status
+	Controllers *[]Controller `json:"controllers,omitempty"`
+
+	// Country Country code
+	Country *int `json:"country,omitempty"`
+
+	// DeviceErrorCode Device error code if any
+	DeviceErrorCode *string `json:"deviceErrorCode"`
+
+	// DeviceState Current device state
+	DeviceState *string `json:"deviceState,omitempty"`
+
+	// DeviceStateLastChanged Unix timestamp when device state last changed
+	DeviceStateLastChanged *int `json:"deviceStateLastChanged,omitempty"`
+
+	// DirectConnectDomain Direct connect domain for remote access
+	DirectConnectDomain *string             `json:"directConnectDomain,omitempty"`
+	Features            *DeviceFeatures     `json:"features,omitempty"`
+	FirmwareUpdate      *FirmwareUpdateInfo `json:"firmwareUpdate,omitempty"`
+	Hardware            *HardwareInfo       `json:"hardware,omitempty"`
+
+	// HostType Host type identifier
+	HostType *int `json:"host_type,omitempty"`
+
+	// Hostname Device hostname
+	Hostname           *string         `json:"hostname,omitempty"`
+	InternetIssues5min *InternetIssues `json:"internetIssues5min,omitempty"`
+
+	// Ip Public IP address
+	Ip *string `json:"ip,omitempty"`
+
+	// IpAddrs List of all IP addresses assigned to the device
+	IpAddrs *[]string `json:"ipAddrs,omitempty"`
+
+	// IsStacked Indicates if device is part of a stack
+	IsStacked *bool `json:"isStacked,omitempty"`
+
+	// Location Physical location of the device
+	Location *struct {
+		// Lat Latitude
+		Lat *float32 `json:"lat,omitempty"`
+
+		// Long Longitude
+		Long *float32 `json:"long,omitempty"`
+
+		// Radius Location radius in meters
+		Radius *float32 `json:"radius,omitempty"`
+
+		// Text Location description
+		Text *string `json:"text,omitempty"`
+	} `json:"location,omitempty"`
+
+	// Mac MAC address
+	Mac *string `json:"mac,omitempty"`
+
+	// MgmtPort Management port number
+	MgmtPort *int `json:"mgmt_port,omitempty"`
+
+	// Name Device name
+	Name *string `json:"name,omitempty"`
+
+	// ReleaseChannel Current release channel
+	ReleaseChannel *string `json:"releaseChannel,omitempty"`
+
+	// State Connection state
+	State *string `json:"state,omitempty"`
+
+	// Timezone Device timezone
+	Timezone *string   `json:"timezone,omitempty"`
+	Uidb     *UidbInfo `json:"uidb,omitempty"`
+
+	// UnadoptedUnifiOSDevices List of unadopted UniFi OS devices
+	UnadoptedUnifiOSDevices *[]map[string]interface{} `json:"unadoptedUnifiOSDevices,omitempty"`
+
+	// Version UniFi OS version
+	Version *string `json:"version,omitempty"`
+
+	// Wans WAN interfaces configuration
+	Wans *[]struct {
+		AssociatedInterface *string `json:"associatedInterface,omitempty"`
+		Enabled             *bool   `json:"enabled,omitempty"`
+		Interface           *string `json:"interface,omitempty"`
+		Ipv4                *string `json:"ipv4,omitempty"`
+		Mac                 *string `json:"mac,omitempty"`
+		Plugged             *bool   `json:"plugged,omitempty"`
+		Port                *int    `json:"port,omitempty"`
+		Type                *string `json:"type,omitempty"`
+	} `json:"wans,omitempty"`
+}
+
 // ReportedStateApp defines model for ReportedStateApp.
 type ReportedStateApp struct {
 	// ControllerStatus Controller status
@@ -742,6 +763,9 @@ type SDWANConfig struct {
 	// Name Name of the SD-WAN config
 	Name *string `json:"name,omitempty"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// Settings SD-WAN configuration settings
 	Settings *SDWANSettings `json:"settings,omitempty"`
 
@@ -789,6 +813,9 @@ type SDWANConfigStatus struct {
 	// LastGeneratedAt Last generation timestamp
 	LastGeneratedAt *int64 `json:"lastGeneratedAt,omitempty"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// Spokes Spoke deployment statuses
 	Spokes *[]SDWANSpokeStatus `json:"spokes,omitempty"`
 
@@ -1035,6 +1062,9 @@ type Site struct {
 	// Permission Permission level of the current user for this site (admin, readonly, etc.)
 	Permission *string `json:"permission,omitempty"`
 
+	// RawJSON Raw JSON object as returned by the API. Client-side only, populated when raw JSON retention is enabled.
+	RawJSON json.RawMessage `json:"-"`
+
 	// SiteId Unique identifier of the site
 	SiteId     *string         `json:"siteId,omitempty"`
 	Statistics *SiteStatistics `json:"statistics,omitempty"`
@@ -1141,6 +1171,9 @@ type SitesResponse struct {
 
 	// TraceId Unique identifier for debugging purposes
 	TraceId string `json:"traceId"`
+
+	// Warnings List elements skipped because they could not be decoded. Client-side only, populated when lenient decoding is enabled.
+	Warnings []DecodeWarning `json:"-"`
 }
 
 // SuccessResponse defines model for SuccessResponse.
@@ -1248,6 +1281,74 @@ type ListHostsParams struct {
 // QueryISPMetricsJSONRequestBody defines body for QueryISPMetrics for application/json ContentType.
 type QueryISPMetricsJSONRequestBody = ISPMetricsQuery
 
+// Getter for additional properties for ISPMetricPeriodData. Returns the specified
+// element and whether it was found
+func (a ISPMetricPeriodData) Get(fieldName string) (value ISPMetricWanData, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ISPMetricPeriodData
+func (a *ISPMetricPeriodData) Set(fieldName string, value ISPMetricWanData) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]ISPMetricWanData)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ISPMetricPeriodData to handle AdditionalProperties
+func (a *ISPMetricPeriodData) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["wan"]; found {
+		err = json.Unmarshal(raw, &a.Wan)
+		if err != nil {
+			return fmt.Errorf("error reading 'wan': %w", err)
+		}
+		delete(object, "wan")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]ISPMetricWanData)
+		for fieldName, fieldBuf := range object {
+			var fieldVal ISPMetricWanData
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ISPMetricPeriodData to handle AdditionalProperties
+func (a ISPMetricPeriodData) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Wan != nil {
+		object["wan"], err = json.Marshal(a.Wan)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'wan': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -2857,138 +2958,143 @@ func ParseListSitesResponse(rsp *http.Response) (*ListSitesResponse, error) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9eXMbN/LoV0FxX9UqKVKiLh/669GSHLN+lqwVpXjfxi4HnAFJrGaACYChzKT03V/h",
-	"mLsxM5SlJPvb5J9YHJyN7kbf+G0Q8DjhjDAlBye/DQSRCWeSmD/e4PAHrMg93ui/As4UYUr/EydJRAOs",
-	"KGd7/5ac6d/IVxwnEbEtQzI4GbyZnH35YXJz/nHy/wbDwUqpZKawSuWp+Xw8PhgOYiIlXurGt4lUguAY",
-	"SSLWNCAoZXiNaYTnERkMB0rggEzDwckAz4P9g8PBw3AggxWJsZ7w/wiyGJwM/rZXbGbPfpV750Jwce22",
-	"NXh4eBgOQiIDQRO9fL1MHKKl3SYaobS+DqL76+ne4PCa/JISqR4Njevzf9yez24AaByNx2VoTNkaRzRE",
-	"wk6IEixwTBQR8vlhkc05QjGOFlzEpPhNbpjCX/WEU6aIYDiaEbEmwgz8KLBML2/Ory8n77+cX19/uAbx",
-	"pAYZO685HyLc8TwrUOApH4aDS67e8pSFj9r45YebL28/3F6egdhwVN7zNZE8FQFBjCu0MDM+64Yvs2nQ",
-	"KDt5gwNuFSEn0iyFfKVS6XmvsSLvaUwVeRwsric351/eTy+mIGkcvK4AAyuCIj0ZIl8DQkLyzNC44RzF",
-	"mG0yUEgNFXARK4JDTaInvw2uiRKb0WShiCGLGnzTeE4E4gskScBZKJHi6B5TheZkwQVBQvembDkYFsA6",
-	"Lm9IbRINC8oUWRKhV/0wHNwynKoVF/TXRx7D7eXk9ubdh+vpv85hrNyHeNTkaoruyOZ5D6G8NzRC1M3N",
-	"BYqplJQt82U85JOag5ikit8mIVbklLMFXerfEsETIhS1txxlQZSGZFKASJZAPOc8IpjprSSCLIggLCDy",
-	"SvA4MZBlaWTvqBMlUjIEuunFhKkFdHXmhcEoFmyaKGKXjPIWaCfENNoM0T0hd/r/RAW73w3y+aQSGl8e",
-	"hoMVTwGUe8dTg3Ah3qAFFyg140u0Mx4dHJbGKTAq/4nP/00CBf0yHJxyJnlEfhA8TS6IxurmLmMclOBZ",
-	"LFRwCxPww0QpQeepskNUB8S1k8JhSPUfOLqqtKv24vfMkkXzhDSQqfB9lWmScKHgzxBQGj8EmIVUw/ua",
-	"Rw7nFIkluHn3AxYCb0xfzhgJFAk1JcLwqjZ5j6U6XWG2tAvWFzhWg5OBnn+kaEyaSAOtWW7kNARZDYwG",
-	"SvAogo4/yL9ZXgJuYUGwSgVpPc7myTTWscIsjIgR06ggcSbUwiNW6LUYgzKqKI7OiJb+3lOpZhsW+HCD",
-	"MqlwFOVnUxcczFeDqUjqNmjHEt5bTCMSDlHK3Aj6Dy9JG9gLM8wkoVUEqpMGPeOBfM8teYCwZjiG8WhN",
-	"hIQ7tWB5jqhUWgabegmJymm2WV+D65QxPSP4OVt4FcgF7iHdAO0wou65uBuiRHBFAjVEOAiIlC0A1vQN",
-	"4brj+SpYbUe0gkQES6LJkJGoueZr+x0FtgHacR2GaE4UblloB6eC0bAEIYeEOFB0TYaIMvsvcC6Zk2t1",
-	"OEvGaIffDRFfLCLK2vpfZPICADZ5j+mPDaQrQd/+0rIf0wCYOwXGLX1lOOSJIqEl8gpBAadpSNbe8RDU",
-	"LUVPcmW1sV63FJTrs6UbeDD0SRCl5ZqWV4IvBZHSKyskrgFKiAgIUxrqQwCodrhZP6nEK170uRbWLWfg",
-	"vl3je4A88D1y35Hr0evOssfZ3FFYHHN1Is3ejVRkG2gxHy9JiOYbpFZUohWXajAsCL9NhLWTTxWJIY6g",
-	"R7L3aV2upb+kBNGQMEUX1GoFakXM1G5dsJQn1SXID/WvPQdJaTjv2tYtDedTtuAF5oQTBUASS+VQGmkh",
-	"A1GGrt+eHh4evkZOBBk+WhaxoH1bkhFqt16ERXxhzk7ANIoTOi0uUV+bqlhZm8PcIYB0K5OrCG/mOLjr",
-	"Jx/CAmKi2fCV4F838OKCiKdhqyh8vs7Endat2WbvlEo83CxIjn7Qau6plSo94AJ3odf4Bgd3qWfsIJWK",
-	"x7NYJdZqBLcKc8kL2G+qeETZXYlxA3oaFlqCs2xR+rfphxi0u5AKEqhrEnOjSrbARsuh8g351d784NeS",
-	"WRX8/v70wv/t/MwjPhkmojZNsGk+QNly6hq8S+ezgAsCX7pSYRZiEfoA54WonCyduQH8akE3MXT0I8W3",
-	"H2aepkwzwnASxpTJW0mEvKoKEK3HRNmCZ0Jy7V6jIr7Hgli86D2enKSKx1jRQAvtfE1E5apvrt+JtzUt",
-	"CLMZUac84qLvzPECwxPEMnkjaLgkFzwkctamng4HjKgpmySJpUvX2NOU6yuo1QQiV1iQ8IbfEW8LFieF",
-	"pcWrTb/lIvY02MiIezorEhH/+rOvfk05peHMmvXbRLl2k8N1RbbvZriZoCUffz2URAtAxwzpmoYpjtxF",
-	"jzQF6HtWfx/W96HlXsrZDYVEB/0rul8Rlg11jyVyonLP63uYk9nMoz68dd+RdHpEmtzwM6zIENUk6TaN",
-	"GBClLJTQ9AztpDLFUbRBF5NThMNQS8XwMIl/mKusJ9hROstXs//HFVErrZpoATI7EokwClyPIaj5WvEl",
-	"7D2eE1XB0ZzFDdxXCSTQxmIeQgqr62y+GjUb6gvr566rtxOHVFbN9kchWVBGQmSa9NCTEsHDNFDvKQNG",
-	"vLIfkVZXH2UjkCsuFLzFmf7UARypsFBpAhOekZ5dC+QIqx+1+ZR0B/WMxjjT+8719ZZtPk4naNF+80+Z",
-	"epBpdnSBMNv0OdiSHlmzBKRCEKZQxnOysbdRK2TudjCaRPRhMTj5qX3/s9QgS+GvGDY0TqxwxWTUrTlC",
-	"WiMjX5W5bAF8xkvKrGVR6RbGpmBMVZQtjfKnOyNJjIIriEwjJfvA5fPDcFB1xwAm3RA46Aus5yYjQXBo",
-	"ztv4SZFpXPJj1b1MTdW25nZquDJubq4yxK4PblxUTZE2LoxQtbHSGLP6irPW5UUDzq6GETDzfnXr+Pqs",
-	"QjJPl0t9WEkqEi6JrExoPWiaBx4dvxgtV/Tlq9fg8RUmwZ8GDhw1CBb7Lxb5GaCHtxUB2RB44+gjLR+p",
-	"nKZ/9FHme9OuQZioHNPRQfcQyb7DItTjwYubc0BAekOjSJNAjBURFEcSMeN+hY4wSNJdSLQ4vbotHR/U",
-	"MyRzipkGt+8W1N8NvvpuiAxWbZbL1b0gawCNHViQIGtaZYBlKoDEgg55AN5NPl/7nfeLgID5j2sDhg6A",
-	"CjLnXEGmc/07ClNrykGUZU508Ho0R844cGebLy24sMV9j3bI7nJ3iG7Prl/CskM6z0zZzW8bCUFptpGK",
-	"xCCQKrbcpcAQk7y1H/rCKU2hNeTHfHs7PSuLJKZ5P4LlkBlnlZFx26RNm6jfkkmf0LRKk4mjB6+8USgH",
-	"PZYm30Q8uINEe627BcYNT8ujaPF+bvugheAxMoY1J6GCAn+Ey/Y6o/9aF7BHx2sYZ63Wp5cQ5MM4Z5Ee",
-	"2ziqllsogfaasDaHFl2zuQ4HTts/g8fc2hT7Ts7vGRR104B24E4zlURomOvfTF+7ilzdAkEuyJJKZYlr",
-	"qx3mkC7p2HYwIkiIFLdrM/bevjsWxJqAPH5oK2D+Xc9j27mjbbUUMM4gqpowzjYxTwtdtMyhOjiEMYxI",
-	"r6OchKhshEf3VK00NKjI5D3MQpTHCvR0zFyXgTNJEkjQxnmUUNdojXgi3TsTaspGIZ8WJKq+38o2+kSD",
-	"SB4R+YHNcEze8wBHl1aVbU75wZgNsh6IW6yT+r6KdEfklGBgAX4vfxHN8QW+M0rOWXdrQPEqrkkrKpSa",
-	"lTEhcFEG9lrTCOF04J7oUIpVAfeXMiU20L7Mh0zjaF7FlhyM5gTrLU4lL7SiLTRgO7iHvLNbKSx0fjJo",
-	"H6QWJNS4OL8aK4RUOE4qBkHwSgBgYXwl7kY64zGmgIpwZhplFw4KTTOjGgnjKWhceHC4ULdmnTsOS0J2",
-	"P2IHNCLjg7ESSlfvirLifLZf4JAGLSaZYIYugU+P0Wpnyxt44ogEI2oqZUrkcWxPpW0L00oPn8n0Kp1H",
-	"NOgymRqxqsUJj6OoNASRCEtJl6y4E/PbuD+/pHKmcA/hqxC8EizsajSuB3ewuFUKr6pBYrWRVPPWrElD",
-	"Pmyo06DuTFVaZjNOSzFT2+ioWg/Olt4uAocUMhFmQWLINtCiSpFiUB9Eka+qZYjyz730gsfoovEyVl8y",
-	"90/d9sTw0gT8Id2godaVKOhRVuqugK6MBdcud5/FFg5qqsjdoIWJxuRXzvzLzxs8hWE3C5K6ZXRBP8zO",
-	"uoJo8g7oltG3FH2YZXE1W0kYXmNvPqrXyDsc3GMGrPDj5BIZ3rfAAZFVCaK8tpoELCUPKFYknGZ9QZ5D",
-	"mL66vQGibV1psj4CP/hippMoXS59c/mjGD3Gh+6wTn+LhrajL68Ks0M7TvYcZpLmyCbQfDfQQEtja7HM",
-	"nGLVNiUDZQmFJRFnzrIO+ItkQgK6oAEKscKoOL5ceiw8pEGUhsb0SoTJH9DahhYlBY861CJQf8lIgIJ6",
-	"zGMk/FJEvQSZhW6ElroVim2z2rr7CsP1+P1Wob/t+s41nHLzbbZOYkwj+GyR+Va6HHJF03b6Bhnx1iFV",
-	"RUpMowiOrtOt/y6RbuC9LIx2BZm2jL7mzA1bac0lNG2LkO8PagDCZVJIiChj8ADgAgJ0fpuRRMXLXc3r",
-	"gMBybYhuK4D43J5m/lwf7GR3NfeJmatkm3TtP3vsms/pOGzVK7hUPs+d/vbHezTtCn8Xf2bhMXsxPjg4",
-	"PJi8ejk+OB7n/704fb0/efv2LP/h5dn41dmrUoPDF6/fnv1zcnCyf/Ti5fjVwfH+UV8/6XR2dUGUoIEn",
-	"Nmd2pUVqQQNEjPFA7wmj/J6SVGu5LMzijJtBe6CBXOuK7c4TO+lN60Vt23i4DeUhZMTS51j0RVnDnpdN",
-	"Dq0r0w9CEA0RaM8zDam2PUOCSn2+ptZQ3kbrtd+HLGvTGUGlOAuvidgaWZzklB9KP7uvV1Z2O9sqHAJa",
-	"f5NMzbdC1LILlkbkakDtHrPeQPuImYVY69KyVu0ifvuq8Hr5His47XKyJgIvrf+BBRujE9Moog3XWdnY",
-	"xe9ZxHH45W4OyYZn7rMme6KxDJl2vpEUiChn7ku7P680FJXJRDKYJU1SxRk3NnznZbz0ekGpTGBBSA/k",
-	"E4Bi/NUL4Qv8lcZpvBWEExzcEfWeQy65K/MNRbxP4kvLMd0mPQ8pTeAjuk22OKBWJJf/SAlkgi6uE4l+",
-	"0U3ysgxzHm4aeK55aRsTN9+R4m6skom9Um1iO9Zu167ZNZwJ02Pjzym91HI3fGFBHxIrXmehQJmD39ru",
-	"Q6IwjUxEaafh3p1XyzGUT1XLB/o0KAnt8WwNf1/+kU9gNhDPwwRd5oSDJ6ILJHlMHKYYnzyzRnk6j0hZ",
-	"jZdpZquvDqFl563DfDpkrRqKwbKCxekKItdihciSsvz+Ba7mFUEEi4hq+iqcIYqb4gyUrIk1MxgH/U7V",
-	"x/td7wucsLBjDc4T3rKC1Pz26CV8g5j5LdJaWe9yS8gH/NzKJP5w7aaD2DzoW/WkNHhRSej22CMpC8lX",
-	"T5hBLsLqJv2um26+3HCc98rtb809Tjvdef2z/2v8NEnyyAAU4wSyWSwivIRYse5qPm3lWnLJVP60xP+m",
-	"rCu3x9xDXYUE0cifCCrJJFB0jb3ZSjF1KZqXtqZOe6OWghK1Nm1Z6FwsMaO/mtalLAwwvd4cUcce9JF0",
-	"t9CH1rNZayp7IGdEpUnLGK3d9UF/EPbUz7+6nMt+eXG/V7mHR1ZrqKd/2C95FSuJIlpN7u6k+Fq5gtqd",
-	"93EyzaOcW9yPsPdEsyB/LYNpAPrDpogGnKEEq1VnDYRG1xYnmteqoFe5lUlhdvZxcumru7RK521y8Sqd",
-	"Vz11vYVhM+s5CxNOGVyqZJto0dnZ6OPk0i2lf4h0OQ2/cwhJlL4p+u1sljXWHRN+167k6QbPBcd2T2Bl",
-	"12iEnI882iDOog1yiaASyfAes9FqLpOyQlH8CHkC11hQzIBAADep+452JGXLiAxRnEaKetOeOnD3jzL0",
-	"l8nHI1aWmvhEsMo5ZNaJkCQR35iAiVwuq93ZWteFJbpinFFE1iRCru02zHRB2dLIBNApVuZA5aYALiwJ",
-	"IwIrb8LpD/Z7FlkBi6AwM3qXzpuQItsRkBb77LQ9ORF4Xu2KWISlcttsKc+xLACRq5NlNZEy9eIIvLR8",
-	"bGZmuMu3QsiM4ofRFlVHttjSPRYsY7ndCJ637o/iHTzF7vdPwFkywHfylz9e8a7ww35qd9alVK7DG3dl",
-	"TDn2wlRcyx9Nl1w6B00l6byDQFXqiwvPLmrXIot1tLP3h8qN6b4FFub3uo//aPmLCwcP4lo3YqeezGWp",
-	"GfCVoFy4AiZ18d1+QVyEVjTT7dHOIluhNELFd6306TFEtjLjDEwdq3fBS9OwJUDGtZFoTiLOTOYnfNid",
-	"d2ciaIzF5iNmIKT0N1RxiMHRGCnoH5ilc0aUCQc9nZ5dF9WbtlCUHmsXNAF8WZkV6DBMfJHe2iJrNOyl",
-	"rdZu4n4XPRT8FW1aLF3lvATdtEXe8ElX7+paz2Nkq28gQ+phb6QnHcDpouncX//BUQWgQWX0gsO1PgOZ",
-	"FWbbnjtmOTJQSIpR1T8sLAOVt5KEbzYmdaZs2PPVb864duoWx03OTSlDCbJRZMRbYFLnBorWLZR7bX5/",
-	"AnCZgUDCNg7Nb1v9NzEHn8zWJJunlNi8KVbuQ11WZxrie+Ze6q1WwUM9gvg95gg3fAcZQgeTdX3s2cA7",
-	"e8rzsfgK08NTnA000CNOxtDtjzhKvWtt3LlbgBla5FMCeVayUfVQWHOTFiBDS+MMc4lf3mveSHi03HLY",
-	"p9q6UyaNpT5b83kRog9OZYXczL5fXnvPCcypbqPt1m14N/xdOtdHSKH8nllJKUHCtjL1B9DOkvC2okX5",
-	"2O5+uwBTEyvjZ3eaHV8GuKsYlxXAJ6niswBHZMLCS6y6QI5TxUd6cBsBeTm5QYUw7wd8fZprOMt90hx9",
-	"eoWEbtyDyOxMU8kjMFXHQouazyVU30YUrSJBR4lyaPYGrqK1RUEvjYAr8JkMYbvOcwrERd0B2aaky6aW",
-	"vp2xqWQLgFIQPDfArGlG/xMI5rOqbv4Y0dwO8aTCeXa3/iWe9xTPtwfYf56ADhHQU0onzv7VdEaZ37MA",
-	"tzlR94Qwxz5M5D1s5fuImc/QV400hiscmPE9Y1hI9BrFw1ire9rJn/8YopDK0l/bOrsqONMWXu1mbguY",
-	"p41wJ1/GnG2X151ZU7VB1Pb6JmNeAu+iPUk98oUt667+kGXPYiruBhAZqnDdNsFBUyQcdvTYUvO2rKjN",
-	"uKHSBH7CUeEfnqzGTnWOcpgP6eHHoIpcEJtoUSSwgWkL7huyDh0OLE/Ln/mS0A4OY8qGSBAcctb65JKP",
-	"Y3oh7oOrJiwqlQsY7tr4rGjtRY8LAgU+62V6GHxrJv9w4B5KvIDS911Rc/SoknJmcp8c4k98N938ae8+",
-	"sMwqgK4HNaauLHztd0EVDXB0WSqTDYchOSAVz1EATUyJ/YhWQ/dKDSLMKgI03MrVc22byTX5oXtNruVH",
-	"uqC9monsARVPMBZhIWVLWzulraHiKntvCW5w3wsW93RB2yBqvvcbpnUxet/t87QCBkLJZfEcQFuhvM60",
-	"WMpkUqoBB1ZXSirV3Xb4YjFEnLXV2k48VoPp1cwZCWgoh4gmsn2UGV0yI3k39ynSiEhTYmmbh4DyAW3p",
-	"nJ3zm62EnpYKjxkrszUei4a9xt1a9mmIO2AlmwQuesq2Ts8qB7zCPSsteu25SL0COKf6ap7DBCTZf9qn",
-	"LsHMraL4zD1mt56sKy1A2Yys1jGgJd9jdoGXNAAqf7Q/8NBa8EOmc72+ef/nArOaJf2eMSRf7WO0U0C+",
-	"PXffOovXd6BSJ8b0QAgt8Ob434LXz3O2PR5V0GLAnyCkhao/VeXv+vZOfLtrZirhQKU4QtkL4ijBm4jj",
-	"EErE+JYS3wfjMRhX/cdX33bZxo3q2201t/OKSw1AL115xfql35lySAPO+soLPZvFGV/vyM4p1xqs3Cl6",
-	"ALTCcgWn5kD02ajL4nnWzdSu8WTHLCLORRLZOJnGgzznIfXkzQSY/UjJfe+XekzWSPcDwj0e2Lknc6GA",
-	"K4kG5JqYFyI8OTEkpHhmXq6X3S0mP+53N3p38OLY89DOPf+IN5M0pPyxj9pYW2UqqNrMNCu0u5wk9H/I",
-	"ZpIqIIPDVd63NTRStdLkbEG5iz7MlakdKXiMTCLTbkp3Ax6bcv3SCry7Wr7SA9lXugeZIjr452hyNR39",
-	"T7moPzbrsO9QU0edAWcKW3elK1Q0WPzfiHzdjXAx1iQid5JQNFtTQcM7CiSq2TJmRm91T9aZVSaCr2lI",
-	"pH3GEcfmxSlXAhMp7mqqscwXzxYCSyXSQNPG7if2if3tb2hSAcsnNomi4tFyx6kQZtkjBijBUpIQrSk2",
-	"10YOCGRBlA1rXlw3z7tTtvzERmi9nzsd5AnaHw/H43ExUUIEiilLFdFtz7GINsjmn1V7ebqYKV3mipvv",
-	"5731/t73P6MRminri3RPvVBuH2/YFCPbPPaIs+VIERFnaQZ2GILtMPCihkimBj01vG15009a/I1oQNxd",
-	"6I75zexsdDg6jXAqNYNPhcYGzfflyd4eTwizOUa7XCz3XG+5V+lkjBrKvocBIsSglIcz2N8d746NIJYQ",
-	"hhM6OBkc7o53D02+tVoZ2tGbozIZuVzyvd80Lj+Ym4SAdfRtDrGsJKDjQHApTSlOm/QdUXZXVOC8nWqM",
-	"1Gra32WGRbuf2EXW28rNNKJqc6Ihfjyyp2pVnbVJqDdNT2qPoWKFIoKlQgdHaMVTIXXv/ZH+Z/++h2MU",
-	"4o00Z6Z5p6EC8xz9D0QV+cM2ST3LC9fyXt1Ab2qbEInu3dNLOXTs0/ypYCREqXl1/jhGXKD9Vb7Kqhxx",
-	"HGdcx2VpOQRyuV6F9GDv8uKx/CwPxgywvwKSYLTg+bQp68WyD8YHR6Pxi9Hh+Gb/8OTw+GQ8/le2EZNZ",
-	"X+yklkdf3kO/5z6fOOkd3sRx+yYqafjfvoUCgzTRGAXGhh7kpZNkeUdD+/STRidzNHmF+Ky8h3nuK9Rs",
-	"fpYlTR0crQzy5xTmxh0ar9bLUGPl4Tg0bRwduRa7n9jNyha6tTSAAswYV2hOrJ/UMNDqserRyjDaNTQG",
-	"QTIsQsObyHxwpEngpZYxD8chhNOfNU1Y/cEwtYPxOLt7nb2tVJZu79/SqqPFTP1qlBTK20PjenYK0CIt",
-	"NBnNeI/sQqDx8wXvvcHm6Xlii58djfe7u9wyLctwQX8lpiDW0cHr7k76Qjb3se1z3Gdttv4AjuzLq6ZM",
-	"ue170Gtf2YOlRnBL4xiLjeWr5etD04bJsP/JWJEyfvtZd4Kvp71fslI3iXsopM8tZTjAHGtk5awop1av",
-	"+bH7iV0bbm2rOlcLmyijsZq7DEU4uJMlWQsXclNWiGUXuFVMHZL+98pNXt/LmiuzyjuPvCFA0jFrfsPD",
-	"zTNQja1K9FDVevWqHn4Xoq3WBvqLcr+Ncm3dny1oV4aje8xGNoJD9hArsUmwzwq7QzGiEqzRW8iYKMZ3",
-	"mUlLC5sBjqIGEb6nUpWT1AbPiIxgMtw2mPi/Hq2MawM86xJ+2e8+1Nr7jYZ91BZbhYtU6jYiPOepKpfY",
-	"BGOT5xsbcdNQEkoH/GZjKhJ1cvSOggGlKuNNHm+sbVty+OdH7d8Ns8dH3Z0uuXrLU/YfKhu1Yt8W5LBX",
-	"hIJ1UIWJLrGGc/uIRRshDEuV0EvBvsYCZR/DNSGuVqco8WoXdNtGPrM8YPi/j4Bqqd1/kdGzkFERSg7R",
-	"0Xp/LywerOgtqlhbnOuZvbGtKXbFpTIGIUF6SCm1SDdT9Dk1RdXDmDJQhjnLn8popZgsdsDE7E3PjLqy",
-	"oJEyD4STSl3sJDK+NUsUkK5uAwVlRVXfIslXbYyZRV++g6YNxJRmSATXeE7CkimHL3L4Nh7Hq9tvjkfj",
-	"F6P9lzfjg5Oj45PjVz77jTPMfKvdpggcN2BwNppUMGOgTupvAu+PPcvRLWf01+qSug1fuTM3KXt4C8OX",
-	"37ULLaLwIP9RLLH+vvZfOtsTCNeauxTP6mS8L+MeOfMz/GprLc1yue21MuMp+v77S67I99+foBsjhDjn",
-	"lB775+zllp+NKPFz5b3Kn9GCkijU7HaD1lhstCxiYwezRwvzp3+4QFk6qQVtVo8Mss9oeJn3ELqY6n8s",
-	"3T/X4wd/Tn5SfdviL70bYg0rh+8ZY7D4X2ULT6thG0nEq1Hr+fuq0mlbgsKjFICCPl4fHUxevD09P3hx",
-	"fJBj/6vJi4PTEjW83j99fXD+MieOl6/G++eH+yeHrw9eH78+fLk/GP7uCP+XGvFkakQFUz0EkhfN3+re",
-	"tL7yHeO9s3eosJVNS7dXdm+V0OG7jqsWNna6uvDPp8lWAiP/YrMQm82K8+e6p/n780M5rslwuXJE00+f",
-	"Nbew79tBPPAqD2pxcUvCFjiqhpfgJItuGujh3ArAjOK4eBUzxyNZME+L+oD7mirS1dduuNn3rJRG5u+d",
-	"iavN/pVgFBaimDOquOa1aKcctfNdMVjZXQFsBrIdlJbnG9VZEx4+P/z/AAAA//8h95d/I6cAAA==",
+	"H4sIAAAAAAAC/+x9bXMbN9LgX0Fxr2qVFClRkmXHui9HS3LCe2xZK0rx3hO7EnAGJLGeASYARjKzpf9+",
+	"1QDmjdMYDmU7yT5xvsTi4LXR3eh3/HsQyTSTggmjB6f/HiimMyk0s3+8oPH31LB7uoa/IikMEwb+SbMs",
+	"4RE1XIqDf2kp4Df2kaZZwlzLmA1OBy8m5z9/P7m5eDv5f4PhYGVMNjPU5PrMfj4ZHw0HKdOaLqHxbaaN",
+	"YjQlmqk7HjGSC3pHeULnCRsMB0bRiE3jwemAzqPDo+PBw3CgoxVLKUz4vxRbDE4HfzuoNnPgvuqDC6Wk",
+	"uvbbGjw8PAwHMdOR4hksH5ZJY7J02yQjkm+ug0F/mO4Fja/ZrznT5tHQuL74x+3F7AaBxpPxuA6Nqbij",
+	"CY+JchOSjCqaMsOU/vKwKOYckZQmC6lSVv2m18LQjzDhVBimBE1mTN0xZQd+FFimlzcX15eTVz9fXF+/",
+	"uUbxZAMybl57Pkz54/miQMGnfBgOLqV5KXMRP2rjl29ufn755vbyHMWGJ/U9XzMtcxUxIqQhCzvjF93w",
+	"ZTENGRUnb3HAryKWTNulsI9cG5j3mhr2iqfcsMfB4npyc/Hzq+nrKUoaR88bwKCGkQQmI+xjxFjMvjA0",
+	"bqQkKRXrAhQaoIIuYsVozJRlndfMqPVosjDMksUGfPN0zhSRC6JZJEWsiZHknnJD5mwhFSMKenOxHAwr",
+	"YJ3UN2TWGcCCC8OWTMGqH4aDW0Fzs5KK//bIY7i9nNze/PDmevrfFzhWHmI8anI1JR/Y+sseQn1vZES4",
+	"n1sqknKtuViWy3goJ7UHMcmNvM1iatiZFAu+hN8yJTOmDHe3HBdRksdsUoFI10A8lzJhVMBWMsUWTDER",
+	"MX2lZJpZyIo8cXfUqVE5GyLdYDFxnrD2zAuLUSJat1HELZmULcheTHmyHpJ7xj7A/5mJ9r8ZlPNpowBf",
+	"HoaDlcwRlPtB5hbhYromC6lIbsfXZG88OjqujVNhVPmTnP+LRQb7ZTg4k0LLhH2vZJ69ZoDV7V2mNKrB",
+	"s1qokgkLfpgYo/g8N0y3B6QbJ0XjmMMfNLlqtGv2kveCxbX5aicEQOYq9FXnWSaVwT9jQGn9EFERc4D3",
+	"tUw8zhmWanTz/geqFF3bvlIIFhkWAyXi8Go2eUW1OVtRsXQLhgucmsHpAOYfGZ6yNtJga9ZrPY1RVoOj",
+	"gVEySbDjj8pvjpegW1gwanLFOo+zfTKtdayoiBNmxTSuWFoItfiIDXqtxuCCG06TcwbS3yuuzWwtohBu",
+	"cKENTZLybDYFB/vVYirR0IbsOcJ7SXnC4iHJhR+BxR0kbWGv7DCTjDcRaJM0+LmM9CvpyAOFtaApjkd3",
+	"TGm8UweWl4jKtWOweZCQuJ4Wmw01uM6FgBnRz8XCm0CucI9AA7InmLmX6sOQZEoaFpkhoVHEtO4AMNA3",
+	"huue55totRvRKpYwqhmQoWBJe83X7juJXAOy5zsMyZwZ2rHQLZwKR8MahDwS0sjwOzYkXLh/oXPpklyb",
+	"wzkyJnvyw5DIxSLhoqv/60JeQMCm7yn/sYV0Nei7Xzr2Yxsgc+fIuLWvgsYyMyx2RN4gKOQ0Lcm6Ox6D",
+	"uv3MJqWy2lqvXwop9dnaDTwYhiSI2nJtyysll4ppHZQVMt+AZExFTBiA+hABqhtu1k8qCYoXfa6Fu44z",
+	"8N+u6T1CHvSe+O/E9+h1Z7njbO8oro65ORGwdysVuQYg5tMli8l8TcyKa7KS2gyGFeF3ibBu8qlhKcYR",
+	"YKRp3F7BreC/5ozwmAnDF9xpBWbF7NR+XbiUp80lyg/h156DKHr/f2dvLvEDgC/EwZZQTRQzuRIFbBiI",
+	"2/vkLOFMmJHmMSNSgFiaySxPKKiL9ysmiCoGUszADqUgXBMmAN/j/cFw8HG0lCPQSEZ8KaQqicD+7hg+",
+	"rMYu0//qtwGd9q/pfcFgal9H+gPPRjJzd/4ok4Clyg1tmUM833aatzyeT8VCVgQTTwyCQFQbT8kEZCvC",
+	"Bbl+eXZ8fPyceMlr+GgRzGHUy5potHHZJ1Slry3KKpw10YxPK9kh1KYpTW/MYa/O9u+pzq4Sup7T6EM/",
+	"sRiXizO4fa6U/LjGFxclMo87NYCLu0LK69yaa/aDMVmAiUfZk+9Buz9zwnQAXOguYI0vaPQhD4wd5drI",
+	"dJaazBnL8FZxKXAi+82NTLj4ULuv2gNkVIHg6m4DHd5mGGLY7mKuWGSuWSoN64YNiN/6BfuNJcGvNWsy",
+	"+v3V2evwt4tz/JvjnWbdBhuwPy6WU9/gh3w+iyyPwWQNbaiIqYpDgAtCVE+W3sqCfnWgm1g6+pHT2zez",
+	"QFMB/D+exCkX+lYzpa+aclPnMXGxkIVusHGdc5XeU8UcXvQeT4PhJKWGR6CryDumGhJOe/1eqm9OHlEx",
+	"Y+ZMJlL1nTldUHyCVGcvFI+X7LWMmZ51aeXDgWBmKiZZ5ujSNw40lXDzdlp+9IoqFt/IDyzYQqRZZWAK",
+	"GhFeSpUGGqx1IgOdDUtYeP3F17CBIOfxzHkzuiTYbkvLdUOl2c5wC/lSP/56qElUiGod8zse5zTx8g0B",
+	"CoB7Fr4PN/cRO2HghmMSE/zqxBU/1D3VxGsIPa/vYUlms4DW9NJ/J9qrT3l2I8+pYUOyoUB0GQIQCdJB",
+	"iUzPyV6uc5oka/J6ckZoHCumNT5MFh7mquiJdtTe4Nfu/3bFzIopJzcXR6IJJZHvMUQVfie+xL3H8xI6",
+	"Opo3NKL7qoEE21gqY5YEO9uv1rqA9cXNEr5rsJPENHVg+6OYLTgI2kL2Uw8zJeM8Mq+4QEa8ch8JaOmP",
+	"Mo3olVQG3+IMPm0BjjZUmTzDCc9Kz74F8YTVj9pCtgkP9YLGpIB9l2aKjm0+TifoUPrLT4V6UCi0fEGo",
+	"WPc52Jr6vGEAyZViwpCC5xRj76JW6NLbYjWJ5M1icPpT9/5nuUWWsuPDsKVoU0MblrLtCjOmLAv20djL",
+	"FsFnuuTCGVQNtLCmFGuhAx8QKKbQmWhm9XrFdJ4YlOLvqRJcLENGAZY48zEBVTIDtZdFNNcMpliTSOaJ",
+	"JVAyZyRmkYxZ3EMbTpiAFq4HrHd3XfhtseymMvzT+3O7Cv+5tzLcxo/3D8NB0xuHWPRjBOFfUzgDNlKM",
+	"xhbvrZuc2MY1N+amk7Ft2djwOrY8WTc3VwWBbw5uPZRt0T6tbJAbY+UpFZsrTkuDQrVoxNe5ue7S+bnd",
+	"xAM4G7N5vlwCEmS5yqRmujGhc6DCXfDk5OloueLPvnuOkndlEf5p4MGxAcFq/9Ui3yN84WVDUbCMrnX0",
+	"gMzalLztxxCHemXbtRgUqYf0bOF/GOv6gaoYxsMXN5eIoPiCJwmwgpQapjhNNBHW+44dYZTl+5iIdXZ1",
+	"Wzs+rGfM5pwKAHdIGoDvJCoadAiQXYbr1b1idwgae7AQxe548yKoUwEmHm2Ri/DdlPN13/2/KgyY/7i2",
+	"YNgCUMXmUhrMcwK/kzh3Ji3CRRFDgY2i7ZELicgu9ksHLuwg95A9tr/cH5Lb8+tnuAyVzwtPRvvbWmNQ",
+	"mq21YSkKpIYpf6koxiRv3Ye+cMpzHncc8+3t9Lwumtnm/QhWYuasVUHGXZO2TeJhQzb/jJZ1nk08PQTl",
+	"rkpJ6rE0/SKR0QcW4zpsZKMweH0UkArmrg9ZKJkSa2D0kjqq+CS0bre0dgAXARDQdVtGaieewBKichjv",
+	"K4SxrZ9yuYMy7K4JZ3vp0Lnb6/DgdP0LeMztOL0nh0AP1QPakT/NXDMFMIffbF+3ilLtREH+F3WdKLbk",
+	"2jiestPBlghWM7G4wZhiMTHSHQlgeu+DVsxZAEvDV5e2cd1oHPQt36wz1iRqsuctGEPi1eeRiwMFVs9E",
+	"njrJqzByNNsM3iPrBoQ795oSov/rjEVgkCSgTRGqtYy4QwtuVg0+YQPXrAjJlA2Dk0ITKmKiZLLFIJZl",
+	"He7QMiaFNJxDw93CljYCwzTqyIdGZAmtSOqabay7lyqJhKHha/JxAx17r5z09ea7bJ2llCf42RL7rSZw",
+	"lYjuOg27I6M6jRMeqUp3IfTNkwR3EkPrv2sCDYLiWyIjmmBXNEQXJZ5t1uWTLfLBcFBD065Ar/6gRiBc",
+	"J4WMqToGDxABRaHGTDuSalgtm+GJGFiuLdHtBJCQGcvO7z/2kLM21EA7V03G8u3fB+SzL2kI6hoF5h4E",
+	"LBDw7Y+3ULkV/i72qUrzfzo+Ojo+mnz3bHx0Mi7/e3r2/HDy8uV5+cOz8/F359/VGhw/ff7y/J+To9PD",
+	"J0+fjb87Ojl88tXutZvdazq7es2M4lHA5zS7Iqn9TpgwygVSU1Le15obZq9fHzbUdkajCo/UZosy7Ca9",
+	"6RRYXJsA1+UyRg58Avhc9SVFw56XbgmtK9sPI5S/qJQMiIAd9QwQpOuoMRV6E8xtE0799Dqlvj5ceWM6",
+	"K6dWKBgU+bWhaVYIziUu9pPjg34Ov7OdvBvY+jtkjV6geEtFAYcNbm/nqCR2t3FtJfd9crNiJFM8pWpN",
+	"3k4uATELlYXkImaKvBvcU/Fu8L8LJ6l+J6yQr9kdUzRxvQC1FjRiRW+nqIJzVNeHOXo3GLp/HcO/gA9p",
+	"SaTYf9dGhHsqdt98J7SLVm0/bn0LDQC1lZK7JdiN0XyYCQBk6SwDkA7DBUl5kvCWUatmHYvlvUgkjX/+",
+	"MMe0nXP/GRg4A8Ihtl1oJIPi/rn/0m1pqw3FdTbRAr9cIORGyFTmmnj732XQPsl1hov2MFBIpE/pxyCE",
+	"X9OPPM3TnSCc0egDM68kZiy7st9IIvtEJHcc023W85DyDD+i22yHA+pEcv2PnKl1l2Cgya/QpMyXnct4",
+	"3cJzzQ3ruo7td2KkH6tQ+7lqpgHvdkm7tcMNhIco99j4l5THm78GHXZv/JVbOOkK07vz3MXMUJ7ob7Y7",
+	"l4oLresY6qcKkh6cBmexO56d4R8KDA+pgBbiZSCDj+308AQLppYp85hireXC2Yf5PGF1w5TOC7Nxc4jB",
+	"+60w6hOk9b6NJSWK4eKPw+kGIm948diSi1KkQKSNFSOMqoQDfZmiHZCLglWwO+YMZ9Z0vtc0Q37TWyZh",
+	"It6yBm+j7lhBbn979BI+QWH4FAG0bknwSygHfN/JJP5wfX0LsQXQ12b3MzPVOsdiIGvqUyDpjouYfQxY",
+	"wkupHJr0u2628+XrTcM3FgT195q06Vw5nQZhIQXmRZsIKdZWIimMzjsZuHA78xS1L9cvOs/3QIgtDaA9",
+	"OW4DOJMsw5guLZPCt43WSh+H3oWJuB4MG4r+Us1Uv0dZ0fUbMaMps3bXS+dfQK5GGy5Z9CDSuVs0+Ket",
+	"JbfwXiALCCd1Vjbwn3EfcS0Xz3uJsfTksNm9QoVaszomRD6p1MluVqsp7KJ9XQN+WHx/uTCYSHfmPhQR",
+	"RohKYMnBRkrhcUo+FLGKgtoh8s8NHiDvwgsdV7GObNA9yEZOeMtR/rF2jdUDoVEXMAILmyPiPdDnMqUc",
+	"UXDObaPCwUxi28wKVspmSLQc3Lv7QDYSpmpBNf2IHYmAeqgs6VutxfXgJH9//4x7Ge0VDp+2BXjAGJ3x",
+	"xWWDQNp4dbOdpHy70t/oEQoVv8rnCY+2hYrbMIouR1uS1IYAMVZrvhSVM7j0vvfnl1zPDO0RbFEFWoBU",
+	"bFcDuB59wMMratn0G5BYrTUH3lo0acWDtMLn0Fg5bvI6m/FRSXZqsUR6SLEMdlE05phCUdQEIK6B1e/L",
+	"ilKbgxj20XQMUf+5l1XuMbFn6TI1PxdpLxs9bRZAClwQGrTCuGoU9Kjo/G35+wUL3rjcQy4+PIe9EWeD",
+	"dQWe/JsU4eWXDT5HQHuRE38r+IK/mZ1vy5kuO5BbwV9y8mZGijzrXSSMoPG3HDVo/gVnltBbTI66KUHU",
+	"17YhAZeBFtOiL8pzvG8hVA+kqyvP7p6gH0IlcrIkXy5Dc4WLVgSCDT+DsgHydK8KL50VKPKtt3z/GjAb",
+	"cneWlQoDSWmGufwXCcXcn7ar/bTTjeNzS8NZ2n+lJFS/x1JwbUKCAXFkims2gaojNJi8mXKfsX7pKqt1",
+	"N+ooK7TRpqsWiVRLKvhvtnUtKQ0tsmKPaMse4Ei2t4BD69mss6BJpGfM5FnHGJ3d4aDfKHfqFx99Cnqv",
+	"E//div48smbPZjac+1LWMtQk4c0SH1spfqNozYaB7e1kWiY7dEgluFoALChc0WYaodfklPBICpJRs9pa",
+	"CafVteNuDV7MsMqdXLKz87eTy1D1vVU+7zLCr/J58wLvrfjbWS9EbJ3zOOveIWh8dj4CscItpX+mRL0Y",
+	"y9Yh/qrREsyYIiJp64HOisbQMZMfuh1p0OBLoU93/HDjsMmIeI0hWduDIb4cgCY6vqditJrrrO60qX7E",
+	"4ofvqOJUIGqRn9R/J3uaiyXELad5Yngw+XULyf5R4YG1JYRM97UmIcmzcQ6FFTFmWSLXVn0sxdHmAq3V",
+	"Dhdkq3FGCbtjCfFtd7lDFlwsrSgkzJY5SL0pggtLJpiiJlh24Hv3vdAzcckb58E/5PM2pNhuBATSrpu2",
+	"JwNGz6vb2QXmSb/NjiJNywoQpa2z7sHgwjx9gt7Vf1W2HOCuM/j9kxHDjhJGjR1Kbu1wkuHYV4yuy9b9",
+	"KXsLK3X7/RMw1ALwW9nqH+/TbVwD/Ty6RZdaraqg8c1GCTg5wUiQNttxu/kc9cLn8y18yeQh52Ahn/gW",
+	"hcHbzd4fKje2+w5YWIozIbYL0rZUHh7Mt24Z0D5bXDPcO1eKS+Wrd20qa+4LkSp2gji0J3uLYoXactNv",
+	"Oukz4GrrvIMKMG1ZvfenTuMOI6lvo8mcgSnf5gCgh71VZPCBpG+pQCFVBZmWFkg0dSVHQ89m+VwwY30C",
+	"Z9Pz66p0Yf/1PTrkxFpxixpj2GFYNzpsbVE0GvayTWwIIP3kGyxTLll32DXrzmlo2iFmhYTKHzZ13MeI",
+	"lJ9AhjzA3lhPOsBrBOTzcPEjTxWIvuy/EBrfwRnoSpTalTv6oTBYOcPMm4VjoGDnjF+sbfxE3YwberOh",
+	"4Nq5X5wNxa6HqWAWqYJ4K0zauoGqdQflXtvfPwO47EAoYdtY2U9b/Scxh5DM1iabzymxBeNs/IeNqbkA",
+	"iB/Ye6m3NokP9QjiDxif/PBbyHAah7s+9mzwnX3O83H4itPD5zgbbKBHnIyl2x9pkgfX2rpzdwAztsjP",
+	"CeRZzTTXQ08vLXmIDK2tV9VH/wSveWhIeL3lsM8LK16ZtH6ZYs0XlZ8Wncp2IoU3p772nhPYU91F2900",
+	"Xd7IH/I5HCHHgjxmNaWEKNfKFp0he0smuyr2lWP7++01Gp/WGL+409z4OqLbKlHaSWyN2FlEEzYR8SU1",
+	"20BOcyNHMLhLk7yc3JBKmA8DfnOaa7y0yaQ9+vSKKGjcg8jcTFMtE2qC0OL2cw3VdxFFm0iw5VkSbPYW",
+	"rpI7h4JBGkFXELKU4nadLykQV8VmdJeSrtta+m7GpmoojEWHboBZ23vwJxDMZ03d/DGiuRviswrnxd36",
+	"VTzvKZ7vDrD/PAEdI6DPKZ04/EB8cPb3Iodgzsw9Y8KzD5uej1v53lIRMvQ1k1jxMHc7fmAMB4leowQY",
+	"a3NPe+WTX0MSc137a1cfXwNnujJ3/cxdOSS8lUkTKi/k2pXFxu7Aysddr08y5mX4LrojlZNQRix0DWfD",
+	"BhbTcDegyNCE667lAIAi8SCzxz4v42pqu/IkXNucQjzh+M1nK6zWnKMe1MV6+DG4Ya+ZS8evqv0gVtHy",
+	"G3EOHYksD+TPcklkj8YpF0OiGI2dpy78EtfX0ha9EC2ETsBPuDY+BXfbec+q1kGqeM2wVGJYZuBe64xi",
+	"Hw78m9CvsdB1/5AJeVT5VDt5SPwKB33bbuGQ7xBYZg1Ab0bu5sJgvytuIK/hsvY0Bh5r54FUvbyFNLHP",
+	"6lhsxxskUDm37mJCW/ka7l0z+Sbfb1+Tb/mWL3ivZorFXe0yJmIuli5vqKuhkYYmXQ3ue8Hini94F0Tt",
+	"937DdC4G9t09TydgMJRcVk8AdRWF3ZpZyoXOavVO0czCrFHJdE8uFkMiRQdT51nAWDK9mnnbCI/1kPBM",
+	"d48y40thFY72PlWeMG3TC3d587Ac0KWN7V3c7CTrdVQzLliZq2dcNew17s4iX0vKQ7O4MrzAt9i54Ek9",
+	"qhvv2WjRa89VMROEc5qP9uVvRID/p3vVG62FUiVe3VNxG6hjAnKjq3HSOQa25HsqXtMlj9rrpd2POnUm",
+	"u+h8Duub938ZucjX6fdiM/vo3t2fImL9hf+29cGaLai0FWN6IATI+SX+d+D1lznbHg8pgRjwJ4jk4ebr",
+	"ax9/wqqHm8d8Gjrldg0UGhl4gUv5riSj60TSGMu6+pRnPY7GYzSJ4o9/ccPXMWu9uNH1zkaZddkC9DLn",
+	"qPCztV4Aj6ToKzf1bJYW99uWVLyqy8bdCgOQFdUrPA8P41OtGsaBl3xtnedAKtwikVJlCRXtzhEVFzEP",
+	"JMlFVPzI2X3vVwptithk4+3URz0ueM/myiBXM4/YNbOvY+H9UhZzOjOK0VRvbzH58XB7ox+Onp7grcy9",
+	"fEvXkzzm8rEP+jlTda4gQRCuBLfLScb/i60nuUHStfxrO5Z6aW5WQM4OlPvkzdzY+hHgGbJZi/s5349k",
+	"ap/o0U7wBzbLYaAVo7E1sHm++s/R5Go6+q/6Qz7UrmPw8OAf0ywSW6nzVvui3oPF/0nYx/2EVmNNEvZB",
+	"M05md1zx+ANHslJdKrPV3/1zvXaVmZJ3PGaa2Je7aWpf2/RlMIiRPq9aFKEYYqGoNiqPgDb234l34m9/",
+	"I5MGWN6JSZIUxec08ZyKUFE8XEQyqjWLyR2n9vosAUEciIphr0FhesVTbrhYvhMjcndY+pz0KTkcD8fj",
+	"cTVRxhRJucgNg7YXVCVr4pJNm70CXeyUPk3Nz/fLwd3hwbe/kBGZGeeK9s/cQXy2YjReVyO7cjEQXzky",
+	"TKVFco0bhlE3DL6oIdG5M9MZ6Uuc2HKZCY+Yvwv9Mb+YnY+OR2cJiAWD4SBXgA3A9/XpwYHMmHAJhftS",
+	"LQ98b33Q6GSNO8a9gYUixKCWdDc43B/vj6EPjE0zPjgdHO+P949tJTezsrQDm+M6G/kqdQf/Blx+gC9L",
+	"hr6d46qT6UZpOxopqbUtx+HKycFDw1UVjtspYCSoq3/XBRbtvxOvi95Of+AJN+tTgPjJyJ2qU/nubKk+",
+	"2/R04/17akjCqDbk6AmBR+U19D4cwT/79z0ek5iutT0z4J2WCuAmBAhUlcks0MqKc6c/bUJm5gq4Mg2S",
+	"m/UXltBRrDLf5hqkh5OUSEUOV+Uqm3LESVpwHZ+S6RHIJ3ZW0oO7y5107NKjXfaXHeBwhaR+PQw3F/6p",
+	"xfCqZR+Nj56Mxk9Hx+Obw+PT45PT8fi/i43Ymn3VTjYq9NX30O+p889cTg/fxEn3JhoF/j59CxUGAdFA",
+	"Lx95UpbX1vUdDd2zl4BO9mjK51GKwqH2qdMY2PysSBU8erKyyF9SmB/X1fp9FgNWHo9j28bTkW+x/07c",
+	"rFyxG0cDJKLC6zPWTW4ZaPNYYbQ6jFwZYQyScZUZ0EbmoydAAs/iwXBwPI4xnH4/HBT6g2VqR+Nxcfd6",
+	"u2OtSNwBKEzwWzVTv+qnlRL70LqevQK0yCtNBhjvk/E4NH654IMXNL52R+a6HG7vcitAlpGK/8Zs0fQn",
+	"R8+3d4IL2d7Hrs9Jn7W5ak40ca/O21Jlru9Rr30Vj7VbwS1PIULCJhSa+vUxGA6MLafxk7WmFfz2PXTC",
+	"r6eDX4siupl/HKzPLWU5wJwCskpRldzfrCa6/05cW26tSbPeaeGb9HcZSWgEgSylrEUruako8bqP3Cp2",
+	"vv73yk1ZDN2ZbYuavo+8IVDSsWt+IeP1F6AaV+/44eFhc1UPvwvRNqsOf6XcT6NcC81daFfHI8hDdwE8",
+	"uodYSW01jaK4GxYirNH3rCoZk6T0Q2HaA2EzoknSIkKYpJ6jOPiCyIjmQu6Cif/j0coaUNGzruGX+x5C",
+	"rYN/87iP2uLqe7PGIxeEzmVu6s+wYGuByIvpeQuTlqyOSC/W07gPR99SHaQShxAeb61tO3L4L4/avxtm",
+	"j59s73QpzUuZi/9Q2agT+3Ygh4MqEnALVQACusa+kGUXIQxrrwbWYr2tBYpp7Svpe52ixqt9zHUX+czK",
+	"ePG/HgFtZPZ/JaMvQkZVJgFGR3eHB3FVtLK3qOJscb6ni4V0kXorqY01CCnWQ0rZCHS0D4Pl9gHCOOUC",
+	"lWHOy3KZnRRTxFDAesj03KorC54YprzFoHpDLkusb80RBaaruzhR3VDVd8jxNmtrZoHLd/AwRCtzZEoC",
+	"nrO4ZsqRixK+rZdhN+03J2C/OXx2Mz46fXJyevJdyH7jDTOfarep8gYsGLyNJlfCGqgz54WvFng4DiwH",
+	"Ws74b80lbTd8lU7trO7prgxfYRc3tojKk/5HsUSP0l91ts8pXAN3qUrrFryv4B4l87P8amctzfZ6hFZm",
+	"PUXffnspDfv221P71lnpnIKxfyleOf7FihK/NB5r/oUsOEtiYLdrKFe2BlnExVAWDxeU5X+lIkU2sQNt",
+	"UXwQs8/AzuzboduY6n8s3X+ph0L/nPyk+Q7sV70bYw0rj+8FY3D432QLn1fDhiHDGjXM31eVzrvyUx6l",
+	"AFT08fzJ0eTpy7OLo6cnRyX2fzd5enRWo4bnh2fPjy6elcTx7Lvx4cXx4enx86PnJ8+Pnx0Ohr87wn9V",
+	"Iz6bGtHA1ACBlM/x7XRv2l5kz3rv3B2qXBnj2u1V3Fs1dPhmy1WLGzv9i3NfTpNtBIh+ZbMYmy2e/St1",
+	"T/v3+4d6XJPlcvWIpp/eA7fQdkEYD7wqg1p83JJy9a2a4SU0K6KbBg/vyxWgCeVp9TJGiUe6Yp4O9RH3",
+	"NTdsW1+34Xbf81oWYbh3Ia62+zeCUURMUim4kcBryV49auebarC6uwLZDGY7qC0vNKrrB0Gx/38AGkEt",
+	"5BaxAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,301 @@
+package sitemanager
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// DefaultHostGroupConcurrency is the default number of hosts HostGroups.Run operates
+// on at a time.
+const DefaultHostGroupConcurrency = 4
+
+// LabelStore persists the labels of hosts for HostGroups. The Site Manager API has no
+// place for them, so they live wherever the caller keeps state: a file, a database, or
+// a MemoryLabelStore. Implementations must be safe for concurrent use.
+type LabelStore interface {
+	// Labels returns the labels of every labeled host, keyed by host ID.
+	Labels(ctx context.Context) (map[string][]string, error)
+
+	// SetLabels replaces the labels of a host. Setting no labels removes the host.
+	SetLabels(ctx context.Context, hostID string, labels []string) error
+}
+
+// MemoryLabelStore is an in-memory LabelStore, useful in tests and short-lived tools.
+type MemoryLabelStore struct {
+	mu     sync.RWMutex
+	labels map[string][]string
+}
+
+// NewMemoryLabelStore creates an empty in-memory label store.
+func NewMemoryLabelStore() *MemoryLabelStore {
+	return &MemoryLabelStore{labels: make(map[string][]string)}
+}
+
+// Labels implements LabelStore.
+func (s *MemoryLabelStore) Labels(context.Context) (map[string][]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	labels := make(map[string][]string, len(s.labels))
+	for hostID, hostLabels := range s.labels {
+		labels[hostID] = slices.Clone(hostLabels)
+	}
+	return labels, nil
+}
+
+// SetLabels implements LabelStore.
+func (s *MemoryLabelStore) SetLabels(_ context.Context, hostID string, labels []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(labels) == 0 {
+		delete(s.labels, hostID)
+		return nil
+	}
+	s.labels[hostID] = slices.Clone(labels)
+	return nil
+}
+
+// HostGroups tags hosts with labels, such as customer names or regions, and selects
+// and operates on the hosts of a label. It is meant for MSPs managing hundreds of
+// consoles through one account.
+//
+// Labels are trimmed, compared ignoring case, and stored in lowercase. Labels of hosts
+// that disappear from the account are kept until removed with Unlabel.
+//
+// Example:
+//
+//	groups := sitemanager.NewHostGroups(client, store)
+//	err := groups.Label(ctx, hostID, "customer-acme", "eu")
+//
+//	result, err := groups.Run(ctx, "customer-acme", func(ctx context.Context, host *sitemanager.Host) error {
+//	    _, err := client.GetHostByID(ctx, host.Id)
+//	    return err
+//	}, nil)
+type HostGroups struct {
+	client SiteManagerAPIClient
+	store  LabelStore
+
+	// mu serializes label updates, which read and rewrite the labels of a host.
+	mu sync.Mutex
+}
+
+// NewHostGroups creates host groups listing hosts through client and keeping labels
+// in store.
+func NewHostGroups(client SiteManagerAPIClient, store LabelStore) *HostGroups {
+	return &HostGroups{client: client, store: store}
+}
+
+// Label adds labels to a host.
+func (g *HostGroups) Label(ctx context.Context, hostID string, labels ...string) error {
+	return g.update(ctx, hostID, func(current []string) []string {
+		return append(current, normalizeLabels(labels)...)
+	})
+}
+
+// Unlabel removes labels from a host, or all its labels if none are given.
+func (g *HostGroups) Unlabel(ctx context.Context, hostID string, labels ...string) error {
+	remove := normalizeLabels(labels)
+	return g.update(ctx, hostID, func(current []string) []string {
+		if len(remove) == 0 {
+			return nil
+		}
+		return slices.DeleteFunc(current, func(label string) bool { return slices.Contains(remove, label) })
+	})
+}
+
+func (g *HostGroups) update(ctx context.Context, hostID string, change func([]string) []string) error {
+	err := validateID("host", hostID, "use the Id of a Host from ListHosts")
+	if err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	all, err := g.store.Labels(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to load host labels")
+	}
+	labels := normalizeLabels(change(slices.Clone(all[hostID])))
+	err = g.store.SetLabels(ctx, hostID, labels)
+	if err != nil {
+		return errors.Wrapf(err, "failed to save labels of host %s", hostID)
+	}
+	return nil
+}
+
+// HostLabels returns the labels of a host, sorted.
+func (g *HostGroups) HostLabels(ctx context.Context, hostID string) ([]string, error) {
+	all, err := g.store.Labels(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load host labels")
+	}
+	return normalizeLabels(all[hostID]), nil
+}
+
+// Groups returns the IDs of the hosts of every label, sorted.
+func (g *HostGroups) Groups(ctx context.Context) (map[string][]string, error) {
+	all, err := g.store.Labels(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load host labels")
+	}
+
+	groups := make(map[string][]string)
+	for _, hostID := range slices.Sorted(maps.Keys(all)) {
+		for _, label := range normalizeLabels(all[hostID]) {
+			groups[label] = append(groups[label], hostID)
+		}
+	}
+	return groups, nil
+}
+
+// Filter returns the hosts carrying all the given labels, in their original order,
+// e.g. to narrow down the results of ListHosts. No labels keep all hosts.
+func (g *HostGroups) Filter(ctx context.Context, hosts []Host, labels ...string) ([]Host, error) {
+	all, err := g.store.Labels(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load host labels")
+	}
+
+	want := normalizeLabels(labels)
+	var matching []Host
+	for i := range hosts {
+		have := normalizeLabels(all[hosts[i].Id])
+		if !slices.ContainsFunc(want, func(label string) bool { return !slices.Contains(have, label) }) {
+			matching = append(matching, hosts[i])
+		}
+	}
+	return matching, nil
+}
+
+// Hosts lists all hosts of the account, fetching every page, and returns those
+// carrying all the given labels.
+func (g *HostGroups) Hosts(ctx context.Context, labels ...string) ([]Host, error) {
+	var hosts []Host
+	for host, err := range tokenPages(func(token *string) ([]Host, *string, error) {
+		resp, err := g.client.ListHosts(ctx, &ListHostsParams{NextToken: token})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, resp.NextToken, nil
+	}) {
+		if err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, host)
+	}
+	return g.Filter(ctx, hosts, labels...)
+}
+
+// HostGroupOptions configures HostGroups.Run.
+type HostGroupOptions struct {
+	// Concurrency limits the number of hosts operated on at a time (defaults to DefaultHostGroupConcurrency)
+	Concurrency int
+}
+
+// HostGroupResult reports the per-host outcome of HostGroups.Run.
+type HostGroupResult struct {
+	// Succeeded lists the IDs of the hosts the operation succeeded on, sorted.
+	Succeeded []string
+
+	// Failed maps host IDs to the error of their operation.
+	Failed map[string]error
+}
+
+// Err returns nil if the operation succeeded on every host, or an error joining all
+// per-host failures. The joined errors keep their causes, so errors.Is matches e.g.
+// unifierr.ErrRateLimited.
+func (r *HostGroupResult) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+
+	errs := make([]error, 0, len(r.Failed))
+	for _, hostID := range slices.Sorted(maps.Keys(r.Failed)) {
+		errs = append(errs, errors.Wrapf(r.Failed[hostID], "host %s", hostID))
+	}
+	return errors.Wrapf(errors.Join(errs...), "%d of %d hosts failed", len(r.Failed), len(r.Failed)+len(r.Succeeded))
+}
+
+// Run calls operation for every host carrying label, with up to opts.Concurrency calls
+// at a time; opts may be nil. A failure on one host does not stop the others: the
+// returned result lists each outcome and the returned error equals result.Err().
+// If the hosts cannot be listed, no operation runs and only an error is returned.
+// Hosts not started before ctx is done are reported as failed with its error.
+//
+// An empty label is an error matching unifierr.ErrValidation rather than a selection
+// of all hosts.
+func (g *HostGroups) Run(
+	ctx context.Context,
+	label string,
+	operation func(ctx context.Context, host *Host) error,
+	opts *HostGroupOptions,
+) (*HostGroupResult, error) {
+	if len(normalizeLabels([]string{label})) == 0 {
+		return nil, errors.Wrap(unifierr.ErrValidation, "host group label is required")
+	}
+	hosts, err := g.Hosts(ctx, label)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := DefaultHostGroupConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	result := &HostGroupResult{Failed: make(map[string]error)}
+	var mu sync.Mutex
+	record := func(hostID string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			result.Failed[hostID] = err
+			return
+		}
+		result.Succeeded = append(result.Succeeded, hostID)
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range hosts {
+		host := &hosts[i]
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			record(host.Id, errors.Wrapf(ctx.Err(), "host %s not processed", host.Id))
+			continue
+		}
+
+		wg.Go(func() {
+			defer func() { <-sem }()
+			record(host.Id, operation(ctx, host))
+		})
+	}
+
+	wg.Wait()
+	slices.Sort(result.Succeeded)
+
+	return result, result.Err()
+}
+
+// normalizeLabels trims and lowercases labels, drops empty ones and duplicates, and
+// sorts them.
+func normalizeLabels(labels []string) []string {
+	normalized := make([]string, 0, len(labels))
+	for _, label := range labels {
+		if label = strings.ToLower(strings.TrimSpace(label)); label != "" {
+			normalized = append(normalized, label)
+		}
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
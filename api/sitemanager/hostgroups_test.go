@@ -0,0 +1,138 @@
+package sitemanager
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// hostGroupsFixture serves three hosts over two pages and labels two of them.
+func hostGroupsFixture(t *testing.T) (*HostGroups, *UnifiClient) {
+	t.Helper()
+
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("nextToken") == "" {
+			_, _ = w.Write([]byte(`{"httpStatusCode": 200, "traceId": "t", "nextToken": "page-2",
+				"data": [{"id": "host-a", "type": "console"}, {"id": "host-b", "type": "console"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"httpStatusCode": 200, "traceId": "t",
+			"data": [{"id": "host-c", "type": "network-server"}]}`))
+	})
+	t.Cleanup(server.Close)
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	groups := NewHostGroups(client, NewMemoryLabelStore())
+	ctx := context.Background()
+	require.NoError(t, groups.Label(ctx, "host-a", "Customer-Acme", " eu "))
+	require.NoError(t, groups.Label(ctx, "host-c", "customer-acme", "us", "customer-acme"))
+	return groups, client
+}
+
+func hostIDs(hosts []Host) []string {
+	ids := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		ids = append(ids, host.Id)
+	}
+	return ids
+}
+
+func TestHostGroupsLabels(t *testing.T) {
+	t.Parallel()
+
+	groups, _ := hostGroupsFixture(t)
+	ctx := context.Background()
+
+	labels, err := groups.HostLabels(ctx, "host-a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"customer-acme", "eu"}, labels, "labels are normalized")
+
+	all, err := groups.Groups(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"customer-acme": {"host-a", "host-c"},
+		"eu":            {"host-a"},
+		"us":            {"host-c"},
+	}, all)
+
+	require.NoError(t, groups.Unlabel(ctx, "host-a", "EU"))
+	labels, err = groups.HostLabels(ctx, "host-a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"customer-acme"}, labels)
+
+	require.NoError(t, groups.Unlabel(ctx, "host-c"))
+	labels, err = groups.HostLabels(ctx, "host-c")
+	require.NoError(t, err)
+	assert.Empty(t, labels, "no labels remove all")
+
+	err = groups.Label(ctx, "", "eu")
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+}
+
+func TestHostGroupsHosts(t *testing.T) {
+	t.Parallel()
+
+	groups, client := hostGroupsFixture(t)
+	ctx := context.Background()
+
+	hosts, err := groups.Hosts(ctx, "customer-acme")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host-a", "host-c"}, hostIDs(hosts), "all pages are listed")
+
+	hosts, err = groups.Hosts(ctx, "customer-acme", "us")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host-c"}, hostIDs(hosts))
+
+	resp, err := client.ListHosts(ctx, nil)
+	require.NoError(t, err)
+	hosts, err = groups.Filter(ctx, resp.Data, "eu")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"host-a"}, hostIDs(hosts))
+
+	hosts, err = groups.Filter(ctx, resp.Data)
+	require.NoError(t, err)
+	assert.Len(t, hosts, 2, "no labels keep all hosts")
+}
+
+func TestHostGroupsRun(t *testing.T) {
+	t.Parallel()
+
+	groups, _ := hostGroupsFixture(t)
+	ctx := context.Background()
+
+	var inFlight, peak atomic.Int32
+	result, err := groups.Run(ctx, "customer-acme", func(_ context.Context, host *Host) error {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		if host.Id == "host-c" {
+			return errors.Wrap(unifierr.ErrRateLimited, "too many requests")
+		}
+		return nil
+	}, &HostGroupOptions{Concurrency: 1})
+
+	require.ErrorIs(t, err, unifierr.ErrRateLimited)
+	assert.Contains(t, err.Error(), "1 of 2 hosts failed")
+	assert.Equal(t, []string{"host-a"}, result.Succeeded)
+	assert.Contains(t, result.Failed, "host-c")
+	assert.Equal(t, int32(1), peak.Load(), "concurrency is bounded")
+
+	_, err = groups.Run(ctx, " ", func(context.Context, *Host) error { return nil }, nil)
+	require.ErrorIs(t, err, unifierr.ErrValidation, "an empty label does not select all hosts")
+}
@@ -0,0 +1,22 @@
+package sitemanager
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/ids"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// validateID rejects IDs that are empty or MAC addresses, the most common mistake,
+// with an error matching unifierr.ErrValidation instead of sending a request the API
+// rejects with an opaque error. hint tells how to find the right ID.
+func validateID(kind, id, hint string) error {
+	switch {
+	case id == "":
+		return errors.Wrapf(unifierr.ErrValidation, "%s ID is required", kind)
+	case ids.IsMAC(id):
+		return errors.Wrapf(unifierr.ErrValidation, "%s ID must be the ID reported by the API, got %s: %s",
+			kind, ids.Describe(id), hint)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package sitemanager
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestIDValidation(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.GetHostByID(ctx, "70:a7:41:97:83:ed")
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+	assert.Contains(t, err.Error(), `host ID must be the ID reported by the API, got MAC address "70:a7:41:97:83:ed": look the host up in ListHosts`)
+
+	_, err = client.GetSDWANConfigStatus(ctx, "")
+	require.ErrorIs(t, err, unifierr.ErrValidation)
+
+	assert.Zero(t, requests.Load(), "invalid IDs must not reach the API")
+}
@@ -31,7 +31,7 @@ import "context"
 //	}
 //
 //nolint:revive // SiteManagerAPIClient is intentionally explicit to avoid confusion with UnifiClient struct
-type SiteManagerAPIClient interface {
+type SiteManagerAPIClient interface { //nolint:interfacebloat // This interface mirrors the full API client with 9 methods
 	// Hosts operations
 
 	// ListHosts retrieves a list of all hosts across all sites.
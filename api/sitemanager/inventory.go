@@ -0,0 +1,165 @@
+package sitemanager
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// DeviceField names a device attribute compared by DiffDeviceInventories.
+type DeviceField string
+
+// Device attributes compared by DiffDeviceInventories, in the order they are reported.
+const (
+	// DeviceFieldStatus is the device status, e.g. online or offline.
+	DeviceFieldStatus DeviceField = "status"
+
+	// DeviceFieldVersion is the firmware version.
+	DeviceFieldVersion DeviceField = "version"
+
+	// DeviceFieldFirmwareStatus is the firmware status, e.g. upToDate or updateAvailable.
+	DeviceFieldFirmwareStatus DeviceField = "firmwareStatus"
+
+	// DeviceFieldStartupTime is the last startup time; a change means the device restarted.
+	DeviceFieldStartupTime DeviceField = "startupTime"
+
+	// DeviceFieldName is the device name.
+	DeviceFieldName DeviceField = "name"
+
+	// DeviceFieldIP is the device IP address.
+	DeviceFieldIP DeviceField = "ip"
+
+	// DeviceFieldHost is the host managing the device.
+	DeviceFieldHost DeviceField = "host"
+)
+
+// InventoryDevice is a device of an inventory snapshot together with its host.
+type InventoryDevice struct {
+	HostID   string
+	HostName string
+	Device   DeviceItem
+}
+
+// DeviceChange is a device present in both snapshots whose compared attributes differ.
+type DeviceChange struct {
+	Old InventoryDevice
+	New InventoryDevice
+
+	// Fields lists the attributes that changed, in the order of the DeviceField constants.
+	Fields []DeviceField
+}
+
+// Has reports whether field changed.
+func (c *DeviceChange) Has(field DeviceField) bool {
+	return slices.Contains(c.Fields, field)
+}
+
+// DeviceInventoryDiff is the difference between two device inventory snapshots.
+// Each list is sorted by host ID and device ID.
+type DeviceInventoryDiff struct {
+	Added   []InventoryDevice
+	Removed []InventoryDevice
+	Changed []DeviceChange
+}
+
+// IsEmpty reports whether the snapshots hold the same devices with the same attributes.
+func (d *DeviceInventoryDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffDeviceInventories compares two ListDevices snapshots and returns the devices
+// that were added, removed, or changed status, firmware, startup time, name, IP or host.
+//
+// Devices are matched by ID, or by MAC address if they have no ID, ignoring case;
+// devices with neither are skipped. A nil snapshot counts as empty. To diff inventories
+// spanning several pages, collect AllDevices into the Data of a DevicesResponse:
+//
+//	devices, err := seq.Collect(client.AllDevices(ctx, nil))
+//	current := &sitemanager.DevicesResponse{Data: devices}
+//	diff := sitemanager.DiffDeviceInventories(previous, current)
+//	for _, change := range diff.Changed {
+//	    if change.Has(sitemanager.DeviceFieldVersion) {
+//	        log.Printf("%s upgraded to %s", *change.New.Device.Name, *change.New.Device.Version)
+//	    }
+//	}
+func DiffDeviceInventories(before, after *DevicesResponse) *DeviceInventoryDiff {
+	oldDevices := inventoryByKey(before)
+	newDevices := inventoryByKey(after)
+	diff := &DeviceInventoryDiff{}
+
+	for key, current := range newDevices {
+		previous, ok := oldDevices[key]
+		if !ok {
+			diff.Added = append(diff.Added, current)
+			continue
+		}
+		if fields := changedDeviceFields(&previous, &current); len(fields) > 0 {
+			diff.Changed = append(diff.Changed, DeviceChange{Old: previous, New: current, Fields: fields})
+		}
+	}
+	for key, previous := range oldDevices {
+		if _, ok := newDevices[key]; !ok {
+			diff.Removed = append(diff.Removed, previous)
+		}
+	}
+
+	slices.SortFunc(diff.Added, compareInventoryDevices)
+	slices.SortFunc(diff.Removed, compareInventoryDevices)
+	slices.SortFunc(diff.Changed, func(a, b DeviceChange) int {
+		return compareInventoryDevices(a.New, b.New)
+	})
+	return diff
+}
+
+func inventoryByKey(snapshot *DevicesResponse) map[string]InventoryDevice {
+	devices := make(map[string]InventoryDevice)
+	if snapshot == nil {
+		return devices
+	}
+	for i := range snapshot.Data {
+		host := &snapshot.Data[i]
+		if host.Devices == nil {
+			continue
+		}
+		for _, device := range *host.Devices {
+			key := deviceKey(&device)
+			if key == "" {
+				continue
+			}
+			devices[key] = InventoryDevice{
+				HostID:   valueOrZero(host.HostId),
+				HostName: valueOrZero(host.HostName),
+				Device:   device,
+			}
+		}
+	}
+	return devices
+}
+
+func deviceKey(device *DeviceItem) string {
+	return strings.ToLower(cmp.Or(valueOrZero(device.Id), valueOrZero(device.Mac)))
+}
+
+func changedDeviceFields(before, after *InventoryDevice) []DeviceField {
+	var fields []DeviceField
+	diff := func(field DeviceField, a, b *string) {
+		if valueOrZero(a) != valueOrZero(b) {
+			fields = append(fields, field)
+		}
+	}
+
+	diff(DeviceFieldStatus, before.Device.Status, after.Device.Status)
+	diff(DeviceFieldVersion, before.Device.Version, after.Device.Version)
+	diff(DeviceFieldFirmwareStatus, before.Device.FirmwareStatus, after.Device.FirmwareStatus)
+	if !valueOrZero(before.Device.StartupTime).Equal(valueOrZero(after.Device.StartupTime)) {
+		fields = append(fields, DeviceFieldStartupTime)
+	}
+	diff(DeviceFieldName, before.Device.Name, after.Device.Name)
+	diff(DeviceFieldIP, before.Device.Ip, after.Device.Ip)
+	diff(DeviceFieldHost, &before.HostID, &after.HostID)
+	return fields
+}
+
+func compareInventoryDevices(a, b InventoryDevice) int {
+	return cmp.Or(cmp.Compare(a.HostID, b.HostID), cmp.Compare(deviceKey(&a.Device), deviceKey(&b.Device)))
+}
@@ -0,0 +1,107 @@
+package sitemanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+)
+
+func loadInventory(t *testing.T) *DevicesResponse {
+	t.Helper()
+	var inventory DevicesResponse
+	testdata.LoadFixtureJSON(t, "devices/list_success.json", &inventory)
+	require.Len(t, inventory.Data, 1)
+	require.Len(t, *inventory.Data[0].Devices, 2)
+	return &inventory
+}
+
+func TestDiffDeviceInventoriesUnchanged(t *testing.T) {
+	t.Parallel()
+
+	diff := DiffDeviceInventories(loadInventory(t), loadInventory(t))
+	assert.True(t, diff.IsEmpty())
+}
+
+func TestDiffDeviceInventories(t *testing.T) {
+	t.Parallel()
+
+	before := loadInventory(t)
+	after := loadInventory(t)
+
+	devices := *after.Data[0].Devices
+	// The switch was upgraded, which restarted it.
+	version, firmwareStatus, startup := "2.1.7", "updateAvailable", time.Date(2025, 6, 18, 3, 0, 0, 0, time.UTC)
+	devices[0].Version = &version
+	devices[0].FirmwareStatus = &firmwareStatus
+	devices[0].StartupTime = &startup
+	// The console went offline; its ID is reported in lowercase this time.
+	status, id := "offline", "112233445566"
+	devices[1].Status = &status
+	devices[1].Id = &id
+	// A new access point was adopted.
+	apID, apName := "A1B2C3D4E5F6", "U7 Pro"
+	devices = append(devices, DeviceItem{Id: &apID, Name: &apName})
+	after.Data[0].Devices = &devices
+
+	// A device without identifiers is ignored.
+	anonymous := append(*before.Data[0].Devices, DeviceItem{Name: &apName})
+	before.Data[0].Devices = &anonymous
+
+	diff := DiffDeviceInventories(before, after)
+	require.False(t, diff.IsEmpty())
+
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "U7 Pro", *diff.Added[0].Device.Name)
+	assert.Equal(t, "unifi.example.com", diff.Added[0].HostName)
+	assert.Empty(t, diff.Removed)
+
+	require.Len(t, diff.Changed, 2)
+	console, sw := diff.Changed[0], diff.Changed[1]
+	assert.Equal(t, []DeviceField{DeviceFieldStatus}, console.Fields)
+	assert.Equal(t, "online", *console.Old.Device.Status)
+	assert.Equal(t, "offline", *console.New.Device.Status)
+	assert.Equal(t, []DeviceField{DeviceFieldVersion, DeviceFieldFirmwareStatus, DeviceFieldStartupTime}, sw.Fields)
+	assert.True(t, sw.Has(DeviceFieldVersion))
+	assert.False(t, sw.Has(DeviceFieldStatus))
+	assert.Equal(t, "2.1.6", *sw.Old.Device.Version)
+	assert.Equal(t, "2.1.7", *sw.New.Device.Version)
+}
+
+func TestDiffDeviceInventoriesMovedAndRemoved(t *testing.T) {
+	t.Parallel()
+
+	before := loadInventory(t)
+	after := loadInventory(t)
+
+	devices := *after.Data[0].Devices
+	hostID, hostName := "second-host", "branch"
+	after.Data = append(after.Data, Device{HostId: &hostID, HostName: &hostName, Devices: &[]DeviceItem{devices[0]}})
+	after.Data[0].Devices = &[]DeviceItem{}
+
+	diff := DiffDeviceInventories(before, after)
+	assert.Empty(t, diff.Added)
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, "UniFi Console", *diff.Removed[0].Device.Name)
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, []DeviceField{DeviceFieldHost}, diff.Changed[0].Fields)
+	assert.Equal(t, "second-host", diff.Changed[0].New.HostID)
+	assert.Equal(t, "branch", diff.Changed[0].New.HostName)
+}
+
+func TestDiffDeviceInventoriesNil(t *testing.T) {
+	t.Parallel()
+
+	diff := DiffDeviceInventories(nil, loadInventory(t))
+	assert.Len(t, diff.Added, 2)
+	assert.Empty(t, diff.Removed)
+
+	diff = DiffDeviceInventories(loadInventory(t), nil)
+	assert.Len(t, diff.Removed, 2)
+	assert.Empty(t, diff.Added)
+
+	assert.True(t, DiffDeviceInventories(nil, nil).IsEmpty())
+}
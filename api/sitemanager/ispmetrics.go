@@ -0,0 +1,181 @@
+package sitemanager
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WANID identifies a WAN interface in ISP metrics, e.g. "wan" or "wan2".
+type WANID string
+
+// Well-known WAN identifiers of dual-WAN consoles.
+const (
+	// WANPrimary is the primary WAN interface.
+	WANPrimary WANID = "wan"
+	// WANSecondary is the secondary (failover or load-balanced) WAN interface.
+	WANSecondary WANID = "wan2"
+)
+
+// Index returns the 1-based position of the WAN interface: 1 for "wan", 2 for "wan2".
+// It returns 0 for identifiers that do not follow this scheme.
+func (id WANID) Index() int {
+	suffix, ok := strings.CutPrefix(string(id), string(WANPrimary))
+	if !ok {
+		return 0
+	}
+	if suffix == "" {
+		return 1
+	}
+	n, err := strconv.Atoi(suffix)
+	if err != nil || n < 2 {
+		return 0
+	}
+	return n
+}
+
+// WANs returns the metrics of every WAN interface reported in the period, keyed by WAN.
+func (d *ISPMetricPeriodData) WANs() map[WANID]ISPMetricWanData {
+	wans := make(map[WANID]ISPMetricWanData, len(d.AdditionalProperties)+1)
+	if d.Wan != nil {
+		wans[WANPrimary] = *d.Wan
+	}
+	for name, data := range d.AdditionalProperties {
+		wans[WANID(name)] = data
+	}
+	return wans
+}
+
+// WANMetric is the metric of one WAN interface for one period.
+type WANMetric struct {
+	HostID string
+	SiteID string
+	WAN    WANID
+	Time   time.Time
+	Data   ISPMetricWanData
+}
+
+// GroupByWAN splits ISP metrics into one series per WAN interface, each ordered by time.
+// Metrics of all hosts and sites in items are merged; filter items first to compare
+// the WAN links of a single site.
+//
+// Example:
+//
+//	resp, err := client.GetISPMetrics(ctx, sitemanager.N5m, nil)
+//	for wan, series := range sitemanager.GroupByWAN(resp.Data) {
+//	    summary := sitemanager.SummarizeWAN(series)
+//	    fmt.Printf("%s (%s): %.1f ms, %.2f%% loss\n", wan, summary.ISPName, summary.AvgLatency, summary.AvgPacketLoss)
+//	}
+func GroupByWAN(items []ISPMetricItem) map[WANID][]WANMetric {
+	groups := make(map[WANID][]WANMetric)
+	for item, period := range ISPMetricPeriods(items) {
+		if period.Data == nil {
+			continue
+		}
+		for wan, data := range period.Data.WANs() {
+			metric := WANMetric{
+				HostID: valueOrZero(item.HostId),
+				SiteID: valueOrZero(item.SiteId),
+				WAN:    wan,
+				Data:   data,
+			}
+			if period.MetricTime != nil {
+				metric.Time = *period.MetricTime
+			}
+			groups[wan] = append(groups[wan], metric)
+		}
+	}
+
+	for _, series := range groups {
+		slices.SortStableFunc(series, func(a, b WANMetric) int {
+			return a.Time.Compare(b.Time)
+		})
+	}
+	return groups
+}
+
+// WANSummary aggregates the link quality of one WAN interface over a series of periods.
+type WANSummary struct {
+	// ISPName and ISPASN are taken from the most recent period reporting them.
+	ISPName string
+	ISPASN  string
+
+	// Periods is the number of periods summarized.
+	Periods int
+
+	// AvgLatency is the mean of the per-period average latencies, in milliseconds.
+	AvgLatency float64
+	// MaxLatency is the highest latency of any period, in milliseconds.
+	MaxLatency int
+
+	// AvgPacketLoss is the mean per-period packet loss, in percent.
+	AvgPacketLoss float64
+
+	// AvgDownloadKbps and AvgUploadKbps are the mean per-period throughputs.
+	AvgDownloadKbps float64
+	AvgUploadKbps   float64
+
+	// Downtime and Uptime are the summed durations, in seconds.
+	Downtime int
+	Uptime   int
+}
+
+// SummarizeWAN aggregates a series produced by GroupByWAN. Averages only include
+// periods that report the respective value.
+func SummarizeWAN(series []WANMetric) WANSummary {
+	summary := WANSummary{Periods: len(series)}
+	var latency, loss, download, upload mean
+	for _, metric := range series {
+		data := metric.Data
+		if data.IspName != nil {
+			summary.ISPName = *data.IspName
+		}
+		if data.IspAsn != nil {
+			summary.ISPASN = *data.IspAsn
+		}
+		if data.MaxLatency != nil {
+			summary.MaxLatency = max(summary.MaxLatency, *data.MaxLatency)
+		}
+		summary.Downtime += valueOrZero(data.Downtime)
+		summary.Uptime += valueOrZero(data.Uptime)
+		latency.add(data.AvgLatency)
+		loss.add(data.PacketLoss)
+		download.add(data.DownloadKbps)
+		upload.add(data.UploadKbps)
+	}
+
+	summary.AvgLatency = latency.value()
+	summary.AvgPacketLoss = loss.value()
+	summary.AvgDownloadKbps = download.value()
+	summary.AvgUploadKbps = upload.value()
+	return summary
+}
+
+// mean accumulates optional integer samples.
+type mean struct {
+	sum   int
+	count int
+}
+
+func (m *mean) add(v *int) {
+	if v != nil {
+		m.sum += *v
+		m.count++
+	}
+}
+
+func (m *mean) value() float64 {
+	if m.count == 0 {
+		return 0
+	}
+	return float64(m.sum) / float64(m.count)
+}
+
+func valueOrZero[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,99 @@
+package sitemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestWANIDIndex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id   WANID
+		want int
+	}{
+		{id: WANPrimary, want: 1},
+		{id: WANSecondary, want: 2},
+		{id: "wan3", want: 3},
+		{id: "wan1", want: 0},
+		{id: "wanx", want: 0},
+		{id: "lte", want: 0},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.id.Index(), string(tt.id))
+	}
+}
+
+func TestISPMetricPeriodDataWANs(t *testing.T) {
+	t.Parallel()
+
+	var data ISPMetricPeriodData
+	require.NoError(t, json.Unmarshal([]byte(`{"wan":{"avgLatency":10},"wan2":{"avgLatency":40}}`), &data))
+
+	wans := data.WANs()
+	require.Len(t, wans, 2)
+	assert.Equal(t, 10, *wans[WANPrimary].AvgLatency)
+	assert.Equal(t, 40, *wans[WANSecondary].AvgLatency)
+
+	assert.Empty(t, (&ISPMetricPeriodData{}).WANs())
+}
+
+func TestGroupByWAN(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/ea/isp-metrics/5m", testAPIKey,
+		testdata.LoadFixture(t, "metrics/get_isp_metrics_dual_wan.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	resp, err := client.GetISPMetrics(context.Background(), N5m, nil)
+	require.NoError(t, err)
+
+	groups := GroupByWAN(resp.Data)
+	require.Len(t, groups, 2)
+
+	primary := groups[WANPrimary]
+	require.Len(t, primary, 2)
+	assert.Equal(t, testHostID, primary[0].HostID)
+	assert.Equal(t, "661900ae6aec8f548d49fd54", primary[0].SiteID)
+	assert.Equal(t, time.Date(2024, 11, 10, 18, 55, 0, 0, time.UTC), primary[0].Time, "series should be ordered by time")
+	assert.True(t, primary[0].Time.Before(primary[1].Time))
+
+	secondary := SummarizeWAN(groups[WANSecondary])
+	assert.Equal(t, WANSummary{
+		ISPName:         "LTE ISP",
+		ISPASN:          "67890",
+		Periods:         2,
+		AvgLatency:      43,
+		MaxLatency:      120,
+		AvgPacketLoss:   3,
+		AvgDownloadKbps: 100000,
+		AvgUploadKbps:   25000,
+		Downtime:        30,
+		Uptime:          570,
+	}, secondary)
+
+	assert.Less(t, SummarizeWAN(primary).AvgLatency, secondary.AvgLatency)
+}
+
+func TestGroupByWANSkipsEmptyPeriods(t *testing.T) {
+	t.Parallel()
+
+	periods := []ISPMetricPeriod{{}, {Data: &ISPMetricPeriodData{}}}
+	groups := GroupByWAN([]ISPMetricItem{{}, {Periods: &periods}})
+	assert.Empty(t, groups)
+
+	assert.Equal(t, WANSummary{}, SummarizeWAN(nil))
+}
@@ -0,0 +1,79 @@
+package sitemanager
+
+import (
+	"context"
+	"iter"
+)
+
+// tokenPages iterates over the items of a nextToken paginated endpoint. fetch
+// returns one page and the token of the next page, nil after the last page.
+// Pages are requested lazily; a failed request ends the sequence with the error.
+func tokenPages[T any](fetch func(token *string) (items []T, next *string, err error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var token *string
+		for {
+			items, next, err := fetch(token)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if next == nil || *next == "" || len(items) == 0 {
+				return
+			}
+			token = next
+		}
+	}
+}
+
+// AllHosts iterates over all hosts, fetching pages as needed.
+// See package github.com/lexfrei/go-unifi/seq for slice and channel adapters.
+func (c *UnifiClient) AllHosts(ctx context.Context) iter.Seq2[Host, error] {
+	return tokenPages(func(token *string) ([]Host, *string, error) {
+		resp, err := c.ListHosts(ctx, &ListHostsParams{NextToken: token})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, resp.NextToken, nil
+	})
+}
+
+// AllDevices iterates over the devices of all hosts matching params, fetching pages as
+// needed. The NextToken of params is ignored; params may be nil.
+func (c *UnifiClient) AllDevices(ctx context.Context, params *ListDevicesParams) iter.Seq2[Device, error] {
+	var query ListDevicesParams
+	if params != nil {
+		query = *params
+	}
+	return tokenPages(func(token *string) ([]Device, *string, error) {
+		query.NextToken = token
+		resp, err := c.ListDevices(ctx, &query)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.Data, resp.NextToken, nil
+	})
+}
+
+// ISPMetricPeriods iterates over the periods of all items, paired with the item
+// (host and site) they belong to. Items without periods are skipped.
+func ISPMetricPeriods(items []ISPMetricItem) iter.Seq2[*ISPMetricItem, ISPMetricPeriod] {
+	return func(yield func(*ISPMetricItem, ISPMetricPeriod) bool) {
+		for i := range items {
+			if items[i].Periods == nil {
+				continue
+			}
+			for _, period := range *items[i].Periods {
+				if !yield(&items[i], period) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package sitemanager
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+	"github.com/lexfrei/go-unifi/seq"
+)
+
+// newPagedHostsServer serves the ucore fixture as the first page (with testNextToken)
+// and the console fixture as the last page.
+func newPagedHostsServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	firstPage := testdata.LoadFixture(t, "hosts/list_success_ucore.json")
+	lastPage := testdata.LoadFixture(t, "hosts/list_success_console.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("nextToken") {
+		case "":
+			w.Write([]byte(firstPage))
+		case testNextToken:
+			w.Write([]byte(lastPage))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAllHosts(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := newPagedHostsServer(t, &requests)
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	hosts, err := seq.Collect(client.AllHosts(context.Background()))
+	require.NoError(t, err)
+	assert.Len(t, hosts, 2)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestAllHosts_StopsEarly(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := newPagedHostsServer(t, &requests)
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	for host, err := range client.AllHosts(context.Background()) {
+		require.NoError(t, err)
+		assert.NotEmpty(t, host.Id)
+		break
+	}
+	assert.Equal(t, int32(1), requests.Load(), "second page must not be fetched")
+}
+
+func TestAllHosts_Error(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	hosts, err := seq.Collect(client.AllHosts(context.Background()))
+	require.Error(t, err)
+	assert.Empty(t, hosts)
+}
+
+func TestISPMetricPeriods(t *testing.T) {
+	t.Parallel()
+
+	var resp ISPMetricsResponse
+	testdata.LoadFixtureJSON(t, "metrics/get_isp_metrics_dual_wan.json", &resp)
+	require.NotEmpty(t, resp.Data)
+
+	var want int
+	for _, item := range resp.Data {
+		if item.Periods != nil {
+			want += len(*item.Periods)
+		}
+	}
+
+	var got int
+	for item, period := range ISPMetricPeriods(resp.Data) {
+		require.NotNil(t, item)
+		assert.NotNil(t, period.MetricTime)
+		got++
+	}
+	assert.Equal(t, want, got)
+	assert.Positive(t, got)
+}
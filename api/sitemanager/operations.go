@@ -0,0 +1,16 @@
+package sitemanager
+
+import "reflect"
+
+// Operations returns the sorted names of the operations the client attributes its
+// requests to, such as "ListHosts". Each is the name of the client method making the
+// request. Metrics recorders implementing observability.OperationMetricsRecorder
+// receive these names and can use the list to pre-register label values.
+func Operations() []string {
+	api := reflect.TypeFor[SiteManagerAPIClient]()
+	names := make([]string, api.NumMethod())
+	for i := range names {
+		names[i] = api.Method(i).Name
+	}
+	return names
+}
@@ -0,0 +1,63 @@
+package sitemanager
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+func TestOperationsAnnotated(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, Operations(), testutil.AnnotatedOperations(t, "."),
+		"every operation must be annotated exactly once, by the method of the same name")
+}
+
+// operationRecorder records operation metrics on top of the noop recorder.
+type operationRecorder struct {
+	observability.MetricsRecorder
+
+	mu         sync.Mutex
+	operations []string
+	statuses   []int
+}
+
+func (r *operationRecorder) RecordOperation(operation string, statusCode int, _ time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.operations = append(r.operations, operation)
+	r.statuses = append(r.statuses, statusCode)
+}
+
+func TestOperationMetrics(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/v1/hosts", testAPIKey,
+		testdata.LoadFixture(t, "hosts/list_success_ucore.json"), http.StatusOK)
+	defer server.Close()
+
+	recorder := &operationRecorder{MetricsRecorder: observability.NoopMetricsRecorder()}
+	client, err := NewWithConfig(&ClientConfig{
+		APIKey:  testAPIKey,
+		BaseURL: server.URL,
+		Metrics: recorder,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ListHosts(context.Background(), nil)
+	require.NoError(t, err)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	assert.Equal(t, []string{"ListHosts"}, recorder.operations)
+	assert.Equal(t, []int{http.StatusOK}, recorder.statuses)
+}
@@ -0,0 +1,53 @@
+package sitemanager
+
+import (
+	"encoding/json"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+// retainRawJSON populates the RawJSON field of decoded models from the response body.
+// It is installed as the decoder hook when ClientConfig.RetainRawJSON is enabled.
+//
+//nolint:cyclop // Flat type switch over all response types with raw JSON support
+func retainRawJSON(data any, body []byte) error {
+	switch v := data.(type) {
+	case *HostsResponse:
+		return retainDataItems(body, v.Data, func(item *Host, raw json.RawMessage) { item.RawJSON = raw })
+	case *SitesResponse:
+		return retainDataItems(body, v.Data, func(item *Site, raw json.RawMessage) { item.RawJSON = raw })
+	case *DevicesResponse:
+		return retainDataItems(body, v.Data, func(item *Device, raw json.RawMessage) { item.RawJSON = raw })
+	case *ISPMetricsResponse:
+		return retainDataItems(body, v.Data, func(item *ISPMetricItem, raw json.RawMessage) { item.RawJSON = raw })
+	case *SDWANConfigsResponse:
+		return retainDataItems(body, v.Data, func(item *SDWANConfig, raw json.RawMessage) { item.RawJSON = raw })
+	case *HostResponse:
+		return retainData(body, &v.Data.RawJSON)
+	case *SDWANConfigResponse:
+		return retainData(body, &v.Data.RawJSON)
+	case *SDWANConfigStatusResponse:
+		return retainData(body, &v.Data.RawJSON)
+	}
+	return nil
+}
+
+func retainData(body []byte, target *json.RawMessage) error {
+	raw, err := response.RawData(body)
+	if err != nil {
+		//nolint:wrapcheck // response.RawData returns wrapped errors
+		return err
+	}
+	*target = raw
+	return nil
+}
+
+func retainDataItems[T any](body []byte, items []T, set func(*T, json.RawMessage)) error {
+	raws, err := response.RawDataArray(body)
+	if err != nil {
+		//nolint:wrapcheck // response.RawDataArray returns wrapped errors
+		return err
+	}
+	//nolint:wrapcheck // response.AssignRaw returns descriptive errors
+	return response.AssignRaw(raws, items, set)
+}
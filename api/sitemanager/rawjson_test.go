@@ -0,0 +1,64 @@
+package sitemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestRetainRawJSON(t *testing.T) {
+	t.Parallel()
+
+	fixture := testdata.LoadFixture(t, "hosts/list_success_console.json")
+	server := testutil.NewMockServer(t, "/v1/hosts", testAPIKey, fixture, http.StatusOK)
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		APIKey:        testAPIKey,
+		BaseURL:       server.URL,
+		RetainRawJSON: true,
+	})
+	require.NoError(t, err)
+
+	resp, err := client.ListHosts(context.Background(), nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Data)
+
+	var envelope struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(fixture), &envelope))
+
+	for i, host := range resp.Data {
+		assert.JSONEq(t, string(envelope.Data[i]), string(host.RawJSON))
+	}
+}
+
+func TestRetainRawJSONSingle(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/v1/hosts/"+testHostID, testAPIKey,
+		testdata.LoadFixture(t, "hosts/get_ucore.json"), http.StatusOK)
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{
+		APIKey:        testAPIKey,
+		BaseURL:       server.URL,
+		RetainRawJSON: true,
+	})
+	require.NoError(t, err)
+
+	resp, err := client.GetHostByID(context.Background(), testHostID)
+	require.NoError(t, err)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(resp.Data.RawJSON, &raw))
+	assert.Equal(t, "e5bf13cd-98a7-5a96-9463-0d65d78cd3a4", raw["hardwareId"])
+}
@@ -0,0 +1,216 @@
+package sitemanager
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/backoff"
+)
+
+// SD-WAN convergence errors.
+var (
+	// ErrSDWANFailed is returned by WaitForSDWANConvergence when the configuration,
+	// or one of its hubs or spokes, reports errors.
+	ErrSDWANFailed = errors.New("SD-WAN configuration failed")
+
+	// ErrSDWANNotConverged is returned by WaitForSDWANConvergence when the configuration
+	// is still being applied when the wait ends.
+	ErrSDWANNotConverged = errors.New("SD-WAN configuration did not converge")
+)
+
+// Apply and tunnel states reported by SD-WAN hubs and spokes.
+const (
+	SDWANApplyStatusOK   = "OK"
+	SDWANTunnelConnected = "connected"
+)
+
+// Roles of SDWANIssue.
+const (
+	sdwanRoleConfig = "config"
+	sdwanRoleHub    = "hub"
+	sdwanRoleSpoke  = "spoke"
+)
+
+// sdwanPoll paces WaitForSDWANConvergence: configurations take tens of seconds to
+// apply, so polls start a few seconds apart and back off within the API rate limits.
+var sdwanPoll = backoff.Policy{Initial: 5 * time.Second, Max: 30 * time.Second, Multiplier: 1.5}
+
+// SDWANIssue is a problem keeping an SD-WAN configuration from converging.
+type SDWANIssue struct {
+	// Role is "hub", "spoke", or "config" for the configuration itself.
+	Role string
+
+	// Name is the name of the hub or spoke, or its ID if it has none.
+	Name string
+
+	// Reason describes the problem, e.g. an error reported by the endpoint or a
+	// tunnel that is not connected.
+	Reason string
+}
+
+// String returns the issue as "spoke Branch: reason".
+func (i SDWANIssue) String() string {
+	if i.Role == sdwanRoleConfig {
+		return i.Reason
+	}
+	return i.Role + " " + i.Name + ": " + i.Reason
+}
+
+// SDWANConvergence summarizes how far an SD-WAN configuration is applied.
+type SDWANConvergence struct {
+	// Failures are the errors reported by the configuration, its hubs and spokes,
+	// and their networks and routes.
+	Failures []SDWANIssue
+
+	// Pending are the hubs and spokes still applying the configuration, and the
+	// tunnels not connected yet.
+	Pending []SDWANIssue
+}
+
+// Converged reports whether every hub and spoke applied the configuration without
+// errors and every tunnel is connected.
+func (c *SDWANConvergence) Converged() bool {
+	return len(c.Failures) == 0 && len(c.Pending) == 0
+}
+
+// Summary describes the failures and pending endpoints in one line, e.g.
+// "1 failed, 1 pending: spoke Branch: WAN down; spoke Lab: apply status PENDING".
+func (c *SDWANConvergence) Summary() string {
+	if c.Converged() {
+		return "converged"
+	}
+	issues := make([]string, 0, len(c.Failures)+len(c.Pending))
+	for _, issue := range c.Failures {
+		issues = append(issues, issue.String())
+	}
+	for _, issue := range c.Pending {
+		issues = append(issues, issue.String())
+	}
+	return strconv.Itoa(len(c.Failures)) + " failed, " + strconv.Itoa(len(c.Pending)) + " pending: " +
+		strings.Join(issues, "; ")
+}
+
+// Convergence evaluates the status of the configuration: hubs and spokes converge
+// once their apply status is OK and, for spokes, all tunnels are connected.
+func (s *SDWANConfigStatus) Convergence() SDWANConvergence {
+	var c SDWANConvergence
+	for _, reason := range valueOrZero(s.Errors) {
+		c.Failures = append(c.Failures, SDWANIssue{Role: sdwanRoleConfig, Reason: reason})
+	}
+
+	for _, hub := range valueOrZero(s.Hubs) {
+		name := sdwanEndpointName(hub.Name, hub.Id)
+		c.addEndpoint(sdwanRoleHub, name, hub.ApplyStatus, hub.Errors, hub.Networks, hub.Routes)
+	}
+
+	for _, spoke := range valueOrZero(s.Spokes) {
+		name := sdwanEndpointName(spoke.Name, spoke.Id)
+		c.addEndpoint(sdwanRoleSpoke, name, spoke.ApplyStatus, spoke.Errors, spoke.Networks, spoke.Routes)
+		for _, connection := range valueOrZero(spoke.Connections) {
+			for _, tunnel := range valueOrZero(connection.Tunnels) {
+				status := valueOrZero(tunnel.Status)
+				if status == SDWANTunnelConnected {
+					continue
+				}
+				c.Pending = append(c.Pending, SDWANIssue{Role: sdwanRoleSpoke, Name: name, Reason: "tunnel " +
+					valueOrZero(tunnel.SpokeWanId) + " to hub " + valueOrZero(connection.HubId) + " " +
+					valueOrZero(tunnel.HubWanId) + " is " + cmp.Or(status, "unknown")})
+			}
+		}
+	}
+	return c
+}
+
+// addEndpoint adds the failures and pending state of a hub or spoke.
+func (c *SDWANConvergence) addEndpoint(role, name string, applyStatus *string, errs *[]string, networks *[]SDWANNetwork, routes *[]SDWANRoute) {
+	failures := valueOrZero(errs)
+	for _, network := range valueOrZero(networks) {
+		for _, reason := range valueOrZero(network.Errors) {
+			failures = append(failures, "network "+valueOrZero(network.Name)+": "+reason)
+		}
+	}
+	for _, route := range valueOrZero(routes) {
+		for _, reason := range valueOrZero(route.Errors) {
+			failures = append(failures, "route "+valueOrZero(route.RouteValue)+": "+reason)
+		}
+	}
+	for _, reason := range failures {
+		c.Failures = append(c.Failures, SDWANIssue{Role: role, Name: name, Reason: reason})
+	}
+
+	if status := valueOrZero(applyStatus); status != SDWANApplyStatusOK && len(failures) == 0 {
+		c.Pending = append(c.Pending, SDWANIssue{Role: role, Name: name, Reason: "apply status " + cmp.Or(status, "unknown")})
+	}
+}
+
+func sdwanEndpointName(name, id *string) string {
+	return cmp.Or(valueOrZero(name), valueOrZero(id))
+}
+
+// WaitForSDWANConvergence polls the status of an SD-WAN configuration until every hub
+// and spoke has applied it and all tunnels are connected, and returns the final
+// convergence. It is the verification step of SD-WAN rollouts.
+//
+// It stops early with an error matching ErrSDWANFailed when the configuration or an
+// endpoint reports errors. If timeout (or ctx) expires first, it returns the last
+// convergence with an error matching ErrSDWANNotConverged and the context error.
+// Both errors list the failing and pending endpoints; see SDWANConvergence.Summary.
+// A timeout of zero waits as long as ctx allows.
+//
+// Example:
+//
+//	convergence, err := client.WaitForSDWANConvergence(ctx, configID, 10*time.Minute)
+//	if err != nil {
+//	    for _, failure := range convergence.Failures {
+//	        log.Printf("%s", failure)
+//	    }
+//	}
+func (c *UnifiClient) WaitForSDWANConvergence(ctx context.Context, configID string, timeout time.Duration) (*SDWANConvergence, error) {
+	return waitForSDWANConvergence(ctx, c, configID, timeout, sdwanPoll)
+}
+
+func waitForSDWANConvergence(ctx context.Context, client SiteManagerAPIClient, configID string, timeout time.Duration, poll backoff.Policy) (*SDWANConvergence, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var last *SDWANConvergence
+	for attempt := 0; ; attempt++ {
+		resp, err := client.GetSDWANConfigStatus(ctx, configID)
+		if err != nil {
+			if last != nil && ctx.Err() != nil {
+				return last, notConverged(ctx.Err(), configID, last)
+			}
+			//nolint:wrapcheck // GetSDWANConfigStatus wraps errors internally
+			return last, err
+		}
+
+		convergence := resp.Data.Convergence()
+		last = &convergence
+		if len(convergence.Failures) > 0 {
+			return last, errors.Wrapf(ErrSDWANFailed, "SD-WAN configuration %s: %s", configID, convergence.Summary())
+		}
+		if convergence.Converged() {
+			return last, nil
+		}
+
+		err = backoff.Sleep(ctx, poll.Delay(attempt))
+		if err != nil {
+			return last, notConverged(err, configID, last)
+		}
+	}
+}
+
+// notConverged returns err, the reason the wait ended, marked as ErrSDWANNotConverged.
+func notConverged(err error, configID string, convergence *SDWANConvergence) error {
+	return errors.Wrapf(fmt.Errorf("%w: %w", ErrSDWANNotConverged, err), "SD-WAN configuration %s: %s",
+		configID, convergence.Summary())
+}
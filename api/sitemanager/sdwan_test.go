@@ -0,0 +1,113 @@
+package sitemanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+	"github.com/lexfrei/go-unifi/internal/backoff"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+const testSDWANConfigID = "0cd6c4ab-5c3f-4a8f-9ac4-a9e0bd0ac1f5"
+
+// sdwanServer serves the given status fixtures, one per poll; the last fixture is repeated.
+func sdwanServer(t *testing.T, polls ...string) (*UnifiClient, *atomic.Int32) {
+	t.Helper()
+
+	var served atomic.Int32
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		"/ea/sd-wan-configs/" + testSDWANConfigID + "/status": func(w http.ResponseWriter, _ *http.Request) {
+			n := min(int(served.Add(1)), len(polls))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(testdata.LoadFixture(t, polls[n-1])))
+		},
+	})
+	t.Cleanup(server.Close)
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+	return client, &served
+}
+
+func loadSDWANStatus(t *testing.T, fixture string) SDWANConfigStatus {
+	t.Helper()
+
+	var resp SDWANConfigStatusResponse
+	require.NoError(t, json.Unmarshal([]byte(testdata.LoadFixture(t, fixture)), &resp))
+	return resp.Data
+}
+
+func TestSDWANConvergence(t *testing.T) {
+	t.Parallel()
+
+	status := loadSDWANStatus(t, "sdwan/config_status.json")
+	convergence := status.Convergence()
+	assert.True(t, convergence.Converged())
+	assert.Equal(t, "converged", convergence.Summary())
+
+	status = loadSDWANStatus(t, "sdwan/config_status_pending.json")
+	convergence = status.Convergence()
+	assert.False(t, convergence.Converged())
+	assert.Empty(t, convergence.Failures)
+	require.Len(t, convergence.Pending, 2)
+	assert.Equal(t, SDWANIssue{Role: "spoke", Name: "Marlind's UDR", Reason: "tunnel WAN to hub " +
+		"9C05D6B1DA7100000000080A820B000000000877B4A4000000006634E113:779231894_670d14b2b4e979611b761866 WAN is disconnected"},
+		convergence.Pending[0])
+	assert.Equal(t, "spoke AG UCG Ultra STG: apply status PENDING", convergence.Pending[1].String())
+
+	status = loadSDWANStatus(t, "sdwan/config_status_failed.json")
+	convergence = status.Convergence()
+	require.Len(t, convergence.Failures, 2)
+	assert.Empty(t, convergence.Pending, "endpoints with errors are failed, not pending")
+	assert.Equal(t, "route 172.16.2.0/24: Overlapping subnet", convergence.Failures[1].Reason)
+	assert.Contains(t, convergence.Summary(), "2 failed, 0 pending: spoke AG UCG Ultra STG: Route 172.16.2.0/24 overlaps")
+
+	errs := []string{"Hub is offline"}
+	status = SDWANConfigStatus{Errors: &errs}
+	convergence = status.Convergence()
+	assert.Equal(t, "1 failed, 0 pending: Hub is offline", convergence.Summary())
+}
+
+func TestWaitForSDWANConvergence(t *testing.T) {
+	t.Parallel()
+
+	client, served := sdwanServer(t, "sdwan/config_status_pending.json", "sdwan/config_status_pending.json", "sdwan/config_status.json")
+
+	convergence, err := waitForSDWANConvergence(context.Background(), client, testSDWANConfigID, time.Minute, backoff.Policy{})
+	require.NoError(t, err)
+	assert.True(t, convergence.Converged())
+	assert.Equal(t, int32(3), served.Load())
+}
+
+func TestWaitForSDWANConvergenceFailed(t *testing.T) {
+	t.Parallel()
+
+	client, served := sdwanServer(t, "sdwan/config_status_pending.json", "sdwan/config_status_failed.json", "sdwan/config_status.json")
+
+	convergence, err := waitForSDWANConvergence(context.Background(), client, testSDWANConfigID, time.Minute, backoff.Policy{})
+	require.ErrorIs(t, err, ErrSDWANFailed)
+	assert.Contains(t, err.Error(), "Overlapping subnet")
+	assert.Len(t, convergence.Failures, 2)
+	assert.Equal(t, int32(2), served.Load(), "polling stops at the first failure")
+}
+
+func TestWaitForSDWANConvergenceTimeout(t *testing.T) {
+	t.Parallel()
+
+	client, _ := sdwanServer(t, "sdwan/config_status_pending.json")
+
+	convergence, err := waitForSDWANConvergence(context.Background(), client, testSDWANConfigID, 50*time.Millisecond,
+		backoff.Policy{Initial: 10 * time.Millisecond})
+	require.ErrorIs(t, err, ErrSDWANNotConverged)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "apply status PENDING")
+	assert.Len(t, convergence.Pending, 2)
+}
@@ -0,0 +1,145 @@
+package sitemanager
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// DefaultSiteCacheTTL is the default time a SiteCache serves sites without refreshing.
+const DefaultSiteCacheTTL = 30 * time.Second
+
+// GetSiteByID retrieves a single site with its statistics.
+//
+// The Site Manager API has no endpoint for a single site, so this fetches the
+// site list and selects the site. Errors for unknown sites match unifierr.ErrNotFound.
+// To poll one or more sites repeatedly, use a SiteCache instead.
+func (c *UnifiClient) GetSiteByID(ctx context.Context, siteID string) (*Site, error) {
+	sites, err := c.ListSites(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findSite(sites.Data, siteID)
+}
+
+func findSite(sites []Site, siteID string) (*Site, error) {
+	for i := range sites {
+		if sites[i].SiteId != nil && *sites[i].SiteId == siteID {
+			site := sites[i]
+			return &site, nil
+		}
+	}
+	return nil, errors.Wrapf(unifierr.ErrNotFound, "site %s", siteID)
+}
+
+// SiteCache serves sites from a shared, periodically refreshed site list.
+//
+// Dashboards tracking individual sites can call Site or Statistics as often as they
+// render: the site list is fetched at most once per TTL, no matter how many sites or
+// goroutines ask, and concurrent callers share a single in-flight refresh.
+//
+// Example:
+//
+//	cache := sitemanager.NewSiteCache(client, time.Minute)
+//	stats, err := cache.Statistics(ctx, siteID)
+type SiteCache struct {
+	client SiteManagerAPIClient
+	ttl    time.Duration
+
+	// refreshMu serializes refreshes so concurrent callers share one request.
+	refreshMu sync.Mutex
+
+	mu        sync.RWMutex
+	sites     []Site
+	fetchedAt time.Time
+}
+
+// NewSiteCache creates a cache that refreshes the site list through client when it is
+// older than ttl. A ttl of zero or less uses DefaultSiteCacheTTL.
+func NewSiteCache(client SiteManagerAPIClient, ttl time.Duration) *SiteCache {
+	if ttl <= 0 {
+		ttl = DefaultSiteCacheTTL
+	}
+	return &SiteCache{client: client, ttl: ttl}
+}
+
+// Site returns the site with the given ID, refreshing the site list if it is stale.
+// Errors for unknown sites match unifierr.ErrNotFound.
+func (c *SiteCache) Site(ctx context.Context, siteID string) (*Site, error) {
+	sites, err := c.Sites(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findSite(sites, siteID)
+}
+
+// Statistics returns the statistics of the site with the given ID.
+func (c *SiteCache) Statistics(ctx context.Context, siteID string) (*SiteStatistics, error) {
+	site, err := c.Site(ctx, siteID)
+	if err != nil {
+		return nil, err
+	}
+	if site.Statistics == nil {
+		return &SiteStatistics{}, nil
+	}
+	return site.Statistics, nil
+}
+
+// Sites returns all sites, refreshing the site list if it is stale.
+// The returned slice must not be modified.
+func (c *SiteCache) Sites(ctx context.Context) ([]Site, error) {
+	if sites, ok := c.fresh(); ok {
+		return sites, nil
+	}
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	// Another caller may have refreshed while we waited.
+	if sites, ok := c.fresh(); ok {
+		return sites, nil
+	}
+
+	resp, err := c.client.ListSites(ctx)
+	if err != nil {
+		//nolint:wrapcheck // Errors from the client are already wrapped
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.sites = resp.Data
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+
+	return resp.Data, nil
+}
+
+// Invalidate forces the next call to refresh the site list.
+func (c *SiteCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fetchedAt = time.Time{}
+}
+
+// FetchedAt returns when the cached site list was fetched, or the zero time if never.
+func (c *SiteCache) FetchedAt() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.fetchedAt
+}
+
+func (c *SiteCache) fresh() ([]Site, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.fetchedAt.IsZero() || time.Since(c.fetchedAt) >= c.ttl {
+		return nil, false
+	}
+	return c.sites, true
+}
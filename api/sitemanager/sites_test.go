@@ -0,0 +1,110 @@
+package sitemanager
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const testSiteID = "661de833b6b2463f0c20b319"
+
+func TestGetSiteByID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		siteID  string
+		wantErr error
+	}{
+		{name: "existing site", siteID: testSiteID},
+		{name: "unknown site", siteID: "000000000000000000000000", wantErr: unifierr.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := testutil.NewMockServer(t, "/v1/sites", testAPIKey,
+				testdata.LoadFixture(t, "sites/list_success.json"), http.StatusOK)
+			defer server.Close()
+
+			client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+			require.NoError(t, err)
+
+			site, err := client.GetSiteByID(context.Background(), tt.siteID)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.siteID, *site.SiteId)
+			require.NotNil(t, site.Statistics)
+		})
+	}
+}
+
+func TestSiteCache(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testdata.LoadFixture(t, "sites/list_success.json")))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	cache := NewSiteCache(client, 0)
+	ctx := context.Background()
+	assert.True(t, cache.FetchedAt().IsZero())
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			stats, err := cache.Statistics(ctx, testSiteID)
+			assert.NoError(t, err)
+			if assert.NotNil(t, stats.Counts) {
+				assert.Equal(t, 1, *stats.Counts.TotalDevice)
+			}
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load(), "concurrent callers should share one refresh")
+
+	_, err = cache.Site(ctx, "000000000000000000000000")
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+	assert.Equal(t, int32(1), calls.Load(), "unknown sites should not force a refresh")
+
+	cache.Invalidate()
+	_, err = cache.Site(ctx, testSiteID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.False(t, cache.FetchedAt().IsZero())
+}
+
+func TestSiteCacheError(t *testing.T) {
+	t.Parallel()
+
+	server := testutil.NewMockServer(t, "/v1/sites", testAPIKey,
+		testdata.LoadFixture(t, "errors/unauthorized.json"), http.StatusUnauthorized)
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	_, err = NewSiteCache(client, 0).Statistics(context.Background(), testSiteID)
+	require.ErrorIs(t, err, unifierr.ErrUnauthorized)
+}
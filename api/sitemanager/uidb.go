@@ -0,0 +1,112 @@
+package sitemanager
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// UIDB image variants, the keys of UidbInfo.Images.
+const (
+	// UIDBImageDefault is the product image with padding, as shown in device lists.
+	UIDBImageDefault = "default"
+
+	// UIDBImageNoPadding is the product image cropped to its content.
+	UIDBImageNoPadding = "nopadding"
+
+	// UIDBImageTopology is the small image used in topology views.
+	UIDBImageTopology = "topology"
+)
+
+const (
+	// UIDBImageBaseURL is the CDN serving the original UIDB product images.
+	UIDBImageBaseURL = "https://static.ui.com/fingerprint/ui/images"
+
+	// UIDBResizerURL is the Ubiquiti image service that serves resized product images.
+	UIDBResizerURL = "https://images.svc.ui.com/"
+
+	// uidbResizerQuality is the quality requested from the image service, as used by the UniFi UI.
+	uidbResizerQuality = 75
+)
+
+// ImageURL returns the URL of a product image variant, such as UIDBImageDefault.
+// A width of 0 returns the original PNG; a positive width returns the image scaled to
+// that width by the Ubiquiti image service. It returns an empty string if the UIDB
+// entry has no ID or no image for the variant.
+func (u *UidbInfo) ImageURL(variant string, width int) string {
+	if u == nil || u.Id == nil || u.Images == nil {
+		return ""
+	}
+	hash := (*u.Images)[variant]
+	if hash == "" {
+		return ""
+	}
+
+	original := UIDBImageBaseURL + "/" + u.Id.String() + "/" + url.PathEscape(variant) + "/" + url.PathEscape(hash) + ".png"
+	if width <= 0 {
+		return original
+	}
+	query := url.Values{
+		"u": {original},
+		"w": {strconv.Itoa(width)},
+		"q": {strconv.Itoa(uidbResizerQuality)},
+	}
+	return UIDBResizerURL + "?" + query.Encode()
+}
+
+// ImageURLs returns the URLs of all product image variants of the UIDB entry, keyed
+// by variant, at the given width (see ImageURL). It returns nil if there are none.
+func (u *UidbInfo) ImageURLs(width int) map[string]string {
+	if u == nil || u.Images == nil {
+		return nil
+	}
+	var urls map[string]string
+	for variant := range *u.Images {
+		if imageURL := u.ImageURL(variant, width); imageURL != "" {
+			if urls == nil {
+				urls = make(map[string]string, len(*u.Images))
+			}
+			urls[variant] = imageURL
+		}
+	}
+	return urls
+}
+
+// DeviceImages is a device together with its host and the URLs of its product images.
+type DeviceImages struct {
+	InventoryDevice
+
+	// Images maps image variants, such as UIDBImageDefault, to URLs; nil if the device
+	// has no UIDB images.
+	Images map[string]string
+}
+
+// ListDevicesWithImages lists the devices of all hosts matching params, fetching pages
+// as needed, with the URLs of their product images resolved at the given width (0
+// for the original images). UI consumers can use the URLs directly, without knowing
+// how UIDB images are addressed. params may be nil.
+//
+// Example:
+//
+//	devices, err := client.ListDevicesWithImages(ctx, nil, 128)
+//	for _, d := range devices {
+//	    fmt.Println(*d.Device.Name, d.Images[sitemanager.UIDBImageDefault])
+//	}
+func (c *UnifiClient) ListDevicesWithImages(ctx context.Context, params *ListDevicesParams, width int) ([]DeviceImages, error) {
+	var devices []DeviceImages
+	for host, err := range c.AllDevices(ctx, params) {
+		if err != nil {
+			return nil, err
+		}
+		if host.Devices == nil {
+			continue
+		}
+		for _, device := range *host.Devices {
+			devices = append(devices, DeviceImages{
+				InventoryDevice: InventoryDevice{HostID: valueOrZero(host.HostId), HostName: valueOrZero(host.HostName), Device: device},
+				Images:          device.Uidb.ImageURLs(width),
+			})
+		}
+	}
+	return devices, nil
+}
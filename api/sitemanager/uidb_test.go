@@ -0,0 +1,86 @@
+package sitemanager
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+func TestUidbInfoImageURL(t *testing.T) {
+	t.Parallel()
+
+	inventory := loadInventory(t)
+	uidb := (*inventory.Data[0].Devices)[0].Uidb
+	require.NotNil(t, uidb)
+
+	const original = "https://static.ui.com/fingerprint/ui/images/b13610ef-7e73-4a14-985a-53bb75a62401/default/e3fbf220c145f4301929576039d52d19.png"
+
+	tests := []struct {
+		name    string
+		uidb    *UidbInfo
+		variant string
+		width   int
+		want    string
+	}{
+		{name: "original", uidb: uidb, variant: UIDBImageDefault, want: original},
+		{name: "missing variant", uidb: uidb, variant: "hero"},
+		{name: "nil entry", variant: UIDBImageDefault},
+		{name: "no ID", uidb: &UidbInfo{Images: uidb.Images}, variant: UIDBImageDefault},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.uidb.ImageURL(tt.variant, tt.width))
+		})
+	}
+
+	t.Run("resized", func(t *testing.T) {
+		t.Parallel()
+
+		resized, err := url.Parse(uidb.ImageURL(UIDBImageDefault, 128))
+		require.NoError(t, err)
+		assert.Equal(t, "images.svc.ui.com", resized.Host)
+		assert.Equal(t, original, resized.Query().Get("u"))
+		assert.Equal(t, "128", resized.Query().Get("w"))
+		assert.Equal(t, "75", resized.Query().Get("q"))
+	})
+}
+
+func TestListDevicesWithImages(t *testing.T) {
+	t.Parallel()
+
+	// The fixture announces a next page, served empty
+	firstPage := testdata.LoadFixture(t, "devices/list_success.json")
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("nextToken") == "" {
+			_, _ = w.Write([]byte(firstPage))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": [], "httpStatusCode": 200, "traceId": "last-page"}`))
+	})
+	defer server.Close()
+
+	client, err := NewWithConfig(&ClientConfig{APIKey: testAPIKey, BaseURL: server.URL})
+	require.NoError(t, err)
+
+	devices, err := client.ListDevicesWithImages(context.Background(), nil, 0)
+	require.NoError(t, err)
+	require.Len(t, devices, 2)
+
+	console := devices[1]
+	assert.Equal(t, "UniFi Console", *console.Device.Name)
+	assert.NotEmpty(t, console.HostID)
+	assert.Len(t, console.Images, 3)
+	assert.Equal(t,
+		"https://static.ui.com/fingerprint/ui/images/e06fdc4f-c73f-2124-775b-6ab68813fae6/topology/a11d0f6ce02119c83f9cca6e2fe361aa.png",
+		console.Images[UIDBImageTopology])
+}
@@ -0,0 +1,56 @@
+package sitemanager
+
+// AvailableUpdate is an update a host reports as available.
+type AvailableUpdate struct {
+	// Application is the controller name ("network", "protect", ...) for application
+	// updates, or empty for UniFi OS updates.
+	Application string
+
+	// Current is the installed version, empty if the host does not report it.
+	Current string
+
+	// Available is the version the update installs.
+	Available string
+}
+
+// AvailableUpdates lists the UniFi OS and application updates the host reports, UniFi OS first.
+// Applications the host marks as not updatable are skipped.
+//
+// The Site Manager API does not start updates: install them on the console or in the
+// UniFi Site Manager web UI.
+func (h *Host) AvailableUpdates() []AvailableUpdate {
+	state := h.ReportedState
+	if state == nil {
+		return nil
+	}
+
+	var updates []AvailableUpdate
+	if state.FirmwareUpdate != nil && valueOrZero(state.FirmwareUpdate.LatestAvailableVersion) != "" {
+		latest := *state.FirmwareUpdate.LatestAvailableVersion
+		current, ok := h.UniFiOSVersion()
+		want, err := ParseVersion(latest)
+		if err != nil || !ok || want.Compare(current) > 0 {
+			update := AvailableUpdate{Available: latest}
+			if ok {
+				update.Current = current.String()
+			}
+			updates = append(updates, update)
+		}
+	}
+
+	if state.Controllers == nil {
+		return updates
+	}
+	for _, controller := range *state.Controllers {
+		available := valueOrZero(controller.UpdateAvailable)
+		if available == "" || controller.Updatable != nil && !*controller.Updatable {
+			continue
+		}
+		updates = append(updates, AvailableUpdate{
+			Application: valueOrZero(controller.Name),
+			Current:     valueOrZero(controller.Version),
+			Available:   available,
+		})
+	}
+	return updates
+}
@@ -0,0 +1,40 @@
+package sitemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostAvailableUpdates(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(s string) *string { return &s }
+	no := false
+
+	host := &Host{
+		Type: Console,
+		ReportedState: &ReportedState{
+			Version:        ptr("4.1.13"),
+			FirmwareUpdate: &FirmwareUpdateInfo{LatestAvailableVersion: ptr("4.3.87")},
+			Controllers: &[]Controller{
+				{Name: ptr("network"), Version: ptr("9.0.108"), UpdateAvailable: ptr("9.0.114")},
+				{Name: ptr("protect"), Version: ptr("5.3.41")},
+				{Name: ptr("access"), Version: ptr("2.4.0"), UpdateAvailable: ptr("2.5.1"), Updatable: &no},
+			},
+		},
+	}
+
+	updates := host.AvailableUpdates()
+	require.Len(t, updates, 2)
+	assert.Equal(t, AvailableUpdate{Current: "4.1.13", Available: "4.3.87"}, updates[0])
+	assert.Equal(t, AvailableUpdate{Application: "network", Current: "9.0.108", Available: "9.0.114"}, updates[1])
+
+	upToDate := &Host{ReportedState: &ReportedState{
+		Version:        ptr("4.3.87"),
+		FirmwareUpdate: &FirmwareUpdateInfo{LatestAvailableVersion: ptr("4.3.87")},
+	}}
+	assert.Empty(t, upToDate.AvailableUpdates())
+	assert.Empty(t, (&Host{}).AvailableUpdates())
+}
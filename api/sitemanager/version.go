@@ -0,0 +1,189 @@
+package sitemanager
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidVersion is returned when a version string cannot be parsed.
+var ErrInvalidVersion = errors.New("invalid version")
+
+// Version is a parsed UniFi OS, application or firmware version.
+//
+// Versions follow semantic versioning ("4.1.13", "9.0.114-beta.2") with two relaxations
+// found in UniFi firmware: missing minor or patch numbers count as zero ("4.1" equals
+// "4.1.0"), and an optional fourth number ("6.6.77.15402") is compared after the patch.
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Revision   int
+	Prerelease string
+}
+
+// ParseVersion parses a version such as "4.1.13", "v9.0.114-beta.2" or "6.6.77.15402".
+// A leading "v" and build metadata after "+" are ignored.
+func ParseVersion(s string) (Version, error) {
+	raw := s
+	s = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(s), "v"), "V")
+	s, _, _ = strings.Cut(s, "+")
+	s, pre, hasPre := strings.Cut(s, "-")
+	if hasPre && pre == "" {
+		return Version{}, errors.Wrapf(ErrInvalidVersion, "%q: empty prerelease", raw)
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 4 {
+		return Version{}, errors.Wrapf(ErrInvalidVersion, "%q: too many components", raw)
+	}
+
+	var numbers [4]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return Version{}, errors.Wrapf(ErrInvalidVersion, "%q", raw)
+		}
+		numbers[i] = n
+	}
+
+	return Version{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		Revision:   numbers[3],
+		Prerelease: pre,
+	}, nil
+}
+
+// String returns the version in its canonical form, e.g. "4.1.0" or "9.0.114-beta.2".
+func (v Version) String() string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor) + "." + strconv.Itoa(v.Patch))
+	if v.Revision != 0 {
+		b.WriteString("." + strconv.Itoa(v.Revision))
+	}
+	if v.Prerelease != "" {
+		b.WriteString("-" + v.Prerelease)
+	}
+	return b.String()
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is lower than, equal to,
+// or higher than other. Prereleases are lower than the corresponding release.
+func (v Version) Compare(other Version) int {
+	if c := cmp.Compare(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Revision, other.Revision); c != 0 {
+		return c
+	}
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// AtLeast reports whether v is equal to or higher than minimum.
+func (v Version) AtLeast(minimum Version) bool {
+	return v.Compare(minimum) >= 0
+}
+
+// comparePrerelease compares prerelease strings by semantic versioning precedence:
+// numeric identifiers compare numerically and rank below alphanumeric ones.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range min(len(as), len(bs)) {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		var c int
+		switch {
+		case aErr == nil && bErr == nil:
+			c = cmp.Compare(an, bn)
+		case aErr == nil:
+			c = -1
+		case bErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(as[i], bs[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(as), len(bs))
+}
+
+// UniFiOSVersion returns the UniFi OS version of a console host.
+// It returns false for self-hosted Network Servers and hosts that do not report a version.
+func (h *Host) UniFiOSVersion() (Version, bool) {
+	if h.Type == NetworkServer || h.ReportedState == nil {
+		return Version{}, false
+	}
+	if v, ok := parseOptionalVersion(h.ReportedState.Version); ok {
+		return v, true
+	}
+	if h.ReportedState.Hardware != nil {
+		return parseOptionalVersion(h.ReportedState.Hardware.FirmwareVersion)
+	}
+	return Version{}, false
+}
+
+// IsUniFiOSAtLeast reports whether the host runs UniFi OS minimum or later, e.g.
+// host.IsUniFiOSAtLeast("4.1"). It returns false if the version is unknown or
+// minimum cannot be parsed.
+func (h *Host) IsUniFiOSAtLeast(minimum string) bool {
+	want, err := ParseVersion(minimum)
+	if err != nil {
+		return false
+	}
+	have, ok := h.UniFiOSVersion()
+	return ok && have.AtLeast(want)
+}
+
+// ControllerVersion returns the version of the installed application with the
+// given name, e.g. host.ControllerVersion(sitemanager.ControllerProtect).
+func (h *Host) ControllerVersion(name ControllerName) (Version, bool) {
+	controller, ok := h.Controller(name)
+	if !ok || !controllerInstalled(controller) {
+		return Version{}, false
+	}
+	return parseOptionalVersion(controller.Version)
+}
+
+// NetworkControllerVersion returns the version of the UniFi Network application.
+// For self-hosted Network Servers this is the version the host reports itself.
+func (h *Host) NetworkControllerVersion() (Version, bool) {
+	if v, ok := h.ControllerVersion(ControllerNetwork); ok {
+		return v, true
+	}
+	if h.Type == NetworkServer && h.ReportedState != nil {
+		return parseOptionalVersion(h.ReportedState.Version)
+	}
+	return Version{}, false
+}
+
+func parseOptionalVersion(s *string) (Version, bool) {
+	if s == nil {
+		return Version{}, false
+	}
+	v, err := ParseVersion(*s)
+	if err != nil {
+		return Version{}, false
+	}
+	return v, true
+}
@@ -0,0 +1,149 @@
+package sitemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    Version
+		str     string
+		wantErr bool
+	}{
+		{input: "4.1.13", want: Version{Major: 4, Minor: 1, Patch: 13}, str: "4.1.13"},
+		{input: "v4.1", want: Version{Major: 4, Minor: 1}, str: "4.1.0"},
+		{input: "9", want: Version{Major: 9}, str: "9.0.0"},
+		{input: "9.0.114-beta.2", want: Version{Major: 9, Patch: 114, Prerelease: "beta.2"}, str: "9.0.114-beta.2"},
+		{input: "6.6.77.15402", want: Version{Major: 6, Minor: 6, Patch: 77, Revision: 15402}, str: "6.6.77.15402"},
+		{input: " 4.3.9+build.7 ", want: Version{Major: 4, Minor: 3, Patch: 9}, str: "4.3.9"},
+		{input: "", wantErr: true},
+		{input: "4.x", wantErr: true},
+		{input: "4..1", wantErr: true},
+		{input: "1.2.3.4.5", wantErr: true},
+		{input: "4.1-", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseVersion(tt.input)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidVersion)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.str, got.String())
+		})
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	t.Parallel()
+
+	// Each version is lower than the next.
+	ordered := []string{
+		"4.0.9",
+		"4.1.0-alpha",
+		"4.1.0-alpha.1",
+		"4.1.0-alpha.beta",
+		"4.1.0-beta.2",
+		"4.1.0-beta.11",
+		"4.1.0-rc.1",
+		"4.1",
+		"4.1.0.1",
+		"4.1.13",
+		"4.10.0",
+		"10.0.0",
+	}
+
+	for i := range len(ordered) - 1 {
+		lower, err := ParseVersion(ordered[i])
+		require.NoError(t, err)
+		higher, err := ParseVersion(ordered[i+1])
+		require.NoError(t, err)
+
+		assert.Equal(t, -1, lower.Compare(higher), "%s < %s", lower, higher)
+		assert.Equal(t, 1, higher.Compare(lower), "%s > %s", higher, lower)
+		assert.True(t, higher.AtLeast(lower))
+		assert.False(t, lower.AtLeast(higher))
+	}
+
+	a, _ := ParseVersion("v4.1")
+	b, _ := ParseVersion("4.1.0")
+	assert.Equal(t, 0, a.Compare(b))
+	assert.True(t, a.AtLeast(b))
+}
+
+func TestHostVersions(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(s string) *string { return &s }
+
+	console := &Host{
+		Type: Console,
+		ReportedState: &ReportedState{
+			Version:  ptr("4.3.87"),
+			Hardware: &HardwareInfo{FirmwareVersion: ptr("4.3.9")},
+			Controllers: &[]Controller{
+				{Name: ptr("protect"), Version: ptr("5.3.41")},
+				{Name: ptr("network"), Version: ptr("9.0.114")},
+			},
+		},
+	}
+	firmwareOnly := &Host{
+		Type:          Console,
+		ReportedState: &ReportedState{Hardware: &HardwareInfo{FirmwareVersion: ptr("v3.2.12")}},
+	}
+	server := &Host{Type: NetworkServer, ReportedState: &ReportedState{Version: ptr("8.3.11")}}
+	unknown := &Host{Type: Console}
+
+	tests := []struct {
+		name        string
+		host        *Host
+		wantOS      string
+		wantNetwork string
+	}{
+		{name: "console", host: console, wantOS: "4.3.87", wantNetwork: "9.0.114"},
+		{name: "firmware only", host: firmwareOnly, wantOS: "3.2.12"},
+		{name: "network server", host: server, wantNetwork: "8.3.11"},
+		{name: "no reported state", host: unknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			osVersion, ok := tt.host.UniFiOSVersion()
+			assert.Equal(t, tt.wantOS != "", ok)
+			if ok {
+				assert.Equal(t, tt.wantOS, osVersion.String())
+			}
+
+			networkVersion, ok := tt.host.NetworkControllerVersion()
+			assert.Equal(t, tt.wantNetwork != "", ok)
+			if ok {
+				assert.Equal(t, tt.wantNetwork, networkVersion.String())
+			}
+		})
+	}
+
+	assert.True(t, console.IsUniFiOSAtLeast("4.1"))
+	assert.True(t, console.IsUniFiOSAtLeast("4.3.87"))
+	assert.False(t, console.IsUniFiOSAtLeast("4.4"))
+	assert.False(t, console.IsUniFiOSAtLeast("not-a-version"))
+	assert.False(t, server.IsUniFiOSAtLeast("1.0"))
+
+	protect, ok := console.ControllerVersion("protect")
+	require.True(t, ok)
+	assert.Equal(t, "5.3.41", protect.String())
+	_, ok = console.ControllerVersion("access")
+	assert.False(t, ok)
+}
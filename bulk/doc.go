@@ -0,0 +1,43 @@
+// Package bulk executes large batches of UniFi API operations with adaptive concurrency.
+//
+// Mass onboarding (creating hundreds of DNS records, firewall rules, or vouchers in
+// one run) quickly hits controller rate limits when run with fixed concurrency, and
+// runs needlessly slowly when run sequentially. An Executor starts with a low number
+// of operations in flight, adds one for every window of fast successes, and halves it
+// whenever the controller answers 429 Too Many Requests or latency exceeds a target:
+//
+//	exec := bulk.NewExecutor(&bulk.Config{
+//	    MaxConcurrency: 16,
+//	    LatencyTarget:  500 * time.Millisecond,
+//	    OnResult: func(r bulk.Result) {
+//	        log.Printf("record %d done in %s: %v", r.Index, r.Duration, r.Err)
+//	    },
+//	})
+//
+//	ops := make([]bulk.Operation, 0, len(records))
+//	for _, record := range records {
+//	    ops = append(ops, func(ctx context.Context) error {
+//	        _, err := client.CreateDNSRecord(ctx, "default", &record)
+//	        return err
+//	    })
+//	}
+//
+//	report, err := exec.Run(ctx, ops)
+//	if err != nil {
+//	    // ctx was canceled; operations that did not run report the context error
+//	}
+//	for _, failed := range report.Failed() {
+//	    log.Printf("record %d: %v", failed.Index, failed.Err)
+//	}
+//
+// # Rate Limiting
+//
+// Operations failing with an error matching unifierr.ErrRateLimited are requeued up
+// to Config.MaxAttempts times, and no new operations start for Config.Cooldown after
+// each such failure. All other errors are final and reported as they are.
+//
+// # Results
+//
+// Config.OnResult receives every final result as it happens, from a single goroutine,
+// and Run returns a Report with the results in operation order.
+package bulk
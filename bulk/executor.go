@@ -0,0 +1,287 @@
+package bulk
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+const (
+	// DefaultMaxConcurrency is the default upper bound of operations in flight.
+	DefaultMaxConcurrency = 8
+
+	// DefaultMaxAttempts is the default number of attempts of rate-limited operations.
+	DefaultMaxAttempts = 3
+
+	// DefaultCooldown is the default pause after a rate-limited operation.
+	DefaultCooldown = time.Second
+)
+
+// Operation is one unit of work, typically a single client call.
+type Operation func(ctx context.Context) error
+
+// Config configures an Executor.
+type Config struct {
+	// MinConcurrency is the lower bound of operations in flight (defaults to 1)
+	MinConcurrency int
+
+	// MaxConcurrency is the upper bound of operations in flight (defaults to DefaultMaxConcurrency)
+	MaxConcurrency int
+
+	// InitialConcurrency is the number of operations in flight at the start
+	// (defaults to MinConcurrency)
+	InitialConcurrency int
+
+	// LatencyTarget reduces concurrency by one whenever an operation takes longer
+	// (optional, latency is ignored if zero)
+	LatencyTarget time.Duration
+
+	// MaxAttempts bounds the attempts of an operation failing with
+	// unifierr.ErrRateLimited (defaults to DefaultMaxAttempts)
+	MaxAttempts int
+
+	// Cooldown is the pause before new operations start after a rate-limited
+	// operation (defaults to DefaultCooldown)
+	Cooldown time.Duration
+
+	// Logger for observability (optional, uses noop logger if nil)
+	Logger observability.Logger
+
+	// OnResult is called with the final result of every operation (optional)
+	OnResult func(Result)
+}
+
+// Result is the outcome of one operation.
+type Result struct {
+	// Index is the position of the operation in the slice passed to Run.
+	Index int
+
+	// Err is the error of the last attempt, or nil on success.
+	Err error
+
+	// Attempts is the number of times the operation ran, zero if it never started.
+	Attempts int
+
+	// Duration is the run time of the last attempt.
+	Duration time.Duration
+}
+
+// Report summarizes a Run.
+type Report struct {
+	// Results holds the result of every operation, in operation order.
+	Results []Result
+
+	// RateLimited counts attempts that failed with unifierr.ErrRateLimited.
+	RateLimited int
+
+	// PeakConcurrency is the highest number of operations that were in flight at once.
+	PeakConcurrency int
+}
+
+// Failed returns the results of failed operations, in operation order.
+func (r *Report) Failed() []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Err returns the errors of all failed operations joined, or nil if all succeeded.
+func (r *Report) Err() error {
+	var errs []error
+	for _, result := range r.Failed() {
+		errs = append(errs, errors.Wrapf(result.Err, "operation %d", result.Index))
+	}
+	return errors.Join(errs...)
+}
+
+// Executor runs batches of operations with adaptive concurrency.
+// An Executor holds no state between runs and may be reused.
+type Executor struct {
+	cfg Config
+}
+
+// NewExecutor creates an executor. Inconsistent bounds are clamped: MaxConcurrency
+// is raised to MinConcurrency, and InitialConcurrency is kept between the two.
+func NewExecutor(cfg *Config) *Executor {
+	e := &Executor{}
+	if cfg != nil {
+		e.cfg = *cfg
+	}
+	if e.cfg.MinConcurrency <= 0 {
+		e.cfg.MinConcurrency = 1
+	}
+	if e.cfg.MaxConcurrency <= 0 {
+		e.cfg.MaxConcurrency = DefaultMaxConcurrency
+	}
+	e.cfg.MaxConcurrency = max(e.cfg.MaxConcurrency, e.cfg.MinConcurrency)
+	e.cfg.InitialConcurrency = min(max(e.cfg.InitialConcurrency, e.cfg.MinConcurrency), e.cfg.MaxConcurrency)
+	if e.cfg.MaxAttempts <= 0 {
+		e.cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if e.cfg.Cooldown <= 0 {
+		e.cfg.Cooldown = DefaultCooldown
+	}
+	if e.cfg.Logger == nil {
+		e.cfg.Logger = observability.NoopLogger()
+	}
+	return e
+}
+
+// attempt is the outcome of a single run of an operation.
+type attempt struct {
+	index    int
+	err      error
+	duration time.Duration
+}
+
+// run holds the state of one Run call. It is only accessed by the dispatching goroutine.
+type run struct {
+	cfg        *Config
+	report     *Report
+	queue      []int
+	limit      int
+	inFlight   int
+	successes  int
+	pauseUntil time.Time
+}
+
+// Run executes ops and blocks until all of them completed or ctx is canceled.
+// Operations not started before cancellation report the context error. The
+// returned error is non-nil only if ctx was canceled; failures of individual
+// operations are reported in the Report.
+func (e *Executor) Run(ctx context.Context, ops []Operation) (*Report, error) {
+	r := &run{
+		cfg:    &e.cfg,
+		report: &Report{Results: make([]Result, len(ops))},
+		queue:  make([]int, len(ops)),
+		limit:  e.cfg.InitialConcurrency,
+	}
+	for i := range ops {
+		r.report.Results[i].Index = i
+		r.queue[i] = i
+	}
+
+	done := make(chan attempt)
+	ctxDone := ctx.Done()
+	for len(r.queue) > 0 || r.inFlight > 0 {
+		if ctx.Err() != nil && r.inFlight == 0 {
+			r.cancelQueued(ctx.Err())
+			break
+		}
+
+		wake := r.launch(ctx, ops, done)
+
+		select {
+		case a := <-done:
+			r.inFlight--
+			r.complete(ctx, a)
+		case <-wake:
+		case <-ctxDone:
+			ctxDone = nil
+		}
+	}
+
+	err := ctx.Err()
+	if err != nil {
+		return r.report, errors.Wrap(err, "bulk run canceled")
+	}
+	return r.report, nil
+}
+
+// launch starts queued operations up to the concurrency limit. If a cooldown holds
+// back queued operations, it returns a channel firing when the cooldown ends.
+func (r *run) launch(ctx context.Context, ops []Operation, done chan<- attempt) <-chan time.Time {
+	if ctx.Err() != nil {
+		return nil
+	}
+	for len(r.queue) > 0 && r.inFlight < r.limit {
+		if wait := time.Until(r.pauseUntil); wait > 0 {
+			return time.After(wait)
+		}
+
+		index := r.queue[0]
+		r.queue = r.queue[1:]
+		r.inFlight++
+		r.report.PeakConcurrency = max(r.report.PeakConcurrency, r.inFlight)
+		r.report.Results[index].Attempts++
+
+		go func() {
+			start := time.Now()
+			err := runOperation(ctx, ops[index])
+			done <- attempt{index: index, err: err, duration: time.Since(start)}
+		}()
+	}
+	return nil
+}
+
+// complete records an attempt and adapts the concurrency limit to it.
+func (r *run) complete(ctx context.Context, a attempt) {
+	result := &r.report.Results[a.index]
+	result.Err = a.err
+	result.Duration = a.duration
+
+	if errors.Is(a.err, unifierr.ErrRateLimited) {
+		r.report.RateLimited++
+		r.decrease(max(r.limit/2, r.cfg.MinConcurrency), "rate limited")
+		r.pauseUntil = time.Now().Add(r.cfg.Cooldown)
+		if result.Attempts < r.cfg.MaxAttempts && ctx.Err() == nil {
+			r.queue = append(r.queue, a.index)
+			return
+		}
+	} else if r.cfg.LatencyTarget > 0 && a.duration > r.cfg.LatencyTarget {
+		r.decrease(max(r.limit-1, r.cfg.MinConcurrency), "latency above target")
+	} else if a.err == nil {
+		r.successes++
+		if r.successes >= r.limit && r.limit < r.cfg.MaxConcurrency {
+			r.limit++
+			r.successes = 0
+		}
+	}
+
+	if r.cfg.OnResult != nil {
+		r.cfg.OnResult(*result)
+	}
+}
+
+func (r *run) decrease(limit int, reason string) {
+	r.successes = 0
+	if limit == r.limit {
+		return
+	}
+	r.cfg.Logger.Debug("reducing bulk concurrency",
+		observability.Field{Key: "reason", Value: reason},
+		observability.Field{Key: "from", Value: r.limit},
+		observability.Field{Key: "to", Value: limit},
+	)
+	r.limit = limit
+}
+
+// cancelQueued reports err for every operation still waiting to run.
+func (r *run) cancelQueued(err error) {
+	for _, index := range r.queue {
+		r.report.Results[index].Err = err
+		if r.cfg.OnResult != nil {
+			r.cfg.OnResult(r.report.Results[index])
+		}
+	}
+	r.queue = nil
+}
+
+// runOperation calls op and converts a panic into an error, so one faulty
+// operation cannot stop the run.
+func runOperation(ctx context.Context, op Operation) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = errors.Newf("operation panicked: %v", rec)
+		}
+	}()
+	return op(ctx)
+}
@@ -0,0 +1,224 @@
+package bulk
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+var errRateLimited = errors.WithStack(&unifierr.APIError{StatusCode: http.StatusTooManyRequests})
+
+func TestNewExecutorDefaults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  *Config
+		want Config
+	}{
+		{
+			name: "nil config",
+			cfg:  nil,
+			want: Config{MinConcurrency: 1, MaxConcurrency: DefaultMaxConcurrency, InitialConcurrency: 1},
+		},
+		{
+			name: "max below min",
+			cfg:  &Config{MinConcurrency: 4, MaxConcurrency: 2},
+			want: Config{MinConcurrency: 4, MaxConcurrency: 4, InitialConcurrency: 4},
+		},
+		{
+			name: "initial above max",
+			cfg:  &Config{MaxConcurrency: 4, InitialConcurrency: 10},
+			want: Config{MinConcurrency: 1, MaxConcurrency: 4, InitialConcurrency: 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := NewExecutor(tt.cfg).cfg
+			assert.Equal(t, tt.want.MinConcurrency, cfg.MinConcurrency)
+			assert.Equal(t, tt.want.MaxConcurrency, cfg.MaxConcurrency)
+			assert.Equal(t, tt.want.InitialConcurrency, cfg.InitialConcurrency)
+			assert.Equal(t, DefaultMaxAttempts, cfg.MaxAttempts)
+			assert.Equal(t, DefaultCooldown, cfg.Cooldown)
+		})
+	}
+}
+
+func TestExecutorRun(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var reported []int
+	exec := NewExecutor(&Config{
+		MaxConcurrency: 4,
+		OnResult: func(r Result) {
+			mu.Lock()
+			defer mu.Unlock()
+			reported = append(reported, r.Index)
+		},
+	})
+
+	errFailed := errors.New("failed")
+	ops := make([]Operation, 20)
+	for i := range ops {
+		ops[i] = func(context.Context) error {
+			if i == 7 {
+				return errFailed
+			}
+			return nil
+		}
+	}
+
+	report, err := exec.Run(context.Background(), ops)
+	require.NoError(t, err)
+	require.Len(t, report.Results, 20)
+	assert.Len(t, reported, 20)
+	assert.LessOrEqual(t, report.PeakConcurrency, 4)
+
+	failed := report.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, 7, failed[0].Index)
+	require.ErrorIs(t, report.Err(), errFailed)
+	assert.Contains(t, report.Err().Error(), "operation 7")
+
+	for i, result := range report.Results {
+		assert.Equal(t, i, result.Index)
+		assert.Equal(t, 1, result.Attempts)
+	}
+}
+
+func TestExecutorRampsUp(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, peak atomic.Int32
+	ops := make([]Operation, 60)
+	for i := range ops {
+		ops[i] = func(context.Context) error {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			return nil
+		}
+	}
+
+	report, err := NewExecutor(&Config{MaxConcurrency: 4}).Run(context.Background(), ops)
+	require.NoError(t, err)
+	assert.Equal(t, 4, report.PeakConcurrency, "concurrency should grow to the maximum")
+	assert.LessOrEqual(t, peak.Load(), int32(4))
+}
+
+func TestExecutorRateLimited(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	ops := []Operation{
+		func(context.Context) error {
+			if calls.Add(1) < 3 {
+				return errRateLimited
+			}
+			return nil
+		},
+		func(context.Context) error { return errRateLimited },
+	}
+
+	exec := NewExecutor(&Config{
+		InitialConcurrency: 2,
+		MaxAttempts:        3,
+		Cooldown:           time.Millisecond,
+	})
+	report, err := exec.Run(context.Background(), ops)
+	require.NoError(t, err)
+
+	assert.NoError(t, report.Results[0].Err)
+	assert.Equal(t, 3, report.Results[0].Attempts)
+
+	require.ErrorIs(t, report.Results[1].Err, unifierr.ErrRateLimited)
+	assert.Equal(t, 3, report.Results[1].Attempts)
+	assert.Equal(t, 5, report.RateLimited)
+}
+
+func TestExecutorLatencyTarget(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, laterPeak atomic.Int32
+	ops := make([]Operation, 12)
+	for i := range ops {
+		ops[i] = func(context.Context) error {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			if i >= 4 && n > laterPeak.Load() {
+				laterPeak.Store(n)
+			}
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		}
+	}
+
+	exec := NewExecutor(&Config{InitialConcurrency: 4, MaxConcurrency: 4, LatencyTarget: time.Millisecond})
+	report, err := exec.Run(context.Background(), ops)
+	require.NoError(t, err)
+	assert.Equal(t, 4, report.PeakConcurrency)
+	assert.Equal(t, int32(1), laterPeak.Load(), "slow operations should reduce concurrency to the minimum")
+}
+
+func TestExecutorCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var started atomic.Int32
+	ops := make([]Operation, 10)
+	for i := range ops {
+		ops[i] = func(context.Context) error {
+			if started.Add(1) == 2 {
+				cancel()
+			}
+			return nil
+		}
+	}
+
+	report, err := NewExecutor(&Config{MaxConcurrency: 1}).Run(ctx, ops)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(2), started.Load())
+
+	canceled := report.Failed()
+	require.Len(t, canceled, 8)
+	for _, result := range canceled {
+		require.ErrorIs(t, result.Err, context.Canceled)
+		assert.Zero(t, result.Attempts)
+	}
+}
+
+func TestExecutorRecoversPanics(t *testing.T) {
+	t.Parallel()
+
+	ops := []Operation{
+		func(context.Context) error { panic("boom") },
+		func(context.Context) error { return nil },
+	}
+
+	report, err := NewExecutor(nil).Run(context.Background(), ops)
+	require.NoError(t, err)
+	require.Error(t, report.Results[0].Err)
+	assert.Contains(t, report.Results[0].Err.Error(), "operation panicked: boom")
+	assert.NoError(t, report.Results[1].Err)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/seq"
+)
+
+// captureResources lists the resource collections a snapshot can hold, in snapshot order.
+var captureResources = []string{
+	"devices", "clients", "networks", "wlans", "dnsRecords", "firewallPolicies", "trafficRules", "userGroups",
+}
+
+// snapshot is the inventory of a Network controller at one point in time.
+type snapshot struct {
+	CapturedAt time.Time      `json:"capturedAt"`
+	Sites      []siteSnapshot `json:"sites"`
+}
+
+// siteSnapshot is a site with the resources captured for it. Empty collections are
+// omitted; the diff treats missing and empty collections alike.
+type siteSnapshot struct {
+	network.SiteListItem
+
+	Devices          []network.DeviceListItem `json:"devices,omitempty"`
+	Clients          []network.ClientListItem `json:"clients,omitempty"`
+	Networks         []network.NetworkConf    `json:"networks,omitempty"`
+	WLANs            []network.WLAN           `json:"wlans,omitempty"`
+	DNSRecords       []network.DNSRecord      `json:"dnsRecords,omitempty"`
+	FirewallPolicies []network.FirewallPolicy `json:"firewallPolicies,omitempty"`
+	TrafficRules     []network.TrafficRule    `json:"trafficRules,omitempty"`
+	UserGroups       []network.UserGroup      `json:"userGroups,omitempty"`
+}
+
+// capture takes a snapshot of the resources of the selected sites, all if none are
+// selected by name or internal reference.
+func capture(ctx context.Context, client *network.APIClient, sites map[string]bool, resources []string) (*snapshot, error) {
+	for _, resource := range resources {
+		if !slices.Contains(captureResources, resource) {
+			return nil, errors.Newf("unknown resource %q, want one of %v", resource, captureResources)
+		}
+	}
+
+	result := &snapshot{CapturedAt: time.Now().UTC()}
+	for site, err := range client.AllSites(ctx) {
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list sites")
+		}
+		if len(sites) > 0 && !sites[site.Name] && !sites[site.InternalReference] {
+			continue
+		}
+
+		captured := siteSnapshot{SiteListItem: site}
+		for _, resource := range resources {
+			err := captureResource(ctx, client, &captured, resource)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to capture %s of site %s", resource, site.Name)
+			}
+		}
+		result.Sites = append(result.Sites, captured)
+	}
+	return result, nil
+}
+
+func captureResource(ctx context.Context, client *network.APIClient, site *siteSnapshot, resource string) error {
+	ref := site.InternalReference
+	var err error
+	switch resource {
+	case "devices":
+		site.Devices, err = seq.Collect(client.AllSiteDevices(ctx, site.Id))
+	case "clients":
+		site.Clients, err = seq.Collect(client.AllSiteClients(ctx, site.Id))
+	case "networks":
+		site.Networks, err = client.ListNetworks(ctx, ref)
+	case "wlans":
+		site.WLANs, err = client.ListWLANs(ctx, ref)
+	case "dnsRecords":
+		site.DNSRecords, err = client.ListDNSRecords(ctx, ref)
+	case "firewallPolicies":
+		site.FirewallPolicies, err = client.ListFirewallPolicies(ctx, ref)
+	case "trafficRules":
+		site.TrafficRules, err = client.ListTrafficRules(ctx, ref)
+	case "userGroups":
+		site.UserGroups, err = client.ListUserGroups(ctx, ref)
+	}
+	return err //nolint:wrapcheck // Wrapped by capture with the resource and site
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// identityKeys are the fields identifying the elements of a resource collection, in
+// order of preference: IDs of the Integration and legacy APIs, host IDs of Site Manager
+// device lists, then MAC addresses and names for resources without IDs.
+var identityKeys = []string{"id", "_id", "hostId", "macAddress", "mac", "name"}
+
+// displayKeys are the fields naming a resource in the report.
+var displayKeys = []string{"name", "hostname", "hostName", "key"}
+
+// Actions of resource changes.
+const (
+	actionAdded   = "added"
+	actionRemoved = "removed"
+	actionChanged = "changed"
+)
+
+// resourceChange is a resource added, removed or changed between two snapshots.
+type resourceChange struct {
+	// Collection is the path of the collection holding the resource, e.g.
+	// "sites[Default].devices".
+	Collection string `json:"collection"`
+
+	// Key is the value of the identity field of the resource; Name is its display name.
+	Key  string `json:"key"`
+	Name string `json:"name,omitempty"`
+
+	Action string        `json:"action"`
+	Fields []fieldChange `json:"fields,omitempty"`
+}
+
+// fieldChange is a field of a resource with different values in the two snapshots.
+// A missing value is null.
+type fieldChange struct {
+	Path   string `json:"path"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+// report lists the changes between two snapshots, sorted by collection and key.
+type report struct {
+	Changes []resourceChange `json:"changes"`
+}
+
+// count returns the number of changes with the given action.
+func (r *report) count(action string) int {
+	n := 0
+	for i := range r.Changes {
+		if r.Changes[i].Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// differ compares decoded JSON snapshots. Collections, arrays of objects with an
+// identity field, are compared element by element; other values are compared as fields
+// of the resource holding them, and ignored outside resources, where they are metadata
+// such as capture times.
+type differ struct {
+	// ignore holds field names never compared, such as volatile counters.
+	ignore map[string]bool
+
+	changes []resourceChange
+}
+
+// diffSnapshots compares two JSON documents.
+func diffSnapshots(before, after []byte, ignore []string) (*report, error) {
+	beforeValue, err := decodeJSON(before)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode first snapshot")
+	}
+	afterValue, err := decodeJSON(after)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode second snapshot")
+	}
+
+	d := &differ{ignore: make(map[string]bool)}
+	for _, field := range ignore {
+		d.ignore[field] = true
+	}
+	d.walk("", beforeValue, afterValue)
+
+	// Collections are diffed in key order, and nested ones while diffing their parent
+	slices.SortStableFunc(d.changes, func(a, b resourceChange) int {
+		return strings.Compare(a.Collection, b.Collection)
+	})
+	return &report{Changes: d.changes}, nil
+}
+
+// decodeJSON decodes data keeping numbers exact.
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid JSON")
+	}
+	return value, nil
+}
+
+// walk looks for collections in values outside resources.
+func (d *differ) walk(path string, before, after any) {
+	if beforeItems, afterItems, ok := collections(before, after); ok {
+		d.diffCollection(path, beforeItems, afterItems)
+		return
+	}
+
+	beforeObject, _ := before.(map[string]any) //nolint:errcheck // Non-objects hold no collections
+	afterObject, _ := after.(map[string]any)   //nolint:errcheck // Non-objects hold no collections
+	for _, key := range unionKeys(beforeObject, afterObject) {
+		if !d.ignore[key] {
+			d.walk(joinPath(path, key), beforeObject[key], afterObject[key])
+		}
+	}
+}
+
+// diffCollection records the elements added to, removed from, or changed in a collection.
+func (d *differ) diffCollection(path string, before, after []map[string]any) {
+	beforeByKey := indexCollection(before)
+	afterByKey := indexCollection(after)
+
+	for _, key := range unionKeys(beforeByKey, afterByKey) {
+		old, hadOld := beforeByKey[key]
+		current, hasCurrent := afterByKey[key]
+		switch {
+		case !hadOld:
+			d.changes = append(d.changes, resourceChange{Collection: path, Key: key, Name: displayName(current), Action: actionAdded})
+		case !hasCurrent:
+			d.changes = append(d.changes, resourceChange{Collection: path, Key: key, Name: displayName(old), Action: actionRemoved})
+		default:
+			name := cmp.Or(displayName(current), displayName(old))
+			fields := d.diffFields(path+"["+cmp.Or(name, key)+"]", "", old, current)
+			if len(fields) > 0 {
+				d.changes = append(d.changes, resourceChange{Collection: path, Key: key, Name: name, Action: actionChanged, Fields: fields})
+			}
+		}
+	}
+}
+
+// diffFields returns the differing fields of a resource below prefix. Collections in
+// the resource are diffed as collections of their own, under resourcePath.
+func (d *differ) diffFields(resourcePath, prefix string, before, after any) []fieldChange {
+	if beforeItems, afterItems, ok := collections(before, after); ok && prefix != "" {
+		d.diffCollection(resourcePath+"."+prefix, beforeItems, afterItems)
+		return nil
+	}
+
+	beforeObject, beforeIsObject := before.(map[string]any)
+	afterObject, afterIsObject := after.(map[string]any)
+	if beforeIsObject && afterIsObject {
+		var fields []fieldChange
+		for _, key := range unionKeys(beforeObject, afterObject) {
+			if !d.ignore[key] {
+				fields = append(fields, d.diffFields(resourcePath, joinPath(prefix, key), beforeObject[key], afterObject[key])...)
+			}
+		}
+		return fields
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return nil
+	}
+	return []fieldChange{{Path: prefix, Before: before, After: after}}
+}
+
+// collections returns the elements of before and after if both are collections, or
+// one is and the other is empty or missing.
+func collections(before, after any) (beforeItems, afterItems []map[string]any, ok bool) {
+	beforeItems, beforeOK := collection(before)
+	afterItems, afterOK := collection(after)
+	switch {
+	case beforeOK && afterOK:
+		return beforeItems, afterItems, len(beforeItems) > 0 || len(afterItems) > 0
+	case beforeOK && isEmpty(after):
+		return beforeItems, nil, len(beforeItems) > 0
+	case afterOK && isEmpty(before):
+		return nil, afterItems, len(afterItems) > 0
+	}
+	return nil, nil, false
+}
+
+// collection returns the elements of v if it is an array of objects that all have an
+// identity field. Empty arrays are collections without elements.
+func collection(v any) ([]map[string]any, bool) {
+	array, ok := v.([]any)
+	if !ok {
+		return nil, false
+	}
+	items := make([]map[string]any, 0, len(array))
+	for _, element := range array {
+		object, ok := element.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if _, ok := identity(object); !ok {
+			return nil, false
+		}
+		items = append(items, object)
+	}
+	return items, true
+}
+
+func isEmpty(v any) bool {
+	array, ok := v.([]any)
+	return v == nil || ok && len(array) == 0
+}
+
+// indexCollection keys the elements of a collection by identity. Elements sharing an
+// identity are numbered in order, e.g. "name#2".
+func indexCollection(items []map[string]any) map[string]map[string]any {
+	byKey := make(map[string]map[string]any, len(items))
+	for _, item := range items {
+		key, _ := identity(item)
+		unique := key
+		for n := 2; byKey[unique] != nil; n++ {
+			unique = fmt.Sprintf("%s#%d", key, n)
+		}
+		byKey[unique] = item
+	}
+	return byKey
+}
+
+// identity returns the value of the first identity field of object.
+func identity(object map[string]any) (string, bool) {
+	for _, key := range identityKeys {
+		switch value := object[key].(type) {
+		case string:
+			if value != "" {
+				return value, true
+			}
+		case json.Number:
+			return value.String(), true
+		}
+	}
+	return "", false
+}
+
+func displayName(object map[string]any) string {
+	for _, key := range displayKeys {
+		if value, ok := object[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := slices.Collect(maps.Keys(a))
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// writeText writes the report for people: one block per collection, a line per
+// resource, prefixed with + for added, - for removed and ~ for changed, and the
+// changed fields below.
+func writeText(w io.Writer, r *report) error {
+	var buf bytes.Buffer
+	if len(r.Changes) == 0 {
+		buf.WriteString("No differences\n")
+		_, err := w.Write(buf.Bytes())
+		return errors.Wrap(err, "failed to write report")
+	}
+
+	collection := ""
+	for _, change := range r.Changes {
+		if change.Collection != collection || buf.Len() == 0 {
+			collection = change.Collection
+			fmt.Fprintf(&buf, "%s\n", cmp.Or(collection, "(root)"))
+		}
+		symbol := map[string]string{actionAdded: "+", actionRemoved: "-", actionChanged: "~"}[change.Action]
+		if change.Name != "" && change.Name != change.Key {
+			fmt.Fprintf(&buf, "  %s %s (%s)\n", symbol, change.Key, change.Name)
+		} else {
+			fmt.Fprintf(&buf, "  %s %s\n", symbol, change.Key)
+		}
+		for _, field := range change.Fields {
+			fmt.Fprintf(&buf, "      %s: %s -> %s\n", field.Path, compactJSON(field.Before), compactJSON(field.After))
+		}
+	}
+	fmt.Fprintf(&buf, "\n%d added, %d removed, %d changed\n",
+		r.count(actionAdded), r.count(actionRemoved), r.count(actionChanged))
+
+	_, err := w.Write(buf.Bytes())
+	return errors.Wrap(err, "failed to write report")
+}
+
+func compactJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
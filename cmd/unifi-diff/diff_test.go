@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+const (
+	testBefore = `{"capturedAt": "2025-10-20T10:00:00Z", "sites": [{
+		"id": "s1", "name": "Default",
+		"devices": [
+			{"id": "d1", "name": "Office AP", "state": "ONLINE", "uptime": 100, "config": {"led": true, "channel": 36}},
+			{"id": "d2", "name": "Old Switch", "state": "ONLINE"}
+		],
+		"dnsRecords": [{"_id": "r1", "key": "nas.lan", "value": "192.168.1.10"}]
+	}]}`
+	testAfter = `{"capturedAt": "2025-10-21T10:00:00Z", "sites": [{
+		"id": "s1", "name": "Default",
+		"devices": [
+			{"id": "d1", "name": "Office AP", "state": "OFFLINE", "uptime": 5, "config": {"led": false, "channel": 36}},
+			{"id": "d3", "name": "New Gateway", "state": "ONLINE"}
+		],
+		"dnsRecords": []
+	}, {"id": "s2", "name": "Branch"}]}`
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	t.Parallel()
+
+	result, err := diffSnapshots([]byte(testBefore), []byte(testAfter), []string{"uptime"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []resourceChange{
+		{Collection: "sites", Key: "s2", Name: "Branch", Action: actionAdded},
+		{Collection: "sites[Default].devices", Key: "d1", Name: "Office AP", Action: actionChanged, Fields: []fieldChange{
+			{Path: "config.led", Before: true, After: false},
+			{Path: "state", Before: "ONLINE", After: "OFFLINE"},
+		}},
+		{Collection: "sites[Default].devices", Key: "d2", Name: "Old Switch", Action: actionRemoved},
+		{Collection: "sites[Default].devices", Key: "d3", Name: "New Gateway", Action: actionAdded},
+		{Collection: "sites[Default].dnsRecords", Key: "r1", Name: "nas.lan", Action: actionRemoved},
+	}, result.Changes, "capture times and ignored fields are not compared")
+
+	var buf bytes.Buffer
+	require.NoError(t, writeText(&buf, result))
+	assert.Equal(t, `sites
+  + s2 (Branch)
+sites[Default].devices
+  ~ d1 (Office AP)
+      config.led: true -> false
+      state: "ONLINE" -> "OFFLINE"
+  - d2 (Old Switch)
+  + d3 (New Gateway)
+sites[Default].dnsRecords
+  - r1 (nas.lan)
+
+2 added, 2 removed, 1 changed
+`, buf.String())
+}
+
+func TestDiffSnapshotsSame(t *testing.T) {
+	t.Parallel()
+
+	result, err := diffSnapshots([]byte(testBefore), []byte(testBefore), nil)
+	require.NoError(t, err)
+	assert.Empty(t, result.Changes)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeText(&buf, result))
+	assert.Equal(t, "No differences\n", buf.String())
+
+	_, err = diffSnapshots([]byte(testBefore), []byte(`{"sites": [`), nil)
+	require.Error(t, err)
+}
+
+func TestDiffSnapshotsSiteManagerDevices(t *testing.T) {
+	t.Parallel()
+
+	// Saved ListDevices responses: hosts keyed by hostId, devices by id
+	before := `{"data": [{"hostId": "h1", "hostName": "UDM", "devices": [{"id": "a", "mac": "aa", "version": "4.0.1"}]}]}`
+	after := `{"data": [{"hostId": "h1", "hostName": "UDM", "devices": [{"id": "a", "mac": "aa", "version": "4.0.6"}]}],
+		"traceId": "ignored"}`
+
+	result, err := diffSnapshots([]byte(before), []byte(after), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []resourceChange{{
+		Collection: "data[UDM].devices", Key: "a", Action: actionChanged,
+		Fields: []fieldChange{{Path: "version", Before: "4.0.1", After: "4.0.6"}},
+	}}, result.Changes)
+}
+
+func TestCapture(t *testing.T) {
+	t.Parallel()
+
+	const sitePath = "/proxy/network/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6"
+	respond := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		}
+	}
+	server := testutil.NewMockServerMulti(t, map[string]http.HandlerFunc{
+		"/proxy/network/integration/v1/sites": respond(testdata.LoadFixture(t, "sites/list_success.json")),
+		sitePath + "/devices":                 respond(testdata.LoadFixture(t, "devices/list_success.json")),
+	})
+	defer server.Close()
+
+	client, err := network.NewWithConfig(&network.ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        "test-api-key",
+		RetryWaitTime: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	result, err := capture(context.Background(), client, map[string]bool{"default": true}, []string{"devices"})
+	require.NoError(t, err)
+	require.Len(t, result.Sites, 1)
+	assert.NotEmpty(t, result.Sites[0].Devices)
+
+	// A snapshot compared with itself has no differences
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	diff, err := diffSnapshots(data, data, nil)
+	require.NoError(t, err)
+	assert.Empty(t, diff.Changes)
+
+	_, err = capture(context.Background(), client, nil, []string{"vouchers"})
+	require.ErrorContains(t, err, "unknown resource")
+}
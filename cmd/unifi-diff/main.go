@@ -0,0 +1,147 @@
+// Command unifi-diff compares two inventory snapshots and prints the resources that
+// were added, removed or changed, e.g. to verify a change or to see what changed
+// before an incident.
+//
+// Snapshots are JSON documents: ones it captures from a Network controller with
+// -capture, or saved API responses such as Site Manager device lists. Arrays of
+// objects with an ID, MAC address or name are compared as resource collections,
+// element by element, at any depth.
+//
+// Like diff, it exits with 0 if the snapshots hold the same resources, 1 if they
+// differ, and 2 on errors.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/api/network"
+)
+
+// Exit codes, as for diff.
+const (
+	exitSame    = 0
+	exitDiffers = 1
+	exitError   = 2
+)
+
+var (
+	captureFile = flag.String("capture", "", "Capture a snapshot of a Network controller to this file instead of comparing")
+	configFile  = flag.String("config", "", "Client config file for -capture (YAML, TOML or JSON); UNIFI_* environment variables if empty")
+	sites       = flag.String("sites", "", "Comma-separated site names or internal references to capture (default all)")
+	resources   = flag.String("resources", strings.Join(captureResources, ","), "Comma-separated resources to capture")
+	ignore      = flag.String("ignore", "", "Comma-separated field names not to compare, e.g. volatile counters such as uptime")
+	jsonOutput  = flag.Bool("json", false, "Print the differences as JSON")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage:\n  %[1]s [flags] before.json after.json\n  %[1]s -capture snapshot.json [flags]\n\nFlags:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	code, err := run()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "unifi-diff:", err)
+	}
+	os.Exit(code)
+}
+
+func run() (int, error) {
+	if *captureFile != "" {
+		err := runCapture()
+		if err != nil {
+			return exitError, err
+		}
+		return exitSame, nil
+	}
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		return exitError, errors.New("two snapshots are required")
+	}
+	before, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		return exitError, errors.Wrap(err, "failed to read snapshot")
+	}
+	after, err := os.ReadFile(flag.Arg(1))
+	if err != nil {
+		return exitError, errors.Wrap(err, "failed to read snapshot")
+	}
+
+	result, err := diffSnapshots(before, after, splitList(*ignore))
+	if err != nil {
+		return exitError, err
+	}
+	if *jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = errors.Wrap(encoder.Encode(result), "failed to write report")
+	} else {
+		err = writeText(os.Stdout, result)
+	}
+	if err != nil {
+		return exitError, err
+	}
+
+	if len(result.Changes) > 0 {
+		return exitDiffers, nil
+	}
+	return exitSame, nil
+}
+
+// runCapture writes a snapshot of the controller to captureFile.
+func runCapture() error {
+	var client *network.APIClient
+	var err error
+	if *configFile != "" {
+		client, err = network.NewFromConfigFile(*configFile)
+	} else {
+		client, err = network.NewFromEnv()
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to create client")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	selected := make(map[string]bool)
+	for _, site := range splitList(*sites) {
+		selected[site] = true
+	}
+	result, err := capture(ctx, client, selected, splitList(*resources))
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode snapshot")
+	}
+	err = os.WriteFile(*captureFile, append(data, '\n'), 0o600)
+	if err != nil {
+		return errors.Wrap(err, "failed to write snapshot")
+	}
+	fmt.Printf("Captured %d sites to %s\n", len(result.Sites), *captureFile)
+	return nil
+}
+
+func splitList(list string) []string {
+	var items []string
+	for item := range strings.SplitSeq(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
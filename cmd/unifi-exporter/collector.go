@@ -0,0 +1,240 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/lexfrei/go-unifi/api/network"
+)
+
+// collector gathers metrics for all monitored sites from the Network API.
+type collector struct {
+	client *network.APIClient
+	logger *slog.Logger
+
+	// sites limits collection to sites with these names or internal references;
+	// empty collects all sites.
+	sites map[string]bool
+
+	// ports enables per-port metrics, which cost one legacy request per switching device.
+	ports bool
+
+	// wan enables WAN health metrics from the site dashboard.
+	wan bool
+
+	// errors counts failed collection steps since start, by site and step.
+	errors map[stepKey]int
+}
+
+// stepKey identifies a collection step of a site.
+type stepKey struct {
+	site string
+	step string
+}
+
+// collect queries the controller and returns the resulting metrics.
+func (c *collector) collect(ctx context.Context) *metricSet {
+	start := time.Now()
+	m := newMetricSet()
+	c.register(m)
+
+	success := true
+	for site, err := range c.client.AllSites(ctx) {
+		if err != nil {
+			c.fail("", "sites", err)
+			success = false
+			break
+		}
+		if len(c.sites) > 0 && !c.sites[site.Name] && !c.sites[site.InternalReference] {
+			continue
+		}
+		if !c.collectSite(ctx, m, site) {
+			success = false
+		}
+	}
+
+	keys := slices.SortedFunc(maps.Keys(c.errors), func(a, b stepKey) int {
+		return cmp.Or(cmp.Compare(a.site, b.site), cmp.Compare(a.step, b.step))
+	})
+	for _, key := range keys {
+		m.family("unifi_exporter_errors_total", counter, "").add(float64(c.errors[key]),
+			label{"site", key.site}, label{"step", key.step})
+	}
+	m.family("unifi_exporter_last_collection_success", gauge, "").add(boolValue(success))
+	m.family("unifi_exporter_collection_duration_seconds", gauge, "").add(time.Since(start).Seconds())
+	m.family("unifi_exporter_last_collection_timestamp_seconds", gauge, "").add(float64(time.Now().Unix()))
+	return m
+}
+
+// register declares all families up front, fixing their help texts and output order.
+func (c *collector) register(m *metricSet) {
+	m.family("unifi_site_info", gauge, "Monitored site; always 1.")
+	m.family("unifi_devices", gauge, "Number of adopted devices by state.")
+	m.family("unifi_device_up", gauge, "Whether the device is online.")
+	m.family("unifi_clients", gauge, "Number of connected clients by connection type.")
+	m.family("unifi_port_up", gauge, "Whether the port link is up.")
+	m.family("unifi_port_speed_mbps", gauge, "Negotiated link speed of the port.")
+	m.family("unifi_port_stp_blocked", gauge, "Whether spanning tree blocks the port.")
+	m.family("unifi_port_receive_bytes_total", counter, "Bytes received on the port.")
+	m.family("unifi_port_transmit_bytes_total", counter, "Bytes transmitted on the port.")
+	m.family("unifi_port_receive_errors_total", counter, "Receive errors on the port.")
+	m.family("unifi_port_transmit_errors_total", counter, "Transmit errors on the port.")
+	m.family("unifi_port_receive_dropped_total", counter, "Received packets dropped on the port.")
+	m.family("unifi_port_transmit_dropped_total", counter, "Transmitted packets dropped on the port.")
+	m.family("unifi_wan_up", gauge, "Whether the WAN was up at the latest health sample.")
+	m.family("unifi_wan_high_latency", gauge, "Whether WAN latency was high at the latest health sample.")
+	m.family("unifi_wan_packet_loss", gauge, "Whether the WAN lost packets at the latest health sample.")
+	m.family("unifi_wan_availability_ratio", gauge, "Share of WAN health samples without downtime over the dashboard history.")
+	m.family("unifi_exporter_errors_total", counter, "Failed collection steps by site and step.")
+	m.family("unifi_exporter_last_collection_success", gauge, "Whether the last collection completed without errors.")
+	m.family("unifi_exporter_collection_duration_seconds", gauge, "Duration of the last collection.")
+	m.family("unifi_exporter_last_collection_timestamp_seconds", gauge, "Unix time of the last collection.")
+}
+
+// collectSite adds the metrics of one site and reports whether all steps succeeded.
+func (c *collector) collectSite(ctx context.Context, m *metricSet, site network.SiteListItem) bool {
+	siteLabel := label{"site", site.InternalReference}
+	m.family("unifi_site_info", gauge, "").add(1, siteLabel, label{"site_id", site.Id.String()}, label{"name", site.Name})
+	success := true
+
+	states := make(map[network.DeviceListItemState]int)
+	var switches []network.DeviceListItem
+	for device, err := range c.client.AllSiteDevices(ctx, site.Id) {
+		if err != nil {
+			c.fail(site.InternalReference, "devices", err)
+			success = false
+			break
+		}
+		states[device.State]++
+		m.family("unifi_device_up", gauge, "").add(boolValue(device.State == network.DeviceListItemStateONLINE),
+			siteLabel, label{"device", device.Name}, label{"model", device.Model}, label{"mac", device.MacAddress})
+		if hasPorts(device) {
+			switches = append(switches, device)
+		}
+	}
+	for _, state := range slices.Sorted(maps.Keys(states)) {
+		m.family("unifi_devices", gauge, "").add(float64(states[state]), siteLabel, label{"state", string(state)})
+	}
+
+	types := make(map[network.ClientListItemType]int)
+	for client, err := range c.client.AllSiteClients(ctx, site.Id) {
+		if err != nil {
+			c.fail(site.InternalReference, "clients", err)
+			success = false
+			break
+		}
+		types[client.Type]++
+	}
+	for _, kind := range slices.Sorted(maps.Keys(types)) {
+		m.family("unifi_clients", gauge, "").add(float64(types[kind]), siteLabel, label{"type", string(kind)})
+	}
+
+	if c.ports {
+		for _, device := range switches {
+			if !c.collectPorts(ctx, m, site, device) {
+				success = false
+			}
+		}
+	}
+	if c.wan && !c.collectWAN(ctx, m, site) {
+		success = false
+	}
+	return success
+}
+
+func hasPorts(device network.DeviceListItem) bool {
+	if device.State != network.DeviceListItemStateONLINE {
+		return false
+	}
+	for _, iface := range device.Interfaces {
+		if iface == network.Ports {
+			return true
+		}
+	}
+	return false
+}
+
+// collectPorts adds the port metrics of one device.
+func (c *collector) collectPorts(ctx context.Context, m *metricSet, site network.SiteListItem, device network.DeviceListItem) bool {
+	ports, err := c.client.GetPortStates(ctx, site.Id, device.Id)
+	if err != nil {
+		c.fail(site.InternalReference, "ports", err)
+		return false
+	}
+
+	for i := range ports {
+		port := &ports[i]
+		labels := []label{
+			{"site", site.InternalReference},
+			{"device", device.Name},
+			{"port", strconv.Itoa(port.PortIdx)},
+			{"port_name", valueOrEmpty(port.Name)},
+		}
+		m.family("unifi_port_up", gauge, "").add(boolValue(port.Up != nil && port.Up.Bool()), labels...)
+		m.family("unifi_port_stp_blocked", gauge, "").add(boolValue(port.IsBlocked()), labels...)
+		addInt(m.family("unifi_port_speed_mbps", gauge, ""), port.Speed, labels)
+		addInt(m.family("unifi_port_receive_bytes_total", counter, ""), port.RxBytes, labels)
+		addInt(m.family("unifi_port_transmit_bytes_total", counter, ""), port.TxBytes, labels)
+		addInt(m.family("unifi_port_receive_errors_total", counter, ""), port.RxErrors, labels)
+		addInt(m.family("unifi_port_transmit_errors_total", counter, ""), port.TxErrors, labels)
+		addInt(m.family("unifi_port_receive_dropped_total", counter, ""), port.RxDropped, labels)
+		addInt(m.family("unifi_port_transmit_dropped_total", counter, ""), port.TxDropped, labels)
+	}
+	return true
+}
+
+// collectWAN adds the WAN health metrics of one site from its dashboard.
+func (c *collector) collectWAN(ctx context.Context, m *metricSet, site network.SiteListItem) bool {
+	dashboard, err := c.client.GetAggregatedDashboard(ctx, site.InternalReference, nil)
+	if err != nil {
+		c.fail(site.InternalReference, "wan", err)
+		return false
+	}
+	if dashboard.Internet == nil || dashboard.Internet.HealthHistory == nil || len(*dashboard.Internet.HealthHistory) == 0 {
+		return true
+	}
+
+	history := *dashboard.Internet.HealthHistory
+	latest, available := 0, 0
+	for i, point := range history {
+		if valueOrEmpty(point.Timestamp) >= valueOrEmpty(history[latest].Timestamp) {
+			latest = i
+		}
+		if !valueOrEmpty(point.WanDowntime) {
+			available++
+		}
+	}
+
+	siteLabel := label{"site", site.InternalReference}
+	point := history[latest]
+	m.family("unifi_wan_up", gauge, "").add(boolValue(!valueOrEmpty(point.WanDowntime)), siteLabel)
+	m.family("unifi_wan_high_latency", gauge, "").add(boolValue(valueOrEmpty(point.HighLatency)), siteLabel)
+	m.family("unifi_wan_packet_loss", gauge, "").add(boolValue(valueOrEmpty(point.PacketLoss)), siteLabel)
+	m.family("unifi_wan_availability_ratio", gauge, "").add(float64(available)/float64(len(history)), siteLabel)
+	return true
+}
+
+// fail logs a failed collection step and counts it.
+func (c *collector) fail(site, step string, err error) {
+	c.logger.Warn("collection step failed", "site", site, "step", step, "error", err)
+	c.errors[stepKey{site, step}]++
+}
+
+func addInt(f *family, v *network.FlexibleInt, labels []label) {
+	if v != nil {
+		f.add(float64(v.Int64()), labels...)
+	}
+}
+
+func valueOrEmpty[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/api/network/testdata"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+)
+
+const (
+	testSitePath  = "/proxy/network/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6"
+	testDashboard = `{"internet": {"health_history": [
+		{"timestamp": 1000, "wan_downtime": true},
+		{"timestamp": 3000, "high_latency": true},
+		{"timestamp": 2000},
+		{"timestamp": 4000}
+	]}}`
+)
+
+func exporterHandlers(t *testing.T) map[string]http.HandlerFunc {
+	t.Helper()
+
+	respond := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		}
+	}
+	fixture := func(name string) http.HandlerFunc {
+		return respond(testdata.LoadFixture(t, name))
+	}
+	return map[string]http.HandlerFunc{
+		"/proxy/network/integration/v1/sites":                          fixture("sites/list_success.json"),
+		testSitePath + "/devices":                                      fixture("devices/list_success.json"),
+		testSitePath + "/clients":                                      fixture("clients/list_success.json"),
+		testSitePath + "/devices/6204b587-7215-235b-d068-f96ca12eab52": fixture("devices/single_device.json"),
+		"/proxy/network/api/s/default/stat/device/aa:bb:cc:99:ea:6b":   fixture("devices/legacy_device.json"),
+		"/proxy/network/v2/api/site/default/aggregated-dashboard":      respond(testDashboard),
+		testSitePath + "/devices/0cd24618-8745-b626-b3c3-57692a02433e": http.NotFound,
+		"/proxy/network/api/s/default/stat/device/aa:bb:cc:6f:6d:73":   http.NotFound,
+	}
+}
+
+func newTestCollector(t *testing.T, handlers map[string]http.HandlerFunc) *collector {
+	t.Helper()
+
+	server := testutil.NewMockServerMulti(t, handlers)
+	t.Cleanup(server.Close)
+
+	client, err := network.NewWithConfig(&network.ClientConfig{
+		ControllerURL: server.URL,
+		APIKey:        "test-api-key",
+		RetryWaitTime: time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	return &collector{
+		client: client,
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		sites:  map[string]bool{},
+		ports:  true,
+		wan:    true,
+		errors: make(map[stepKey]int),
+	}
+}
+
+func render(t *testing.T, m *metricSet) string {
+	t.Helper()
+
+	var out strings.Builder
+	require.NoError(t, m.writeTo(&out))
+	return out.String()
+}
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	handlers := exporterHandlers(t)
+	// The second switch only answers the device lookup; its port request fails
+	handlers[testSitePath+"/devices/0cd24618-8745-b626-b3c3-57692a02433e"] = handlers[testSitePath+"/devices/6204b587-7215-235b-d068-f96ca12eab52"]
+	c := newTestCollector(t, handlers)
+
+	out := render(t, c.collect(context.Background()))
+
+	for _, line := range []string{
+		`unifi_site_info{site="default",site_id="88f7af54-98f8-306a-a1c7-c9349722b1f6",name="Default"} 1`,
+		`unifi_devices{site="default",state="ONLINE"} 2`,
+		`unifi_device_up{site="default",device="Device-1",model="UDR7",mac="aa:bb:cc:99:ea:6b"} 1`,
+		`unifi_clients{site="default",type="WIRED"} `,
+		`unifi_port_up{site="default",device="Device-1",port="1",port_name="Port 1"} 1`,
+		`unifi_port_speed_mbps{site="default",device="Device-1",port="1",port_name="Port 1"} 1000`,
+		`unifi_port_stp_blocked{site="default",device="Device-1",port="5",port_name="Port 5"} 1`,
+		`unifi_port_receive_errors_total{site="default",device="Device-1",port="1",port_name="Port 1"} 3`,
+		`unifi_wan_up{site="default"} 1`,
+		`unifi_wan_high_latency{site="default"} 0`,
+		`unifi_wan_availability_ratio{site="default"} 0.75`,
+		`unifi_exporter_last_collection_success 1`,
+		"# TYPE unifi_port_receive_bytes_total counter",
+	} {
+		assert.Contains(t, out, line)
+	}
+	assert.NotContains(t, out, "unifi_exporter_errors_total")
+}
+
+func TestCollect_PartialFailure(t *testing.T) {
+	t.Parallel()
+
+	c := newTestCollector(t, exporterHandlers(t))
+	c.wan = false
+
+	out := render(t, c.collect(context.Background()))
+	assert.Contains(t, out, `unifi_device_up{site="default",device="Device-2",model="USW Flex Mini",mac="aa:bb:cc:6f:6d:73"} 1`)
+	assert.Contains(t, out, `unifi_exporter_errors_total{site="default",step="ports"} 1`)
+	assert.Contains(t, out, `unifi_exporter_last_collection_success 0`)
+	assert.NotContains(t, out, "unifi_wan_up")
+
+	// Error counters accumulate across collections
+	out = render(t, c.collect(context.Background()))
+	assert.Contains(t, out, `unifi_exporter_errors_total{site="default",step="ports"} 2`)
+}
+
+func TestCollect_SiteFilter(t *testing.T) {
+	t.Parallel()
+
+	c := newTestCollector(t, map[string]http.HandlerFunc{
+		"/proxy/network/integration/v1/sites": exporterHandlers(t)["/proxy/network/integration/v1/sites"],
+	})
+	c.sites = parseSites("branch, lab")
+
+	out := render(t, c.collect(context.Background()))
+	assert.NotContains(t, out, "unifi_site_info")
+	assert.Contains(t, out, `unifi_exporter_last_collection_success 1`)
+}
+
+func TestExporterHandler(t *testing.T) {
+	t.Parallel()
+
+	exp := &exporter{
+		collector: newTestCollector(t, exporterHandlers(t)),
+		logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	server := httptest.NewServer(exp.handler())
+	t.Cleanup(server.Close)
+
+	get := func(path string) (*http.Response, string) {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+path, http.NoBody)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body)
+	}
+
+	resp, _ := get("/metrics")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "no collection yet")
+
+	exp.refresh(context.Background(), 10*time.Second)
+	resp, body := get("/metrics")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, contentType, resp.Header.Get("Content-Type"))
+	assert.Contains(t, body, "unifi_device_up")
+
+	resp, _ = get("/healthz")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,179 @@
+// Command unifi-exporter exposes UniFi Network metrics for Prometheus.
+//
+// It polls the Network API of a local controller at a fixed interval and serves
+// the latest results on /metrics: device status, client counts, switch port
+// state and counters, and WAN health, labeled per site.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/api/network"
+)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	listen     = flag.String("listen", ":9130", "Address to serve metrics on")
+	configFile = flag.String("config", "", "Client config file (YAML, TOML or JSON); UNIFI_* environment variables if empty")
+	interval   = flag.Duration("interval", 30*time.Second, "Interval between collections")
+	timeout    = flag.Duration("timeout", 20*time.Second, "Timeout of one collection")
+	sites      = flag.String("sites", "", "Comma-separated site names or internal references to collect (default all)")
+	ports      = flag.Bool("ports", true, "Collect per-port metrics (one extra request per switching device)")
+	wan        = flag.Bool("wan", true, "Collect WAN health metrics")
+	logLevel   = flag.String("log-level", "info", "Log level: debug, info, warn or error")
+)
+
+func main() {
+	flag.Parse()
+
+	var level slog.Level
+	err := level.UnmarshalText([]byte(*logLevel))
+	if err != nil {
+		slog.Error("invalid log level", "level", *logLevel)
+		os.Exit(2)
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+
+	err = run(logger)
+	if err != nil {
+		logger.Error("exporter failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(logger *slog.Logger) error {
+	var client *network.APIClient
+	var err error
+	if *configFile != "" {
+		client, err = network.NewFromConfigFile(*configFile)
+	} else {
+		client, err = network.NewFromEnv()
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to create client")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	exp := &exporter{
+		collector: &collector{
+			client: client,
+			logger: logger,
+			sites:  parseSites(*sites),
+			ports:  *ports,
+			wan:    *wan,
+			errors: make(map[stepKey]int),
+		},
+		logger: logger,
+	}
+	go exp.loop(ctx, *interval, *timeout)
+
+	server := &http.Server{
+		Addr:              *listen,
+		Handler:           exp.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx) //nolint:contextcheck // Shutdown outlives the canceled context
+	}()
+
+	logger.Info("serving metrics", "listen", *listen, "interval", *interval)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return errors.Wrap(err, "failed to serve metrics")
+	}
+	return nil
+}
+
+func parseSites(list string) map[string]bool {
+	selected := make(map[string]bool)
+	for site := range strings.SplitSeq(list, ",") {
+		if site = strings.TrimSpace(site); site != "" {
+			selected[site] = true
+		}
+	}
+	return selected
+}
+
+// exporter runs collections in the background and serves the latest result,
+// so scrapes never wait for the controller and cannot overload it.
+type exporter struct {
+	collector *collector
+	logger    *slog.Logger
+
+	mu     sync.RWMutex
+	latest []byte
+}
+
+// loop collects immediately and then every interval until ctx is canceled.
+func (e *exporter) loop(ctx context.Context, interval, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		e.refresh(ctx, timeout)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh runs one collection and stores its exposition.
+func (e *exporter) refresh(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var buf bytes.Buffer
+	err := e.collector.collect(ctx).writeTo(&buf)
+	if err != nil {
+		e.logger.Error("failed to render metrics", "error", err)
+		return
+	}
+
+	e.mu.Lock()
+	e.latest = buf.Bytes()
+	e.mu.Unlock()
+	e.logger.Debug("collection finished", "bytes", buf.Len())
+}
+
+func (e *exporter) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /metrics", func(w http.ResponseWriter, _ *http.Request) {
+		e.mu.RLock()
+		latest := e.latest
+		e.mu.RUnlock()
+
+		if latest == nil {
+			http.Error(w, "first collection in progress", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write(latest)
+	})
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<html><body><h1>UniFi Exporter</h1><a href="/metrics">Metrics</a></body></html>`))
+	})
+	return mux
+}
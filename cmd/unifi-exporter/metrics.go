@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Metric types of the Prometheus text exposition format.
+const (
+	gauge   = "gauge"
+	counter = "counter"
+)
+
+// label is a metric label name and value.
+type label struct {
+	name  string
+	value string
+}
+
+// sample is one value of a metric family with its labels.
+type sample struct {
+	labels []label
+	value  float64
+}
+
+// family is a named metric with its help text, type and samples.
+type family struct {
+	name    string
+	help    string
+	kind    string
+	samples []sample
+}
+
+// metricSet collects metric families in registration order.
+type metricSet struct {
+	families []*family
+	byName   map[string]*family
+}
+
+func newMetricSet() *metricSet {
+	return &metricSet{byName: make(map[string]*family)}
+}
+
+// family returns the family with the given name, registering it on first use.
+func (m *metricSet) family(name, kind, help string) *family {
+	if f, ok := m.byName[name]; ok {
+		return f
+	}
+	f := &family{name: name, help: help, kind: kind}
+	m.families = append(m.families, f)
+	m.byName[name] = f
+	return f
+}
+
+// add appends a sample to the family.
+func (f *family) add(value float64, labels ...label) {
+	f.samples = append(f.samples, sample{labels: labels, value: value})
+}
+
+// writeTo writes all families with at least one sample in the Prometheus text
+// exposition format (version 0.0.4).
+func (m *metricSet) writeTo(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range m.families {
+		if len(f.samples) == 0 {
+			continue
+		}
+		bw.WriteString("# HELP " + f.name + " " + escapeHelp(f.help) + "\n")
+		bw.WriteString("# TYPE " + f.name + " " + f.kind + "\n")
+		for _, s := range f.samples {
+			bw.WriteString(f.name)
+			if len(s.labels) > 0 {
+				bw.WriteByte('{')
+				for i, l := range s.labels {
+					if i > 0 {
+						bw.WriteByte(',')
+					}
+					bw.WriteString(l.name + `="` + escapeLabelValue(l.value) + `"`)
+				}
+				bw.WriteByte('}')
+			}
+			bw.WriteString(" " + formatValue(s.value) + "\n")
+		}
+	}
+	return bw.Flush() //nolint:wrapcheck // Writer errors are reported as is
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
+
+// boolValue converts a flag to the 0/1 value of a gauge.
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricSetWriteTo(t *testing.T) {
+	t.Parallel()
+
+	m := newMetricSet()
+	m.family("test_up", gauge, "Whether the \\thing\\ is up.\nSecond line.").add(1, label{"name", `a "quoted"` + "\n" + `\value`})
+	m.family("test_empty", gauge, "Never written.")
+	requests := m.family("test_requests_total", counter, "Requests.")
+	requests.add(42, label{"site", "default"}, label{"code", "200"})
+	requests.add(math.Inf(1))
+	m.family("test_up", gauge, "ignored").add(0.5)
+
+	var out strings.Builder
+	require.NoError(t, m.writeTo(&out))
+
+	want := `# HELP test_up Whether the \\thing\\ is up.\nSecond line.
+# TYPE test_up gauge
+test_up{name="a \"quoted\"\n\\value"} 1
+test_up 0.5
+# HELP test_requests_total Requests.
+# TYPE test_requests_total counter
+test_requests_total{site="default",code="200"} 42
+test_requests_total +Inf
+`
+	assert.Equal(t, want, out.String())
+}
+
+func TestFormatValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{value: 0, want: "0"},
+		{value: 123456789012, want: "1.23456789012e+11"},
+		{value: 0.25, want: "0.25"},
+		{value: math.Inf(-1), want: "-Inf"},
+		{value: math.NaN(), want: "NaN"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, formatValue(tt.value))
+	}
+}
@@ -0,0 +1,93 @@
+// Command unifi-fixtures generates JSON fixtures for unit tests from the OpenAPI specs
+// bundled with go-unifi, or from any other spec file.
+//
+// It writes one file per schema, <Schema>.json, to a directory, or prints the fixture
+// of one schema. See package fixtures for how values are chosen.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/lexfrei/go-unifi/api/access"
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/api/protect"
+	"github.com/lexfrei/go-unifi/api/sitemanager"
+	"github.com/lexfrei/go-unifi/fixtures"
+)
+
+var (
+	api      = flag.String("api", "network", "Bundled spec to use: network, sitemanager, protect or access")
+	specFile = flag.String("spec", "", "OpenAPI spec file to use instead of a bundled spec")
+	out      = flag.String("out", "testdata/fixtures", "Directory to write <Schema>.json files to")
+	schema   = flag.String("schema", "", "Print the fixture of this schema to stdout instead of writing all")
+)
+
+func main() {
+	flag.Parse()
+
+	err := run()
+	if err != nil {
+		slog.Error("failed to generate fixtures", "error", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	spec, err := loadSpec()
+	if err != nil {
+		return err
+	}
+
+	if *schema != "" {
+		data, err := fixtures.Schema(spec, *schema)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(data)
+		return errors.Wrap(err, "failed to write fixture")
+	}
+
+	all, err := fixtures.All(spec)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(*out, 0o755)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", *out)
+	}
+	for name, data := range all {
+		path := filepath.Join(*out, name+".json")
+		err := os.WriteFile(path, data, 0o600)
+		if err != nil {
+			return errors.Wrapf(err, "failed to write %s", path)
+		}
+	}
+	fmt.Printf("Wrote %d fixtures to %s\n", len(all), *out)
+	return nil
+}
+
+func loadSpec() (*openapi3.T, error) {
+	if *specFile != "" {
+		spec, err := openapi3.NewLoader().LoadFromFile(*specFile)
+		return spec, errors.Wrapf(err, "failed to load %s", *specFile)
+	}
+
+	switch *api {
+	case "network":
+		return network.GetSwagger()
+	case "sitemanager":
+		return sitemanager.GetSwagger()
+	case "protect":
+		return protect.GetSwagger()
+	case "access":
+		return access.GetSwagger()
+	}
+	return nil, errors.Newf("unknown API %q, want network, sitemanager, protect or access", *api)
+}
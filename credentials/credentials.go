@@ -0,0 +1,102 @@
+package credentials
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// DefaultService is the service name under which go-unifi API keys are stored.
+const DefaultService = "go-unifi"
+
+var (
+	// ErrNotFound indicates that no secret is stored for the service and account.
+	ErrNotFound = errors.New("credential not found")
+
+	// ErrUnsupported indicates that the OS keychain is not available on this system.
+	ErrUnsupported = errors.New("keychain not supported")
+
+	// ErrInsecurePermissions indicates that a credentials file is readable by other users.
+	ErrInsecurePermissions = errors.New("credentials file has insecure permissions")
+)
+
+// Store keeps secrets by service and account.
+type Store interface {
+	// Get returns the secret stored for service and account.
+	// Errors for missing secrets match ErrNotFound.
+	Get(ctx context.Context, service, account string) (string, error)
+
+	// Set stores the secret for service and account, replacing any existing one.
+	Set(ctx context.Context, service, account, secret string) error
+
+	// Delete removes the secret for service and account.
+	// Deleting a missing secret is not an error.
+	Delete(ctx context.Context, service, account string) error
+}
+
+// Default returns the OS keychain store if it is available,
+// otherwise a file store at DefaultFilePath.
+//
+//nolint:ireturn // Factory function returning interface is correct DI pattern
+func Default() (Store, error) {
+	if store, err := NewKeychainStore(); err == nil {
+		return store, nil
+	}
+
+	path, err := DefaultFilePath()
+	if err != nil {
+		return nil, err
+	}
+	return NewFileStore(path), nil
+}
+
+// MemoryStore is an in-memory Store, useful in tests.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	secrets map[key]string
+}
+
+type key struct {
+	service string
+	account string
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{secrets: make(map[key]string)}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, service, account string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	secret, ok := s.secrets[key{service, account}]
+	if !ok {
+		return "", notFound(service, account)
+	}
+	return secret, nil
+}
+
+// Set implements Store.
+func (s *MemoryStore) Set(_ context.Context, service, account, secret string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.secrets[key{service, account}] = secret
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(_ context.Context, service, account string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.secrets, key{service, account})
+	return nil
+}
+
+func notFound(service, account string) error {
+	return errors.Wrapf(ErrNotFound, "service %q account %q", service, account)
+}
@@ -0,0 +1,171 @@
+package credentials
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStore(t *testing.T, store Store) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := store.Get(ctx, DefaultService, "home")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Set(ctx, DefaultService, "home", "secret-1"))
+	require.NoError(t, store.Set(ctx, DefaultService, "office", "secret-2"))
+	require.NoError(t, store.Set(ctx, DefaultService, "home", "secret-3"))
+
+	secret, err := store.Get(ctx, DefaultService, "home")
+	require.NoError(t, err)
+	assert.Equal(t, "secret-3", secret)
+
+	require.NoError(t, store.Delete(ctx, DefaultService, "home"))
+	require.NoError(t, store.Delete(ctx, DefaultService, "home"), "deleting a missing secret should succeed")
+
+	_, err = store.Get(ctx, DefaultService, "home")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	secret, err = store.Get(ctx, DefaultService, "office")
+	require.NoError(t, err)
+	assert.Equal(t, "secret-2", secret)
+}
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	testStore(t, NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "credentials.json")
+	store := NewFileStore(path)
+	assert.Equal(t, path, store.Path())
+
+	testStore(t, store)
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	// A new store over the same file sees the persisted secrets.
+	secret, err := NewFileStore(path).Get(context.Background(), DefaultService, "office")
+	require.NoError(t, err)
+	assert.Equal(t, "secret-2", secret)
+}
+
+func TestFileStoreInsecurePermissions(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not checked on Windows")
+	}
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"go-unifi":{"home":"secret"}}`), 0o600))
+	require.NoError(t, os.Chmod(path, 0o644))
+
+	_, err := NewFileStore(path).Get(context.Background(), DefaultService, "home")
+	require.ErrorIs(t, err, ErrInsecurePermissions)
+}
+
+// fakeRunner records commands and answers them from a function.
+type fakeRunner struct {
+	calls  []string
+	stdins []string
+	stderr string
+	answer func(args []string) (string, int)
+}
+
+func (f *fakeRunner) run(_ context.Context, stdin, name string, args ...string) (string, int, error) {
+	f.calls = append(f.calls, name+" "+strings.Join(args, " "))
+	f.stdins = append(f.stdins, stdin)
+	out, code := f.answer(args)
+	if code != 0 {
+		return out, code, &exitError{name: name, stderr: f.stderr}
+	}
+	return out, 0, nil
+}
+
+func TestSecurityStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	runner := &fakeRunner{answer: func(args []string) (string, int) {
+		if args[0] == "find-generic-password" && args[4] == "missing" {
+			return "", securityNotFound
+		}
+		if args[0] == "delete-generic-password" {
+			return "", securityNotFound
+		}
+		return "s3cret\n", 0
+	}}
+	store := &securityStore{run: runner.run}
+
+	secret, err := store.Get(ctx, DefaultService, "home")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", secret)
+
+	_, err = store.Get(ctx, DefaultService, "missing")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Set(ctx, DefaultService, `ho"me`, "s3cret"))
+	assert.Equal(t, "security -i", runner.calls[2])
+	assert.Equal(t, `add-generic-password -U -s "go-unifi" -a "ho\"me" -X 733363726574`+"\n", runner.stdins[2])
+	assert.NotContains(t, runner.calls[2], "s3cret", "secret must not be passed as an argument")
+
+	require.NoError(t, store.Delete(ctx, DefaultService, "home"))
+}
+
+func TestSecretToolStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	runner := &fakeRunner{answer: func(args []string) (string, int) {
+		if args[0] == "lookup" && args[4] == "missing" {
+			return "", 1
+		}
+		return "s3cret", 0
+	}}
+	store := &secretToolStore{run: runner.run}
+
+	secret, err := store.Get(ctx, DefaultService, "home")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", secret)
+
+	_, err = store.Get(ctx, DefaultService, "missing")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, store.Set(ctx, DefaultService, "home", "s3cret"))
+	assert.Equal(t, "secret-tool store --label=go-unifi (home) service go-unifi account home", runner.calls[2])
+	assert.Equal(t, "s3cret", runner.stdins[2])
+
+	require.NoError(t, store.Delete(ctx, DefaultService, "home"))
+	assert.Equal(t, "secret-tool clear service go-unifi account home", runner.calls[3])
+}
+
+func TestSecretToolStoreLockedCollection(t *testing.T) {
+	t.Parallel()
+
+	runner := &fakeRunner{
+		stderr: "Cannot get secret of a locked object",
+		answer: func([]string) (string, int) { return "", 1 },
+	}
+	store := &secretToolStore{run: runner.run}
+
+	_, err := store.Get(context.Background(), DefaultService, "home")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "locked object")
+}
@@ -0,0 +1,25 @@
+// Package credentials stores API keys outside of shell history and plaintext env files.
+//
+// A Store keeps secrets by service and account. Three implementations are provided:
+//
+//   - NewKeychainStore uses the operating system's secret storage: the login
+//     keychain on macOS (via security), the Secret Service on Linux (via
+//     secret-tool from libsecret) and the Credential Manager on Windows.
+//   - NewFileStore keeps secrets in a JSON file readable only by its owner (0600),
+//     as a fallback for headless machines without a keychain.
+//   - NewMemoryStore keeps secrets in memory, for tests.
+//
+// Default picks the keychain when it is available and falls back to the file store:
+//
+//	store, err := credentials.Default()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	err = store.Set(ctx, credentials.DefaultService, "home", apiKey)
+//
+// Clients built with network.NewFromEnv or NewFromConfigFile resolve the API key
+// from the default store when api_key_credential (UNIFI_API_KEY_CREDENTIAL) names
+// the account:
+//
+//	UNIFI_CONTROLLER_URL=https://unifi.local UNIFI_API_KEY_CREDENTIAL=home ./tool
+package credentials
@@ -0,0 +1,161 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+const dirMode = 0o700
+
+// DefaultFilePath returns the default credentials file,
+// go-unifi/credentials.json in the user's configuration directory.
+func DefaultFilePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to locate user config directory")
+	}
+	return filepath.Join(dir, "go-unifi", "credentials.json"), nil
+}
+
+// FileStore keeps secrets in a JSON file readable only by its owner.
+//
+// The file is created with 0600 permissions, and on Unix systems files readable
+// or writable by group or others are rejected with ErrInsecurePermissions.
+// Updates replace the file atomically.
+type FileStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileStore creates a store backed by the file at path.
+// The file and its directory are created on the first Set.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Path returns the path of the credentials file.
+func (s *FileStore) Path() string {
+	return s.path
+}
+
+// Get implements Store.
+func (s *FileStore) Get(_ context.Context, service, account string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets, err := s.load()
+	if err != nil {
+		return "", err
+	}
+	secret, ok := secrets[service][account]
+	if !ok {
+		return "", notFound(service, account)
+	}
+	return secret, nil
+}
+
+// Set implements Store.
+func (s *FileStore) Set(_ context.Context, service, account, secret string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets, err := s.load()
+	if err != nil {
+		return err
+	}
+	if secrets[service] == nil {
+		secrets[service] = make(map[string]string)
+	}
+	secrets[service][account] = secret
+	return s.save(secrets)
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(_ context.Context, service, account string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[service][account]; !ok {
+		return nil
+	}
+	delete(secrets[service], account)
+	if len(secrets[service]) == 0 {
+		delete(secrets, service)
+	}
+	return s.save(secrets)
+}
+
+// load reads the file as service -> account -> secret. A missing file is empty.
+func (s *FileStore) load() (map[string]map[string]string, error) {
+	secrets := make(map[string]map[string]string)
+
+	info, err := os.Stat(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return secrets, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to stat credentials file")
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return nil, errors.Wrapf(ErrInsecurePermissions, "%s has mode %v, expected 0600", s.path, info.Mode().Perm())
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read credentials file")
+	}
+	if len(data) == 0 {
+		return secrets, nil
+	}
+	err = json.Unmarshal(data, &secrets)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse credentials file")
+	}
+	return secrets, nil
+}
+
+func (s *FileStore) save(secrets map[string]map[string]string) error {
+	data, err := json.MarshalIndent(secrets, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode credentials")
+	}
+
+	dir := filepath.Dir(s.path)
+	err = os.MkdirAll(dir, dirMode)
+	if err != nil {
+		return errors.Wrap(err, "failed to create credentials directory")
+	}
+
+	// CreateTemp creates the file with 0600 permissions.
+	tmp, err := os.CreateTemp(dir, ".credentials-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create credentials file")
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // Already renamed on success
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "failed to write credentials file")
+	}
+	err = tmp.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to write credentials file")
+	}
+	err = os.Rename(tmp.Name(), s.path)
+	if err != nil {
+		return errors.Wrap(err, "failed to replace credentials file")
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os/exec"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// runFunc runs a command with stdin and returns its standard output and exit code.
+type runFunc func(ctx context.Context, stdin string, name string, args ...string) (stdout string, exitCode int, err error)
+
+func runCommand(ctx context.Context, stdin, name string, args ...string) (string, int, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return stdout.String(), exitErr.ExitCode(), &exitError{name: name, stderr: strings.TrimSpace(stderr.String())}
+	}
+	if err != nil {
+		return "", -1, errors.Wrapf(err, "failed to run %s", name)
+	}
+	return stdout.String(), 0, nil
+}
+
+// exitError reports a command that exited with a non-zero code, carrying its
+// standard error.
+type exitError struct {
+	name   string
+	stderr string
+}
+
+func (e *exitError) Error() string {
+	return e.name + ": " + e.stderr
+}
+
+// hasStderr reports whether err is an exitError with output on standard error.
+func hasStderr(err error) bool {
+	var exitErr *exitError
+	return errors.As(err, &exitErr) && exitErr.stderr != ""
+}
+
+// securityStore uses the macOS login keychain through the security tool.
+type securityStore struct {
+	run runFunc
+}
+
+// securityNotFound is the exit code of security when no keychain item matches.
+const securityNotFound = 44
+
+func (s *securityStore) Get(ctx context.Context, service, account string) (string, error) {
+	out, code, err := s.run(ctx, "", "security", "find-generic-password", "-s", service, "-a", account, "-w")
+	if code == securityNotFound {
+		return "", notFound(service, account)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read keychain item")
+	}
+	return strings.TrimSuffix(out, "\n"), nil
+}
+
+func (s *securityStore) Set(ctx context.Context, service, account, secret string) error {
+	// Pass the command on stdin in interactive mode, hex-encoded with -X,
+	// so the secret never appears in the process list.
+	command := "add-generic-password -U -s " + securityQuote(service) + " -a " + securityQuote(account) +
+		" -X " + hex.EncodeToString([]byte(secret)) + "\n"
+	_, _, err := s.run(ctx, command, "security", "-i")
+	if err != nil {
+		return errors.Wrap(err, "failed to write keychain item")
+	}
+	return nil
+}
+
+func (s *securityStore) Delete(ctx context.Context, service, account string) error {
+	_, code, err := s.run(ctx, "", "security", "delete-generic-password", "-s", service, "-a", account)
+	if code == securityNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to delete keychain item")
+	}
+	return nil
+}
+
+// securityQuote quotes an argument for the security interactive mode.
+func securityQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// secretToolStore uses the Secret Service (GNOME Keyring, KWallet) through
+// secret-tool from libsecret.
+type secretToolStore struct {
+	run runFunc
+}
+
+func (s *secretToolStore) Get(ctx context.Context, service, account string) (string, error) {
+	out, code, err := s.run(ctx, "", "secret-tool", "lookup", "service", service, "account", account)
+	// secret-tool exits with 1 and no output when nothing matches. A locked
+	// collection or a D-Bus failure also exits with 1, but explains itself on stderr.
+	if code == 1 && out == "" && !hasStderr(err) {
+		return "", notFound(service, account)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read secret")
+	}
+	return out, nil
+}
+
+func (s *secretToolStore) Set(ctx context.Context, service, account, secret string) error {
+	// secret-tool reads the secret from stdin, keeping it out of the process list.
+	_, _, err := s.run(ctx, secret, "secret-tool", "store", "--label="+service+" ("+account+")",
+		"service", service, "account", account)
+	if err != nil {
+		return errors.Wrap(err, "failed to write secret")
+	}
+	return nil
+}
+
+func (s *secretToolStore) Delete(ctx context.Context, service, account string) error {
+	_, code, err := s.run(ctx, "", "secret-tool", "clear", "service", service, "account", account)
+	if code == 1 {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to delete secret")
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package credentials
+
+import (
+	"os/exec"
+
+	"github.com/cockroachdb/errors"
+)
+
+// NewKeychainStore returns a store backed by the macOS login keychain.
+// It returns ErrUnsupported if the security tool is not available.
+//
+//nolint:ireturn // Factory function returning interface is correct DI pattern
+func NewKeychainStore() (Store, error) {
+	_, err := exec.LookPath("security")
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupported, "security tool not found")
+	}
+	return &securityStore{run: runCommand}, nil
+}
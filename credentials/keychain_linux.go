@@ -0,0 +1,23 @@
+package credentials
+
+import (
+	"os"
+	"os/exec"
+
+	"github.com/cockroachdb/errors"
+)
+
+// NewKeychainStore returns a store backed by the Secret Service through secret-tool.
+// It returns ErrUnsupported if secret-tool is not installed or no D-Bus session is available.
+//
+//nolint:ireturn // Factory function returning interface is correct DI pattern
+func NewKeychainStore() (Store, error) {
+	_, err := exec.LookPath("secret-tool")
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupported, "secret-tool not found (install libsecret-tools)")
+	}
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return nil, errors.Wrap(ErrUnsupported, "no D-Bus session")
+	}
+	return &secretToolStore{run: runCommand}, nil
+}
@@ -0,0 +1,10 @@
+//go:build !darwin && !linux && !windows
+
+package credentials
+
+// NewKeychainStore returns ErrUnsupported: this platform has no supported keychain.
+//
+//nolint:ireturn // Factory function returning interface is correct DI pattern
+func NewKeychainStore() (Store, error) {
+	return nil, ErrUnsupported
+}
@@ -0,0 +1,123 @@
+package credentials
+
+import (
+	"context"
+	"syscall"
+	"unsafe"
+
+	"github.com/cockroachdb/errors"
+)
+
+var (
+	advapi32       = syscall.NewLazyDLL("advapi32.dll")
+	procCredReadW  = advapi32.NewProc("CredReadW")
+	procCredWriteW = advapi32.NewProc("CredWriteW")
+	procCredDelete = advapi32.NewProc("CredDeleteW")
+	procCredFree   = advapi32.NewProc("CredFree")
+)
+
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+	errorNotFound           = syscall.Errno(1168)
+)
+
+// credential mirrors the Win32 CREDENTIALW structure.
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// credManStore uses the Windows Credential Manager.
+type credManStore struct{}
+
+// NewKeychainStore returns a store backed by the Windows Credential Manager.
+//
+//nolint:ireturn // Factory function returning interface is correct DI pattern
+func NewKeychainStore() (Store, error) {
+	err := advapi32.Load()
+	if err != nil {
+		return nil, errors.Wrap(ErrUnsupported, err.Error())
+	}
+	return credManStore{}, nil
+}
+
+func targetName(service, account string) (*uint16, error) {
+	target, err := syscall.UTF16PtrFromString(service + ":" + account)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid credential name")
+	}
+	return target, nil
+}
+
+func (credManStore) Get(_ context.Context, service, account string) (string, error) {
+	target, err := targetName(service, account)
+	if err != nil {
+		return "", err
+	}
+
+	var cred *credential
+	ret, _, callErr := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0,
+		uintptr(unsafe.Pointer(&cred)))
+	if ret == 0 {
+		if errors.Is(callErr, errorNotFound) {
+			return "", notFound(service, account)
+		}
+		return "", errors.Wrap(callErr, "failed to read credential")
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred))) //nolint:errcheck // CredFree returns nothing
+
+	return string(unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)), nil
+}
+
+func (credManStore) Set(_ context.Context, service, account, secret string) error {
+	target, err := targetName(service, account)
+	if err != nil {
+		return err
+	}
+	user, err := syscall.UTF16PtrFromString(account)
+	if err != nil {
+		return errors.Wrap(err, "invalid account name")
+	}
+
+	blob := []byte(secret)
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(blob)), //nolint:gosec // Secrets are far below 4 GiB
+		Persist:            credPersistLocalMachine,
+		UserName:           user,
+	}
+	if len(blob) > 0 {
+		cred.CredentialBlob = &blob[0]
+	}
+
+	ret, _, callErr := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if ret == 0 {
+		return errors.Wrap(callErr, "failed to write credential")
+	}
+	return nil
+}
+
+func (credManStore) Delete(_ context.Context, service, account string) error {
+	target, err := targetName(service, account)
+	if err != nil {
+		return err
+	}
+
+	ret, _, callErr := procCredDelete.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0)
+	if ret == 0 && !errors.Is(callErr, errorNotFound) {
+		return errors.Wrap(callErr, "failed to delete credential")
+	}
+	return nil
+}
@@ -93,6 +93,13 @@ func (m *customMetricsRecorder) RecordContextCancellation(operation string) {
 	fmt.Printf("[METRICS] Context cancellation in %s\n", operation)
 }
 
+// RecordConnection implements the optional observability.ConnectionMetricsRecorder,
+// separating connection setup latency from controller response time.
+func (m *customMetricsRecorder) RecordConnection(endpoint string, info observability.ConnectionInfo) {
+	fmt.Printf("[METRICS] Connection for %s: %s reused=%t dns=%v connect=%v tls=%v ttfb=%v\n",
+		endpoint, info.Protocol, info.Reused, info.DNSLookup, info.Connect, info.TLSHandshake, info.TimeToFirstByte)
+}
+
 func (m *customMetricsRecorder) PrintSummary() {
 	fmt.Println("\n=== Metrics Summary ===")
 	fmt.Printf("Total HTTP Requests: %d\n", m.requestCount)
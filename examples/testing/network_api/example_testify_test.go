@@ -51,12 +51,36 @@ func (m *MockNetworkClient) ListSiteDevices(ctx context.Context, siteID network.
 func (m *MockNetworkClient) GetDeviceByID(ctx context.Context, siteID network.SiteId, deviceID network.DeviceId) (*network.Device, error) {
 	return nil, fmt.Errorf("not implemented")
 }
+func (m *MockNetworkClient) GetDeviceNeighbors(ctx context.Context, siteID network.SiteId, deviceID network.DeviceId) ([]network.LLDPNeighbor, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) GetPortStates(ctx context.Context, siteID network.SiteId, deviceID network.DeviceId) ([]network.SwitchPortState, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListRegulatoryChannels(ctx context.Context, site network.Site) ([]network.RegulatoryChannels, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListPendingAdoptionDevices(ctx context.Context, site network.Site) ([]network.LegacyDevice, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) AdoptWithSettings(ctx context.Context, site network.Site, mac string, settings *network.AdoptionSettings) (*network.LegacyDevice, error) {
+	return nil, fmt.Errorf("not implemented")
+}
 func (m *MockNetworkClient) ListSiteClients(ctx context.Context, siteID network.SiteId, params *network.ListSiteClientsParams) (*network.ClientsResponse, error) {
 	return nil, fmt.Errorf("not implemented")
 }
 func (m *MockNetworkClient) GetClientByID(ctx context.Context, siteID network.SiteId, clientID network.ClientId) (*network.NetworkClient, error) {
 	return nil, fmt.Errorf("not implemented")
 }
+func (m *MockNetworkClient) BlockClient(ctx context.Context, site network.Site, mac string) error {
+	return fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) UnblockClient(ctx context.Context, site network.Site, mac string) error {
+	return fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) KickClient(ctx context.Context, site network.Site, mac string) error {
+	return fmt.Errorf("not implemented")
+}
 func (m *MockNetworkClient) ListHotspotVouchers(ctx context.Context, siteID network.SiteId, params *network.ListHotspotVouchersParams) (*network.HotspotVouchersResponse, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -69,6 +93,9 @@ func (m *MockNetworkClient) GetHotspotVoucher(ctx context.Context, siteID networ
 func (m *MockNetworkClient) DeleteHotspotVoucher(ctx context.Context, siteID network.SiteId, voucherID openapi_types.UUID) error {
 	return fmt.Errorf("not implemented")
 }
+func (m *MockNetworkClient) ListGuestAuthorizations(ctx context.Context, site network.Site, request *network.GuestAuthorizationsRequest) ([]network.GuestAuthorization, error) {
+	return nil, fmt.Errorf("not implemented")
+}
 func (m *MockNetworkClient) UpdateDNSRecord(ctx context.Context, site network.Site, recordID network.RecordId, record *network.DNSRecordInput) (*network.DNSRecord, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -84,6 +111,9 @@ func (m *MockNetworkClient) UpdateFirewallPolicy(ctx context.Context, site netwo
 func (m *MockNetworkClient) DeleteFirewallPolicy(ctx context.Context, site network.Site, policyID network.PolicyId) error {
 	return fmt.Errorf("not implemented")
 }
+func (m *MockNetworkClient) ListFirewallZones(ctx context.Context, site network.Site) ([]network.FirewallZone, error) {
+	return nil, fmt.Errorf("not implemented")
+}
 func (m *MockNetworkClient) ListTrafficRules(ctx context.Context, site network.Site) ([]network.TrafficRule, error) {
 	return nil, fmt.Errorf("not implemented")
 }
@@ -96,9 +126,102 @@ func (m *MockNetworkClient) UpdateTrafficRule(ctx context.Context, site network.
 func (m *MockNetworkClient) DeleteTrafficRule(ctx context.Context, site network.Site, ruleID network.RuleId) error {
 	return fmt.Errorf("not implemented")
 }
+func (m *MockNetworkClient) GetDPIApplicationCatalog(ctx context.Context) (*network.DPICatalog, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListUserGroups(ctx context.Context, site network.Site) ([]network.UserGroup, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) CreateUserGroup(ctx context.Context, site network.Site, group *network.UserGroupInput) (*network.UserGroup, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) UpdateUserGroup(ctx context.Context, site network.Site, groupID network.UserGroupId, group *network.UserGroupInput) (*network.UserGroup, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) DeleteUserGroup(ctx context.Context, site network.Site, groupID network.UserGroupId) error {
+	return fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) AssignClientToUserGroup(ctx context.Context, site network.Site, mac string, groupID network.UserGroupId) error {
+	return fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListKnownClients(ctx context.Context, site network.Site) ([]network.KnownClient, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListClientSessions(ctx context.Context, site network.Site, request *network.ClientSessionsRequest) ([]network.ClientSession, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListNetworks(ctx context.Context, site network.Site) ([]network.NetworkConf, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListSiteToSiteVPNs(ctx context.Context, site network.Site) ([]network.SiteToSiteVPN, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) CreateSiteToSiteVPN(ctx context.Context, site network.Site, vpn *network.SiteToSiteVPNInput) (*network.SiteToSiteVPN, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) UpdateSiteToSiteVPN(ctx context.Context, site network.Site, networkID network.NetworkId, vpn *network.SiteToSiteVPNInput) (*network.SiteToSiteVPN, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) DeleteSiteToSiteVPN(ctx context.Context, site network.Site, networkID network.NetworkId) error {
+	return fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) GetDynamicRoutingConfig(ctx context.Context, site network.Site) (*network.DynamicRoutingConfig, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) UpdateDynamicRoutingConfig(ctx context.Context, site network.Site, cfg *network.DynamicRoutingConfig) (*network.DynamicRoutingConfig, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListAPGroups(ctx context.Context, site network.Site) ([]network.APGroup, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) CreateAPGroup(ctx context.Context, site network.Site, group *network.APGroupInput) (*network.APGroup, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) UpdateAPGroup(ctx context.Context, site network.Site, groupID network.APGroupId, group *network.APGroupInput) (*network.APGroup, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) DeleteAPGroup(ctx context.Context, site network.Site, groupID network.APGroupId) error {
+	return fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) AssignDeviceToGroup(ctx context.Context, site network.Site, groupID network.APGroupId, mac string) error {
+	return fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListWLANs(ctx context.Context, site network.Site) ([]network.WLAN, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) GetWLANMACFilter(ctx context.Context, site network.Site, wlanID network.WLANId) (*network.WLANMACFilter, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) UpdateWLANMACFilter(ctx context.Context, site network.Site, wlanID network.WLANId, filter *network.WLANMACFilter) (*network.WLANMACFilter, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) SetWLANClientIsolation(ctx context.Context, site network.Site, wlanID network.WLANId, enabled bool) error {
+	return fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) ListAdminActivityLog(ctx context.Context, site network.Site, request *network.AdminActivityLogRequest) (*network.AdminActivityLogResponse, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) GetControllerStatus(ctx context.Context) (*network.ControllerStatus, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) GenerateSupportFile(ctx context.Context, site network.Site) (*network.SupportFile, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) GetControllerTime(ctx context.Context, site network.Site) (*network.ControllerTime, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) GetControllerCertificate(ctx context.Context) (*network.ControllerCertificate, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+func (m *MockNetworkClient) UpdateControllerCertificate(ctx context.Context, certPEM, keyPEM []byte) (*network.ControllerCertificate, error) {
+	return nil, fmt.Errorf("not implemented")
+}
 func (m *MockNetworkClient) GetAggregatedDashboard(ctx context.Context, site network.Site, params *network.GetAggregatedDashboardParams) (*network.AggregatedDashboard, error) {
 	return nil, fmt.Errorf("not implemented")
 }
+func (m *MockNetworkClient) GetSiteHealth(ctx context.Context, site network.Site) (*network.SiteHealth, error) {
+	return nil, fmt.Errorf("not implemented")
+}
 
 // Example application code that uses the Network API client
 
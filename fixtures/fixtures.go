@@ -0,0 +1,222 @@
+// Package fixtures synthesizes JSON fixtures for the schemas of an OpenAPI spec, such as
+// the specs bundled with the API clients, so that unit tests have realistic responses
+// without fixtures authored by hand for every endpoint.
+//
+// Values come from the spec where it has them: the example of a schema or property, its
+// default, or the first enum value. Everything else is synthesized from the type and
+// format: UUIDs, timestamps, MAC and IP addresses are valid for their kind, and every
+// optional property and array is populated, so that fixtures exercise the whole model.
+// Generation is deterministic: the same spec always yields the same fixtures.
+//
+// Example:
+//
+//	spec, err := network.GetSwagger()
+//	if err != nil {
+//	    return err
+//	}
+//	device, err := fixtures.Schema(spec, "Device") // JSON of a network.Device
+//
+// The unifi-fixtures command writes the fixtures of the bundled specs to files.
+package fixtures
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ErrUnknownSchema is returned by Schema for names not defined in the spec.
+var ErrUnknownSchema = errors.New("unknown schema")
+
+// Synthesized values for string formats and well-known property names.
+const (
+	exampleUUID     = "00000000-0000-4000-8000-000000000001"
+	exampleDateTime = "2025-01-01T00:00:00Z"
+	exampleDate     = "2025-01-01"
+	exampleMAC      = "aa:bb:cc:dd:ee:01"
+	exampleIPv4     = "192.168.1.10"
+	exampleIPv6     = "2001:db8::10"
+	exampleEmail    = "admin@example.com"
+	exampleURI      = "https://example.com"
+	exampleString   = "example"
+	exampleMapKey   = "key"
+)
+
+// All returns the indented JSON fixture of every schema in the components of spec,
+// keyed by schema name.
+func All(spec *openapi3.T) (map[string][]byte, error) {
+	if spec == nil || spec.Components == nil {
+		return map[string][]byte{}, nil
+	}
+	result := make(map[string][]byte, len(spec.Components.Schemas))
+	for _, name := range slices.Sorted(maps.Keys(spec.Components.Schemas)) {
+		data, err := Schema(spec, name)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = data
+	}
+	return result, nil
+}
+
+// Schema returns the indented JSON fixture of the named schema in the components of
+// spec. Unknown names are an error matching ErrUnknownSchema.
+func Schema(spec *openapi3.T, name string) ([]byte, error) {
+	if spec == nil || spec.Components == nil || spec.Components.Schemas[name] == nil {
+		return nil, errors.Wrapf(ErrUnknownSchema, "%q", name)
+	}
+	data, err := json.MarshalIndent(Value(spec.Components.Schemas[name]), "", "  ")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode fixture of %s", name)
+	}
+	return append(data, '\n'), nil
+}
+
+// Value returns a value matching schema, made of the types encoding/json decodes into
+// (maps, slices, strings, float64 and bool), ready to be encoded or validated.
+func Value(schema *openapi3.SchemaRef) any {
+	g := generator{visiting: map[string]bool{}}
+	return g.value(schema, "")
+}
+
+type generator struct {
+	// visiting holds the references being generated, to cut recursive schemas short.
+	visiting map[string]bool
+}
+
+// value generates a value of schema for a property named name, "" outside objects.
+func (g *generator) value(ref *openapi3.SchemaRef, name string) any {
+	if ref == nil || ref.Value == nil {
+		return nil
+	}
+	if ref.Ref != "" {
+		if g.visiting[ref.Ref] {
+			return nil
+		}
+		g.visiting[ref.Ref] = true
+		defer delete(g.visiting, ref.Ref)
+	}
+
+	schema := ref.Value
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case len(schema.AllOf) > 0:
+		return g.allOf(schema, name)
+	case len(schema.OneOf) > 0:
+		return g.value(schema.OneOf[0], name)
+	case len(schema.AnyOf) > 0:
+		return g.value(schema.AnyOf[0], name)
+	}
+
+	switch {
+	case schema.Type.Is(openapi3.TypeObject), schema.Type == nil && len(schema.Properties) > 0:
+		return g.object(schema)
+	case schema.Type.Is(openapi3.TypeArray):
+		item := g.value(schema.Items, name)
+		if item == nil {
+			return []any{}
+		}
+		return []any{item}
+	case schema.Type.Is(openapi3.TypeString):
+		return stringValue(schema.Format, name)
+	case schema.Type.Is(openapi3.TypeInteger):
+		return float64(int64(numberValue(schema, 1)))
+	case schema.Type.Is(openapi3.TypeNumber):
+		return numberValue(schema, 1.5)
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return true
+	}
+	return nil
+}
+
+func (g *generator) object(schema *openapi3.Schema) map[string]any {
+	object := make(map[string]any, len(schema.Properties))
+	for _, property := range slices.Sorted(maps.Keys(schema.Properties)) {
+		value := g.value(schema.Properties[property], property)
+		if value == nil && !slices.Contains(schema.Required, property) {
+			continue
+		}
+		object[property] = value
+	}
+	if additional := schema.AdditionalProperties.Schema; additional != nil {
+		if value := g.value(additional, exampleMapKey); value != nil {
+			object[exampleMapKey] = value
+		}
+	}
+	return object
+}
+
+// allOf merges the objects generated for the parts of schema; the first part that is
+// not an object wins otherwise.
+func (g *generator) allOf(schema *openapi3.Schema, name string) any {
+	merged := map[string]any{}
+	for _, part := range schema.AllOf {
+		value := g.value(part, name)
+		object, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+		maps.Copy(merged, object)
+	}
+	if len(schema.Properties) > 0 {
+		maps.Copy(merged, g.object(schema))
+	}
+	return merged
+}
+
+// stringValue synthesizes a string of the given format, or one fitting a property
+// named name for formats the spec does not declare, such as MAC and IP addresses.
+func stringValue(format, name string) string {
+	switch format {
+	case "uuid":
+		return exampleUUID
+	case "date-time":
+		return exampleDateTime
+	case "date":
+		return exampleDate
+	case "email":
+		return exampleEmail
+	case "uri", "url":
+		return exampleURI
+	case "ipv4":
+		return exampleIPv4
+	case "ipv6":
+		return exampleIPv6
+	}
+
+	lower := strings.ToLower(name)
+	switch {
+	case lower == "mac" || strings.HasSuffix(lower, "mac") || strings.HasSuffix(lower, "macaddress"):
+		return exampleMAC
+	case lower == "ip" || strings.HasSuffix(lower, "ipaddress") || strings.HasSuffix(lower, "_ip"):
+		return exampleIPv4
+	}
+	return exampleString
+}
+
+// numberValue returns fallback within the bounds of schema.
+func numberValue(schema *openapi3.Schema, fallback float64) float64 {
+	value := fallback
+	if schema.Min != nil && value < *schema.Min {
+		value = *schema.Min
+		if schema.ExclusiveMin {
+			value++
+		}
+	}
+	if schema.Max != nil && value > *schema.Max {
+		value = *schema.Max
+		if schema.ExclusiveMax {
+			value--
+		}
+	}
+	return value
+}
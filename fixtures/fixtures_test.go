@@ -0,0 +1,176 @@
+package fixtures_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/access"
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/api/protect"
+	"github.com/lexfrei/go-unifi/api/sitemanager"
+	"github.com/lexfrei/go-unifi/fixtures"
+)
+
+func bundledSpecs(t *testing.T) map[string]*openapi3.T {
+	t.Helper()
+
+	networkSpec, err := network.GetSwagger()
+	require.NoError(t, err)
+	siteManagerSpec, err := sitemanager.GetSwagger()
+	require.NoError(t, err)
+	protectSpec, err := protect.GetSwagger()
+	require.NoError(t, err)
+	accessSpec, err := access.GetSwagger()
+	require.NoError(t, err)
+	return map[string]*openapi3.T{
+		"network": networkSpec, "sitemanager": siteManagerSpec, "protect": protectSpec, "access": accessSpec,
+	}
+}
+
+// unsatisfiable lists the schemas no value can match: response envelopes whose allOf
+// parts declare data both as an object and as an array, and a page envelope requiring
+// a data property it does not define.
+var unsatisfiable = map[string][]string{
+	"network": {"PaginatedResponse"},
+	"sitemanager": {
+		"DevicesResponse", "HostsResponse", "ISPMetricsResponse",
+		"SDWANConfigsResponse", "SitesResponse",
+	},
+}
+
+func TestAllValidateAgainstSchemas(t *testing.T) {
+	t.Parallel()
+
+	for api, spec := range bundledSpecs(t) {
+		all, err := fixtures.All(spec)
+		require.NoError(t, err)
+		require.Len(t, all, len(spec.Components.Schemas), api)
+
+		for name, data := range all {
+			if slices.Contains(unsatisfiable[api], name) {
+				continue
+			}
+			var value any
+			require.NoError(t, json.Unmarshal(data, &value), "%s %s", api, name)
+			err := spec.Components.Schemas[name].Value.VisitJSON(value)
+			assert.NoError(t, err, "%s %s does not match its schema", api, name)
+		}
+	}
+}
+
+// decodeStrict decodes a fixture, failing on fields the Go type does not model.
+func decodeStrict(t *testing.T, data []byte, v any) {
+	t.Helper()
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	require.NoError(t, decoder.Decode(v))
+}
+
+func TestSchemaDecodesIntoModels(t *testing.T) {
+	t.Parallel()
+
+	specs := bundledSpecs(t)
+
+	data, err := fixtures.Schema(specs["network"], "DeviceListItem")
+	require.NoError(t, err)
+	var device network.DeviceListItem
+	decodeStrict(t, data, &device)
+	assert.NotEmpty(t, device.MacAddress)
+	assert.NotZero(t, device.Id)
+
+	data, err = fixtures.Schema(specs["network"], "ClientSession")
+	require.NoError(t, err)
+	var session network.ClientSession
+	decodeStrict(t, data, &session)
+	assert.NotNil(t, session.ESSID, "optional properties are populated")
+
+	data, err = fixtures.Schema(specs["sitemanager"], "Host")
+	require.NoError(t, err)
+	var host sitemanager.Host
+	decodeStrict(t, data, &host)
+	assert.NotEmpty(t, host.Id)
+
+	data, err = fixtures.Schema(specs["protect"], "Camera")
+	require.NoError(t, err)
+	var camera protect.Camera
+	decodeStrict(t, data, &camera)
+	assert.NotEmpty(t, camera.MAC)
+	assert.True(t, camera.State.IsKnown())
+
+	data, err = fixtures.Schema(specs["access"], "Door")
+	require.NoError(t, err)
+	var door access.Door
+	decodeStrict(t, data, &door)
+	assert.NotEmpty(t, door.Id)
+	assert.True(t, door.DoorLockRelayStatus.IsKnown())
+}
+
+func TestSchemaDeterministic(t *testing.T) {
+	t.Parallel()
+
+	spec := bundledSpecs(t)["network"]
+	first, err := fixtures.Schema(spec, "FirewallPolicy")
+	require.NoError(t, err)
+	second, err := fixtures.Schema(spec, "FirewallPolicy")
+	require.NoError(t, err)
+	assert.Equal(t, string(first), string(second))
+
+	_, err = fixtures.Schema(spec, "NoSuchSchema")
+	require.ErrorIs(t, err, fixtures.ErrUnknownSchema)
+}
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.3
+info: {title: test, version: "1"}
+paths: {}
+components:
+  schemas:
+    Node:
+      type: object
+      required: [id, children]
+      properties:
+        id: {type: string, format: uuid}
+        mac: {type: string}
+        apMac: {type: string}
+        ipAddress: {type: string}
+        kind: {type: string, enum: [leaf, branch]}
+        port: {type: integer, minimum: 1024, maximum: 65535}
+        ratio: {type: number, maximum: 1}
+        children:
+          type: array
+          items: {$ref: '#/components/schemas/Node'}
+        labels:
+          type: object
+          additionalProperties: {type: string}
+        name: {type: string, example: core}
+`))
+	require.NoError(t, err)
+
+	leaf := map[string]any{
+		"id":        "00000000-0000-4000-8000-000000000001",
+		"mac":       "aa:bb:cc:dd:ee:01",
+		"apMac":     "aa:bb:cc:dd:ee:01",
+		"ipAddress": "192.168.1.10",
+		"kind":      "leaf",
+		"port":      float64(1024),
+		"ratio":     float64(1),
+		"children":  []any{},
+		"labels":    map[string]any{"key": "example"},
+		"name":      "core",
+	}
+	root := maps.Clone(leaf)
+	root["children"] = []any{leaf}
+	assert.Equal(t, root, fixtures.Value(spec.Components.Schemas["Node"]),
+		"recursive references end in empty arrays")
+}
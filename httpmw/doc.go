@@ -0,0 +1,34 @@
+// Package httpmw exposes the HTTP middleware of the UniFi API clients, so that
+// requests the clients do not cover, e.g. to other Ubiquiti endpoints, get the
+// same retries, rate limiting, logging and metrics.
+//
+// A Middleware wraps an http.RoundTripper. Chain stacks middleware on a base
+// transport, the first one outermost, in the order the API clients use:
+//
+//	transport := httpmw.Chain(nil,
+//	    httpmw.Recover(logger, metrics),
+//	    httpmw.UserAgent("my-tool/1.0"),
+//	    httpmw.RequestID(),
+//	    httpmw.Observability(logger, metrics),
+//	    httpmw.RateLimit(httpmw.RateLimitConfig{Limiter: rate.NewLimiter(rate.Limit(5), 1)}),
+//	    httpmw.Retry(httpmw.RetryConfig{MaxRetries: 3, InitialWait: time.Second, Jitter: 0.2}),
+//	    httpmw.Auth("X-API-KEY", apiKey),
+//	)
+//	httpClient := &http.Client{Transport: transport, Timeout: 30 * time.Second}
+//
+// For chaos testing, Fault placed after Retry injects delays, error responses and
+// connection resets at given rates.
+//
+// # Errors
+//
+// Middleware fail requests with the errors of package unifierr: retries running
+// out of budget with *unifierr.RetryBudgetError, rate limit waits that would end
+// after the context deadline with unifierr.ErrWouldExceedDeadline, and panics with
+// *unifierr.PanicError.
+//
+// # Compatibility
+//
+// The functions and configuration types of this package are stable. The
+// middleware behind them is shared with the API clients and may gain features,
+// exposed here as new configuration fields.
+package httpmw
@@ -0,0 +1,228 @@
+package httpmw
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	unifi "github.com/lexfrei/go-unifi"
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// RequestIDHeader is the header carrying the ID of a request, see RequestID.
+const RequestIDHeader = middleware.RequestIDHeader
+
+// Middleware wraps an http.RoundTripper to add behavior.
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+// Chain wraps base, or http.DefaultTransport if nil, in middleware. The first
+// middleware is the outermost: Chain(base, A, B) sends requests through A, then B,
+// then base.
+func Chain(base http.RoundTripper, middlewares ...Middleware) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		base = middlewares[i](base)
+	}
+	return base
+}
+
+// RetryDecisionFunc is called before every retry with the failed response (nil on
+// network errors), the number of the upcoming retry (starting at 1), and the computed
+// wait time. Returning false returns the failure to the caller immediately.
+type RetryDecisionFunc func(resp *http.Response, attempt int, wait time.Duration) bool
+
+// RetryConfig configures Retry.
+type RetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt
+	MaxRetries int
+
+	// InitialWait is the wait before the first retry, doubled for every further one
+	InitialWait time.Duration
+
+	// MaxWait caps the backoff between retries; Retry-After headers are honored
+	// even above it (optional, 0 means uncapped)
+	MaxWait time.Duration
+
+	// Jitter is the fraction of each wait that is randomized, between 0 and 1
+	// (optional, 0 means fixed waits)
+	Jitter float64
+
+	// Budget, if set, is a token bucket every retry takes a token from; share it
+	// between requests to cap the extra load retries add during an outage (optional)
+	Budget *rate.Limiter
+
+	// OnRetryDecision, if set, is consulted before every retry (optional)
+	OnRetryDecision RetryDecisionFunc
+
+	// DetectMaintenance fails 503 responses announcing maintenance of a UniFi
+	// console with *unifierr.MaintenanceError instead of retrying them (optional)
+	DetectMaintenance bool
+
+	// Logger and Metrics receive retry attempts (optional)
+	Logger  observability.Logger
+	Metrics observability.MetricsRecorder
+}
+
+// Retry returns a middleware retrying network errors, 5xx responses and 429
+// responses with exponential backoff, honoring Retry-After headers. Request bodies
+// are buffered in memory so they can be sent again.
+func Retry(cfg RetryConfig) Middleware {
+	var onDecision middleware.RetryDecisionFunc
+	if cfg.OnRetryDecision != nil {
+		onDecision = middleware.RetryDecisionFunc(cfg.OnRetryDecision)
+	}
+	return middleware.Retry(middleware.RetryConfig{
+		MaxRetries:        cfg.MaxRetries,
+		InitialWait:       cfg.InitialWait,
+		MaxWait:           cfg.MaxWait,
+		Jitter:            cfg.Jitter,
+		Budget:            cfg.Budget,
+		OnRetryDecision:   onDecision,
+		DetectMaintenance: cfg.DetectMaintenance,
+		Logger:            cfg.Logger,
+		Metrics:           cfg.Metrics,
+	})
+}
+
+// RateLimitConfig configures RateLimit.
+type RateLimitConfig struct {
+	// Limiter limits all requests, unless Selector is set
+	Limiter *rate.Limiter
+
+	// Selector chooses the limiter of each request and a name for logs and
+	// metrics (optional)
+	Selector func(*http.Request) (*rate.Limiter, string)
+
+	// WaitPastDeadline waits for the limiter even when the wait ends after the
+	// context deadline, instead of failing with unifierr.ErrWouldExceedDeadline
+	WaitPastDeadline bool
+
+	// Logger and Metrics receive rate limit waits (optional)
+	Logger  observability.Logger
+	Metrics observability.MetricsRecorder
+}
+
+// RateLimit returns a middleware that waits for a rate limiter before sending
+// each request. Without a limiter or selector it passes requests through.
+func RateLimit(cfg RateLimitConfig) Middleware {
+	var selector middleware.RateLimiterSelector
+	if cfg.Selector != nil {
+		selector = middleware.RateLimiterSelector(cfg.Selector)
+	}
+	return middleware.RateLimit(middleware.RateLimitConfig{
+		Limiter:          cfg.Limiter,
+		Selector:         selector,
+		WaitPastDeadline: cfg.WaitPastDeadline,
+		Logger:           cfg.Logger,
+		Metrics:          cfg.Metrics,
+	})
+}
+
+// Observability returns a middleware that logs requests and records their
+// metrics, with paths normalized so that IDs do not inflate metric cardinality.
+// Placed before RateLimit and Retry, it splits request durations into the time
+// spent on the server and the time spent waiting in the client, see
+// observability.LatencyMetricsRecorder. logger and metrics may be nil.
+func Observability(logger observability.Logger, metrics observability.MetricsRecorder) Middleware {
+	return middleware.Observability(logger, metrics, nil)
+}
+
+// Recover returns a middleware that returns panics raised further down the chain
+// as *unifierr.PanicError instead of crashing. Place it first, outermost. logger
+// and metrics may be nil.
+func Recover(logger observability.Logger, metrics observability.MetricsRecorder) Middleware {
+	return middleware.Recover(logger, metrics)
+}
+
+// RequestID returns a middleware that sends a request ID in the X-Request-ID
+// header: the one set with WithRequestID, or a random UUID. Placed before Retry,
+// retries of a request share its ID.
+func RequestID() Middleware {
+	return middleware.RequestID()
+}
+
+// WithRequestID returns a context whose requests are sent with id as their
+// request ID, e.g. to propagate the ID of an incoming request.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return middleware.WithRequestID(ctx, id)
+}
+
+// WithOperation returns a context whose requests are attributed to the named
+// operation in logs and operation metrics.
+func WithOperation(ctx context.Context, name string) context.Context {
+	return middleware.WithOperation(ctx, name)
+}
+
+// UserAgent returns a middleware that sets the User-Agent header to userAgent
+// followed by the go-unifi product token, e.g. "my-tool/1.0 go-unifi/v0.9.0".
+func UserAgent(userAgent string) Middleware {
+	return middleware.UserAgent(userAgent, "go-unifi/"+unifi.Version())
+}
+
+// Auth returns a middleware that sets the header name to value on every request,
+// e.g. Auth("X-API-KEY", apiKey).
+func Auth(name, value string) Middleware {
+	return middleware.Auth(name, value)
+}
+
+// TLSConfig returns a middleware that sets the TLS configuration of the
+// *http.Transport it wraps; any other transport is replaced by a clone of
+// http.DefaultTransport. Place it last.
+func TLSConfig(config *tls.Config) Middleware {
+	return middleware.TLSConfig(config)
+}
+
+// FaultConfig configures Fault. Rates are fractions of requests, from 0 (never)
+// to 1 (always).
+type FaultConfig struct {
+	// LatencyRate is the fraction of requests delayed before being sent
+	LatencyRate float64
+
+	// Latency is the delay of delayed requests, drawn between Latency and twice
+	// Latency
+	Latency time.Duration
+
+	// ErrorRate is the fraction of requests answered with ErrorStatus without
+	// being sent
+	ErrorRate float64
+
+	// ErrorStatus is the status code of injected error responses (optional,
+	// defaults to 503)
+	ErrorStatus int
+
+	// ResetRate is the fraction of requests failed with a connection reset
+	// without being sent
+	ResetRate float64
+
+	// Filter selects the requests faults are injected into (optional, defaults
+	// to all)
+	Filter func(*http.Request) bool
+
+	// Seed makes the injected faults reproducible (optional, defaults to random)
+	Seed uint64
+
+	// Logger receives injected faults at debug level (optional)
+	Logger observability.Logger
+}
+
+// Fault returns a middleware that injects delays, error responses and connection
+// resets at the configured rates, for chaos testing. Place it after Retry, so
+// that injected failures are retried like real ones.
+func Fault(cfg FaultConfig) Middleware {
+	return middleware.Fault(middleware.FaultConfig{
+		LatencyRate: cfg.LatencyRate,
+		Latency:     cfg.Latency,
+		ErrorRate:   cfg.ErrorRate,
+		ErrorStatus: cfg.ErrorStatus,
+		ResetRate:   cfg.ResetRate,
+		Filter:      cfg.Filter,
+		Seed:        cfg.Seed,
+		Logger:      cfg.Logger,
+	})
+}
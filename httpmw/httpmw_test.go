@@ -0,0 +1,144 @@
+package httpmw_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/lexfrei/go-unifi/httpmw"
+	"github.com/lexfrei/go-unifi/internal/testutil"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestChainOrder(t *testing.T) {
+	t.Parallel()
+
+	var order []string
+	tag := func(name string) httpmw.Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	base := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		order = append(order, "base")
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+	})
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://example.com", http.NoBody)
+	require.NoError(t, err)
+	resp, err := httpmw.Chain(base, tag("a"), tag("b")).RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, []string{"a", "b", "base"}, order)
+}
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-API-KEY"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("User-Agent"), "my-tool/1.0 go-unifi/"))
+		assert.Equal(t, "req-1", r.Header.Get(httpmw.RequestIDHeader))
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	defer server.Close()
+
+	transport := httpmw.Chain(nil,
+		httpmw.Recover(nil, nil),
+		httpmw.UserAgent("my-tool/1.0"),
+		httpmw.RequestID(),
+		httpmw.Observability(nil, nil),
+		httpmw.RateLimit(httpmw.RateLimitConfig{Limiter: rate.NewLimiter(rate.Inf, 1)}),
+		httpmw.Retry(httpmw.RetryConfig{MaxRetries: 3, InitialWait: time.Millisecond}),
+		httpmw.Auth("X-API-KEY", "secret"),
+	)
+	client := &http.Client{Transport: transport, Timeout: 5 * time.Second}
+
+	ctx := httpmw.WithRequestID(context.Background(), "req-1")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/status", http.NoBody)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestRetryDecision(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := testutil.NewMockServerWithHandler(t, func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer server.Close()
+
+	transport := httpmw.Chain(nil, httpmw.Retry(httpmw.RetryConfig{
+		MaxRetries:      3,
+		InitialWait:     time.Millisecond,
+		OnRetryDecision: func(_ *http.Response, attempt int, _ time.Duration) bool { return attempt < 2 },
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestRateLimitDeadline(t *testing.T) {
+	t.Parallel()
+
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+	limiter.Allow()
+	transport := httpmw.Chain(nil, httpmw.RateLimit(httpmw.RateLimitConfig{
+		Selector: func(*http.Request) (*rate.Limiter, string) { return limiter, "slow" },
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", http.NoBody)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req) //nolint:bodyclose // No response on error
+	require.ErrorIs(t, err, unifierr.ErrWouldExceedDeadline)
+}
+
+func TestRecover(t *testing.T) {
+	t.Parallel()
+
+	transport := httpmw.Chain(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		panic("boom")
+	}), httpmw.Recover(nil, nil))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://example.com", http.NoBody)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req) //nolint:bodyclose // No response on error
+	require.ErrorIs(t, err, unifierr.ErrPanic)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
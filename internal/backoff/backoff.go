@@ -0,0 +1,101 @@
+// Package backoff computes exponential backoff delays with caps and jitter.
+//
+// It is shared by everything that waits before trying again: the HTTP retry
+// middleware, pollers, and reconnect loops, so they all back off the same way
+// and are configured through the same client settings.
+package backoff
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// DefaultMultiplier is the growth factor applied when Policy.Multiplier is not set.
+const DefaultMultiplier = 2
+
+// Policy describes an exponential backoff: the delay before retry n (starting at 0)
+// is Initial * Multiplier^n, capped at Max, with a random part of Jitter.
+// The zero Policy never waits.
+type Policy struct {
+	// Initial is the delay before the first retry.
+	Initial time.Duration
+
+	// Max caps every delay (optional, 0 means uncapped).
+	Max time.Duration
+
+	// Multiplier is the growth factor between consecutive delays (defaults to DefaultMultiplier).
+	Multiplier float64
+
+	// Jitter is the fraction of each delay that is randomized, from 0 (fixed delays)
+	// to 1 (delays anywhere between 0 and the computed value). Jitter spreads out
+	// clients that failed at the same moment, e.g. after a controller restart.
+	Jitter float64
+}
+
+// Delay returns the wait before retry attempt, counted from 0.
+func (p Policy) Delay(attempt int) time.Duration {
+	if p.Initial <= 0 {
+		return 0
+	}
+
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = DefaultMultiplier
+	}
+
+	delay := float64(p.Initial) * math.Pow(multiplier, float64(max(attempt, 0)))
+	if p.Max > 0 {
+		delay = math.Min(delay, float64(p.Max))
+	}
+	if jitter := math.Min(p.Jitter, 1); jitter > 0 {
+		//nolint:gosec // Jitter does not need a cryptographically secure source
+		delay -= delay * jitter * rand.Float64()
+	}
+	// Guard against overflow for large attempts without a cap.
+	if delay >= math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// Sleep waits for d or until ctx is done, whichever comes first.
+// It returns the context error if ctx ended the wait.
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return errors.WithStack(ctx.Err())
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return errors.WithStack(ctx.Err())
+	}
+}
+
+// Retry calls fn until it succeeds, ctx is done, or maxRetries retries failed,
+// sleeping p.Delay between attempts. A negative maxRetries retries forever, as
+// reconnect loops do. It returns the last error of fn, or the context error
+// if ctx ended a wait.
+func (p Policy) Retry(ctx context.Context, maxRetries int, fn func(ctx context.Context) error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+		if maxRetries >= 0 && attempt >= maxRetries {
+			return err
+		}
+		sleepErr := Sleep(ctx, p.Delay(attempt))
+		if sleepErr != nil {
+			return errors.WithSecondaryError(sleepErr, err)
+		}
+	}
+}
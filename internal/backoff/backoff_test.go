@@ -0,0 +1,114 @@
+package backoff
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelay(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		policy  Policy
+		attempt int
+		want    time.Duration
+	}{
+		{name: "zero policy", policy: Policy{}, attempt: 3, want: 0},
+		{name: "first attempt", policy: Policy{Initial: time.Second}, attempt: 0, want: time.Second},
+		{name: "doubles by default", policy: Policy{Initial: time.Second}, attempt: 3, want: 8 * time.Second},
+		{name: "custom multiplier", policy: Policy{Initial: time.Second, Multiplier: 3}, attempt: 2, want: 9 * time.Second},
+		{name: "capped", policy: Policy{Initial: time.Second, Max: 5 * time.Second}, attempt: 4, want: 5 * time.Second},
+		{name: "negative attempt", policy: Policy{Initial: time.Second}, attempt: -1, want: time.Second},
+		{name: "huge attempt without cap", policy: Policy{Initial: time.Second}, attempt: 1000, want: time.Duration(1<<63 - 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.policy.Delay(tt.attempt))
+		})
+	}
+}
+
+func TestDelayJitter(t *testing.T) {
+	t.Parallel()
+
+	policy := Policy{Initial: time.Second, Max: 4 * time.Second, Jitter: 0.5}
+	for range 100 {
+		delay := policy.Delay(5)
+		assert.GreaterOrEqual(t, delay, 2*time.Second)
+		assert.LessOrEqual(t, delay, 4*time.Second)
+	}
+
+	full := Policy{Initial: time.Second, Jitter: 2}
+	for range 100 {
+		delay := full.Delay(0)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, time.Second)
+	}
+}
+
+func TestSleep(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Sleep(context.Background(), time.Millisecond))
+	require.NoError(t, Sleep(context.Background(), 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, Sleep(ctx, time.Hour), context.Canceled)
+	require.ErrorIs(t, Sleep(ctx, 0), context.Canceled)
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	errFlaky := errors.New("flaky")
+	policy := Policy{Initial: time.Millisecond}
+
+	t.Run("succeeds after failures", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		err := policy.Retry(context.Background(), 3, func(context.Context) error {
+			calls++
+			if calls < 3 {
+				return errFlaky
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		err := policy.Retry(context.Background(), 2, func(context.Context) error {
+			calls++
+			return errFlaky
+		})
+		require.ErrorIs(t, err, errFlaky)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("retries forever until canceled", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		err := policy.Retry(ctx, -1, func(context.Context) error {
+			calls++
+			if calls == 10 {
+				cancel()
+			}
+			return errFlaky
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 10, calls)
+	})
+}
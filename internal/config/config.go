@@ -0,0 +1,367 @@
+// Package config loads flat client settings from configuration files and the environment.
+//
+// Only flat key/value documents are supported: YAML mappings of scalars, TOML files
+// without tables, and JSON objects of scalars. This covers client construction
+// settings without pulling a YAML or TOML library into the module.
+package config
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/credentials"
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// ErrInvalidConfig is returned for malformed configuration files and values.
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// credentialStore opens the store used for *_credential keys; replaced in tests.
+var credentialStore = credentials.Default
+
+// Values holds configuration values keyed by their snake_case name (e.g. "api_key").
+type Values map[string]string
+
+// Load reads a configuration file, choosing the format by extension
+// (.yaml, .yml, .toml or .json).
+func Load(path string) (Values, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Path is supplied by the caller on purpose
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read config file %s", path)
+	}
+
+	var values Values
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		values, err = parseLines(string(data), ":")
+	case ".toml":
+		values, err = parseLines(string(data), "=")
+	case ".json":
+		values, err = parseJSON(data)
+	default:
+		return nil, errors.Wrapf(ErrInvalidConfig, "unsupported config file extension %q", ext)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "config file %s", path)
+	}
+	return values, nil
+}
+
+// FromEnv collects the given keys from environment variables named prefix followed
+// by the upper-cased key, e.g. "api_key" with prefix "UNIFI_" reads UNIFI_API_KEY.
+// Unset and empty variables are skipped.
+func FromEnv(prefix string, keys []string) Values {
+	values := Values{}
+	for _, key := range keys {
+		if value := os.Getenv(prefix + strings.ToUpper(key)); value != "" {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// parseLines parses "key<sep>value" lines, as used by flat YAML and TOML files.
+func parseLines(data, sep string) (Values, error) {
+	values := Values{}
+	for i, line := range strings.Split(data, "\n") {
+		lineNo := i + 1
+		line = strings.TrimRight(stripComment(line), " \t\r")
+		if strings.TrimSpace(line) == "" || line == "---" {
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' || line[0] == '-' {
+			return nil, errors.Wrapf(ErrInvalidConfig, "line %d: nested values are not supported", lineNo)
+		}
+		if strings.HasPrefix(line, "[") {
+			return nil, errors.Wrapf(ErrInvalidConfig, "line %d: tables are not supported", lineNo)
+		}
+
+		key, value, ok := strings.Cut(line, sep)
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, errors.Wrapf(ErrInvalidConfig, "line %d: expected key%svalue", lineNo, sep)
+		}
+
+		value, err := unquote(strings.TrimSpace(value))
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", lineNo)
+		}
+		if _, dup := values[key]; dup {
+			return nil, errors.Wrapf(ErrInvalidConfig, "line %d: duplicate key %q", lineNo, key)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// stripComment removes a trailing "#" comment that is not inside a quoted string.
+func stripComment(line string) string {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func unquote(value string) (string, error) {
+	if len(value) < 2 {
+		return value, nil
+	}
+	switch first, last := value[0], value[len(value)-1]; {
+	case first == '\'' && last == '\'':
+		return value[1 : len(value)-1], nil
+	case first == '"' && last == '"':
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return "", errors.Wrapf(ErrInvalidConfig, "malformed string %s", value)
+		}
+		return unquoted, nil
+	case first == '{' || first == '[':
+		return "", errors.Wrap(ErrInvalidConfig, "nested values are not supported")
+	}
+	return value, nil
+}
+
+func parseJSON(data []byte) (Values, error) {
+	var raw map[string]any
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidConfig, err.Error())
+	}
+
+	values := make(Values, len(raw))
+	for key, value := range raw {
+		switch v := value.(type) {
+		case string:
+			values[key] = v
+		case bool, float64:
+			values[key] = fmt.Sprint(v)
+		case nil:
+		default:
+			return nil, errors.Wrapf(ErrInvalidConfig, "key %q: nested values are not supported", key)
+		}
+	}
+	return values, nil
+}
+
+// CheckKeys returns an error naming the first key not in known, catching typos
+// that would otherwise be ignored silently.
+func (v Values) CheckKeys(known []string) error {
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if !slices.Contains(known, key) {
+			return errors.Wrapf(ErrInvalidConfig, "unknown key %q", key)
+		}
+	}
+	return nil
+}
+
+// String stores the value of key in dst if it is set.
+func (v Values) String(key string, dst *string) {
+	if value, ok := v[key]; ok {
+		*dst = value
+	}
+}
+
+// Bool stores the boolean value of key in dst if it is set.
+func (v Values) Bool(key string, dst *bool) error {
+	value, ok := v[key]
+	if !ok {
+		return nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidConfig, "%s: expected a boolean, got %q", key, value)
+	}
+	*dst = parsed
+	return nil
+}
+
+// Int stores the integer value of key in dst if it is set.
+func (v Values) Int(key string, dst *int) error {
+	value, ok := v[key]
+	if !ok {
+		return nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidConfig, "%s: expected an integer, got %q", key, value)
+	}
+	*dst = parsed
+	return nil
+}
+
+// Float stores the floating-point value of key in dst if it is set.
+func (v Values) Float(key string, dst *float64) error {
+	value, ok := v[key]
+	if !ok {
+		return nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidConfig, "%s: expected a number, got %q", key, value)
+	}
+	*dst = parsed
+	return nil
+}
+
+// Duration stores the duration value of key (e.g. "30s") in dst if it is set.
+func (v Values) Duration(key string, dst *time.Duration) error {
+	value, ok := v[key]
+	if !ok {
+		return nil
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidConfig, "%s: expected a duration, got %q", key, value)
+	}
+	*dst = parsed
+	return nil
+}
+
+// CertPool reads the PEM-encoded certificates from the file named by key, if it is set,
+// into a new pool stored in dst.
+func (v Values) CertPool(key string, dst **x509.CertPool) error {
+	file, ok := v[key]
+	if !ok {
+		return nil
+	}
+	data, err := os.ReadFile(file) //nolint:gosec // Path is supplied by the configuration on purpose
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", key)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return errors.Wrapf(ErrInvalidConfig, "%s: no PEM certificates in %s", key, file)
+	}
+	*dst = pool
+	return nil
+}
+
+// Secret resolves a secret from key itself, from the file named by key+"_file",
+// from the environment variable named by key+"_env", or from the account named by
+// key+"_credential" in the default credentials store. Setting more than one is an error.
+func (v Values) Secret(key string) (string, error) {
+	value, hasValue := v[key]
+	file, hasFile := v[key+"_file"]
+	env, hasEnv := v[key+"_env"]
+	account, hasCredential := v[key+"_credential"]
+
+	set := 0
+	for _, has := range []bool{hasValue, hasFile, hasEnv, hasCredential} {
+		if has {
+			set++
+		}
+	}
+	if set > 1 {
+		return "", errors.Wrapf(ErrInvalidConfig, "only one of %[1]s, %[1]s_file, %[1]s_env and %[1]s_credential may be set", key)
+	}
+
+	switch {
+	case hasFile:
+		data, err := os.ReadFile(file) //nolint:gosec // Path is supplied by the configuration on purpose
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read %s_file", key)
+		}
+		return strings.TrimSpace(string(data)), nil
+	case hasEnv:
+		secret := os.Getenv(env)
+		if secret == "" {
+			return "", errors.Wrapf(ErrInvalidConfig, "%s_env: environment variable %s is not set", key, env)
+		}
+		return secret, nil
+	case hasCredential:
+		store, err := credentialStore()
+		if err != nil {
+			return "", errors.Wrap(err, "failed to open credentials store")
+		}
+		secret, err := store.Get(context.Background(), credentials.DefaultService, account)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read %s_credential", key)
+		}
+		return secret, nil
+	}
+	return value, nil
+}
+
+// StrictMode stores the strict decoding mode of key ("off", "log" or "fail") in dst if it is set.
+func (v Values) StrictMode(key string, dst *response.StrictMode) error {
+	value, ok := v[key]
+	if !ok {
+		return nil
+	}
+	switch strings.ToLower(value) {
+	case "off", "false":
+		*dst = response.StrictOff
+	case "log":
+		*dst = response.StrictLog
+	case "fail", "true":
+		*dst = response.StrictFail
+	default:
+		return errors.Wrapf(ErrInvalidConfig, "%s: expected off, log or fail, got %q", key, value)
+	}
+	return nil
+}
+
+// Logger builds a logger from the log level in key ("debug", "info", "warn", "error" or "off").
+// It returns nil if the key is unset or "off", leaving the client's no-op logger in place.
+//
+//nolint:ireturn // Returns the observability.Logger interface expected by client configs
+func (v Values) Logger(key string) (observability.Logger, error) {
+	value, ok := v[key]
+	if !ok {
+		return nil, nil //nolint:nilnil // No logger configured is not an error
+	}
+
+	level, err := parseLogLevel(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s", key)
+	}
+	if level == nil {
+		return nil, nil //nolint:nilnil // Logging explicitly disabled
+	}
+	return observability.NewSlogLogger(newLevelLogger(*level)), nil
+}
+
+// parseLogLevel returns the slog level for name, or nil for "off".
+func parseLogLevel(name string) (*slog.Level, error) {
+	if strings.EqualFold(name, "off") {
+		return nil, nil
+	}
+	var level slog.Level
+	err := level.UnmarshalText([]byte(name))
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidConfig, "unknown log level %q", name)
+	}
+	return &level, nil
+}
+
+func newLevelLogger(level slog.Level) *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+}
@@ -0,0 +1,274 @@
+package config
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/credentials"
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	want := Values{
+		"controller_url": "https://unifi.local:8443",
+		"api_key":        "key # not a comment",
+		"timeout":        "30s",
+		"max_retries":    "5",
+		"insecure":       "false",
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name: "yaml",
+			file: "config.yaml",
+			content: `---
+# UniFi client
+controller_url: https://unifi.local:8443
+api_key: "key # not a comment"
+timeout: 30s # trailing comment
+max_retries: 5
+
+insecure: false
+`,
+		},
+		{
+			name: "toml",
+			file: "config.toml",
+			content: `# UniFi client
+controller_url = "https://unifi.local:8443"
+api_key = 'key # not a comment'
+timeout = "30s"
+max_retries = 5
+insecure = false
+`,
+		},
+		{
+			name: "json",
+			file: "config.json",
+			content: `{"controller_url": "https://unifi.local:8443", "api_key": "key # not a comment",
+"timeout": "30s", "max_retries": 5, "insecure": false, "unused": null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := Load(writeFile(t, tt.file, tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, want, values)
+		})
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{name: "unsupported extension", file: "config.ini", content: "a=b"},
+		{name: "toml table", file: "config.toml", content: "[network]\na = 1"},
+		{name: "yaml nesting", file: "config.yaml", content: "network:\n  url: x\n"},
+		{name: "missing separator", file: "config.yaml", content: "just text"},
+		{name: "duplicate key", file: "config.toml", content: "a = 1\na = 2"},
+		{name: "inline table", file: "config.toml", content: "a = {b = 1}"},
+		{name: "malformed string", file: "config.toml", content: `a = "\q"`},
+		{name: "json nesting", file: "config.json", content: `{"a": {"b": 1}}`},
+		{name: "invalid json", file: "config.json", content: `{`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Load(writeFile(t, tt.file, tt.content))
+			require.ErrorIs(t, err, ErrInvalidConfig)
+		})
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	t.Parallel()
+
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("TEST_CFG_API_KEY", "secret")
+	t.Setenv("TEST_CFG_TIMEOUT", "")
+
+	values := FromEnv("TEST_CFG_", []string{"api_key", "timeout", "max_retries"})
+	assert.Equal(t, Values{"api_key": "secret"}, values)
+}
+
+func TestTypedValues(t *testing.T) {
+	t.Parallel()
+
+	values := Values{"name": "x", "flag": "true", "count": "3", "wait": "1m", "ratio": "0.25", "strict": "log", "bad": "nope"}
+
+	var (
+		name   string
+		flag   bool
+		count  int
+		wait   time.Duration
+		ratio  float64
+		strict response.StrictMode
+	)
+	values.String("name", &name)
+	require.NoError(t, values.Bool("flag", &flag))
+	require.NoError(t, values.Int("count", &count))
+	require.NoError(t, values.Duration("wait", &wait))
+	require.NoError(t, values.Float("ratio", &ratio))
+	require.NoError(t, values.StrictMode("strict", &strict))
+
+	assert.Equal(t, "x", name)
+	assert.True(t, flag)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, time.Minute, wait)
+	assert.InDelta(t, 0.25, ratio, 0)
+	assert.Equal(t, response.StrictLog, strict)
+
+	unset := 7
+	require.NoError(t, values.Int("missing", &unset))
+	assert.Equal(t, 7, unset, "unset keys should leave the destination untouched")
+
+	require.ErrorIs(t, values.Bool("bad", &flag), ErrInvalidConfig)
+	require.ErrorIs(t, values.Int("bad", &count), ErrInvalidConfig)
+	require.ErrorIs(t, values.Duration("bad", &wait), ErrInvalidConfig)
+	require.ErrorIs(t, values.Float("bad", &ratio), ErrInvalidConfig)
+	require.ErrorIs(t, values.StrictMode("bad", &strict), ErrInvalidConfig)
+}
+
+func TestCertPool(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(nil)
+	defer server.Close()
+	certFile := writeFile(t, "ca.pem", string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	})))
+	notPEM := writeFile(t, "ca.txt", "not a certificate")
+
+	var pool *x509.CertPool
+	require.NoError(t, Values{}.CertPool("ca_cert_file", &pool))
+	assert.Nil(t, pool, "unset keys should leave the destination untouched")
+
+	require.NoError(t, Values{"ca_cert_file": certFile}.CertPool("ca_cert_file", &pool))
+	require.NotNil(t, pool)
+	_, err := server.Certificate().Verify(x509.VerifyOptions{Roots: pool})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, Values{"ca_cert_file": notPEM}.CertPool("ca_cert_file", &pool), ErrInvalidConfig)
+	require.Error(t, Values{"ca_cert_file": certFile + ".missing"}.CertPool("ca_cert_file", &pool))
+}
+
+func TestCheckKeys(t *testing.T) {
+	t.Parallel()
+
+	values := Values{"api_key": "x", "api_kye": "y"}
+	require.NoError(t, values.CheckKeys([]string{"api_key", "api_kye"}))
+
+	err := values.CheckKeys([]string{"api_key"})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	assert.Contains(t, err.Error(), "api_kye")
+}
+
+func TestSecret(t *testing.T) {
+	t.Setenv("TEST_CFG_SECRET", "from-env")
+	keyFile := writeFile(t, "key", "from-file\n")
+
+	store := credentials.NewMemoryStore()
+	require.NoError(t, store.Set(context.Background(), credentials.DefaultService, "home", "from-store"))
+	credentialStore = func() (credentials.Store, error) { return store, nil }
+	t.Cleanup(func() { credentialStore = credentials.Default })
+
+	tests := []struct {
+		name    string
+		values  Values
+		want    string
+		wantErr bool
+	}{
+		{name: "inline", values: Values{"api_key": "inline"}, want: "inline"},
+		{name: "file", values: Values{"api_key_file": keyFile}, want: "from-file"},
+		{name: "env", values: Values{"api_key_env": "TEST_CFG_SECRET"}, want: "from-env"},
+		{name: "credential", values: Values{"api_key_credential": "home"}, want: "from-store"},
+		{name: "unset", values: Values{}, want: ""},
+		{name: "missing credential", values: Values{"api_key_credential": "office"}, wantErr: true},
+		{name: "unset env", values: Values{"api_key_env": "TEST_CFG_UNSET"}, wantErr: true},
+		{name: "missing file", values: Values{"api_key_file": keyFile + ".missing"}, wantErr: true},
+		{name: "ambiguous", values: Values{"api_key": "a", "api_key_env": "TEST_CFG_SECRET"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, err := tt.values.Secret("api_key")
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, secret)
+		})
+	}
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		level   string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "debug", level: "debug"},
+		{name: "warn", level: "WARN"},
+		{name: "off", level: "off", wantNil: true},
+		{name: "invalid", level: "loud", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger, err := Values{"log_level": tt.level}.Logger("log_level")
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidConfig)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNil, logger == nil)
+		})
+	}
+
+	logger, err := Values{}.Logger("log_level")
+	require.NoError(t, err)
+	assert.Nil(t, logger)
+}
@@ -0,0 +1,145 @@
+// Command enumgen generates the IsKnown and Raw methods of the enum types declared by
+// oapi-codegen in generated.go, into enums_gen.go of the same package.
+//
+// oapi-codegen declares enums as named string (or number) types with one constant per
+// value of the spec. Values that newer controllers add decode as is; IsKnown tells them
+// apart from the values the client was generated with, and Raw returns them as text.
+//
+// Run it with go generate after regenerating the client:
+//
+//	//go:generate go run ../../internal/enumgen
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	input  = "generated.go"
+	output = "enums_gen.go"
+)
+
+func main() {
+	source, err := os.ReadFile(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	code, err := generate(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile(output, code, 0o600)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// enum is a named type with the constants declared for it.
+type enum struct {
+	name       string
+	underlying string
+	values     []string
+}
+
+// generate returns the source of the enum methods for the generated code in source.
+func generate(source []byte) ([]byte, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), input, source, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", input)
+	}
+
+	enums := collectEnums(file)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by enumgen from %s. DO NOT EDIT.\n\npackage %s\n\n", input, file.Name.Name)
+	if slices.ContainsFunc(enums, func(e *enum) bool { return e.underlying != "string" }) {
+		buf.WriteString("import \"strconv\"\n\n")
+	}
+	for _, e := range enums {
+		writeEnum(&buf, e)
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to format generated code")
+	}
+	return code, nil
+}
+
+// collectEnums returns the types of file with constants of their own type, sorted by name.
+func collectEnums(file *ast.File) []*enum {
+	basics := map[string]string{}
+	byName := map[string]*enum{}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			switch spec := spec.(type) {
+			case *ast.TypeSpec:
+				if ident, ok := spec.Type.(*ast.Ident); ok && !spec.Assign.IsValid() {
+					basics[spec.Name.Name] = ident.Name
+				}
+			case *ast.ValueSpec:
+				typ, ok := spec.Type.(*ast.Ident)
+				if gen.Tok != token.CONST || !ok {
+					continue
+				}
+				e := byName[typ.Name]
+				if e == nil {
+					e = &enum{name: typ.Name}
+					byName[typ.Name] = e
+				}
+				for _, name := range spec.Names {
+					e.values = append(e.values, name.Name)
+				}
+			}
+		}
+	}
+
+	var enums []*enum
+	for name, e := range byName {
+		underlying, ok := basics[name]
+		if !ok {
+			continue
+		}
+		e.underlying = underlying
+		enums = append(enums, e)
+	}
+	slices.SortFunc(enums, func(a, b *enum) int { return strings.Compare(a.name, b.name) })
+	return enums
+}
+
+func writeEnum(buf *bytes.Buffer, e *enum) {
+	fmt.Fprintf(buf, "// IsKnown reports whether e is one of the values of %s defined in the API\n", e.name)
+	buf.WriteString("// specification. Values added by newer versions of the API are not known.\n")
+	fmt.Fprintf(buf, "func (e %s) IsKnown() bool {\n\tswitch e {\n\tcase %s:\n\t\treturn true\n\t}\n\treturn false\n}\n\n",
+		e.name, strings.Join(e.values, ", "))
+
+	buf.WriteString("// Raw returns the value as received from the API.\n")
+	fmt.Fprintf(buf, "func (e %s) Raw() string {\n\treturn %s\n}\n\n", e.name, rawExpr(e.underlying))
+}
+
+// rawExpr returns the expression formatting a value of the underlying type as text.
+func rawExpr(underlying string) string {
+	switch underlying {
+	case "string":
+		return "string(e)"
+	case "float32":
+		return "strconv.FormatFloat(float64(e), 'f', -1, 32)"
+	case "float64":
+		return "strconv.FormatFloat(float64(e), 'f', -1, 64)"
+	}
+	return "strconv.FormatInt(int64(e), 10)"
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedUpToDate(t *testing.T) {
+	t.Parallel()
+
+	for _, pkg := range []string{"network", "sitemanager"} {
+		dir := filepath.Join("..", "..", "api", pkg)
+		source, err := os.ReadFile(filepath.Join(dir, input))
+		require.NoError(t, err)
+		want, err := os.ReadFile(filepath.Join(dir, output))
+		require.NoError(t, err)
+
+		got, err := generate(source)
+		require.NoError(t, err)
+		assert.Equal(t, string(want), string(got), "%s/%s is stale: run go generate ./api/%s", pkg, output, pkg)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	got, err := generate([]byte(`package demo
+
+type Mode string
+
+// Defines values for Mode.
+const (
+	ModeAuto Mode = "auto"
+	ModeOff  Mode = "off"
+)
+
+type Width int
+
+const (
+	W20 Width = 20
+)
+
+type Name = string
+
+type Plain string
+`))
+	require.NoError(t, err)
+
+	code := string(got)
+	assert.Contains(t, code, "package demo")
+	assert.Contains(t, code, "import \"strconv\"")
+	assert.Contains(t, code, "func (e Mode) IsKnown() bool {\n\tswitch e {\n\tcase ModeAuto, ModeOff:")
+	assert.Contains(t, code, "func (e Width) Raw() string {\n\treturn strconv.FormatInt(int64(e), 10)")
+	assert.NotContains(t, code, "Plain", "types without values are not enums")
+	assert.NotContains(t, code, "Name")
+}
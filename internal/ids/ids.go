@@ -0,0 +1,57 @@
+// Package ids recognizes the identifier formats used by the UniFi APIs, so that clients
+// can reject IDs of the wrong kind with a descriptive error instead of sending requests
+// the controller answers with an opaque 400.
+package ids
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// IsUUID reports whether s is a UUID in its canonical, hyphenated form, as used by
+// the Integration API and Site Manager IDs.
+func IsUUID(s string) bool {
+	if len(s) != len("00000000-0000-0000-0000-000000000000") {
+		return false
+	}
+	_, err := uuid.Parse(s)
+	return err == nil
+}
+
+// IsMAC reports whether s is a 48-bit MAC address in any format accepted by net.ParseMAC.
+func IsMAC(s string) bool {
+	hw, err := net.ParseMAC(s)
+	return err == nil && len(hw) == 6
+}
+
+// IsObjectID reports whether s is a 24-digit hexadecimal object ID, as the legacy
+// Network API uses for records such as DNS records, firewall policies and WLANs.
+func IsObjectID(s string) bool {
+	if len(s) != 24 {
+		return false
+	}
+	for _, r := range s {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// Describe names the format of s for error messages, e.g. `MAC address "aa:bb:cc:dd:ee:ff"`.
+func Describe(s string) string {
+	switch {
+	case s == "":
+		return "an empty string"
+	case IsMAC(s):
+		return "MAC address " + strconv.Quote(s)
+	case IsUUID(s):
+		return "UUID " + strconv.Quote(s)
+	case IsObjectID(s):
+		return "object ID " + strconv.Quote(s)
+	default:
+		return strconv.Quote(s)
+	}
+}
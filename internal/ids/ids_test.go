@@ -0,0 +1,37 @@
+package ids_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lexfrei/go-unifi/internal/ids"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: "", want: "an empty string"},
+		{id: "aa:bb:cc:dd:ee:ff", want: `MAC address "aa:bb:cc:dd:ee:ff"`},
+		{id: "AABB.CCDD.EEFF", want: `MAC address "AABB.CCDD.EEFF"`},
+		{id: "6204b587-7215-235b-d068-f96ca12eab52", want: `UUID "6204b587-7215-235b-d068-f96ca12eab52"`},
+		{id: "5f8a1b2c3d4e5f6a7b8c9d01", want: `object ID "5f8a1b2c3d4e5f6a7b8c9d01"`},
+		{id: "942A6F00301100000000074A6BA90000000007A3387E0000000063EC9853:123456789", want: `"942A6F00301100000000074A6BA90000000007A3387E0000000063EC9853:123456789"`},
+		{id: "default", want: `"default"`},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ids.Describe(tt.id), tt.id)
+	}
+}
+
+func TestIsMAC(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ids.IsMAC("aa-bb-cc-dd-ee-ff"))
+	assert.False(t, ids.IsMAC("00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01"), "only 48-bit addresses")
+	assert.False(t, ids.IsUUID("urn:uuid:6204b587-7215-235b-d068-f96ca12eab52"), "only the canonical form")
+}
@@ -0,0 +1,244 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// DefaultCacheMaxEntries is the default maximum number of cached responses.
+const DefaultCacheMaxEntries = 1000
+
+// CacheInvalidator returns a predicate matching the paths of cached GET responses
+// made stale by a successful mutating request. Returning nil invalidates the whole cache.
+type CacheInvalidator func(mutation *http.Request) (stale func(path string) bool)
+
+// CacheConfig configures the response cache middleware.
+type CacheConfig struct {
+	TTL        time.Duration    // How long GET responses are served from the cache
+	MaxEntries int              // Optional: maximum cached responses (defaults to DefaultCacheMaxEntries)
+	Invalidate CacheInvalidator // Optional: resource-aware invalidation (nil invalidates everything)
+	Logger     observability.Logger
+}
+
+// ResponseCache caches successful GET responses and invalidates them when
+// mutating requests (POST, PUT, PATCH, DELETE) succeed.
+//
+// A GET that was in flight while an invalidation happened is not cached, so
+// callers never read data older than their own completed writes.
+type ResponseCache struct {
+	ttl        time.Duration
+	maxEntries int
+	invalidate CacheInvalidator
+	logger     observability.Logger
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	// generation increments on every invalidation.
+	generation uint64
+}
+
+type cacheEntry struct {
+	path    string
+	status  int
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+// NewResponseCache creates a response cache.
+func NewResponseCache(cfg CacheConfig) *ResponseCache {
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultCacheMaxEntries
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = observability.NoopLogger()
+	}
+
+	return &ResponseCache{
+		ttl:        cfg.TTL,
+		maxEntries: cfg.MaxEntries,
+		invalidate: cfg.Invalidate,
+		logger:     cfg.Logger,
+		entries:    make(map[string]*cacheEntry),
+	}
+}
+
+// Middleware returns the middleware serving and populating the cache.
+func (c *ResponseCache) Middleware() func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &cacheTransport{next: next, cache: c}
+	}
+}
+
+// Purge removes all cached responses.
+func (c *ResponseCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.purgeLocked(nil)
+}
+
+// Len returns the number of cached responses, including expired ones not yet evicted.
+func (c *ResponseCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+func (c *ResponseCache) purgeLocked(stale func(path string) bool) {
+	c.generation++
+	for key, entry := range c.entries {
+		if stale == nil || stale(entry.path) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *ResponseCache) get(key string) (*cacheEntry, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if ok && time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		entry = nil
+	}
+	return entry, c.generation
+}
+
+func (c *ResponseCache) store(key string, generation uint64, entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// An invalidation happened while the request was in flight; its result may be stale.
+	if generation != c.generation {
+		return
+	}
+
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evictLocked()
+	}
+	c.entries[key] = entry
+}
+
+// evictLocked removes expired entries, or the entry closest to expiry if none expired.
+func (c *ResponseCache) evictLocked() {
+	now := time.Now()
+	var oldestKey string
+	var oldest time.Time
+	for key, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldestKey == "" || entry.expires.Before(oldest) {
+			oldestKey, oldest = key, entry.expires
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldestKey)
+	}
+}
+
+func (c *ResponseCache) invalidateFor(req *http.Request) {
+	var stale func(path string) bool
+	if c.invalidate != nil {
+		stale = c.invalidate(req)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.purgeLocked(stale)
+}
+
+type bypassCacheKey struct{}
+
+// WithoutCache returns a context whose GET requests are neither served from nor stored
+// in the response cache, e.g. for large binary downloads that must stream.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+type cacheTransport struct {
+	next  http.RoundTripper
+	cache *ResponseCache
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet:
+		return t.roundTripGet(req)
+	case http.MethodHead, http.MethodOptions:
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return t.next.RoundTrip(req)
+	}
+
+	// Transport errors leave it unknown whether the mutation was applied, so invalidate too.
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest {
+		t.cache.invalidateFor(req)
+	}
+	//nolint:wrapcheck // Middleware passes through errors from next transport
+	return resp, err
+}
+
+func (t *cacheTransport) roundTripGet(req *http.Request) (*http.Response, error) {
+	if bypass, _ := req.Context().Value(bypassCacheKey{}).(bool); bypass { //nolint:errcheck // Absent flag means false
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return t.next.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+
+	entry, generation := t.cache.get(key)
+	if entry != nil {
+		t.cache.logger.Debug("serving cached response", observability.Field{Key: "path", Value: req.URL.Path})
+		return entry.response(req), nil
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.cache.store(key, generation, &cacheEntry{
+		path:    req.URL.Path,
+		status:  resp.StatusCode,
+		header:  resp.Header.Clone(),
+		body:    body,
+		expires: time.Now().Add(t.cache.ttl),
+	})
+	return resp, nil
+}
+
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.status, http.StatusText(e.status)),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
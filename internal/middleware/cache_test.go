@@ -0,0 +1,189 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingTransport answers every request with 200 and counts requests per method.
+type countingTransport struct {
+	gets      atomic.Int32
+	mutations atomic.Int32
+	status    int
+	onGet     func()
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet {
+		t.gets.Add(1)
+		if t.onGet != nil {
+			t.onGet()
+		}
+	} else {
+		t.mutations.Add(1)
+	}
+	status := t.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{"path":"` + req.URL.RequestURI() + `"}`)),
+		Request:    req,
+	}, nil
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper, method, path string) *http.Response {
+	t.Helper()
+	req := httptest.NewRequest(method, "https://unifi.local"+path, nil)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"path":"`+path+`"}`, string(body))
+	return resp
+}
+
+func TestResponseCache(t *testing.T) {
+	t.Parallel()
+
+	next := &countingTransport{}
+	cache := NewResponseCache(CacheConfig{TTL: time.Minute})
+	rt := cache.Middleware()(next)
+
+	resp := doRequest(t, rt, http.MethodGet, "/dns")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = doRequest(t, rt, http.MethodGet, "/dns")
+	assert.Equal(t, "200 OK", resp.Status)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, int32(1), next.gets.Load(), "second GET should be served from the cache")
+
+	doRequest(t, rt, http.MethodGet, "/dns?limit=1")
+	assert.Equal(t, int32(2), next.gets.Load(), "queries are part of the cache key")
+	assert.Equal(t, 2, cache.Len())
+
+	doRequest(t, rt, http.MethodPost, "/dns")
+	assert.Equal(t, 0, cache.Len(), "mutations without an invalidator purge everything")
+
+	doRequest(t, rt, http.MethodGet, "/dns")
+	assert.Equal(t, int32(3), next.gets.Load())
+
+	cache.Purge()
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestResponseCacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	next := &countingTransport{}
+	rt := NewResponseCache(CacheConfig{TTL: time.Nanosecond}).Middleware()(next)
+
+	doRequest(t, rt, http.MethodGet, "/dns")
+	time.Sleep(time.Millisecond)
+	doRequest(t, rt, http.MethodGet, "/dns")
+	assert.Equal(t, int32(2), next.gets.Load())
+}
+
+func TestResponseCacheSkipsErrors(t *testing.T) {
+	t.Parallel()
+
+	next := &countingTransport{status: http.StatusInternalServerError}
+	cache := NewResponseCache(CacheConfig{TTL: time.Minute})
+	rt := cache.Middleware()(next)
+
+	doRequest(t, rt, http.MethodGet, "/dns")
+	doRequest(t, rt, http.MethodGet, "/dns")
+	assert.Equal(t, int32(2), next.gets.Load())
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestResponseCacheWithoutCache(t *testing.T) {
+	t.Parallel()
+
+	next := &countingTransport{}
+	cache := NewResponseCache(CacheConfig{TTL: time.Minute})
+	rt := cache.Middleware()(next)
+
+	doRequest(t, rt, http.MethodGet, "/backup")
+	for range 2 {
+		req := httptest.NewRequestWithContext(WithoutCache(context.Background()), http.MethodGet, "https://unifi.local/backup", nil)
+		resp, err := rt.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, int32(3), next.gets.Load(), "bypassing requests should not be served from the cache")
+	assert.Equal(t, 1, cache.Len(), "bypassing requests should not be stored")
+}
+
+func TestResponseCacheSelectiveInvalidation(t *testing.T) {
+	t.Parallel()
+
+	next := &countingTransport{}
+	cache := NewResponseCache(CacheConfig{
+		TTL: time.Minute,
+		Invalidate: func(mutation *http.Request) func(string) bool {
+			return func(path string) bool { return strings.HasPrefix(path, mutation.URL.Path) }
+		},
+	})
+	rt := cache.Middleware()(next)
+
+	doRequest(t, rt, http.MethodGet, "/dns")
+	doRequest(t, rt, http.MethodGet, "/firewall")
+	doRequest(t, rt, http.MethodDelete, "/dns")
+	assert.Equal(t, 1, cache.Len())
+
+	doRequest(t, rt, http.MethodGet, "/firewall")
+	assert.Equal(t, int32(2), next.gets.Load(), "unrelated entries should stay cached")
+}
+
+func TestResponseCacheFailedMutationKeepsEntries(t *testing.T) {
+	t.Parallel()
+
+	next := &countingTransport{}
+	cache := NewResponseCache(CacheConfig{TTL: time.Minute})
+	rt := cache.Middleware()(next)
+
+	doRequest(t, rt, http.MethodGet, "/dns")
+	next.status = http.StatusBadRequest
+	doRequest(t, rt, http.MethodPost, "/dns")
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestResponseCacheInFlightInvalidation(t *testing.T) {
+	t.Parallel()
+
+	cache := NewResponseCache(CacheConfig{TTL: time.Minute})
+	next := &countingTransport{}
+	// A mutation completes while the GET is in flight.
+	next.onGet = cache.Purge
+	rt := cache.Middleware()(next)
+
+	doRequest(t, rt, http.MethodGet, "/dns")
+	assert.Equal(t, 0, cache.Len(), "responses racing an invalidation must not be cached")
+}
+
+func TestResponseCacheEviction(t *testing.T) {
+	t.Parallel()
+
+	next := &countingTransport{}
+	cache := NewResponseCache(CacheConfig{TTL: time.Minute, MaxEntries: 2})
+	rt := cache.Middleware()(next)
+
+	doRequest(t, rt, http.MethodGet, "/a")
+	doRequest(t, rt, http.MethodGet, "/b")
+	doRequest(t, rt, http.MethodGet, "/c")
+	assert.Equal(t, 2, cache.Len())
+
+	doRequest(t, rt, http.MethodGet, "/c")
+	assert.Equal(t, int32(3), next.gets.Load(), "newest entry should survive eviction")
+}
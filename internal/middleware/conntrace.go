@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// connTrace collects connection setup details of a request through httptrace.
+// Hooks may run on transport goroutines, so all fields are guarded by mu.
+type connTrace struct {
+	mu sync.Mutex
+
+	gotConn bool
+	info    observability.ConnectionInfo
+
+	dnsStart     time.Time
+	connectStart time.Time
+	tlsStart     time.Time
+	connObtained time.Time
+}
+
+// withConnTrace returns req with a client trace recording into a new connTrace.
+// Traces already attached to the request context keep receiving events.
+func withConnTrace(req *http.Request) (*http.Request, *connTrace) {
+	trace := &connTrace{}
+	ctx := httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			trace.mu.Lock()
+			defer trace.mu.Unlock()
+			trace.dnsStart = time.Now()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			trace.mu.Lock()
+			defer trace.mu.Unlock()
+			trace.info.DNSLookup = since(trace.dnsStart)
+		},
+		ConnectStart: func(string, string) {
+			trace.mu.Lock()
+			defer trace.mu.Unlock()
+			trace.connectStart = time.Now()
+		},
+		ConnectDone: func(string, string, error) {
+			trace.mu.Lock()
+			defer trace.mu.Unlock()
+			trace.info.Connect = since(trace.connectStart)
+		},
+		TLSHandshakeStart: func() {
+			trace.mu.Lock()
+			defer trace.mu.Unlock()
+			trace.tlsStart = time.Now()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			trace.mu.Lock()
+			defer trace.mu.Unlock()
+			trace.info.TLSHandshake = since(trace.tlsStart)
+		},
+		GotConn: func(conn httptrace.GotConnInfo) {
+			trace.mu.Lock()
+			defer trace.mu.Unlock()
+			trace.gotConn = true
+			trace.connObtained = time.Now()
+			trace.info.Reused = conn.Reused
+			trace.info.IdleTime = conn.IdleTime
+			if conn.Reused {
+				// A retry may reuse the connection set up by an earlier attempt
+				trace.info.DNSLookup, trace.info.Connect, trace.info.TLSHandshake = 0, 0, 0
+			}
+		},
+		GotFirstResponseByte: func() {
+			trace.mu.Lock()
+			defer trace.mu.Unlock()
+			trace.info.TimeToFirstByte = since(trace.connObtained)
+		},
+	})
+	return req.WithContext(ctx), trace
+}
+
+// result returns the connection details and whether a connection was obtained at all;
+// responses served without one, e.g. from the cache, have no connection details.
+func (t *connTrace) result(resp *http.Response) (observability.ConnectionInfo, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	info := t.info
+	if resp != nil {
+		info.Protocol = resp.Proto
+	}
+	return info, t.gotConn
+}
+
+// connFields returns connection details as log fields.
+func connFields(info observability.ConnectionInfo) []observability.Field {
+	fields := []observability.Field{
+		{Key: "proto", Value: info.Protocol},
+		{Key: "conn_reused", Value: info.Reused},
+		{Key: "ttfb", Value: info.TimeToFirstByte},
+	}
+	if info.Reused {
+		return append(fields, observability.Field{Key: "conn_idle", Value: info.IdleTime})
+	}
+	return append(fields,
+		observability.Field{Key: "dns", Value: info.DNSLookup},
+		observability.Field{Key: "connect", Value: info.Connect},
+		observability.Field{Key: "tls_handshake", Value: info.TLSHandshake},
+	)
+}
+
+func since(start time.Time) time.Duration {
+	if start.IsZero() {
+		return 0
+	}
+	return time.Since(start)
+}
@@ -0,0 +1,70 @@
+package middleware_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connRecorder records connection details on top of the noop recorder.
+type connRecorder struct {
+	observability.MetricsRecorder
+
+	mu          sync.Mutex
+	endpoints   []string
+	connections []observability.ConnectionInfo
+}
+
+func (r *connRecorder) RecordConnection(endpoint string, info observability.ConnectionInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.endpoints = append(r.endpoints, endpoint)
+	r.connections = append(r.connections, info)
+}
+
+func TestObservabilityConnectionInfo(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	recorder := &connRecorder{MetricsRecorder: observability.NoopMetricsRecorder()}
+	transport := middleware.Observability(nil, recorder, nil)(server.Client().Transport)
+
+	for range 2 {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet,
+			server.URL+"/api/site/default/device/507f1f77bcf86cd799439011", http.NoBody)
+		require.NoError(t, err)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	require.Len(t, recorder.connections, 2)
+	assert.Equal(t, []string{"/api/site/:site/device/:id", "/api/site/:site/device/:id"}, recorder.endpoints)
+
+	first, second := recorder.connections[0], recorder.connections[1]
+	assert.Equal(t, "HTTP/2.0", first.Protocol)
+	assert.False(t, first.Reused)
+	assert.Positive(t, first.TLSHandshake)
+	assert.Positive(t, first.Connect)
+
+	assert.Equal(t, "HTTP/2.0", second.Protocol)
+	assert.True(t, second.Reused, "the second request should reuse the connection")
+	assert.Zero(t, second.TLSHandshake)
+}
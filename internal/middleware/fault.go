@@ -0,0 +1,147 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// FaultConfig configures Fault. Rates are fractions of requests, from 0 (never) to 1
+// (always).
+type FaultConfig struct {
+	// LatencyRate is the fraction of requests delayed by Latency before being sent
+	LatencyRate float64
+
+	// Latency is the delay of delayed requests; each delay is drawn uniformly between
+	// Latency and twice Latency, so concurrent requests do not wake up together
+	Latency time.Duration
+
+	// ErrorRate is the fraction of requests answered with ErrorStatus without being sent
+	ErrorRate float64
+
+	// ErrorStatus is the status code of injected error responses (defaults to 503)
+	ErrorStatus int
+
+	// ResetRate is the fraction of requests failed with a connection reset without
+	// being sent
+	ResetRate float64
+
+	// Filter selects the requests faults are injected into (optional, defaults to all)
+	Filter func(*http.Request) bool
+
+	// Seed makes the injected faults reproducible: clients with the same seed inject
+	// faults into the same requests of the same sequence (optional, defaults to random)
+	Seed uint64
+
+	// Logger receives injected faults at debug level (optional)
+	Logger observability.Logger
+}
+
+// Fault returns a middleware that injects faults: delays, error responses and
+// connection resets at the configured rates, to test how automation copes with a
+// flaky controller. Placed below Retry, injected failures are retried like real
+// ones. Requests are drawn independently for latency and for failures; a request
+// fails with a connection reset or an error response, never both.
+func Fault(cfg FaultConfig) func(http.RoundTripper) http.RoundTripper {
+	if cfg.ErrorStatus == 0 {
+		cfg.ErrorStatus = http.StatusServiceUnavailable
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = observability.NoopLogger()
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		seed1, seed2 := rand.Uint64(), rand.Uint64() //nolint:gosec // Fault injection needs no secure randomness
+		if cfg.Seed != 0 {
+			seed1, seed2 = cfg.Seed, cfg.Seed
+		}
+		return &faultTransport{next: next, cfg: cfg, rand: rand.New(rand.NewPCG(seed1, seed2))} //nolint:gosec // As above
+	}
+}
+
+type faultTransport struct {
+	next http.RoundTripper
+	cfg  FaultConfig
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+func (t *faultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cfg.Filter != nil && !t.cfg.Filter(req) {
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return t.next.RoundTrip(req)
+	}
+
+	// All draws are taken for every request, so that a seed yields the same faults
+	// whatever the rates
+	t.mu.Lock()
+	latencyDraw, failureDraw, jitter := t.rand.Float64(), t.rand.Float64(), t.rand.Float64()
+	t.mu.Unlock()
+
+	if latencyDraw < t.cfg.LatencyRate && t.cfg.Latency > 0 {
+		delay := t.cfg.Latency + time.Duration(jitter*float64(t.cfg.Latency))
+		t.cfg.Logger.Debug("injecting latency",
+			observability.Field{Key: "delay", Value: delay},
+			observability.Field{Key: "path", Value: req.URL.Path},
+		)
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, errors.Wrap(req.Context().Err(), "request canceled during injected latency")
+		}
+	}
+
+	switch {
+	case failureDraw < t.cfg.ResetRate:
+		t.cfg.Logger.Debug("injecting connection reset", observability.Field{Key: "path", Value: req.URL.Path})
+		closeRequestBody(req)
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	case failureDraw < t.cfg.ResetRate+t.cfg.ErrorRate:
+		t.cfg.Logger.Debug("injecting error response",
+			observability.Field{Key: "status", Value: t.cfg.ErrorStatus},
+			observability.Field{Key: "path", Value: req.URL.Path},
+		)
+		closeRequestBody(req)
+		return faultResponse(req, t.cfg.ErrorStatus), nil
+	}
+
+	//nolint:wrapcheck // Middleware passes through errors from next transport
+	return t.next.RoundTrip(req)
+}
+
+// faultResponse returns an injected error response in the format of the Integration API.
+func faultResponse(req *http.Request, status int) *http.Response {
+	body := fmt.Sprintf(`{"statusCode":%d,"statusName":%q,"message":"injected fault"}`,
+		status, http.StatusText(status))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader([]byte(body))),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// closeRequestBody closes the body of a request that is not sent, as a transport must.
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
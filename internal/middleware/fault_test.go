@@ -0,0 +1,133 @@
+package middleware_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+)
+
+// faultRoundTrips sends n requests through a fault middleware and returns the
+// number of requests that reached the next transport, with the outcome of each.
+func faultRoundTrips(t *testing.T, cfg middleware.FaultConfig, n int) (sent int32, results []string) {
+	t.Helper()
+
+	var count atomic.Int32
+	transport := middleware.Fault(cfg)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		count.Add(1)
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}))
+
+	for range n {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://unifi.local/proxy/network/integration/v1/sites", http.NoBody)
+		require.NoError(t, err)
+		resp, err := transport.RoundTrip(req)
+		switch {
+		case err != nil:
+			require.ErrorIs(t, err, syscall.ECONNRESET)
+			results = append(results, "reset")
+		default:
+			resp.Body.Close()
+			results = append(results, resp.Status)
+		}
+	}
+	return count.Load(), results
+}
+
+func TestFaultRates(t *testing.T) {
+	t.Parallel()
+
+	sent, _ := faultRoundTrips(t, middleware.FaultConfig{}, 20)
+	assert.Equal(t, int32(20), sent, "no rates inject no faults")
+
+	sent, results := faultRoundTrips(t, middleware.FaultConfig{ResetRate: 1}, 5)
+	assert.Zero(t, sent, "reset requests are not sent")
+	assert.Equal(t, []string{"reset", "reset", "reset", "reset", "reset"}, results)
+
+	sent, results = faultRoundTrips(t, middleware.FaultConfig{ErrorRate: 1, ErrorStatus: http.StatusBadGateway}, 2)
+	assert.Zero(t, sent)
+	assert.Equal(t, []string{"502 Bad Gateway", "502 Bad Gateway"}, results)
+
+	sent, results = faultRoundTrips(t, middleware.FaultConfig{ErrorRate: 0.3, ResetRate: 0.2, Seed: 7}, 1000)
+	assert.InDelta(t, 500, sent, 60)
+	errorCount, resetCount := 0, 0
+	for _, result := range results {
+		switch result {
+		case "503 Service Unavailable":
+			errorCount++
+		case "reset":
+			resetCount++
+		}
+	}
+	assert.InDelta(t, 300, errorCount, 50)
+	assert.InDelta(t, 200, resetCount, 50)
+
+	_, again := faultRoundTrips(t, middleware.FaultConfig{ErrorRate: 0.3, ResetRate: 0.2, Seed: 7}, 1000)
+	assert.Equal(t, results, again, "a seed makes faults reproducible")
+}
+
+func TestFaultResponse(t *testing.T) {
+	t.Parallel()
+
+	transport := middleware.Fault(middleware.FaultConfig{ErrorRate: 1})(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		t.Fatal("request must not be sent")
+		return nil, nil //nolint:nilnil // Unreachable
+	}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://unifi.local/proxy/network/integration/v1/sites",
+		strings.NewReader("{}"))
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"statusCode": 503, "statusName": "Service Unavailable", "message": "injected fault"}`, string(body))
+}
+
+func TestFaultLatency(t *testing.T) {
+	t.Parallel()
+
+	transport := middleware.Fault(middleware.FaultConfig{LatencyRate: 1, Latency: 20 * time.Millisecond})(
+		roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}))
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://unifi.local/", http.NoBody)
+	require.NoError(t, err)
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 20*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, "https://unifi.local/", http.NoBody)
+	require.NoError(t, err)
+	_, err = transport.RoundTrip(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "delays end with the context")
+}
+
+func TestFaultFilter(t *testing.T) {
+	t.Parallel()
+
+	sent, _ := faultRoundTrips(t, middleware.FaultConfig{
+		ResetRate: 1,
+		Filter:    func(req *http.Request) bool { return req.Method != http.MethodGet },
+	}, 3)
+	assert.Equal(t, int32(3), sent, "filtered out requests pass")
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/lexfrei/go-unifi/internal/retry"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// maintenanceBodyLimit bounds how much of a 503 response body is inspected for
+// maintenance markers; maintenance pages are short.
+const maintenanceBodyLimit = 8 << 10
+
+// maintenanceMarkers maps lower-case body fragments to the maintenance state they
+// announce, most specific first.
+var maintenanceMarkers = []struct {
+	fragment string
+	state    string
+}{
+	{"migrat", "migrating"},
+	{"updating", "updating"},
+	{"upgrad", "updating"},
+	{"starting", "starting"},
+	{"initializ", "starting"},
+	{"booting", "starting"},
+	{"maintenance", "maintenance"},
+}
+
+// MaintenanceState returns the maintenance state announced by the body of a 503
+// response ("starting", "migrating", "updating" or "maintenance"), or "" if the
+// body does not describe a maintenance condition.
+func MaintenanceState(body []byte) string {
+	if len(body) > maintenanceBodyLimit {
+		body = body[:maintenanceBodyLimit]
+	}
+	lower := bytes.ToLower(body)
+	for _, marker := range maintenanceMarkers {
+		if bytes.Contains(lower, []byte(marker.fragment)) {
+			return marker.state
+		}
+	}
+	return ""
+}
+
+// maintenanceError inspects a 503 response and returns a *unifierr.MaintenanceError
+// if the controller announces maintenance. Otherwise it restores the response body
+// and returns nil.
+func maintenanceError(resp *http.Response) error {
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		return nil
+	}
+
+	body, err := peekBody(resp, maintenanceBodyLimit)
+	if err != nil {
+		return nil
+	}
+
+	state := MaintenanceState(body)
+	if state == "" {
+		return nil
+	}
+	return &unifierr.MaintenanceError{
+		State:      state,
+		RetryAfter: retry.ParseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+}
+
+// peekBody reads up to limit bytes of the response body and puts them back, so that
+// the body can still be read in full.
+func peekBody(resp *http.Response, limit int64) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit))
+	rest := resp.Body
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), rest), rest}
+	//nolint:wrapcheck // Callers treat read errors as a missing body
+	return body, err
+}
@@ -0,0 +1,100 @@
+package middleware_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestMaintenanceState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "updating page", body: "<html><h1>UniFi OS is Updating</h1></html>", want: "updating"},
+		{name: "upgrade json", body: `{"error":"Network application upgrade in progress"}`, want: "updating"},
+		{name: "database migration", body: `{"meta":{"rc":"error","msg":"api.err.DatabaseMigrating"}}`, want: "migrating"},
+		{name: "starting", body: "The Network application is starting up", want: "starting"},
+		{name: "generic maintenance", body: "Down for maintenance", want: "maintenance"},
+		{name: "unrelated", body: "upstream connect error", want: ""},
+		{name: "empty", body: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, middleware.MaintenanceState([]byte(tt.body)))
+		})
+	}
+}
+
+func TestRetryDetectMaintenance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		detect           bool
+		body             string
+		wantAttempts     int32
+		wantMaintenance  bool
+		wantResponseBody string
+	}{
+		{name: "maintenance detected", detect: true, body: "UniFi OS is updating", wantAttempts: 1, wantMaintenance: true},
+		{name: "detection disabled", detect: false, body: "UniFi OS is updating", wantAttempts: 3, wantResponseBody: "UniFi OS is updating"},
+		{name: "plain 503 still retried", detect: true, body: "upstream connect error", wantAttempts: 3, wantResponseBody: "upstream connect error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				attempts.Add(1)
+				w.Header().Set("Retry-After", "120")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			transport := middleware.Retry(middleware.RetryConfig{
+				MaxRetries:        2,
+				InitialWait:       time.Millisecond,
+				DetectMaintenance: tt.detect,
+			})(http.DefaultTransport)
+
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+			resp, err := transport.RoundTrip(req)
+			assert.Equal(t, tt.wantAttempts, attempts.Load())
+
+			if tt.wantMaintenance {
+				require.ErrorIs(t, err, unifierr.ErrControllerMaintenance)
+				var maintenance *unifierr.MaintenanceError
+				require.ErrorAs(t, err, &maintenance)
+				assert.Equal(t, "updating", maintenance.State)
+				assert.Equal(t, 120*time.Second, maintenance.RetryAfter)
+				return
+			}
+
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResponseBody, string(body))
+		})
+	}
+}
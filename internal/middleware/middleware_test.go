@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/lexfrei/go-unifi/internal/middleware"
 	"github.com/lexfrei/go-unifi/observability"
@@ -74,6 +75,53 @@ func TestTLSConfig(t *testing.T) {
 	assert.Equal(t, uint16(tls.VersionTLS12), httpTransport.TLSClientConfig.MinVersion)
 }
 
+func TestTimeouts(t *testing.T) {
+	t.Parallel()
+
+	transport := middleware.Timeouts(middleware.TransportTimeouts{
+		Dial:           time.Second,
+		ResponseHeader: 2 * time.Second,
+	})(http.DefaultTransport)
+
+	httpTransport, ok := transport.(*http.Transport)
+	require.True(t, ok, "Transport should be *http.Transport")
+	assert.NotNil(t, httpTransport.DialContext)
+	assert.Equal(t, 2*time.Second, httpTransport.ResponseHeaderTimeout)
+	assert.Equal(t, http.DefaultTransport.(*http.Transport).TLSHandshakeTimeout, httpTransport.TLSHandshakeTimeout,
+		"unset timeouts keep the defaults")
+
+	// TLSConfig applied on top keeps the timeouts
+	transport = middleware.TLSConfig(middleware.InsecureSkipVerify())(transport)
+	httpTransport, ok = transport.(*http.Transport)
+	require.True(t, ok)
+	assert.Equal(t, 2*time.Second, httpTransport.ResponseHeaderTimeout)
+	assert.True(t, httpTransport.TLSClientConfig.InsecureSkipVerify)
+
+	next := http.RoundTripper(http.DefaultTransport)
+	assert.Same(t, next, middleware.Timeouts(middleware.TransportTimeouts{})(next), "no timeouts leave the transport alone")
+}
+
+func TestTimeoutsResponseHeader(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := middleware.Timeouts(middleware.TransportTimeouts{ResponseHeader: 20 * time.Millisecond})(nil)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+	require.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timeout awaiting response headers")
+}
+
 func TestInsecureSkipVerify(t *testing.T) {
 	t.Parallel()
 
@@ -95,7 +143,7 @@ func TestObservability(t *testing.T) {
 	logger := observability.NoopLogger()
 	metrics := observability.NoopMetricsRecorder()
 
-	transport := middleware.Observability(logger, metrics)(http.DefaultTransport)
+	transport := middleware.Observability(logger, metrics, nil)(http.DefaultTransport)
 
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
 	resp, err := transport.RoundTrip(req)
@@ -114,7 +162,7 @@ func TestObservabilityWithNilParams(t *testing.T) {
 	defer server.Close()
 
 	// Should use no-op implementations
-	transport := middleware.Observability(nil, nil)(http.DefaultTransport)
+	transport := middleware.Observability(nil, nil, nil)(http.DefaultTransport)
 
 	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
 	resp, err := transport.RoundTrip(req)
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// MutationKeyFunc returns the key whose mutations are serialized, e.g. the site a
+// request targets. Requests with an empty key are not serialized.
+type MutationKeyFunc func(*http.Request) string
+
+// SerializeMutations returns a middleware that lets only one mutating request
+// (POST, PUT, PATCH, DELETE) per key through at a time, including its retries.
+// Other mutations for the same key wait their turn, or fail with the context error
+// if their context ends first. GET, HEAD and OPTIONS requests are never held back.
+//
+// This prevents parallel jobs sharing a client from triggering provisioning
+// conflicts on controllers that reject concurrent configuration changes.
+func SerializeMutations(key MutationKeyFunc) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &mutationLockTransport{next: next, key: key}
+	}
+}
+
+type mutationLockTransport struct {
+	next http.RoundTripper
+	key  MutationKeyFunc
+
+	// locks holds a buffered channel of capacity 1 per key; holding the lock
+	// means having sent to it. Channels, unlike mutexes, allow waiting on ctx.
+	locks sync.Map
+}
+
+func (t *mutationLockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return t.next.RoundTrip(req)
+	}
+
+	key := t.key(req)
+	if key == "" {
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return t.next.RoundTrip(req)
+	}
+
+	lock, _ := t.locks.LoadOrStore(key, make(chan struct{}, 1))
+	//nolint:forcetypeassert // locks only stores lock channels
+	sem := lock.(chan struct{})
+
+	select {
+	case sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, errors.Wrap(req.Context().Err(), "context canceled waiting for concurrent mutation")
+	}
+	defer func() { <-sem }()
+
+	//nolint:wrapcheck // Middleware passes through errors from next transport
+	return t.next.RoundTrip(req)
+}
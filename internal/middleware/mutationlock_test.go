@@ -0,0 +1,100 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerializeMutations(t *testing.T) {
+	t.Parallel()
+
+	// Track the highest number of concurrent requests per path prefix
+	var mu sync.Mutex
+	inFlight := map[string]int{}
+	peak := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + strings.Split(r.URL.Path, "/")[1]
+		mu.Lock()
+		inFlight[key]++
+		peak[key] = max(peak[key], inFlight[key])
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight[key]--
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := middleware.SerializeMutations(func(req *http.Request) string {
+		return strings.Split(req.URL.Path, "/")[1]
+	})(http.DefaultTransport)
+
+	send := func(method, path string) {
+		req, _ := http.NewRequestWithContext(context.Background(), method, server.URL+path, http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		assert.NoError(t, err)
+		if resp != nil {
+			resp.Body.Close()
+		}
+	}
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() { send(http.MethodPut, "/site-a/item") })
+		wg.Go(func() { send(http.MethodDelete, "/site-b/item") })
+		wg.Go(func() { send(http.MethodGet, "/site-c/item") })
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, peak["PUT site-a"], "mutations of one site should not overlap")
+	assert.Equal(t, 1, peak["DELETE site-b"], "mutations of one site should not overlap")
+	assert.Greater(t, peak["GET site-c"], 1, "reads should not be serialized")
+}
+
+func TestSerializeMutationsContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	var started atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		started.Store(true)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := middleware.SerializeMutations(func(*http.Request) string { return "default" })(http.DefaultTransport)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	require.Eventually(t, started.Load, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, http.NoBody)
+	_, err := transport.RoundTrip(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(release)
+	<-done
+}
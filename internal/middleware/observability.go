@@ -3,34 +3,73 @@ package middleware
 import (
 	"net/http"
 	"regexp"
-	"sync"
 	"time"
 
+	"github.com/lexfrei/go-unifi/internal/response"
 	"github.com/lexfrei/go-unifi/observability"
 )
 
 // Observability returns a middleware that logs and records metrics for HTTP requests.
-func Observability(logger observability.Logger, metrics observability.MetricsRecorder) func(http.RoundTripper) http.RoundTripper {
+//
+// Connection details collected through httptrace (protocol, connection reuse, and DNS,
+// connect and TLS handshake durations) are added to the completion log entry and
+// reported to metrics recorders implementing observability.ConnectionMetricsRecorder.
+//
+// Entries include the request ID set by RequestID and, for failed responses, the trace
+// ID returned by the API, so that client logs can be matched with controller logs and
+// support tickets.
+//
+// Requests made by client methods are attributed to the operation set with
+// WithOperation: log entries include its name, and recorders implementing
+// observability.OperationMetricsRecorder receive per-operation metrics.
+//
+// The duration of a request includes the time it waited on the rate limiter and between
+// retries, when those middleware are below this one. Log entries split it into the time
+// spent on the controller, server_duration, and the time added by the client,
+// client_wait, and recorders implementing observability.LatencyMetricsRecorder receive
+// the split per operation.
+//
+// Paths are normalized for metrics through paths, or a cache shared by all clients if
+// nil. Its statistics are reported to recorders implementing
+// observability.PathCacheMetricsRecorder.
+func Observability(logger observability.Logger, metrics observability.MetricsRecorder, paths *PathCache) func(http.RoundTripper) http.RoundTripper {
 	if logger == nil {
 		logger = observability.NoopLogger()
 	}
 	if metrics == nil {
 		metrics = observability.NoopMetricsRecorder()
 	}
+	if paths == nil {
+		paths = defaultPathCache
+	}
 
 	return func(next http.RoundTripper) http.RoundTripper {
+		connMetrics, _ := metrics.(observability.ConnectionMetricsRecorder) //nolint:errcheck // Optional extension
+		cacheMetrics, _ := metrics.(observability.PathCacheMetricsRecorder) //nolint:errcheck // Optional extension
+		opMetrics, _ := metrics.(observability.OperationMetricsRecorder)    //nolint:errcheck // Optional extension
+		latMetrics, _ := metrics.(observability.LatencyMetricsRecorder)     //nolint:errcheck // Optional extension
 		return &observabilityTransport{
-			next:    next,
-			logger:  logger,
-			metrics: metrics,
+			next:         next,
+			logger:       logger,
+			metrics:      metrics,
+			connMetrics:  connMetrics,
+			paths:        paths,
+			cacheMetrics: cacheMetrics,
+			opMetrics:    opMetrics,
+			latMetrics:   latMetrics,
 		}
 	}
 }
 
 type observabilityTransport struct {
-	next    http.RoundTripper
-	logger  observability.Logger
-	metrics observability.MetricsRecorder
+	next         http.RoundTripper
+	logger       observability.Logger
+	metrics      observability.MetricsRecorder
+	connMetrics  observability.ConnectionMetricsRecorder // nil if metrics does not implement it
+	paths        *PathCache
+	cacheMetrics observability.PathCacheMetricsRecorder // nil if metrics does not implement it
+	opMetrics    observability.OperationMetricsRecorder // nil if metrics does not implement it
+	latMetrics   observability.LatencyMetricsRecorder   // nil if metrics does not implement it
 }
 
 func (t *observabilityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -38,55 +77,106 @@ func (t *observabilityTransport) RoundTrip(req *http.Request) (*http.Response, e
 
 	// Compute URL string once to avoid multiple allocations
 	urlStr := req.URL.String()
+	requestID := req.Header.Get(RequestIDHeader)
+	operation := Operation(req.Context())
 
 	// Log request
-	t.logger.Debug("http request started",
-		observability.Field{Key: "method", Value: req.Method},
-		observability.Field{Key: "url", Value: urlStr},
-		observability.Field{Key: "path", Value: req.URL.Path},
-	)
+	t.logger.Debug("http request started", withCorrelation([]observability.Field{
+		{Key: "method", Value: req.Method},
+		{Key: "url", Value: urlStr},
+		{Key: "path", Value: req.URL.Path},
+	}, requestID, operation)...)
 
-	// Make request
+	// Make request, tracing how its connection is obtained and how long it waits
+	ctx, waits := withRequestWaitStats(req.Context())
+	req, trace := withConnTrace(req.WithContext(ctx))
 	resp, err := t.next.RoundTrip(req)
 
 	duration := time.Since(start)
+	latency := waits.latency(duration)
+	if operation != "" && t.latMetrics != nil {
+		t.latMetrics.RecordLatency(operation, latency)
+	}
 
 	if err != nil {
 		// Log error
-		t.logger.Error("http request failed",
-			observability.Field{Key: "method", Value: req.Method},
-			observability.Field{Key: "url", Value: urlStr},
-			observability.Field{Key: "duration", Value: duration},
-			observability.Field{Key: "error", Value: err.Error()},
-		)
+		t.logger.Error("http request failed", withCorrelation([]observability.Field{
+			{Key: "method", Value: req.Method},
+			{Key: "url", Value: urlStr},
+			{Key: "duration", Value: duration},
+			{Key: "server_duration", Value: latency.Server},
+			{Key: "client_wait", Value: latency.ClientWait()},
+			{Key: "error", Value: err.Error()},
+		}, requestID, operation)...)
 
 		t.metrics.RecordError("http_request", "NetworkError")
+		if operation != "" && t.opMetrics != nil {
+			t.opMetrics.RecordOperation(operation, 0, duration)
+		}
 
 		//nolint:wrapcheck // Observability middleware logs error but passes it through unchanged
 		return nil, err
 	}
 
 	// Log response
-	fields := []observability.Field{
+	fields := withCorrelation([]observability.Field{
 		{Key: "method", Value: req.Method},
 		{Key: "url", Value: urlStr},
 		{Key: "status", Value: resp.StatusCode},
 		{Key: "duration", Value: duration},
+		{Key: "server_duration", Value: latency.Server},
+		{Key: "client_wait", Value: latency.ClientWait()},
+	}, requestID, operation)
+	connInfo, gotConn := trace.result(resp)
+	if gotConn {
+		fields = append(fields, connFields(connInfo)...)
 	}
 
 	if resp.StatusCode >= http.StatusBadRequest {
+		if body, err := peekBody(resp, traceIDBodyLimit); err == nil {
+			if traceID := response.TraceID(body); traceID != "" {
+				fields = append(fields, observability.Field{Key: "trace_id", Value: traceID})
+			}
+		}
 		t.logger.Warn("http request completed with error", fields...)
 	} else {
 		t.logger.Debug("http request completed", fields...)
 	}
 
 	// Record metrics with normalized path to avoid unbounded cardinality
-	normalizedPath := normalizePath(req.URL.Path)
+	normalizedPath := t.paths.Normalize(req.URL.Path)
 	t.metrics.RecordHTTPRequest(req.Method, normalizedPath, resp.StatusCode, duration)
+	if gotConn && t.connMetrics != nil {
+		t.connMetrics.RecordConnection(normalizedPath, connInfo)
+	}
+	if t.cacheMetrics != nil {
+		t.cacheMetrics.RecordPathCache(t.paths.Stats())
+	}
+	if operation != "" && t.opMetrics != nil {
+		t.opMetrics.RecordOperation(operation, resp.StatusCode, duration)
+	}
 
 	return resp, nil
 }
 
+// traceIDBodyLimit bounds how much of an error response body is inspected for a
+// trace ID; error bodies are short.
+const traceIDBodyLimit = 8 << 10
+
+// withCorrelation appends the request ID and operation name to log fields, if there are any.
+func withCorrelation(fields []observability.Field, requestID, operation string) []observability.Field {
+	if requestID != "" {
+		fields = append(fields, observability.Field{Key: "request_id", Value: requestID})
+	}
+	if operation != "" {
+		fields = append(fields, observability.Field{Key: "operation", Value: operation})
+	}
+	return fields
+}
+
+// defaultPathCache normalizes paths for clients without their own PathCache.
+var defaultPathCache = NewPathCache(DefaultPathCacheSize, nil)
+
 var (
 	// combinedIDPattern matches UUIDs, ObjectIDs, or numeric IDs in a single pattern.
 	// This reduces the number of passes over the string from 3 to 1 for ID replacement.
@@ -94,17 +184,12 @@ var (
 	combinedIDPattern = regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|[0-9a-f]{24}|/\d{5,}(?:/|$)`)
 	// siteNamePattern matches site names in paths: /site/{name}/ → /site/:site/.
 	siteNamePattern = regexp.MustCompile(`/site/[^/]+(/|$)`)
-
-	// normalizedPathCache caches normalized paths to avoid repeated regex operations.
-	// In production, most requests hit a limited set of endpoints, so caching provides
-	// significant performance improvement (up to 150x faster on cache hit).
-	normalizedPathCache sync.Map
 )
 
 // normalizePath replaces dynamic path segments (UUIDs, ObjectIDs, numeric IDs) with placeholders
 // to prevent unbounded cardinality in Prometheus metrics.
 //
-// Uses an in-memory cache to avoid repeated regex operations for the same paths.
+// Uses the shared PathCache to avoid repeated regex operations for the same paths.
 // In production scenarios with limited endpoint sets, this provides up to 150x speedup.
 //
 // Examples:
@@ -112,13 +197,11 @@ var (
 //   - /api/site/my-site/device/12345678 → /api/site/:site/device/:id
 //   - /proxy/network/v2/api/site/default/setting → /proxy/network/v2/api/site/:site/setting
 func normalizePath(path string) string {
-	// Fast path: check cache
-	if cached, ok := normalizedPathCache.Load(path); ok {
-		//nolint:forcetypeassert // Cache only stores strings, type assertion is safe
-		return cached.(string)
-	}
+	return defaultPathCache.Normalize(path)
+}
 
-	// Slow path: compute normalization
+// computeNormalizedPath normalizes path without caching; see normalizePath.
+func computeNormalizedPath(path string) string {
 	// Replace all ID types (UUIDs, ObjectIDs, numeric IDs) in a single pass.
 	// ReplaceAllStringFunc allows us to handle the numeric ID case specially
 	// where we need to preserve the trailing slash or end-of-string.
@@ -136,10 +219,5 @@ func normalizePath(path string) string {
 	})
 
 	// Replace site names: /site/{name}/ → /site/:site/
-	normalized = siteNamePattern.ReplaceAllString(normalized, "/site/:site$1")
-
-	// Store in cache for future requests
-	normalizedPathCache.Store(path, normalized)
-
-	return normalized
+	return siteNamePattern.ReplaceAllString(normalized, "/site/:site$1")
 }
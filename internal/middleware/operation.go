@@ -0,0 +1,18 @@
+package middleware
+
+import "context"
+
+type operationKey struct{}
+
+// WithOperation returns a context whose requests are attributed to the named client
+// operation, e.g. "ListSiteClients", in logs and operation metrics. An operation
+// calling another one attributes the inner requests to the inner operation.
+func WithOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, name)
+}
+
+// Operation returns the operation name set with WithOperation, or "" if there is none.
+func Operation(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string) //nolint:errcheck // Absent is ""
+	return name
+}
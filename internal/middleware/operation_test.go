@@ -0,0 +1,80 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// operationRecorder records operation metrics on top of the noop recorder.
+type operationRecorder struct {
+	observability.MetricsRecorder
+
+	mu         sync.Mutex
+	operations []string
+	statuses   []int
+}
+
+func (r *operationRecorder) RecordOperation(operation string, statusCode int, _ time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.operations = append(r.operations, operation)
+	r.statuses = append(r.statuses, statusCode)
+}
+
+func TestOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assert.Empty(t, middleware.Operation(ctx))
+
+	outer := middleware.WithOperation(ctx, "DownloadSupportFile")
+	inner := middleware.WithOperation(outer, "GenerateSupportFile")
+	assert.Equal(t, "DownloadSupportFile", middleware.Operation(outer))
+	assert.Equal(t, "GenerateSupportFile", middleware.Operation(inner))
+}
+
+func TestObservabilityRecordsOperation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	recorder := &operationRecorder{MetricsRecorder: observability.NoopMetricsRecorder()}
+	failing := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	ctx := middleware.WithOperation(context.Background(), "GetDeviceByID")
+	for _, next := range []http.RoundTripper{http.DefaultTransport, failing} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/device", http.NoBody)
+		require.NoError(t, err)
+		resp, err := middleware.Observability(nil, recorder, nil)(next).RoundTrip(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}
+
+	// Requests without an operation are not recorded.
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+	require.NoError(t, err)
+	resp, err := middleware.Observability(nil, recorder, nil)(http.DefaultTransport).RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	assert.Equal(t, []string{"GetDeviceByID", "GetDeviceByID"}, recorder.operations)
+	assert.Equal(t, []int{http.StatusNotFound, 0}, recorder.statuses)
+}
@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// DefaultPathCacheSize is the number of distinct request paths whose normalized form
+// is cached when no size is configured. Clients hit a limited set of endpoints, so
+// it only fills up when paths carry unbounded input such as user-supplied names.
+const DefaultPathCacheSize = 4096
+
+// PathCache caches normalized request paths (see normalizePath) in a bounded LRU,
+// so that services requesting arbitrary paths do not grow memory without limit.
+// Seeded paths are pinned: they are never evicted and do not count toward the
+// capacity. It is safe for concurrent use.
+type PathCache struct {
+	mu        sync.Mutex
+	capacity  int
+	entries   map[string]*list.Element
+	order     *list.List // front is most recently used
+	pinned    map[string]string
+	seed      []string // pinned paths in seed order
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+// pathCacheEntry is an element of PathCache.order.
+type pathCacheEntry struct {
+	path       string
+	normalized string
+}
+
+// NewPathCache returns a cache holding up to capacity paths besides the pinned
+// seed paths; zero or less uses DefaultPathCacheSize.
+func NewPathCache(capacity int, seed []string) *PathCache {
+	if capacity <= 0 {
+		capacity = DefaultPathCacheSize
+	}
+	c := &PathCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		pinned:   make(map[string]string, len(seed)),
+	}
+	for _, path := range seed {
+		if _, ok := c.pinned[path]; ok {
+			continue
+		}
+		c.pinned[path] = computeNormalizedPath(path)
+		c.seed = append(c.seed, path)
+	}
+	return c
+}
+
+// Normalize returns the normalized form of path, from the cache if present.
+func (c *PathCache) Normalize(path string) string {
+	c.mu.Lock()
+	if normalized, ok := c.pinned[path]; ok {
+		c.hits++
+		c.mu.Unlock()
+		return normalized
+	}
+	if elem, ok := c.entries[path]; ok {
+		c.hits++
+		c.order.MoveToFront(elem)
+		normalized := elem.Value.(*pathCacheEntry).normalized //nolint:forcetypeassert // order only holds entries
+		c.mu.Unlock()
+		return normalized
+	}
+	c.misses++
+	c.mu.Unlock()
+
+	// Normalize outside the lock: it is the expensive part.
+	normalized := computeNormalizedPath(path)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[path]; ok {
+		return normalized
+	}
+	c.entries[path] = c.order.PushFront(&pathCacheEntry{path: path, normalized: normalized})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*pathCacheEntry).path) //nolint:forcetypeassert // order only holds entries
+		c.evictions++
+	}
+	return normalized
+}
+
+// Stats returns the cumulative statistics of the cache.
+func (c *PathCache) Stats() observability.PathCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return observability.PathCacheStats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Size:      c.order.Len(),
+		Capacity:  c.capacity,
+		Pinned:    len(c.pinned),
+	}
+}
+
+// Paths returns the pinned paths in seed order, then the other cached paths from most
+// to least recently used. Saved across restarts, they make a good seed for the next cache.
+func (c *PathCache) Paths() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	paths := make([]string, 0, len(c.seed)+c.order.Len())
+	paths = append(paths, c.seed...)
+	for elem := c.order.Front(); elem != nil; elem = elem.Next() {
+		paths = append(paths, elem.Value.(*pathCacheEntry).path) //nolint:forcetypeassert // order only holds entries
+	}
+	return paths
+}
@@ -0,0 +1,118 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	t.Parallel()
+
+	cache := middleware.NewPathCache(2, nil)
+	assert.Equal(t, "/api/site/:site/device/:id", cache.Normalize("/api/site/a/device/507f1f77bcf86cd799439011"))
+	cache.Normalize("/api/site/b/device")
+	cache.Normalize("/api/site/a/device/507f1f77bcf86cd799439011") // hit, now most recent
+	cache.Normalize("/api/site/c/device")                          // evicts site b
+
+	assert.Equal(t, []string{"/api/site/c/device", "/api/site/a/device/507f1f77bcf86cd799439011"}, cache.Paths())
+	assert.Equal(t, observability.PathCacheStats{
+		Hits: 1, Misses: 3, Evictions: 1, Size: 2, Capacity: 2,
+	}, cache.Stats())
+}
+
+func TestPathCachePinsSeedPaths(t *testing.T) {
+	t.Parallel()
+
+	seed := []string{"/api/site/default/stat/health", "/api/site/default/stat/device", "/api/site/default/stat/health"}
+	cache := middleware.NewPathCache(1, seed)
+
+	for _, path := range []string{"/api/site/x/a", "/api/site/x/b", "/api/site/x/c"} {
+		cache.Normalize(path)
+	}
+	assert.Equal(t, "/api/site/:site/stat/health", cache.Normalize("/api/site/default/stat/health"))
+
+	assert.Equal(t, []string{"/api/site/default/stat/health", "/api/site/default/stat/device", "/api/site/x/c"}, cache.Paths())
+	stats := cache.Stats()
+	assert.Equal(t, 2, stats.Pinned)
+	assert.Equal(t, 1, stats.Size)
+	assert.Equal(t, uint64(2), stats.Evictions)
+	assert.Equal(t, uint64(1), stats.Hits)
+}
+
+func TestPathCacheDefaultCapacity(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, middleware.DefaultPathCacheSize, middleware.NewPathCache(0, nil).Stats().Capacity)
+}
+
+func TestPathCacheConcurrent(t *testing.T) {
+	t.Parallel()
+
+	cache := middleware.NewPathCache(8, nil)
+	paths := []string{"/api/site/a/x", "/api/site/b/y", "/api/site/c/z", "/api/system/info"}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for i := range 1000 {
+				cache.Normalize(paths[i%len(paths)])
+			}
+		})
+	}
+	wg.Wait()
+
+	stats := cache.Stats()
+	assert.Equal(t, uint64(8000), stats.Hits+stats.Misses)
+	assert.Equal(t, len(paths), stats.Size)
+	assert.Zero(t, stats.Evictions)
+}
+
+// pathCacheRecorder records path cache statistics on top of the noop recorder.
+type pathCacheRecorder struct {
+	observability.MetricsRecorder
+
+	mu    sync.Mutex
+	stats []observability.PathCacheStats
+}
+
+func (r *pathCacheRecorder) RecordPathCache(stats observability.PathCacheStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats = append(r.stats, stats)
+}
+
+func TestObservabilityRecordsPathCache(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	recorder := &pathCacheRecorder{MetricsRecorder: observability.NoopMetricsRecorder()}
+	cache := middleware.NewPathCache(10, []string{"/api/system/info"})
+	transport := middleware.Observability(nil, recorder, cache)(http.DefaultTransport)
+
+	for _, path := range []string{"/api/system/info", "/api/site/default/device", "/api/site/default/device"} {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+path, http.NoBody)
+		require.NoError(t, err)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	require.Len(t, recorder.stats, 3)
+	assert.Equal(t, observability.PathCacheStats{
+		Hits: 2, Misses: 1, Size: 1, Capacity: 10, Pinned: 1,
+	}, recorder.stats[2])
+}
@@ -1,12 +1,16 @@
 package middleware
 
 import (
+	"cmp"
 	"context"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
 	"golang.org/x/time/rate"
 )
 
@@ -14,12 +18,52 @@ import (
 // Returns the rate limiter and a descriptive name for logging/metrics.
 type RateLimiterSelector func(*http.Request) (*rate.Limiter, string)
 
+// PathPrefixSelector returns a selector that picks the limiter of the longest prefix
+// matching the request path, or fallback with the name "default" if none matches.
+//
+// Paths are matched after trimming basePath and replacing IDs and site names with
+// the placeholders used in metrics (see normalizePath), so one prefix such as
+// "/integration/v1/sites/:id/hotspot/vouchers" covers every site. Each prefix is
+// reported by its own name to logs and metrics.
+//
+// Paths are normalized through paths, the cache the client passes to Observability,
+// or a cache shared by all clients if nil.
+func PathPrefixSelector(basePath string, limiters map[string]*rate.Limiter, fallback *rate.Limiter, paths *PathCache) RateLimiterSelector {
+	if paths == nil {
+		paths = defaultPathCache
+	}
+
+	prefixes := make([]string, 0, len(limiters))
+	for prefix := range limiters {
+		prefixes = append(prefixes, prefix)
+	}
+	// Longest first, so the most specific prefix wins
+	slices.SortFunc(prefixes, func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), strings.Compare(a, b))
+	})
+
+	return func(req *http.Request) (*rate.Limiter, string) {
+		path := paths.Normalize(strings.TrimPrefix(req.URL.Path, basePath))
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(path, prefix) {
+				return limiters[prefix], prefix
+			}
+		}
+		return fallback, "default"
+	}
+}
+
 // RateLimitConfig configures the rate limit middleware.
 type RateLimitConfig struct {
 	Limiter  *rate.Limiter       // Single limiter (used if Selector is nil)
 	Selector RateLimiterSelector // Optional: select limiter based on request
 	Logger   observability.Logger
 	Metrics  observability.MetricsRecorder
+
+	// WaitPastDeadline disables the deadline fast-fail. By default a request whose
+	// rate limit wait ends after the context deadline fails immediately with
+	// unifierr.ErrWouldExceedDeadline instead of waiting for the deadline to expire.
+	WaitPastDeadline bool
 }
 
 // RateLimit returns a middleware that applies rate limiting to requests.
@@ -27,7 +71,12 @@ type RateLimitConfig struct {
 // Two modes of operation:
 // 1. Single limiter: Set cfg.Limiter for uniform rate limiting.
 // 2. Selector mode: Set cfg.Selector to choose limiter per request (e.g., v1 vs EA endpoints).
+//
+// If neither is set, the middleware is a pass-through and removes itself from the chain.
 func RateLimit(cfg RateLimitConfig) func(http.RoundTripper) http.RoundTripper {
+	if cfg.Limiter == nil && cfg.Selector == nil {
+		return func(next http.RoundTripper) http.RoundTripper { return next }
+	}
 	if cfg.Logger == nil {
 		cfg.Logger = observability.NoopLogger()
 	}
@@ -37,21 +86,23 @@ func RateLimit(cfg RateLimitConfig) func(http.RoundTripper) http.RoundTripper {
 
 	return func(next http.RoundTripper) http.RoundTripper {
 		return &rateLimitTransport{
-			next:     next,
-			limiter:  cfg.Limiter,
-			selector: cfg.Selector,
-			logger:   cfg.Logger,
-			metrics:  cfg.Metrics,
+			next:             next,
+			limiter:          cfg.Limiter,
+			selector:         cfg.Selector,
+			logger:           cfg.Logger,
+			metrics:          cfg.Metrics,
+			waitPastDeadline: cfg.WaitPastDeadline,
 		}
 	}
 }
 
 type rateLimitTransport struct {
-	next     http.RoundTripper
-	limiter  *rate.Limiter
-	selector RateLimiterSelector
-	logger   observability.Logger
-	metrics  observability.MetricsRecorder
+	next             http.RoundTripper
+	limiter          *rate.Limiter
+	selector         RateLimiterSelector
+	logger           observability.Logger
+	metrics          observability.MetricsRecorder
+	waitPastDeadline bool
 }
 
 func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -94,6 +145,19 @@ func (t *rateLimitTransport) waitWithObservability(
 	}
 
 	delay := reservation.Delay()
+	if delay > 0 && !t.waitPastDeadline {
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			// Return the token: this request will never be sent
+			reservation.Cancel()
+			t.logger.Debug("rate limit wait exceeds deadline",
+				observability.Field{Key: "endpoint", Value: endpoint},
+				observability.Field{Key: "delay", Value: delay},
+				observability.Field{Key: "path", Value: path},
+			)
+			return errors.Wrapf(unifierr.ErrWouldExceedDeadline, "rate limit wait of %s", delay)
+		}
+	}
+
 	if delay > 0 {
 		t.logger.Debug("rate limit delay",
 			observability.Field{Key: "endpoint", Value: endpoint},
@@ -107,10 +171,13 @@ func (t *rateLimitTransport) waitWithObservability(
 		timer := time.NewTimer(delay)
 		defer timer.Stop()
 
+		waitStart := time.Now()
 		select {
 		case <-timer.C:
 			// Rate limit satisfied
+			waitStatsFrom(ctx).addRateLimitWait(time.Since(waitStart))
 		case <-ctx.Done():
+			waitStatsFrom(ctx).addRateLimitWait(time.Since(waitStart))
 			reservation.Cancel()
 			return errors.Wrap(ctx.Err(), "context canceled during rate limit wait")
 		}
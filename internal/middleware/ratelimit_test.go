@@ -5,10 +5,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/unifierr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/time/rate"
@@ -119,6 +121,7 @@ func TestRateLimit(t *testing.T) {
 		transport := middleware.RateLimit(middleware.RateLimitConfig{
 			Limiter: nil, // No limiter
 		})(http.DefaultTransport)
+		assert.Same(t, http.DefaultTransport, transport, "middleware should remove itself from the chain")
 
 		// Should complete quickly without rate limiting
 		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
@@ -145,7 +148,8 @@ func TestRateLimit(t *testing.T) {
 		limiter.Allow() // Use up the token
 
 		transport := middleware.RateLimit(middleware.RateLimitConfig{
-			Limiter: limiter,
+			Limiter:          limiter,
+			WaitPastDeadline: true,
 		})(http.DefaultTransport)
 
 		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
@@ -161,4 +165,129 @@ func TestRateLimit(t *testing.T) {
 
 		assert.Contains(t, err.Error(), "context", "error should be context-related")
 	})
+	t.Run("wait exceeding deadline fails fast", func(t *testing.T) {
+		t.Parallel()
+
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		limiter := rate.NewLimiter(0.1, 1)
+		limiter.Allow() // Next token in 10s
+
+		transport := middleware.RateLimit(middleware.RateLimitConfig{
+			Limiter: limiter,
+		})(http.DefaultTransport)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+		start := time.Now()
+		resp, err := transport.RoundTrip(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		require.ErrorIs(t, err, unifierr.ErrWouldExceedDeadline)
+		assert.Less(t, time.Since(start), 100*time.Millisecond, "should fail without waiting")
+		assert.Zero(t, requests.Load())
+
+		// The canceled reservation returned its token: a request without deadline
+		// waits for the original 10s slot, not a second one.
+		reservation := limiter.Reserve()
+		assert.LessOrEqual(t, reservation.Delay(), 10*time.Second)
+		reservation.Cancel()
+	})
+
+	t.Run("wait within deadline proceeds", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		limiter := rate.NewLimiter(20, 1)
+		limiter.Allow() // Next token in 50ms
+
+		transport := middleware.RateLimit(middleware.RateLimitConfig{
+			Limiter: limiter,
+		})(http.DefaultTransport)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	})
+}
+
+func TestPathPrefixSelector(t *testing.T) {
+	t.Parallel()
+
+	vouchers := rate.NewLimiter(1, 1)
+	sites := rate.NewLimiter(2, 2)
+	fallback := rate.NewLimiter(3, 3)
+	selector := middleware.PathPrefixSelector("/proxy/network", map[string]*rate.Limiter{
+		"/integration/v1/sites":                      sites,
+		"/integration/v1/sites/:id/hotspot/vouchers": vouchers,
+	}, fallback, nil)
+
+	tests := []struct {
+		name        string
+		path        string
+		wantLimiter *rate.Limiter
+		wantName    string
+	}{
+		{
+			name:        "longest prefix wins",
+			path:        "/proxy/network/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6/hotspot/vouchers",
+			wantLimiter: vouchers,
+			wantName:    "/integration/v1/sites/:id/hotspot/vouchers",
+		},
+		{
+			name:        "shorter prefix",
+			path:        "/proxy/network/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6/devices",
+			wantLimiter: sites,
+			wantName:    "/integration/v1/sites",
+		},
+		{
+			name:        "no match uses fallback",
+			path:        "/proxy/network/api/s/default/stat/health",
+			wantLimiter: fallback,
+			wantName:    "default",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, http.NoBody)
+			limiter, name := selector(req)
+			assert.Same(t, tt.wantLimiter, limiter)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func TestPathPrefixSelectorUsesClientPathCache(t *testing.T) {
+	t.Parallel()
+
+	paths := middleware.NewPathCache(0, nil)
+	selector := middleware.PathPrefixSelector("/proxy/network", map[string]*rate.Limiter{
+		"/integration/v1/sites": rate.NewLimiter(1, 1),
+	}, nil, paths)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/proxy/network/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6/devices", http.NoBody)
+	_, name := selector(req)
+	assert.Equal(t, "/integration/v1/sites", name)
+	assert.Equal(t, []string{"/integration/v1/sites/88f7af54-98f8-306a-a1c7-c9349722b1f6/devices"}, paths.Paths())
 }
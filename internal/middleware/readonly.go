@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// ReadOnly returns a middleware that fails every request that could change state
+// with unifierr.ErrReadOnlyClient, without sending it. GET, HEAD and OPTIONS requests
+// pass, as do requests of readOperations (see WithOperation): operations that read
+// through POST, such as filtered statistics queries. Requests without an operation,
+// such as raw requests, must use one of the safe methods.
+func ReadOnly(readOperations ...string) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &readOnlyTransport{next: next, readOperations: readOperations}
+	}
+}
+
+type readOnlyTransport struct {
+	next           http.RoundTripper
+	readOperations []string
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		//nolint:wrapcheck // Middleware passes through errors from next transport
+		return t.next.RoundTrip(req)
+	}
+
+	operation := Operation(req.Context())
+	if operation == "" {
+		return nil, errors.Wrapf(unifierr.ErrReadOnlyClient, "refused %s %s", req.Method, req.URL.Path)
+	}
+	if !slices.Contains(t.readOperations, operation) {
+		return nil, errors.Wrapf(unifierr.ErrReadOnlyClient, "refused %s: %s %s", operation, req.Method, req.URL.Path)
+	}
+
+	//nolint:wrapcheck // Middleware passes through errors from next transport
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,68 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestReadOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		method    string
+		operation string
+		wantSent  bool
+	}{
+		{name: "GET", method: http.MethodGet, operation: "ListSites", wantSent: true},
+		{name: "HEAD without operation", method: http.MethodHead, wantSent: true},
+		{name: "POST read operation", method: http.MethodPost, operation: "ListClientSessions", wantSent: true},
+		{name: "POST mutation", method: http.MethodPost, operation: "CreateDNSRecord"},
+		{name: "PUT", method: http.MethodPut, operation: "UpdateDNSRecord"},
+		{name: "DELETE", method: http.MethodDelete, operation: "DeleteDNSRecord"},
+		{name: "PATCH without operation", method: http.MethodPatch},
+		{name: "POST without operation", method: http.MethodPost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var sent atomic.Bool
+			transport := middleware.ReadOnly("ListClientSessions")(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				sent.Store(true)
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+			}))
+
+			ctx := context.Background()
+			if tt.operation != "" {
+				ctx = middleware.WithOperation(ctx, tt.operation)
+			}
+			req, err := http.NewRequestWithContext(ctx, tt.method, "https://unifi.local/proxy/network/v2/api/site/default/static-dns",
+				strings.NewReader("{}"))
+			require.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+			assert.Equal(t, tt.wantSent, sent.Load())
+			if tt.wantSent {
+				require.NoError(t, err)
+				resp.Body.Close()
+				return
+			}
+			require.ErrorIs(t, err, unifierr.ErrReadOnlyClient)
+			assert.Contains(t, err.Error(), tt.method+" /proxy/network/v2/api/site/default/static-dns")
+			if tt.operation != "" {
+				assert.Contains(t, err.Error(), tt.operation)
+			}
+		})
+	}
+}
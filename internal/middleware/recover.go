@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"runtime/debug"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// Recover returns a middleware that recovers panics raised further down the chain,
+// e.g. by a Logger, MetricsRecorder or retry hook supplied by the caller, and returns
+// them as a *unifierr.PanicError instead of crashing the process. It must be the
+// outermost middleware. The panic is logged and recorded as a "Panic" error; panics
+// of the logger and metrics recorder themselves are ignored at this point.
+func Recover(logger observability.Logger, metrics observability.MetricsRecorder) func(http.RoundTripper) http.RoundTripper {
+	if logger == nil {
+		logger = observability.NoopLogger()
+	}
+	if metrics == nil {
+		metrics = observability.NoopMetricsRecorder()
+	}
+
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &recoverTransport{next: next, logger: logger, metrics: metrics}
+	}
+}
+
+type recoverTransport struct {
+	next    http.RoundTripper
+	logger  observability.Logger
+	metrics observability.MetricsRecorder
+}
+
+func (t *recoverTransport) RoundTrip(req *http.Request) (resp *http.Response, err error) {
+	defer func() {
+		value := recover()
+		if value == nil {
+			return
+		}
+		panicErr := &unifierr.PanicError{Value: value, Stack: debug.Stack()}
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		t.report(req, panicErr)
+		resp, err = nil, errors.WithStack(panicErr)
+	}()
+
+	//nolint:wrapcheck // Middleware passes through errors from next handler in chain
+	return t.next.RoundTrip(req)
+}
+
+// report logs and records a recovered panic, shielding the caller from a second
+// panic raised by a faulty logger or metrics recorder.
+func (t *recoverTransport) report(req *http.Request, panicErr *unifierr.PanicError) {
+	ignorePanic(func() {
+		t.logger.Error("recovered panic during request",
+			observability.Field{Key: "method", Value: req.Method},
+			observability.Field{Key: "url", Value: req.URL.String()},
+			observability.Field{Key: "panic", Value: panicErr.Error()},
+			observability.Field{Key: "stack", Value: string(panicErr.Stack)},
+		)
+	})
+	ignorePanic(func() {
+		t.metrics.RecordError("http_request", "Panic")
+	})
+}
+
+// ignorePanic calls fn, discarding any panic it raises.
+func ignorePanic(fn func()) {
+	defer func() { _ = recover() }()
+	fn()
+}
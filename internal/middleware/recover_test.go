@@ -0,0 +1,91 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// panickingLogger panics on every log call.
+type panickingLogger struct{}
+
+func (l panickingLogger) With(...observability.Field) observability.Logger { return l }
+
+func (panickingLogger) Debug(string, ...observability.Field) { panic("logger") }
+func (panickingLogger) Info(string, ...observability.Field)  { panic("logger") }
+func (panickingLogger) Warn(string, ...observability.Field)  { panic("logger") }
+func (panickingLogger) Error(string, ...observability.Field) { panic("logger") }
+
+func TestRecover(t *testing.T) {
+	t.Parallel()
+
+	cause := errors.New("hook failed")
+	tests := []struct {
+		name   string
+		value  any
+		logger observability.Logger
+	}{
+		{name: "string panic", value: "boom"},
+		{name: "error panic", value: cause},
+		{name: "panicking logger", value: "boom", logger: panickingLogger{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := middleware.Recover(tt.logger, nil)(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+				panic(tt.value)
+			}))
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://unifi.local/api", http.NoBody)
+			require.NoError(t, err)
+			resp, err := transport.RoundTrip(req) //nolint:bodyclose // No response on panic
+			assert.Nil(t, resp)
+			require.ErrorIs(t, err, unifierr.ErrPanic)
+
+			var panicErr *unifierr.PanicError
+			require.ErrorAs(t, err, &panicErr)
+			assert.Equal(t, tt.value, panicErr.Value)
+			assert.NotEmpty(t, panicErr.Stack)
+			if valueErr, ok := tt.value.(error); ok {
+				assert.ErrorIs(t, err, valueErr)
+			}
+		})
+	}
+}
+
+func TestRecoverThroughChain(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: middleware.Recover(nil, nil)(
+		middleware.Observability(panickingLogger{}, nil, nil)(http.DefaultTransport),
+	)}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+	require.NoError(t, err)
+	resp, err := client.Do(req) //nolint:bodyclose // No response on panic
+	assert.Nil(t, resp)
+	require.ErrorIs(t, err, unifierr.ErrPanic)
+
+	// Without a panic, responses pass through unchanged.
+	req, err = http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+	require.NoError(t, err)
+	resp, err = middleware.Recover(nil, nil)(http.DefaultTransport).RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader is the header carrying the ID of a request.
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context whose requests are sent with id as their request ID
+// instead of a generated one, e.g. to propagate the ID of an incoming request.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns a middleware that sends a request ID in the X-Request-ID header:
+// the one set with WithRequestID, or a random UUID. A request that already carries
+// the header keeps it. Placed outside Retry, retries of a request share its ID.
+func RequestID() func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &requestIDTransport{next: next}
+	}
+}
+
+type requestIDTransport struct {
+	next http.RoundTripper
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(RequestIDHeader) == "" {
+		id, ok := req.Context().Value(requestIDKey{}).(string)
+		if !ok || id == "" {
+			id = uuid.NewString()
+		}
+		req = cloneRequest(req)
+		req.Header.Set(RequestIDHeader, id)
+	}
+
+	//nolint:wrapcheck // Middleware passes through errors from next handler in chain
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,141 @@
+package middleware_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// fieldLogger captures the fields of warning and debug entries.
+type fieldLogger struct {
+	observability.Logger
+
+	mu       sync.Mutex
+	warnings []map[string]any
+}
+
+func (l *fieldLogger) Debug(string, ...observability.Field) {}
+
+func (l *fieldLogger) Warn(_ string, fields ...observability.Field) {
+	entry := make(map[string]any, len(fields))
+	for _, field := range fields {
+		entry[field.Key] = field.Value
+	}
+	l.mu.Lock()
+	l.warnings = append(l.warnings, entry)
+	l.mu.Unlock()
+}
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		header string
+		want   string
+	}{
+		{name: "generated", ctx: context.Background()},
+		{name: "from context", ctx: middleware.WithRequestID(context.Background(), "incoming-42"), want: "incoming-42"},
+		{name: "already set", ctx: middleware.WithRequestID(context.Background(), "incoming-42"), header: "caller-7", want: "caller-7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get(middleware.RequestIDHeader)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			transport := middleware.RequestID()(http.DefaultTransport)
+
+			req, _ := http.NewRequestWithContext(tt.ctx, http.MethodGet, server.URL, http.NoBody)
+			if tt.header != "" {
+				req.Header.Set(middleware.RequestIDHeader, tt.header)
+			}
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			if tt.want == "" {
+				_, err := uuid.Parse(got)
+				require.NoError(t, err, "generated request ID should be a UUID")
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRequestIDSharedByRetries(t *testing.T) {
+	t.Parallel()
+
+	var ids []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get(middleware.RequestIDHeader))
+		if len(ids) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := middleware.RequestID()(middleware.Retry(middleware.RetryConfig{
+		MaxRetries:  1,
+		InitialWait: time.Millisecond,
+	})(http.DefaultTransport))
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Len(t, ids, 2)
+	assert.NotEmpty(t, ids[0])
+	assert.Equal(t, ids[0], ids[1])
+}
+
+func TestObservabilityLogsCorrelationIDs(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"code": "NOT_FOUND", "httpStatusCode": 404, "traceId": "a7dc15e0eb4527142d7823515b15f87d"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, body)
+	}))
+	defer server.Close()
+
+	logger := &fieldLogger{}
+	transport := middleware.RequestID()(middleware.Observability(logger, nil, nil)(http.DefaultTransport))
+
+	ctx := middleware.WithRequestID(context.Background(), "incoming-42")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	read, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, body, string(read), "the body should be readable after inspection")
+
+	require.Len(t, logger.warnings, 1)
+	assert.Equal(t, "incoming-42", logger.warnings[0]["request_id"])
+	assert.Equal(t, "a7dc15e0eb4527142d7823515b15f87d", logger.warnings[0]["trace_id"])
+}
@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/lexfrei/go-unifi/internal/backoff"
 	"github.com/lexfrei/go-unifi/internal/retry"
 	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+	"golang.org/x/time/rate"
 )
 
 // bodyBufferPool is a pool of bytes.Buffer for reusing memory when buffering request bodies.
@@ -21,12 +24,39 @@ var bodyBufferPool = sync.Pool{
 	},
 }
 
+// RetryDecisionFunc is called before every retry with the failed response (nil on
+// network errors), the number of the upcoming retry (starting at 1), and the computed
+// wait time. Returning false aborts the retry sequence: the failed response, or the
+// network error, is returned to the caller immediately.
+type RetryDecisionFunc func(resp *http.Response, attempt int, wait time.Duration) bool
+
 // RetryConfig configures the retry middleware.
 type RetryConfig struct {
 	MaxRetries  int
 	InitialWait time.Duration
 	Logger      observability.Logger
 	Metrics     observability.MetricsRecorder
+
+	// MaxWait caps the exponential backoff between retries; Retry-After headers
+	// are honored even above it (optional, 0 means uncapped).
+	MaxWait time.Duration
+
+	// Jitter is the fraction of each backoff wait that is randomized, see
+	// backoff.Policy (optional, 0 means fixed waits).
+	Jitter float64
+
+	// OnRetryDecision, if set, is consulted before every retry (optional).
+	OnRetryDecision RetryDecisionFunc
+
+	// DetectMaintenance, if set, fails requests answered with a 503 maintenance page
+	// immediately with *unifierr.MaintenanceError instead of retrying them (optional).
+	DetectMaintenance bool
+
+	// Budget, if set, is a token bucket every retry must take a token from. Sharing
+	// one budget between all requests of a client caps the extra load retries add
+	// during an outage: once it is empty, failures are returned as
+	// *unifierr.RetryBudgetError instead of being retried (optional).
+	Budget *rate.Limiter
 }
 
 // Retry returns a middleware that retries failed requests with exponential backoff.
@@ -35,6 +65,14 @@ type RetryConfig struct {
 // - 5xx server errors.
 // - 429 rate limit errors (respects Retry-After header).
 //
+// With a Budget, each retry consumes a token; a failure that finds the budget empty
+// is returned as *unifierr.RetryBudgetError and recorded as a "retry_budget_exhausted"
+// error metric.
+//
+// With DetectMaintenance, 503 responses announcing controller maintenance are not
+// retried but returned as *unifierr.MaintenanceError, since maintenance usually
+// outlasts the retry budget.
+//
 // It does NOT retry on:
 // - 4xx client errors (except 429).
 // - Successful responses (2xx, 3xx).
@@ -53,11 +91,18 @@ func Retry(cfg RetryConfig) func(http.RoundTripper) http.RoundTripper {
 
 	return func(next http.RoundTripper) http.RoundTripper {
 		return &retryTransport{
-			next:        next,
-			maxRetries:  cfg.MaxRetries,
-			initialWait: cfg.InitialWait,
+			next:       next,
+			maxRetries: cfg.MaxRetries,
+			backoff: backoff.Policy{
+				Initial: cfg.InitialWait,
+				Max:     cfg.MaxWait,
+				Jitter:  cfg.Jitter,
+			},
 			logger:      cfg.Logger,
 			metrics:     cfg.Metrics,
+			onDecision:  cfg.OnRetryDecision,
+			maintenance: cfg.DetectMaintenance,
+			budget:      cfg.Budget,
 		}
 	}
 }
@@ -65,9 +110,12 @@ func Retry(cfg RetryConfig) func(http.RoundTripper) http.RoundTripper {
 type retryTransport struct {
 	next        http.RoundTripper
 	maxRetries  int
-	initialWait time.Duration
+	backoff     backoff.Policy
 	logger      observability.Logger
 	metrics     observability.MetricsRecorder
+	onDecision  RetryDecisionFunc
+	maintenance bool
+	budget      *rate.Limiter
 }
 
 //nolint:funlen,gocyclo,cyclop // Retry logic requires comprehensive error handling and observability
@@ -94,6 +142,7 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	var lastErr error
 	var lastResp *http.Response
+	var attempts int
 
 	for attempt := 0; attempt <= t.maxRetries; attempt++ {
 		// Restore request body for retry
@@ -104,6 +153,22 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		// Make request
 		resp, err := t.next.RoundTrip(req)
 
+		// Maintenance outlasts any retry budget; report it instead of retrying
+		if err == nil && t.maintenance {
+			maintenanceErr := maintenanceError(resp)
+			if maintenanceErr != nil {
+				resp.Body.Close()
+				if buf != nil {
+					bodyBufferPool.Put(buf)
+				}
+				t.logger.Warn("controller in maintenance",
+					observability.Field{Key: "url", Value: req.URL.String()},
+					observability.Field{Key: "error", Value: maintenanceErr.Error()},
+				)
+				return nil, errors.WithStack(maintenanceErr)
+			}
+		}
+
 		// Success case
 		if err == nil && !retry.ShouldRetry(resp.StatusCode) {
 			// Return buffer to pool before returning
@@ -116,12 +181,33 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		// Store last error/response
 		lastErr = err
 		lastResp = resp
+		attempts = attempt + 1
 
 		// No more retries
 		if attempt == t.maxRetries {
 			break
 		}
 
+		// Calculate wait time
+		waitTime := t.calculateWait(attempt, resp)
+
+		// Let the caller veto the retry
+		if t.onDecision != nil && !t.onDecision(resp, attempt+1, waitTime) {
+			t.logger.Debug("retry aborted by decision callback",
+				observability.Field{Key: "attempt", Value: attempt + 1},
+				observability.Field{Key: "url", Value: req.URL.String()},
+			)
+			break
+		}
+
+		// Take a token from the shared budget
+		if t.budget != nil && !t.budget.Allow() {
+			if buf != nil {
+				bodyBufferPool.Put(buf)
+			}
+			return nil, t.budgetExhausted(req, resp, err)
+		}
+
 		// Log retry
 		t.logger.Warn("retrying request",
 			observability.Field{Key: "attempt", Value: attempt + 1},
@@ -132,18 +218,12 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 		t.metrics.RecordRetry(attempt+1, req.URL.Path)
 
-		// Calculate wait time
-		waitTime := t.calculateWait(attempt, resp)
-
 		// Wait before retry (respect context cancellation)
-		timer := time.NewTimer(waitTime)
-
-		select {
-		case <-timer.C:
-			// Timer expired, continue to retry
-		case <-ctx.Done():
-			// Stop timer and close response body before returning on context cancellation
-			timer.Stop()
+		waitStart := time.Now()
+		sleepErr := backoff.Sleep(ctx, waitTime)
+		waitStatsFrom(ctx).addRetryWait(time.Since(waitStart))
+		if sleepErr != nil {
+			// Close response body before returning on context cancellation
 			if resp != nil {
 				resp.Body.Close()
 			}
@@ -170,16 +250,33 @@ func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		bodyBufferPool.Put(buf)
 	}
 
-	// All retries exhausted
+	// All retries exhausted, or the decision callback stopped retrying
 	if lastResp != nil {
 		return lastResp, nil
 	}
 
-	return nil, errors.Wrapf(lastErr, "request failed after %d retries", t.maxRetries)
+	return nil, errors.Wrapf(lastErr, "request failed after %d attempts", attempts)
+}
+
+// budgetExhausted reports a failed attempt that cannot be retried for lack of budget.
+func (t *retryTransport) budgetExhausted(req *http.Request, resp *http.Response, err error) error {
+	budgetErr := &unifierr.RetryBudgetError{Err: err}
+	if resp != nil {
+		budgetErr.StatusCode = resp.StatusCode
+		resp.Body.Close()
+	}
+
+	t.logger.Warn("retry budget exhausted",
+		observability.Field{Key: "url", Value: req.URL.String()},
+		observability.Field{Key: "error", Value: budgetErr.Error()},
+	)
+	t.metrics.RecordError("retry", "retry_budget_exhausted")
+
+	return errors.WithStack(budgetErr)
 }
 
 // calculateWait determines how long to wait before next retry.
-// Uses exponential backoff: initialWait * 2^attempt
+// Uses exponential backoff: initialWait * 2^attempt, capped and jittered by the policy.
 // Respects Retry-After header for 429 responses.
 func (t *retryTransport) calculateWait(attempt int, resp *http.Response) time.Duration {
 	// Check Retry-After header for 429 responses
@@ -196,7 +293,7 @@ func (t *retryTransport) calculateWait(attempt int, resp *http.Response) time.Du
 	}
 
 	// Exponential backoff: initialWait * 2^attempt
-	wait := t.initialWait * time.Duration(1<<attempt)
+	wait := t.backoff.Delay(attempt)
 
 	t.logger.Debug("calculated exponential backoff",
 		observability.Field{Key: "attempt", Value: attempt},
@@ -6,13 +6,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/lexfrei/go-unifi/internal/middleware"
 	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
 )
 
 func TestRetry(t *testing.T) {
@@ -214,3 +217,257 @@ func TestRetryWithObservability(t *testing.T) {
 
 	assert.Equal(t, 3, attempts)
 }
+
+func TestRetryDecisionCallback(t *testing.T) {
+	t.Parallel()
+
+	t.Run("callback sees response headers and wait", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts++
+			if attempts < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.Header().Set("X-Trace-Id", "trace-123")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		var decisions []int
+		transport := middleware.Retry(middleware.RetryConfig{
+			MaxRetries:  3,
+			InitialWait: time.Millisecond,
+			OnRetryDecision: func(resp *http.Response, attempt int, wait time.Duration) bool {
+				assert.Equal(t, "trace-123", resp.Header.Get("X-Trace-Id"))
+				assert.Equal(t, time.Millisecond*time.Duration(1<<(attempt-1)), wait)
+				decisions = append(decisions, attempt)
+				return true
+			},
+		})(http.DefaultTransport)
+
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []int{1, 2}, decisions)
+	})
+
+	t.Run("callback aborts retries", func(t *testing.T) {
+		t.Parallel()
+
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		transport := middleware.Retry(middleware.RetryConfig{
+			MaxRetries:  5,
+			InitialWait: time.Millisecond,
+			OnRetryDecision: func(_ *http.Response, attempt int, _ time.Duration) bool {
+				return attempt < 2
+			},
+		})(http.DefaultTransport)
+
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("callback receives nil response on network error", func(t *testing.T) {
+		t.Parallel()
+
+		var gotNil bool
+		transport := middleware.Retry(middleware.RetryConfig{
+			MaxRetries:  3,
+			InitialWait: time.Millisecond,
+			OnRetryDecision: func(resp *http.Response, _ int, _ time.Duration) bool {
+				gotNil = resp == nil
+				return false
+			},
+		})(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, io.ErrUnexpectedEOF
+		}))
+
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://unifi.invalid", http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.True(t, gotNil)
+	})
+
+	t.Run("aborted network error reports attempts made", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		transport := middleware.Retry(middleware.RetryConfig{
+			MaxRetries:  5,
+			InitialWait: time.Millisecond,
+			OnRetryDecision: func(_ *http.Response, attempt int, _ time.Duration) bool {
+				return attempt < 2
+			},
+		})(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			calls.Add(1)
+			return nil, io.ErrUnexpectedEOF
+		}))
+
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://unifi.invalid", http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.Contains(t, err.Error(), "request failed after 2 attempts")
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
+
+// errorCounter records RecordError calls.
+type errorCounter struct {
+	observability.MetricsRecorder
+
+	errors atomic.Int32
+}
+
+func (m *errorCounter) RecordError(_, errorType string) {
+	if errorType == "retry_budget_exhausted" {
+		m.errors.Add(1)
+	}
+}
+
+func TestRetryBackoffPolicy(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	transport := middleware.Retry(middleware.RetryConfig{
+		MaxRetries:  4,
+		InitialWait: time.Millisecond,
+		MaxWait:     4 * time.Millisecond,
+		Jitter:      0.5,
+		OnRetryDecision: func(_ *http.Response, _ int, wait time.Duration) bool {
+			waits = append(waits, wait)
+			return true
+		},
+	})(http.DefaultTransport)
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Len(t, waits, 4)
+	for i, wait := range waits {
+		full := min(time.Millisecond*time.Duration(1<<i), 4*time.Millisecond)
+		assert.LessOrEqual(t, wait, full, "retry %d should be capped", i+1)
+		assert.GreaterOrEqual(t, wait, full/2, "retry %d should keep half of the wait", i+1)
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	t.Parallel()
+
+	t.Run("budget is shared between requests", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		metrics := &errorCounter{MetricsRecorder: observability.NoopMetricsRecorder()}
+		transport := middleware.Retry(middleware.RetryConfig{
+			MaxRetries:  3,
+			InitialWait: time.Millisecond,
+			Metrics:     metrics,
+			Budget:      rate.NewLimiter(rate.Every(time.Hour), 2),
+		})(http.DefaultTransport)
+
+		// First request spends both tokens, second request gets no retry
+		for _, want := range []int32{3, 4} {
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+			resp, err := transport.RoundTrip(req)
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			require.ErrorIs(t, err, unifierr.ErrRetryBudgetExhausted)
+			require.ErrorIs(t, err, unifierr.ErrUnavailable)
+
+			var budgetErr *unifierr.RetryBudgetError
+			require.ErrorAs(t, err, &budgetErr)
+			assert.Equal(t, http.StatusServiceUnavailable, budgetErr.StatusCode)
+			assert.Equal(t, want, attempts.Load())
+		}
+		assert.Equal(t, int32(2), metrics.errors.Load())
+	})
+
+	t.Run("network error keeps its cause", func(t *testing.T) {
+		t.Parallel()
+
+		transport := middleware.Retry(middleware.RetryConfig{
+			MaxRetries:  3,
+			InitialWait: time.Millisecond,
+			Budget:      rate.NewLimiter(rate.Every(time.Hour), 0),
+		})(roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, io.ErrUnexpectedEOF
+		}))
+
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://unifi.invalid", http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		require.ErrorIs(t, err, unifierr.ErrRetryBudgetExhausted)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("successful requests do not spend budget", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		budget := rate.NewLimiter(rate.Every(time.Hour), 1)
+		transport := middleware.Retry(middleware.RetryConfig{
+			MaxRetries: 3,
+			Budget:     budget,
+		})(http.DefaultTransport)
+
+		for range 3 {
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}
+		assert.InDelta(t, 1, budget.Tokens(), 0.01)
+	})
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
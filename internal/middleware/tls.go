@@ -12,18 +12,9 @@ import (
 // - Minimum TLS version enforcement.
 func TLSConfig(config *tls.Config) func(http.RoundTripper) http.RoundTripper {
 	return func(next http.RoundTripper) http.RoundTripper {
-		// Get underlying transport or create default
-		transport, ok := next.(*http.Transport)
+		transport, ok := baseTransport(next)
 		if !ok {
-			defaultTransport, ok := http.DefaultTransport.(*http.Transport)
-			if !ok {
-				// Should never happen, but handle gracefully
-				return next
-			}
-			transport = defaultTransport.Clone()
-			transport.ForceAttemptHTTP2 = true
-		} else {
-			transport = transport.Clone()
+			return next
 		}
 
 		// Apply TLS config
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// defaultKeepAlive matches the keep-alive period of http.DefaultTransport.
+const defaultKeepAlive = 30 * time.Second
+
+// TransportTimeouts bounds the phases of a request up to the response headers. Unlike
+// http.Client.Timeout, none of them limits reading the response body, so large
+// downloads are not cut short by a timeout sized for quick calls. Zero keeps the
+// default of http.DefaultTransport.
+type TransportTimeouts struct {
+	// Dial bounds establishing the TCP connection, including DNS resolution.
+	Dial time.Duration
+
+	// TLSHandshake bounds the TLS handshake.
+	TLSHandshake time.Duration
+
+	// ResponseHeader bounds waiting for the response headers after the request was
+	// written, i.e. the time the server takes to start answering.
+	ResponseHeader time.Duration
+}
+
+// Timeouts returns a middleware that applies timeouts to the underlying *http.Transport.
+// Like TLSConfig, it configures rather than wraps the transport and must be innermost.
+func Timeouts(timeouts TransportTimeouts) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		if timeouts == (TransportTimeouts{}) {
+			return next
+		}
+
+		transport, ok := baseTransport(next)
+		if !ok {
+			return next
+		}
+
+		if timeouts.Dial > 0 {
+			transport.DialContext = (&net.Dialer{Timeout: timeouts.Dial, KeepAlive: defaultKeepAlive}).DialContext
+		}
+		if timeouts.TLSHandshake > 0 {
+			transport.TLSHandshakeTimeout = timeouts.TLSHandshake
+		}
+		if timeouts.ResponseHeader > 0 {
+			transport.ResponseHeaderTimeout = timeouts.ResponseHeader
+		}
+
+		return transport
+	}
+}
+
+// baseTransport returns a copy of next if it is an *http.Transport, or of
+// http.DefaultTransport otherwise, for middleware that configure the transport.
+func baseTransport(next http.RoundTripper) (*http.Transport, bool) {
+	if transport, ok := next.(*http.Transport); ok {
+		return transport.Clone(), true
+	}
+
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		// Should never happen, but handle gracefully
+		return nil, false
+	}
+	transport := defaultTransport.Clone()
+	transport.ForceAttemptHTTP2 = true
+	return transport, true
+}
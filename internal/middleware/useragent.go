@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type userAgentKey struct{}
+
+// WithUserAgent returns a context whose requests identify themselves with userAgent
+// instead of the one configured on the client. The SDK token is still appended.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey{}, userAgent)
+}
+
+// UserAgent returns a middleware that sets the User-Agent header to userAgent, or to
+// the one set with WithUserAgent on the request context, followed by the sdk product
+// token, e.g. "my-exporter/1.2 go-unifi/v0.9.0".
+func UserAgent(userAgent, sdk string) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return &userAgentTransport{next: next, userAgent: userAgent, sdk: sdk}
+	}
+}
+
+type userAgentTransport struct {
+	next      http.RoundTripper
+	userAgent string
+	sdk       string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	userAgent := t.userAgent
+	if override, ok := req.Context().Value(userAgentKey{}).(string); ok {
+		userAgent = override
+	}
+
+	req = cloneRequest(req)
+	req.Header.Set("User-Agent", strings.TrimSpace(userAgent+" "+t.sdk))
+
+	//nolint:wrapcheck // Middleware passes through errors from next handler in chain
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,55 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+)
+
+func TestUserAgent(t *testing.T) {
+	t.Parallel()
+
+	backupJob, cleared := "backup-job", ""
+	tests := []struct {
+		name      string
+		userAgent string
+		override  *string
+		want      string
+	}{
+		{name: "SDK only", want: "go-unifi/v0.9.0"},
+		{name: "configured", userAgent: "my-exporter/1.2", want: "my-exporter/1.2 go-unifi/v0.9.0"},
+		{name: "per request", userAgent: "my-exporter/1.2", override: &backupJob, want: "backup-job go-unifi/v0.9.0"},
+		{name: "per request cleared", userAgent: "my-exporter/1.2", override: &cleared, want: "go-unifi/v0.9.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.want, r.Header.Get("User-Agent"))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			transport := middleware.UserAgent(tt.userAgent, "go-unifi/v0.9.0")(http.DefaultTransport)
+
+			ctx := context.Background()
+			if tt.override != nil {
+				ctx = middleware.WithUserAgent(ctx, *tt.override)
+			}
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+			resp, err := transport.RoundTrip(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Empty(t, req.Header.Get("User-Agent"), "original request should not be modified")
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// WaitStats accumulates the time requests made with a context spent waiting in the
+// client rather than on the network: on the rate limiter and between retries.
+// It is safe for concurrent use.
+type WaitStats struct {
+	rateLimit atomic.Int64
+	retry     atomic.Int64
+	retries   atomic.Int64
+
+	// parent, if set, also receives the waits, e.g. the stats of the caller's context
+	// for the stats of one request.
+	parent *WaitStats
+}
+
+type waitStatsKey struct{}
+
+// WithWaitStats returns a context that records the waits of every request made with it
+// into the returned WaitStats. If ctx already carries WaitStats, they are replaced for
+// requests made with the returned context.
+func WithWaitStats(ctx context.Context) (context.Context, *WaitStats) {
+	stats := &WaitStats{}
+	return context.WithValue(ctx, waitStatsKey{}, stats), stats
+}
+
+// RateLimitWait returns the total time spent waiting on the rate limiter.
+func (s *WaitStats) RateLimitWait() time.Duration {
+	return time.Duration(s.rateLimit.Load())
+}
+
+// RetryWait returns the total time spent waiting between retries.
+func (s *WaitStats) RetryWait() time.Duration {
+	return time.Duration(s.retry.Load())
+}
+
+// Retries returns the number of retries made.
+func (s *WaitStats) Retries() int {
+	return int(s.retries.Load())
+}
+
+// Total returns the total time spent waiting on the rate limiter and between retries.
+func (s *WaitStats) Total() time.Duration {
+	return s.RateLimitWait() + s.RetryWait()
+}
+
+// waitStatsFrom returns the WaitStats carried by ctx, or nil.
+func waitStatsFrom(ctx context.Context) *WaitStats {
+	stats, _ := ctx.Value(waitStatsKey{}).(*WaitStats) //nolint:errcheck // Absent stats are nil
+	return stats
+}
+
+// withRequestWaitStats returns a context recording the waits of one request into the
+// returned WaitStats, as well as into the WaitStats ctx carries, if any.
+func withRequestWaitStats(ctx context.Context) (context.Context, *WaitStats) {
+	stats := &WaitStats{parent: waitStatsFrom(ctx)}
+	return context.WithValue(ctx, waitStatsKey{}, stats), stats
+}
+
+// latency splits total, the duration of a request, into the waits recorded and the rest.
+func (s *WaitStats) latency(total time.Duration) observability.Latency {
+	latency := observability.Latency{
+		Total:         total,
+		RateLimitWait: s.RateLimitWait(),
+		RetryWait:     s.RetryWait(),
+		Retries:       s.Retries(),
+	}
+	latency.Server = max(total-latency.ClientWait(), 0)
+	return latency
+}
+
+func (s *WaitStats) addRateLimitWait(d time.Duration) {
+	for ; s != nil; s = s.parent {
+		s.rateLimit.Add(int64(d))
+	}
+}
+
+func (s *WaitStats) addRetryWait(d time.Duration) {
+	for ; s != nil; s = s.parent {
+		s.retries.Add(1)
+		s.retry.Add(int64(d))
+	}
+}
@@ -0,0 +1,125 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lexfrei/go-unifi/internal/middleware"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestWaitStats(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// Fail every first attempt, so each request is retried once
+		if attempts.Add(1)%2 == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// One token per 50ms: the second request waits on the limiter
+	limiter := rate.NewLimiter(rate.Every(50*time.Millisecond), 1)
+	transport := middleware.RateLimit(middleware.RateLimitConfig{Limiter: limiter})(
+		middleware.Retry(middleware.RetryConfig{
+			MaxRetries:  1,
+			InitialWait: 20 * time.Millisecond,
+		})(http.DefaultTransport),
+	)
+
+	ctx, stats := middleware.WithWaitStats(context.Background())
+	for range 2 {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, 2, stats.Retries())
+	assert.GreaterOrEqual(t, stats.RetryWait(), 40*time.Millisecond)
+	assert.Positive(t, stats.RateLimitWait())
+	assert.Equal(t, stats.RateLimitWait()+stats.RetryWait(), stats.Total())
+
+	// Requests without stats in their context are not recorded
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, http.NoBody)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 2, stats.Retries())
+}
+
+// latencyRecorder records latency metrics on top of the noop recorder.
+type latencyRecorder struct {
+	observability.MetricsRecorder
+
+	mu        sync.Mutex
+	latencies map[string]observability.Latency
+}
+
+func (r *latencyRecorder) RecordLatency(operation string, latency observability.Latency) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.latencies[operation] = latency
+}
+
+func TestObservabilityRecordsLatency(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	recorder := &latencyRecorder{
+		MetricsRecorder: observability.NoopMetricsRecorder(),
+		latencies:       map[string]observability.Latency{},
+	}
+	logger := &fieldLogger{}
+	limiter := rate.NewLimiter(rate.Every(30*time.Millisecond), 1)
+	limiter.Allow() // the request waits for the next token
+	transport := middleware.Observability(logger, recorder, nil)(
+		middleware.RateLimit(middleware.RateLimitConfig{Limiter: limiter})(
+			middleware.Retry(middleware.RetryConfig{MaxRetries: 1, InitialWait: 20 * time.Millisecond})(http.DefaultTransport),
+		),
+	)
+
+	ctx, callerStats := middleware.WithWaitStats(middleware.WithOperation(context.Background(), "GetHostByID"))
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	recorder.mu.Lock()
+	latency, ok := recorder.latencies["GetHostByID"]
+	recorder.mu.Unlock()
+	require.True(t, ok)
+	assert.Equal(t, 1, latency.Retries)
+	assert.GreaterOrEqual(t, latency.RetryWait, 20*time.Millisecond)
+	assert.Positive(t, latency.RateLimitWait)
+	assert.GreaterOrEqual(t, latency.Server, 10*time.Millisecond)
+	assert.Equal(t, latency.Total, latency.Server+latency.ClientWait())
+
+	assert.Equal(t, latency.ClientWait(), callerStats.Total(), "the caller's stats receive the waits too")
+
+	require.Len(t, logger.warnings, 1)
+	assert.Equal(t, latency.Server, logger.warnings[0]["server_duration"])
+	assert.Equal(t, latency.ClientWait(), logger.warnings[0]["client_wait"])
+}
@@ -9,3 +9,23 @@ import "golang.org/x/time/rate"
 func NewRateLimiter(requestsPerMinute int) *rate.Limiter {
 	return rate.NewLimiter(rate.Limit(float64(requestsPerMinute)/60.0), requestsPerMinute)
 }
+
+// NewOptionalRateLimiter is NewRateLimiter for settings where a negative
+// requestsPerMinute turns rate limiting off. It returns nil, meaning no limit,
+// if requestsPerMinute is negative.
+func NewOptionalRateLimiter(requestsPerMinute int) *rate.Limiter {
+	if requestsPerMinute < 0 {
+		return nil
+	}
+	return NewRateLimiter(requestsPerMinute)
+}
+
+// NewRetryBudget creates a token bucket limiting retries to retriesPerMinute, with
+// the same refill and burst semantics as NewRateLimiter. It returns nil, meaning
+// no budget, if retriesPerMinute is not positive.
+func NewRetryBudget(retriesPerMinute int) *rate.Limiter {
+	if retriesPerMinute <= 0 {
+		return nil
+	}
+	return NewRateLimiter(retriesPerMinute)
+}
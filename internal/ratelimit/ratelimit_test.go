@@ -211,3 +211,26 @@ func TestConcurrentWait(t *testing.T) {
 	wg.Wait()
 	// If no race detector warnings, test passes
 }
+
+func TestNewOptionalRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, NewOptionalRateLimiter(-1))
+
+	limiter := NewOptionalRateLimiter(120)
+	require.NotNil(t, limiter)
+	assert.Equal(t, 120, limiter.Burst())
+	assert.InDelta(t, 2.0, float64(limiter.Limit()), 0.001)
+}
+
+func TestNewRetryBudget(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, NewRetryBudget(0))
+	assert.Nil(t, NewRetryBudget(-1))
+
+	budget := NewRetryBudget(30)
+	require.NotNil(t, budget)
+	assert.Equal(t, 30, budget.Burst())
+	assert.InDelta(t, 0.5, float64(budget.Limit()), 0.001)
+}
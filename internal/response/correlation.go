@@ -0,0 +1,71 @@
+package response
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// requestIDHeader mirrors middleware.RequestIDHeader, which cannot be imported here.
+const requestIDHeader = "X-Request-ID"
+
+// TraceID returns the trace ID in a response body, as returned by the Site Manager
+// API in its traceId field, or "" if there is none.
+func TraceID(body []byte) string {
+	var envelope struct {
+		TraceID string `json:"traceId"`
+	}
+	if json.Unmarshal(body, &envelope) != nil {
+		return ""
+	}
+	return envelope.TraceID
+}
+
+// NewAPIError returns the error for a response with an unexpected status, with the
+// ID of the request that caused it and the trace ID returned by the API, if any.
+func NewAPIError(resp *http.Response, body []byte) *unifierr.APIError {
+	apiErr := &unifierr.APIError{StatusCode: resp.StatusCode, TraceID: TraceID(body)}
+	if resp.Request != nil {
+		apiErr.RequestID = resp.Request.Header.Get(requestIDHeader)
+	}
+	return apiErr
+}
+
+// apiError returns the error for a generated response with an unexpected status.
+func apiError(resp StatusCoder) *unifierr.APIError {
+	httpResp, body := rawResponse(resp)
+	if httpResp == nil {
+		return &unifierr.APIError{StatusCode: resp.StatusCode()}
+	}
+	return NewAPIError(httpResp, body)
+}
+
+// rawResponse returns the HTTPResponse and Body fields shared by all generated
+// response types, or nil if resp has none.
+func rawResponse(resp StatusCoder) (*http.Response, []byte) {
+	v := reflect.ValueOf(resp)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	httpResp, _ := fieldValue(v, "HTTPResponse").(*http.Response) //nolint:errcheck // Absent or mistyped fields are ignored
+	body, _ := fieldValue(v, "Body").([]byte)                     //nolint:errcheck // Absent or mistyped fields are ignored
+	return httpResp, body
+}
+
+// fieldValue returns the value of the exported field name of struct v, or nil.
+func fieldValue(v reflect.Value, name string) any {
+	field := v.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+	return field.Interface()
+}
@@ -0,0 +1,54 @@
+package response_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// generatedResponse mirrors the shape of oapi-codegen response types.
+type generatedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *mockData
+}
+
+func (r *generatedResponse) StatusCode() int {
+	return r.HTTPResponse.StatusCode
+}
+
+func TestTraceID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "a7dc15e0", response.TraceID([]byte(`{"code": "NOT_FOUND", "traceId": "a7dc15e0"}`)))
+	assert.Empty(t, response.TraceID([]byte(`{"meta": {"rc": "error"}}`)))
+	assert.Empty(t, response.TraceID([]byte(`<html>Bad Gateway</html>`)))
+}
+
+func TestHandleCorrelationIDs(t *testing.T) {
+	t.Parallel()
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.ui.com/v1/hosts/missing", http.NoBody)
+	req.Header.Set("X-Request-ID", "req-1")
+	resp := &generatedResponse{
+		Body:         []byte(`{"code": "NOT_FOUND", "httpStatusCode": 404, "traceId": "trace-1"}`),
+		HTTPResponse: &http.Response{StatusCode: http.StatusNotFound, Request: req},
+	}
+
+	_, err := response.Handle(resp, resp.JSON200, nil, "failed to get host")
+	require.ErrorIs(t, err, unifierr.ErrNotFound)
+
+	var apiErr *unifierr.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "req-1", apiErr.RequestID)
+	assert.Equal(t, "trace-1", apiErr.TraceID)
+
+	err = response.HandleNoContent(resp, nil, "failed to delete host")
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "trace-1", apiErr.TraceID)
+}
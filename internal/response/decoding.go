@@ -0,0 +1,112 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// StrictMode controls how response fields that are not part of the client's models are treated.
+type StrictMode int
+
+const (
+	// StrictOff ignores unknown fields. This is the default.
+	StrictOff StrictMode = iota
+	// StrictLog logs unknown fields and enum values as warnings and returns the decoded data.
+	StrictLog
+	// StrictFail fails the call when the response contains unknown fields.
+	StrictFail
+)
+
+// Decoder applies a StrictMode to response bodies.
+// A nil Decoder behaves like StrictOff.
+type Decoder struct {
+	Mode   StrictMode
+	Logger observability.Logger
+
+	// OnDecoded, if set, is called with the decoded data and raw body of every
+	// successful response, e.g. to retain raw JSON alongside typed models.
+	OnDecoded func(data any, body []byte) error
+
+	// Lenient makes ParseList skip list elements that fail to decode instead
+	// of failing the whole response.
+	Lenient bool
+
+	// Unmarshal, if set, replaces encoding/json.Unmarshal in ParseList, e.g. with a
+	// faster drop-in implementation such as go-json or sonic.
+	Unmarshal func(data []byte, v any) error
+}
+
+// HandleDecoded is like Handle but additionally checks the raw response body
+// against the model type according to the decoder's StrictMode. In the strict modes,
+// enum values unknown to the model are logged as well, but never fail the call.
+//
+// Usage:
+//
+//	resp, err := c.client.GetDeviceByIdWithResponse(ctx, siteID, deviceID)
+//	return response.HandleDecoded(c.decoder, resp, resp.Body, resp.JSON200, err, "failed to get device")
+func HandleDecoded[T any](dec *Decoder, resp StatusCoder, body []byte, data *T, err error, errorMsg string) (*T, error) {
+	result, err := Handle(resp, data, err, errorMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkDecoded(dec, result, body, errorMsg)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkDecoded checks the raw body of a successful response decoded into result
+// according to the decoder's options. dec may be nil.
+func checkDecoded[T any](dec *Decoder, result *T, body []byte, errorMsg string) error {
+	err := CheckUnknownFields[T](dec, body, errorMsg)
+	if err != nil {
+		return err
+	}
+	dec.logUnknownEnums(result, errorMsg)
+
+	if dec != nil && dec.OnDecoded != nil {
+		err = dec.OnDecoded(result, body)
+		if err != nil {
+			return errors.Wrap(err, errorMsg)
+		}
+	}
+	return nil
+}
+
+// CheckUnknownFields decodes body into a new T with unknown fields disallowed.
+// Depending on the decoder's mode, a mismatch is ignored, logged, or returned
+// as an error matching unifierr.ErrUnknownField.
+func CheckUnknownFields[T any](dec *Decoder, body []byte, errorMsg string) error {
+	if dec == nil || dec.Mode == StrictOff || len(body) == 0 {
+		return nil
+	}
+
+	jsonDecoder := json.NewDecoder(bytes.NewReader(body))
+	jsonDecoder.DisallowUnknownFields()
+
+	var target T
+	err := jsonDecoder.Decode(&target)
+	if err == nil {
+		return nil
+	}
+
+	if dec.Mode == StrictLog {
+		if dec.Logger != nil {
+			dec.Logger.Warn("response does not match client model",
+				observability.Field{Key: "context", Value: errorMsg},
+				observability.Field{Key: "error", Value: err.Error()},
+			)
+		}
+		return nil
+	}
+
+	return errors.Wrap(fmt.Errorf("%w: %w", unifierr.ErrUnknownField, err), errorMsg)
+}
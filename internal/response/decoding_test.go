@@ -0,0 +1,133 @@
+package response_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// recordingLogger captures warning messages for assertions.
+type recordingLogger struct {
+	observability.Logger
+
+	warnings []string
+}
+
+func (l *recordingLogger) Warn(msg string, _ ...observability.Field) {
+	l.warnings = append(l.warnings, msg)
+}
+
+func TestCheckUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	knownBody := []byte(`{"Value":"test"}`)
+	unknownBody := []byte(`{"Value":"test","extra":1}`)
+
+	tests := []struct {
+		name         string
+		mode         response.StrictMode
+		body         []byte
+		wantErr      bool
+		wantWarnings int
+	}{
+		{name: "off ignores unknown fields", mode: response.StrictOff, body: unknownBody},
+		{name: "log reports unknown fields", mode: response.StrictLog, body: unknownBody, wantWarnings: 1},
+		{name: "fail rejects unknown fields", mode: response.StrictFail, body: unknownBody, wantErr: true},
+		{name: "fail accepts known fields", mode: response.StrictFail, body: knownBody},
+		{name: "fail accepts empty body", mode: response.StrictFail, body: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := &recordingLogger{Logger: observability.NoopLogger()}
+			dec := &response.Decoder{Mode: tt.mode, Logger: logger}
+
+			err := response.CheckUnknownFields[mockData](dec, tt.body, "test error")
+			if tt.wantErr {
+				require.ErrorIs(t, err, unifierr.ErrUnknownField)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Len(t, logger.warnings, tt.wantWarnings)
+		})
+	}
+}
+
+func TestCheckUnknownFieldsNilDecoder(t *testing.T) {
+	t.Parallel()
+
+	err := response.CheckUnknownFields[mockData](nil, []byte(`{"extra":1}`), "test error")
+	require.NoError(t, err)
+}
+
+func TestHandleDecoded(t *testing.T) {
+	t.Parallel()
+
+	dec := &response.Decoder{Mode: response.StrictFail}
+	data := &mockData{Value: "test"}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		resp := &mockResponse{statusCode: http.StatusOK}
+
+		result, err := response.HandleDecoded(dec, resp, []byte(`{"Value":"test"}`), data, nil, "test error")
+		require.NoError(t, err)
+		assert.Same(t, data, result)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		t.Parallel()
+
+		resp := &mockResponse{statusCode: http.StatusOK}
+
+		_, err := response.HandleDecoded(dec, resp, []byte(`{"Value":"test","extra":1}`), data, nil, "test error")
+		require.ErrorIs(t, err, unifierr.ErrUnknownField)
+	})
+
+	t.Run("status error takes precedence", func(t *testing.T) {
+		t.Parallel()
+
+		resp := &mockResponse{statusCode: http.StatusNotFound}
+
+		_, err := response.HandleDecoded(dec, resp, []byte(`{"extra":1}`), data, nil, "test error")
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+	})
+}
+
+func TestHandleDecodedOnDecoded(t *testing.T) {
+	t.Parallel()
+
+	resp := &mockResponse{statusCode: http.StatusOK}
+	data := &mockData{Value: "test"}
+	body := []byte(`{"Value":"test"}`)
+
+	var gotData any
+	var gotBody []byte
+	dec := &response.Decoder{
+		OnDecoded: func(data any, body []byte) error {
+			gotData = data
+			gotBody = body
+			return nil
+		},
+	}
+
+	_, err := response.HandleDecoded(dec, resp, body, data, nil, "test error")
+	require.NoError(t, err)
+	assert.Same(t, data, gotData)
+	assert.Equal(t, body, gotBody)
+
+	dec.OnDecoded = func(any, []byte) error { return errors.New("hook failed") }
+	_, err = response.HandleDecoded(dec, resp, body, data, nil, "test error")
+	require.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package response
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// Enum is implemented by the enum types of the models. Values the API specification
+// does not define decode as is, and are reported by IsKnown.
+type Enum interface {
+	IsKnown() bool
+	Raw() string
+}
+
+var enumType = reflect.TypeFor[Enum]()
+
+// UnknownEnums returns the enum values of v that are not known, as "path=value" entries
+// such as "Data[].State=ISOLATED", sorted and without duplicates. Zero values, which
+// stand for absent fields, are skipped.
+func UnknownEnums(v any) []string {
+	found := map[string]bool{}
+	collectUnknownEnums(reflect.ValueOf(v), "", found)
+	return slices.Sorted(maps.Keys(found))
+}
+
+func collectUnknownEnums(v reflect.Value, path string, found map[string]bool) {
+	if !v.IsValid() {
+		return
+	}
+	if v.Type().Implements(enumType) && v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface {
+		if enum, ok := v.Interface().(Enum); ok && !v.IsZero() && !enum.IsKnown() {
+			found[strings.TrimPrefix(path, ".")+"="+enum.Raw()] = true
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			collectUnknownEnums(v.Elem(), path, found)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if field := v.Type().Field(i); field.IsExported() {
+				collectUnknownEnums(v.Field(i), path+"."+field.Name, found)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return // raw JSON retained alongside the models
+		}
+		for i := range v.Len() {
+			collectUnknownEnums(v.Index(i), path+"[]", found)
+		}
+	case reflect.Map:
+		for iter := v.MapRange(); iter.Next(); {
+			collectUnknownEnums(iter.Value(), path+"[]", found)
+		}
+	}
+}
+
+// logUnknownEnums warns about the enum values of data that are not known, in the strict
+// modes. They are not an error: the values are kept, for callers to handle with Raw.
+func (dec *Decoder) logUnknownEnums(data any, errorMsg string) {
+	if dec == nil || dec.Mode == StrictOff || dec.Logger == nil {
+		return
+	}
+	unknown := UnknownEnums(data)
+	if len(unknown) == 0 {
+		return
+	}
+	dec.Logger.Warn("response contains enum values unknown to client model",
+		observability.Field{Key: "context", Value: errorMsg},
+		observability.Field{Key: "values", Value: strings.Join(unknown, ", ")},
+	)
+}
@@ -0,0 +1,71 @@
+package response_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+type mockState string
+
+func (s mockState) IsKnown() bool { return s == "ONLINE" || s == "OFFLINE" }
+func (s mockState) Raw() string   { return string(s) }
+
+type mockDevice struct {
+	State   mockState            `json:"state"`
+	Uplink  *mockState           `json:"uplink,omitempty"`
+	Ports   map[string]mockState `json:"ports,omitempty"`
+	RawJSON json.RawMessage      `json:"-"`
+}
+
+type mockDevices struct {
+	Data []mockDevice `json:"data"`
+}
+
+func TestUnknownEnums(t *testing.T) {
+	t.Parallel()
+
+	var devices mockDevices
+	require.NoError(t, json.Unmarshal([]byte(`{"data": [
+		{"state": "ONLINE"},
+		{"state": "ISOLATED", "uplink": "DEGRADED"},
+		{"state": "ISOLATED", "ports": {"1": "ONLINE", "2": "SLEEPING"}},
+		{}
+	]}`), &devices))
+
+	assert.Equal(t, mockState("ISOLATED"), devices.Data[1].State, "unknown values are kept")
+	assert.Equal(t, []string{
+		"Data[].Ports[]=SLEEPING",
+		"Data[].State=ISOLATED",
+		"Data[].Uplink=DEGRADED",
+	}, response.UnknownEnums(&devices))
+	assert.Empty(t, response.UnknownEnums(&mockDevices{Data: []mockDevice{{State: "OFFLINE"}}}))
+	assert.Empty(t, response.UnknownEnums(nil))
+}
+
+func TestHandleDecodedUnknownEnums(t *testing.T) {
+	t.Parallel()
+
+	resp := &mockResponse{statusCode: http.StatusOK}
+	body := []byte(`{"state":"ISOLATED"}`)
+
+	for _, mode := range []response.StrictMode{response.StrictOff, response.StrictLog, response.StrictFail} {
+		logger := &recordingLogger{Logger: observability.NoopLogger()}
+		dec := &response.Decoder{Mode: mode, Logger: logger}
+
+		result, err := response.HandleDecoded(dec, resp, body, &mockDevice{State: "ISOLATED"}, nil, "test error")
+		require.NoError(t, err, "unknown enum values never fail the call")
+		assert.Equal(t, "ISOLATED", result.State.Raw())
+		if mode == response.StrictOff {
+			assert.Empty(t, logger.warnings)
+		} else {
+			assert.Len(t, logger.warnings, 1)
+		}
+	}
+}
@@ -15,6 +15,8 @@ type StatusCoder interface {
 
 // Handle is a generic handler for API responses that return data (GET, POST, PUT).
 // It checks for errors, validates status code (expects 200 OK), and ensures response data is non-nil.
+// Unexpected status codes are reported as *unifierr.APIError, which matches the unifierr sentinel errors
+// and carries the request ID and the trace ID returned by the API, if any.
 //
 // Usage:
 //
@@ -41,8 +43,7 @@ func HandleWithStatus[T any](resp StatusCoder, data *T, err error, errorMsg stri
 	}
 
 	if resp.StatusCode() != expectedStatus {
-		//nolint:wrapcheck // Creating new error for non-expected status, no source error to wrap
-		return nil, errors.Newf("API error: status=%d", resp.StatusCode())
+		return nil, errors.WithStack(apiError(resp))
 	}
 
 	if data == nil {
@@ -53,14 +54,27 @@ func HandleWithStatus[T any](resp StatusCoder, data *T, err error, errorMsg stri
 }
 
 // HandleNoContent is a handler for API responses that don't return data (DELETE).
-// It checks for errors and validates status code (expects 200 OK).
+// It checks for errors and validates status code: 200 OK, 202 Accepted and
+// 204 No Content are successes, whatever the body. Use HandleOutcome to tell them apart.
 //
 // Usage:
 //
 //	resp, err := c.client.DeleteResourceWithResponse(ctx, id)
 //	return response.HandleNoContent(resp, err, "failed to delete resource")
 func HandleNoContent(resp StatusCoder, err error, errorMsg string) error {
-	return HandleNoContentWithStatus(resp, err, errorMsg, http.StatusOK)
+	if err != nil {
+		return errors.Wrap(err, errorMsg)
+	}
+
+	if resp == nil {
+		return errors.New("nil response from API client")
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent:
+		return nil
+	}
+	return errors.WithStack(apiError(resp))
 }
 
 // HandleNoContentWithStatus is like HandleNoContent but allows specifying expected status code.
@@ -80,8 +94,7 @@ func HandleNoContentWithStatus(resp StatusCoder, err error, errorMsg string, exp
 	}
 
 	if resp.StatusCode() != expectedStatus {
-		//nolint:wrapcheck // Creating new error for non-expected status, no source error to wrap
-		return errors.Newf("API error: status=%d", resp.StatusCode())
+		return errors.WithStack(apiError(resp))
 	}
 
 	return nil
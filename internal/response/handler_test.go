@@ -6,6 +6,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -60,6 +61,12 @@ func TestHandle(t *testing.T) {
 
 		_, err := response.Handle(resp, data, nil, "test error")
 		require.Error(t, err, "Handle() should return error")
+
+		assert.ErrorIs(t, err, unifierr.ErrNotFound, "Handle() error should match status class")
+
+		var apiErr *unifierr.APIError
+		require.ErrorAs(t, err, &apiErr, "Handle() error should expose status code")
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
 	})
 
 	t.Run("nil data", func(t *testing.T) {
@@ -110,6 +117,15 @@ func TestHandleNoContent(t *testing.T) {
 		require.NoError(t, err, "HandleNoContent() should not return error")
 	})
 
+	t.Run("accepted and no content", func(t *testing.T) {
+		t.Parallel()
+
+		for _, status := range []int{http.StatusAccepted, http.StatusNoContent} {
+			err := response.HandleNoContent(&mockResponse{statusCode: status}, nil, "test error")
+			require.NoError(t, err, "HandleNoContent() should accept status %d", status)
+		}
+	})
+
 	t.Run("client error", func(t *testing.T) {
 		t.Parallel()
 
@@ -129,6 +145,8 @@ func TestHandleNoContent(t *testing.T) {
 
 		err := response.HandleNoContent(resp, nil, "test error")
 		require.Error(t, err, "HandleNoContent() should return error")
+
+		assert.ErrorIs(t, err, unifierr.ErrNotFound, "HandleNoContent() error should match status class")
 	})
 }
 
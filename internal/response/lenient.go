@@ -0,0 +1,65 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DecodeWarning reports a list element that was skipped because it could not be decoded.
+type DecodeWarning struct {
+	// Index is the position of the element in the "data" array of the response.
+	Index int
+
+	// Raw is the JSON of the skipped element.
+	Raw json.RawMessage
+
+	// Err is the decoding error.
+	Err error
+}
+
+// String describes the skipped element.
+func (w DecodeWarning) String() string {
+	return fmt.Sprintf("element %d skipped: %v", w.Index, w.Err)
+}
+
+// dropUndecodable returns body without the "data" elements that do not decode into T,
+// and a warning for each of them. It returns no warnings when the envelope itself
+// does not decode, since dropping elements cannot fix that.
+func dropUndecodable[T any](dec *Decoder, body []byte) ([]byte, []DecodeWarning) {
+	var envelope map[string]json.RawMessage
+	if json.Unmarshal(body, &envelope) != nil {
+		return nil, nil
+	}
+	items, err := RawArray(envelope["data"])
+	if err != nil {
+		return nil, nil
+	}
+
+	decodes := func(data []json.RawMessage) error {
+		envelope["data"], _ = json.Marshal(data)
+		encoded, _ := json.Marshal(envelope)
+		var target T
+		return dec.unmarshal(encoded, &target)
+	}
+	if decodes(nil) != nil {
+		return nil, nil
+	}
+
+	var warnings []DecodeWarning
+	kept := make([]json.RawMessage, 0, len(items))
+	for i, item := range items {
+		err := decodes([]json.RawMessage{item})
+		if err != nil {
+			warnings = append(warnings, DecodeWarning{Index: i, Raw: item, Err: err})
+			continue
+		}
+		kept = append(kept, item)
+	}
+
+	envelope["data"], _ = json.Marshal(kept)
+	encoded, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, nil
+	}
+	return encoded, warnings
+}
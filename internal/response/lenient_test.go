@@ -0,0 +1,92 @@
+package response_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+type lenientItem struct {
+	ID   string `json:"id"`
+	Size int    `json:"size"`
+}
+
+type lenientEnvelope struct {
+	Count int           `json:"count"`
+	Data  []lenientItem `json:"data"`
+}
+
+func TestParseListLenient(t *testing.T) {
+	t.Parallel()
+
+	mixed := `{"count":3,"data":[{"id":"a","size":1},{"id":"b","size":"big"},{"id":"c","size":3}]}`
+
+	tests := []struct {
+		name         string
+		lenient      bool
+		body         string
+		wantIDs      []string
+		wantSkipped  []int
+		wantErr      bool
+		wantWarnings int
+	}{
+		{name: "valid body", lenient: true, body: `{"count":1,"data":[{"id":"a","size":1}]}`, wantIDs: []string{"a"}},
+		{name: "strict fails on bad element", body: mixed, wantErr: true},
+		{name: "lenient skips bad element", lenient: true, body: mixed, wantIDs: []string{"a", "c"}, wantSkipped: []int{1}, wantWarnings: 1},
+		{name: "lenient keeps envelope errors", lenient: true, body: `{"count":"x","data":[]}`, wantErr: true},
+		{name: "lenient keeps syntax errors", lenient: true, body: `{"count":1,"data":[`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := &recordingLogger{Logger: observability.NoopLogger()}
+			dec := &response.Decoder{Lenient: tt.lenient, Logger: logger}
+			raw := jsonResponse(http.StatusOK, tt.body)
+
+			resp, warnings, err := response.ParseList[lenientEnvelope](dec, raw, nil, "test error")
+			assert.Len(t, logger.warnings, tt.wantWarnings)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Empty(t, warnings)
+				return
+			}
+			require.NoError(t, err)
+
+			ids := make([]string, 0, len(resp.JSON200.Data))
+			for _, item := range resp.JSON200.Data {
+				ids = append(ids, item.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+
+			skipped := make([]int, 0, len(warnings))
+			for _, w := range warnings {
+				skipped = append(skipped, w.Index)
+				assert.NotEmpty(t, w.Raw)
+			}
+			if tt.wantSkipped == nil {
+				assert.Empty(t, skipped)
+			} else {
+				assert.Equal(t, tt.wantSkipped, skipped)
+				assert.NotContains(t, string(resp.Body), `"big"`)
+			}
+		})
+	}
+}
+
+func TestParseListRequestError(t *testing.T) {
+	t.Parallel()
+
+	requestErr := errors.New("connection refused")
+	resp, warnings, err := response.ParseList[lenientEnvelope](&response.Decoder{Lenient: true}, nil, requestErr, "test error")
+	require.ErrorIs(t, err, requestErr)
+	assert.Nil(t, resp)
+	assert.Nil(t, warnings)
+}
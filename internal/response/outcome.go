@@ -0,0 +1,91 @@
+package response
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/retry"
+)
+
+// Outcome is the result of a mutation that either completes with the response or is
+// accepted for asynchronous processing.
+type Outcome[T any] struct {
+	// StatusCode is the success status: 200 OK, 201 Created, 202 Accepted or 204 No Content
+	StatusCode int
+
+	// Data is the decoded body of 200 OK and 201 Created responses, nil otherwise
+	Data *T
+
+	// Location is the absolute URL of the operation of 202 Accepted responses, from
+	// their Location header, or empty if they have none
+	Location string
+
+	// RetryAfter is how long the API asks to wait before polling Location, from the
+	// Retry-After header, or zero
+	RetryAfter time.Duration
+}
+
+// Accepted reports whether the request was accepted for asynchronous processing
+// rather than completed.
+func (o *Outcome[T]) Accepted() bool {
+	return o.StatusCode == http.StatusAccepted
+}
+
+// HandleOutcome is like HandleDecoded for endpoints that answer a request with one of
+// several success statuses. 200 OK and 201 Created must carry data, which is checked
+// like in HandleDecoded; 202 Accepted and 204 No Content carry no data, and the
+// operation location of 202 responses is returned. Other statuses are reported as
+// *unifierr.APIError, like in Handle.
+//
+// Usage:
+//
+//	resp, err := c.client.StartResourceJobWithResponse(ctx, req)
+//	outcome, err := response.HandleOutcome(c.decoder, resp, resp.Body, resp.JSON200, err, "failed to start job")
+//	if err == nil && outcome.Accepted() {
+//	    // poll outcome.Location
+//	}
+func HandleOutcome[T any](dec *Decoder, resp StatusCoder, body []byte, data *T, err error, errorMsg string) (*Outcome[T], error) {
+	if err != nil {
+		return nil, errors.Wrap(err, errorMsg)
+	}
+	if resp == nil {
+		return nil, errors.New("nil response from API client")
+	}
+
+	status := resp.StatusCode()
+	outcome := &Outcome[T]{StatusCode: status}
+	switch status {
+	case http.StatusOK, http.StatusCreated:
+		outcome.Data, err = HandleWithStatus(resp, data, nil, errorMsg, status)
+		if err != nil {
+			return nil, err
+		}
+		err = checkDecoded(dec, outcome.Data, body, errorMsg)
+		if err != nil {
+			return nil, err
+		}
+	case http.StatusAccepted:
+		httpResp, _ := rawResponse(resp)
+		if httpResp != nil {
+			outcome.Location = operationLocation(httpResp)
+			outcome.RetryAfter = retry.ParseRetryAfter(httpResp.Header.Get("Retry-After"))
+		}
+	case http.StatusNoContent:
+	default:
+		return nil, errors.WithStack(apiError(resp))
+	}
+	return outcome, nil
+}
+
+// operationLocation returns the Location header of resp, resolved against the
+// request URL so that callers can follow relative locations.
+func operationLocation(resp *http.Response) string {
+	location, err := resp.Location()
+	if err != nil {
+		// No Location header, or one that is not a URL
+		return resp.Header.Get("Location")
+	}
+	return location.String()
+}
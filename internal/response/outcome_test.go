@@ -0,0 +1,78 @@
+package response_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestHandleOutcome(t *testing.T) {
+	t.Parallel()
+
+	request := &http.Request{URL: &url.URL{Scheme: "https", Host: "unifi.local", Path: "/proxy/network/v2/api/site/default/jobs"}}
+	outcome := func(t *testing.T, status int, header http.Header, body string, data *mockData) (*response.Outcome[mockData], error) {
+		t.Helper()
+
+		resp := &generatedResponse{
+			Body:         []byte(body),
+			HTTPResponse: &http.Response{StatusCode: status, Header: header, Request: request},
+			JSON200:      data,
+		}
+		return response.HandleOutcome(&response.Decoder{Mode: response.StrictFail}, resp, resp.Body, resp.JSON200, nil, "test error")
+	}
+
+	t.Run("completed", func(t *testing.T) {
+		t.Parallel()
+
+		data := &mockData{Value: "done"}
+		result, err := outcome(t, http.StatusOK, http.Header{}, `{"Value": "done"}`, data)
+		require.NoError(t, err)
+		assert.False(t, result.Accepted())
+		assert.Same(t, data, result.Data)
+
+		_, err = outcome(t, http.StatusOK, http.Header{}, `{"Value": "done", "extra": 1}`, data)
+		require.ErrorIs(t, err, unifierr.ErrUnknownField, "bodies are checked like in HandleDecoded")
+
+		_, err = outcome(t, http.StatusOK, http.Header{}, "", nil)
+		require.Error(t, err, "200 OK must carry data")
+	})
+
+	t.Run("accepted", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{"Location": []string{"jobs/42"}, "Retry-After": []string{"5"}}
+		result, err := outcome(t, http.StatusAccepted, header, "", nil)
+		require.NoError(t, err)
+		assert.True(t, result.Accepted())
+		assert.Nil(t, result.Data)
+		assert.Equal(t, "https://unifi.local/proxy/network/v2/api/site/default/jobs/42", result.Location)
+		assert.Equal(t, 5*time.Second, result.RetryAfter)
+
+		result, err = outcome(t, http.StatusAccepted, http.Header{}, "", nil)
+		require.NoError(t, err)
+		assert.Empty(t, result.Location)
+	})
+
+	t.Run("no content", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := outcome(t, http.StatusNoContent, http.Header{}, "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, result.StatusCode)
+		assert.False(t, result.Accepted())
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := outcome(t, http.StatusNotFound, http.Header{}, `{"statusCode": 404}`, nil)
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+	})
+}
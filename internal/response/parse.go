@@ -0,0 +1,134 @@
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+// maxPooledBodySize bounds the buffers kept for reuse by ReadBody, so that one huge
+// response does not pin its buffer for the life of the process.
+const maxPooledBodySize = 8 << 20
+
+var bodyBufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// ReadBody reads r to the end. It reads into a pooled buffer and returns a copy of
+// exactly the body size, avoiding the repeated growth and copying of io.ReadAll on
+// large bodies such as client lists polled at high frequency.
+func ReadBody(r io.Reader) ([]byte, error) {
+	buf := bodyBufferPool.Get().(*bytes.Buffer) //nolint:forcetypeassert // Pool only contains *bytes.Buffer
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBodySize {
+			bodyBufferPool.Put(buf)
+		}
+	}()
+
+	_, err := buf.ReadFrom(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	body := make([]byte, buf.Len())
+	copy(body, buf.Bytes())
+	return body, nil
+}
+
+// unmarshal decodes data into v with the decoder's Unmarshal, or encoding/json.
+func (d *Decoder) unmarshal(data []byte, v any) error {
+	if d == nil || d.Unmarshal == nil {
+		return json.Unmarshal(data, v)
+	}
+	return d.Unmarshal(data, v)
+}
+
+// Parsed is a response read by ParseList. Like the generated response types, it
+// carries the HTTP response and body, so Handle reports its errors the same way.
+type Parsed[T any] struct {
+	HTTPResponse *http.Response
+	Body         []byte
+
+	// JSON200 is the decoded body of a 200 OK JSON response, or nil.
+	JSON200 *T
+}
+
+// StatusCode returns the HTTP status code of the response.
+func (p *Parsed[T]) StatusCode() int {
+	if p.HTTPResponse == nil {
+		return 0
+	}
+	return p.HTTPResponse.StatusCode
+}
+
+// ParseList reads raw and decodes a 200 OK JSON body into the envelope type T with
+// the decoder's Unmarshal function, replacing the generated parse function on hot
+// paths such as large lists.
+//
+// If decoding fails and the decoder is lenient, the elements of the "data" array
+// that cannot be decoded into T are dropped, logged as warnings, and the rest of the
+// body is decoded again. The returned response then holds the remaining body, so
+// HandleDecoded checks and retains only the kept elements. Failures outside the
+// "data" array are returned as before.
+//
+// Usage:
+//
+//	raw, err := c.client.ListSiteClients(ctx, siteID, params)
+//	resp, warnings, err := response.ParseList[ClientsResponse](c.decoder, raw, err, errorMsg)
+func ParseList[T any](dec *Decoder, raw *http.Response, err error, errorMsg string) (*Parsed[T], []DecodeWarning, error) {
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := ReadBody(raw.Body)
+	_ = raw.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsed := &Parsed[T]{HTTPResponse: raw, Body: body}
+	if raw.StatusCode != http.StatusOK || !strings.Contains(raw.Header.Get("Content-Type"), "json") {
+		return parsed, nil, nil
+	}
+
+	var data T
+	decodeErr := dec.unmarshal(body, &data)
+	if decodeErr == nil {
+		parsed.JSON200 = &data
+		return parsed, nil, nil
+	}
+	if dec == nil || !dec.Lenient {
+		return nil, nil, decodeErr
+	}
+
+	kept, warnings := dropUndecodable[T](dec, body)
+	if len(warnings) == 0 {
+		return nil, nil, decodeErr
+	}
+
+	var keptData T
+	err = dec.unmarshal(kept, &keptData)
+	if err != nil {
+		return nil, nil, err
+	}
+	parsed.Body = kept
+	parsed.JSON200 = &keptData
+
+	if dec.Logger != nil {
+		for _, w := range warnings {
+			dec.Logger.Warn("skipped undecodable list element",
+				observability.Field{Key: "context", Value: errorMsg},
+				observability.Field{Key: "index", Value: w.Index},
+				observability.Field{Key: "error", Value: w.Err.Error()},
+			)
+		}
+	}
+	return parsed, warnings, nil
+}
@@ -0,0 +1,81 @@
+package response_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+// largeListBody returns a list envelope of n elements, like a busy site's client list.
+func largeListBody(n int) string {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = fmt.Sprintf(`{"id":"client-%05d","size":%d}`, i, i)
+	}
+	return `{"count":` + fmt.Sprint(n) + `,"data":[` + strings.Join(items, ",") + `]}`
+}
+
+// BenchmarkParseList measures parsing a large list with pooled body buffers.
+func BenchmarkParseList(b *testing.B) {
+	body := largeListBody(5000)
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		resp, _, err := response.ParseList[lenientEnvelope](nil, jsonResponse(http.StatusOK, body), nil, "bench")
+		if err != nil || len(resp.JSON200.Data) != 5000 {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkParseGenerated measures the generated parse functions, which read the
+// body with io.ReadAll, as the baseline for BenchmarkParseList.
+func BenchmarkParseGenerated(b *testing.B) {
+	body := largeListBody(5000)
+	b.SetBytes(int64(len(body)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		raw := jsonResponse(http.StatusOK, body)
+		bodyBytes, err := io.ReadAll(raw.Body)
+		if err != nil {
+			b.Fatal(err)
+		}
+		var dest lenientEnvelope
+		err = json.Unmarshal(bodyBytes, &dest)
+		if err != nil || len(dest.Data) != 5000 {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkReadBody isolates body reading, where the pooled buffers save allocations.
+func BenchmarkReadBody(b *testing.B) {
+	body := largeListBody(5000)
+	b.SetBytes(int64(len(body)))
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_, err := response.ReadBody(strings.NewReader(body))
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("ReadAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_, err := io.ReadAll(strings.NewReader(body))
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package response_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+// jsonResponse returns a response with a JSON body.
+func jsonResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestParseList(t *testing.T) {
+	t.Parallel()
+
+	body := `{"count":1,"data":[{"id":"a","size":1}]}`
+
+	t.Run("decodes 200 JSON", func(t *testing.T) {
+		t.Parallel()
+
+		resp, warnings, err := response.ParseList[lenientEnvelope](nil, jsonResponse(http.StatusOK, body), nil, "test error")
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.JSONEq(t, body, string(resp.Body))
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, []lenientItem{{ID: "a", Size: 1}}, resp.JSON200.Data)
+	})
+
+	t.Run("keeps error responses for Handle", func(t *testing.T) {
+		t.Parallel()
+
+		resp, _, err := response.ParseList[lenientEnvelope](nil,
+			jsonResponse(http.StatusNotFound, `{"traceId":"abc"}`), nil, "test error")
+		require.NoError(t, err)
+		assert.Nil(t, resp.JSON200)
+
+		_, err = response.HandleDecoded(nil, resp, resp.Body, resp.JSON200, nil, "test error")
+		require.ErrorIs(t, err, unifierr.ErrNotFound)
+		var apiErr *unifierr.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "abc", apiErr.TraceID)
+	})
+
+	t.Run("ignores non-JSON bodies", func(t *testing.T) {
+		t.Parallel()
+
+		raw := jsonResponse(http.StatusOK, "<html></html>")
+		raw.Header.Set("Content-Type", "text/html")
+		resp, _, err := response.ParseList[lenientEnvelope](nil, raw, nil, "test error")
+		require.NoError(t, err)
+		assert.Nil(t, resp.JSON200)
+	})
+
+	t.Run("uses the configured unmarshaler", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		dec := &response.Decoder{Unmarshal: func(data []byte, v any) error {
+			calls++
+			return json.Unmarshal(data, v)
+		}}
+		resp, _, err := response.ParseList[lenientEnvelope](dec, jsonResponse(http.StatusOK, body), nil, "test error")
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.JSON200.Count)
+		assert.Equal(t, 1, calls)
+
+		failing := &response.Decoder{Unmarshal: func([]byte, any) error { return errors.New("unsupported") }}
+		_, _, err = response.ParseList[lenientEnvelope](failing, jsonResponse(http.StatusOK, body), nil, "test error")
+		require.EqualError(t, err, "unsupported")
+	})
+}
+
+func TestReadBody(t *testing.T) {
+	t.Parallel()
+
+	large := strings.Repeat("x", 100_000)
+	for range 3 {
+		body, err := response.ReadBody(strings.NewReader(large))
+		require.NoError(t, err)
+		assert.Equal(t, large, string(body))
+		assert.Equal(t, len(large), cap(body), "the body should not keep the spare capacity of the pooled buffer")
+	}
+
+	body, err := response.ReadBody(strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Empty(t, body)
+
+	_, err = response.ReadBody(io.MultiReader(strings.NewReader("{"), errReader{}))
+	require.Error(t, err)
+}
+
+// errReader fails every read.
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
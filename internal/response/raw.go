@@ -0,0 +1,50 @@
+package response
+
+import (
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+)
+
+// RawArray splits a JSON array into its raw elements.
+func RawArray(body []byte) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	err := json.Unmarshal(body, &items)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to split raw JSON array")
+	}
+	return items, nil
+}
+
+// RawData extracts the raw value stored under the "data" key of a response envelope.
+func RawData(body []byte) (json.RawMessage, error) {
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	err := json.Unmarshal(body, &envelope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract raw JSON data")
+	}
+	return envelope.Data, nil
+}
+
+// RawDataArray splits the array stored under the "data" key of a response envelope into its raw elements.
+func RawDataArray(body []byte) ([]json.RawMessage, error) {
+	data, err := RawData(body)
+	if err != nil {
+		return nil, err
+	}
+	return RawArray(data)
+}
+
+// AssignRaw calls set for every item with the raw JSON element at the same index.
+// It fails if the number of raw elements does not match the number of decoded items.
+func AssignRaw[T any](raws []json.RawMessage, items []T, set func(item *T, raw json.RawMessage)) error {
+	if len(raws) != len(items) {
+		return errors.Newf("raw JSON has %d elements, decoded %d", len(raws), len(items))
+	}
+	for i := range items {
+		set(&items[i], raws[i])
+	}
+	return nil
+}
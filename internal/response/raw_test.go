@@ -0,0 +1,52 @@
+package response_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/internal/response"
+)
+
+func TestRawDataArray(t *testing.T) {
+	t.Parallel()
+
+	raws, err := response.RawDataArray([]byte(`{"data":[{"a":1},{"b":2}],"count":2}`))
+	require.NoError(t, err)
+	require.Len(t, raws, 2)
+	assert.JSONEq(t, `{"a":1}`, string(raws[0]))
+	assert.JSONEq(t, `{"b":2}`, string(raws[1]))
+
+	_, err = response.RawDataArray([]byte(`{"data":{"a":1}}`))
+	require.Error(t, err)
+}
+
+func TestRawData(t *testing.T) {
+	t.Parallel()
+
+	raw, err := response.RawData([]byte(`{"data":{"a":1},"traceId":"x"}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":1}`, string(raw))
+
+	_, err = response.RawData([]byte(`not json`))
+	require.Error(t, err)
+}
+
+func TestAssignRaw(t *testing.T) {
+	t.Parallel()
+
+	items := make([]mockData, 2)
+	raws := []json.RawMessage{json.RawMessage(`"first"`), json.RawMessage(`"second"`)}
+
+	err := response.AssignRaw(raws, items, func(item *mockData, raw json.RawMessage) {
+		item.Value = string(raw)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `"first"`, items[0].Value)
+	assert.Equal(t, `"second"`, items[1].Value)
+
+	err = response.AssignRaw(raws[:1], items, func(*mockData, json.RawMessage) {})
+	require.Error(t, err)
+}
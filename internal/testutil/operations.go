@@ -0,0 +1,66 @@
+package testutil
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// AnnotatedOperations returns the sorted operation names passed to
+// middleware.WithOperation by the non-test sources in dir. It fails the test for
+// names that differ from the method making the call.
+func AnnotatedOperations(tb testing.TB, dir string) []string {
+	tb.Helper()
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	require.NoError(tb, err)
+
+	fset := token.NewFileSet()
+	var names []string
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(file)
+		require.NoError(tb, err)
+		parsed, err := parser.ParseFile(fset, file, src, 0)
+		require.NoError(tb, err)
+
+		for _, decl := range parsed.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) != 2 {
+					return true
+				}
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok || sel.Sel.Name != "WithOperation" {
+					return true
+				}
+				lit, ok := call.Args[1].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					return true
+				}
+				name, err := strconv.Unquote(lit.Value)
+				require.NoError(tb, err)
+				assert.Equal(tb, fn.Name.Name, name, "operation name at %s", fset.Position(call.Pos()))
+				names = append(names, name)
+				return true
+			})
+		}
+	}
+	slices.Sort(names)
+	return names
+}
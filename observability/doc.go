@@ -36,6 +36,14 @@
 //   - Rate limiting events and wait times
 //   - Error occurrences by type
 //
+// Recorders that also implement ConnectionMetricsRecorder receive connection
+// details of every request: protocol (HTTP/2 or HTTP/1.1), connection reuse, and
+// DNS, connect and TLS handshake durations.
+//
+// Recorders that also implement LatencyMetricsRecorder receive the latency of every
+// client operation split into server time and the time the client spent waiting on
+// its rate limiter and between retries.
+//
 // # Default Behavior
 //
 // If no logger or metrics recorder is provided, the client uses no-op
@@ -22,6 +22,106 @@ type MetricsRecorder interface {
 	RecordContextCancellation(operation string)
 }
 
+// ConnectionMetricsRecorder is an optional extension of MetricsRecorder. Recorders that
+// also implement it receive the connection setup details of every request, to tell
+// latency caused by connection setup apart from a slow controller.
+type ConnectionMetricsRecorder interface {
+	// RecordConnection records how the connection of a request to endpoint was obtained.
+	RecordConnection(endpoint string, info ConnectionInfo)
+}
+
+// OperationMetricsRecorder is an optional extension of MetricsRecorder. Recorders that
+// also implement it receive every request made by a client method labeled with the
+// name of the method, e.g. "ListSiteClients", which is more precise than the
+// normalized path given to RecordHTTPRequest: several operations may share a path.
+type OperationMetricsRecorder interface {
+	// RecordOperation records a request of operation. The status code is 0 when no
+	// response was received.
+	RecordOperation(operation string, statusCode int, duration time.Duration)
+}
+
+// LatencyMetricsRecorder is an optional extension of MetricsRecorder. Recorders that
+// also implement it receive the end-to-end latency of every request made by a client
+// method, split into the time spent on the controller and the time the client added by
+// waiting on its rate limiter and between retries, so that dashboards can tell a slow
+// controller apart from client-side throttling.
+type LatencyMetricsRecorder interface {
+	// RecordLatency records the latency of a request of operation, as named for
+	// OperationMetricsRecorder.
+	RecordLatency(operation string, latency Latency)
+}
+
+// Latency splits the end-to-end duration of a request, including all its retries.
+type Latency struct {
+	// Total is the duration the caller waited for the request.
+	Total time.Duration
+
+	// Server is the time spent in attempts, on the network and in the controller.
+	// It is Total less the waits below.
+	Server time.Duration
+
+	// RateLimitWait and RetryWait are the time spent waiting on the client's rate
+	// limiter and between retries.
+	RateLimitWait time.Duration
+	RetryWait     time.Duration
+
+	// Retries is the number of retries made.
+	Retries int
+}
+
+// ClientWait returns the latency added by the client, RateLimitWait plus RetryWait.
+func (l Latency) ClientWait() time.Duration {
+	return l.RateLimitWait + l.RetryWait
+}
+
+// PathCacheMetricsRecorder is an optional extension of MetricsRecorder. Recorders that
+// also implement it receive the statistics of the cache of normalized request paths
+// after every request, to size ClientConfig.PathCacheSize.
+type PathCacheMetricsRecorder interface {
+	// RecordPathCache records the cumulative statistics of the path cache.
+	RecordPathCache(stats PathCacheStats)
+}
+
+// PathCacheStats describes the cache of normalized request paths shared by the
+// requests of a client.
+type PathCacheStats struct {
+	// Hits and Misses count the lookups answered from the cache and computed.
+	Hits   uint64
+	Misses uint64
+
+	// Evictions counts the paths dropped to stay within Capacity.
+	Evictions uint64
+
+	// Size is the number of cached paths, excluding pinned ones; Capacity is its bound.
+	Size     int
+	Capacity int
+
+	// Pinned is the number of pre-seeded paths, which are never evicted.
+	Pinned int
+}
+
+// ConnectionInfo describes the connection used by a request. When a request is retried,
+// it describes the connection of the last attempt.
+type ConnectionInfo struct {
+	// Protocol is the protocol of the response, e.g. "HTTP/2.0" or "HTTP/1.1".
+	Protocol string
+
+	// Reused reports whether the connection was reused from an earlier request.
+	Reused bool
+
+	// IdleTime is how long a reused connection was idle before the request.
+	IdleTime time.Duration
+
+	// DNSLookup, Connect and TLSHandshake are the durations of the connection setup
+	// phases; all are zero for reused connections.
+	DNSLookup    time.Duration
+	Connect      time.Duration
+	TLSHandshake time.Duration
+
+	// TimeToFirstByte is the time from obtaining the connection to the first response byte.
+	TimeToFirstByte time.Duration
+}
+
 // noopMetricsRecorder is a no-operation metrics recorder that does nothing.
 type noopMetricsRecorder struct{}
 
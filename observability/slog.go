@@ -0,0 +1,44 @@
+package observability
+
+import (
+	"context"
+	"log/slog"
+)
+
+// slogLogger adapts a *slog.Logger to the Logger interface.
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger writing to the given structured logger.
+// If logger is nil, slog.Default() is used.
+//
+//nolint:ireturn // Factory function returning interface is correct DI pattern
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &slogLogger{logger: logger}
+}
+
+func (l *slogLogger) Debug(msg string, fields ...Field) { l.log(slog.LevelDebug, msg, fields) }
+func (l *slogLogger) Info(msg string, fields ...Field)  { l.log(slog.LevelInfo, msg, fields) }
+func (l *slogLogger) Warn(msg string, fields ...Field)  { l.log(slog.LevelWarn, msg, fields) }
+func (l *slogLogger) Error(msg string, fields ...Field) { l.log(slog.LevelError, msg, fields) }
+
+//nolint:ireturn // Interface method must return interface to satisfy Logger contract
+func (l *slogLogger) With(fields ...Field) Logger {
+	return &slogLogger{logger: l.logger.With(slogArgs(fields)...)}
+}
+
+func (l *slogLogger) log(level slog.Level, msg string, fields []Field) {
+	l.logger.Log(context.Background(), level, msg, slogArgs(fields)...)
+}
+
+func slogArgs(fields []Field) []any {
+	args := make([]any, 0, len(fields))
+	for _, f := range fields {
+		args = append(args, slog.Any(f.Key, f.Value))
+	}
+	return args
+}
@@ -0,0 +1,181 @@
+package otlp
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	unifi "github.com/lexfrei/go-unifi"
+	"github.com/lexfrei/go-unifi/api/sitemanager"
+)
+
+// Attribute keys of resources and data points.
+const (
+	AttributeHostID  = "unifi.host.id"
+	AttributeSiteID  = "unifi.site.id"
+	AttributeWAN     = "unifi.wan"
+	AttributeISPName = "unifi.isp.name"
+	AttributeISPASN  = "unifi.isp.asn"
+)
+
+// ScopeName is the instrumentation scope of the exported metrics.
+const ScopeName = "github.com/lexfrei/go-unifi/otlp"
+
+// instrument describes how a WAN value becomes a metric.
+type instrument struct {
+	name        string
+	description string
+	unit        string
+	sum         bool
+	value       func(*sitemanager.ISPMetricWanData) *int
+}
+
+// instruments are the exported metrics, in export order.
+var instruments = []instrument{
+	{
+		name: "unifi.isp.latency", description: "Average latency to the ISP", unit: "ms",
+		value: func(d *sitemanager.ISPMetricWanData) *int { return d.AvgLatency },
+	},
+	{
+		name: "unifi.isp.latency.max", description: "Maximum latency to the ISP", unit: "ms",
+		value: func(d *sitemanager.ISPMetricWanData) *int { return d.MaxLatency },
+	},
+	{
+		name: "unifi.isp.packet_loss", description: "Packet loss to the ISP", unit: "%",
+		value: func(d *sitemanager.ISPMetricWanData) *int { return d.PacketLoss },
+	},
+	{
+		name: "unifi.isp.download.throughput", description: "Download throughput of the WAN link", unit: "kbit/s",
+		value: func(d *sitemanager.ISPMetricWanData) *int { return d.DownloadKbps },
+	},
+	{
+		name: "unifi.isp.upload.throughput", description: "Upload throughput of the WAN link", unit: "kbit/s",
+		value: func(d *sitemanager.ISPMetricWanData) *int { return d.UploadKbps },
+	},
+	{
+		name: "unifi.isp.uptime", description: "Time the WAN link was up in the period", unit: "s", sum: true,
+		value: func(d *sitemanager.ISPMetricWanData) *int { return d.Uptime },
+	},
+	{
+		name: "unifi.isp.downtime", description: "Time the WAN link was down in the period", unit: "s", sum: true,
+		value: func(d *sitemanager.ISPMetricWanData) *int { return d.Downtime },
+	},
+}
+
+// ISPMetrics converts ISP metrics, as returned by GetISPMetrics or QueryISPMetrics,
+// into an OTLP export request with one resource per item. attributes are added to
+// every resource, e.g. service.name. Periods without time or data are skipped, as
+// are items without any period left; the result has no resources if nothing remains.
+//
+// The time of a data point is the metric time of its period. Sums start one period
+// earlier, the length of the period being the metric type of the item, "5m" or "1h";
+// items of other types have sums without start time.
+func ISPMetrics(items []sitemanager.ISPMetricItem, attributes map[string]string) *ExportMetricsRequest {
+	request := &ExportMetricsRequest{ResourceMetrics: []ResourceMetrics{}}
+	for i := range items {
+		if metrics := itemMetrics(&items[i]); len(metrics) > 0 {
+			request.ResourceMetrics = append(request.ResourceMetrics, ResourceMetrics{
+				Resource: Resource{Attributes: resourceAttributes(&items[i], attributes)},
+				ScopeMetrics: []ScopeMetrics{{
+					Scope:   Scope{Name: ScopeName, Version: unifi.Version()},
+					Metrics: metrics,
+				}},
+			})
+		}
+	}
+	return request
+}
+
+func resourceAttributes(item *sitemanager.ISPMetricItem, attributes map[string]string) []KeyValue {
+	kvs := make([]KeyValue, 0, len(attributes)+2)
+	for _, key := range slices.Sorted(maps.Keys(attributes)) {
+		if key != AttributeHostID && key != AttributeSiteID {
+			kvs = append(kvs, stringAttribute(key, attributes[key]))
+		}
+	}
+	if item.HostId != nil {
+		kvs = append(kvs, stringAttribute(AttributeHostID, *item.HostId))
+	}
+	if item.SiteId != nil {
+		kvs = append(kvs, stringAttribute(AttributeSiteID, *item.SiteId))
+	}
+	return kvs
+}
+
+// itemMetrics returns the metrics of the periods of an item that have a value.
+func itemMetrics(item *sitemanager.ISPMetricItem) []Metric {
+	if item.Periods == nil {
+		return nil
+	}
+	var length time.Duration
+	if item.MetricType != nil {
+		switch sitemanager.GetISPMetricsParamsType(*item.MetricType) {
+		case sitemanager.N5m:
+			length = 5 * time.Minute
+		case sitemanager.N1h:
+			length = time.Hour
+		}
+	}
+
+	points := make([][]NumberDataPoint, len(instruments))
+	for _, period := range *item.Periods {
+		if period.MetricTime == nil || period.Data == nil {
+			continue
+		}
+		wans := period.Data.WANs()
+		for _, wan := range slices.Sorted(maps.Keys(wans)) {
+			data := wans[wan]
+			attributes := wanAttributes(wan, &data)
+			for i, inst := range instruments {
+				value := inst.value(&data)
+				if value == nil {
+					continue
+				}
+				point := NumberDataPoint{
+					Attributes:   attributes,
+					TimeUnixNano: unixNano(*period.MetricTime),
+					AsInt:        int64(*value),
+				}
+				if inst.sum && length > 0 {
+					point.StartTimeUnixNano = unixNano(period.MetricTime.Add(-length))
+				}
+				points[i] = append(points[i], point)
+			}
+		}
+	}
+
+	var metrics []Metric
+	for i, inst := range instruments {
+		if len(points[i]) == 0 {
+			continue
+		}
+		metric := Metric{Name: inst.name, Description: inst.description, Unit: inst.unit}
+		if inst.sum {
+			metric.Sum = &Sum{DataPoints: points[i], AggregationTemporality: TemporalityDelta, IsMonotonic: true}
+		} else {
+			metric.Gauge = &Gauge{DataPoints: points[i]}
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics
+}
+
+func wanAttributes(wan sitemanager.WANID, data *sitemanager.ISPMetricWanData) []KeyValue {
+	attributes := []KeyValue{stringAttribute(AttributeWAN, string(wan))}
+	if data.IspName != nil && *data.IspName != "" {
+		attributes = append(attributes, stringAttribute(AttributeISPName, *data.IspName))
+	}
+	if data.IspAsn != nil && *data.IspAsn != "" {
+		attributes = append(attributes, stringAttribute(AttributeISPASN, *data.IspAsn))
+	}
+	return attributes
+}
+
+func stringAttribute(key, value string) KeyValue {
+	return KeyValue{Key: key, Value: AnyValue{StringValue: value}}
+}
+
+// unixNano returns t in nanoseconds since the Unix epoch, or 0 before it.
+func unixNano(t time.Time) uint64 {
+	return uint64(max(t.UnixNano(), 0))
+}
@@ -0,0 +1,117 @@
+package otlp
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager"
+	smtestdata "github.com/lexfrei/go-unifi/api/sitemanager/testdata"
+)
+
+const (
+	testHostID = "900A6F00301100000000074A6BA90000000007A3387E0000000063EC9853:123456789"
+	testSiteID = "661900ae6aec8f548d49fd54"
+)
+
+var testPeriod = time.Date(2024, 11, 10, 19, 0, 0, 0, time.UTC)
+
+func loadDualWAN(t *testing.T) []sitemanager.ISPMetricItem {
+	t.Helper()
+
+	var resp sitemanager.ISPMetricsResponse
+	smtestdata.LoadFixtureJSON(t, "metrics/get_isp_metrics_dual_wan.json", &resp)
+	return resp.Data
+}
+
+func metricByName(t *testing.T, metrics []Metric, name string) Metric {
+	t.Helper()
+
+	for _, metric := range metrics {
+		if metric.Name == name {
+			return metric
+		}
+	}
+	require.Failf(t, "metric not found", "%s", name)
+	return Metric{}
+}
+
+func TestISPMetrics(t *testing.T) {
+	t.Parallel()
+
+	request := ISPMetrics(loadDualWAN(t), map[string]string{"service.name": "unifi-isp", AttributeSiteID: "ignored"})
+	require.Len(t, request.ResourceMetrics, 1)
+	resource := request.ResourceMetrics[0]
+	assert.Equal(t, []KeyValue{
+		stringAttribute("service.name", "unifi-isp"),
+		stringAttribute(AttributeHostID, testHostID),
+		stringAttribute(AttributeSiteID, testSiteID),
+	}, resource.Resource.Attributes, "item attributes win over configured ones")
+
+	require.Len(t, resource.ScopeMetrics, 1)
+	assert.Equal(t, ScopeName, resource.ScopeMetrics[0].Scope.Name)
+	metrics := resource.ScopeMetrics[0].Metrics
+	require.Len(t, metrics, len(instruments))
+
+	latency := metricByName(t, metrics, "unifi.isp.latency")
+	assert.Equal(t, "ms", latency.Unit)
+	require.NotNil(t, latency.Gauge)
+	assert.Nil(t, latency.Sum)
+	require.Len(t, latency.Gauge.DataPoints, 4, "two periods of two WANs")
+	assert.Equal(t, NumberDataPoint{
+		Attributes: []KeyValue{
+			stringAttribute(AttributeWAN, "wan2"),
+			stringAttribute(AttributeISPName, "LTE ISP"),
+			stringAttribute(AttributeISPASN, "67890"),
+		},
+		TimeUnixNano: uint64(testPeriod.UnixNano()),
+		AsInt:        41,
+	}, latency.Gauge.DataPoints[1])
+
+	downtime := metricByName(t, metrics, "unifi.isp.downtime")
+	require.NotNil(t, downtime.Sum)
+	assert.Equal(t, TemporalityDelta, downtime.Sum.AggregationTemporality)
+	assert.True(t, downtime.Sum.IsMonotonic)
+	point := downtime.Sum.DataPoints[1]
+	assert.Equal(t, int64(30), point.AsInt)
+	assert.Equal(t, uint64(testPeriod.Add(-5*time.Minute).UnixNano()), point.StartTimeUnixNano, "sums cover the period")
+}
+
+func TestISPMetricsJSON(t *testing.T) {
+	t.Parallel()
+
+	latency := 14
+	request := ISPMetrics([]sitemanager.ISPMetricItem{{
+		MetricType: new(string),
+		Periods: &[]sitemanager.ISPMetricPeriod{
+			{MetricTime: &testPeriod, Data: &sitemanager.ISPMetricPeriodData{Wan: &sitemanager.ISPMetricWanData{AvgLatency: &latency}}},
+			{MetricTime: &testPeriod},
+			{Data: &sitemanager.ISPMetricPeriodData{Wan: &sitemanager.ISPMetricWanData{AvgLatency: &latency}}},
+		},
+	}}, nil)
+
+	body, err := json.Marshal(request)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"resourceMetrics":[{
+		"resource":{"attributes":[]},
+		"scopeMetrics":[{
+			"scope":{"name":"github.com/lexfrei/go-unifi/otlp","version":"`+request.ResourceMetrics[0].ScopeMetrics[0].Scope.Version+`"},
+			"metrics":[{
+				"name":"unifi.isp.latency",
+				"description":"Average latency to the ISP",
+				"unit":"ms",
+				"gauge":{"dataPoints":[{
+					"attributes":[{"key":"unifi.wan","value":{"stringValue":"wan"}}],
+					"timeUnixNano":"1731265200000000000",
+					"asInt":"14"
+				}]}
+			}]
+		}]
+	}]}`, string(body), "64-bit integers are strings in OTLP JSON")
+
+	empty := ISPMetrics([]sitemanager.ISPMetricItem{{}}, nil)
+	assert.Empty(t, empty.ResourceMetrics)
+}
@@ -0,0 +1,48 @@
+// Package otlp pushes the ISP metrics of the UniFi Site Manager API to an
+// OpenTelemetry collector over OTLP/HTTP, for deployments that standardize on a
+// collector rather than Prometheus scraping. It speaks the JSON encoding of OTLP and
+// does not depend on the OpenTelemetry SDK.
+//
+// An Exporter fetches ISP metrics at every interval and sends the periods it has not
+// sent yet:
+//
+//	exporter, err := otlp.NewExporter(&otlp.Config{
+//	    Endpoint:           "http://otel-collector:4318/v1/metrics",
+//	    Source:             client,
+//	    ResourceAttributes: map[string]string{"service.name": "unifi-isp"},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//
+//	// Blocks until ctx is canceled
+//	err = exporter.Run(ctx)
+//
+// Export sends metrics fetched by the caller instead, and ISPMetrics converts them
+// without sending, e.g. to inspect or forward the request.
+//
+// # Metrics
+//
+// Each ISP metric item becomes a resource with the unifi.host.id and unifi.site.id
+// attributes, and each WAN interface of a period a data point with the unifi.wan
+// attribute and, when reported, unifi.isp.name and unifi.isp.asn. Latency, packet
+// loss and throughput are gauges; uptime and downtime are monotonic sums with delta
+// temporality covering the period:
+//
+//	unifi.isp.latency              gauge  ms      average latency
+//	unifi.isp.latency.max          gauge  ms      maximum latency
+//	unifi.isp.packet_loss          gauge  %       packet loss
+//	unifi.isp.download.throughput  gauge  kbit/s  download throughput
+//	unifi.isp.upload.throughput    gauge  kbit/s  upload throughput
+//	unifi.isp.uptime               sum    s       uptime in the period
+//	unifi.isp.downtime             sum    s       downtime in the period
+//
+// Values missing from a period are left out rather than reported as zero.
+//
+// # Retries
+//
+// Exports that fail with a transport error, 429 Too Many Requests or a 5xx status are
+// retried with exponential backoff, honoring Retry-After. Periods are remembered per
+// host and site once the collector accepted them, so overlapping fetches do not send
+// them twice, which would double the delta sums.
+package otlp
@@ -0,0 +1,301 @@
+package otlp
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	unifi "github.com/lexfrei/go-unifi"
+	"github.com/lexfrei/go-unifi/api/sitemanager"
+	"github.com/lexfrei/go-unifi/internal/backoff"
+	"github.com/lexfrei/go-unifi/internal/retry"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+const (
+	// DefaultInterval is the default time between two fetches of Run, the length of
+	// the 5 minute periods it fetches by default.
+	DefaultInterval = 5 * time.Minute
+
+	// DefaultMaxRetries is the default number of retries of a failed export.
+	DefaultMaxRetries = 5
+
+	// DefaultRetryWait is the default wait before the first retry of a failed export.
+	DefaultRetryWait = time.Second
+
+	// maxRetryWait caps the exponentially growing wait between retries of an export.
+	maxRetryWait = time.Minute
+
+	// maxErrorBody caps the part of a failed response body kept in export errors.
+	maxErrorBody = 512
+)
+
+// ErrInvalidConfig is returned by NewExporter for configurations it cannot run.
+var ErrInvalidConfig = errors.New("invalid OTLP exporter configuration")
+
+// ISPMetricsSource fetches ISP metrics; *sitemanager.UnifiClient implements it.
+type ISPMetricsSource interface {
+	GetISPMetrics(ctx context.Context, metricType sitemanager.GetISPMetricsParamsType,
+		params *sitemanager.GetISPMetricsParams) (*sitemanager.ISPMetricsResponse, error)
+}
+
+// Config holds configuration for an Exporter.
+type Config struct {
+	// Endpoint is the OTLP/HTTP metrics URL of the collector, usually ending in
+	// /v1/metrics, e.g. "http://otel-collector:4318/v1/metrics". Required.
+	Endpoint string
+
+	// Headers are added to every export, e.g. an authorization header (optional)
+	Headers map[string]string
+
+	// ResourceAttributes are added to every resource, e.g. service.name or
+	// deployment.environment (optional)
+	ResourceAttributes map[string]string
+
+	// Source fetches the metrics exported by Run (required by Run only)
+	Source ISPMetricsSource
+
+	// MetricType is the period length Run fetches (defaults to sitemanager.N5m)
+	MetricType sitemanager.GetISPMetricsParamsType
+
+	// Interval is the time between two fetches of Run (defaults to DefaultInterval)
+	Interval time.Duration
+
+	// HTTPClient sends exports (optional, uses a client with a 10 second timeout if nil)
+	HTTPClient *http.Client
+
+	// MaxRetries is the number of retries of a failed export (defaults to
+	// DefaultMaxRetries, negative disables retries)
+	MaxRetries int
+
+	// RetryWait is the wait before the first retry, doubling up to one minute for the
+	// following ones (defaults to DefaultRetryWait)
+	RetryWait time.Duration
+
+	// Logger for observability (optional, uses noop logger if nil)
+	Logger observability.Logger
+}
+
+// Exporter pushes ISP metrics to an OpenTelemetry collector. Exports are serialized;
+// all methods are safe for concurrent use.
+type Exporter struct {
+	cfg    Config
+	policy backoff.Policy
+
+	// mu serializes exports, so periods are sent once and in order
+	mu sync.Mutex
+	// sent is the metric time of the latest period sent, per host and site
+	sent map[string]time.Time
+}
+
+// NewExporter creates an exporter, validating the endpoint of cfg. Errors match
+// ErrInvalidConfig.
+func NewExporter(cfg *Config) (*Exporter, error) {
+	if cfg == nil || cfg.Endpoint == "" {
+		return nil, errors.Wrap(ErrInvalidConfig, "endpoint is required")
+	}
+	if !strings.HasPrefix(cfg.Endpoint, "http://") && !strings.HasPrefix(cfg.Endpoint, "https://") {
+		return nil, errors.Wrapf(ErrInvalidConfig, "endpoint %q is not an HTTP(S) URL", cfg.Endpoint)
+	}
+
+	e := &Exporter{cfg: *cfg, sent: make(map[string]time.Time)}
+	e.cfg.MetricType = cmp.Or(e.cfg.MetricType, sitemanager.N5m)
+	e.cfg.Interval = cmp.Or(e.cfg.Interval, DefaultInterval)
+	e.cfg.RetryWait = cmp.Or(e.cfg.RetryWait, DefaultRetryWait)
+	if e.cfg.MaxRetries == 0 {
+		e.cfg.MaxRetries = DefaultMaxRetries
+	}
+	if e.cfg.HTTPClient == nil {
+		e.cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if e.cfg.Logger == nil {
+		e.cfg.Logger = observability.NoopLogger()
+	}
+	e.policy = backoff.Policy{Initial: e.cfg.RetryWait, Max: maxRetryWait, Jitter: 0.2}
+	return e, nil
+}
+
+// Run fetches the ISP metrics of Config.Source every Interval and exports the new
+// periods, until ctx is canceled, which is the only error it returns. Failed fetches
+// and exports are logged and retried at the next interval; periods that could not
+// be exported are sent with the next ones, as long as the source still returns them.
+func (e *Exporter) Run(ctx context.Context) error {
+	if e.cfg.Source == nil {
+		return errors.Wrap(ErrInvalidConfig, "Run requires a source")
+	}
+
+	ticker := time.NewTicker(e.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := e.fetchAndExport(ctx)
+		if err != nil && ctx.Err() == nil {
+			e.cfg.Logger.Warn("ISP metrics export failed", observability.Field{Key: "error", Value: err})
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Exporter) fetchAndExport(ctx context.Context) error {
+	resp, err := e.cfg.Source.GetISPMetrics(ctx, e.cfg.MetricType, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch ISP metrics")
+	}
+	_, err = e.Export(ctx, resp.Data)
+	return err
+}
+
+// Export sends the periods of items that were not sent yet, and returns the number of
+// data points sent. Periods are identified by host, site, and metric time; those no
+// later than the latest period already sent for their host and site are skipped, so
+// the same metrics can be fetched and exported repeatedly. Nothing is sent if no
+// period is new. A partially rejected export is logged, not returned, since sending
+// it again would not help.
+func (e *Exporter) Export(ctx context.Context, items []sitemanager.ISPMetricItem) (int, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	pending, latest := e.unsent(items)
+	request := ISPMetrics(pending, e.cfg.ResourceAttributes)
+	points := countDataPoints(request)
+	if points == 0 {
+		return 0, nil
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to encode metrics")
+	}
+	err = e.deliver(ctx, body)
+	if err != nil {
+		return 0, err
+	}
+	for key, t := range latest {
+		e.sent[key] = t
+	}
+	return points, nil
+}
+
+// unsent returns items restricted to the periods after the latest period sent for
+// their host and site, and the latest metric time among them per host and site.
+func (e *Exporter) unsent(items []sitemanager.ISPMetricItem) ([]sitemanager.ISPMetricItem, map[string]time.Time) {
+	pending := make([]sitemanager.ISPMetricItem, 0, len(items))
+	latest := make(map[string]time.Time)
+	for _, item := range items {
+		if item.Periods == nil {
+			continue
+		}
+		key := valueOrZero(item.HostId) + "/" + valueOrZero(item.SiteId)
+		sent := e.sent[key]
+
+		var periods []sitemanager.ISPMetricPeriod
+		for _, period := range *item.Periods {
+			if period.MetricTime == nil || !period.MetricTime.After(sent) {
+				continue
+			}
+			periods = append(periods, period)
+			if period.MetricTime.After(latest[key]) {
+				latest[key] = *period.MetricTime
+			}
+		}
+		if len(periods) > 0 {
+			item.Periods = &periods
+			pending = append(pending, item)
+		}
+	}
+	return pending, latest
+}
+
+func countDataPoints(request *ExportMetricsRequest) int {
+	count := 0
+	for _, resource := range request.ResourceMetrics {
+		for _, scope := range resource.ScopeMetrics {
+			for _, metric := range scope.Metrics {
+				if metric.Gauge != nil {
+					count += len(metric.Gauge.DataPoints)
+				}
+				if metric.Sum != nil {
+					count += len(metric.Sum.DataPoints)
+				}
+			}
+		}
+	}
+	return count
+}
+
+// deliver posts body to the collector, retrying transport errors, 429 and 5xx statuses.
+func (e *Exporter) deliver(ctx context.Context, body []byte) error {
+	for attempt := 0; ; attempt++ {
+		status, retryAfter, err := e.send(ctx, body)
+		if err == nil {
+			return nil
+		}
+
+		retryable := status == 0 || retry.ShouldRetry(status)
+		if !retryable || e.cfg.MaxRetries < 0 || attempt >= e.cfg.MaxRetries {
+			return errors.Wrapf(err, "failed to export metrics after %d attempts", attempt+1)
+		}
+		sleepErr := backoff.Sleep(ctx, max(e.policy.Delay(attempt), retryAfter))
+		if sleepErr != nil {
+			return errors.WithSecondaryError(errors.WithStack(sleepErr), err)
+		}
+	}
+}
+
+// send makes one export attempt and returns the response status, its Retry-After
+// delay, and an error for anything but a 2xx status.
+func (e *Exporter) send(ctx context.Context, body []byte) (int, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.cfg.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to create request")
+	}
+	for key, value := range e.cfg.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-unifi-otlp/"+unifi.Version())
+
+	resp, err := e.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to send metrics")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return resp.StatusCode, retry.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			errors.Newf("collector returned status %d: %s", resp.StatusCode, bytes.TrimSpace(excerpt))
+	}
+
+	var result exportMetricsResponse
+	if json.NewDecoder(resp.Body).Decode(&result) == nil && result.PartialSuccess != nil &&
+		(result.PartialSuccess.RejectedDataPoints > 0 || result.PartialSuccess.ErrorMessage != "") {
+		e.cfg.Logger.Warn("collector rejected part of the ISP metrics",
+			observability.Field{Key: "rejected_data_points", Value: result.PartialSuccess.RejectedDataPoints},
+			observability.Field{Key: "error", Value: result.PartialSuccess.ErrorMessage},
+		)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, 0, nil
+}
+
+func valueOrZero[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,259 @@
+package otlp
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/sitemanager"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+var errFetchFail = errors.New("site manager unavailable")
+
+// collector records the exports it receives and answers with the next status of
+// statuses, then 200 with body.
+type collector struct {
+	mu       sync.Mutex
+	requests []ExportMetricsRequest
+	headers  []http.Header
+	statuses []int
+	body     string
+}
+
+func (c *collector) handler(t *testing.T) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+
+		var request ExportMetricsRequest
+		assert.NoError(t, json.Unmarshal(body, &request))
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.requests = append(c.requests, request)
+		c.headers = append(c.headers, req.Header.Clone())
+		status := http.StatusOK
+		if len(c.statuses) > 0 {
+			status, c.statuses = c.statuses[0], c.statuses[1:]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, cmp.Or(c.body, "{}"))
+	}
+}
+
+func (c *collector) received() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.requests)
+}
+
+// fakeSource serves a settable ISP metrics response.
+type fakeSource struct {
+	mu    sync.Mutex
+	items []sitemanager.ISPMetricItem
+	err   error
+	calls int
+}
+
+func (s *fakeSource) GetISPMetrics(_ context.Context, metricType sitemanager.GetISPMetricsParamsType,
+	_ *sitemanager.GetISPMetricsParams,
+) (*sitemanager.ISPMetricsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	if metricType != sitemanager.N5m {
+		return nil, errors.Newf("unexpected metric type %s", metricType)
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &sitemanager.ISPMetricsResponse{Data: s.items}, nil
+}
+
+func newTestExporter(t *testing.T, url string, cfg Config) *Exporter {
+	t.Helper()
+
+	cfg.Endpoint = url
+	cfg.RetryWait = cmp.Or(cfg.RetryWait, time.Millisecond)
+	exporter, err := NewExporter(&cfg)
+	require.NoError(t, err)
+	return exporter
+}
+
+func TestNewExporterValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  *Config
+	}{
+		{name: "nil config"},
+		{name: "no endpoint", cfg: &Config{}},
+		{name: "bad URL", cfg: &Config{Endpoint: "otel-collector:4318"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewExporter(tt.cfg)
+			require.ErrorIs(t, err, ErrInvalidConfig)
+		})
+	}
+
+	exporter, err := NewExporter(&Config{Endpoint: "https://otel.example.com/v1/metrics"})
+	require.NoError(t, err)
+	err = exporter.Run(context.Background())
+	require.ErrorIs(t, err, ErrInvalidConfig, "Run requires a source")
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	recv := &collector{}
+	server := httptest.NewServer(recv.handler(t))
+	defer server.Close()
+
+	exporter := newTestExporter(t, server.URL, Config{
+		Headers:            map[string]string{"Authorization": "Bearer token"},
+		ResourceAttributes: map[string]string{"service.name": "unifi-isp"},
+	})
+	items := loadDualWAN(t)
+	ctx := context.Background()
+
+	points, err := exporter.Export(ctx, items)
+	require.NoError(t, err)
+	assert.Equal(t, 2*2*len(instruments), points, "two periods of two WANs")
+	require.Equal(t, 1, recv.received())
+
+	header := recv.headers[0]
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+	assert.Contains(t, header.Get("User-Agent"), "go-unifi-otlp/")
+	assert.Equal(t, *ISPMetrics(items, map[string]string{"service.name": "unifi-isp"}), recv.requests[0])
+
+	points, err = exporter.Export(ctx, items)
+	require.NoError(t, err)
+	assert.Zero(t, points)
+	assert.Equal(t, 1, recv.received(), "periods are sent once")
+
+	next := testPeriod.Add(5 * time.Minute)
+	periods := append([]sitemanager.ISPMetricPeriod{{MetricTime: &next, Data: (*items[0].Periods)[0].Data}}, *items[0].Periods...)
+	items[0].Periods = &periods
+	points, err = exporter.Export(ctx, items)
+	require.NoError(t, err)
+	assert.Equal(t, 2*len(instruments), points, "only the new period is sent")
+	require.Equal(t, 2, recv.received())
+	latency := metricByName(t, recv.requests[1].ResourceMetrics[0].ScopeMetrics[0].Metrics, "unifi.isp.latency")
+	assert.Equal(t, uint64(next.UnixNano()), latency.Gauge.DataPoints[0].TimeUnixNano)
+}
+
+func TestExportRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		statuses     []int
+		maxRetries   int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "retries server errors", statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}, wantAttempts: 3},
+		{name: "gives up after max retries", statuses: []int{500, 500, 500}, maxRetries: 2, wantAttempts: 3, wantErr: true},
+		{name: "does not retry client errors", statuses: []int{http.StatusBadRequest}, wantAttempts: 1, wantErr: true},
+		{name: "retries disabled", statuses: []int{503}, maxRetries: -1, wantAttempts: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recv := &collector{statuses: tt.statuses}
+			server := httptest.NewServer(recv.handler(t))
+			defer server.Close()
+
+			exporter := newTestExporter(t, server.URL, Config{MaxRetries: tt.maxRetries})
+			items := loadDualWAN(t)
+			_, err := exporter.Export(context.Background(), items)
+			assert.Equal(t, tt.wantErr, err != nil, "export error: %v", err)
+			assert.Equal(t, tt.wantAttempts, recv.received())
+			if !tt.wantErr {
+				return
+			}
+
+			points, err := exporter.Export(context.Background(), items)
+			require.NoError(t, err)
+			assert.Positive(t, points, "periods of failed exports are sent again")
+		})
+	}
+}
+
+func TestExportPartialSuccess(t *testing.T) {
+	t.Parallel()
+
+	recv := &collector{body: `{"partialSuccess":{"rejectedDataPoints":"3","errorMessage":"unknown unit"}}`}
+	server := httptest.NewServer(recv.handler(t))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	exporter := newTestExporter(t, server.URL, Config{
+		Logger: observability.NewSlogLogger(slog.New(slog.NewTextHandler(&logs, nil))),
+	})
+
+	points, err := exporter.Export(context.Background(), loadDualWAN(t))
+	require.NoError(t, err, "partially rejected exports are not retried")
+	assert.Positive(t, points)
+	assert.Equal(t, 1, recv.received())
+	assert.Contains(t, logs.String(), "rejected_data_points=3")
+	assert.Contains(t, logs.String(), "unknown unit")
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	recv := &collector{}
+	server := httptest.NewServer(recv.handler(t))
+	defer server.Close()
+
+	source := &fakeSource{err: errFetchFail}
+	exporter := newTestExporter(t, server.URL, Config{Source: source, Interval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- exporter.Run(ctx) }()
+
+	assert.Eventually(t, func() bool {
+		source.mu.Lock()
+		defer source.mu.Unlock()
+		if source.calls > 0 && source.err != nil {
+			source.items, source.err = loadDualWAN(t), nil
+		}
+		return source.calls > 2
+	}, 5*time.Second, 5*time.Millisecond)
+	assert.Equal(t, 1, recv.received(), "failed fetches are retried and periods sent once")
+
+	cancel()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("exporter did not stop")
+	}
+}
@@ -0,0 +1,95 @@
+package otlp
+
+// The types below are the subset of the OTLP metrics data model used by this package,
+// with the field names of its JSON encoding, in which 64-bit integers are strings.
+
+// AggregationTemporality is how the values of a sum accumulate.
+type AggregationTemporality int
+
+// Aggregation temporalities.
+const (
+	// TemporalityDelta means each data point covers only its own time range
+	TemporalityDelta AggregationTemporality = 1
+
+	// TemporalityCumulative means each data point covers the time since a fixed start
+	TemporalityCumulative AggregationTemporality = 2
+)
+
+// ExportMetricsRequest is the body of an OTLP/HTTP metrics export.
+type ExportMetricsRequest struct {
+	ResourceMetrics []ResourceMetrics `json:"resourceMetrics"`
+}
+
+// ResourceMetrics are the metrics of one resource, e.g. a site of a console.
+type ResourceMetrics struct {
+	Resource     Resource       `json:"resource"`
+	ScopeMetrics []ScopeMetrics `json:"scopeMetrics"`
+}
+
+// Resource is the entity the metrics describe.
+type Resource struct {
+	Attributes []KeyValue `json:"attributes"`
+}
+
+// ScopeMetrics are the metrics produced by one instrumentation scope.
+type ScopeMetrics struct {
+	Scope   Scope    `json:"scope"`
+	Metrics []Metric `json:"metrics"`
+}
+
+// Scope identifies the instrumentation producing metrics.
+type Scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// Metric is a named series of data points; exactly one of Gauge and Sum is set.
+type Metric struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Unit        string `json:"unit,omitempty"`
+	Gauge       *Gauge `json:"gauge,omitempty"`
+	Sum         *Sum   `json:"sum,omitempty"`
+}
+
+// Gauge holds sampled values.
+type Gauge struct {
+	DataPoints []NumberDataPoint `json:"dataPoints"`
+}
+
+// Sum holds values accumulated over time ranges.
+type Sum struct {
+	DataPoints             []NumberDataPoint      `json:"dataPoints"`
+	AggregationTemporality AggregationTemporality `json:"aggregationTemporality"`
+	IsMonotonic            bool                   `json:"isMonotonic"`
+}
+
+// NumberDataPoint is one integer value of a metric.
+type NumberDataPoint struct {
+	Attributes []KeyValue `json:"attributes,omitempty"`
+
+	// StartTimeUnixNano is the start of the time range of sums, or 0
+	StartTimeUnixNano uint64 `json:"startTimeUnixNano,string,omitempty"`
+
+	TimeUnixNano uint64 `json:"timeUnixNano,string"`
+	AsInt        int64  `json:"asInt,string"`
+}
+
+// KeyValue is an attribute of a resource or data point.
+type KeyValue struct {
+	Key   string   `json:"key"`
+	Value AnyValue `json:"value"`
+}
+
+// AnyValue is the value of an attribute; this package only uses strings.
+type AnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// exportMetricsResponse is the body of a successful OTLP/HTTP metrics export.
+type exportMetricsResponse struct {
+	PartialSuccess *struct {
+		RejectedDataPoints int64  `json:"rejectedDataPoints,string"`
+		ErrorMessage       string `json:"errorMessage"`
+	} `json:"partialSuccess"`
+}
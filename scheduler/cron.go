@@ -0,0 +1,267 @@
+package scheduler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrInvalidCron is returned when a cron expression cannot be parsed.
+var ErrInvalidCron = errors.New("invalid cron expression")
+
+// Schedule computes the activation times of a task.
+type Schedule interface {
+	// Next returns the first activation time strictly after after,
+	// or the zero time if the schedule never activates again.
+	Next(after time.Time) time.Time
+}
+
+// maxCronSearch bounds the search for the next activation, so expressions that
+// can never match (such as "0 0 30 2 *") terminate.
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+// bitset holds the allowed values of a cron field (all fields fit into 0-63).
+type bitset uint64
+
+func (b bitset) has(v int) bool {
+	return b&(1<<uint(v)) != 0
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Day of week accepts 7 as an alias for Sunday, folded into 0 after parsing.
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSchedule is a parsed five-field cron expression.
+type cronSchedule struct {
+	minute, hour, dom, month, dow bitset
+
+	// domAny and dowAny record a "*" day field. As in classic cron, a day matches
+	// either day field when both are restricted, and the restricted one otherwise.
+	domAny, dowAny bool
+
+	loc *time.Location
+}
+
+// ParseCron parses a standard five-field cron expression
+// (minute, hour, day of month, month, day of week).
+//
+// Fields support "*", values, ranges ("1-5"), steps ("*/15", "0-30/10"), and lists ("1,15").
+// Months and weekdays also accept three-letter names ("jan", "mon"); Sunday is 0 or 7.
+// The descriptors @yearly, @monthly, @weekly, @daily, @hourly, and "@every <duration>"
+// are supported as well.
+//
+// Activation times are computed in the location of the time passed to Next.
+// Prefix the expression with "TZ=<location> " to evaluate it in a fixed location instead:
+//
+//	schedule, err := scheduler.ParseCron("TZ=Europe/Berlin 0 21 * * *")
+func ParseCron(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	var loc *time.Location
+	if rest, ok := strings.CutPrefix(expr, "TZ="); ok {
+		name, spec, _ := strings.Cut(rest, " ")
+		l, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidCron, "%q: unknown location %q", expr, name)
+		}
+		loc = l
+		expr = strings.TrimSpace(spec)
+	}
+
+	if rest, ok := strings.CutPrefix(expr, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil || interval <= 0 {
+			return nil, errors.Wrapf(ErrInvalidCron, "%q: invalid interval", expr)
+		}
+		return Every(interval), nil
+	}
+
+	if spec, ok := cronDescriptors[strings.ToLower(expr)]; ok {
+		expr = spec
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.Wrapf(ErrInvalidCron, "%q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	sched := &cronSchedule{
+		domAny: fields[2] == "*",
+		dowAny: fields[4] == "*",
+		loc:    loc,
+	}
+
+	targets := []*bitset{&sched.minute, &sched.hour, &sched.dom, &sched.month, &sched.dow}
+	specs := []cronField{minuteField, hourField, domField, monthField, dowField}
+	for i, field := range fields {
+		bits, err := specs[i].parse(field)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%q", expr)
+		}
+		*targets[i] = bits
+	}
+
+	if sched.dow.has(7) {
+		sched.dow = sched.dow&^(1<<7) | 1
+	}
+
+	return sched, nil
+}
+
+// MustParseCron is like ParseCron but panics if the expression cannot be parsed.
+// It simplifies safe initialization of package-level schedules.
+func MustParseCron(expr string) Schedule {
+	sched, err := ParseCron(expr)
+	if err != nil {
+		panic(err)
+	}
+	return sched
+}
+
+func (f cronField) parse(spec string) (bitset, error) {
+	var bits bitset
+	for part := range strings.SplitSeq(spec, ",") {
+		rangeSpec, stepSpec, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepSpec)
+			if err != nil || s < 1 {
+				return 0, errors.Wrapf(ErrInvalidCron, "%s: invalid step %q", f.name, stepSpec)
+			}
+			step = s
+		}
+
+		low, high := f.min, f.max
+		switch {
+		case rangeSpec == "*":
+		case strings.Contains(rangeSpec, "-"):
+			lowSpec, highSpec, _ := strings.Cut(rangeSpec, "-")
+			var err error
+			low, err = f.value(lowSpec)
+			if err != nil {
+				return 0, err
+			}
+			high, err = f.value(highSpec)
+			if err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, errors.Wrapf(ErrInvalidCron, "%s: invalid range %q", f.name, rangeSpec)
+			}
+		default:
+			v, err := f.value(rangeSpec)
+			if err != nil {
+				return 0, err
+			}
+			low = v
+			if !hasStep {
+				high = v
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(spec string) (int, error) {
+	if v, ok := f.names[strings.ToLower(spec)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(spec)
+	if err != nil || v < f.min || v > f.max {
+		return 0, errors.Wrapf(ErrInvalidCron, "%s: value %q out of range %d-%d", f.name, spec, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next implements Schedule.
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	if s.loc != nil {
+		after = after.In(s.loc)
+	}
+	loc := after.Location()
+
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+
+	for t.Before(limit) {
+		year, month, day := t.Date()
+		switch {
+		case !s.month.has(int(month)):
+			t = time.Date(year, month+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+		case !s.hour.has(t.Hour()):
+			t = time.Date(year, month, day, t.Hour()+1, 0, 0, 0, loc)
+		case !s.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom.has(t.Day())
+	dowMatch := s.dow.has(int(t.Weekday()))
+	switch {
+	case s.domAny && s.dowAny:
+		return true
+	case s.domAny:
+		return dowMatch
+	case s.dowAny:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// intervalSchedule activates at a fixed interval.
+type intervalSchedule struct {
+	interval time.Duration
+}
+
+// Every returns a schedule activating every interval after the previous activation.
+// The scheduler counts the first interval from the moment it starts.
+// Intervals shorter than a millisecond are rounded up to one millisecond.
+func Every(interval time.Duration) Schedule {
+	return intervalSchedule{interval: max(interval, time.Millisecond)}
+}
+
+// Next implements Schedule.
+func (s intervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(s.interval)
+}
@@ -0,0 +1,132 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCronNext(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday, 2025-01-15 10:30:20 UTC
+	base := time.Date(2025, time.January, 15, 10, 30, 20, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{
+			name: "every minute",
+			expr: "* * * * *",
+			want: time.Date(2025, time.January, 15, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name: "step minutes",
+			expr: "*/15 * * * *",
+			want: time.Date(2025, time.January, 15, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "daily at midnight rolls over",
+			expr: "@daily",
+			want: time.Date(2025, time.January, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekday name",
+			expr: "0 8 * * mon",
+			want: time.Date(2025, time.January, 20, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 0 * * 7",
+			want: time.Date(2025, time.January, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "range and list",
+			expr: "0 9-17/4 * * 1-5",
+			want: time.Date(2025, time.January, 15, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "month name rolls over year",
+			expr: "0 0 1 jan *",
+			want: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week when both restricted",
+			expr: "0 0 20 * wed",
+			want: time.Date(2025, time.January, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day",
+			expr: "0 0 29 2 *",
+			want: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "every interval",
+			expr: "@every 90m",
+			want: base.Add(90 * time.Minute),
+		},
+		{
+			name: "fixed location",
+			expr: "TZ=Asia/Tokyo 0 21 * * *",
+			want: time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sched, err := ParseCron(tt.expr)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(sched.Next(base)), "got %s, want %s", sched.Next(base), tt.want)
+		})
+	}
+}
+
+func TestParseCronNeverMatches(t *testing.T) {
+	t.Parallel()
+
+	sched, err := ParseCron("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, sched.Next(time.Now()).IsZero())
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"abc * * * *",
+		"@every nope",
+		"@every -1s",
+		"TZ=Nowhere/Land * * * * *",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseCron(expr)
+			require.ErrorIs(t, err, ErrInvalidCron)
+		})
+	}
+}
+
+func TestMustParseCronPanics(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { MustParseCron("bogus") })
+	assert.NotPanics(t, func() { MustParseCron("@hourly") })
+}
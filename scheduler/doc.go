@@ -0,0 +1,56 @@
+// Package scheduler runs recurring UniFi automation tasks on cron-like schedules.
+//
+// It turns the API clients into a base for standalone automation daemons: tasks
+// wrap client calls and are registered with a cron expression or a fixed interval.
+//
+//	sched := scheduler.New(&scheduler.Config{Logger: logger})
+//
+//	// Create 10 guest vouchers every Monday at 08:00
+//	err := sched.Add(scheduler.Task{
+//	    Name:     "weekly-vouchers",
+//	    Schedule: scheduler.MustParseCron("0 8 * * mon"),
+//	    Run: func(ctx context.Context) error {
+//	        _, err := client.CreateHotspotVouchersFromSpec(ctx, siteID,
+//	            network.NewVoucherSpec(10).ValidFor(7*24*time.Hour))
+//	        return err
+//	    },
+//	})
+//
+//	// Block the kids' devices every night at 21:00
+//	err = sched.Add(scheduler.Task{
+//	    Name:     "kids-bedtime",
+//	    Schedule: scheduler.MustParseCron("0 21 * * *"),
+//	    Jitter:   30 * time.Second,
+//	    Run: func(ctx context.Context) error {
+//	        _, err := client.BlockClients(ctx, "default", tags.MACs("kids"), nil)
+//	        return err
+//	    },
+//	})
+//
+//	// Blocks until ctx is canceled
+//	err = sched.Run(ctx)
+//
+// # Schedules
+//
+// ParseCron accepts standard five-field cron expressions, the @daily-style
+// descriptors, and "@every <duration>". Every creates interval schedules directly.
+// Custom schedules implement the Schedule interface.
+//
+// # Execution
+//
+// Each run gets its own goroutine and a context derived from the one passed to Run,
+// bounded by Task.Timeout. Runs overlapping a still running previous run of the same
+// task are skipped. Panics in tasks are recovered and reported as errors.
+//
+// # Persistence
+//
+// A Store records completed runs. With Config.CatchUp, a task whose activation was
+// missed while the process was down runs once right after startup. MemoryStore is
+// an in-memory implementation; persistent stores (files, databases) implement the
+// two-method Store interface.
+//
+// # Observability
+//
+// Runs are logged through Config.Logger, and Config.OnRunComplete receives a
+// RunRecord for every run, e.g. to export success and duration metrics.
+package scheduler
@@ -0,0 +1,449 @@
+package scheduler
+
+import (
+	"cmp"
+	"context"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/internal/backoff"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+var (
+	// ErrInvalidTask is returned when a task is missing its name, schedule, or function.
+	ErrInvalidTask = errors.New("invalid task")
+	// ErrDuplicateTask is returned when a task with the same name is already registered.
+	ErrDuplicateTask = errors.New("task already registered")
+	// ErrUnknownTask is returned for operations on task names that are not registered.
+	ErrUnknownTask = errors.New("unknown task")
+	// ErrTaskRunning is returned by RunNow while the task is already running.
+	ErrTaskRunning = errors.New("task is already running")
+	// ErrAlreadyRunning is returned by Run when the scheduler is already running.
+	ErrAlreadyRunning = errors.New("scheduler is already running")
+)
+
+// TaskFunc is the work performed by a task, typically one or more client calls.
+type TaskFunc func(ctx context.Context) error
+
+// Task is a unit of work registered with a Scheduler.
+type Task struct {
+	// Name identifies the task in logs, run records, and the Store. Required and unique.
+	Name string
+
+	// Schedule determines when the task runs. Required.
+	Schedule Schedule
+
+	// Run is the work to perform. Required.
+	Run TaskFunc
+
+	// Jitter delays each run by a random duration in [0, Jitter) to spread load
+	// when many schedulers share a schedule (optional)
+	Jitter time.Duration
+
+	// Timeout bounds each run through its context (optional, no timeout if zero)
+	Timeout time.Duration
+}
+
+// RunRecord describes one completed task run.
+type RunRecord struct {
+	Task     string
+	Started  time.Time
+	Finished time.Time
+	Err      error
+}
+
+// Store persists run history, so a restarted scheduler knows when tasks last ran.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// LastRun returns the start time of the last recorded run of task,
+	// or false if the task never ran.
+	LastRun(ctx context.Context, task string) (time.Time, bool, error)
+
+	// RecordRun records a completed run.
+	RecordRun(ctx context.Context, record RunRecord) error
+}
+
+// Config holds configuration for a Scheduler.
+type Config struct {
+	// Store persists run history (optional, history is kept in memory only if nil)
+	Store Store
+
+	// CatchUp runs a task once at startup if the Store shows an activation was
+	// missed while the scheduler was down (defaults to false)
+	CatchUp bool
+
+	// Location is the time zone cron schedules are evaluated in (defaults to time.Local)
+	Location *time.Location
+
+	// Logger for observability (optional, uses noop logger if nil)
+	Logger observability.Logger
+
+	// OnRunComplete is called after every task run, e.g. to export metrics (optional)
+	OnRunComplete func(RunRecord)
+}
+
+// Entry is a snapshot of a registered task's state.
+type Entry struct {
+	Name    string
+	Next    time.Time
+	LastRun time.Time
+	LastErr error
+	Running bool
+}
+
+type entry struct {
+	task    Task
+	next    time.Time
+	lastRun time.Time
+	lastErr error
+	running bool
+}
+
+// Scheduler runs registered tasks according to their schedules.
+// All methods are safe for concurrent use; tasks can be added and removed while it runs.
+type Scheduler struct {
+	cfg Config
+
+	mu      sync.Mutex
+	entries map[string]*entry
+	running bool
+	wake    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// New creates a scheduler. A nil cfg uses the defaults.
+func New(cfg *Config) *Scheduler {
+	s := &Scheduler{
+		entries: make(map[string]*entry),
+		wake:    make(chan struct{}, 1),
+	}
+	if cfg != nil {
+		s.cfg = *cfg
+	}
+	if s.cfg.Location == nil {
+		s.cfg.Location = time.Local
+	}
+	if s.cfg.Logger == nil {
+		s.cfg.Logger = observability.NoopLogger()
+	}
+	return s
+}
+
+// Add registers a task. If the scheduler is running, the task is scheduled immediately.
+func (s *Scheduler) Add(task Task) error {
+	if task.Name == "" || task.Schedule == nil || task.Run == nil {
+		return errors.Wrap(ErrInvalidTask, "name, schedule, and run function are required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.entries[task.Name]; ok {
+		return errors.Wrapf(ErrDuplicateTask, "%q", task.Name)
+	}
+
+	e := &entry{task: task}
+	s.entries[task.Name] = e
+	if s.running {
+		e.next = task.Schedule.Next(s.localNow())
+		s.notify()
+	}
+	return nil
+}
+
+// Remove unregisters a task. A run in progress is not interrupted.
+// It reports whether the task was registered.
+func (s *Scheduler) Remove(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.entries[name]
+	delete(s.entries, name)
+	if ok {
+		s.notify()
+	}
+	return ok
+}
+
+// Entries returns the state of all registered tasks, sorted by name.
+func (s *Scheduler) Entries() []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := make([]Entry, 0, len(s.entries))
+	for _, e := range s.entries {
+		entries = append(entries, Entry{
+			Name:    e.task.Name,
+			Next:    e.next,
+			LastRun: e.lastRun,
+			LastErr: e.lastErr,
+			Running: e.running,
+		})
+	}
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return entries
+}
+
+// RunNow runs the named task immediately in the calling goroutine, regardless of its
+// schedule and without jitter, and returns its error. The run is recorded like a scheduled one.
+func (s *Scheduler) RunNow(ctx context.Context, name string) error {
+	s.mu.Lock()
+	e, ok := s.entries[name]
+	if !ok {
+		s.mu.Unlock()
+		return errors.Wrapf(ErrUnknownTask, "%q", name)
+	}
+	if e.running {
+		s.mu.Unlock()
+		return errors.Wrapf(ErrTaskRunning, "%q", name)
+	}
+	e.running = true
+	s.mu.Unlock()
+
+	return s.execute(ctx, e)
+}
+
+// Run starts the scheduler and blocks until ctx is canceled. It then waits for
+// running tasks to finish, which observe the cancellation through their context,
+// and returns nil. A run whose previous run is still in progress is skipped.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return ErrAlreadyRunning
+	}
+	s.running = true
+	now := s.localNow()
+	for _, e := range s.entries {
+		e.next = s.firstActivation(ctx, e, now)
+	}
+	tasks := len(s.entries)
+	s.mu.Unlock()
+
+	s.cfg.Logger.Info("scheduler started", observability.Field{Key: "tasks", Value: tasks})
+
+	defer func() {
+		s.wg.Wait()
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+		s.cfg.Logger.Info("scheduler stopped")
+	}()
+
+	for {
+		var timer *time.Timer
+		var fire <-chan time.Time
+		if next := s.nextActivation(); !next.IsZero() {
+			timer = time.NewTimer(time.Until(next))
+			fire = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			stopTimer(timer)
+			return nil
+		case <-s.wake:
+			stopTimer(timer)
+		case <-fire:
+			s.dispatchDue(ctx)
+		}
+	}
+}
+
+// firstActivation computes the first activation of e when the scheduler starts,
+// taking missed runs recorded in the Store into account.
+func (s *Scheduler) firstActivation(ctx context.Context, e *entry, now time.Time) time.Time {
+	if s.cfg.Store != nil {
+		last, ok, err := s.cfg.Store.LastRun(ctx, e.task.Name)
+		switch {
+		case err != nil:
+			s.cfg.Logger.Warn("failed to load task history",
+				observability.Field{Key: "task", Value: e.task.Name},
+				observability.Field{Key: "error", Value: err.Error()},
+			)
+		case ok:
+			e.lastRun = last
+			missed := e.task.Schedule.Next(last.In(s.cfg.Location))
+			if s.cfg.CatchUp && !missed.IsZero() && !missed.After(now) {
+				return now
+			}
+		}
+	}
+	return e.task.Schedule.Next(now)
+}
+
+func (s *Scheduler) nextActivation() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var next time.Time
+	for _, e := range s.entries {
+		if !e.next.IsZero() && (next.IsZero() || e.next.Before(next)) {
+			next = e.next
+		}
+	}
+	return next
+}
+
+// dispatchDue starts all tasks whose activation time has passed.
+func (s *Scheduler) dispatchDue(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.localNow()
+	for _, e := range s.entries {
+		if e.next.IsZero() || e.next.After(now) {
+			continue
+		}
+		e.next = e.task.Schedule.Next(now)
+
+		if e.running {
+			s.cfg.Logger.Warn("skipping task run, previous run still in progress",
+				observability.Field{Key: "task", Value: e.task.Name},
+			)
+			continue
+		}
+		e.running = true
+
+		s.wg.Go(func() {
+			if !sleepJitter(ctx, e.task.Jitter) {
+				s.mu.Lock()
+				e.running = false
+				s.mu.Unlock()
+				return
+			}
+			//nolint:errcheck // The error is recorded and reported by execute
+			_ = s.execute(ctx, e)
+		})
+	}
+}
+
+// execute runs the task of e and records the outcome. e.running must be set by the caller.
+func (s *Scheduler) execute(ctx context.Context, e *entry) error {
+	taskCtx := ctx
+	if e.task.Timeout > 0 {
+		var cancel context.CancelFunc
+		taskCtx, cancel = context.WithTimeout(ctx, e.task.Timeout)
+		defer cancel()
+	}
+
+	logger := s.cfg.Logger.With(observability.Field{Key: "task", Value: e.task.Name})
+	logger.Debug("task started")
+
+	record := RunRecord{Task: e.task.Name, Started: time.Now()}
+	record.Err = runTask(taskCtx, e.task.Run)
+	record.Finished = time.Now()
+
+	s.mu.Lock()
+	e.running = false
+	e.lastRun = record.Started
+	e.lastErr = record.Err
+	s.mu.Unlock()
+
+	duration := observability.Field{Key: "duration", Value: record.Finished.Sub(record.Started)}
+	if record.Err != nil {
+		logger.Error("task failed", duration, observability.Field{Key: "error", Value: record.Err.Error()})
+	} else {
+		logger.Info("task completed", duration)
+	}
+
+	if s.cfg.Store != nil {
+		// Record the run even if ctx was canceled during it.
+		err := s.cfg.Store.RecordRun(context.WithoutCancel(ctx), record)
+		if err != nil {
+			logger.Warn("failed to record task run", observability.Field{Key: "error", Value: err.Error()})
+		}
+	}
+
+	if s.cfg.OnRunComplete != nil {
+		s.cfg.OnRunComplete(record)
+	}
+
+	return record.Err
+}
+
+// runTask calls fn and converts a panic into an error, so one faulty task cannot stop the scheduler.
+func runTask(ctx context.Context, fn TaskFunc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Newf("task panicked: %v", r)
+		}
+	}()
+	return fn(ctx)
+}
+
+// sleepJitter waits a random duration in [0, jitter). It returns false if ctx is canceled first.
+func sleepJitter(ctx context.Context, jitter time.Duration) bool {
+	if jitter <= 0 {
+		return true
+	}
+
+	//nolint:gosec // Jitter does not need a cryptographically secure source
+	return backoff.Sleep(ctx, rand.N(jitter)) == nil
+}
+
+func (s *Scheduler) localNow() time.Time {
+	return time.Now().In(s.cfg.Location)
+}
+
+// notify wakes up the Run loop to recompute the next activation. s.mu must be held.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// MemoryStore is an in-memory Store. It is useful for tests and as a reference
+// implementation; run history is lost when the process exits.
+type MemoryStore struct {
+	mu   sync.Mutex
+	runs map[string][]RunRecord
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{runs: make(map[string][]RunRecord)}
+}
+
+// LastRun implements Store.
+func (m *MemoryStore) LastRun(_ context.Context, task string) (time.Time, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	runs := m.runs[task]
+	if len(runs) == 0 {
+		return time.Time{}, false, nil
+	}
+	return runs[len(runs)-1].Started, true, nil
+}
+
+// RecordRun implements Store.
+func (m *MemoryStore) RecordRun(_ context.Context, record RunRecord) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.runs[record.Task] = append(m.runs[record.Task], record)
+	return nil
+}
+
+// Runs returns the recorded runs of task, oldest first.
+func (m *MemoryStore) Runs(task string) []RunRecord {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.runs[task])
+}
@@ -0,0 +1,267 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runScheduler starts s in the background and returns a function stopping it.
+func runScheduler(t *testing.T, s *Scheduler) func() {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	return func() {
+		cancel()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("scheduler did not stop")
+		}
+	}
+}
+
+func TestSchedulerAddValidation(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil)
+	noop := func(context.Context) error { return nil }
+
+	require.ErrorIs(t, s.Add(Task{Schedule: Every(time.Second), Run: noop}), ErrInvalidTask)
+	require.ErrorIs(t, s.Add(Task{Name: "a", Run: noop}), ErrInvalidTask)
+	require.ErrorIs(t, s.Add(Task{Name: "a", Schedule: Every(time.Second)}), ErrInvalidTask)
+
+	require.NoError(t, s.Add(Task{Name: "a", Schedule: Every(time.Second), Run: noop}))
+	require.ErrorIs(t, s.Add(Task{Name: "a", Schedule: Every(time.Second), Run: noop}), ErrDuplicateTask)
+
+	assert.True(t, s.Remove("a"))
+	assert.False(t, s.Remove("a"))
+}
+
+func TestSchedulerRunsTasks(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryStore()
+	var completed atomic.Int32
+	s := New(&Config{
+		Store:         store,
+		OnRunComplete: func(RunRecord) { completed.Add(1) },
+	})
+
+	var runs atomic.Int32
+	require.NoError(t, s.Add(Task{
+		Name:     "tick",
+		Schedule: Every(10 * time.Millisecond),
+		Run: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	}))
+
+	stop := runScheduler(t, s)
+	require.Eventually(t, func() bool { return runs.Load() >= 3 }, 5*time.Second, 5*time.Millisecond)
+	stop()
+
+	assert.Equal(t, runs.Load(), completed.Load())
+	assert.Len(t, store.Runs("tick"), int(runs.Load()))
+
+	entries := s.Entries()
+	require.Len(t, entries, 1)
+	assert.False(t, entries[0].LastRun.IsZero())
+	assert.NoError(t, entries[0].LastErr)
+}
+
+func TestSchedulerSkipsOverlappingRuns(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil)
+	var running, maxRunning, runs atomic.Int32
+	require.NoError(t, s.Add(Task{
+		Name:     "slow",
+		Schedule: Every(5 * time.Millisecond),
+		Run: func(ctx context.Context) error {
+			current := running.Add(1)
+			defer running.Add(-1)
+			if current > maxRunning.Load() {
+				maxRunning.Store(current)
+			}
+			runs.Add(1)
+			select {
+			case <-time.After(50 * time.Millisecond):
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	}))
+
+	stop := runScheduler(t, s)
+	require.Eventually(t, func() bool { return runs.Load() >= 2 }, 5*time.Second, 5*time.Millisecond)
+	stop()
+
+	assert.Equal(t, int32(1), maxRunning.Load())
+}
+
+func TestSchedulerAddWhileRunning(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil)
+	stop := runScheduler(t, s)
+	defer stop()
+
+	ran := make(chan struct{}, 1)
+	require.NoError(t, s.Add(Task{
+		Name:     "late",
+		Schedule: Every(10 * time.Millisecond),
+		Run: func(context.Context) error {
+			select {
+			case ran <- struct{}{}:
+			default:
+			}
+			return nil
+		},
+	}))
+
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		t.Fatal("task added while running was not executed")
+	}
+}
+
+func TestSchedulerRunNow(t *testing.T) {
+	t.Parallel()
+
+	taskErr := errors.New("controller unreachable")
+	store := NewMemoryStore()
+	s := New(&Config{Store: store})
+	require.NoError(t, s.Add(Task{
+		Name:     "manual",
+		Schedule: MustParseCron("@yearly"),
+		Run:      func(context.Context) error { return taskErr },
+	}))
+
+	err := s.RunNow(context.Background(), "manual")
+	require.ErrorIs(t, err, taskErr)
+	require.Len(t, store.Runs("manual"), 1)
+	require.ErrorIs(t, store.Runs("manual")[0].Err, taskErr)
+	require.ErrorIs(t, s.Entries()[0].LastErr, taskErr)
+
+	require.ErrorIs(t, s.RunNow(context.Background(), "missing"), ErrUnknownTask)
+}
+
+func TestSchedulerRecoversPanics(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil)
+	require.NoError(t, s.Add(Task{
+		Name:     "faulty",
+		Schedule: Every(time.Hour),
+		Run:      func(context.Context) error { panic("boom") },
+	}))
+
+	err := s.RunNow(context.Background(), "faulty")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestSchedulerTimeout(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil)
+	require.NoError(t, s.Add(Task{
+		Name:     "bounded",
+		Schedule: Every(time.Hour),
+		Timeout:  10 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}))
+
+	err := s.RunNow(context.Background(), "bounded")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSchedulerCatchUp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		catchUp bool
+		lastRun time.Duration
+		wantRun bool
+	}{
+		{name: "missed run caught up", catchUp: true, lastRun: -2 * time.Hour, wantRun: true},
+		{name: "no missed run", catchUp: true, lastRun: -time.Minute, wantRun: false},
+		{name: "catch up disabled", catchUp: false, lastRun: -2 * time.Hour, wantRun: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := NewMemoryStore()
+			require.NoError(t, store.RecordRun(context.Background(), RunRecord{
+				Task:    "hourly",
+				Started: time.Now().Add(tt.lastRun),
+			}))
+
+			s := New(&Config{Store: store, CatchUp: tt.catchUp})
+			var runs atomic.Int32
+			require.NoError(t, s.Add(Task{
+				Name:     "hourly",
+				Schedule: Every(time.Hour),
+				Run: func(context.Context) error {
+					runs.Add(1)
+					return nil
+				},
+			}))
+
+			stop := runScheduler(t, s)
+			if tt.wantRun {
+				require.Eventually(t, func() bool { return runs.Load() == 1 }, 5*time.Second, 5*time.Millisecond)
+			} else {
+				time.Sleep(50 * time.Millisecond)
+			}
+			stop()
+
+			if !tt.wantRun {
+				assert.Zero(t, runs.Load())
+			}
+		})
+	}
+}
+
+func TestSchedulerRunTwice(t *testing.T) {
+	t.Parallel()
+
+	s := New(nil)
+	started := make(chan struct{})
+	require.NoError(t, s.Add(Task{
+		Name:     "signal",
+		Schedule: Every(5 * time.Millisecond),
+		Run: func(context.Context) error {
+			select {
+			case <-started:
+			default:
+				close(started)
+			}
+			return nil
+		},
+	}))
+
+	stop := runScheduler(t, s)
+	defer stop()
+
+	<-started
+	require.ErrorIs(t, s.Run(context.Background()), ErrAlreadyRunning)
+}
@@ -0,0 +1,109 @@
+// Package seq adapts the iterators returned by go-unifi clients to slices and channels.
+//
+// Paginated and streaming helpers of the API clients return iter.Seq2[T, error]:
+// every element comes with a nil error, and a failed page fetch ends the sequence
+// with a single zero element and the error. Ranging over such a sequence fetches
+// pages lazily and stops fetching as soon as the loop breaks:
+//
+//	for device, err := range client.AllSiteDevices(ctx, siteID) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    if device.Name == "Core Switch" {
+//	        break // No further pages are requested
+//	    }
+//	}
+//
+// Collect gathers a sequence into a slice, and Chan streams it to a channel for
+// pipelines built around goroutines.
+package seq
+
+import (
+	"context"
+	"iter"
+)
+
+// Result is an element or error delivered by Chan.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// Collect returns all elements of s, or the first error it yields.
+func Collect[T any](s iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range s {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Values returns the elements of s as an iter.Seq for use with the slices and maps
+// packages. Iteration stops at the first error, which is stored in *errp.
+//
+// Example:
+//
+//	var err error
+//	devices := slices.SortedFunc(seq.Values(client.AllSiteDevices(ctx, siteID), &err),
+//	    func(a, b network.DeviceListItem) int { return strings.Compare(a.Name, b.Name) })
+//	if err != nil {
+//	    return err
+//	}
+func Values[T any](s iter.Seq2[T, error], errp *error) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item, err := range s {
+			if err != nil {
+				*errp = err
+				return
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Chan streams s to a channel from a new goroutine. The channel is closed after the
+// last element, after an error, or when ctx is canceled; cancellation also stops the
+// underlying iteration, so no further pages are fetched. buffer is the channel capacity.
+func Chan[T any](ctx context.Context, s iter.Seq2[T, error], buffer int) <-chan Result[T] {
+	ch := make(chan Result[T], buffer)
+	go func() {
+		defer close(ch)
+		for item, err := range s {
+			select {
+			case ch <- Result[T]{Value: item, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// FromSlice returns a sequence yielding the elements of items without error,
+// e.g. to feed fixed data to code consuming client iterators.
+func FromSlice[T any](items []T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Fail returns a sequence yielding only err, as client iterators do when the first
+// page cannot be fetched.
+func Fail[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
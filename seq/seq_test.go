@@ -0,0 +1,98 @@
+package seq
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errPage = errors.New("page fetch failed")
+
+// failAfter yields items, then err if it is not nil.
+func failAfter(items []int, err error) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for item, err := range FromSlice(items) {
+			if !yield(item, err) {
+				return
+			}
+		}
+		if err != nil {
+			yield(0, err)
+		}
+	}
+}
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	items, err := Collect(FromSlice([]int{1, 2, 3}))
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+
+	_, err = Collect(failAfter([]int{1}, errPage))
+	require.ErrorIs(t, err, errPage)
+
+	_, err = Collect(Fail[int](errPage))
+	require.ErrorIs(t, err, errPage)
+}
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	assert.Equal(t, []int{1, 2}, slices.Collect(Values(failAfter([]int{1, 2}, errPage), &err)))
+	require.ErrorIs(t, err, errPage)
+
+	err = nil
+	assert.Equal(t, []int{1, 2, 3}, slices.Sorted(Values(FromSlice([]int{3, 1, 2}), &err)))
+	require.NoError(t, err)
+
+	for range Values(FromSlice([]int{1, 2, 3}), &err) {
+		break
+	}
+	require.NoError(t, err)
+}
+
+func TestChan(t *testing.T) {
+	t.Parallel()
+
+	var got []Result[int]
+	for result := range Chan(context.Background(), failAfter([]int{1, 2}, errPage), 1) {
+		got = append(got, result)
+	}
+	require.Len(t, got, 3)
+	assert.Equal(t, 1, got[0].Value)
+	assert.Equal(t, 2, got[1].Value)
+	require.ErrorIs(t, got[2].Err, errPage)
+}
+
+func TestChanCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stopped := make(chan struct{})
+	infinite := func(yield func(int, error) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if !yield(i, nil) {
+				return
+			}
+		}
+	}
+
+	ch := Chan(ctx, infinite, 0)
+	assert.Equal(t, 0, (<-ch).Value)
+	cancel()
+	<-stopped
+
+	for range ch {
+		// Drain values sent before cancellation was observed
+	}
+}
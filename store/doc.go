@@ -0,0 +1,27 @@
+// Package store persists inventory snapshots of a UniFi Network controller, its
+// sites with their devices and connected clients, to a JSON file. CLIs and exporters
+// open the store at start to show the last known state at once, while the first
+// refresh runs in the background:
+//
+//	s, err := store.Open(&store.Config{Path: path, Source: client})
+//	if err != nil {
+//	    return err
+//	}
+//	go s.Run(ctx)
+//
+//	if snapshot := s.Snapshot(); snapshot != nil {
+//	    show(snapshot) // last known state, as of snapshot.CapturedAt
+//	}
+//	<-s.Ready()
+//	show(s.Snapshot()) // current state
+//
+// Fetch captures a snapshot without a store, e.g. for one-off inventories.
+//
+// # Persistence
+//
+// Every successful refresh replaces the file atomically, so a crash never leaves a
+// truncated snapshot behind. The file is readable by its owner only, since snapshots
+// list the addresses of clients. A file that cannot be used, e.g. one written by a
+// future version of this package, is ignored at Open and replaced by the next
+// refresh: the store is a cache, not a source of truth.
+package store
@@ -0,0 +1,305 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"io/fs"
+	"iter"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/observability"
+	"github.com/lexfrei/go-unifi/seq"
+)
+
+const (
+	// DefaultInterval is the default time between two refreshes of Run.
+	DefaultInterval = time.Minute
+
+	// formatVersion is the version of the file format; files of other versions are
+	// ignored, as if missing.
+	formatVersion = 1
+
+	dirMode = 0o700
+)
+
+// ErrInvalidConfig is returned by Open for configurations it cannot run.
+var ErrInvalidConfig = errors.New("invalid snapshot store configuration")
+
+// Snapshot is the inventory of a Network controller at one point in time. Snapshots
+// returned by a Store are shared and must not be modified.
+type Snapshot struct {
+	CapturedAt time.Time `json:"capturedAt"`
+	Sites      []Site    `json:"sites"`
+}
+
+// Site is a site with its devices and connected clients.
+type Site struct {
+	network.SiteListItem
+
+	Devices []network.DeviceListItem `json:"devices"`
+	Clients []network.ClientListItem `json:"clients"`
+}
+
+// Site returns the site with the given ID, name, or internal reference, or nil.
+func (s *Snapshot) Site(ref string) *Site {
+	for i := range s.Sites {
+		if siteMatches(&s.Sites[i].SiteListItem, ref) {
+			return &s.Sites[i]
+		}
+	}
+	return nil
+}
+
+func siteMatches(site *network.SiteListItem, ref string) bool {
+	return ref == site.Id.String() || ref == site.Name || ref == site.InternalReference
+}
+
+// file is the JSON document persisted by a Store.
+type file struct {
+	Version  int       `json:"version"`
+	Snapshot *Snapshot `json:"snapshot"`
+}
+
+// Source lists the inventory of a controller; *network.APIClient implements it.
+type Source interface {
+	AllSites(ctx context.Context) iter.Seq2[network.SiteListItem, error]
+	AllSiteDevices(ctx context.Context, siteID network.SiteId) iter.Seq2[network.DeviceListItem, error]
+	AllSiteClients(ctx context.Context, siteID network.SiteId) iter.Seq2[network.ClientListItem, error]
+}
+
+// Fetch captures a snapshot of the sites listed by source, restricted to the sites
+// matching one of sites by ID, name, or internal reference if any are given.
+func Fetch(ctx context.Context, source Source, sites ...string) (*Snapshot, error) {
+	snapshot := &Snapshot{CapturedAt: time.Now().UTC(), Sites: []Site{}}
+	for site, err := range source.AllSites(ctx) {
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list sites")
+		}
+		if !selected(&site, sites) {
+			continue
+		}
+
+		captured := Site{SiteListItem: site}
+		captured.Devices, err = seq.Collect(source.AllSiteDevices(ctx, site.Id))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list devices of site %s", site.Name)
+		}
+		captured.Clients, err = seq.Collect(source.AllSiteClients(ctx, site.Id))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list clients of site %s", site.Name)
+		}
+		snapshot.Sites = append(snapshot.Sites, captured)
+	}
+	return snapshot, nil
+}
+
+func selected(site *network.SiteListItem, sites []string) bool {
+	if len(sites) == 0 {
+		return true
+	}
+	for _, ref := range sites {
+		if siteMatches(site, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultPath returns the default snapshot file, go-unifi/snapshot.json in the user's
+// cache directory.
+func DefaultPath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to locate user cache directory")
+	}
+	return filepath.Join(dir, "go-unifi", "snapshot.json"), nil
+}
+
+// Config holds configuration for a Store.
+type Config struct {
+	// Path is the file snapshots are persisted to, e.g. DefaultPath(). Required.
+	Path string
+
+	// Source lists the inventory (required by Refresh and Run only)
+	Source Source
+
+	// Sites restricts snapshots to the sites with these IDs, names, or internal
+	// references (optional, all sites if empty)
+	Sites []string
+
+	// Interval is the time between two refreshes of Run (defaults to DefaultInterval)
+	Interval time.Duration
+
+	// Logger for observability (optional, uses noop logger if nil)
+	Logger observability.Logger
+}
+
+// Store keeps the latest inventory snapshot in memory and in a file, so programs can
+// show the last known state at start while the first refresh is in progress.
+// Refreshes are serialized; all methods are safe for concurrent use.
+type Store struct {
+	cfg Config
+
+	// refreshMu serializes refreshes, so the file always holds the latest snapshot
+	refreshMu sync.Mutex
+
+	mu       sync.RWMutex
+	snapshot *Snapshot
+
+	ready     chan struct{}
+	readyOnce sync.Once
+}
+
+// Open creates a store backed by the file at cfg.Path and loads the snapshot it
+// holds. A missing file leaves the store empty; so does a file that cannot be read
+// or parsed, or was written by another format version, which is logged, since the
+// next refresh replaces it. Errors match ErrInvalidConfig.
+func Open(cfg *Config) (*Store, error) {
+	if cfg == nil || cfg.Path == "" {
+		return nil, errors.Wrap(ErrInvalidConfig, "path is required")
+	}
+
+	s := &Store{cfg: *cfg, ready: make(chan struct{})}
+	s.cfg.Interval = cmp.Or(s.cfg.Interval, DefaultInterval)
+	if s.cfg.Logger == nil {
+		s.cfg.Logger = observability.NoopLogger()
+	}
+
+	snapshot, err := s.load()
+	if err != nil {
+		s.cfg.Logger.Warn("ignoring unusable snapshot file",
+			observability.Field{Key: "path", Value: s.cfg.Path},
+			observability.Field{Key: "error", Value: err},
+		)
+	}
+	s.snapshot = snapshot
+	return s, nil
+}
+
+// Snapshot returns the latest snapshot, loaded from the file or captured by a
+// refresh, or nil if there is none yet.
+func (s *Store) Snapshot() *Snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.snapshot
+}
+
+// Ready returns a channel closed once a refresh succeeded, from when Snapshot
+// returns the current inventory instead of the one loaded from the file.
+func (s *Store) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Refresh captures a snapshot from Config.Source, makes it the latest, and writes it
+// to the file. If capturing fails, the previous snapshot is kept; if only writing
+// fails, the new snapshot is still returned and served, along with the error.
+func (s *Store) Refresh(ctx context.Context) (*Snapshot, error) {
+	if s.cfg.Source == nil {
+		return nil, errors.Wrap(ErrInvalidConfig, "Refresh requires a source")
+	}
+
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	snapshot, err := Fetch(ctx, s.cfg.Source, s.cfg.Sites...)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.snapshot = snapshot
+	s.mu.Unlock()
+	s.readyOnce.Do(func() { close(s.ready) })
+
+	return snapshot, s.save(snapshot)
+}
+
+// Run refreshes the snapshot every Interval, starting at once, until ctx is
+// canceled, which is the only error it returns. Failed refreshes are logged and
+// retried at the next interval.
+func (s *Store) Run(ctx context.Context) error {
+	if s.cfg.Source == nil {
+		return errors.Wrap(ErrInvalidConfig, "Run requires a source")
+	}
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := s.Refresh(ctx)
+		if err != nil && ctx.Err() == nil {
+			s.cfg.Logger.Warn("snapshot refresh failed", observability.Field{Key: "error", Value: err})
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// load reads the snapshot of the file. A missing file holds no snapshot.
+func (s *Store) load() (*Snapshot, error) {
+	data, err := os.ReadFile(s.cfg.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read snapshot file")
+	}
+
+	var f file
+	err = json.Unmarshal(data, &f)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse snapshot file")
+	}
+	if f.Version != formatVersion {
+		return nil, errors.Newf("snapshot file has format version %d, expected %d", f.Version, formatVersion)
+	}
+	return f.Snapshot, nil
+}
+
+// save replaces the file atomically with one holding snapshot.
+func (s *Store) save(snapshot *Snapshot) error {
+	data, err := json.Marshal(file{Version: formatVersion, Snapshot: snapshot})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode snapshot")
+	}
+
+	dir := filepath.Dir(s.cfg.Path)
+	err = os.MkdirAll(dir, dirMode)
+	if err != nil {
+		return errors.Wrap(err, "failed to create snapshot directory")
+	}
+
+	// CreateTemp creates the file with 0600 permissions, as snapshots list the
+	// addresses of clients.
+	tmp, err := os.CreateTemp(dir, ".snapshot-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create snapshot file")
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // Already renamed on success
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		_ = tmp.Close()
+		return errors.Wrap(err, "failed to write snapshot file")
+	}
+	err = tmp.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to write snapshot file")
+	}
+	err = os.Rename(tmp.Name(), s.cfg.Path)
+	if err != nil {
+		return errors.Wrap(err, "failed to replace snapshot file")
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network"
+)
+
+var (
+	testSite    = network.SiteListItem{Id: uuid.MustParse("88f7af54-98f8-306a-a1c7-c9349722b1f6"), InternalReference: "default", Name: "Default"}
+	testBranch  = network.SiteListItem{Id: uuid.MustParse("99f7af54-98f8-306a-a1c7-c9349722b1f6"), InternalReference: "branch", Name: "Branch"}
+	errListFail = errors.New("controller unavailable")
+)
+
+// fakeSource serves a settable inventory and counts the site listings.
+type fakeSource struct {
+	mu      sync.Mutex
+	sites   []network.SiteListItem
+	devices map[network.SiteId][]network.DeviceListItem
+	clients map[network.SiteId][]network.ClientListItem
+	err     error
+	lists   int
+}
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{
+		sites: []network.SiteListItem{testSite, testBranch},
+		devices: map[network.SiteId][]network.DeviceListItem{
+			testSite.Id:   {{Id: uuid.New(), Name: "gateway", MacAddress: "aa:00:00:00:00:01"}},
+			testBranch.Id: {{Id: uuid.New(), Name: "branch-ap", MacAddress: "aa:00:00:00:00:02"}},
+		},
+		clients: map[network.SiteId][]network.ClientListItem{
+			testSite.Id: {{Id: uuid.New(), Name: "laptop", MacAddress: "bb:00:00:00:00:01", IpAddress: "10.0.0.10"}},
+		},
+	}
+}
+
+func (s *fakeSource) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *fakeSource) listed() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lists
+}
+
+func (s *fakeSource) AllSites(context.Context) iter.Seq2[network.SiteListItem, error] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lists++
+	return items(s.sites, s.err)
+}
+
+func (s *fakeSource) AllSiteDevices(_ context.Context, siteID network.SiteId) iter.Seq2[network.DeviceListItem, error] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return items(s.devices[siteID], nil)
+}
+
+func (s *fakeSource) AllSiteClients(_ context.Context, siteID network.SiteId) iter.Seq2[network.ClientListItem, error] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return items(s.clients[siteID], nil)
+}
+
+func items[T any](list []T, err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+		for _, item := range list {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+func testPath(t *testing.T) string {
+	t.Helper()
+	return filepath.Join(t.TempDir(), "cache", "snapshot.json")
+}
+
+func openStore(t *testing.T, cfg Config) *Store {
+	t.Helper()
+
+	s, err := Open(&cfg)
+	require.NoError(t, err)
+	return s
+}
+
+func isReady(s *Store) bool {
+	select {
+	case <-s.Ready():
+		return true
+	default:
+		return false
+	}
+}
+
+func TestOpenValidation(t *testing.T) {
+	t.Parallel()
+
+	_, err := Open(nil)
+	require.ErrorIs(t, err, ErrInvalidConfig)
+	_, err = Open(&Config{})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	s := openStore(t, Config{Path: testPath(t)})
+	_, err = s.Refresh(context.Background())
+	require.ErrorIs(t, err, ErrInvalidConfig, "Refresh requires a source")
+	err = s.Run(context.Background())
+	require.ErrorIs(t, err, ErrInvalidConfig, "Run requires a source")
+}
+
+func TestFetch(t *testing.T) {
+	t.Parallel()
+
+	source := newFakeSource()
+	snapshot, err := Fetch(context.Background(), source)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Sites, 2)
+	assert.WithinDuration(t, time.Now(), snapshot.CapturedAt, time.Minute)
+	assert.Equal(t, "gateway", snapshot.Sites[0].Devices[0].Name)
+	assert.Equal(t, "laptop", snapshot.Sites[0].Clients[0].Name)
+	assert.Empty(t, snapshot.Sites[1].Clients)
+
+	assert.Same(t, &snapshot.Sites[1], snapshot.Site("branch"))
+	assert.Same(t, &snapshot.Sites[1], snapshot.Site("Branch"))
+	assert.Same(t, &snapshot.Sites[0], snapshot.Site(testSite.Id.String()))
+	assert.Nil(t, snapshot.Site("missing"))
+
+	snapshot, err = Fetch(context.Background(), source, "branch")
+	require.NoError(t, err)
+	require.Len(t, snapshot.Sites, 1)
+	assert.Equal(t, testBranch.Id, snapshot.Sites[0].Id)
+
+	source.fail(errListFail)
+	_, err = Fetch(context.Background(), source)
+	require.ErrorIs(t, err, errListFail)
+}
+
+func TestRefreshPersists(t *testing.T) {
+	t.Parallel()
+
+	path := testPath(t)
+	source := newFakeSource()
+	s := openStore(t, Config{Path: path, Source: source, Sites: []string{"default"}})
+	assert.Nil(t, s.Snapshot(), "a missing file leaves the store empty")
+	assert.False(t, isReady(s))
+
+	snapshot, err := s.Refresh(context.Background())
+	require.NoError(t, err)
+	require.Len(t, snapshot.Sites, 1)
+	assert.Same(t, snapshot, s.Snapshot())
+	assert.True(t, isReady(s))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	reopened := openStore(t, Config{Path: path})
+	loaded := reopened.Snapshot()
+	require.NotNil(t, loaded, "the snapshot is loaded at start")
+	assert.False(t, isReady(reopened), "a loaded snapshot is not current")
+	assert.True(t, snapshot.CapturedAt.Equal(loaded.CapturedAt))
+	assert.Equal(t, snapshot.Sites[0].SiteListItem, loaded.Sites[0].SiteListItem)
+	assert.Equal(t, snapshot.Sites[0].Devices, loaded.Sites[0].Devices)
+	assert.Equal(t, snapshot.Sites[0].Clients, loaded.Sites[0].Clients)
+}
+
+func TestRefreshFailureKeepsSnapshot(t *testing.T) {
+	t.Parallel()
+
+	path := testPath(t)
+	source := newFakeSource()
+	s := openStore(t, Config{Path: path, Source: source})
+	snapshot, err := s.Refresh(context.Background())
+	require.NoError(t, err)
+
+	source.fail(errListFail)
+	_, err = s.Refresh(context.Background())
+	require.ErrorIs(t, err, errListFail)
+	assert.Same(t, snapshot, s.Snapshot())
+	assert.NotNil(t, openStore(t, Config{Path: path}).Snapshot(), "the file keeps the previous snapshot")
+}
+
+func TestOpenIgnoresUnusableFiles(t *testing.T) {
+	t.Parallel()
+
+	future, err := json.Marshal(file{Version: formatVersion + 1, Snapshot: &Snapshot{}})
+	require.NoError(t, err)
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "corrupt", data: []byte(`{"version":1,"snapshot":`)},
+		{name: "other version", data: future},
+		{name: "empty", data: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "snapshot.json")
+			require.NoError(t, os.WriteFile(path, tt.data, 0o600))
+
+			s := openStore(t, Config{Path: path, Source: newFakeSource()})
+			assert.Nil(t, s.Snapshot())
+
+			_, err := s.Refresh(context.Background())
+			require.NoError(t, err)
+			assert.NotNil(t, openStore(t, Config{Path: path}).Snapshot(), "the next refresh replaces the file")
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	source := newFakeSource()
+	s := openStore(t, Config{Path: testPath(t), Source: source, Interval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	select {
+	case <-s.Ready():
+	case <-time.After(5 * time.Second):
+		t.Fatal("store did not refresh")
+	}
+	assert.NotNil(t, s.Snapshot())
+	assert.Eventually(t, func() bool { return source.listed() > 2 }, 5*time.Second, 5*time.Millisecond)
+
+	cancel()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("store did not stop")
+	}
+}
@@ -0,0 +1,22 @@
+// Package unifierr defines the error taxonomy shared by all go-unifi API clients.
+//
+// Both the Network and Site Manager clients report failed API calls with errors
+// that match one of the sentinel errors below, so code working across APIs can
+// handle failures uniformly with errors.Is:
+//
+//	hosts, err := smClient.ListHosts(ctx, nil)
+//	switch {
+//	case errors.Is(err, unifierr.ErrUnauthorized):
+//	    // Invalid or revoked API key
+//	case errors.Is(err, unifierr.ErrRateLimited):
+//	    // Back off and try again later
+//	}
+//
+// The HTTP status code, the ID of the request, and the trace ID returned by the API
+// are available through APIError, e.g. for support tickets:
+//
+//	var apiErr *unifierr.APIError
+//	if errors.As(err, &apiErr) {
+//	    log.Printf("status: %d, request: %s, trace: %s", apiErr.StatusCode, apiErr.RequestID, apiErr.TraceID)
+//	}
+package unifierr
@@ -0,0 +1,243 @@
+package unifierr
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Sentinel errors classifying failed API calls.
+var (
+	// ErrNotFound indicates the requested resource does not exist (404).
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthorized indicates missing, invalid, or insufficient credentials (401, 403).
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrRateLimited indicates the API rate limit was exceeded (429).
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrUnavailable indicates the API or controller could not serve the request (5xx).
+	ErrUnavailable = errors.New("service unavailable")
+
+	// ErrValidation indicates the request was rejected as invalid (400, 422).
+	ErrValidation = errors.New("validation failed")
+
+	// ErrConflict indicates the resource changed since the caller read it, so an update
+	// based on the old state was refused (409, 412), see ConflictError.
+	ErrConflict = errors.New("resource changed concurrently")
+
+	// ErrUnknownField indicates a response contained fields not modeled by the client.
+	// It is only reported when strict decoding is enabled.
+	ErrUnknownField = errors.New("response contains unknown field")
+
+	// ErrControllerMaintenance indicates the controller is starting, migrating its
+	// database, or updating, and temporarily cannot serve requests. Errors matching it
+	// also match ErrUnavailable.
+	ErrControllerMaintenance = errors.New("controller in maintenance")
+
+	// ErrWouldExceedDeadline indicates a request was not sent because waiting for
+	// the client-side rate limiter would outlast the context deadline.
+	ErrWouldExceedDeadline = errors.New("rate limit wait would exceed context deadline")
+
+	// ErrRetryBudgetExhausted indicates a failed request was not retried because the
+	// client's retry budget, shared by all its requests, was used up.
+	ErrRetryBudgetExhausted = errors.New("retry budget exhausted")
+
+	// ErrReadOnlyClient indicates a request was not sent because it could change the
+	// configuration of the controller and the client is read-only.
+	ErrReadOnlyClient = errors.New("client is read-only")
+
+	// ErrOutOfScope indicates a request was not sent because its operation is outside
+	// the capabilities granted to a scoped client.
+	ErrOutOfScope = errors.New("operation outside client scope")
+
+	// ErrPanic indicates a request failed because code it ran, typically a Logger,
+	// MetricsRecorder or hook supplied by the caller, panicked.
+	ErrPanic = errors.New("panic during request")
+)
+
+// MaintenanceError is returned when the controller answers 503 Service Unavailable
+// because it is in maintenance. It matches ErrControllerMaintenance and ErrUnavailable.
+type MaintenanceError struct {
+	// State is the maintenance phase announced by the controller:
+	// "starting", "migrating", "updating", or "maintenance" if unspecified.
+	State string
+
+	// RetryAfter is the wait suggested by the Retry-After header, or zero if absent.
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface.
+func (e *MaintenanceError) Error() string {
+	return "controller in maintenance: " + e.State
+}
+
+// Unwrap returns ErrControllerMaintenance.
+func (e *MaintenanceError) Unwrap() error {
+	return ErrControllerMaintenance
+}
+
+// Is reports whether target is ErrUnavailable, the class maintenance belongs to.
+func (e *MaintenanceError) Is(target error) bool {
+	return target == ErrUnavailable //nolint:errorlint // Sentinel comparison by identity is intended
+}
+
+// RetryBudgetError is returned when a request failed and could not be retried because
+// the client's retry budget was exhausted. It matches ErrRetryBudgetExhausted as well
+// as the class of the last failure: the sentinel for StatusCode, or Err.
+type RetryBudgetError struct {
+	// StatusCode is the HTTP status of the last failed attempt, or zero on network errors.
+	StatusCode int
+
+	// Err is the network error of the last failed attempt, or nil if a response was received.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *RetryBudgetError) Error() string {
+	if e.Err != nil {
+		return "retry budget exhausted: " + e.Err.Error()
+	}
+	return fmt.Sprintf("retry budget exhausted: status=%d", e.StatusCode)
+}
+
+// Unwrap returns ErrRetryBudgetExhausted and the cause of the last failure.
+func (e *RetryBudgetError) Unwrap() []error {
+	errs := []error{ErrRetryBudgetExhausted}
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+	if cause := FromStatus(e.StatusCode); cause != nil {
+		errs = append(errs, cause)
+	}
+	return errs
+}
+
+// PanicError is returned when a panic was recovered while a request went through the
+// client's middleware chain. It matches ErrPanic and, if the panic value is an error,
+// that error.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic during request: %v", e.Value)
+}
+
+// Unwrap returns ErrPanic and the panic value if it is an error.
+func (e *PanicError) Unwrap() []error {
+	if err, ok := e.Value.(error); ok {
+		return []error{ErrPanic, err}
+	}
+	return []error{ErrPanic}
+}
+
+// ScopeError is returned by scoped clients for operations outside their scope.
+// It matches ErrOutOfScope.
+type ScopeError struct {
+	// Operation is the refused operation, such as "DeleteDNSRecord", or empty for
+	// raw requests.
+	Operation string
+
+	// Capability is the capability the operation requires, such as "dns.write".
+	Capability string
+}
+
+// Error implements the error interface.
+func (e *ScopeError) Error() string {
+	if e.Operation == "" {
+		return "raw request requires capability " + e.Capability
+	}
+	return fmt.Sprintf("operation %s requires capability %s", e.Operation, e.Capability)
+}
+
+// Unwrap returns ErrOutOfScope.
+func (e *ScopeError) Unwrap() error {
+	return ErrOutOfScope
+}
+
+// ConflictError is returned when an update expecting a revision of a resource finds
+// it at another revision, because it was changed concurrently. It matches ErrConflict.
+type ConflictError struct {
+	// Resource is the kind of the resource, such as "DNS record".
+	Resource string
+
+	// ID identifies the resource.
+	ID string
+
+	// Expected is the revision the update was based on.
+	Expected string
+
+	// Actual is the current revision of the resource.
+	Actual string
+}
+
+// Error implements the error interface.
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s %s changed concurrently: expected revision %s, found %s", e.Resource, e.ID, e.Expected, e.Actual)
+}
+
+// Unwrap returns ErrConflict.
+func (e *ConflictError) Unwrap() error {
+	return ErrConflict
+}
+
+// APIError is returned when an API responds with an unexpected HTTP status.
+// It matches the sentinel error for its status class via errors.Is.
+type APIError struct {
+	// StatusCode is the HTTP status code returned by the API.
+	StatusCode int
+
+	// RequestID is the X-Request-ID the client sent with the request, or empty.
+	RequestID string
+
+	// TraceID is the trace ID returned by the API in the response body, or empty.
+	// Ubiquiti support uses it to find the request in their logs.
+	TraceID string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API error: status=%d", e.StatusCode)
+	if e.RequestID != "" {
+		msg += " request_id=" + e.RequestID
+	}
+	if e.TraceID != "" {
+		msg += " trace_id=" + e.TraceID
+	}
+	return msg
+}
+
+// Unwrap returns the sentinel error matching the status code, if any.
+func (e *APIError) Unwrap() error {
+	return FromStatus(e.StatusCode)
+}
+
+// FromStatus returns the sentinel error for an HTTP status code,
+// or nil if the status does not belong to a known class.
+func FromStatus(statusCode int) error {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden:
+		return ErrUnauthorized
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusUnprocessableEntity:
+		return ErrValidation
+	case statusCode == http.StatusConflict, statusCode == http.StatusPreconditionFailed:
+		return ErrConflict
+	case statusCode >= http.StatusInternalServerError:
+		return ErrUnavailable
+	default:
+		return nil
+	}
+}
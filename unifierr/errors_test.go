@@ -0,0 +1,160 @@
+package unifierr_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/unifierr"
+)
+
+func TestFromStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		want       error
+	}{
+		{name: "bad request", statusCode: http.StatusBadRequest, want: unifierr.ErrValidation},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, want: unifierr.ErrUnauthorized},
+		{name: "forbidden", statusCode: http.StatusForbidden, want: unifierr.ErrUnauthorized},
+		{name: "not found", statusCode: http.StatusNotFound, want: unifierr.ErrNotFound},
+		{name: "unprocessable entity", statusCode: http.StatusUnprocessableEntity, want: unifierr.ErrValidation},
+		{name: "too many requests", statusCode: http.StatusTooManyRequests, want: unifierr.ErrRateLimited},
+		{name: "internal server error", statusCode: http.StatusInternalServerError, want: unifierr.ErrUnavailable},
+		{name: "bad gateway", statusCode: http.StatusBadGateway, want: unifierr.ErrUnavailable},
+		{name: "service unavailable", statusCode: http.StatusServiceUnavailable, want: unifierr.ErrUnavailable},
+		{name: "conflict", statusCode: http.StatusConflict, want: unifierr.ErrConflict},
+		{name: "precondition failed", statusCode: http.StatusPreconditionFailed, want: unifierr.ErrConflict},
+		{name: "method not allowed", statusCode: http.StatusMethodNotAllowed, want: nil},
+		{name: "created", statusCode: http.StatusCreated, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, unifierr.FromStatus(tt.statusCode))
+		})
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Wrap(&unifierr.APIError{StatusCode: http.StatusNotFound}, "failed to get host")
+
+	assert.ErrorIs(t, err, unifierr.ErrNotFound)
+	assert.NotErrorIs(t, err, unifierr.ErrUnauthorized)
+	assert.Contains(t, err.Error(), "API error: status=404")
+
+	var apiErr *unifierr.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestAPIErrorCorrelation(t *testing.T) {
+	t.Parallel()
+
+	err := &unifierr.APIError{StatusCode: http.StatusBadGateway, RequestID: "req-1", TraceID: "trace-1"}
+	assert.Equal(t, "API error: status=502 request_id=req-1 trace_id=trace-1", err.Error())
+	assert.Equal(t, "API error: status=502 trace_id=trace-1", (&unifierr.APIError{StatusCode: http.StatusBadGateway, TraceID: "trace-1"}).Error())
+}
+
+func TestAPIErrorUnclassified(t *testing.T) {
+	t.Parallel()
+
+	err := &unifierr.APIError{StatusCode: http.StatusMethodNotAllowed}
+
+	for _, sentinel := range []error{
+		unifierr.ErrConflict,
+		unifierr.ErrNotFound,
+		unifierr.ErrUnauthorized,
+		unifierr.ErrRateLimited,
+		unifierr.ErrUnavailable,
+		unifierr.ErrValidation,
+	} {
+		assert.NotErrorIs(t, err, sentinel)
+	}
+}
+
+func TestMaintenanceError(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Wrap(&unifierr.MaintenanceError{State: "updating", RetryAfter: 30 * time.Second}, "failed to list devices")
+
+	assert.ErrorIs(t, err, unifierr.ErrControllerMaintenance)
+	assert.ErrorIs(t, err, unifierr.ErrUnavailable)
+	assert.NotErrorIs(t, err, unifierr.ErrNotFound)
+	assert.Contains(t, err.Error(), "controller in maintenance: updating")
+
+	var maintenance *unifierr.MaintenanceError
+	require.ErrorAs(t, err, &maintenance)
+	assert.Equal(t, 30*time.Second, maintenance.RetryAfter)
+
+	assert.NotErrorIs(t, &unifierr.APIError{StatusCode: http.StatusServiceUnavailable}, unifierr.ErrControllerMaintenance)
+}
+
+func TestRetryBudgetError(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Wrap(&unifierr.RetryBudgetError{StatusCode: http.StatusTooManyRequests}, "failed to list devices")
+	assert.ErrorIs(t, err, unifierr.ErrRetryBudgetExhausted)
+	assert.ErrorIs(t, err, unifierr.ErrRateLimited)
+	assert.NotErrorIs(t, err, unifierr.ErrUnavailable)
+	assert.Contains(t, err.Error(), "retry budget exhausted: status=429")
+
+	cause := errors.New("connection reset")
+	err = &unifierr.RetryBudgetError{Err: cause}
+	assert.ErrorIs(t, err, unifierr.ErrRetryBudgetExhausted)
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, "retry budget exhausted: connection reset", err.Error())
+}
+
+func TestPanicError(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Wrap(&unifierr.PanicError{Value: "nil map"}, "failed to list devices")
+	assert.ErrorIs(t, err, unifierr.ErrPanic)
+	assert.Contains(t, err.Error(), "panic during request: nil map")
+
+	cause := errors.New("index out of range")
+	err = &unifierr.PanicError{Value: cause}
+	assert.ErrorIs(t, err, unifierr.ErrPanic)
+	assert.ErrorIs(t, err, cause)
+
+	var panicErr *unifierr.PanicError
+	require.ErrorAs(t, errors.Wrap(err, "failed"), &panicErr)
+	assert.Equal(t, cause, panicErr.Value)
+}
+
+func TestScopeError(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Wrap(&unifierr.ScopeError{Operation: "DeleteDNSRecord", Capability: "dns.write"}, "failed to delete DNS record")
+	assert.ErrorIs(t, err, unifierr.ErrOutOfScope)
+	assert.Contains(t, err.Error(), "operation DeleteDNSRecord requires capability dns.write")
+
+	var scopeErr *unifierr.ScopeError
+	require.ErrorAs(t, err, &scopeErr)
+	assert.Equal(t, "dns.write", scopeErr.Capability)
+
+	assert.Equal(t, "raw request requires capability raw.write", (&unifierr.ScopeError{Capability: "raw.write"}).Error())
+}
+
+func TestConflictError(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Wrap(&unifierr.ConflictError{Resource: "DNS record", ID: "abc", Expected: "r1", Actual: "r2"}, "failed to update DNS record")
+	assert.ErrorIs(t, err, unifierr.ErrConflict)
+	assert.Contains(t, err.Error(), "DNS record abc changed concurrently: expected revision r1, found r2")
+
+	var conflictErr *unifierr.ConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, "r2", conflictErr.Actual)
+}
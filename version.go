@@ -0,0 +1,56 @@
+// Package unifi holds information about the go-unifi module itself. The API clients
+// live in the api/sitemanager and api/network packages.
+package unifi
+
+import (
+	"runtime/debug"
+	"sync"
+)
+
+// ModulePath is the import path of the go-unifi module.
+const ModulePath = "github.com/lexfrei/go-unifi"
+
+// DevelVersion is reported by Version when the module version is unknown, e.g. in
+// a local checkout or a build without module information.
+const DevelVersion = "devel"
+
+// Version returns the version of the go-unifi module linked into the binary, such as
+// "v0.9.0", as recorded by the Go toolchain, or DevelVersion if it is unknown.
+//
+// The API clients report it in their User-Agent header as go-unifi/<version>.
+func Version() string {
+	return buildVersion()
+}
+
+var buildVersion = sync.OnceValue(func() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return DevelVersion
+	}
+	return moduleVersion(info)
+})
+
+// moduleVersion finds the version of the go-unifi module in build information,
+// following replace directives.
+func moduleVersion(info *debug.BuildInfo) string {
+	module := &info.Main
+	if module.Path != ModulePath {
+		module = nil
+		for _, dep := range info.Deps {
+			if dep.Path == ModulePath {
+				module = dep
+				break
+			}
+		}
+	}
+	if module == nil {
+		return DevelVersion
+	}
+	if module.Replace != nil {
+		module = module.Replace
+	}
+	if module.Version == "" || module.Version == "(devel)" {
+		return DevelVersion
+	}
+	return module.Version
+}
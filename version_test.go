@@ -0,0 +1,69 @@
+package unifi
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModuleVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		info *debug.BuildInfo
+		want string
+	}{
+		{
+			name: "main module checkout",
+			info: &debug.BuildInfo{Main: debug.Module{Path: ModulePath, Version: "(devel)"}},
+			want: DevelVersion,
+		},
+		{
+			name: "main module release",
+			info: &debug.BuildInfo{Main: debug.Module{Path: ModulePath, Version: "v0.9.0"}},
+			want: "v0.9.0",
+		},
+		{
+			name: "dependency",
+			info: &debug.BuildInfo{
+				Main: debug.Module{Path: "example.com/app", Version: "(devel)"},
+				Deps: []*debug.Module{
+					{Path: "github.com/cockroachdb/errors", Version: "v1.12.0"},
+					{Path: ModulePath, Version: "v0.9.1"},
+				},
+			},
+			want: "v0.9.1",
+		},
+		{
+			name: "replaced dependency",
+			info: &debug.BuildInfo{
+				Main: debug.Module{Path: "example.com/app"},
+				Deps: []*debug.Module{
+					{Path: ModulePath, Version: "v0.9.1", Replace: &debug.Module{Path: "../go-unifi"}},
+				},
+			},
+			want: DevelVersion,
+		},
+		{
+			name: "not linked",
+			info: &debug.BuildInfo{Main: debug.Module{Path: "example.com/app"}},
+			want: DevelVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, moduleVersion(tt.info))
+		})
+	}
+}
+
+func TestVersion(t *testing.T) {
+	t.Parallel()
+
+	assert.NotEmpty(t, Version())
+	assert.Equal(t, Version(), Version())
+}
@@ -0,0 +1,46 @@
+// Package webhook turns client connections seen by a UniFi Network controller into
+// webhook deliveries, bridging the SDK to systems that can only ingest webhooks.
+//
+// An Emitter polls the connected clients of sites, compares them with the previous
+// poll, and POSTs one JSON Event per change to every configured endpoint:
+//
+//	emitter, err := webhook.NewEmitter(&webhook.Config{
+//	    Source: client,
+//	    Sites:  []network.SiteId{siteID},
+//	    Endpoints: []webhook.Endpoint{{
+//	        URL:    "https://automation.example.com/hooks/unifi",
+//	        Secret: os.Getenv("WEBHOOK_SECRET"),
+//	        Events: []webhook.EventType{webhook.EventClientConnected},
+//	    }},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//
+//	// Blocks until ctx is canceled
+//	err = emitter.Run(ctx)
+//
+// # Events
+//
+// A client is identified by its MAC address. It is connected when it appears, or
+// when its connection time changes because it reconnected between two polls; it is
+// disconnected when it disappears; it roamed when its uplink device changes. The
+// first poll of a site records the clients already connected without emitting
+// events, unless Config.EmitInitial is set. Events are normalized JSON documents
+// whose ID stays the same across retries, so receivers can drop duplicates.
+//
+// # Signing
+//
+// Deliveries to endpoints with a Secret carry an HMAC-SHA256 signature of their
+// timestamp and body in the X-Webhook-Signature header. VerifySignature checks it
+// on the receiving side and rejects stale timestamps, which defeats replays.
+//
+// # Retries
+//
+// Deliveries that fail with a transport error, 429 Too Many Requests or a 5xx status
+// are retried with exponential backoff, honoring Retry-After; other statuses fail
+// the delivery at once. Each endpoint receives its events in order, and a slow
+// endpoint does not hold up deliveries to the others, only the next poll.
+// Config.OnDelivery reports the outcome of every delivery, e.g. to export metrics
+// or to keep failed events for later.
+package webhook
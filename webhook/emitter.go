@@ -0,0 +1,417 @@
+package webhook
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+
+	unifi "github.com/lexfrei/go-unifi"
+	"github.com/lexfrei/go-unifi/api/network"
+	"github.com/lexfrei/go-unifi/internal/backoff"
+	"github.com/lexfrei/go-unifi/internal/retry"
+	"github.com/lexfrei/go-unifi/observability"
+)
+
+const (
+	// DefaultInterval is the default time between two polls of the connected clients.
+	DefaultInterval = 30 * time.Second
+
+	// DefaultMaxRetries is the default number of retries of a failed delivery.
+	DefaultMaxRetries = 5
+
+	// DefaultRetryWait is the default wait before the first retry of a failed delivery.
+	DefaultRetryWait = time.Second
+
+	// maxRetryWait caps the exponentially growing wait between retries of a delivery.
+	maxRetryWait = time.Minute
+
+	// maxErrorBody caps the part of a failed response body kept in delivery errors.
+	maxErrorBody = 512
+)
+
+// ErrInvalidConfig is returned by NewEmitter for configurations it cannot run.
+var ErrInvalidConfig = errors.New("invalid webhook configuration")
+
+// EventType is the type of an Event.
+type EventType string
+
+// Event types.
+const (
+	// EventClientConnected is emitted when a client connects, or reconnects between two polls
+	EventClientConnected EventType = "client.connected"
+
+	// EventClientDisconnected is emitted when a client is no longer connected
+	EventClientDisconnected EventType = "client.disconnected"
+
+	// EventClientRoamed is emitted when a connected client moves to another uplink device
+	EventClientRoamed EventType = "client.roamed"
+)
+
+// Client is the normalized description of a client in events.
+type Client struct {
+	ID             string    `json:"id"`
+	MAC            string    `json:"mac"`
+	Name           string    `json:"name,omitempty"`
+	IP             string    `json:"ip,omitempty"`
+	Type           string    `json:"type,omitempty"`
+	UplinkDeviceID string    `json:"uplink_device_id,omitempty"`
+	ConnectedAt    time.Time `json:"connected_at"`
+}
+
+// Event is the JSON document delivered to webhook endpoints.
+type Event struct {
+	// ID identifies the event; it is the same across retries of a delivery
+	ID string `json:"id"`
+
+	Type EventType `json:"type"`
+
+	// Time is when the change was detected
+	Time time.Time `json:"time"`
+
+	SiteID string `json:"site_id"`
+	Client Client `json:"client"`
+
+	// PreviousUplinkDeviceID is the uplink device the client left (client.roamed only)
+	PreviousUplinkDeviceID string `json:"previous_uplink_device_id,omitempty"`
+}
+
+// Endpoint is a webhook URL events are delivered to.
+type Endpoint struct {
+	// URL receives events as POST requests with a JSON body. Required.
+	URL string
+
+	// Secret signs deliveries with HMAC-SHA256 (optional, deliveries are unsigned if empty)
+	Secret string
+
+	// Events restricts deliveries to the listed types (optional, all types if empty)
+	Events []EventType
+}
+
+func (e *Endpoint) wants(eventType EventType) bool {
+	return len(e.Events) == 0 || slices.Contains(e.Events, eventType)
+}
+
+// Delivery describes the outcome of delivering an event to an endpoint.
+type Delivery struct {
+	Endpoint string
+	Event    Event
+
+	// Attempts is the number of requests sent, including retries
+	Attempts int
+
+	// StatusCode is the status of the last response, or 0 if none was received
+	StatusCode int
+
+	// Err is nil if the endpoint accepted the event with a 2xx status
+	Err error
+}
+
+// ClientSource lists the connected clients of a site; *network.APIClient implements it.
+type ClientSource interface {
+	AllSiteClients(ctx context.Context, siteID network.SiteId) iter.Seq2[network.ClientListItem, error]
+}
+
+// Config holds configuration for an Emitter.
+type Config struct {
+	// Source lists the connected clients. Required.
+	Source ClientSource
+
+	// Sites are the sites whose clients are watched. Required.
+	Sites []network.SiteId
+
+	// Endpoints receive the events. Required.
+	Endpoints []Endpoint
+
+	// Interval is the time between two polls of Run (defaults to DefaultInterval)
+	Interval time.Duration
+
+	// HTTPClient sends deliveries (optional, uses a client with a 10 second timeout if nil)
+	HTTPClient *http.Client
+
+	// MaxRetries is the number of retries of a failed delivery (defaults to
+	// DefaultMaxRetries, negative disables retries)
+	MaxRetries int
+
+	// RetryWait is the wait before the first retry, doubling up to one minute for the
+	// following ones (defaults to DefaultRetryWait)
+	RetryWait time.Duration
+
+	// EmitInitial emits client.connected for the clients already connected at the
+	// first poll of a site (defaults to false, they only form the baseline)
+	EmitInitial bool
+
+	// Logger for observability (optional, uses noop logger if nil)
+	Logger observability.Logger
+
+	// OnDelivery is called after every delivery, successful or not, e.g. to export
+	// metrics or to keep failed events for later (optional)
+	OnDelivery func(Delivery)
+}
+
+// Emitter watches the connected clients of sites and delivers their changes to
+// webhook endpoints. Polls are serialized; all methods are safe for concurrent use.
+type Emitter struct {
+	cfg    Config
+	policy backoff.Policy
+
+	// pollMu serializes polls, so events of consecutive polls are delivered in order
+	pollMu sync.Mutex
+	known  map[network.SiteId]map[string]network.ClientListItem
+}
+
+// NewEmitter creates an emitter, validating the source, sites, and endpoints of cfg.
+// Errors match ErrInvalidConfig.
+func NewEmitter(cfg *Config) (*Emitter, error) {
+	if cfg == nil || cfg.Source == nil || len(cfg.Sites) == 0 || len(cfg.Endpoints) == 0 {
+		return nil, errors.Wrap(ErrInvalidConfig, "source, sites, and endpoints are required")
+	}
+	for i := range cfg.Endpoints {
+		if !strings.HasPrefix(cfg.Endpoints[i].URL, "http://") && !strings.HasPrefix(cfg.Endpoints[i].URL, "https://") {
+			return nil, errors.Wrapf(ErrInvalidConfig, "endpoint URL %q is not an HTTP(S) URL", cfg.Endpoints[i].URL)
+		}
+	}
+
+	e := &Emitter{
+		cfg:   *cfg,
+		known: make(map[network.SiteId]map[string]network.ClientListItem),
+	}
+	e.cfg.Interval = cmp.Or(e.cfg.Interval, DefaultInterval)
+	e.cfg.RetryWait = cmp.Or(e.cfg.RetryWait, DefaultRetryWait)
+	if e.cfg.MaxRetries == 0 {
+		e.cfg.MaxRetries = DefaultMaxRetries
+	}
+	if e.cfg.HTTPClient == nil {
+		e.cfg.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if e.cfg.Logger == nil {
+		e.cfg.Logger = observability.NoopLogger()
+	}
+	e.policy = backoff.Policy{Initial: e.cfg.RetryWait, Max: maxRetryWait, Jitter: 0.2}
+	return e, nil
+}
+
+// Run polls every Interval until ctx is canceled, which is the only error it returns.
+// Failed polls are logged and retried at the next interval.
+func (e *Emitter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := e.Poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			e.cfg.Logger.Warn("webhook poll failed", observability.Field{Key: "error", Value: err})
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.WithStack(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll lists the connected clients of every site once, delivers the changes since
+// the previous poll, and returns the events. It waits for the deliveries, so slow
+// endpoints delay the next poll instead of piling up events. Sites that cannot be
+// listed keep their previous clients and make Poll return their joined errors;
+// failed deliveries are reported to OnDelivery and the Logger, not returned.
+func (e *Emitter) Poll(ctx context.Context) ([]Event, error) {
+	e.pollMu.Lock()
+	defer e.pollMu.Unlock()
+
+	var events []Event
+	var errs []error
+	for _, siteID := range e.cfg.Sites {
+		siteEvents, err := e.pollSite(ctx, siteID)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to list clients of site %s", siteID))
+			continue
+		}
+		events = append(events, siteEvents...)
+	}
+
+	e.deliverAll(ctx, events)
+	return events, errors.Join(errs...)
+}
+
+func (e *Emitter) pollSite(ctx context.Context, siteID network.SiteId) ([]Event, error) {
+	current := make(map[string]network.ClientListItem)
+	for client, err := range e.cfg.Source.AllSiteClients(ctx, siteID) {
+		if err != nil {
+			return nil, err
+		}
+		current[strings.ToLower(client.MacAddress)] = client
+	}
+
+	previous, seen := e.known[siteID]
+	e.known[siteID] = current
+	if !seen && !e.cfg.EmitInitial {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+	event := func(eventType EventType, client *network.ClientListItem) Event {
+		return Event{
+			ID:     uuid.NewString(),
+			Type:   eventType,
+			Time:   now,
+			SiteID: siteID.String(),
+			Client: normalizeClient(client),
+		}
+	}
+
+	var events []Event
+	for _, mac := range slices.Sorted(maps.Keys(current)) {
+		client := current[mac]
+		before, ok := previous[mac]
+		switch {
+		case !ok || !before.ConnectedAt.Equal(client.ConnectedAt):
+			events = append(events, event(EventClientConnected, &client))
+		case before.UplinkDeviceId != client.UplinkDeviceId:
+			roamed := event(EventClientRoamed, &client)
+			roamed.PreviousUplinkDeviceID = uuidString(before.UplinkDeviceId)
+			events = append(events, roamed)
+		}
+	}
+	for _, mac := range slices.Sorted(maps.Keys(previous)) {
+		if _, ok := current[mac]; !ok {
+			client := previous[mac]
+			events = append(events, event(EventClientDisconnected, &client))
+		}
+	}
+	return events, nil
+}
+
+func normalizeClient(client *network.ClientListItem) Client {
+	return Client{
+		ID:             uuidString(client.Id),
+		MAC:            strings.ToLower(client.MacAddress),
+		Name:           client.Name,
+		IP:             client.IpAddress,
+		Type:           strings.ToLower(string(client.Type)),
+		UplinkDeviceID: uuidString(client.UplinkDeviceId),
+		ConnectedAt:    client.ConnectedAt.UTC(),
+	}
+}
+
+// uuidString returns id as a string, or "" for the zero UUID of missing IDs.
+func uuidString(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
+
+// deliverAll delivers events to every endpoint concurrently, in order per endpoint.
+func (e *Emitter) deliverAll(ctx context.Context, events []Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := range e.cfg.Endpoints {
+		endpoint := &e.cfg.Endpoints[i]
+		wg.Go(func() {
+			for j := range events {
+				if ctx.Err() != nil {
+					return
+				}
+				if endpoint.wants(events[j].Type) {
+					e.report(e.deliver(ctx, endpoint, &events[j]))
+				}
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func (e *Emitter) report(delivery Delivery) {
+	if delivery.Err != nil {
+		e.cfg.Logger.Error("webhook delivery failed",
+			observability.Field{Key: "endpoint", Value: delivery.Endpoint},
+			observability.Field{Key: "event_id", Value: delivery.Event.ID},
+			observability.Field{Key: "attempts", Value: delivery.Attempts},
+			observability.Field{Key: "error", Value: delivery.Err},
+		)
+	}
+	if e.cfg.OnDelivery != nil {
+		e.cfg.OnDelivery(delivery)
+	}
+}
+
+// deliver posts event to endpoint, retrying transport errors, 429 and 5xx statuses.
+func (e *Emitter) deliver(ctx context.Context, endpoint *Endpoint, event *Event) Delivery {
+	delivery := Delivery{Endpoint: endpoint.URL, Event: *event}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		delivery.Err = errors.Wrap(err, "failed to encode event")
+		return delivery
+	}
+
+	for attempt := 0; ; attempt++ {
+		var retryAfter time.Duration
+		delivery.Attempts++
+		delivery.StatusCode, retryAfter, delivery.Err = e.send(ctx, endpoint, event, body)
+		if delivery.Err == nil {
+			return delivery
+		}
+
+		retryable := delivery.StatusCode == 0 || retry.ShouldRetry(delivery.StatusCode)
+		if !retryable || e.cfg.MaxRetries < 0 || attempt >= e.cfg.MaxRetries {
+			return delivery
+		}
+		err := backoff.Sleep(ctx, max(e.policy.Delay(attempt), retryAfter))
+		if err != nil {
+			delivery.Err = errors.WithSecondaryError(err, delivery.Err)
+			return delivery
+		}
+	}
+}
+
+// send makes one delivery attempt and returns the response status, its Retry-After
+// delay, and an error for anything but a 2xx status.
+func (e *Emitter) send(ctx context.Context, endpoint *Endpoint, event *Event, body []byte) (int, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to create request")
+	}
+
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-unifi-webhook/"+unifi.Version())
+	req.Header.Set(HeaderEventID, event.ID)
+	req.Header.Set(HeaderEventType, string(event.Type))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	if endpoint.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(endpoint.Secret, now, body))
+	}
+
+	resp, err := e.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to send event")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, 0, nil
+	}
+
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	return resp.StatusCode, retry.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		errors.Newf("endpoint returned status %d: %s", resp.StatusCode, bytes.TrimSpace(excerpt))
+}
@@ -0,0 +1,360 @@
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"io"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lexfrei/go-unifi/api/network"
+)
+
+var (
+	testSite    = uuid.MustParse("88f7af54-98f8-306a-a1c7-c9349722b1f6")
+	testAP1     = uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	testAP2     = uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	testJoined  = time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	errListFail = errors.New("controller unavailable")
+)
+
+// fakeSource serves a settable list of clients for every site.
+type fakeSource struct {
+	mu      sync.Mutex
+	clients []network.ClientListItem
+	err     error
+}
+
+func (s *fakeSource) set(clients ...network.ClientListItem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clients, s.err = clients, nil
+}
+
+func (s *fakeSource) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *fakeSource) AllSiteClients(context.Context, network.SiteId) iter.Seq2[network.ClientListItem, error] {
+	s.mu.Lock()
+	clients, err := s.clients, s.err
+	s.mu.Unlock()
+
+	return func(yield func(network.ClientListItem, error) bool) {
+		if err != nil {
+			yield(network.ClientListItem{}, err)
+			return
+		}
+		for _, client := range clients {
+			if !yield(client, nil) {
+				return
+			}
+		}
+	}
+}
+
+func testClient(mac string, uplink uuid.UUID, connectedAt time.Time) network.ClientListItem {
+	return network.ClientListItem{
+		Id:             uuid.NewSHA1(uuid.Nil, []byte(mac)),
+		MacAddress:     mac,
+		Name:           "client-" + mac[len(mac)-2:],
+		IpAddress:      "10.0.0.10",
+		Type:           network.WIRELESS,
+		UplinkDeviceId: uplink,
+		ConnectedAt:    connectedAt,
+	}
+}
+
+// receiver records the requests of an endpoint and answers with the next status of
+// statuses, then 204.
+type receiver struct {
+	mu       sync.Mutex
+	events   []Event
+	headers  []http.Header
+	bodies   [][]byte
+	statuses []int
+}
+
+func (r *receiver) handler(t *testing.T) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, http.MethodPost, req.Method)
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		status := http.StatusNoContent
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		if status == http.StatusNoContent {
+			var event Event
+			assert.NoError(t, json.Unmarshal(body, &event))
+			r.events = append(r.events, event)
+		}
+		r.headers = append(r.headers, req.Header.Clone())
+		r.bodies = append(r.bodies, body)
+		w.WriteHeader(status)
+	}
+}
+
+func (r *receiver) received() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events...)
+}
+
+func newTestEmitter(t *testing.T, source ClientSource, cfg Config) *Emitter {
+	t.Helper()
+
+	cfg.Source = source
+	cfg.Sites = []network.SiteId{testSite}
+	cfg.RetryWait = cmp.Or(cfg.RetryWait, time.Millisecond)
+	emitter, err := NewEmitter(&cfg)
+	require.NoError(t, err)
+	return emitter
+}
+
+func eventTypes(events []Event) []EventType {
+	types := make([]EventType, len(events))
+	for i := range events {
+		types[i] = events[i].Type
+	}
+	return types
+}
+
+func TestNewEmitterValidation(t *testing.T) {
+	t.Parallel()
+
+	source := &fakeSource{}
+	endpoints := []Endpoint{{URL: "https://example.com/hook"}}
+	tests := []struct {
+		name string
+		cfg  *Config
+	}{
+		{name: "nil config"},
+		{name: "no source", cfg: &Config{Sites: []network.SiteId{testSite}, Endpoints: endpoints}},
+		{name: "no sites", cfg: &Config{Source: source, Endpoints: endpoints}},
+		{name: "no endpoints", cfg: &Config{Source: source, Sites: []network.SiteId{testSite}}},
+		{
+			name: "bad URL",
+			cfg:  &Config{Source: source, Sites: []network.SiteId{testSite}, Endpoints: []Endpoint{{URL: "ftp://example.com"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewEmitter(tt.cfg)
+			require.ErrorIs(t, err, ErrInvalidConfig)
+		})
+	}
+}
+
+func TestPollDetectsChanges(t *testing.T) {
+	t.Parallel()
+
+	recv := &receiver{}
+	server := httptest.NewServer(recv.handler(t))
+	defer server.Close()
+
+	source := &fakeSource{}
+	source.set(
+		testClient("AA:00:00:00:00:01", testAP1, testJoined),
+		testClient("aa:00:00:00:00:02", testAP1, testJoined),
+		testClient("aa:00:00:00:00:03", testAP1, testJoined),
+	)
+	emitter := newTestEmitter(t, source, Config{Endpoints: []Endpoint{{URL: server.URL}}})
+	ctx := context.Background()
+
+	events, err := emitter.Poll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events, "the first poll only records the baseline")
+
+	rejoined := testJoined.Add(10 * time.Minute)
+	source.set(
+		testClient("aa:00:00:00:00:01", testAP1, testJoined),
+		testClient("aa:00:00:00:00:02", testAP2, testJoined),
+		testClient("aa:00:00:00:00:03", testAP1, rejoined),
+		testClient("aa:00:00:00:00:04", testAP2, rejoined),
+	)
+	events, err = emitter.Poll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []EventType{EventClientRoamed, EventClientConnected, EventClientConnected}, eventTypes(events))
+	assert.Equal(t, testAP1.String(), events[0].PreviousUplinkDeviceID)
+	assert.Equal(t, testAP2.String(), events[0].Client.UplinkDeviceID)
+	assert.Equal(t, "aa:00:00:00:00:03", events[1].Client.MAC)
+	assert.Equal(t, rejoined, events[1].Client.ConnectedAt)
+	assert.Equal(t, testSite.String(), events[2].SiteID)
+	assert.Equal(t, "wireless", events[2].Client.Type)
+
+	source.set(testClient("aa:00:00:00:00:04", testAP2, rejoined))
+	events, err = emitter.Poll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []EventType{EventClientDisconnected, EventClientDisconnected, EventClientDisconnected}, eventTypes(events))
+	assert.Equal(t, "aa:00:00:00:00:01", events[0].Client.MAC, "MAC addresses are normalized to lower case")
+
+	assert.Equal(t, 6, len(recv.received()))
+}
+
+func TestPollEmitInitialAndListErrors(t *testing.T) {
+	t.Parallel()
+
+	recv := &receiver{}
+	server := httptest.NewServer(recv.handler(t))
+	defer server.Close()
+
+	source := &fakeSource{}
+	source.set(testClient("aa:00:00:00:00:01", testAP1, testJoined))
+	emitter := newTestEmitter(t, source, Config{Endpoints: []Endpoint{{URL: server.URL}}, EmitInitial: true})
+	ctx := context.Background()
+
+	events, err := emitter.Poll(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []EventType{EventClientConnected}, eventTypes(events))
+
+	source.fail(errListFail)
+	events, err = emitter.Poll(ctx)
+	require.ErrorIs(t, err, errListFail)
+	assert.Empty(t, events)
+
+	source.set(testClient("aa:00:00:00:00:01", testAP1, testJoined))
+	events, err = emitter.Poll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events, "a failed poll keeps the previous clients")
+}
+
+func TestDeliveryFiltersAndSigns(t *testing.T) {
+	t.Parallel()
+
+	signed, all := &receiver{}, &receiver{}
+	signedServer := httptest.NewServer(signed.handler(t))
+	defer signedServer.Close()
+	allServer := httptest.NewServer(all.handler(t))
+	defer allServer.Close()
+
+	source := &fakeSource{}
+	emitter := newTestEmitter(t, source, Config{Endpoints: []Endpoint{
+		{URL: signedServer.URL, Secret: "s3cret", Events: []EventType{EventClientDisconnected}},
+		{URL: allServer.URL},
+	}})
+	ctx := context.Background()
+
+	source.set(testClient("aa:00:00:00:00:01", testAP1, testJoined))
+	_, err := emitter.Poll(ctx)
+	require.NoError(t, err)
+	source.set(testClient("aa:00:00:00:00:02", testAP1, testJoined))
+	events, err := emitter.Poll(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, []Event{events[0], events[1]}, all.received())
+	assert.Equal(t, []Event{events[1]}, signed.received())
+
+	header := signed.headers[0]
+	assert.Equal(t, events[1].ID, header.Get(HeaderEventID))
+	assert.Equal(t, string(EventClientDisconnected), header.Get(HeaderEventType))
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	require.NoError(t, VerifySignature(header, signed.bodies[0], "s3cret", 0))
+	assert.Empty(t, all.headers[0].Get(HeaderSignature), "endpoints without a secret get unsigned deliveries")
+}
+
+func TestDeliveryRetries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		statuses     []int
+		maxRetries   int
+		wantAttempts int
+		wantStatus   int
+		wantErr      bool
+	}{
+		{name: "retries server errors", statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests}, wantAttempts: 3, wantStatus: http.StatusNoContent},
+		{name: "gives up after max retries", statuses: []int{500, 500, 500}, maxRetries: 2, wantAttempts: 3, wantStatus: 500, wantErr: true},
+		{name: "does not retry client errors", statuses: []int{http.StatusBadRequest}, wantAttempts: 1, wantStatus: http.StatusBadRequest, wantErr: true},
+		{name: "retries disabled", statuses: []int{503}, maxRetries: -1, wantAttempts: 1, wantStatus: 503, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recv := &receiver{statuses: tt.statuses}
+			server := httptest.NewServer(recv.handler(t))
+			defer server.Close()
+
+			var deliveries []Delivery
+			source := &fakeSource{}
+			emitter := newTestEmitter(t, source, Config{
+				Endpoints:   []Endpoint{{URL: server.URL}},
+				MaxRetries:  tt.maxRetries,
+				EmitInitial: true,
+				OnDelivery:  func(d Delivery) { deliveries = append(deliveries, d) },
+			})
+			source.set(testClient("aa:00:00:00:00:01", testAP1, testJoined))
+
+			events, err := emitter.Poll(context.Background())
+			require.NoError(t, err)
+			require.Len(t, deliveries, 1)
+			assert.Equal(t, tt.wantAttempts, deliveries[0].Attempts)
+			assert.Equal(t, tt.wantStatus, deliveries[0].StatusCode)
+			assert.Equal(t, tt.wantErr, deliveries[0].Err != nil, "delivery error: %v", deliveries[0].Err)
+			assert.Equal(t, events[0].ID, deliveries[0].Event.ID)
+
+			recv.mu.Lock()
+			defer recv.mu.Unlock()
+			for _, header := range recv.headers {
+				assert.Equal(t, events[0].ID, header.Get(HeaderEventID), "retries keep the event ID")
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var delivered atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		delivered.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	source := &fakeSource{}
+	source.set(testClient("aa:00:00:00:00:01", testAP1, testJoined))
+	emitter := newTestEmitter(t, source, Config{
+		Endpoints:   []Endpoint{{URL: server.URL}},
+		Interval:    10 * time.Millisecond,
+		EmitInitial: true,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- emitter.Run(ctx) }()
+
+	assert.Eventually(t, func() bool { return delivered.Load() == 1 }, 5*time.Second, 5*time.Millisecond)
+
+	cancel()
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("emitter did not stop")
+	}
+}
@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Headers of webhook deliveries.
+const (
+	// HeaderEventID holds the ID of the delivered event, the same across retries
+	HeaderEventID = "X-Webhook-Id"
+
+	// HeaderEventType holds the type of the delivered event
+	HeaderEventType = "X-Webhook-Event"
+
+	// HeaderTimestamp holds the Unix time of the delivery attempt, in seconds
+	HeaderTimestamp = "X-Webhook-Timestamp"
+
+	// HeaderSignature holds "sha256=" followed by the hex-encoded HMAC-SHA256 of the
+	// timestamp, a dot and the body, keyed with the endpoint secret
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// signaturePrefix names the algorithm of signatures.
+const signaturePrefix = "sha256="
+
+// DefaultSignatureTolerance is the maximum age of deliveries accepted by
+// VerifySignature when given no tolerance.
+const DefaultSignatureTolerance = 5 * time.Minute
+
+// ErrInvalidSignature is returned by VerifySignature for deliveries that are not
+// signed with the secret, or whose timestamp is outside the tolerance.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Sign returns the value of the HeaderSignature header of a delivery of body at
+// timestamp, signed with secret.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	return signaturePrefix + hex.EncodeToString(signature(secret, strconv.FormatInt(timestamp.Unix(), 10), body))
+}
+
+func signature(secret, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// VerifySignature checks that a delivery with the given headers and body was signed
+// with secret less than tolerance ago (DefaultSignatureTolerance if zero). Errors
+// match ErrInvalidSignature.
+//
+// Example:
+//
+//	func handle(w http.ResponseWriter, r *http.Request) {
+//	    body, _ := io.ReadAll(r.Body)
+//	    if err := webhook.VerifySignature(r.Header, body, secret, 0); err != nil {
+//	        http.Error(w, err.Error(), http.StatusUnauthorized)
+//	        return
+//	    }
+//	    var event webhook.Event
+//	    _ = json.Unmarshal(body, &event)
+//	}
+func VerifySignature(header http.Header, body []byte, secret string, tolerance time.Duration) error {
+	if tolerance <= 0 {
+		tolerance = DefaultSignatureTolerance
+	}
+
+	timestamp := header.Get(HeaderTimestamp)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "invalid timestamp %q", timestamp)
+	}
+	if age := time.Since(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return errors.Wrapf(ErrInvalidSignature, "timestamp is %s off", age.Round(time.Second))
+	}
+
+	got, ok := strings.CutPrefix(header.Get(HeaderSignature), signaturePrefix)
+	if !ok {
+		return errors.Wrap(ErrInvalidSignature, "missing sha256 signature")
+	}
+	gotMAC, err := hex.DecodeString(got)
+	if err != nil || !hmac.Equal(gotMAC, signature(secret, timestamp, body)) {
+		return errors.Wrap(ErrInvalidSignature, "signature mismatch")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"id":"1","type":"client.connected"}`)
+	now := time.Now()
+	signedHeader := func(secret string, at time.Time) http.Header {
+		header := http.Header{}
+		header.Set(HeaderTimestamp, strconv.FormatInt(at.Unix(), 10))
+		header.Set(HeaderSignature, Sign(secret, at, body))
+		return header
+	}
+
+	require.NoError(t, VerifySignature(signedHeader("s3cret", now), body, "s3cret", 0))
+	require.NoError(t, VerifySignature(signedHeader("s3cret", now.Add(-time.Hour)), body, "s3cret", 2*time.Hour))
+
+	tampered := signedHeader("s3cret", now)
+	tampered.Set(HeaderTimestamp, strconv.FormatInt(now.Unix()+1, 10))
+
+	tests := []struct {
+		name   string
+		header http.Header
+		body   []byte
+	}{
+		{name: "wrong secret", header: signedHeader("other", now), body: body},
+		{name: "modified body", header: signedHeader("s3cret", now), body: []byte(`{}`)},
+		{name: "modified timestamp", header: tampered, body: body},
+		{name: "stale", header: signedHeader("s3cret", now.Add(-DefaultSignatureTolerance-time.Minute)), body: body},
+		{name: "from the future", header: signedHeader("s3cret", now.Add(DefaultSignatureTolerance+time.Minute)), body: body},
+		{name: "unsigned", header: http.Header{HeaderTimestamp: {strconv.FormatInt(now.Unix(), 10)}}, body: body},
+		{name: "no timestamp", header: http.Header{}, body: body},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, VerifySignature(tt.header, tt.body, "s3cret", 0), ErrInvalidSignature)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	// Reference value from: printf '1700000000.{}' | openssl dgst -sha256 -hmac key
+	assert.Equal(t,
+		"sha256=9d713ed406bb7076d4123f0dc2c39d2df5c654ed4b0cd56b52c8b4c940bd63ae",
+		Sign("key", time.Unix(1700000000, 0), []byte("{}")))
+}